@@ -17,14 +17,22 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"log"
+	"net/http"
+	"net/url"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
+	// the time zones of the backup schedules are resolved without the time zone database of the image
+	_ "time/tzdata"
+
 	"go.uber.org/zap/zapcore"
 	ctrzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
 
@@ -34,27 +42,50 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller"
 
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/auditexport"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/communityconvert"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/featureflags"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nametemplate"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/selfcheck"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/waitfor"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/apikeyrotation"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlasaccessrequest"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlasdatabaseuser"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlasdatafederation"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlasdeployment"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlasenvironment"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlasfederatedauth"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlasmigration"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlasnetworkcontainer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlasorganization"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlasorguser"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlasprivateendpoint"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlasproject"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlasprojectapikey"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlasresourcepolicy"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlassearchindex"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/connectionsecret"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/deletion"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/notifier"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/ownership"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/secretstore"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/watch"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/version"
 )
 
@@ -65,6 +96,10 @@ const (
 	subobjectDeletionProtectionEnvVar  = "SUBOBJECT_DELETION_PROTECTION"
 	objectDeletionProtectionDefault    = true
 	subobjectDeletionProtectionDefault = true
+
+	waitForAtlasCommand     = "wait-for-atlas"
+	convertCommunityCommand = "convert-community"
+	auditExportCommand      = "audit-export"
 )
 
 var (
@@ -78,6 +113,18 @@ func init() {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == waitForAtlasCommand {
+		os.Exit(runWaitForAtlas(os.Args[2:]))
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == convertCommunityCommand {
+		os.Exit(runConvertCommunity(os.Args[2:], os.Stdin, os.Stdout))
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == auditExportCommand {
+		os.Exit(runAuditExport(os.Args[2:], os.Stdout))
+	}
+
 	// controller-runtime/pkg/log/zap is a wrapper over zap that implements logr
 	// logr looks quite limited in functionality so we better use Zap directly.
 	// Though we still need the controller-runtime library and go-logr/zapr as they are used in controller-runtime
@@ -94,6 +141,10 @@ func main() {
 
 	ctrl.SetLogger(zapr.NewLogger(logger))
 
+	if config.SelfCheck {
+		os.Exit(runSelfCheck(config, os.Stdout))
+	}
+
 	syncPeriod := time.Hour * 3
 
 	var cacheFunc cache.NewCacheFunc
@@ -121,7 +172,10 @@ func main() {
 		LeaderElection:         config.EnableLeaderElection,
 		LeaderElectionID:       "06d035fb.mongodb.com",
 		SyncPeriod:             &syncPeriod,
-		NewCache:               cacheFunc,
+		NewCache:               controller.TransformingCacheBuilder(cacheFunc, controller.StripManagedFields),
+		Client: client.Options{
+			Cache: &client.CacheOptions{DisableFor: controller.UncachedObjects},
+		},
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
@@ -131,23 +185,91 @@ func main() {
 	// globalPredicates should be used for general controller Predicates
 	// that should be applied to all controllers in order to limit the
 	// resources they receive events for.
-	globalPredicates := []predicate.Predicate{
-		watch.CommonPredicates(),                                  // ignore spurious changes. status changes etc.
+	namespacePredicates := []predicate.Predicate{
 		watch.SelectNamespacesPredicate(config.WatchedNamespaces), // select only desired namespaces
 	}
+	if len(config.AllowedNamespaces) > 0 {
+		namespacePredicates = append(namespacePredicates, watch.SelectNamespacesPredicate(config.AllowedNamespaces))
+	}
+	if len(config.DeniedNamespaces) > 0 {
+		namespacePredicates = append(namespacePredicates, watch.ExcludeNamespacesPredicate(config.DeniedNamespaces))
+	}
+	globalPredicates := append([]predicate.Predicate{
+		watch.CommonPredicates(), // ignore spurious changes. status changes etc.
+	}, namespacePredicates...)
+	if config.ObjectLabelSelector != "" {
+		// the selector was already validated when parsing the configuration
+		selector, _ := labels.Parse(config.ObjectLabelSelector)
+		globalPredicates = append(globalPredicates, watch.SelectLabelsPredicate(selector))
+	}
+
+	nametemplate.Environment = config.Environment
+	nametemplate.Prefix = config.GeneratedNamePrefix
+	nametemplate.Suffix = config.GeneratedNameSuffix
+	workflow.MaxRetries = config.MaxRetries
+
+	// the cluster identifies the operator in the ownership marker stamped on the Atlas resources. The namespaced
+	// installations may not be allowed to read it, the resources are marked without it then
+	if ownership.ClusterID, err = ownership.DiscoverClusterID(context.Background(), mgr.GetAPIReader()); err != nil {
+		setupLog.Info("unable to discover the cluster ID, the Atlas resources are marked without it", "error", err.Error())
+	}
+
+	atlasProvider := atlas.NewProductionProvider(config.AtlasDomain, config.GlobalAPISecret, mgr.GetClient()).
+		WithAPIVersions(config.AtlasAPIVersions).
+		WithExternalTokenURL(config.AllowExternalTokenURL)
+
+	// the pinned API versions are checked once the cache reading the global secret has started, the Operator stops
+	// when Atlas rejects one of them
+	if err = mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		return atlasProvider.CheckAPIVersions(ctx, logger.Named("atlas").Sugar())
+	})); err != nil {
+		setupLog.Error(err, "unable to add the check of the Atlas Admin API versions")
+		os.Exit(1)
+	}
+
+	var failureNotifier *notifier.Notifier
+	if config.FailureNotificationURL != "" {
+		failureNotifier, err = notifier.New(config.FailureNotificationURL, config.FailureNotificationFormat, config.Environment, logger.Named("notifier").Sugar())
+		if err != nil {
+			setupLog.Error(err, "unable to create the failure notifier")
+			os.Exit(1)
+		}
+		if err = mgr.Add(failureNotifier); err != nil {
+			setupLog.Error(err, "unable to add the failure notifier")
+			os.Exit(1)
+		}
+	}
 
-	atlasProvider := atlas.NewProductionProvider(config.AtlasDomain, config.GlobalAPISecret, mgr.GetClient())
+	var capabilitiesCache *atlas.CapabilitiesCache
+	if config.CapabilitiesCacheTTL > 0 {
+		capabilitiesCache = atlas.NewCapabilitiesCache(config.CapabilitiesCacheTTL)
+	}
+
+	var deletionScheduler *deletion.Scheduler
+	if config.DeletionConcurrency > 0 {
+		deletionScheduler = deletion.NewScheduler(config.DeletionConcurrency)
+	}
+
+	stores := secretStores(config)
 
 	if err = (&atlasdeployment.AtlasDeploymentReconciler{
-		Client:                      mgr.GetClient(),
-		Log:                         logger.Named("controllers").Named("AtlasDeployment").Sugar(),
-		Scheme:                      mgr.GetScheme(),
-		ResourceWatcher:             watch.NewResourceWatcher(),
-		GlobalPredicates:            globalPredicates,
-		EventRecorder:               mgr.GetEventRecorderFor("AtlasDeployment"),
-		AtlasProvider:               atlasProvider,
-		ObjectDeletionProtection:    config.ObjectDeletionProtection,
-		SubObjectDeletionProtection: config.SubObjectDeletionProtection,
+		Client:                       mgr.GetClient(),
+		Log:                          logger.Named("controllers").Named("AtlasDeployment").Sugar(),
+		Scheme:                       mgr.GetScheme(),
+		ResourceWatcher:              watch.NewResourceWatcher(),
+		GlobalPredicates:             globalPredicates,
+		EventRecorder:                mgr.GetEventRecorderFor("AtlasDeployment"),
+		FailureNotifier:              failureNotifier,
+		AtlasProvider:                atlasProvider,
+		ObjectDeletionProtection:     config.ObjectDeletionProtection,
+		SubObjectDeletionProtection:  config.SubObjectDeletionProtection,
+		ServerlessUsageStatsInterval: config.ServerlessUsageStatsInterval,
+		ScalingAdvisorInterval:       config.ScalingAdvisorInterval,
+		CapabilitiesCache:            capabilitiesCache,
+		DeletionScheduler:            deletionScheduler,
+		ConnectionSecretMetadata:     config.ConnectionSecretMetadata,
+		AtlasDomain:                  config.AtlasDomain,
+		SecretStores:                 stores,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AtlasDeployment")
 		os.Exit(1)
@@ -160,9 +282,13 @@ func main() {
 		ResourceWatcher:             watch.NewResourceWatcher(),
 		GlobalPredicates:            globalPredicates,
 		EventRecorder:               mgr.GetEventRecorderFor("AtlasProject"),
+		FailureNotifier:             failureNotifier,
 		AtlasProvider:               atlasProvider,
 		ObjectDeletionProtection:    config.ObjectDeletionProtection,
 		SubObjectDeletionProtection: config.SubObjectDeletionProtection,
+		EgressIPProvider:            egressIPProvider(mgr, config),
+		AWSPeeringAccepter:          awsPeeringAccepter(config),
+		DeletionScheduler:           deletionScheduler,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AtlasProject")
 		os.Exit(1)
@@ -174,11 +300,17 @@ func main() {
 		Log:                           logger.Named("controllers").Named("AtlasDatabaseUser").Sugar(),
 		Scheme:                        mgr.GetScheme(),
 		EventRecorder:                 mgr.GetEventRecorderFor("AtlasDatabaseUser"),
+		FailureNotifier:               failureNotifier,
 		AtlasProvider:                 atlasProvider,
 		GlobalPredicates:              globalPredicates,
 		ObjectDeletionProtection:      config.ObjectDeletionProtection,
 		SubObjectDeletionProtection:   config.SubObjectDeletionProtection,
 		FeaturePreviewOIDCAuthEnabled: config.FeatureFlags.IsFeaturePresent(featureflags.FeatureOIDC),
+		ConnectionSecretMetadata:      config.ConnectionSecretMetadata,
+		PropagatedLabels:              config.DatabaseUserPropagatedLabels,
+		DeletionScheduler:             deletionScheduler,
+		SecretStores:                  stores,
+		GrantableRoles:                config.AccessRequestGrantableRoles,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AtlasDatabaseUser")
 		os.Exit(1)
@@ -191,9 +323,12 @@ func main() {
 		ResourceWatcher:             watch.NewResourceWatcher(),
 		GlobalPredicates:            globalPredicates,
 		EventRecorder:               mgr.GetEventRecorderFor("AtlasDataFederation"),
+		FailureNotifier:             failureNotifier,
 		AtlasProvider:               atlasProvider,
 		ObjectDeletionProtection:    config.ObjectDeletionProtection,
 		SubObjectDeletionProtection: config.SubObjectDeletionProtection,
+		ConnectionSecretMetadata:    config.ConnectionSecretMetadata,
+		SecretStores:                stores,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AtlasDataFederation")
 		os.Exit(1)
@@ -206,6 +341,7 @@ func main() {
 		ResourceWatcher:             watch.NewResourceWatcher(),
 		GlobalPredicates:            globalPredicates,
 		EventRecorder:               mgr.GetEventRecorderFor("AtlasFederatedAuth"),
+		FailureNotifier:             failureNotifier,
 		AtlasProvider:               atlasProvider,
 		ObjectDeletionProtection:    config.ObjectDeletionProtection,
 		SubObjectDeletionProtection: config.SubObjectDeletionProtection,
@@ -214,6 +350,187 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = (&atlasmigration.AtlasMigrationReconciler{
+		Client:           mgr.GetClient(),
+		Log:              logger.Named("controllers").Named("AtlasMigration").Sugar(),
+		Scheme:           mgr.GetScheme(),
+		GlobalPredicates: globalPredicates,
+		EventRecorder:    mgr.GetEventRecorderFor("AtlasMigration"),
+		FailureNotifier:  failureNotifier,
+		AtlasProvider:    atlasProvider,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AtlasMigration")
+		os.Exit(1)
+	}
+
+	if err = (&atlasaccessrequest.AtlasAccessRequestReconciler{
+		Client:           mgr.GetClient(),
+		Log:              logger.Named("controllers").Named("AtlasAccessRequest").Sugar(),
+		Scheme:           mgr.GetScheme(),
+		GlobalPredicates: globalPredicates,
+		EventRecorder:    mgr.GetEventRecorderFor("AtlasAccessRequest"),
+		FailureNotifier:  failureNotifier,
+		GrantableRoles:   config.AccessRequestGrantableRoles,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AtlasAccessRequest")
+		os.Exit(1)
+	}
+
+	if err = (&atlassearchindex.AtlasSearchIndexReconciler{
+		Client:                   mgr.GetClient(),
+		Log:                      logger.Named("controllers").Named("AtlasSearchIndex").Sugar(),
+		Scheme:                   mgr.GetScheme(),
+		GlobalPredicates:         globalPredicates,
+		EventRecorder:            mgr.GetEventRecorderFor("AtlasSearchIndex"),
+		FailureNotifier:          failureNotifier,
+		AtlasProvider:            atlasProvider,
+		ObjectDeletionProtection: config.ObjectDeletionProtection,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AtlasSearchIndex")
+		os.Exit(1)
+	}
+
+	if err = (&atlasnetworkcontainer.AtlasNetworkContainerReconciler{
+		Client:                   mgr.GetClient(),
+		Log:                      logger.Named("controllers").Named("AtlasNetworkContainer").Sugar(),
+		Scheme:                   mgr.GetScheme(),
+		GlobalPredicates:         globalPredicates,
+		EventRecorder:            mgr.GetEventRecorderFor("AtlasNetworkContainer"),
+		FailureNotifier:          failureNotifier,
+		AtlasProvider:            atlasProvider,
+		ObjectDeletionProtection: config.ObjectDeletionProtection,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AtlasNetworkContainer")
+		os.Exit(1)
+	}
+
+	if err = (&atlasprivateendpoint.AtlasPrivateEndpointReconciler{
+		Client:                   mgr.GetClient(),
+		Log:                      logger.Named("controllers").Named("AtlasPrivateEndpoint").Sugar(),
+		Scheme:                   mgr.GetScheme(),
+		GlobalPredicates:         globalPredicates,
+		EventRecorder:            mgr.GetEventRecorderFor("AtlasPrivateEndpoint"),
+		FailureNotifier:          failureNotifier,
+		AtlasProvider:            atlasProvider,
+		ObjectDeletionProtection: config.ObjectDeletionProtection,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AtlasPrivateEndpoint")
+		os.Exit(1)
+	}
+
+	if err = (&atlasorguser.AtlasOrgUserReconciler{
+		ResourceWatcher:          watch.NewResourceWatcher(),
+		Client:                   mgr.GetClient(),
+		Log:                      logger.Named("controllers").Named("AtlasOrgUser").Sugar(),
+		Scheme:                   mgr.GetScheme(),
+		GlobalPredicates:         globalPredicates,
+		EventRecorder:            mgr.GetEventRecorderFor("AtlasOrgUser"),
+		FailureNotifier:          failureNotifier,
+		AtlasProvider:            atlasProvider,
+		ObjectDeletionProtection: config.ObjectDeletionProtection,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AtlasOrgUser")
+		os.Exit(1)
+	}
+
+	if err = (&atlasprojectapikey.AtlasProjectAPIKeyReconciler{
+		Client:                   mgr.GetClient(),
+		Log:                      logger.Named("controllers").Named("AtlasProjectAPIKey").Sugar(),
+		Scheme:                   mgr.GetScheme(),
+		GlobalPredicates:         globalPredicates,
+		EventRecorder:            mgr.GetEventRecorderFor("AtlasProjectAPIKey"),
+		FailureNotifier:          failureNotifier,
+		AtlasProvider:            atlasProvider,
+		ObjectDeletionProtection: config.ObjectDeletionProtection,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AtlasProjectAPIKey")
+		os.Exit(1)
+	}
+
+	if err = (&atlasorganization.AtlasOrganizationReconciler{
+		ResourceWatcher:          watch.NewResourceWatcher(),
+		Client:                   mgr.GetClient(),
+		Log:                      logger.Named("controllers").Named("AtlasOrganization").Sugar(),
+		Scheme:                   mgr.GetScheme(),
+		GlobalPredicates:         globalPredicates,
+		EventRecorder:            mgr.GetEventRecorderFor("AtlasOrganization"),
+		FailureNotifier:          failureNotifier,
+		AtlasProvider:            atlasProvider,
+		ObjectDeletionProtection: config.ObjectDeletionProtection,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AtlasOrganization")
+		os.Exit(1)
+	}
+
+	if err = (&atlasresourcepolicy.AtlasResourcePolicyReconciler{
+		ResourceWatcher:          watch.NewResourceWatcher(),
+		Client:                   mgr.GetClient(),
+		Log:                      logger.Named("controllers").Named("AtlasResourcePolicy").Sugar(),
+		Scheme:                   mgr.GetScheme(),
+		GlobalPredicates:         globalPredicates,
+		EventRecorder:            mgr.GetEventRecorderFor("AtlasResourcePolicy"),
+		FailureNotifier:          failureNotifier,
+		AtlasProvider:            atlasProvider,
+		ObjectDeletionProtection: config.ObjectDeletionProtection,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AtlasResourcePolicy")
+		os.Exit(1)
+	}
+
+	if err = (&atlasenvironment.AtlasEnvironmentReconciler{
+		Client:           mgr.GetClient(),
+		Log:              logger.Named("controllers").Named("AtlasEnvironment").Sugar(),
+		Scheme:           mgr.GetScheme(),
+		GlobalPredicates: globalPredicates,
+		EventRecorder:    mgr.GetEventRecorderFor("AtlasEnvironment"),
+		FailureNotifier:  failureNotifier,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AtlasEnvironment")
+		os.Exit(1)
+	}
+
+	if config.APIKeyRotationInterval > 0 && config.APIKeyRotationParentSecret != "" {
+		if err = (&apikeyrotation.APIKeyRotationReconciler{
+			Client:           mgr.GetClient(),
+			Log:              logger.Named("controllers").Named("APIKeyRotation").Sugar(),
+			EventRecorder:    mgr.GetEventRecorderFor("APIKeyRotation"),
+			GlobalPredicates: namespacePredicates,
+			AtlasDomain:      config.AtlasDomain,
+			ParentSecret:     client.ObjectKey{Namespace: config.GlobalAPISecret.Namespace, Name: config.APIKeyRotationParentSecret},
+			RotationInterval: config.APIKeyRotationInterval,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "APIKeyRotation")
+			os.Exit(1)
+		}
+	}
+
+	if config.OrphanedDeploymentsInterval > 0 {
+		if err = mgr.Add(&atlasdeployment.OrphanedDeploymentsJanitor{
+			Client:                   mgr.GetClient(),
+			Log:                      logger.Named("janitors").Named("OrphanedDeployments").Sugar(),
+			EventRecorder:            mgr.GetEventRecorderFor("AtlasProject"),
+			AtlasProvider:            atlasProvider,
+			Interval:                 config.OrphanedDeploymentsInterval,
+			DeletionGracePeriod:      config.OrphanedDeploymentsGrace,
+			ObjectDeletionProtection: config.ObjectDeletionProtection,
+		}); err != nil {
+			setupLog.Error(err, "unable to add the orphaned deployments janitor")
+			os.Exit(1)
+		}
+	}
+
+	if config.OrphanedSecretsInterval > 0 {
+		if err = mgr.Add(&connectionsecret.OrphanedSecretsJanitor{
+			Client:        mgr.GetClient(),
+			Log:           logger.Named("janitors").Named("OrphanedConnectionSecrets").Sugar(),
+			AtlasProvider: atlasProvider,
+			Interval:      config.OrphanedSecretsInterval,
+		}); err != nil {
+			setupLog.Error(err, "unable to add the orphaned connection secrets janitor")
+			os.Exit(1)
+		}
+	}
+
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("health", healthz.Ping); err != nil {
@@ -232,24 +549,96 @@ func main() {
 	}
 }
 
+// egressIPProvider reads the egress IPs from the configured URL, or from the nodes of the cluster. The nodes are read
+// without the cache, as they are only listed when the projects are reconciled
+func egressIPProvider(mgr ctrl.Manager, config Config) atlasproject.EgressIPProvider {
+	if config.EgressIPProviderURL != "" {
+		return &atlasproject.URLEgressIPProvider{URL: config.EgressIPProviderURL, HTTPClient: &http.Client{Timeout: 30 * time.Second}}
+	}
+
+	return &atlasproject.NodeEgressIPProvider{Reader: mgr.GetAPIReader()}
+}
+
+// secretStores returns the external secret stores the passwords of the database users can be read from, and their
+// connection credentials written to
+func secretStores(config Config) secretstore.Registry {
+	stores := secretstore.Registry{}
+	if config.VaultAddress != "" {
+		stores[secretstore.VaultProviderName] = &secretstore.VaultProvider{
+			Address:    config.VaultAddress,
+			Mount:      config.VaultKVMount,
+			PathPrefix: config.VaultPathPrefix,
+			TokenFile:  config.VaultTokenFile,
+			HTTPClient: &http.Client{Timeout: 30 * time.Second},
+		}
+	}
+
+	return stores
+}
+
+func awsPeeringAccepter(config Config) atlasproject.AWSPeeringAccepter {
+	if !config.AWSPeeringAutoAccept {
+		return nil
+	}
+
+	accepter, err := atlasproject.NewEC2PeeringAccepter()
+	if err != nil {
+		setupLog.Error(err, "unable to set up the auto-accept of the AWS peering connections")
+		os.Exit(1)
+	}
+
+	return accepter
+}
+
 type Config struct {
-	AtlasDomain                 string
-	EnableLeaderElection        bool
-	MetricsAddr                 string
-	Namespace                   string
-	WatchedNamespaces           map[string]bool
-	ProbeAddr                   string
-	GlobalAPISecret             client.ObjectKey
-	LogLevel                    string
-	LogEncoder                  string
-	ObjectDeletionProtection    bool
-	SubObjectDeletionProtection bool
-	FeatureFlags                *featureflags.FeatureFlags
+	AtlasDomain                  string
+	EnableLeaderElection         bool
+	MetricsAddr                  string
+	Namespace                    string
+	WatchedNamespaces            map[string]bool
+	AllowedNamespaces            map[string]bool
+	DeniedNamespaces             map[string]bool
+	ObjectLabelSelector          string
+	ProbeAddr                    string
+	GlobalAPISecret              client.ObjectKey
+	LogLevel                     string
+	LogEncoder                   string
+	ObjectDeletionProtection     bool
+	SubObjectDeletionProtection  bool
+	ServerlessUsageStatsInterval time.Duration
+	ScalingAdvisorInterval       time.Duration
+	CapabilitiesCacheTTL         time.Duration
+	DeletionConcurrency          int
+	APIKeyRotationInterval       time.Duration
+	APIKeyRotationParentSecret   string
+	OrphanedDeploymentsInterval  time.Duration
+	OrphanedDeploymentsGrace     time.Duration
+	OrphanedSecretsInterval      time.Duration
+	EgressIPProviderURL          string
+	AWSPeeringAutoAccept         bool
+	Environment                  string
+	GeneratedNamePrefix          string
+	GeneratedNameSuffix          string
+	ConnectionSecretMetadata     connectionsecret.Metadata
+	DatabaseUserPropagatedLabels []string
+	AccessRequestGrantableRoles  []string
+	MaxRetries                   int
+	FeatureFlags                 *featureflags.FeatureFlags
+	VaultAddress                 string
+	VaultKVMount                 string
+	VaultTokenFile               string
+	VaultPathPrefix              string
+	AtlasAPIVersions             atlas.APIVersions
+	SelfCheck                    bool
+	FailureNotificationURL       string
+	AllowExternalTokenURL        bool
+	FailureNotificationFormat    string
 }
 
 // ParseConfiguration fills the 'OperatorConfig' from the flags passed to the program
 func parseConfiguration() Config {
-	var globalAPISecretName string
+	var globalAPISecretName, allowedNamespaces, deniedNamespaces, secretLabels, secretAnnotations, propagatedLabels, grantableRoles, apiVersions, secretCAFile, secretDriverOptions string
+	var secretTLS bool
 	config := Config{}
 	flag.StringVar(&config.AtlasDomain, "atlas-domain", "https://cloud.mongodb.com/", "the Atlas URL domain name (with slash in the end).")
 	flag.StringVar(&config.MetricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
@@ -265,6 +654,91 @@ func parseConfiguration() Config {
 		"when a Custom Resource is deleted")
 	flag.BoolVar(&config.SubObjectDeletionProtection, subobjectDeletionProtectionFlag, subobjectDeletionProtectionDefault, "Defines if the operator overwrites "+
 		"(and consequently delete) subresources that were not previously created by the operator")
+	flag.StringVar(&config.ObjectLabelSelector, "object-label-selector", "", "Label selector restricting the Atlas Custom Resources "+
+		"reconciled by this Operator instance (e.g. 'atlas-shard=a'). Allows sharding the load between several Operator instances")
+	flag.StringVar(&allowedNamespaces, "allowed-namespaces", "", "Comma-separated list of namespaces which Atlas Custom Resources are reconciled. "+
+		"Applied on top of the WATCH_NAMESPACE configuration. Defaults to all namespaces")
+	flag.StringVar(&deniedNamespaces, "denied-namespaces", "", "Comma-separated list of namespaces which Atlas Custom Resources are never reconciled")
+	flag.DurationVar(&config.ServerlessUsageStatsInterval, "serverless-usage-stats-interval", 0, "How often the usage of serverless instances "+
+		"(storage, processing units, connections) is collected into the AtlasDeployment status. The collection is disabled when not set")
+	flag.DurationVar(&config.ScalingAdvisorInterval, "scaling-advisor-interval", 0, "How often the sizing of deployments is evaluated "+
+		"from their Atlas metrics (cpu, disk, connections) and reported in the DeploymentRightSized condition. The evaluation is disabled when not set")
+	flag.DurationVar(&config.CapabilitiesCacheTTL, "capabilities-cache-ttl", time.Hour, "How long the instance sizes and regions available "+
+		"to a project are cached. The deployments requesting unavailable ones are reported in the CapabilitiesSupported condition "+
+		"instead of being sent to Atlas. The validation is disabled when set to 0")
+	flag.IntVar(&config.DeletionConcurrency, "deletion-concurrency", 0, "How many Atlas resources are deleted at a time. "+
+		"The deletions are then paused while Atlas applies its rate limits, and the database users are deleted before the deployments, "+
+		"and the deployments before their project. The deletions are not throttled when not set")
+	flag.DurationVar(&config.APIKeyRotationInterval, "api-key-rotation-interval", 0, "How often the Atlas API keys of the credentials secrets "+
+		"annotated with mongodb.com/atlas-api-key-rotation=true are rotated. The rotation is disabled when not set")
+	flag.StringVar(&config.APIKeyRotationParentSecret, "api-key-rotation-parent-secret", "", "The name of the Secret in the Operator namespace "+
+		"holding the organization API key used to rotate the API keys. The key must be allowed to manage the organization API keys")
+	flag.DurationVar(&config.OrphanedDeploymentsInterval, "orphaned-deployments-check-interval", 0, "How often the Atlas deployments "+
+		"created by the Operator are checked for their AtlasDeployment, to report those left behind with an event on their AtlasProject. "+
+		"The check is disabled when not set")
+	flag.DurationVar(&config.OrphanedDeploymentsGrace, "orphaned-deployments-deletion-grace-period", 0, "How old the deployments left "+
+		"behind in Atlas must be to be deleted, unless the object deletion protection is enabled. They are only reported when not set, "+
+		"or when the Kubernetes cluster that created them can't be confirmed to be this one, e.g. with namespaced roles")
+	flag.DurationVar(&config.OrphanedSecretsInterval, "orphaned-connection-secrets-check-interval", 0, "How often the connection "+
+		"Secrets are checked for their project, deployment and database user, to delete those left behind. The check is disabled when not set")
+	flag.StringVar(&config.EgressIPProviderURL, "egress-ip-provider-url", "", "The URL returning the egress IPs of the cluster "+
+		"added to the IP Access List of the projects enabling spec.egressIpDiscovery, separated by commas, spaces or new lines. "+
+		"The external IPs of the nodes are used when not set")
+	flag.BoolVar(&config.AWSPeeringAutoAccept, "aws-peering-auto-accept", false, "Allows the AWS network peers enabling "+
+		"awsAutoAccept to be accepted, and their route tables updated, with the AWS credentials of the Operator (e.g. IAM Roles for Service Accounts)")
+	flag.StringVar(&config.Environment, "environment-name", "", "The name of the environment the Operator runs in (e.g. production), "+
+		"available as .Environment to the name templates of the private endpoints and database users")
+	flag.StringVar(&config.GeneratedNamePrefix, "generated-name-prefix", "", "The prefix added to the names expanded from "+
+		"a name template (e.g. the CI run ID), to avoid collisions between operators sharing an Atlas project")
+	flag.StringVar(&config.GeneratedNameSuffix, "generated-name-suffix", "", "The suffix added to the names expanded from "+
+		"a name template, to avoid collisions between operators sharing an Atlas project")
+	flag.IntVar(&config.MaxRetries, "max-retries", 0, "The number of consecutive failed reconciliations with the same reason "+
+		"after which a resource is marked as Degraded and no longer retried until its spec changes or the reapply annotation is set. "+
+		"The resources are retried forever when not set")
+	flag.StringVar(&grantableRoles, "access-request-grantable-roles", "read,readWrite", "Comma-separated list of the role names "+
+		"the AtlasAccessRequests may grant to the database users, the requests for other roles are rejected")
+	flag.StringVar(&propagatedLabels, "database-user-propagated-labels", "", "Comma-separated list of the label keys copied "+
+		"from the AtlasDatabaseUser resources to the labels of the database users in Atlas (e.g. 'team,cost-center')")
+	flag.StringVar(&secretLabels, "connection-secret-labels", "", "Comma-separated list of key=value labels added to all the "+
+		"connection Secrets generated by the Operator (e.g. 'vault-sync=true,team=platform')")
+	flag.StringVar(&secretAnnotations, "connection-secret-annotations", "", "Comma-separated list of key=value annotations added to all the "+
+		"connection Secrets generated by the Operator")
+	flag.BoolVar(&config.ConnectionSecretMetadata.RegionalKeys, "connection-secret-regional-keys", false, "Adds the private endpoint "+
+		"connection strings of every region of multi-region deployments under their own keys in the connection Secrets "+
+		"(e.g. connectionStringPrivateSrv.aws.us-east-1)")
+	flag.BoolVar(&config.ConnectionSecretMetadata.ZoneKeys, "connection-secret-zone-keys", false, "Adds the private endpoint "+
+		"connection strings of every zone of the global clusters under their own keys in the connection Secrets "+
+		"(e.g. connectionStringPrivateSrv.zone.europe)")
+	flag.StringVar(&secretCAFile, "connection-secret-ca-file", "", "The file holding the CA chain of the Atlas certificates "+
+		"added to all the connection Secrets under the ca.crt key, for the drivers and sidecars which don't trust the system CAs")
+	flag.BoolVar(&secretTLS, "connection-secret-tls", false, "Adds tls=true to all the connection strings of the connection Secrets, "+
+		"for the legacy drivers which don't enable TLS by default")
+	flag.StringVar(&secretDriverOptions, "connection-secret-driver-options", "", "Comma-separated list of key=value driver options "+
+		"added to all the connection strings of the connection Secrets unless Atlas sets them (e.g. 'appName=orders,retryWrites=true')")
+	flag.StringVar(&config.VaultAddress, "vault-address", "", "The address of the HashiCorp Vault server the passwords of "+
+		"the database users referencing the vault provider in their passwordStoreRef are read from, and their connection credentials "+
+		"are written to when they reference it in their connectionSecretStoreRef (e.g. https://vault.example.com:8200). "+
+		"The provider is disabled when not set")
+	flag.StringVar(&config.VaultKVMount, "vault-kv-mount", "secret", "The path the KV version 2 secrets engine holding the "+
+		"passwords and the connection credentials is mounted at in Vault")
+	flag.StringVar(&config.VaultTokenFile, "vault-token-file", "", "The file holding the Vault token, e.g. written by the "+
+		"Vault Agent. It's read on each request so that the token can be renewed")
+	flag.StringVar(&config.VaultPathPrefix, "vault-path-prefix", "", "The path prefix the paths referenced by the database "+
+		"users are confined under, followed by their namespace, e.g. 'kube01' for 'kube01/<namespace>/<path>'")
+	flag.StringVar(&apiVersions, "atlas-api-versions", "", "Comma-separated list of domain=version pins of the Atlas Admin API "+
+		"versions (e.g. 'clusters=2023-02-01,flexClusters=preview'). The domain is the path segment following the project or organization ID "+
+		"in the API paths, or the first one otherwise. The endpoints of the domains which aren't pinned use the versions of the Atlas SDK. "+
+		"The responses are still decoded with the models of the "+atlas.SDKAPIVersion+" version of the Atlas SDK: only pin versions whose payloads "+
+		"are compatible with them")
+	flag.StringVar(&config.FailureNotificationURL, "failure-notification-url", "", "The URL of the webhook notified when an Atlas "+
+		"Custom Resource fails, and once it recovers. No notification is sent when empty")
+	flag.StringVar(&config.FailureNotificationFormat, "failure-notification-format", notifier.FormatAlertmanager, "The format of the "+
+		"failure notifications. Available values: alertmanager (the payload of the Alertmanager webhook receivers) | event")
+	flag.BoolVar(&config.AllowExternalTokenURL, "allow-external-token-url", false, "Allows the service accounts of the credentials "+
+		"secrets to request their access tokens from a tokenUrl outside of the Atlas domain. The client secrets are sent to that URL")
+	flag.BoolVar(&config.SelfCheck, "self-check", false, "Validates the installation instead of running the controllers: the Custom Resource "+
+		"Definitions, the credentials of the global secret, the RBAC and the access to the Atlas API. The readiness report is written to the "+
+		"standard output and the exit code is not zero when the installation isn't ready")
 	appVersion := flag.Bool("v", false, "prints application version")
 	flag.Parse()
 
@@ -288,12 +762,117 @@ func parseConfiguration() Config {
 		config.Namespace = watchedNamespace
 	}
 
+	config.AllowedNamespaces = parseNamespaceList(allowedNamespaces)
+	config.DeniedNamespaces = parseNamespaceList(deniedNamespaces)
+	config.DatabaseUserPropagatedLabels = parseLabelKeys(propagatedLabels)
+	config.AccessRequestGrantableRoles = parseLabelKeys(grantableRoles)
+	if _, err := labels.Parse(config.ObjectLabelSelector); err != nil {
+		log.Fatalf("Invalid object label selector %q: %s", config.ObjectLabelSelector, err)
+	}
+	if config.VaultAddress != "" && config.VaultTokenFile == "" {
+		log.Fatal("The Vault token file must be set along with the Vault address")
+	}
+
+	var err error
+	if config.ConnectionSecretMetadata.Labels, err = labels.ConvertSelectorToLabelsMap(secretLabels); err != nil {
+		log.Fatalf("Invalid connection secret labels %q: %s", secretLabels, err)
+	}
+	if config.ConnectionSecretMetadata.Annotations, err = parseAnnotations(secretAnnotations); err != nil {
+		log.Fatalf("Invalid connection secret annotations %q: %s", secretAnnotations, err)
+	}
+	if config.ConnectionSecretMetadata.DriverOptions, err = parseDriverOptions(secretDriverOptions); err != nil {
+		log.Fatalf("Invalid connection secret driver options %q: %s", secretDriverOptions, err)
+	}
+	if secretTLS {
+		config.ConnectionSecretMetadata.DriverOptions.Set("tls", "true")
+	}
+	if secretCAFile != "" {
+		if config.ConnectionSecretMetadata.CACertificate, err = os.ReadFile(secretCAFile); err != nil {
+			log.Fatalf("Failed to read the connection secret CA file: %s", err)
+		}
+	}
+
+	if config.AtlasAPIVersions, err = atlas.ParseAPIVersions(apiVersions); err != nil {
+		log.Fatalf("Invalid Atlas API versions %q: %s", apiVersions, err)
+	}
+
 	configureDeletionProtection(&config)
 
 	config.FeatureFlags = featureflags.NewFeatureFlags(os.Environ)
 	return config
 }
 
+// parseLabelKeys converts a comma-separated list of label keys into a sorted list, ignoring empty entries
+func parseLabelKeys(value string) []string {
+	var keys []string
+	for _, key := range strings.Split(value, ",") {
+		key = strings.TrimSpace(key)
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// parseNamespaceList converts a comma-separated list of namespaces into a set, ignoring empty entries
+func parseNamespaceList(value string) map[string]bool {
+	namespaces := map[string]bool{}
+	for _, namespace := range strings.Split(value, ",") {
+		namespace = strings.TrimSpace(namespace)
+		if namespace != "" {
+			namespaces[namespace] = true
+		}
+	}
+
+	return namespaces
+}
+
+// parseAnnotations converts a comma-separated list of key=value annotations into a map, ignoring empty entries
+func parseAnnotations(value string) (map[string]string, error) {
+	annotations := map[string]string{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		key, annotation, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("expected key=value, got %q", entry)
+		}
+		key = strings.TrimSpace(key)
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid annotation key %q: %s", key, strings.Join(errs, "; "))
+		}
+		annotations[key] = strings.TrimSpace(annotation)
+	}
+
+	return annotations, nil
+}
+
+// parseDriverOptions converts a comma-separated list of key=value driver options into query values, ignoring empty
+// entries
+func parseDriverOptions(value string) (url.Values, error) {
+	options := url.Values{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		key, option, ok := strings.Cut(entry, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("expected key=value, got %q", entry)
+		}
+		options.Set(key, strings.TrimSpace(option))
+	}
+
+	return options, nil
+}
+
 func operatorGlobalKeySecretOrDefault(secretNameOverride string) client.ObjectKey {
 	secretName := secretNameOverride
 	if secretName == "" {
@@ -315,6 +894,196 @@ func operatorGlobalKeySecretOrDefault(secretNameOverride string) client.ObjectKe
 	return client.ObjectKey{Namespace: operatorNamespace, Name: secretName}
 }
 
+// runWaitForAtlas blocks until the Atlas resource passed in the arguments is ready and its connection secret exists.
+// This mode is meant to be used in initContainers of applications depending on the Atlas resources.
+func runWaitForAtlas(args []string) int {
+	target := waitfor.Target{}
+	var interval, timeout time.Duration
+	flags := flag.NewFlagSet(waitForAtlasCommand, flag.ExitOnError)
+	flags.StringVar(&target.Kind, "kind", waitfor.KindAtlasDeployment, "Kind of the resource to wait for. Available values: AtlasDeployment | AtlasDatabaseUser")
+	flags.StringVar(&target.Name, "name", "", "Name of the resource to wait for")
+	flags.StringVar(&target.Namespace, "namespace", os.Getenv("POD_NAMESPACE"), "Namespace of the resource to wait for. Defaults to the POD_NAMESPACE environment variable")
+	flags.StringVar(&target.SecretName, "secret", "", "Name of the connection secret to wait for. Defaults to any connection secret of the resource")
+	flags.DurationVar(&interval, "interval", time.Second*10, "Interval between two checks")
+	flags.DurationVar(&timeout, "timeout", time.Minute*30, "Maximum time to wait for")
+	_ = flags.Parse(args)
+
+	logger, err := initCustomZapLogger("info", "console")
+	if err != nil {
+		fmt.Printf("error instantiating logger: %v\r\n", err)
+		return 1
+	}
+	log := logger.Sugar()
+
+	if err = target.Validate(); err != nil {
+		log.Errorf("invalid arguments: %s", err)
+		return 1
+	}
+
+	k8sClient, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
+	if err != nil {
+		log.Errorf("unable to create kubernetes client: %s", err)
+		return 1
+	}
+
+	log.Infof("waiting for %s to be ready", target)
+	if err = waitfor.Wait(ctrl.SetupSignalHandler(), k8sClient, target, interval, timeout, log); err != nil {
+		log.Errorf("%s didn't become ready: %s", target, err)
+		return 1
+	}
+	log.Infof("%s is ready", target)
+
+	return 0
+}
+
+// runConvertCommunity translates the MongoDB Community Operator manifests passed in the arguments, or read from the
+// standard input, into Atlas Custom Resources written to the output. Nothing is applied to the cluster.
+func runConvertCommunity(args []string, input io.Reader, output io.Writer) int {
+	options := communityconvert.Options{}
+	var file string
+	flags := flag.NewFlagSet(convertCommunityCommand, flag.ContinueOnError)
+	flags.StringVar(&file, "file", "-", "Manifests holding the MongoDBCommunity resources and the password secrets of their users. Defaults to the standard input")
+	flags.StringVar(&options.ProjectName, "project", "", "Name of the AtlasProject the converted resources belong to")
+	flags.StringVar(&options.ProjectNamespace, "project-namespace", "", "Namespace of the AtlasProject. Defaults to the namespace of the converted resources")
+	flags.StringVar(&options.Namespace, "namespace", "", "Namespace of the converted resources. Defaults to the namespace of the MongoDBCommunity resources")
+	flags.StringVar(&options.ProviderName, "provider", "AWS", "Cloud provider of the converted deployments. Available values: AWS | GCP | AZURE")
+	flags.StringVar(&options.RegionName, "region", "US_EAST_1", "Atlas region of the converted deployments")
+	flags.StringVar(&options.InstanceSize, "instance-size", "M10", "Instance size of the converted deployments")
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	if err := options.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "invalid arguments: %s\n", err)
+		return 1
+	}
+
+	if file != "-" {
+		f, err := os.Open(file)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "unable to read the manifests: %s\n", err)
+			return 1
+		}
+		defer f.Close()
+		input = f
+	}
+
+	result, err := communityconvert.Convert(input, options)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to convert the manifests: %s\n", err)
+		return 1
+	}
+
+	if err = communityconvert.Write(output, result); err != nil {
+		fmt.Fprintf(os.Stderr, "unable to write the converted resources: %s\n", err)
+		return 1
+	}
+
+	return 0
+}
+
+// runAuditExport writes a point-in-time report of the Atlas resources managed by the Operator to the output, a file
+// (e.g. on a mounted volume) or a ConfigMap. This mode is meant to be run by a Job or a CronJob for access reviews.
+func runAuditExport(args []string, output io.Writer) int {
+	options := auditexport.Options{}
+	var file, configMapName, configMapNamespace string
+	flags := flag.NewFlagSet(auditExportCommand, flag.ContinueOnError)
+	flags.StringVar(&options.Namespace, "namespace", "", "Namespace of the reported resources. Defaults to all namespaces")
+	flags.StringVar(&options.Format, "format", auditexport.FormatJSON, "Format of the report. Available values: json | csv")
+	flags.StringVar(&file, "file", "-", "File the report is written to. Defaults to the standard output")
+	flags.StringVar(&configMapName, "configmap", "", "Name of the ConfigMap the report is written to instead of the file")
+	flags.StringVar(&configMapNamespace, "configmap-namespace", os.Getenv("POD_NAMESPACE"), "Namespace of the ConfigMap. Defaults to the POD_NAMESPACE environment variable")
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	if err := options.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "invalid arguments: %s\n", err)
+		return 1
+	}
+
+	if configMapName != "" && configMapNamespace == "" {
+		fmt.Fprintln(os.Stderr, "invalid arguments: the namespace of the ConfigMap must be provided")
+		return 1
+	}
+
+	k8sClient, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to create kubernetes client: %s\n", err)
+		return 1
+	}
+
+	ctx := ctrl.SetupSignalHandler()
+	report, err := auditexport.Collect(ctx, k8sClient, options, time.Now())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to collect the report: %s\n", err)
+		return 1
+	}
+
+	if configMapName != "" {
+		if err = auditexport.Store(ctx, k8sClient, client.ObjectKey{Namespace: configMapNamespace, Name: configMapName}, report, options.Format); err != nil {
+			fmt.Fprintf(os.Stderr, "unable to write the report to the ConfigMap: %s\n", err)
+			return 1
+		}
+
+		return 0
+	}
+
+	if file != "-" {
+		f, err := os.Create(file)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "unable to create the report file: %s\n", err)
+			return 1
+		}
+		defer f.Close()
+		output = f
+	}
+
+	if err = auditexport.Write(output, report, options.Format); err != nil {
+		fmt.Fprintf(os.Stderr, "unable to write the report: %s\n", err)
+		return 1
+	}
+
+	return 0
+}
+
+// runSelfCheck validates that the installation described by the configuration can run the Operator and writes the
+// readiness report to the output. This mode is meant to be run by a Job before upgrading the Operator.
+func runSelfCheck(config Config, output io.Writer) int {
+	k8sClient, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to create kubernetes client: %s\n", err)
+		return 1
+	}
+
+	var namespaces []string
+	for namespace := range config.WatchedNamespaces {
+		if namespace != "" {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	sort.Strings(namespaces)
+
+	report := selfcheck.Run(ctrl.SetupSignalHandler(), k8sClient, scheme, selfcheck.Options{
+		AtlasDomain:           config.AtlasDomain,
+		GlobalAPISecret:       config.GlobalAPISecret,
+		Namespaces:            namespaces,
+		AllowExternalTokenURL: config.AllowExternalTokenURL,
+		HTTPClient:            &http.Client{Transport: http.DefaultTransport, Timeout: time.Minute},
+	}, time.Now())
+
+	if err = selfcheck.Write(output, report); err != nil {
+		fmt.Fprintf(os.Stderr, "unable to write the report: %s\n", err)
+		return 1
+	}
+
+	if !report.Ready {
+		return 1
+	}
+
+	return 0
+}
+
 func initCustomZapLogger(level, encoding string) (*zap.Logger, error) {
 	lv := zap.AtomicLevel{}
 	err := lv.UnmarshalText([]byte(strings.ToLower(level)))
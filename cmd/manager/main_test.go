@@ -2,6 +2,7 @@ package main
 
 import (
 	"flag"
+	"net/url"
 	"os"
 	"testing"
 
@@ -180,3 +181,70 @@ func Test_configureDeletionProtection(t *testing.T) {
 		)
 	})
 }
+
+func Test_parseNamespaceList(t *testing.T) {
+	t.Run("should return an empty set for an empty value", func(t *testing.T) {
+		assert.Empty(t, parseNamespaceList(""))
+	})
+
+	t.Run("should trim namespaces and skip empty entries", func(t *testing.T) {
+		assert.Equal(
+			t,
+			map[string]bool{"ns1": true, "ns2": true},
+			parseNamespaceList(" ns1, ,ns2,"),
+		)
+	})
+}
+
+func Test_parseLabelKeys(t *testing.T) {
+	t.Run("should return no keys for an empty value", func(t *testing.T) {
+		assert.Empty(t, parseLabelKeys(""))
+	})
+
+	t.Run("should trim and sort the keys and skip empty entries", func(t *testing.T) {
+		assert.Equal(t, []string{"cost-center", "team"}, parseLabelKeys(" team, ,cost-center,"))
+	})
+}
+
+func Test_parseAnnotations(t *testing.T) {
+	t.Run("should return an empty map for an empty value", func(t *testing.T) {
+		annotations, err := parseAnnotations("")
+		assert.NoError(t, err)
+		assert.Empty(t, annotations)
+	})
+
+	t.Run("should parse the key value pairs", func(t *testing.T) {
+		annotations, err := parseAnnotations(" vault.example.com/sync=true, ,owner = team-a,")
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"vault.example.com/sync": "true", "owner": "team-a"}, annotations)
+	})
+
+	t.Run("should reject an entry without value", func(t *testing.T) {
+		_, err := parseAnnotations("owner")
+		assert.ErrorContains(t, err, `expected key=value, got "owner"`)
+	})
+
+	t.Run("should reject an invalid key", func(t *testing.T) {
+		_, err := parseAnnotations("not valid=true")
+		assert.ErrorContains(t, err, `invalid annotation key "not valid"`)
+	})
+}
+
+func Test_parseDriverOptions(t *testing.T) {
+	t.Run("should return no options for an empty value", func(t *testing.T) {
+		options, err := parseDriverOptions("")
+		assert.NoError(t, err)
+		assert.Empty(t, options)
+	})
+
+	t.Run("should parse the key value pairs", func(t *testing.T) {
+		options, err := parseDriverOptions(" appName=orders, ,retryWrites = true,")
+		assert.NoError(t, err)
+		assert.Equal(t, url.Values{"appName": {"orders"}, "retryWrites": {"true"}}, options)
+	})
+
+	t.Run("should reject an entry without value", func(t *testing.T) {
+		_, err := parseDriverOptions("appName")
+		assert.ErrorContains(t, err, `expected key=value, got "appName"`)
+	})
+}
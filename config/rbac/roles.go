@@ -0,0 +1,17 @@
+// Package rbac embeds the roles of the Operator, generated from the kubebuilder markers of the controllers by
+// "make manifests", so that the access they grant can be checked at runtime
+package rbac
+
+import (
+	_ "embed"
+)
+
+// ClusterwideRole is the ClusterRole of the Operator watching all the namespaces
+//
+//go:embed clusterwide/role.yaml
+var ClusterwideRole []byte
+
+// NamespacedRole is the Role of the Operator watching a set of namespaces
+//
+//go:embed namespaced/role.yaml
+var NamespacedRole []byte
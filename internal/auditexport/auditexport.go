@@ -0,0 +1,322 @@
+// Package auditexport implements the "audit-export" mode of the Operator binary. It builds a point-in-time report of
+// the Atlas projects, deployments, database users, custom roles, IP access entries and network peerings managed by the
+// Operator from their Custom Resources and status, to support periodic access reviews.
+package auditexport
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/timeutil"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+)
+
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+
+	// reportKeyPrefix is the prefix of the key holding the report in the ConfigMap, followed by the format
+	reportKeyPrefix = "report."
+)
+
+// Options configures the content and the format of the report
+type Options struct {
+	// Namespace restricts the report to the resources of a namespace. All namespaces are reported when empty
+	Namespace string
+	Format    string
+}
+
+// Validate checks that the format is supported
+func (o Options) Validate() error {
+	if o.Format != FormatJSON && o.Format != FormatCSV {
+		return fmt.Errorf("unsupported format %q, must be one of %s, %s", o.Format, FormatJSON, FormatCSV)
+	}
+
+	return nil
+}
+
+// Report is the point-in-time inventory of the Atlas resources managed by the Operator
+type Report struct {
+	GeneratedAt string          `json:"generatedAt"`
+	Projects    []ProjectReport `json:"projects"`
+}
+
+// ProjectReport lists the access related resources of a project. The ID is empty when the project isn't created in
+// Atlas or when the resources reference a project which isn't reported
+type ProjectReport struct {
+	Namespace     string          `json:"namespace"`
+	Name          string          `json:"name"`
+	ID            string          `json:"id,omitempty"`
+	IPAccessList  []IPAccessEntry `json:"ipAccessList,omitempty"`
+	NetworkPeers  []NetworkPeer   `json:"networkPeers,omitempty"`
+	CustomRoles   []CustomRole    `json:"customRoles,omitempty"`
+	Deployments   []Deployment    `json:"deployments,omitempty"`
+	DatabaseUsers []DatabaseUser  `json:"databaseUsers,omitempty"`
+}
+
+type IPAccessEntry struct {
+	Entry           string `json:"entry"`
+	Comment         string `json:"comment,omitempty"`
+	DeleteAfterDate string `json:"deleteAfterDate,omitempty"`
+}
+
+type NetworkPeer struct {
+	ID           string `json:"id"`
+	ProviderName string `json:"providerName"`
+	Region       string `json:"region,omitempty"`
+	Status       string `json:"status,omitempty"`
+}
+
+type CustomRole struct {
+	Name           string   `json:"name"`
+	InheritedRoles []string `json:"inheritedRoles,omitempty"`
+	Actions        []string `json:"actions,omitempty"`
+}
+
+type Deployment struct {
+	Namespace      string `json:"namespace"`
+	Name           string `json:"name"`
+	AtlasName      string `json:"atlasName"`
+	StateName      string `json:"stateName,omitempty"`
+	MongoDBVersion string `json:"mongoDBVersion,omitempty"`
+}
+
+type DatabaseUser struct {
+	Namespace       string   `json:"namespace"`
+	Name            string   `json:"name"`
+	Username        string   `json:"username"`
+	DatabaseName    string   `json:"databaseName"`
+	Roles           []string `json:"roles"`
+	Scopes          []string `json:"scopes,omitempty"`
+	DeleteAfterDate string   `json:"deleteAfterDate,omitempty"`
+}
+
+// Collect builds the report from the AtlasProject, AtlasDeployment and AtlasDatabaseUser resources
+func Collect(ctx context.Context, k8sClient client.Client, options Options, now time.Time) (*Report, error) {
+	listOptions := []client.ListOption{client.InNamespace(options.Namespace)}
+
+	projects := &mdbv1.AtlasProjectList{}
+	if err := k8sClient.List(ctx, projects, listOptions...); err != nil {
+		return nil, fmt.Errorf("failed to list the projects: %w", err)
+	}
+
+	deployments := &mdbv1.AtlasDeploymentList{}
+	if err := k8sClient.List(ctx, deployments, listOptions...); err != nil {
+		return nil, fmt.Errorf("failed to list the deployments: %w", err)
+	}
+
+	users := &mdbv1.AtlasDatabaseUserList{}
+	if err := k8sClient.List(ctx, users, listOptions...); err != nil {
+		return nil, fmt.Errorf("failed to list the database users: %w", err)
+	}
+
+	reports := map[client.ObjectKey]*ProjectReport{}
+	projectReport := func(key client.ObjectKey) *ProjectReport {
+		if _, ok := reports[key]; !ok {
+			reports[key] = &ProjectReport{Namespace: key.Namespace, Name: key.Name}
+		}
+
+		return reports[key]
+	}
+
+	for i := range projects.Items {
+		project := &projects.Items[i]
+		report := projectReport(client.ObjectKeyFromObject(project))
+		report.ID = project.ID()
+		report.IPAccessList = ipAccessEntries(project)
+		report.NetworkPeers = networkPeers(project)
+		report.CustomRoles = customRoles(project)
+	}
+
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		report := projectReport(deployment.AtlasProjectObjectKey())
+		report.Deployments = append(report.Deployments, Deployment{
+			Namespace:      deployment.Namespace,
+			Name:           deployment.Name,
+			AtlasName:      deployment.GetDeploymentName(),
+			StateName:      deployment.Status.StateName,
+			MongoDBVersion: deployment.Status.MongoDBVersion,
+		})
+	}
+
+	for i := range users.Items {
+		user := &users.Items[i]
+		report := projectReport(user.AtlasProjectObjectKey())
+		report.DatabaseUsers = append(report.DatabaseUsers, databaseUser(user))
+	}
+
+	result := &Report{GeneratedAt: timeutil.FormatISO8601(now), Projects: make([]ProjectReport, 0, len(reports))}
+	for _, report := range reports {
+		result.Projects = append(result.Projects, *report)
+	}
+	sort.Slice(result.Projects, func(i, j int) bool {
+		if result.Projects[i].Namespace != result.Projects[j].Namespace {
+			return result.Projects[i].Namespace < result.Projects[j].Namespace
+		}
+
+		return result.Projects[i].Name < result.Projects[j].Name
+	})
+
+	return result, nil
+}
+
+// Write writes the report to the output in the format. The CSV format has one row per reported resource
+func Write(output io.Writer, report *Report, format string) error {
+	if format == FormatJSON {
+		encoder := json.NewEncoder(output)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(report)
+	}
+
+	writer := csv.NewWriter(output)
+	rows := [][]string{{"projectNamespace", "projectName", "projectId", "kind", "name", "details"}}
+	for _, project := range report.Projects {
+		row := func(kind, name string, details ...string) []string {
+			return []string{project.Namespace, project.Name, project.ID, kind, name, strings.Join(details, "; ")}
+		}
+
+		rows = append(rows, row("Project", project.Name))
+		for _, entry := range project.IPAccessList {
+			rows = append(rows, row("IPAccessEntry", entry.Entry, "comment="+entry.Comment, "deleteAfterDate="+entry.DeleteAfterDate))
+		}
+		for _, peer := range project.NetworkPeers {
+			rows = append(rows, row("NetworkPeer", peer.ID, "providerName="+peer.ProviderName, "region="+peer.Region, "status="+peer.Status))
+		}
+		for _, role := range project.CustomRoles {
+			rows = append(rows, row("CustomRole", role.Name, "inheritedRoles="+strings.Join(role.InheritedRoles, ","), "actions="+strings.Join(role.Actions, ",")))
+		}
+		for _, deployment := range project.Deployments {
+			rows = append(rows, row("Deployment", deployment.AtlasName, "resource="+deployment.Namespace+"/"+deployment.Name, "stateName="+deployment.StateName, "mongoDBVersion="+deployment.MongoDBVersion))
+		}
+		for _, user := range project.DatabaseUsers {
+			rows = append(
+				rows,
+				row(
+					"DatabaseUser",
+					user.Username,
+					"resource="+user.Namespace+"/"+user.Name,
+					"databaseName="+user.DatabaseName,
+					"roles="+strings.Join(user.Roles, ","),
+					"scopes="+strings.Join(user.Scopes, ","),
+					"deleteAfterDate="+user.DeleteAfterDate,
+				),
+			)
+		}
+	}
+
+	return writer.WriteAll(rows)
+}
+
+// Store writes the report in the format to the ConfigMap, which is created if it doesn't exist
+func Store(ctx context.Context, k8sClient client.Client, key client.ObjectKey, report *Report, format string) error {
+	content := &strings.Builder{}
+	if err := Write(content, report, format); err != nil {
+		return err
+	}
+
+	configMap := &corev1.ConfigMap{}
+	err := k8sClient.Get(ctx, key, configMap)
+	if apiErrors.IsNotFound(err) {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Data:       map[string]string{reportKeyPrefix + format: content.String()},
+		}
+
+		return k8sClient.Create(ctx, configMap)
+	}
+	if err != nil {
+		return err
+	}
+
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[reportKeyPrefix+format] = content.String()
+
+	return k8sClient.Update(ctx, configMap)
+}
+
+func ipAccessEntries(project *mdbv1.AtlasProject) []IPAccessEntry {
+	entries := make([]IPAccessEntry, 0, len(project.Spec.ProjectIPAccessList))
+	for _, ipAccess := range project.Spec.ProjectIPAccessList {
+		entry := ipAccess.IPAddress
+		switch {
+		case ipAccess.CIDRBlock != "":
+			entry = ipAccess.CIDRBlock
+		case ipAccess.AwsSecurityGroup != "":
+			entry = ipAccess.AwsSecurityGroup
+		}
+
+		entries = append(entries, IPAccessEntry{Entry: entry, Comment: ipAccess.Comment, DeleteAfterDate: ipAccess.DeleteAfterDate})
+	}
+
+	return entries
+}
+
+func networkPeers(project *mdbv1.AtlasProject) []NetworkPeer {
+	peers := make([]NetworkPeer, 0, len(project.Status.NetworkPeers))
+	for _, peer := range project.Status.NetworkPeers {
+		peerStatus := peer.StatusName
+		if peerStatus == "" {
+			peerStatus = peer.Status
+		}
+
+		peers = append(peers, NetworkPeer{ID: peer.ID, ProviderName: string(peer.ProviderName), Region: peer.Region, Status: peerStatus})
+	}
+
+	return peers
+}
+
+func customRoles(project *mdbv1.AtlasProject) []CustomRole {
+	roles := make([]CustomRole, 0, len(project.Spec.CustomRoles))
+	for _, customRole := range project.Spec.CustomRoles {
+		role := CustomRole{Name: customRole.Name}
+		for _, inherited := range customRole.InheritedRoles {
+			role.InheritedRoles = append(role.InheritedRoles, fmt.Sprintf("%s@%s", inherited.Name, inherited.Database))
+		}
+		for _, action := range customRole.Actions {
+			role.Actions = append(role.Actions, action.Name)
+		}
+		roles = append(roles, role)
+	}
+
+	return roles
+}
+
+func databaseUser(user *mdbv1.AtlasDatabaseUser) DatabaseUser {
+	report := DatabaseUser{
+		Namespace:       user.Namespace,
+		Name:            user.Name,
+		Username:        user.AtlasUsername(),
+		DatabaseName:    user.Spec.DatabaseName,
+		Roles:           make([]string, 0, len(user.Spec.Roles)),
+		DeleteAfterDate: user.Spec.DeleteAfterDate,
+	}
+
+	for _, role := range user.Spec.Roles {
+		name := fmt.Sprintf("%s@%s", role.RoleName, role.DatabaseName)
+		if role.CollectionName != "" {
+			name = fmt.Sprintf("%s.%s", name, role.CollectionName)
+		}
+		report.Roles = append(report.Roles, name)
+	}
+
+	for _, scope := range user.Spec.Scopes {
+		report.Scopes = append(report.Scopes, fmt.Sprintf("%s:%s", scope.Type, scope.Name))
+	}
+
+	return report
+}
@@ -0,0 +1,152 @@
+package auditexport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+func auditClient(t *testing.T, objects ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, mdbv1.AddToScheme(scheme))
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func auditObjects() []client.Object {
+	atlasProject := &mdbv1.AtlasProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "project", Namespace: "team"},
+		Spec: mdbv1.AtlasProjectSpec{
+			Name:                "Team Project",
+			ProjectIPAccessList: []project.IPAccessList{{CIDRBlock: "10.0.0.0/24", Comment: "office"}},
+			CustomRoles: []mdbv1.CustomRole{
+				{
+					Name:           "reader",
+					InheritedRoles: []mdbv1.Role{{Name: "read", Database: "sales"}},
+					Actions:        []mdbv1.Action{{Name: "FIND"}},
+				},
+			},
+		},
+		Status: status.AtlasProjectStatus{
+			ID:           "project-id",
+			NetworkPeers: []status.AtlasNetworkPeer{{ID: "peer-id", ProviderName: "AWS", Region: "US_EAST_1", StatusName: "AVAILABLE"}},
+		},
+	}
+
+	deployment := mdbv1.DefaultAwsAdvancedDeployment("team", "project")
+	deployment.Status.StateName = "IDLE"
+
+	user := mdbv1.DefaultDBUser("team", "app", "project").WithRole("readWrite", "sales", "orders")
+	user.Name = "app-user"
+
+	return []client.Object{atlasProject, deployment, user}
+}
+
+func TestCollect(t *testing.T) {
+	t.Run("should report the resources of the projects", func(t *testing.T) {
+		report, err := Collect(context.Background(), auditClient(t, auditObjects()...), Options{Format: FormatJSON}, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+		require.NoError(t, err)
+
+		assert.Equal(t, "2024-01-02T03:04:05Z", report.GeneratedAt)
+		require.Len(t, report.Projects, 1)
+		projectReport := report.Projects[0]
+		assert.Equal(t, "project-id", projectReport.ID)
+		assert.Equal(t, []IPAccessEntry{{Entry: "10.0.0.0/24", Comment: "office"}}, projectReport.IPAccessList)
+		assert.Equal(t, []NetworkPeer{{ID: "peer-id", ProviderName: "AWS", Region: "US_EAST_1", Status: "AVAILABLE"}}, projectReport.NetworkPeers)
+		assert.Equal(t, []CustomRole{{Name: "reader", InheritedRoles: []string{"read@sales"}, Actions: []string{"FIND"}}}, projectReport.CustomRoles)
+		require.Len(t, projectReport.Deployments, 1)
+		assert.Equal(t, "IDLE", projectReport.Deployments[0].StateName)
+		require.Len(t, projectReport.DatabaseUsers, 1)
+		assert.Equal(t, "app", projectReport.DatabaseUsers[0].Username)
+		assert.Contains(t, projectReport.DatabaseUsers[0].Roles, "readWrite@sales.orders")
+	})
+
+	t.Run("should restrict the report to the namespace", func(t *testing.T) {
+		report, err := Collect(context.Background(), auditClient(t, auditObjects()...), Options{Namespace: "other", Format: FormatJSON}, time.Now())
+		require.NoError(t, err)
+
+		assert.Empty(t, report.Projects)
+	})
+}
+
+func TestWrite(t *testing.T) {
+	report := &Report{
+		GeneratedAt: "2024-01-02T03:04:05Z",
+		Projects: []ProjectReport{
+			{
+				Namespace:     "team",
+				Name:          "project",
+				ID:            "project-id",
+				IPAccessList:  []IPAccessEntry{{Entry: "10.0.0.0/24", Comment: "office"}},
+				DatabaseUsers: []DatabaseUser{{Namespace: "team", Name: "app-user", Username: "app", DatabaseName: "admin", Roles: []string{"readWrite@sales"}}},
+			},
+		},
+	}
+
+	t.Run("should write the report as JSON", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		require.NoError(t, Write(output, report, FormatJSON))
+
+		decoded := &Report{}
+		require.NoError(t, json.Unmarshal(output.Bytes(), decoded))
+		assert.Equal(t, report, decoded)
+	})
+
+	t.Run("should write a CSV row per resource", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		require.NoError(t, Write(output, report, FormatCSV))
+
+		assert.Equal(
+			t,
+			"projectNamespace,projectName,projectId,kind,name,details\n"+
+				"team,project,project-id,Project,project,\n"+
+				"team,project,project-id,IPAccessEntry,10.0.0.0/24,comment=office; deleteAfterDate=\n"+
+				"team,project,project-id,DatabaseUser,app,resource=team/app-user; databaseName=admin; roles=readWrite@sales; scopes=; deleteAfterDate=\n",
+			output.String(),
+		)
+	})
+}
+
+func TestStore(t *testing.T) {
+	report := &Report{GeneratedAt: "2024-01-02T03:04:05Z", Projects: []ProjectReport{}}
+	key := client.ObjectKey{Namespace: "operator", Name: "audit"}
+
+	t.Run("should create the ConfigMap", func(t *testing.T) {
+		k8sClient := auditClient(t)
+
+		require.NoError(t, Store(context.Background(), k8sClient, key, report, FormatJSON))
+
+		configMap := &corev1.ConfigMap{}
+		require.NoError(t, k8sClient.Get(context.Background(), key, configMap))
+		assert.Contains(t, configMap.Data["report.json"], `"generatedAt": "2024-01-02T03:04:05Z"`)
+	})
+
+	t.Run("should update the existing ConfigMap", func(t *testing.T) {
+		k8sClient := auditClient(t, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Data:       map[string]string{"report.json": "{}"},
+		})
+
+		require.NoError(t, Store(context.Background(), k8sClient, key, report, FormatCSV))
+
+		configMap := &corev1.ConfigMap{}
+		require.NoError(t, k8sClient.Get(context.Background(), key, configMap))
+		assert.Equal(t, "{}", configMap.Data["report.json"])
+		assert.Equal(t, "projectNamespace,projectName,projectId,kind,name,details\n", configMap.Data["report.csv"])
+	})
+}
@@ -0,0 +1,435 @@
+// Package communityconvert implements the "convert-community" mode of the Operator binary. It translates the
+// MongoDBCommunity resources of the MongoDB Community Operator, along with their users and password secrets, into the
+// equivalent Atlas Custom Resources, and reports what can't be translated and how to migrate the data.
+package communityconvert
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/connectionsecret"
+)
+
+const (
+	communityKind         = "MongoDBCommunity"
+	communityReplicaSet   = "ReplicaSet"
+	defaultPasswordKey    = "password"
+	adminDatabase         = "admin"
+	externalDatabase      = "$external"
+	mongodPort            = 27017
+	convertedSecretSuffix = "-atlas"
+)
+
+// atlasBuiltInRoles are the built-in roles Atlas allows to grant to database users
+var atlasBuiltInRoles = map[string]struct{}{
+	"atlasAdmin": {}, "backup": {}, "clusterMonitor": {}, "dbAdmin": {}, "dbAdminAnyDatabase": {}, "enableSharding": {},
+	"read": {}, "readAnyDatabase": {}, "readWrite": {}, "readWriteAnyDatabase": {},
+}
+
+// unsupportedRoles are the MongoDB built-in roles Atlas doesn't allow to grant to database users
+var unsupportedRoles = map[string]struct{}{
+	"clusterAdmin": {}, "clusterManager": {}, "dbOwner": {}, "hostManager": {}, "restore": {}, "userAdmin": {},
+	"userAdminAnyDatabase": {}, "__system": {},
+}
+
+var invalidNameCharacters = regexp.MustCompile("[^a-z0-9-]+")
+
+// Options configures the Atlas resources created by the conversion
+type Options struct {
+	// ProjectName is the name of the AtlasProject the converted resources belong to
+	ProjectName string
+	// ProjectNamespace is the namespace of the AtlasProject, defaults to the namespace of each converted resource
+	ProjectNamespace string
+	// Namespace overrides the namespace of the converted resources
+	Namespace    string
+	ProviderName string
+	RegionName   string
+	InstanceSize string
+}
+
+// Validate checks the options required by the conversion are provided
+func (o Options) Validate() error {
+	if o.ProjectName == "" {
+		return errors.New("the name of the AtlasProject must be provided")
+	}
+	if o.ProviderName == "" || o.RegionName == "" || o.InstanceSize == "" {
+		return errors.New("the provider, region and instance size of the deployments must be provided")
+	}
+
+	return nil
+}
+
+// Result holds the converted resources and the notes about the conversion
+type Result struct {
+	Objects []client.Object
+	// Warnings lists the settings which couldn't be converted as they are
+	Warnings []string
+	// MigrationHints explains how to migrate the data of each converted deployment
+	MigrationHints []string
+}
+
+// community is the subset of the MongoDBCommunity resource the conversion relies on
+type community struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec communitySpec `json:"spec"`
+}
+
+type communitySpec struct {
+	Members                int                    `json:"members"`
+	Arbiters               int                    `json:"arbiters,omitempty"`
+	Type                   string                 `json:"type"`
+	Version                string                 `json:"version"`
+	Users                  []communityUser        `json:"users,omitempty"`
+	AdditionalMongodConfig map[string]interface{} `json:"additionalMongodConfig,omitempty"`
+}
+
+type communityUser struct {
+	Name              string                `json:"name"`
+	DB                string                `json:"db,omitempty"`
+	PasswordSecretRef communitySecretKeyRef `json:"passwordSecretRef"`
+	Roles             []communityRole       `json:"roles"`
+}
+
+type communitySecretKeyRef struct {
+	Name string `json:"name"`
+	Key  string `json:"key,omitempty"`
+}
+
+type communityRole struct {
+	Name string `json:"name"`
+	DB   string `json:"db"`
+}
+
+// Convert translates the MongoDBCommunity resources found in the manifests into AtlasDeployment and AtlasDatabaseUser
+// resources. The secrets of the manifests holding the user passwords are converted to Atlas password secrets
+func Convert(manifests io.Reader, options Options) (*Result, error) {
+	communities, secrets, err := decode(manifests)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(communities) == 0 {
+		return nil, fmt.Errorf("no %s resource found in the manifests", communityKind)
+	}
+
+	result := &Result{}
+	for _, mdbc := range communities {
+		namespace := mdbc.Namespace
+		if options.Namespace != "" {
+			namespace = options.Namespace
+		}
+
+		projectRef := common.ResourceRefNamespaced{Name: options.ProjectName, Namespace: options.ProjectNamespace}
+		if projectRef.Namespace == "" {
+			projectRef.Namespace = namespace
+		}
+
+		result.Objects = append(result.Objects, convertDeployment(mdbc, namespace, projectRef, options, result))
+		for _, user := range mdbc.Spec.Users {
+			result.Objects = append(result.Objects, convertUser(mdbc, user, namespace, projectRef, secrets, result)...)
+		}
+		result.MigrationHints = append(result.MigrationHints, migrationHints(mdbc)...)
+	}
+
+	return result, nil
+}
+
+func decode(manifests io.Reader) ([]community, map[string]corev1.Secret, error) {
+	var communities []community
+	secrets := map[string]corev1.Secret{}
+
+	decoder := k8syaml.NewYAMLOrJSONDecoder(manifests, 4096)
+	for {
+		raw := map[string]interface{}{}
+		if err := decoder.Decode(&raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				return communities, secrets, nil
+			}
+
+			return nil, nil, fmt.Errorf("failed to decode the manifests: %w", err)
+		}
+
+		if len(raw) == 0 {
+			continue
+		}
+
+		document, err := yaml.Marshal(raw)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch raw["kind"] {
+		case communityKind:
+			mdbc := community{}
+			if err = yaml.Unmarshal(document, &mdbc); err != nil {
+				return nil, nil, fmt.Errorf("failed to decode the %s resource: %w", communityKind, err)
+			}
+			communities = append(communities, mdbc)
+		case "Secret":
+			secret := corev1.Secret{}
+			if err = yaml.Unmarshal(document, &secret); err != nil {
+				return nil, nil, fmt.Errorf("failed to decode the Secret resource: %w", err)
+			}
+			secrets[secret.Name] = secret
+		}
+	}
+}
+
+func convertDeployment(mdbc community, namespace string, projectRef common.ResourceRefNamespaced, options Options, result *Result) *mdbv1.AtlasDeployment {
+	if mdbc.Spec.Type != "" && mdbc.Spec.Type != communityReplicaSet {
+		result.warnf(mdbc, "type %s is converted to an Atlas replica set", mdbc.Spec.Type)
+	}
+
+	nodeCount := atlasNodeCount(mdbc.Spec.Members)
+	if nodeCount != mdbc.Spec.Members {
+		result.warnf(mdbc, "%d members are converted to %d electable nodes, Atlas replica sets have 3, 5 or 7 electable nodes", mdbc.Spec.Members, nodeCount)
+	}
+
+	if mdbc.Spec.Arbiters > 0 {
+		result.warnf(mdbc, "arbiters are not available in Atlas and are not converted")
+	}
+
+	if len(mdbc.Spec.AdditionalMongodConfig) > 0 {
+		result.warnf(mdbc, "additionalMongodConfig is not converted (%s), set the supported options with the processArgs of the AtlasDeployment", strings.Join(sortedKeys(mdbc.Spec.AdditionalMongodConfig), ", "))
+	}
+
+	return &mdbv1.AtlasDeployment{
+		TypeMeta: metav1.TypeMeta{APIVersion: mdbv1.GroupVersion.String(), Kind: "AtlasDeployment"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      mdbc.Name,
+			Namespace: namespace,
+		},
+		Spec: mdbv1.AtlasDeploymentSpec{
+			Project: projectRef,
+			DeploymentSpec: &mdbv1.AdvancedDeploymentSpec{
+				Name:                mdbc.Name,
+				ClusterType:         string(mdbv1.TypeReplicaSet),
+				MongoDBMajorVersion: majorVersion(mdbc.Spec.Version),
+				ReplicationSpecs: []*mdbv1.AdvancedReplicationSpec{
+					{
+						NumShards: 1,
+						ZoneName:  "Zone 1",
+						RegionConfigs: []*mdbv1.AdvancedRegionConfig{
+							{
+								ProviderName: options.ProviderName,
+								RegionName:   options.RegionName,
+								Priority:     pointer.MakePtr(7),
+								ElectableSpecs: &mdbv1.Specs{
+									InstanceSize: options.InstanceSize,
+									NodeCount:    pointer.MakePtr(nodeCount),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func convertUser(mdbc community, user communityUser, namespace string, projectRef common.ResourceRefNamespaced, secrets map[string]corev1.Secret, result *Result) []client.Object {
+	if user.DB == externalDatabase {
+		result.warnf(mdbc, "user %s authenticates with the %s database and is not converted, configure it as an X.509 or LDAP database user", user.Name, externalDatabase)
+		return nil
+	}
+
+	if user.DB != "" && user.DB != adminDatabase {
+		result.warnf(mdbc, "user %s authenticates with the %s database, Atlas database users authenticate with the admin database", user.Name, user.DB)
+	}
+
+	roles := make([]mdbv1.RoleSpec, 0, len(user.Roles))
+	for _, role := range user.Roles {
+		roleName := role.Name
+		switch {
+		case roleName == "root":
+			result.warnf(mdbc, "role root of user %s is converted to atlasAdmin", user.Name)
+			roleName = "atlasAdmin"
+		case isUnsupportedRole(roleName):
+			result.warnf(mdbc, "role %s of user %s is not available in Atlas and is not converted", roleName, user.Name)
+			continue
+		case !isAtlasBuiltInRole(roleName):
+			result.warnf(mdbc, "role %s of user %s must be defined as a custom role of the AtlasProject", roleName, user.Name)
+		}
+
+		roles = append(roles, mdbv1.RoleSpec{RoleName: roleName, DatabaseName: role.DB})
+	}
+
+	if len(roles) == 0 {
+		result.warnf(mdbc, "user %s is not converted as none of its roles is available in Atlas", user.Name)
+		return nil
+	}
+
+	secretName := user.PasswordSecretRef.Name + convertedSecretSuffix
+	dbUser := &mdbv1.AtlasDatabaseUser{
+		TypeMeta: metav1.TypeMeta{APIVersion: mdbv1.GroupVersion.String(), Kind: "AtlasDatabaseUser"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resourceName(mdbc.Name, user.Name),
+			Namespace: namespace,
+		},
+		Spec: mdbv1.AtlasDatabaseUserSpec{
+			Project:        projectRef,
+			DatabaseName:   adminDatabase,
+			Username:       user.Name,
+			Roles:          roles,
+			PasswordSecret: &common.ResourceRef{Name: secretName},
+			Scopes:         []mdbv1.ScopeSpec{{Name: mdbc.Name, Type: mdbv1.DeploymentScopeType}},
+		},
+	}
+
+	passwordSecret, ok := convertPasswordSecret(user.PasswordSecretRef, secretName, namespace, secrets)
+	if !ok {
+		result.warnf(mdbc, "the password of user %s was not found in the manifests, create the secret %s with the password in the %q key", user.Name, secretName, defaultPasswordKey)
+		return []client.Object{dbUser}
+	}
+
+	return []client.Object{dbUser, passwordSecret}
+}
+
+func convertPasswordSecret(ref communitySecretKeyRef, name, namespace string, secrets map[string]corev1.Secret) (*corev1.Secret, bool) {
+	secret, ok := secrets[ref.Name]
+	if !ok {
+		return nil, false
+	}
+
+	key := ref.Key
+	if key == "" {
+		key = defaultPasswordKey
+	}
+
+	password, ok := secret.Data[key]
+	if !ok {
+		value, found := secret.StringData[key]
+		if !found {
+			return nil, false
+		}
+		password = []byte(value)
+	}
+
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    map[string]string{connectionsecret.TypeLabelKey: connectionsecret.CredLabelVal},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{defaultPasswordKey: password},
+	}, true
+}
+
+// migrationHints describes how to move the data of the community replica set to the converted Atlas deployment
+func migrationHints(mdbc community) []string {
+	hosts := make([]string, 0, mdbc.Spec.Members)
+	for i := 0; i < mdbc.Spec.Members; i++ {
+		hosts = append(hosts, fmt.Sprintf("%s-%d.%s-svc.%s.svc.cluster.local:%d", mdbc.Name, i, mdbc.Name, mdbc.Namespace, mongodPort))
+	}
+	source := fmt.Sprintf("%s/%s", mdbc.Name, strings.Join(hosts, ","))
+
+	return []string{
+		fmt.Sprintf("%s: the source replica set is %s", mdbc.Name, source),
+		fmt.Sprintf("%s: once the AtlasDeployment is ready, pull the data with the Atlas Live Migration (POST /api/atlas/v2/groups/{groupId}/liveMigrations) from a host reachable by Atlas", mdbc.Name),
+		fmt.Sprintf("%s: or push the data from the cluster with mongomirror --host %s --destination <Atlas replica set>/<Atlas hosts> --ssl", mdbc.Name, source),
+	}
+}
+
+// Write outputs the converted resources as a multi document YAML manifest, preceded by the warnings and migration
+// hints as comments
+func Write(w io.Writer, result *Result) error {
+	buf := &bytes.Buffer{}
+	writeComments(buf, "Conversion warnings:", result.Warnings)
+	writeComments(buf, "Data migration:", result.MigrationHints)
+
+	for _, object := range result.Objects {
+		document, err := yaml.Marshal(object)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s: %w", object.GetName(), err)
+		}
+
+		buf.WriteString("---\n")
+		buf.Write(document)
+	}
+
+	_, err := w.Write(buf.Bytes())
+
+	return err
+}
+
+func writeComments(buf *bytes.Buffer, title string, lines []string) {
+	if len(lines) == 0 {
+		return
+	}
+
+	buf.WriteString("# " + title + "\n")
+	for _, line := range lines {
+		buf.WriteString("#   - " + line + "\n")
+	}
+}
+
+func (r *Result) warnf(mdbc community, format string, args ...interface{}) {
+	r.Warnings = append(r.Warnings, fmt.Sprintf("%s: %s", mdbc.Name, fmt.Sprintf(format, args...)))
+}
+
+// atlasNodeCount returns the smallest number of electable nodes Atlas supports holding all the members
+func atlasNodeCount(members int) int {
+	for _, count := range []int{3, 5, 7} {
+		if members <= count {
+			return count
+		}
+	}
+
+	return 7
+}
+
+// majorVersion returns the <major>.<minor> version Atlas expects from a full MongoDB version
+func majorVersion(version string) string {
+	parts := strings.Split(version, ".")
+	if len(parts) < 2 {
+		return version
+	}
+
+	return parts[0] + "." + parts[1]
+}
+
+func resourceName(parts ...string) string {
+	name := strings.ToLower(strings.Join(parts, "-"))
+
+	return strings.Trim(invalidNameCharacters.ReplaceAllString(name, "-"), "-")
+}
+
+func isAtlasBuiltInRole(role string) bool {
+	_, ok := atlasBuiltInRoles[role]
+
+	return ok
+}
+
+func isUnsupportedRole(role string) bool {
+	_, ok := unsupportedRoles[role]
+
+	return ok
+}
+
+func sortedKeys(values map[string]interface{}) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,130 @@
+package communityconvert
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+)
+
+const manifests = `
+apiVersion: mongodbcommunity.mongodb.com/v1
+kind: MongoDBCommunity
+metadata:
+  name: example-mongodb
+  namespace: mongodb
+spec:
+  members: 3
+  type: ReplicaSet
+  version: "6.0.5"
+  users:
+    - name: app-user
+      db: admin
+      passwordSecretRef:
+        name: app-user-password
+        key: secret
+      roles:
+        - name: readWrite
+          db: app
+        - name: userAdminAnyDatabase
+          db: admin
+    - name: admin-user
+      passwordSecretRef:
+        name: admin-user-password
+      roles:
+        - name: root
+          db: admin
+    - name: operator-user
+      passwordSecretRef:
+        name: operator-user-password
+      roles:
+        - name: clusterAdmin
+          db: admin
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: app-user-password
+  namespace: mongodb
+stringData:
+  secret: app-password
+`
+
+var options = Options{
+	ProjectName:  "my-project",
+	ProviderName: "AWS",
+	RegionName:   "US_EAST_1",
+	InstanceSize: "M10",
+}
+
+func TestConvert(t *testing.T) {
+	result, err := Convert(strings.NewReader(manifests), options)
+	require.NoError(t, err)
+	require.Len(t, result.Objects, 4)
+
+	deployment, ok := result.Objects[0].(*mdbv1.AtlasDeployment)
+	require.True(t, ok)
+	assert.Equal(t, "mongodb", deployment.Namespace)
+	assert.Equal(t, common.ResourceRefNamespaced{Name: "my-project", Namespace: "mongodb"}, deployment.Spec.Project)
+	assert.Equal(t, "6.0", deployment.Spec.DeploymentSpec.MongoDBMajorVersion)
+	assert.Equal(t, 3, *deployment.Spec.DeploymentSpec.ReplicationSpecs[0].RegionConfigs[0].ElectableSpecs.NodeCount)
+
+	appUser, ok := result.Objects[1].(*mdbv1.AtlasDatabaseUser)
+	require.True(t, ok)
+	assert.Equal(t, "example-mongodb-app-user", appUser.Name)
+	assert.Equal(t, []mdbv1.RoleSpec{{RoleName: "readWrite", DatabaseName: "app"}}, appUser.Spec.Roles)
+	assert.Equal(t, "app-user-password-atlas", appUser.Spec.PasswordSecret.Name)
+
+	secret, ok := result.Objects[2].(*corev1.Secret)
+	require.True(t, ok)
+	assert.Equal(t, "app-user-password-atlas", secret.Name)
+	assert.Equal(t, map[string][]byte{"password": []byte("app-password")}, secret.Data)
+
+	adminUser, ok := result.Objects[3].(*mdbv1.AtlasDatabaseUser)
+	require.True(t, ok)
+	assert.Equal(t, []mdbv1.RoleSpec{{RoleName: "atlasAdmin", DatabaseName: "admin"}}, adminUser.Spec.Roles)
+
+	assert.Equal(
+		t,
+		[]string{
+			"example-mongodb: role userAdminAnyDatabase of user app-user is not available in Atlas and is not converted",
+			"example-mongodb: role root of user admin-user is converted to atlasAdmin",
+			`example-mongodb: the password of user admin-user was not found in the manifests, create the secret admin-user-password-atlas with the password in the "password" key`,
+			"example-mongodb: role clusterAdmin of user operator-user is not available in Atlas and is not converted",
+			"example-mongodb: user operator-user is not converted as none of its roles is available in Atlas",
+		},
+		result.Warnings,
+	)
+	assert.Len(t, result.MigrationHints, 3)
+	assert.Contains(t, result.MigrationHints[0], "example-mongodb/example-mongodb-0.example-mongodb-svc.mongodb.svc.cluster.local:27017")
+}
+
+func TestConvertWithoutCommunityResources(t *testing.T) {
+	_, err := Convert(strings.NewReader("apiVersion: v1\nkind: Secret\nmetadata:\n  name: secret\n"), options)
+	assert.EqualError(t, err, "no MongoDBCommunity resource found in the manifests")
+}
+
+func TestWrite(t *testing.T) {
+	result, err := Convert(strings.NewReader(manifests), options)
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, Write(buf, result))
+
+	output := buf.String()
+	assert.True(t, strings.HasPrefix(output, "# Conversion warnings:\n"))
+	assert.Equal(t, 4, strings.Count(output, "---\n"))
+	assert.Contains(t, output, "kind: AtlasDeployment")
+}
+
+func TestAtlasNodeCount(t *testing.T) {
+	for members, expected := range map[int]int{1: 3, 3: 3, 4: 5, 6: 7, 9: 7} {
+		assert.Equal(t, expected, atlasNodeCount(members))
+	}
+}
@@ -0,0 +1,88 @@
+package drift
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// fieldHashLength is the number of hex characters kept from the hash of each field
+const fieldHashLength = 12
+
+// Fingerprint returns a compact fingerprint of the JSON representation of v: every leaf field is identified by its
+// path, e.g. replicationSpecs[0].regionConfigs[0].electableSpecs.instanceSize, and mapped to a short hash of its
+// value. Comparing two fingerprints tells which fields changed without having to store their values
+func Fingerprint(v interface{}) (map[string]string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	if err = json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+
+	fingerprint := map[string]string{}
+	if err = flatten(fingerprint, "", value); err != nil {
+		return nil, err
+	}
+
+	return fingerprint, nil
+}
+
+// ChangedFields returns the sorted paths of the fields added, removed or modified between two fingerprints
+func ChangedFields(previous, current map[string]string) []string {
+	changed := make([]string, 0)
+	for path, hash := range current {
+		if previousHash, ok := previous[path]; !ok || previousHash != hash {
+			changed = append(changed, path)
+		}
+	}
+	for path := range previous {
+		if _, ok := current[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+	sort.Strings(changed)
+
+	return changed
+}
+
+func flatten(fingerprint map[string]string, path string, value interface{}) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(v) > 0 {
+			for key, item := range v {
+				itemPath := key
+				if path != "" {
+					itemPath = path + "." + key
+				}
+				if err := flatten(fingerprint, itemPath, item); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	case []interface{}:
+		if len(v) > 0 {
+			for i, item := range v {
+				if err := flatten(fingerprint, fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	hash := sha256.Sum256(data)
+	fingerprint[path] = hex.EncodeToString(hash[:])[:fieldHashLength]
+
+	return nil
+}
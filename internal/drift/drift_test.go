@@ -0,0 +1,59 @@
+package drift
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type nested struct {
+	InstanceSize string `json:"instanceSize,omitempty"`
+	NodeCount    int    `json:"nodeCount,omitempty"`
+}
+
+type document struct {
+	Name   string   `json:"name,omitempty"`
+	Tags   []string `json:"tags,omitempty"`
+	Specs  []nested `json:"specs,omitempty"`
+	Paused bool     `json:"paused"`
+}
+
+func TestFingerprint(t *testing.T) {
+	t.Run("should identify leaf fields by their path", func(t *testing.T) {
+		fingerprint, err := Fingerprint(document{Name: "cluster", Tags: []string{"a"}, Specs: []nested{{InstanceSize: "M10", NodeCount: 3}}})
+
+		require.NoError(t, err)
+		assert.Len(t, fingerprint, 5)
+		for _, path := range []string{"name", "tags[0]", "specs[0].instanceSize", "specs[0].nodeCount", "paused"} {
+			assert.Len(t, fingerprint[path], fieldHashLength, path)
+		}
+	})
+
+	t.Run("should be stable", func(t *testing.T) {
+		first, err := Fingerprint(document{Name: "cluster", Specs: []nested{{InstanceSize: "M10"}}})
+		require.NoError(t, err)
+		second, err := Fingerprint(document{Name: "cluster", Specs: []nested{{InstanceSize: "M10"}}})
+		require.NoError(t, err)
+
+		assert.Equal(t, first, second)
+	})
+}
+
+func TestChangedFields(t *testing.T) {
+	t.Run("should report modified, added and removed fields", func(t *testing.T) {
+		previous, err := Fingerprint(document{Name: "cluster", Tags: []string{"a"}, Specs: []nested{{InstanceSize: "M10", NodeCount: 3}}})
+		require.NoError(t, err)
+		current, err := Fingerprint(document{Name: "cluster", Specs: []nested{{InstanceSize: "M20", NodeCount: 3}}, Paused: true})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"paused", "specs[0].instanceSize", "tags[0]"}, ChangedFields(previous, current))
+	})
+
+	t.Run("should report nothing when unchanged", func(t *testing.T) {
+		fingerprint, err := Fingerprint(document{Name: "cluster"})
+		require.NoError(t, err)
+
+		assert.Empty(t, ChangedFields(fingerprint, fingerprint))
+	})
+}
@@ -1,11 +1,14 @@
 package httputil
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/metrics"
 )
 
 func Test_DecorateClient(t *testing.T) {
@@ -40,3 +43,14 @@ func Test_DecorateClientCustomTransport(t *testing.T) {
 	a.NoError(err)
 	a.Equal(decorated.Transport, dt)
 }
+
+func Test_DecorateClientMetricsTransport(t *testing.T) {
+	decorated, err := DecorateClient(&http.Client{Transport: &dummyTripper{}}, MetricsTransport())
+	a := assert.New(t)
+	a.NoError(err)
+
+	request, err := http.NewRequestWithContext(metrics.WithResource(context.Background(), "AtlasTeam", "ns", "team"), http.MethodGet, "http://localhost", nil)
+	a.NoError(err)
+	_, err = decorated.Transport.RoundTrip(request)
+	a.NoError(err)
+}
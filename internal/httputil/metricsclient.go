@@ -0,0 +1,25 @@
+package httputil
+
+import (
+	"net/http"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/metrics"
+)
+
+// MetricsTransport is the option counting the requests of an http Client per resource their context is tagged with
+func MetricsTransport() ClientOpt {
+	return func(c *http.Client) error {
+		c.Transport = &countedRoundTripper{rt: c.Transport}
+		return nil
+	}
+}
+
+type countedRoundTripper struct {
+	rt http.RoundTripper
+}
+
+func (c *countedRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	metrics.IncAtlasAPIRequests(request.Context())
+
+	return c.rt.RoundTrip(request)
+}
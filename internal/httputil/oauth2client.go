@@ -0,0 +1,19 @@
+package httputil
+
+import (
+	"net/http"
+
+	"golang.org/x/oauth2"
+)
+
+// OAuth2 is the option authenticating the requests of an http client with the access tokens of the token source
+func OAuth2(tokenSource oauth2.TokenSource) ClientOpt {
+	return func(c *http.Client) error {
+		t := &oauth2.Transport{
+			Source: tokenSource,
+			Base:   c.Transport,
+		}
+		c.Transport = t
+		return nil
+	}
+}
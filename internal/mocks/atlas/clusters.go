@@ -0,0 +1,166 @@
+package atlas
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+)
+
+type ClustersClientMock struct {
+	ListFunc     func(projectID string) ([]mongodbatlas.Cluster, *mongodbatlas.Response, error)
+	ListRequests map[string]struct{}
+
+	GetFunc     func(projectID string, clusterName string) (*mongodbatlas.Cluster, *mongodbatlas.Response, error)
+	GetRequests map[string]struct{}
+
+	CreateFunc     func(projectID string, cluster *mongodbatlas.Cluster) (*mongodbatlas.Cluster, *mongodbatlas.Response, error)
+	CreateRequests map[string]*mongodbatlas.Cluster
+
+	UpdateFunc     func(projectID string, clusterName string, cluster *mongodbatlas.Cluster) (*mongodbatlas.Cluster, *mongodbatlas.Response, error)
+	UpdateRequests map[string]*mongodbatlas.Cluster
+
+	DeleteFunc     func(projectID string, clusterName string) (*mongodbatlas.Response, error)
+	DeleteRequests map[string]struct{}
+
+	UpdateProcessArgsFunc     func(projectID string, clusterName string, args *mongodbatlas.ProcessArgs) (*mongodbatlas.ProcessArgs, *mongodbatlas.Response, error)
+	UpdateProcessArgsRequests map[string]*mongodbatlas.ProcessArgs
+
+	GetProcessArgsFunc     func(projectID string, clusterName string) (*mongodbatlas.ProcessArgs, *mongodbatlas.Response, error)
+	GetProcessArgsRequests map[string]struct{}
+
+	StatusFunc     func(projectID string, clusterName string) (mongodbatlas.ClusterStatus, *mongodbatlas.Response, error)
+	StatusRequests map[string]struct{}
+
+	LoadSampleDatasetFunc     func(projectID string, clusterName string) (*mongodbatlas.SampleDatasetJob, *mongodbatlas.Response, error)
+	LoadSampleDatasetRequests map[string]struct{}
+
+	GetSampleDatasetStatusFunc     func(projectID string, jobID string) (*mongodbatlas.SampleDatasetJob, *mongodbatlas.Response, error)
+	GetSampleDatasetStatusRequests map[string]struct{}
+
+	ListCloudProviderRegionsFunc     func(projectID string, options *mongodbatlas.CloudProviderRegionsOptions) (*mongodbatlas.CloudProviders, *mongodbatlas.Response, error)
+	ListCloudProviderRegionsRequests map[string]*mongodbatlas.CloudProviderRegionsOptions
+
+	UpgradeFunc     func(projectID string, cluster *mongodbatlas.Cluster) (*mongodbatlas.Cluster, *mongodbatlas.Response, error)
+	UpgradeRequests map[string]*mongodbatlas.Cluster
+}
+
+func (c *ClustersClientMock) List(_ context.Context, projectID string, _ *mongodbatlas.ListOptions) ([]mongodbatlas.Cluster, *mongodbatlas.Response, error) {
+	if c.ListRequests == nil {
+		c.ListRequests = map[string]struct{}{}
+	}
+
+	c.ListRequests[projectID] = struct{}{}
+
+	return c.ListFunc(projectID)
+}
+
+func (c *ClustersClientMock) Get(_ context.Context, projectID string, clusterName string) (*mongodbatlas.Cluster, *mongodbatlas.Response, error) {
+	if c.GetRequests == nil {
+		c.GetRequests = map[string]struct{}{}
+	}
+
+	c.GetRequests[fmt.Sprintf("%s.%s", projectID, clusterName)] = struct{}{}
+
+	return c.GetFunc(projectID, clusterName)
+}
+
+func (c *ClustersClientMock) Create(_ context.Context, projectID string, cluster *mongodbatlas.Cluster) (*mongodbatlas.Cluster, *mongodbatlas.Response, error) {
+	if c.CreateRequests == nil {
+		c.CreateRequests = map[string]*mongodbatlas.Cluster{}
+	}
+
+	c.CreateRequests[projectID] = cluster
+
+	return c.CreateFunc(projectID, cluster)
+}
+
+func (c *ClustersClientMock) Update(_ context.Context, projectID string, clusterName string, cluster *mongodbatlas.Cluster) (*mongodbatlas.Cluster, *mongodbatlas.Response, error) {
+	if c.UpdateRequests == nil {
+		c.UpdateRequests = map[string]*mongodbatlas.Cluster{}
+	}
+
+	c.UpdateRequests[fmt.Sprintf("%s.%s", projectID, clusterName)] = cluster
+
+	return c.UpdateFunc(projectID, clusterName, cluster)
+}
+
+func (c *ClustersClientMock) Delete(_ context.Context, projectID string, clusterName string, _ *mongodbatlas.DeleteAdvanceClusterOptions) (*mongodbatlas.Response, error) {
+	if c.DeleteRequests == nil {
+		c.DeleteRequests = map[string]struct{}{}
+	}
+
+	c.DeleteRequests[fmt.Sprintf("%s.%s", projectID, clusterName)] = struct{}{}
+
+	return c.DeleteFunc(projectID, clusterName)
+}
+
+func (c *ClustersClientMock) UpdateProcessArgs(_ context.Context, projectID string, clusterName string, args *mongodbatlas.ProcessArgs) (*mongodbatlas.ProcessArgs, *mongodbatlas.Response, error) {
+	if c.UpdateProcessArgsRequests == nil {
+		c.UpdateProcessArgsRequests = map[string]*mongodbatlas.ProcessArgs{}
+	}
+
+	c.UpdateProcessArgsRequests[fmt.Sprintf("%s.%s", projectID, clusterName)] = args
+
+	return c.UpdateProcessArgsFunc(projectID, clusterName, args)
+}
+
+func (c *ClustersClientMock) GetProcessArgs(_ context.Context, projectID string, clusterName string) (*mongodbatlas.ProcessArgs, *mongodbatlas.Response, error) {
+	if c.GetProcessArgsRequests == nil {
+		c.GetProcessArgsRequests = map[string]struct{}{}
+	}
+
+	c.GetProcessArgsRequests[fmt.Sprintf("%s.%s", projectID, clusterName)] = struct{}{}
+
+	return c.GetProcessArgsFunc(projectID, clusterName)
+}
+
+func (c *ClustersClientMock) Status(_ context.Context, projectID string, clusterName string) (mongodbatlas.ClusterStatus, *mongodbatlas.Response, error) {
+	if c.StatusRequests == nil {
+		c.StatusRequests = map[string]struct{}{}
+	}
+
+	c.StatusRequests[fmt.Sprintf("%s.%s", projectID, clusterName)] = struct{}{}
+
+	return c.StatusFunc(projectID, clusterName)
+}
+
+func (c *ClustersClientMock) LoadSampleDataset(_ context.Context, projectID string, clusterName string) (*mongodbatlas.SampleDatasetJob, *mongodbatlas.Response, error) {
+	if c.LoadSampleDatasetRequests == nil {
+		c.LoadSampleDatasetRequests = map[string]struct{}{}
+	}
+
+	c.LoadSampleDatasetRequests[fmt.Sprintf("%s.%s", projectID, clusterName)] = struct{}{}
+
+	return c.LoadSampleDatasetFunc(projectID, clusterName)
+}
+
+func (c *ClustersClientMock) GetSampleDatasetStatus(_ context.Context, projectID string, jobID string) (*mongodbatlas.SampleDatasetJob, *mongodbatlas.Response, error) {
+	if c.GetSampleDatasetStatusRequests == nil {
+		c.GetSampleDatasetStatusRequests = map[string]struct{}{}
+	}
+
+	c.GetSampleDatasetStatusRequests[fmt.Sprintf("%s.%s", projectID, jobID)] = struct{}{}
+
+	return c.GetSampleDatasetStatusFunc(projectID, jobID)
+}
+
+func (c *ClustersClientMock) ListCloudProviderRegions(_ context.Context, projectID string, options *mongodbatlas.CloudProviderRegionsOptions) (*mongodbatlas.CloudProviders, *mongodbatlas.Response, error) {
+	if c.ListCloudProviderRegionsRequests == nil {
+		c.ListCloudProviderRegionsRequests = map[string]*mongodbatlas.CloudProviderRegionsOptions{}
+	}
+
+	c.ListCloudProviderRegionsRequests[projectID] = options
+
+	return c.ListCloudProviderRegionsFunc(projectID, options)
+}
+
+func (c *ClustersClientMock) Upgrade(_ context.Context, projectID string, cluster *mongodbatlas.Cluster) (*mongodbatlas.Cluster, *mongodbatlas.Response, error) {
+	if c.UpgradeRequests == nil {
+		c.UpgradeRequests = map[string]*mongodbatlas.Cluster{}
+	}
+
+	c.UpgradeRequests[projectID] = cluster
+
+	return c.UpgradeFunc(projectID, cluster)
+}
@@ -83,7 +83,7 @@ func (c *ProjectsClientMock) GetOneProjectByName(_ context.Context, projectName
 
 	c.GetOneProjectByNameRequests[projectName] = struct{}{}
 
-	return c.GetOneProjectFunc(projectName)
+	return c.GetOneProjectByNameFunc(projectName)
 }
 
 func (c *ProjectsClientMock) Create(_ context.Context, project *mongodbatlas.Project, _ *mongodbatlas.CreateProjectOptions) (*mongodbatlas.Project, *mongodbatlas.Response, error) {
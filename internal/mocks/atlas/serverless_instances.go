@@ -29,7 +29,7 @@ func (c *ServerlessInstancesClientMock) List(_ context.Context, projectID string
 		c.ListRequests = map[string]struct{}{}
 	}
 
-	c.DeleteRequests[projectID] = struct{}{}
+	c.ListRequests[projectID] = struct{}{}
 
 	return c.ListFunc(projectID)
 }
@@ -63,13 +63,13 @@ func (c *TeamsClientMock) Get(_ context.Context, orgID string, teamID string) (*
 }
 
 func (c *TeamsClientMock) GetOneTeamByName(_ context.Context, orgID string, name string) (*mongodbatlas.Team, *mongodbatlas.Response, error) {
-	if c.GetRequests == nil {
-		c.GetRequests = map[string]struct{}{}
+	if c.GetOneTeamByNameRequests == nil {
+		c.GetOneTeamByNameRequests = map[string]struct{}{}
 	}
 
-	c.GetRequests[fmt.Sprintf("%s.%s", orgID, name)] = struct{}{}
+	c.GetOneTeamByNameRequests[fmt.Sprintf("%s.%s", orgID, name)] = struct{}{}
 
-	return c.GetFunc(orgID, name)
+	return c.GetOneTeamByNameFunc(orgID, name)
 }
 
 func (c *TeamsClientMock) GetTeamUsersAssigned(_ context.Context, orgID string, teamID string) ([]mongodbatlas.AtlasUser, *mongodbatlas.Response, error) {
@@ -0,0 +1,74 @@
+package atlas
+
+import (
+	"context"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+)
+
+type X509AuthDBUsersClientMock struct {
+	CreateUserCertificateFunc     func(projectID, username string, monthsUntilExpiration int) (*mongodbatlas.UserCertificate, *mongodbatlas.Response, error)
+	CreateUserCertificateRequests map[string]int
+
+	GetUserCertificatesFunc     func(projectID, username string) ([]mongodbatlas.UserCertificate, *mongodbatlas.Response, error)
+	GetUserCertificatesRequests map[string]struct{}
+
+	SaveConfigurationFunc     func(projectID string, customerX509 *mongodbatlas.CustomerX509) (*mongodbatlas.CustomerX509, *mongodbatlas.Response, error)
+	SaveConfigurationRequests map[string]*mongodbatlas.CustomerX509
+
+	GetCurrentX509ConfFunc     func(projectID string) (*mongodbatlas.CustomerX509, *mongodbatlas.Response, error)
+	GetCurrentX509ConfRequests map[string]struct{}
+
+	DisableCustomerX509Func     func(projectID string) (*mongodbatlas.Response, error)
+	DisableCustomerX509Requests map[string]struct{}
+}
+
+func (c *X509AuthDBUsersClientMock) CreateUserCertificate(_ context.Context, projectID, username string, monthsUntilExpiration int) (*mongodbatlas.UserCertificate, *mongodbatlas.Response, error) {
+	if c.CreateUserCertificateRequests == nil {
+		c.CreateUserCertificateRequests = map[string]int{}
+	}
+
+	c.CreateUserCertificateRequests[projectID+"."+username] = monthsUntilExpiration
+
+	return c.CreateUserCertificateFunc(projectID, username, monthsUntilExpiration)
+}
+
+func (c *X509AuthDBUsersClientMock) GetUserCertificates(_ context.Context, projectID, username string, _ *mongodbatlas.ListOptions) ([]mongodbatlas.UserCertificate, *mongodbatlas.Response, error) {
+	if c.GetUserCertificatesRequests == nil {
+		c.GetUserCertificatesRequests = map[string]struct{}{}
+	}
+
+	c.GetUserCertificatesRequests[projectID+"."+username] = struct{}{}
+
+	return c.GetUserCertificatesFunc(projectID, username)
+}
+
+func (c *X509AuthDBUsersClientMock) SaveConfiguration(_ context.Context, projectID string, customerX509 *mongodbatlas.CustomerX509) (*mongodbatlas.CustomerX509, *mongodbatlas.Response, error) {
+	if c.SaveConfigurationRequests == nil {
+		c.SaveConfigurationRequests = map[string]*mongodbatlas.CustomerX509{}
+	}
+
+	c.SaveConfigurationRequests[projectID] = customerX509
+
+	return c.SaveConfigurationFunc(projectID, customerX509)
+}
+
+func (c *X509AuthDBUsersClientMock) GetCurrentX509Conf(_ context.Context, projectID string) (*mongodbatlas.CustomerX509, *mongodbatlas.Response, error) {
+	if c.GetCurrentX509ConfRequests == nil {
+		c.GetCurrentX509ConfRequests = map[string]struct{}{}
+	}
+
+	c.GetCurrentX509ConfRequests[projectID] = struct{}{}
+
+	return c.GetCurrentX509ConfFunc(projectID)
+}
+
+func (c *X509AuthDBUsersClientMock) DisableCustomerX509(_ context.Context, projectID string) (*mongodbatlas.Response, error) {
+	if c.DisableCustomerX509Requests == nil {
+		c.DisableCustomerX509Requests = map[string]struct{}{}
+	}
+
+	c.DisableCustomerX509Requests[projectID] = struct{}{}
+
+	return c.DisableCustomerX509Func(projectID)
+}
@@ -0,0 +1,114 @@
+package nametemplate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// MaxLength is the maximum length of the generated names, the shortest limit of the Atlas names they are used for
+const MaxLength = 64
+
+// hashLength is the length of the hash keeping the truncated names unique
+const hashLength = 8
+
+var (
+	// Environment names the environment the operator runs in, e.g. production. It is set once when the operator starts
+	Environment string
+
+	// Prefix and Suffix are added to the generated names, e.g. to tell apart the Atlas resources of parallel test runs
+	// sharing a project. They are set once when the operator starts
+	Prefix string
+	Suffix string
+)
+
+// Values are the values available to the name templates. The ones unknown to a resource are left empty
+type Values struct {
+	// Project is the name of the project in Atlas
+	Project string
+	// Deployment is the name of the deployment in Atlas
+	Deployment string
+	// Namespace and Name are the ones of the custom resource requesting the Atlas resource
+	Namespace string
+	Name      string
+	// Provider and Region are the ones of the Atlas resource
+	Provider string
+	Region   string
+	// Index is the position of the resource in the list of the spec defining it, and Group the GCP endpoint group of a
+	// private endpoint, to tell apart the resources sharing the other values
+	Index int
+	Group string
+	// Environment is the environment the operator runs in
+	Environment string
+	// Prefix and Suffix are the ones configured in the operator
+	Prefix string
+	Suffix string
+}
+
+// NewValues returns the values of the operator configuration, to be completed with the ones of the resource
+func NewValues() Values {
+	return Values{Environment: Environment, Prefix: Prefix, Suffix: Suffix}
+}
+
+// Render expands a name template such as "{{ .Deployment }}-{{ .Environment }}". Names without a template are returned
+// unchanged
+func Render(name string, values Values) (string, error) {
+	if !strings.Contains(name, "{{") {
+		return name, nil
+	}
+
+	tmpl, err := template.New("name").Option("missingkey=error").Parse(name)
+	if err != nil {
+		return "", fmt.Errorf("invalid name template %q: %w", name, err)
+	}
+
+	sb := &strings.Builder{}
+	if err = tmpl.Execute(sb, values); err != nil {
+		return "", fmt.Errorf("invalid name template %q: %w", name, err)
+	}
+
+	return strings.TrimSpace(sb.String()), nil
+}
+
+// Generate expands a name template like Render, adding the configured prefix and suffix to the rendered name unless
+// the template places them itself. The generated names longer than maxLength are truncated. Names without a template
+// are returned unchanged
+func Generate(name string, values Values, maxLength int) (string, error) {
+	if !strings.Contains(name, "{{") {
+		return name, nil
+	}
+
+	rendered, err := Render(name, values)
+	if err != nil {
+		return "", err
+	}
+
+	if !strings.Contains(name, ".Prefix") {
+		rendered = values.Prefix + rendered
+	}
+	if !strings.Contains(name, ".Suffix") {
+		rendered += values.Suffix
+	}
+
+	return Truncate(rendered, maxLength), nil
+}
+
+// Truncate shortens the names longer than maxLength, replacing their end by a hash of the whole name so that the
+// truncated names stay deterministic and don't collide
+func Truncate(name string, maxLength int) string {
+	if maxLength <= 0 || len(name) <= maxLength {
+		return name
+	}
+
+	sum := sha256.Sum256([]byte(name))
+	hash := hex.EncodeToString(sum[:])[:hashLength]
+	if maxLength <= hashLength+1 {
+		return hash[:maxLength]
+	}
+
+	kept := strings.TrimRight(name[:maxLength-hashLength-1], "-_.")
+
+	return kept + "-" + hash
+}
@@ -0,0 +1,91 @@
+package nametemplate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	values := Values{Project: "project", Deployment: "cluster0", Namespace: "ns", Name: "deployment", Provider: "AWS", Region: "US_EAST_1", Environment: "production"}
+
+	t.Run("should return the names without a template unchanged", func(t *testing.T) {
+		name, err := Render("pe-1", values)
+
+		require.NoError(t, err)
+		assert.Equal(t, "pe-1", name)
+	})
+
+	t.Run("should expand the values", func(t *testing.T) {
+		name, err := Render("{{ .Deployment }}-{{ .Environment }}-{{ .Namespace }}/{{ .Name }}", values)
+
+		require.NoError(t, err)
+		assert.Equal(t, "cluster0-production-ns/deployment", name)
+	})
+
+	t.Run("should reject an unknown value", func(t *testing.T) {
+		_, err := Render("{{ .Cluster }}", values)
+
+		require.ErrorContains(t, err, `invalid name template "{{ .Cluster }}"`)
+	})
+
+	t.Run("should reject a malformed template", func(t *testing.T) {
+		_, err := Render("{{ .Deployment", values)
+
+		require.ErrorContains(t, err, `invalid name template "{{ .Deployment"`)
+	})
+}
+
+func TestGenerate(t *testing.T) {
+	values := Values{Deployment: "cluster0", Environment: "ci", Prefix: "run42-", Suffix: "-x"}
+
+	t.Run("should return the names without a template unchanged", func(t *testing.T) {
+		name, err := Generate("pe-1", values, MaxLength)
+
+		require.NoError(t, err)
+		assert.Equal(t, "pe-1", name)
+	})
+
+	t.Run("should add the prefix and suffix", func(t *testing.T) {
+		name, err := Generate("{{ .Deployment }}-{{ .Environment }}", values, MaxLength)
+
+		require.NoError(t, err)
+		assert.Equal(t, "run42-cluster0-ci-x", name)
+	})
+
+	t.Run("should not add the prefix placed by the template", func(t *testing.T) {
+		name, err := Generate("{{ .Deployment }}-{{ .Prefix }}", values, MaxLength)
+
+		require.NoError(t, err)
+		assert.Equal(t, "cluster0-run42--x", name)
+	})
+
+	t.Run("should truncate the long names", func(t *testing.T) {
+		name, err := Generate("{{ .Deployment }}-0123456789", values, 20)
+
+		require.NoError(t, err)
+		assert.Len(t, name, 20)
+		assert.Regexp(t, "^run42-clust-[0-9a-f]{8}$", name)
+	})
+}
+
+func TestTruncate(t *testing.T) {
+	t.Run("should keep the names within the maximum length", func(t *testing.T) {
+		assert.Equal(t, "cluster0", Truncate("cluster0", 8))
+	})
+
+	t.Run("should keep the truncated names deterministic and unique", func(t *testing.T) {
+		first := Truncate("deployment-of-run-1", 15)
+		second := Truncate("deployment-of-run-2", 15)
+
+		assert.Equal(t, first, Truncate("deployment-of-run-1", 15))
+		assert.NotEqual(t, first, second)
+		assert.Len(t, first, 15)
+		assert.Regexp(t, "^deploy-[0-9a-f]{8}$", first)
+	})
+
+	t.Run("should return the hash only when the maximum length is too short", func(t *testing.T) {
+		assert.Regexp(t, "^[0-9a-f]{5}$", Truncate("deployment", 5))
+	})
+}
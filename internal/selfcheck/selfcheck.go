@@ -0,0 +1,363 @@
+// Package selfcheck implements the "--self-check" run mode of the Operator binary. It validates that the installation
+// can run the Operator: the Custom Resource Definitions are installed at the version the Operator serves, the
+// credentials of the global secret resolve, the RBAC grants the access the controllers need and the Atlas API is
+// reachable, through the proxy configured in the environment if any. The result is written as a readiness report, to
+// de-risk the upgrades before the Operator is rolled out.
+package selfcheck
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/config/rbac"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/httputil"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/timeutil"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+)
+
+// Status of a check
+const (
+	StatusPassed  = "Passed"
+	StatusFailed  = "Failed"
+	StatusSkipped = "Skipped"
+)
+
+// Names of the checks
+const (
+	CheckCRDs        = "CustomResourceDefinitions"
+	CheckWebhooks    = "Webhooks"
+	CheckCredentials = "Credentials"
+	CheckRBAC        = "RBAC"
+	CheckAtlasAPI    = "AtlasAPI"
+)
+
+// atlasAPIPath lists the organizations of the credentials, it requires an authenticated request
+const atlasAPIPath = "api/atlas/v1.0/orgs"
+
+// Options describes the installation to check
+type Options struct {
+	// AtlasDomain is the URL of Atlas the Operator talks to
+	AtlasDomain string
+	// GlobalAPISecret is the Secret holding the credentials used by the resources without their own
+	GlobalAPISecret client.ObjectKey
+	// Namespaces are the namespaces the Operator watches. The access is checked cluster-wide when empty
+	Namespaces []string
+	// AllowExternalTokenURL allows the service account of the global secret to use a token URL outside of the Atlas
+	// domain
+	AllowExternalTokenURL bool
+	// HTTPClient sends the requests to Atlas. Its transport is expected to use the proxy of the environment
+	HTTPClient *http.Client
+}
+
+// Report is the result of the checks. The installation is ready when none of them failed
+type Report struct {
+	GeneratedAt string  `json:"generatedAt"`
+	Ready       bool    `json:"ready"`
+	Checks      []Check `json:"checks"`
+}
+
+// Check is the result of a single check
+type Check struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+	// Details lists the individual failures, e.g. the missing Custom Resource Definitions or permissions
+	Details []string `json:"details,omitempty"`
+}
+
+// Run performs all the checks against the cluster of the client and Atlas
+func Run(ctx context.Context, k8sClient client.Client, scheme *runtime.Scheme, options Options, now time.Time) *Report {
+	crds := checkCRDs(k8sClient.RESTMapper(), scheme)
+	credentials, authentication := checkCredentials(ctx, k8sClient, options.AtlasDomain, options.GlobalAPISecret, options.AllowExternalTokenURL)
+
+	report := &Report{
+		GeneratedAt: timeutil.FormatISO8601(now),
+		Checks: []Check{
+			crds,
+			{
+				Name:    CheckWebhooks,
+				Status:  StatusSkipped,
+				Message: "the Operator doesn't serve admission webhooks",
+			},
+			credentials,
+			checkRBAC(ctx, k8sClient, options.Namespaces),
+			checkAtlasAPI(ctx, options.HTTPClient, options.AtlasDomain, authentication),
+		},
+	}
+
+	report.Ready = true
+	for _, check := range report.Checks {
+		if check.Status == StatusFailed {
+			report.Ready = false
+		}
+	}
+
+	return report
+}
+
+// Write writes the report as indented JSON
+func Write(output io.Writer, report *Report) error {
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(report)
+}
+
+// checkCRDs verifies that the API server serves every Atlas Custom Resource at the version known by the Operator
+func checkCRDs(mapper meta.RESTMapper, scheme *runtime.Scheme) Check {
+	check := Check{Name: CheckCRDs}
+
+	kinds := atlasKinds(scheme)
+	for _, kind := range kinds {
+		gk := schema.GroupKind{Group: mdbv1.GroupVersion.Group, Kind: kind}
+		if _, err := mapper.RESTMapping(gk, mdbv1.GroupVersion.Version); err != nil {
+			check.Details = append(check.Details, fmt.Sprintf("%s %s: %s", kind, mdbv1.GroupVersion.Version, notServedReason(err)))
+		}
+	}
+
+	if len(check.Details) > 0 {
+		check.Status = StatusFailed
+		check.Message = fmt.Sprintf("%d of the Custom Resource Definitions aren't installed at a compatible version", len(check.Details))
+
+		return check
+	}
+
+	check.Status = StatusPassed
+	check.Message = fmt.Sprintf("the %d Custom Resource Definitions are served at version %s", len(kinds), mdbv1.GroupVersion.Version)
+
+	return check
+}
+
+// atlasKinds returns the sorted kinds of the Atlas Custom Resources registered in the scheme
+func atlasKinds(scheme *runtime.Scheme) []string {
+	var kinds []string
+	for kind := range scheme.KnownTypes(mdbv1.GroupVersion) {
+		if strings.HasSuffix(kind, "List") {
+			continue
+		}
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	return kinds
+}
+
+func notServedReason(err error) string {
+	if meta.IsNoMatchError(err) {
+		return "not installed or not served"
+	}
+
+	return err.Error()
+}
+
+// checkCredentials resolves the API key, or the service account, of the global secret. The Operator can run without it
+// when all the projects reference their own credentials, the check is skipped then
+func checkCredentials(ctx context.Context, k8sClient client.Client, domain string, secretRef client.ObjectKey, allowExternalTokenURL bool) (Check, httputil.ClientOpt) {
+	check := Check{Name: CheckCredentials}
+
+	authentication, err := atlas.SecretAuthentication(ctx, k8sClient, domain, secretRef, allowExternalTokenURL)
+	if apiErrors.IsNotFound(err) {
+		check.Status = StatusSkipped
+		check.Message = fmt.Sprintf("the global secret %s doesn't exist, the projects must reference their own credentials", secretRef)
+
+		return check, nil
+	}
+	if err != nil {
+		check.Status = StatusFailed
+		check.Message = err.Error()
+
+		return check, nil
+	}
+
+	check.Status = StatusPassed
+	check.Message = fmt.Sprintf("the credentials of the global secret %s resolve", secretRef)
+
+	return check, authentication
+}
+
+// checkRBAC reviews the access of the Operator granted by its role, generated from the kubebuilder markers of the
+// controllers, in each watched namespace. The Operator watching all the namespaces needs its ClusterRole
+func checkRBAC(ctx context.Context, k8sClient client.Client, namespaces []string) Check {
+	check := Check{Name: CheckRBAC}
+
+	role := rbac.NamespacedRole
+	if len(namespaces) == 0 {
+		role = rbac.ClusterwideRole
+		namespaces = []string{""}
+	}
+	attributes, err := requiredAccess(role)
+	if err != nil {
+		check.Status = StatusFailed
+		check.Message = fmt.Sprintf("unable to read the role of the Operator: %s", err)
+
+		return check
+	}
+
+	for _, namespace := range namespaces {
+		for _, attribute := range attributes {
+			attribute.Namespace = namespace
+			review := &authorizationv1.SelfSubjectAccessReview{
+				Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: &attribute},
+			}
+			if err := k8sClient.Create(ctx, review); err != nil {
+				check.Status = StatusFailed
+				check.Message = fmt.Sprintf("unable to review the access of the Operator: %s", err)
+
+				return check
+			}
+			if !review.Status.Allowed {
+				check.Details = append(check.Details, describeAccess(attribute))
+			}
+		}
+	}
+
+	if len(check.Details) > 0 {
+		check.Status = StatusFailed
+		check.Message = fmt.Sprintf("%d permissions are missing", len(check.Details))
+
+		return check
+	}
+
+	check.Status = StatusPassed
+	check.Message = "the Operator has the permissions it needs"
+
+	return check
+}
+
+// requiredAccess returns the access granted by each rule of the role
+func requiredAccess(role []byte) ([]authorizationv1.ResourceAttributes, error) {
+	rules := struct {
+		Rules []rbacv1.PolicyRule `json:"rules"`
+	}{}
+	if err := yaml.Unmarshal(role, &rules); err != nil {
+		return nil, err
+	}
+
+	var attributes []authorizationv1.ResourceAttributes
+	for _, rule := range rules.Rules {
+		for _, group := range rule.APIGroups {
+			for _, resource := range rule.Resources {
+				resource, subresource, _ := strings.Cut(resource, "/")
+				for _, verb := range rule.Verbs {
+					attributes = append(attributes, authorizationv1.ResourceAttributes{Group: group, Resource: resource, Subresource: subresource, Verb: verb})
+				}
+			}
+		}
+	}
+
+	return attributes, nil
+}
+
+func describeAccess(attribute authorizationv1.ResourceAttributes) string {
+	resource := attribute.Resource
+	if attribute.Group != "" {
+		resource += "." + attribute.Group
+	}
+	if attribute.Subresource != "" {
+		resource += "/" + attribute.Subresource
+	}
+
+	scope := "cluster-wide"
+	if attribute.Namespace != "" {
+		scope = "in namespace " + attribute.Namespace
+	}
+
+	return fmt.Sprintf("%s %s %s", attribute.Verb, resource, scope)
+}
+
+// checkAtlasAPI lists the organizations with the Atlas Admin API. The request is authenticated with the credentials
+// when they resolved, an unauthorized response fails the check then. Otherwise, any response proves that Atlas is
+// reachable
+func checkAtlasAPI(ctx context.Context, httpClient *http.Client, domain string, authentication httputil.ClientOpt) Check {
+	check := Check{Name: CheckAtlasAPI}
+
+	if httpClient == nil {
+		httpClient = &http.Client{Transport: http.DefaultTransport}
+	}
+	if authentication != nil {
+		var err error
+		if httpClient, err = httputil.DecorateClient(&http.Client{Transport: httpClient.Transport, Timeout: httpClient.Timeout}, authentication); err != nil {
+			check.Status = StatusFailed
+			check.Message = err.Error()
+
+			return check
+		}
+	}
+
+	endpoint, err := url.JoinPath(domain, atlasAPIPath)
+	if err != nil {
+		check.Status = StatusFailed
+		check.Message = fmt.Sprintf("invalid Atlas domain %q: %s", domain, err)
+
+		return check
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		check.Status = StatusFailed
+		check.Message = err.Error()
+
+		return check
+	}
+	via := proxyOf(request)
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		check.Status = StatusFailed
+		check.Message = fmt.Sprintf("Atlas is unreachable%s: %s", via, unwrapURLError(err))
+
+		return check
+	}
+	defer response.Body.Close()
+
+	switch {
+	case response.StatusCode >= http.StatusInternalServerError:
+		check.Status = StatusFailed
+		check.Message = fmt.Sprintf("Atlas answered%s with the status %s", via, response.Status)
+	case authentication != nil && response.StatusCode == http.StatusUnauthorized:
+		check.Status = StatusFailed
+		check.Message = fmt.Sprintf("Atlas is reachable%s but rejected the credentials of the global secret", via)
+	default:
+		check.Status = StatusPassed
+		check.Message = fmt.Sprintf("Atlas is reachable%s", via)
+	}
+
+	return check
+}
+
+// proxyOf describes the proxy of the environment used for the request, empty when the request is sent directly
+func proxyOf(request *http.Request) string {
+	proxyURL, err := http.ProxyFromEnvironment(request)
+	if err != nil || proxyURL == nil {
+		return ""
+	}
+
+	return fmt.Sprintf(" through the proxy %s", proxyURL.Redacted())
+}
+
+func unwrapURLError(err error) error {
+	urlErr := &url.Error{}
+	if errors.As(err, &urlErr) {
+		return urlErr.Err
+	}
+
+	return err
+}
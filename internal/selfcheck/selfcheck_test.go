@@ -0,0 +1,266 @@
+package selfcheck
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+)
+
+var globalSecret = client.ObjectKey{Namespace: "mongodb-atlas-system", Name: "mongodb-atlas-operator-api-key"}
+
+func checkScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, authorizationv1.AddToScheme(scheme))
+	require.NoError(t, mdbv1.AddToScheme(scheme))
+
+	return scheme
+}
+
+// checkClient returns a client of a cluster serving the Atlas Custom Resources except the missing kinds, and denying
+// the access matching the denied function
+func checkClient(t *testing.T, scheme *runtime.Scheme, missingKinds []string, denied func(authorizationv1.ResourceAttributes) bool, objects ...client.Object) client.Client {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	missing := map[string]bool{}
+	for _, kind := range missingKinds {
+		missing[kind] = true
+	}
+	for _, kind := range atlasKinds(scheme) {
+		if !missing[kind] {
+			mapper.Add(mdbv1.GroupVersion.WithKind(kind), meta.RESTScopeNamespace)
+		}
+	}
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRESTMapper(mapper).
+		WithObjects(objects...).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				if review, ok := obj.(*authorizationv1.SelfSubjectAccessReview); ok {
+					review.Status.Allowed = denied == nil || !denied(*review.Spec.ResourceAttributes)
+					return nil
+				}
+				return c.Create(ctx, obj, opts...)
+			},
+		}).
+		Build()
+}
+
+func credentialsSecret() *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: globalSecret.Name, Namespace: globalSecret.Namespace},
+		Data: map[string][]byte{
+			"orgId":         []byte("org-id"),
+			"publicApiKey":  []byte("public"),
+			"privateApiKey": []byte("private"),
+		},
+	}
+}
+
+func atlasServer(t *testing.T, statusCode int) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/atlas/v1.0/orgs", r.URL.Path)
+		w.WriteHeader(statusCode)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func checkByName(t *testing.T, report *Report, name string) Check {
+	for _, check := range report.Checks {
+		if check.Name == name {
+			return check
+		}
+	}
+	require.Failf(t, "check not found", "the report has no %s check", name)
+
+	return Check{}
+}
+
+func TestRun(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("should report a ready installation", func(t *testing.T) {
+		scheme := checkScheme(t)
+		server := atlasServer(t, http.StatusOK)
+		k8sClient := checkClient(t, scheme, nil, nil, credentialsSecret())
+
+		report := Run(context.Background(), k8sClient, scheme, Options{
+			AtlasDomain:     server.URL + "/",
+			GlobalAPISecret: globalSecret,
+			Namespaces:      []string{"team"},
+		}, now)
+
+		assert.True(t, report.Ready)
+		assert.Equal(t, "2024-03-01T10:00:00Z", report.GeneratedAt)
+		for _, check := range report.Checks {
+			assert.NotEqual(t, StatusFailed, check.Status, check.Name)
+		}
+		assert.Equal(t, StatusSkipped, checkByName(t, report, CheckWebhooks).Status)
+		assert.Equal(t, StatusPassed, checkByName(t, report, CheckAtlasAPI).Status)
+	})
+
+	t.Run("should list the Custom Resource Definitions which aren't served", func(t *testing.T) {
+		scheme := checkScheme(t)
+		server := atlasServer(t, http.StatusOK)
+		k8sClient := checkClient(t, scheme, []string{"AtlasSearchIndex"}, nil, credentialsSecret())
+
+		report := Run(context.Background(), k8sClient, scheme, Options{AtlasDomain: server.URL, GlobalAPISecret: globalSecret}, now)
+
+		assert.False(t, report.Ready)
+		crds := checkByName(t, report, CheckCRDs)
+		assert.Equal(t, StatusFailed, crds.Status)
+		assert.Equal(t, []string{"AtlasSearchIndex v1: not installed or not served"}, crds.Details)
+	})
+
+	t.Run("should list the missing permissions", func(t *testing.T) {
+		scheme := checkScheme(t)
+		server := atlasServer(t, http.StatusOK)
+		k8sClient := checkClient(t, scheme, nil, func(attributes authorizationv1.ResourceAttributes) bool {
+			return attributes.Resource == "secrets" && attributes.Verb == "delete" && attributes.Namespace == "team-b"
+		}, credentialsSecret())
+
+		report := Run(context.Background(), k8sClient, scheme, Options{
+			AtlasDomain:     server.URL,
+			GlobalAPISecret: globalSecret,
+			Namespaces:      []string{"team-a", "team-b"},
+		}, now)
+
+		assert.False(t, report.Ready)
+		rbac := checkByName(t, report, CheckRBAC)
+		assert.Equal(t, StatusFailed, rbac.Status)
+		assert.Equal(t, []string{"delete secrets in namespace team-b"}, rbac.Details)
+	})
+
+	t.Run("should check the access granted by the ClusterRole when watching all the namespaces", func(t *testing.T) {
+		scheme := checkScheme(t)
+		server := atlasServer(t, http.StatusOK)
+		k8sClient := checkClient(t, scheme, nil, func(attributes authorizationv1.ResourceAttributes) bool {
+			return (attributes.Resource == "namespaces" && attributes.Verb == "get") ||
+				(attributes.Resource == "atlasdatabaseusers" && attributes.Verb == "create")
+		}, credentialsSecret())
+
+		report := Run(context.Background(), k8sClient, scheme, Options{AtlasDomain: server.URL, GlobalAPISecret: globalSecret}, now)
+
+		assert.False(t, report.Ready)
+		assert.Equal(t, []string{
+			"get namespaces cluster-wide",
+			"create atlasdatabaseusers.atlas.mongodb.com cluster-wide",
+		}, checkByName(t, report, CheckRBAC).Details)
+	})
+
+	t.Run("should skip the credentials without a global secret", func(t *testing.T) {
+		scheme := checkScheme(t)
+		server := atlasServer(t, http.StatusUnauthorized)
+		k8sClient := checkClient(t, scheme, nil, nil)
+
+		report := Run(context.Background(), k8sClient, scheme, Options{AtlasDomain: server.URL, GlobalAPISecret: globalSecret}, now)
+
+		assert.True(t, report.Ready)
+		assert.Equal(t, StatusSkipped, checkByName(t, report, CheckCredentials).Status)
+		assert.Equal(t, StatusPassed, checkByName(t, report, CheckAtlasAPI).Status)
+	})
+
+	t.Run("should fail on an incomplete global secret", func(t *testing.T) {
+		scheme := checkScheme(t)
+		server := atlasServer(t, http.StatusOK)
+		secret := credentialsSecret()
+		delete(secret.Data, "privateApiKey")
+		k8sClient := checkClient(t, scheme, nil, nil, secret)
+
+		report := Run(context.Background(), k8sClient, scheme, Options{AtlasDomain: server.URL, GlobalAPISecret: globalSecret}, now)
+
+		assert.False(t, report.Ready)
+		credentials := checkByName(t, report, CheckCredentials)
+		assert.Equal(t, StatusFailed, credentials.Status)
+		assert.Contains(t, credentials.Message, "privateApiKey")
+	})
+
+	t.Run("should fail when Atlas rejects the credentials", func(t *testing.T) {
+		scheme := checkScheme(t)
+		server := atlasServer(t, http.StatusUnauthorized)
+		k8sClient := checkClient(t, scheme, nil, nil, credentialsSecret())
+
+		report := Run(context.Background(), k8sClient, scheme, Options{AtlasDomain: server.URL, GlobalAPISecret: globalSecret}, now)
+
+		assert.False(t, report.Ready)
+		atlasAPI := checkByName(t, report, CheckAtlasAPI)
+		assert.Equal(t, StatusFailed, atlasAPI.Status)
+		assert.Contains(t, atlasAPI.Message, "rejected the credentials")
+	})
+
+	t.Run("should fail when Atlas is unreachable", func(t *testing.T) {
+		scheme := checkScheme(t)
+		server := atlasServer(t, http.StatusOK)
+		server.Close()
+		k8sClient := checkClient(t, scheme, nil, nil, credentialsSecret())
+
+		report := Run(context.Background(), k8sClient, scheme, Options{AtlasDomain: server.URL, GlobalAPISecret: globalSecret}, now)
+
+		assert.False(t, report.Ready)
+		atlasAPI := checkByName(t, report, CheckAtlasAPI)
+		assert.Equal(t, StatusFailed, atlasAPI.Status)
+		assert.Contains(t, atlasAPI.Message, "Atlas is unreachable")
+	})
+}
+
+func TestRequiredAccess(t *testing.T) {
+	t.Run("should expand the rules of the role", func(t *testing.T) {
+		role := []byte(`
+rules:
+- apiGroups:
+  - atlas.mongodb.com
+  resources:
+  - atlasprojects
+  - atlasprojects/status
+  verbs:
+  - get
+  - patch
+`)
+
+		attributes, err := requiredAccess(role)
+
+		require.NoError(t, err)
+		assert.Equal(t, []authorizationv1.ResourceAttributes{
+			{Group: "atlas.mongodb.com", Resource: "atlasprojects", Verb: "get"},
+			{Group: "atlas.mongodb.com", Resource: "atlasprojects", Verb: "patch"},
+			{Group: "atlas.mongodb.com", Resource: "atlasprojects", Subresource: "status", Verb: "get"},
+			{Group: "atlas.mongodb.com", Resource: "atlasprojects", Subresource: "status", Verb: "patch"},
+		}, attributes)
+	})
+}
+
+func TestWrite(t *testing.T) {
+	t.Run("should write the report as JSON", func(t *testing.T) {
+		report := &Report{
+			GeneratedAt: "2024-03-01T10:00:00Z",
+			Checks:      []Check{{Name: CheckWebhooks, Status: StatusSkipped}},
+		}
+
+		output := &bytes.Buffer{}
+		require.NoError(t, Write(output, report))
+
+		written := &Report{}
+		require.NoError(t, json.Unmarshal(output.Bytes(), written))
+		assert.Equal(t, report, written)
+	})
+}
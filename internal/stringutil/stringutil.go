@@ -1,6 +1,12 @@
 package stringutil
 
-import "time"
+import (
+	"crypto/rand"
+	"math/big"
+	"time"
+)
+
+const passwordCharacters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
 
 // Contains returns true if there is at least one string in `slice`
 // that is equal to `s`.
@@ -19,3 +25,17 @@ func Contains(slice []string, s string) bool {
 func StringToTime(val string) (time.Time, error) {
 	return time.Parse(time.RFC3339Nano, val)
 }
+
+// GeneratePassword returns a random alphanumeric password of the given length
+func GeneratePassword(length int) (string, error) {
+	password := make([]byte, length)
+	for i := range password {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(passwordCharacters))))
+		if err != nil {
+			return "", err
+		}
+		password[i] = passwordCharacters[n.Int64()]
+	}
+
+	return string(password), nil
+}
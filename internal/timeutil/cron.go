@@ -0,0 +1,152 @@
+package timeutil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSearchLimit bounds the search of the next activation of a cron schedule, which never matches if it names a day
+// that doesn't exist, e.g. "0 0 30 2 *"
+const cronSearchLimit = 5 * 365 * 24 * time.Hour
+
+// CronSchedule is a standard cron expression made of the minute, hour, day of month, month and day of week fields.
+// Each field is either "*" or a comma separated list of values, ranges "a-b" and steps "*/n" or "a-b/n". As in
+// cron, a day matches either the day of month or the day of week when both are restricted
+type CronSchedule struct {
+	minute, hour, dayOfMonth, month, dayOfWeek uint64
+
+	anyDayOfMonth, anyDayOfWeek bool
+}
+
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	// 7 is Sunday as well
+	{name: "day of week", min: 0, max: 7},
+}
+
+// ParseCron parses a standard cron expression, see CronSchedule
+func ParseCron(expression string) (*CronSchedule, error) {
+	fields := strings.Fields(expression)
+	if len(fields) != len(cronFields) {
+		return nil, fmt.Errorf("cron expression %q must have %d fields, got %d", expression, len(cronFields), len(fields))
+	}
+
+	bits := make([]uint64, len(fields))
+	for i, field := range fields {
+		var err error
+		if bits[i], err = parseCronField(field, cronFields[i]); err != nil {
+			return nil, fmt.Errorf("cron expression %q is invalid: %w", expression, err)
+		}
+	}
+
+	// Sunday is both 0 and 7
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+	}
+
+	return &CronSchedule{
+		minute:        bits[0],
+		hour:          bits[1],
+		dayOfMonth:    bits[2],
+		month:         bits[3],
+		dayOfWeek:     bits[4],
+		anyDayOfMonth: fields[2] == "*",
+		anyDayOfWeek:  fields[4] == "*",
+	}, nil
+}
+
+// Next returns the first activation of the schedule strictly after the given time, in its location. The zero time is
+// returned when the schedule never activates
+func (s *CronSchedule) Next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(cronSearchLimit)
+	for t.Before(limit) {
+		switch {
+		case !hasBit(s.month, int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case !hasBit(s.hour, t.Hour()):
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case !hasBit(s.minute, t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+func (s *CronSchedule) dayMatches(t time.Time) bool {
+	dayOfMonth := hasBit(s.dayOfMonth, t.Day())
+	dayOfWeek := hasBit(s.dayOfWeek, int(t.Weekday()))
+	if s.anyDayOfMonth || s.anyDayOfWeek {
+		return dayOfMonth && dayOfWeek
+	}
+
+	return dayOfMonth || dayOfWeek
+}
+
+func parseCronField(field string, spec cronField) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		rangeExpr, stepExpr, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepExpr); err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid step %q of the %s", stepExpr, spec.name)
+			}
+		}
+
+		low, high := spec.min, spec.max
+		if rangeExpr != "*" {
+			lowExpr, highExpr, isRange := strings.Cut(rangeExpr, "-")
+			var err error
+			if low, err = parseCronValue(lowExpr, spec); err != nil {
+				return 0, err
+			}
+			high = low
+			if isRange {
+				if high, err = parseCronValue(highExpr, spec); err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				high = spec.max
+			}
+			if low > high {
+				return 0, fmt.Errorf("invalid range %q of the %s", rangeExpr, spec.name)
+			}
+		}
+
+		for v := low; v <= high; v += step {
+			bits |= 1 << v
+		}
+	}
+
+	return bits, nil
+}
+
+func parseCronValue(expr string, spec cronField) (int, error) {
+	v, err := strconv.Atoi(expr)
+	if err != nil || v < spec.min || v > spec.max {
+		return 0, fmt.Errorf("invalid %s %q, expected a value between %d and %d", spec.name, expr, spec.min, spec.max)
+	}
+
+	return v, nil
+}
+
+func hasBit(bits uint64, v int) bool {
+	return bits&(1<<v) != 0
+}
@@ -0,0 +1,48 @@
+package timeutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCronSchedule(t *testing.T) {
+	// a Wednesday
+	now := time.Date(2024, time.January, 10, 10, 30, 45, 0, time.UTC)
+	tests := []struct {
+		name       string
+		expression string
+		expected   time.Time
+	}{
+		{name: "every minute", expression: "* * * * *", expected: time.Date(2024, time.January, 10, 10, 31, 0, 0, time.UTC)},
+		{name: "daily", expression: "0 3 * * *", expected: time.Date(2024, time.January, 11, 3, 0, 0, 0, time.UTC)},
+		{name: "later today", expression: "15 12 * * *", expected: time.Date(2024, time.January, 10, 12, 15, 0, 0, time.UTC)},
+		{name: "steps", expression: "*/20 * * * *", expected: time.Date(2024, time.January, 10, 10, 40, 0, 0, time.UTC)},
+		{name: "range with steps", expression: "0 8-18/4 * * *", expected: time.Date(2024, time.January, 10, 12, 0, 0, 0, time.UTC)},
+		{name: "lists", expression: "0 0 1,15 * *", expected: time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC)},
+		{name: "Sunday as 7", expression: "0 0 * * 7", expected: time.Date(2024, time.January, 14, 0, 0, 0, 0, time.UTC)},
+		{name: "weekdays", expression: "0 9 * * 1-5", expected: time.Date(2024, time.January, 11, 9, 0, 0, 0, time.UTC)},
+		{name: "either day of month or day of week", expression: "0 0 20 * 5", expected: time.Date(2024, time.January, 12, 0, 0, 0, 0, time.UTC)},
+		{name: "next year", expression: "0 0 1 1 *", expected: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "leap day", expression: "0 0 29 2 *", expected: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{name: "never", expression: "0 0 30 2 *", expected: time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseCron(tt.expression)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, schedule.Next(now))
+		})
+	}
+}
+
+func TestParseCronInvalid(t *testing.T) {
+	for _, expression := range []string{"", "* * * *", "* * * * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "*/0 * * * *", "5-1 * * * *", "a * * * *"} {
+		t.Run(expression, func(t *testing.T) {
+			_, err := ParseCron(expression)
+			assert.Error(t, err)
+		})
+	}
+}
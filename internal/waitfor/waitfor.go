@@ -0,0 +1,196 @@
+// Package waitfor implements the "wait-for-atlas" mode of the Operator binary. It blocks until an Atlas Custom Resource
+// is ready and its connection secret exists, and is intended to be used as an initContainer by applications that must
+// not start before the database exists.
+package waitfor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/connectionsecret"
+)
+
+const (
+	KindAtlasDeployment   = "AtlasDeployment"
+	KindAtlasDatabaseUser = "AtlasDatabaseUser"
+)
+
+// Target describes the resource to wait for
+type Target struct {
+	Kind      string
+	Name      string
+	Namespace string
+	// SecretName is the name of the connection secret to wait for. If empty, any connection secret created for the
+	// resource is enough
+	SecretName string
+}
+
+func (t Target) String() string {
+	return fmt.Sprintf("%s %s", t.Kind, kube.ObjectKey(t.Namespace, t.Name))
+}
+
+// Validate checks that the target references a supported kind
+func (t Target) Validate() error {
+	if t.Kind != KindAtlasDeployment && t.Kind != KindAtlasDatabaseUser {
+		return fmt.Errorf("unsupported kind %q, must be one of %s, %s", t.Kind, KindAtlasDeployment, KindAtlasDatabaseUser)
+	}
+	if t.Name == "" {
+		return fmt.Errorf("the name of the %s must be provided", t.Kind)
+	}
+	if t.Namespace == "" {
+		return fmt.Errorf("the namespace of the %s must be provided", t.Kind)
+	}
+
+	return nil
+}
+
+// Wait polls the target every 'interval' until it's ready or 'timeout' is reached. Transient errors of the API server
+// (server errors, timeouts, throttling, failed connections) don't stop the polling, any other error does
+func Wait(ctx context.Context, k8sClient client.Client, target Target, interval, timeout time.Duration, log *zap.SugaredLogger) error {
+	var lastErr error
+	err := wait.PollUntilContextTimeout(ctx, interval, timeout, true, func(ctx context.Context) (bool, error) {
+		ready, reason, err := IsReady(ctx, k8sClient, target)
+		if err != nil {
+			if !isTransient(err) {
+				return false, err
+			}
+			lastErr = err
+			log.Infof("failed to check if %s is ready, retrying: %v", target, err)
+			return false, nil
+		}
+		if !ready {
+			log.Infof("%s is not ready yet: %s", target, reason)
+		}
+
+		return ready, nil
+	})
+	if err != nil && lastErr != nil && wait.Interrupted(err) {
+		return fmt.Errorf("%w (last error: %v)", err, lastErr)
+	}
+
+	return err
+}
+
+// isTransient returns true if the error may go away on a later attempt
+func isTransient(err error) bool {
+	if apiErrors.IsInternalError(err) || apiErrors.IsServerTimeout(err) || apiErrors.IsTimeout(err) ||
+		apiErrors.IsServiceUnavailable(err) || apiErrors.IsTooManyRequests(err) || apiErrors.IsUnexpectedServerError(err) {
+		return true
+	}
+
+	var statusErr apiErrors.APIStatus
+	if errors.As(err, &statusErr) && statusErr.Status().Code >= http.StatusInternalServerError {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// IsReady returns true if the target resource is Ready and its connection secret exists. If not ready, the returned
+// string explains what is still missing.
+func IsReady(ctx context.Context, k8sClient client.Client, target Target) (bool, string, error) {
+	resource, err := newResource(target.Kind)
+	if err != nil {
+		return false, "", err
+	}
+	if err = k8sClient.Get(ctx, kube.ObjectKey(target.Namespace, target.Name), resource); err != nil {
+		if apiErrors.IsNotFound(err) {
+			return false, "the resource doesn't exist", nil
+		}
+		return false, "", err
+	}
+
+	if !isResourceReady(resource) {
+		return false, "the resource is not in Ready state", nil
+	}
+
+	return secretExists(ctx, k8sClient, target, resource)
+}
+
+func newResource(kind string) (mdbv1.AtlasCustomResource, error) {
+	switch kind {
+	case KindAtlasDeployment:
+		return &mdbv1.AtlasDeployment{}, nil
+	case KindAtlasDatabaseUser:
+		return &mdbv1.AtlasDatabaseUser{}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported kind %q", kind)
+}
+
+// isResourceReady returns true if the operator has seen the latest generation of the resource and marked it as Ready
+func isResourceReady(resource mdbv1.AtlasCustomResource) bool {
+	if resource.GetStatus().GetObservedGeneration() != resource.GetGeneration() {
+		return false
+	}
+
+	for _, condition := range resource.GetStatus().GetConditions() {
+		if condition.Type == status.ReadyType {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+func secretExists(ctx context.Context, k8sClient client.Client, target Target, resource mdbv1.AtlasCustomResource) (bool, string, error) {
+	if target.SecretName != "" {
+		secret := &corev1.Secret{}
+		if err := k8sClient.Get(ctx, kube.ObjectKey(target.Namespace, target.SecretName), secret); err != nil {
+			if apiErrors.IsNotFound(err) {
+				return false, fmt.Sprintf("the secret %s doesn't exist", target.SecretName), nil
+			}
+			return false, "", err
+		}
+
+		return true, "", nil
+	}
+
+	var secrets []corev1.Secret
+	switch r := resource.(type) {
+	case *mdbv1.AtlasDeployment:
+		projectID, err := readProjectID(ctx, k8sClient, r.AtlasProjectObjectKey())
+		if err != nil {
+			return false, "", err
+		}
+		if secrets, err = connectionsecret.ListByDeploymentName(ctx, k8sClient, target.Namespace, projectID, r.GetDeploymentName()); err != nil {
+			return false, "", err
+		}
+	case *mdbv1.AtlasDatabaseUser:
+		projectID, err := readProjectID(ctx, k8sClient, r.AtlasProjectObjectKey())
+		if err != nil {
+			return false, "", err
+		}
+		if secrets, err = connectionsecret.ListByUserName(ctx, k8sClient, target.Namespace, projectID, r.AtlasUsername()); err != nil {
+			return false, "", err
+		}
+	}
+	if len(secrets) == 0 {
+		return false, "no connection secret exists yet", nil
+	}
+
+	return true, "", nil
+}
+
+func readProjectID(ctx context.Context, k8sClient client.Client, projectKey client.ObjectKey) (string, error) {
+	project := &mdbv1.AtlasProject{}
+	if err := k8sClient.Get(ctx, projectKey, project); err != nil {
+		return "", fmt.Errorf("failed to read the AtlasProject %s: %w", projectKey, err)
+	}
+
+	return project.ID(), nil
+}
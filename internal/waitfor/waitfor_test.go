@@ -0,0 +1,201 @@
+package waitfor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/connectionsecret"
+)
+
+func TestTargetValidate(t *testing.T) {
+	assert.NoError(t, Target{Kind: KindAtlasDeployment, Name: "d", Namespace: "ns"}.Validate())
+	assert.NoError(t, Target{Kind: KindAtlasDatabaseUser, Name: "u", Namespace: "ns"}.Validate())
+	assert.ErrorContains(t, Target{Kind: "AtlasProject", Name: "p", Namespace: "ns"}.Validate(), "unsupported kind")
+	assert.ErrorContains(t, Target{Kind: KindAtlasDeployment, Namespace: "ns"}.Validate(), "name")
+	assert.ErrorContains(t, Target{Kind: KindAtlasDeployment, Name: "d"}.Validate(), "namespace")
+}
+
+func TestIsReady(t *testing.T) {
+	t.Run("not ready when the resource doesn't exist", func(t *testing.T) {
+		ready, reason, err := IsReady(context.Background(), newClient(t), Target{Kind: KindAtlasDeployment, Name: "cluster", Namespace: "ns"})
+		require.NoError(t, err)
+		assert.False(t, ready)
+		assert.Contains(t, reason, "doesn't exist")
+	})
+
+	t.Run("not ready when the generation wasn't observed yet", func(t *testing.T) {
+		deployment := readyDeployment()
+		deployment.Generation = 2
+		ready, reason, err := IsReady(context.Background(), newClient(t, deployment), Target{Kind: KindAtlasDeployment, Name: "cluster", Namespace: "ns"})
+		require.NoError(t, err)
+		assert.False(t, ready)
+		assert.Contains(t, reason, "Ready state")
+	})
+
+	t.Run("not ready when the connection secret doesn't exist", func(t *testing.T) {
+		ready, reason, err := IsReady(context.Background(), newClient(t, readyDeployment(), project()), Target{Kind: KindAtlasDeployment, Name: "cluster", Namespace: "ns"})
+		require.NoError(t, err)
+		assert.False(t, ready)
+		assert.Contains(t, reason, "connection secret")
+	})
+
+	t.Run("ready when the deployment is ready and has a connection secret", func(t *testing.T) {
+		ready, _, err := IsReady(context.Background(), newClient(t, readyDeployment(), project(), connectionSecret()), Target{Kind: KindAtlasDeployment, Name: "cluster", Namespace: "ns"})
+		require.NoError(t, err)
+		assert.True(t, ready)
+	})
+
+	t.Run("ready when the database user is ready and has a connection secret", func(t *testing.T) {
+		ready, _, err := IsReady(context.Background(), newClient(t, readyUser(), project(), connectionSecret()), Target{Kind: KindAtlasDatabaseUser, Name: "user", Namespace: "ns"})
+		require.NoError(t, err)
+		assert.True(t, ready)
+	})
+
+	t.Run("waits for the named secret when provided", func(t *testing.T) {
+		target := Target{Kind: KindAtlasDatabaseUser, Name: "user", Namespace: "ns", SecretName: "other"}
+		ready, reason, err := IsReady(context.Background(), newClient(t, readyUser(), project(), connectionSecret()), target)
+		require.NoError(t, err)
+		assert.False(t, ready)
+		assert.Contains(t, reason, "other")
+	})
+}
+
+func TestWait(t *testing.T) {
+	t.Run("returns once the resource is ready", func(t *testing.T) {
+		err := Wait(context.Background(), newClient(t, readyDeployment(), project(), connectionSecret()),
+			Target{Kind: KindAtlasDeployment, Name: "cluster", Namespace: "ns"}, time.Millisecond, time.Second, zaptest.NewLogger(t).Sugar())
+		assert.NoError(t, err)
+	})
+
+	t.Run("times out when the resource never gets ready", func(t *testing.T) {
+		err := Wait(context.Background(), newClient(t),
+			Target{Kind: KindAtlasDeployment, Name: "cluster", Namespace: "ns"}, time.Millisecond, time.Millisecond*20, zaptest.NewLogger(t).Sugar())
+		assert.Error(t, err)
+	})
+
+	t.Run("keeps waiting through transient errors", func(t *testing.T) {
+		k8sClient := failingClient(t, 3, apiErrors.NewServiceUnavailable("the server is restarting"),
+			readyDeployment(), project(), connectionSecret())
+		err := Wait(context.Background(), k8sClient,
+			Target{Kind: KindAtlasDeployment, Name: "cluster", Namespace: "ns"}, time.Millisecond, time.Second, zaptest.NewLogger(t).Sugar())
+		assert.NoError(t, err)
+	})
+
+	t.Run("reports the last transient error on timeout", func(t *testing.T) {
+		k8sClient := failingClient(t, -1, apiErrors.NewTimeoutError("the request timed out", 1))
+		err := Wait(context.Background(), k8sClient,
+			Target{Kind: KindAtlasDeployment, Name: "cluster", Namespace: "ns"}, time.Millisecond, time.Millisecond*20, zaptest.NewLogger(t).Sugar())
+		assert.ErrorContains(t, err, "the request timed out")
+	})
+
+	t.Run("stops on a terminal error", func(t *testing.T) {
+		forbidden := apiErrors.NewForbidden(schema.GroupResource{Resource: "atlasdeployments"}, "cluster", errors.New("no access"))
+		k8sClient := failingClient(t, -1, forbidden)
+		err := Wait(context.Background(), k8sClient,
+			Target{Kind: KindAtlasDeployment, Name: "cluster", Namespace: "ns"}, time.Millisecond, time.Minute, zaptest.NewLogger(t).Sugar())
+		assert.True(t, apiErrors.IsForbidden(err))
+	})
+}
+
+func TestIsTransient(t *testing.T) {
+	assert.True(t, isTransient(apiErrors.NewInternalError(errors.New("boom"))))
+	assert.True(t, isTransient(apiErrors.NewServiceUnavailable("unavailable")))
+	assert.True(t, isTransient(apiErrors.NewTooManyRequests("slow down", 1)))
+	assert.True(t, isTransient(apiErrors.NewGenericServerResponse(502, "get", schema.GroupResource{}, "cluster", "", 0, false)))
+	assert.False(t, isTransient(apiErrors.NewForbidden(schema.GroupResource{}, "cluster", errors.New("no access"))))
+	assert.False(t, isTransient(errors.New("unsupported kind")))
+}
+
+func newClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, mdbv1.AddToScheme(scheme))
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+// failingClient returns a client whose Get calls fail with 'err' the first 'failures' times, or always if negative
+func failingClient(t *testing.T, failures int, err error, objects ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, mdbv1.AddToScheme(scheme))
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			if failures != 0 {
+				failures--
+				return err
+			}
+			return c.Get(ctx, key, obj, opts...)
+		},
+	}).Build()
+}
+
+func readyConditions() status.Common {
+	return status.Common{
+		Conditions:         []status.Condition{status.TrueCondition(status.ReadyType)},
+		ObservedGeneration: 1,
+	}
+}
+
+func readyDeployment() *mdbv1.AtlasDeployment {
+	deployment := mdbv1.DefaultAWSDeployment("ns", "project").WithName("cluster").WithAtlasName("cluster")
+	deployment.Generation = 1
+	deployment.Status.Common = readyConditions()
+
+	return deployment
+}
+
+func readyUser() *mdbv1.AtlasDatabaseUser {
+	user := &mdbv1.AtlasDatabaseUser{
+		ObjectMeta: metav1.ObjectMeta{Name: "user", Namespace: "ns", Generation: 1},
+		Spec: mdbv1.AtlasDatabaseUserSpec{
+			Project:  common.ResourceRefNamespaced{Name: "project"},
+			Username: "admin",
+		},
+	}
+	user.Status.Common = readyConditions()
+
+	return user
+}
+
+func project() *mdbv1.AtlasProject {
+	return &mdbv1.AtlasProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "project", Namespace: "ns"},
+		Status:     status.AtlasProjectStatus{ID: "project-id"},
+	}
+}
+
+func connectionSecret() *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "project-cluster-admin",
+			Namespace: "ns",
+			Labels: map[string]string{
+				connectionsecret.TypeLabelKey:    connectionsecret.CredLabelVal,
+				connectionsecret.ProjectLabelKey: "project-id",
+				connectionsecret.ClusterLabelKey: "cluster",
+			},
+		},
+		Data: map[string][]byte{"username": []byte("admin")},
+	}
+}
@@ -191,6 +191,17 @@ type Notification struct {
 	// Secret containing a VictorOps API key and Routing key. Populated for the VICTOR_OPS notifications type. If the key later becomes invalid, Atlas sends an email to the project owner and eventually removes the key.
 	// +optional
 	VictorOpsSecretRef common.ResourceRefNamespaced `json:"victorOpsSecretRef,omitempty"`
+	webhookURL         string
+	webhookSecret      string
+	// Secret containing the URL of the webhook, under the WebhookURL key, and optionally the secret used to sign its
+	// requests, under the WebhookSecret key. Populated for the WEBHOOK notifications type.
+	// +optional
+	WebhookSecretRef         common.ResourceRefNamespaced `json:"webhookSecretRef,omitempty"`
+	microsoftTeamsWebhookURL string
+	// Secret containing the Microsoft Teams incoming webhook URL under the MicrosoftTeamsWebhookURL key. Populated for
+	// the MICROSOFT_TEAMS notifications type.
+	// +optional
+	MicrosoftTeamsWebhookURLRef common.ResourceRefNamespaced `json:"microsoftTeamsWebhookUrlRef,omitempty"`
 	// The following roles grant privileges within a project.
 	Roles []string `json:"roles,omitempty"`
 }
@@ -223,6 +234,18 @@ func (in *Notification) SetVictorOpsRoutingKey(token string) {
 	in.victorOpsRoutingKey = token
 }
 
+func (in *Notification) SetWebhookURL(url string) {
+	in.webhookURL = url
+}
+
+func (in *Notification) SetWebhookSecret(secret string) {
+	in.webhookSecret = secret
+}
+
+func (in *Notification) SetMicrosoftTeamsWebhookURL(url string) {
+	in.microsoftTeamsWebhookURL = url
+}
+
 func (in *Notification) IsEqual(notification mongodbatlas.Notification) bool {
 	if in == nil {
 		return false
@@ -248,7 +271,10 @@ func (in *Notification) IsEqual(notification mongodbatlas.Notification) bool {
 		in.TypeName != notification.TypeName ||
 		in.Username != notification.Username ||
 		in.victorOpsAPIKey != notification.VictorOpsAPIKey ||
-		in.victorOpsRoutingKey != notification.VictorOpsRoutingKey {
+		in.victorOpsRoutingKey != notification.VictorOpsRoutingKey ||
+		in.webhookURL != notification.WebhookURL ||
+		in.webhookSecret != notification.WebhookSecret ||
+		in.microsoftTeamsWebhookURL != notification.MicrosoftTeamsWebhookURL {
 		return false
 	}
 
@@ -272,6 +298,9 @@ func (in *Notification) ToAtlas() (*mongodbatlas.Notification, error) {
 	result.ServiceKey = in.serviceKey
 	result.VictorOpsAPIKey = in.victorOpsAPIKey
 	result.VictorOpsRoutingKey = in.victorOpsRoutingKey
+	result.WebhookURL = in.webhookURL
+	result.WebhookSecret = in.webhookSecret
+	result.MicrosoftTeamsWebhookURL = in.microsoftTeamsWebhookURL
 	return result, nil
 }
 
@@ -0,0 +1,119 @@
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/timeutil"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasAccessRequest{}, &AtlasAccessRequestList{})
+}
+
+// AtlasAccessRequestSpec defines the elevated roles temporarily granted to a database user
+type AtlasAccessRequestSpec struct {
+	// DatabaseUserRef is a reference to the AtlasDatabaseUser the roles are granted to, in the namespace of the request.
+	DatabaseUserRef common.ResourceRef `json:"databaseUserRef"`
+
+	// Roles are the roles granted to the database user on top of its own roles until the access expires.
+	// Only the roles allowed by the Operator configuration can be granted.
+	// +kubebuilder:validation:MinItems=1
+	Roles []RoleSpec `json:"roles"`
+
+	// Duration is how long the roles are granted once the request is approved, e.g. "2h".
+	Duration metav1.Duration `json:"duration"`
+
+	// Reason is the justification of the request, reviewed by the approver.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// AtlasAccessRequest is the Schema for the atlasaccessrequests API.
+// The request is approved by patching its status with the approval (e.g. kubectl patch --subresource=status), so
+// that the permission to approve is the permission to update the atlasaccessrequests/status subresource.
+// The roles are granted for the spec at the time of the approval, later changes to the spec are ignored
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories={atlas,atlas-security}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="User",type=string,JSONPath=`.spec.databaseUserRef.name`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Expires At",type=string,JSONPath=`.status.expiresAt`
+type AtlasAccessRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasAccessRequestSpec          `json:"spec,omitempty"`
+	Status status.AtlasAccessRequestStatus `json:"status,omitempty"`
+}
+
+func (r *AtlasAccessRequest) DatabaseUserObjectKey() client.ObjectKey {
+	return client.ObjectKey{Name: r.Spec.DatabaseUserRef.Name, Namespace: r.Namespace}
+}
+
+func (r *AtlasAccessRequest) GetStatus() status.Status {
+	return r.Status
+}
+
+func (r *AtlasAccessRequest) UpdateStatus(conditions []status.Condition, options ...status.Option) {
+	r.Status.Conditions = conditions
+	r.Status.ObservedGeneration = r.ObjectMeta.Generation
+
+	for _, o := range options {
+		// This will fail if the Option passed is incorrect - which is expected
+		v := o.(status.AtlasAccessRequestStatusOption)
+		v(&r.Status)
+	}
+}
+
+// GrantExpiresAt returns the time the granted roles are revoked. The status is written when approving the request, so
+// its expiry time is bounded by the requested duration: the earliest of the recorded expiry and the grant time plus the
+// duration of the spec is returned
+func (r *AtlasAccessRequest) GrantExpiresAt() (time.Time, error) {
+	grantedAt, err := timeutil.ParseISO8601(r.Status.GrantedAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid grant time: %w", err)
+	}
+
+	expiresAt, err := timeutil.ParseISO8601(r.Status.ExpiresAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expiry time: %w", err)
+	}
+
+	if bounded := grantedAt.Add(r.Spec.Duration.Duration); bounded.Before(expiresAt) {
+		return bounded, nil
+	}
+
+	return expiresAt, nil
+}
+
+// GrantedSpecRoles returns the granted roles of the status which are also requested by the spec, so that the roles
+// can't be extended by writing the status
+func (r *AtlasAccessRequest) GrantedSpecRoles() []RoleSpec {
+	var roles []RoleSpec
+	for _, granted := range r.Status.GrantedRoles {
+		role := RoleSpec{RoleName: granted.RoleName, DatabaseName: granted.DatabaseName, CollectionName: granted.CollectionName}
+		for _, requested := range r.Spec.Roles {
+			if requested == role {
+				roles = append(roles, role)
+				break
+			}
+		}
+	}
+
+	return roles
+}
+
+// AtlasAccessRequestList contains a list of AtlasAccessRequest
+// +kubebuilder:object:root=true
+type AtlasAccessRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasAccessRequest `json:"items"`
+}
@@ -40,6 +40,7 @@ type AtlasBackupPolicyItem struct {
 
 // AtlasBackupPolicy is the Schema for the atlasbackuppolicies API
 // +kubebuilder:object:root=true
+// +kubebuilder:resource:categories={atlas,atlas-data}
 // +kubebuilder:subresource:status
 type AtlasBackupPolicy struct {
 	metav1.TypeMeta   `json:",inline"`
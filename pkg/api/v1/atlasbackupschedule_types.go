@@ -9,7 +9,9 @@ a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
 package v1
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
 	"go.mongodb.org/atlas/mongodbatlas"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,18 +35,26 @@ type AtlasBackupScheduleSpec struct {
 	// A reference (name & namespace) for backup policy in the desired updated backup policy.
 	PolicyRef common.ResourceRefNamespaced `json:"policy"`
 
-	// UTC Hour of day between 0 and 23, inclusive, representing which hour of the day that Atlas takes snapshots for backup policy items
+	// Hour of day between 0 and 23, inclusive, representing which hour of the day that Atlas takes snapshots for backup policy items.
+	// It is expressed in the timeZone, UTC by default
 	// +kubebuilder:validation:Minimum:=0
 	// +kubebuilder:validation:Maximum:=23
 	// +optional
 	ReferenceHourOfDay int64 `json:"referenceHourOfDay,omitempty"`
 
-	// UTC Minutes after ReferenceHourOfDay that Atlas takes snapshots for backup policy items. Must be between 0 and 59, inclusive.
+	// Minutes after ReferenceHourOfDay that Atlas takes snapshots for backup policy items. Must be between 0 and 59, inclusive.
+	// It is expressed in the timeZone, UTC by default
 	// +kubebuilder:validation:Minimum:=0
 	// +kubebuilder:validation:Maximum:=59
 	// +optional
 	ReferenceMinuteOfHour int64 `json:"referenceMinuteOfHour,omitempty"`
 
+	// TimeZone is the IANA name of the time zone referenceHourOfDay and referenceMinuteOfHour are expressed in, e.g.
+	// "Europe/Paris". Atlas only accepts a reference time in UTC, the Operator converts it with the offset of the time
+	// zone at the next snapshot and updates Atlas when the daylight saving time starts or ends. Defaults to UTC
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
 	// Number of days back in time you can restore to with Continuous Cloud Backup accuracy. Must be a positive, non-zero integer. Applies to continuous cloud backups only.
 	// +optional
 	// +kubebuilder:default:=1
@@ -87,6 +97,7 @@ type CopySetting struct {
 
 // AtlasBackupSchedule is the Schema for the atlasbackupschedules API
 // +kubebuilder:object:root=true
+// +kubebuilder:resource:categories={atlas,atlas-data}
 // +kubebuilder:subresource:status
 type AtlasBackupSchedule struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -97,6 +108,30 @@ type AtlasBackupSchedule struct {
 	Status status.BackupScheduleStatus `json:"status,omitempty"`
 }
 
+// ReferenceTimeUTC returns the UTC hour and minute of the first snapshot of the schedule after the given time, with
+// the offset of the time zone of the schedule in effect then. It also returns the end of this offset, when the UTC
+// reference time changes, or the zero time when the time zone has no daylight saving time
+func (in *AtlasBackupSchedule) ReferenceTimeUTC(now time.Time) (int64, int64, time.Time, error) {
+	if in.Spec.TimeZone == "" {
+		return in.Spec.ReferenceHourOfDay, in.Spec.ReferenceMinuteOfHour, time.Time{}, nil
+	}
+
+	location, err := time.LoadLocation(in.Spec.TimeZone)
+	if err != nil {
+		return 0, 0, time.Time{}, fmt.Errorf("invalid timeZone %q: %w", in.Spec.TimeZone, err)
+	}
+
+	local := now.In(location)
+	next := time.Date(local.Year(), local.Month(), local.Day(), int(in.Spec.ReferenceHourOfDay), int(in.Spec.ReferenceMinuteOfHour), 0, 0, location)
+	if !next.After(now) {
+		next = time.Date(local.Year(), local.Month(), local.Day()+1, int(in.Spec.ReferenceHourOfDay), int(in.Spec.ReferenceMinuteOfHour), 0, 0, location)
+	}
+	_, offsetEnd := next.ZoneBounds()
+	utc := next.UTC()
+
+	return int64(utc.Hour()), int64(utc.Minute()), offsetEnd, nil
+}
+
 func (in *AtlasBackupSchedule) ToAtlas(clusterID, clusterName, replicaSetID string, policy *AtlasBackupPolicy) *mongodbatlas.CloudProviderSnapshotBackupPolicy {
 	atlasPolicy := mongodbatlas.Policy{}
 
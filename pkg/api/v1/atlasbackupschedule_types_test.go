@@ -2,8 +2,11 @@ package v1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.mongodb.org/atlas/mongodbatlas"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
@@ -67,3 +70,51 @@ func Test_BackupScheduleToAtlas(t *testing.T) {
 		}
 	})
 }
+
+func TestBackupScheduleReferenceTimeUTC(t *testing.T) {
+	schedule := func(timeZone string) *AtlasBackupSchedule {
+		return &AtlasBackupSchedule{Spec: AtlasBackupScheduleSpec{ReferenceHourOfDay: 2, ReferenceMinuteOfHour: 30, TimeZone: timeZone}}
+	}
+
+	t.Run("should keep a reference time in UTC", func(t *testing.T) {
+		hour, minute, change, err := schedule("").ReferenceTimeUTC(time.Now())
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), hour)
+		assert.Equal(t, int64(30), minute)
+		assert.True(t, change.IsZero())
+	})
+
+	t.Run("should convert a reference time in winter time", func(t *testing.T) {
+		hour, minute, change, err := schedule("Europe/Paris").ReferenceTimeUTC(time.Date(2024, time.January, 10, 12, 0, 0, 0, time.UTC))
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), hour)
+		assert.Equal(t, int64(30), minute)
+		assert.True(t, time.Date(2024, time.March, 31, 1, 0, 0, 0, time.UTC).Equal(change))
+	})
+
+	t.Run("should convert a reference time in summer time", func(t *testing.T) {
+		hour, _, change, err := schedule("Europe/Paris").ReferenceTimeUTC(time.Date(2024, time.July, 10, 12, 0, 0, 0, time.UTC))
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), hour)
+		assert.True(t, time.Date(2024, time.October, 27, 1, 0, 0, 0, time.UTC).Equal(change))
+	})
+
+	t.Run("should use the offset of the next snapshot", func(t *testing.T) {
+		// the snapshot of 01:30 has already been taken in winter time, the next one is in summer time
+		bSchedule := schedule("Europe/Paris")
+		bSchedule.Spec.ReferenceHourOfDay = 1
+		hour, _, _, err := bSchedule.ReferenceTimeUTC(time.Date(2024, time.March, 31, 0, 45, 0, 0, time.UTC))
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(23), hour)
+	})
+
+	t.Run("should refuse an unknown time zone", func(t *testing.T) {
+		_, _, _, err := schedule("Mars/Olympus").ReferenceTimeUTC(time.Now())
+
+		assert.ErrorContains(t, err, "invalid timeZone")
+	})
+}
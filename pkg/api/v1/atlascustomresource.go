@@ -26,3 +26,13 @@ var _ AtlasCustomResource = &AtlasDataFederation{}
 var _ AtlasCustomResource = &AtlasBackupSchedule{}
 var _ AtlasCustomResource = &AtlasBackupPolicy{}
 var _ AtlasCustomResource = &AtlasFederatedAuth{}
+var _ AtlasCustomResource = &AtlasMigration{}
+var _ AtlasCustomResource = &AtlasAccessRequest{}
+var _ AtlasCustomResource = &AtlasSearchIndex{}
+var _ AtlasCustomResource = &AtlasNetworkContainer{}
+var _ AtlasCustomResource = &AtlasPrivateEndpoint{}
+var _ AtlasCustomResource = &AtlasOrgUser{}
+var _ AtlasCustomResource = &AtlasProjectAPIKey{}
+var _ AtlasCustomResource = &AtlasOrganization{}
+var _ AtlasCustomResource = &AtlasResourcePolicy{}
+var _ AtlasCustomResource = &AtlasEnvironment{}
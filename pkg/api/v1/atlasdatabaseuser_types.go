@@ -19,6 +19,8 @@ package v1
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"go.mongodb.org/atlas-sdk/v20231115004/admin"
 
@@ -29,6 +31,7 @@ import (
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/compat"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/stringutil"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
 )
@@ -45,11 +48,38 @@ func init() {
 
 type ScopeType string
 
+const (
+	// DefaultX509MonthsUntilExpiration is the validity of the certificates issued by Atlas for the MANAGED X.509 users
+	DefaultX509MonthsUntilExpiration = 3
+	// DefaultX509RenewBefore is the time before the expiration of a certificate issued by Atlas when it's renewed
+	DefaultX509RenewBefore = 30 * 24 * time.Hour
+)
+
 const (
 	DeploymentScopeType ScopeType = "CLUSTER" // todo: potentially rename to "DEPLOYMENT"
 	DataLakeScopeType   ScopeType = "DATA_LAKE"
 )
 
+const (
+	AWSIAMTypeNone = "NONE"
+	AWSIAMTypeUser = "USER"
+	AWSIAMTypeRole = "ROLE"
+
+	X509TypeNone     = "NONE"
+	X509TypeCustomer = "CUSTOMER"
+	X509TypeManaged  = "MANAGED"
+
+	// ConnectionStringPolicyPublic makes the public SRV connection string the primary one of the connection Secrets
+	ConnectionStringPolicyPublic = "Public"
+	// ConnectionStringPolicyPrivateEndpoint makes a private endpoint SRV connection string the primary one of the
+	// connection Secrets, the public connection strings are left out of them
+	ConnectionStringPolicyPrivateEndpoint = "PrivateEndpoint"
+
+	// ExternalDatabaseName is the authentication database of the users authenticated outside of MongoDB, e.g. with
+	// AWS IAM or X.509 certificates
+	ExternalDatabaseName = "$external"
+)
+
 // AtlasDatabaseUserSpec defines the desired state of Database User in Atlas
 type AtlasDatabaseUserSpec struct {
 	// Project is a reference to AtlasProject resource the user belongs to
@@ -63,6 +93,16 @@ type AtlasDatabaseUserSpec struct {
 	// The specified date must be in the future and within one week.
 	DeleteAfterDate string `json:"deleteAfterDate,omitempty"`
 
+	// Expiration configures how the Operator handles the deleteAfterDate of a temporary user: it reports the user as
+	// expiring shortly before the date and can push the date back automatically
+	// +optional
+	Expiration *DatabaseUserExpirationSpec `json:"expiration,omitempty"`
+
+	// Description is the description of the database user displayed in the Atlas UI.
+	// +kubebuilder:validation:MaxLength:=100
+	// +optional
+	Description string `json:"description,omitempty"`
+
 	// Labels is an array containing key-value pairs that tag and categorize the database user.
 	// Each key and value has a maximum length of 255 characters.
 	Labels []common.LabelSpec `json:"labels,omitempty"`
@@ -75,14 +115,47 @@ type AtlasDatabaseUserSpec struct {
 	// Scopes is an array of clusters and Atlas Data Lakes that this user has access to.
 	Scopes []ScopeSpec `json:"scopes,omitempty"`
 
+	// SkipConnectionSecrets are the deployments the user gets no connection Secret for, although it has access to them.
+	// +optional
+	SkipConnectionSecrets []string `json:"skipConnectionSecrets,omitempty"`
+
+	// ConnectionSecretLabels are added to the connection Secrets of the user, e.g. to carry the ownership of a team.
+	// They take precedence over the labels of the deployment, the labels set by the Operator can't be overridden
+	// +optional
+	ConnectionSecretLabels map[string]string `json:"connectionSecretLabels,omitempty"`
+
+	// ConnectionSecretAnnotations are added to the connection Secrets of the user, e.g. for secret replication or to
+	// restart the workloads on change. They take precedence over the annotations of the deployment. The annotations
+	// removed from this field are left on the existing Secrets
+	// +optional
+	ConnectionSecretAnnotations map[string]string `json:"connectionSecretAnnotations,omitempty"`
+
+	// ConnectionStringPolicy selects the connection string written as the primary one, under the connectionString key,
+	// of the connection Secrets of the user. It takes precedence over the policy of the namespace
+	// +optional
+	ConnectionStringPolicy *ConnectionStringPolicy `json:"connectionStringPolicy,omitempty"`
+
 	// PasswordSecret is a reference to the Secret keeping the user password.
 	PasswordSecret *common.ResourceRef `json:"passwordSecretRef,omitempty"`
 
+	// PasswordStore is a reference to the password kept in an external secret store configured in the Operator,
+	// e.g. HashiCorp Vault, so that it isn't kept in a Secret. Mutually exclusive with passwordSecretRef. It requires a
+	// connectionSecretStoreRef, so that the password is never written to the connection Secrets
+	// +optional
+	PasswordStore *PasswordStoreRef `json:"passwordStoreRef,omitempty"`
+
+	// ConnectionSecretStore publishes the connection credentials of the user to an external secret store configured in
+	// the Operator instead of Secrets, e.g. for the Secrets Store CSI driver to mount them, so that they aren't kept in etcd
+	// +optional
+	ConnectionSecretStore *ConnectionSecretStoreRef `json:"connectionSecretStoreRef,omitempty"`
+
 	// Username is a username for authenticating to MongoDB
 	// Human-readable label that represents the user that authenticates to MongoDB. The format of this label depends on the method of authentication:
 	// In case of AWS IAM: the value should be AWS ARN for the IAM User/Role;
 	// In case of OIDC: the value should be the Identity Provider ID;
-	// In case of Plain text auth: the value can be anything
+	// In case of Plain text auth: the value can be anything. It can be a template expanded with the values .Project,
+	// .Namespace, .Name, .Environment, .Prefix and .Suffix, e.g. "app-{{ .Environment }}". The prefix and suffix
+	// configured in the operator are added to the expanded name unless it places them itself
 	// +kubebuilder:validation:MaxLength:=1024
 	Username string `json:"username"`
 
@@ -98,18 +171,139 @@ type AtlasDatabaseUserSpec struct {
 	// Human-readable label that indicates whether the new database
 	// user authenticates with the Amazon Web Services (AWS)
 	// Identity and Access Management (IAM) credentials associated with
-	// the user or the user's role
+	// the user or the user's role.
+	// AWS IAM users have the ARN of the IAM user or role as username, $external as databaseName and no password.
+	// Their connection secrets hold the connection strings with the MONGODB-AWS authentication mechanism, the
+	// credentials being taken from the environment of the workload, e.g. the role of an EC2 instance or EKS pod
 	// +kubebuilder:default:=NONE
 	// +kubebuilder:validation:Enum:=NONE;USER;ROLE
 	// +optional
 	AWSIAMType string `json:"awsIamType,omitempty"`
 
-	// X509Type is X.509 method by which the database authenticates the provided username
+	// X509Type is X.509 method by which the database authenticates the provided username.
+	// CUSTOMER users authenticate with certificates signed by the CA configured in the project, their username is the
+	// RFC 2253 distinguished name of the certificate subject. MANAGED users authenticate with certificates issued by
+	// Atlas, which the Operator writes to a Secret and renews before they expire.
+	// X.509 users have $external as databaseName and no password
+	// +kubebuilder:validation:Enum:=NONE;CUSTOMER;MANAGED
+	// +optional
 	X509Type string `json:"x509Type,omitempty"`
+
+	// X509Certificate configures the certificate issued by Atlas for the MANAGED X.509 users
+	// +optional
+	X509Certificate *X509CertificateSpec `json:"x509Certificate,omitempty"`
+
+	// PasswordRotation makes the Operator rotate the password of the user periodically. A new password is written to
+	// the Secret referenced by passwordSecretRef and set in Atlas, then all the connection Secrets of the user are
+	// rewritten once the deployments have applied it and a PasswordRotated event is emitted so that their consumers
+	// can restart. The previous password stops working as soon as the new one is set in Atlas
+	// +optional
+	PasswordRotation *PasswordRotationSpec `json:"passwordRotation,omitempty"`
+}
+
+// PasswordStoreRef is a reference to a password kept in an external secret store
+type PasswordStoreRef struct {
+	// Provider is the name of the secret store provider configured in the Operator, e.g. "vault"
+	Provider string `json:"provider"`
+
+	// Path is the path of the secret in the store, relative to <path prefix of the provider>/<namespace>
+	// +kubebuilder:validation:MinLength=1
+	Path string `json:"path"`
+
+	// Key is the key of the password in the secret
+	// +kubebuilder:default:=password
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// ConnectionSecretStoreRef is the path of an external secret store the connection credentials are written under
+type ConnectionSecretStoreRef struct {
+	// Provider is the name of the secret store provider configured in the Operator, e.g. "vault"
+	Provider string `json:"provider"`
+
+	// Path is dedicated to the connection credentials of the user, relative to <path prefix of the provider>/<namespace>.
+	// The credentials of every deployment are written at <path>/<connection Secret name>, the ones written by the
+	// Operator are deleted along with the user
+	// +kubebuilder:validation:MinLength=1
+	Path string `json:"path"`
+}
+
+// PasswordRotationSpec configures the periodic rotation of the password of a database user, exactly one of interval
+// and schedule must be set
+type PasswordRotationSpec struct {
+	// Interval is the time between two rotations of the password, at least one hour, e.g. "720h"
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// Schedule is the cron expression of the rotations of the password evaluated in UTC, made of the minute, hour,
+	// day of month, month and day of week fields, e.g. "0 3 1 * *" for the first day of each month at 03:00
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// PasswordLength is the length of the passwords generated by the Operator
+	// +kubebuilder:validation:Minimum=16
+	// +kubebuilder:validation:Maximum=128
+	// +kubebuilder:default:=32
+	// +optional
+	PasswordLength int `json:"passwordLength,omitempty"`
+}
+
+// DatabaseUserExpirationSpec configures the handling of the deleteAfterDate of a temporary database user
+type DatabaseUserExpirationSpec struct {
+	// WarnBefore is how long before the deleteAfterDate the user is reported as expiring, with the
+	// DatabaseUserExpiring condition and a Warning event, e.g. "48h". Defaults to 24h
+	// +optional
+	WarnBefore *metav1.Duration `json:"warnBefore,omitempty"`
+
+	// AutoExtend makes the Operator push the deleteAfterDate back to this duration from now whenever the user is
+	// expiring, e.g. "72h". Atlas accepts a deleteAfterDate within one week at most
+	// +optional
+	AutoExtend *metav1.Duration `json:"autoExtend,omitempty"`
+
+	// ExtendUntil is a timestamp in ISO 8601 date and time format in UTC after which the deleteAfterDate isn't pushed
+	// back anymore. The user is extended indefinitely when it's empty
+	// +optional
+	ExtendUntil string `json:"extendUntil,omitempty"`
+}
+
+// ConnectionStringPolicy selects the connection string written as the primary one of the connection Secrets
+type ConnectionStringPolicy struct {
+	// Type is the variant of the primary connection string. With PrivateEndpoint, the public connection strings are
+	// left out of the Secrets and the primary one is empty as long as the deployment has no matching private endpoint
+	// +kubebuilder:validation:Enum=Public;PrivateEndpoint
+	Type string `json:"type"`
+
+	// PrivateEndpointID is the ID of the private endpoint in the cloud provider, e.g. vpce-0123456789abcdef0, whose
+	// connection string is the primary one. The first private endpoint is used when it's empty. Only for the
+	// PrivateEndpoint type
+	// +optional
+	PrivateEndpointID string `json:"privateEndpointID,omitempty"`
+}
+
+// X509CertificateSpec configures the certificate issued by Atlas for a MANAGED X.509 user
+type X509CertificateSpec struct {
+	// SecretName is the name of the Secret the certificate and its private key are written to, under the
+	// certificate.pem key. Defaults to <resource name>-x509
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// MonthsUntilExpiration is the validity of the certificates issued by Atlas, in months
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=24
+	// +kubebuilder:default:=3
+	// +optional
+	MonthsUntilExpiration int `json:"monthsUntilExpiration,omitempty"`
+
+	// RenewBefore is the time before the expiration of the certificate when a new one is issued, e.g. "720h".
+	// It must be shorter than the validity of the certificates
+	// +kubebuilder:default:="720h"
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
+// +kubebuilder:resource:categories={atlas,atlas-security}
 // +kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.spec.name`
 // +kubebuilder:subresource:status
 // +groupName:=atlas.mongodb.com
@@ -165,6 +359,54 @@ func (p AtlasDatabaseUser) AtlasProjectObjectKey() client.ObjectKey {
 	return kube.ObjectKey(ns, p.Spec.Project.Name)
 }
 
+// IsAWSIAM returns true if the user authenticates with the credentials of an AWS IAM user or role
+func (p AtlasDatabaseUser) IsAWSIAM() bool {
+	return p.Spec.AWSIAMType == AWSIAMTypeUser || p.Spec.AWSIAMType == AWSIAMTypeRole
+}
+
+// IsX509 returns true if the user authenticates with an X.509 certificate
+func (p AtlasDatabaseUser) IsX509() bool {
+	return p.Spec.X509Type == X509TypeCustomer || p.Spec.X509Type == X509TypeManaged
+}
+
+// ExternalAuthMechanism returns the authentication mechanism set in the connection strings of the users
+// authenticated outside of MongoDB, or an empty string for the users authenticated with a password
+func (p AtlasDatabaseUser) ExternalAuthMechanism() string {
+	switch {
+	case p.IsAWSIAM():
+		return "MONGODB-AWS"
+	case p.IsX509():
+		return "MONGODB-X509"
+	default:
+		return ""
+	}
+}
+
+// X509CertificateSecretObjectKey returns the key of the Secret holding the certificate of a MANAGED X.509 user
+func (p AtlasDatabaseUser) X509CertificateSecretObjectKey() client.ObjectKey {
+	if p.Spec.X509Certificate != nil && p.Spec.X509Certificate.SecretName != "" {
+		return kube.ObjectKey(p.Namespace, p.Spec.X509Certificate.SecretName)
+	}
+
+	return kube.ObjectKey(p.Namespace, p.Name+"-x509")
+}
+
+// X509CertificateSettings returns the validity in months of the certificates issued by Atlas for a MANAGED X.509 user,
+// and the time before their expiration when they are renewed
+func (p AtlasDatabaseUser) X509CertificateSettings() (int, time.Duration) {
+	months, renewBefore := DefaultX509MonthsUntilExpiration, DefaultX509RenewBefore
+	if spec := p.Spec.X509Certificate; spec != nil {
+		if spec.MonthsUntilExpiration > 0 {
+			months = spec.MonthsUntilExpiration
+		}
+		if spec.RenewBefore != nil {
+			renewBefore = spec.RenewBefore.Duration
+		}
+	}
+
+	return months, renewBefore
+}
+
 func (p AtlasDatabaseUser) PasswordSecretObjectKey() *client.ObjectKey {
 	if p.Spec.PasswordSecret != nil {
 		key := kube.ObjectKey(p.Namespace, p.Spec.PasswordSecret.Name)
@@ -194,19 +436,24 @@ func (p *AtlasDatabaseUser) ReadPassword(ctx context.Context, kubeClient client.
 		if err := kubeClient.Get(ctx, *p.PasswordSecretObjectKey(), secret); err != nil {
 			return "", err
 		}
-		p, exist := secret.Data["password"]
-		switch {
-		case !exist:
-			return "", fmt.Errorf("secret %s is invalid: it doesn't contain 'password' field", secret.Name)
-		case len(p) == 0:
-			return "", fmt.Errorf("secret %s is invalid: the 'password' field is empty", secret.Name)
-		default:
-			return string(p), nil
-		}
+		return PasswordFromSecret(secret)
 	}
 	return "", nil
 }
 
+// PasswordFromSecret returns the password kept in the password Secret of a database user
+func PasswordFromSecret(secret *corev1.Secret) (string, error) {
+	p, exist := secret.Data["password"]
+	switch {
+	case !exist:
+		return "", fmt.Errorf("secret %s is invalid: it doesn't contain 'password' field", secret.Name)
+	case len(p) == 0:
+		return "", fmt.Errorf("secret %s is invalid: the 'password' field is empty", secret.Name)
+	default:
+		return string(p), nil
+	}
+}
+
 // ToAtlas converts the AtlasDatabaseUser to native Atlas client format. Reads the password from the Secret
 func (p AtlasDatabaseUser) ToAtlas(ctx context.Context, kubeClient client.Client) (*mongodbatlas.DatabaseUser, error) {
 	password, err := p.ReadPassword(ctx, kubeClient)
@@ -249,6 +496,27 @@ func (p AtlasDatabaseUser) GetScopes(scopeType ScopeType) []string {
 	return scopeClusters
 }
 
+// HasConnectionSecret tells whether a connection Secret of the user is created for the deployment or the Data
+// Federation: a user without scopes gets one for every deployment of the project, a scoped user only for the scoped
+// clusters and data lakes, unless the name is listed in skipConnectionSecrets
+func (p AtlasDatabaseUser) HasConnectionSecret(deploymentName string) bool {
+	if stringutil.Contains(p.Spec.SkipConnectionSecrets, deploymentName) {
+		return false
+	}
+
+	if len(p.Spec.Scopes) == 0 {
+		return true
+	}
+
+	for _, scope := range p.Spec.Scopes {
+		if scope.Name == deploymentName {
+			return true
+		}
+	}
+
+	return false
+}
+
 // ************************************ Builder methods *************************************************
 
 func NewDBUser(namespace, name, dbUserName, projectName string) *AtlasDatabaseUser {
@@ -267,6 +535,16 @@ func NewDBUser(namespace, name, dbUserName, projectName string) *AtlasDatabaseUs
 	}
 }
 
+// AtlasUsername returns the name of the user in Atlas. The expansion of a username template is the one of the last
+// reconciliation
+func (p AtlasDatabaseUser) AtlasUsername() string {
+	if strings.Contains(p.Spec.Username, "{{") && p.Status.UserName != "" {
+		return p.Status.UserName
+	}
+
+	return p.Spec.Username
+}
+
 func (p *AtlasDatabaseUser) WithName(name string) *AtlasDatabaseUser {
 	p.Name = name
 	return p
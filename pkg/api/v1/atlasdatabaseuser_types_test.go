@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasConnectionSecret(t *testing.T) {
+	t.Run("should create the secrets of every deployment for a user without scopes", func(t *testing.T) {
+		user := NewDBUser("ns", "user", "user", "project")
+
+		assert.True(t, user.HasConnectionSecret("cluster"))
+	})
+
+	t.Run("should create the secrets of the scoped data lakes only", func(t *testing.T) {
+		user := NewDBUser("ns", "user", "user", "project").WithScope(DataLakeScopeType, "lake")
+
+		assert.True(t, user.HasConnectionSecret("lake"))
+		assert.False(t, user.HasConnectionSecret("cluster"))
+	})
+
+	t.Run("should create the secrets of the scoped deployments only", func(t *testing.T) {
+		user := NewDBUser("ns", "user", "user", "project").WithScope(DeploymentScopeType, "cluster")
+
+		assert.True(t, user.HasConnectionSecret("cluster"))
+		assert.False(t, user.HasConnectionSecret("other"))
+	})
+
+	t.Run("should skip the deployments opting out", func(t *testing.T) {
+		user := NewDBUser("ns", "user", "user", "project").
+			WithScope(DeploymentScopeType, "cluster").
+			WithScope(DeploymentScopeType, "other")
+		user.Spec.SkipConnectionSecrets = []string{"cluster"}
+
+		assert.False(t, user.HasConnectionSecret("cluster"))
+		assert.True(t, user.HasConnectionSecret("other"))
+	})
+
+	t.Run("should skip the deployments opting out without scopes", func(t *testing.T) {
+		user := NewDBUser("ns", "user", "user", "project")
+		user.Spec.SkipConnectionSecrets = []string{"cluster"}
+
+		assert.False(t, user.HasConnectionSecret("cluster"))
+		assert.True(t, user.HasConnectionSecret("other"))
+	})
+}
@@ -127,6 +127,7 @@ func (pe DataFederationPE) Identifier() interface{} {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
+// +kubebuilder:resource:categories={atlas,atlas-data}
 // +kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.spec.name`
 // +kubebuilder:subresource:status
 // +groupName:=atlas.mongodb.com
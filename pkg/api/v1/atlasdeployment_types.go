@@ -24,6 +24,7 @@ import (
 	"strconv"
 
 	"go.mongodb.org/atlas/mongodbatlas"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -47,12 +48,30 @@ const (
 	TypeGeoSharded DeploymentType = "GEOSHARDED"
 )
 
+const (
+	ConfigServerManagementModeAtlasManaged     = "ATLAS_MANAGED"
+	ConfigServerManagementModeFixedToDedicated = "FIXED_TO_DEDICATED"
+)
+
+const (
+	VersionReleaseSystemLTS        = "LTS"
+	VersionReleaseSystemContinuous = "CONTINUOUS"
+)
+
 // AtlasDeploymentSpec defines the desired state of AtlasDeployment
 // Only one of DeploymentSpec, AdvancedDeploymentSpec and ServerlessSpec should be defined
 type AtlasDeploymentSpec struct {
 	// Project is a reference to AtlasProject resource the deployment belongs to
 	Project common.ResourceRefNamespaced `json:"projectRef"`
 
+	// CreationPolicy controls whether the Operator may create the deployment in Atlas or must only bind to an existing one
+	// with the same name. MustNotExist refuses to take over a deployment created outside of this resource, preventing
+	// duplicates when names drift.
+	// +kubebuilder:validation:Enum=CreateIfMissing;MustExist;MustNotExist
+	// +kubebuilder:default:=CreateIfMissing
+	// +optional
+	CreationPolicy common.CreationPolicy `json:"creationPolicy,omitempty"`
+
 	// Configuration for the advanced (v1.5) deployment API https://www.mongodb.com/docs/atlas/reference/api/clusters/
 	// +optional
 	DeploymentSpec *AdvancedDeploymentSpec `json:"deploymentSpec,omitempty"`
@@ -68,8 +87,131 @@ type AtlasDeploymentSpec struct {
 	// ProcessArgs allows to modify Advanced Configuration Options
 	// +optional
 	ProcessArgs *ProcessArgs `json:"processArgs,omitempty"`
+
+	// ProvisioningTimeout is the maximum duration of the creation or of an update of the deployment in Atlas, e.g. "2h".
+	// Once exceeded, the ProvisioningTimedOut condition is set, a Warning event is emitted and the operator checks the
+	// deployment less frequently until the provisioning completes. No timeout applies when unset.
+	// +optional
+	ProvisioningTimeout *metav1.Duration `json:"provisioningTimeout,omitempty"`
+
+	// ExternalNameService creates an ExternalName Service pointing at the first host of the standard connection string
+	// of the deployment, so that the applications of the cluster can reach it with a stable Kubernetes DNS name.
+	// The Service is removed when this field is unset
+	// +optional
+	ExternalNameService *ExternalNameServiceSpec `json:"externalNameService,omitempty"`
+
+	// EgressConfigMap creates a ConfigMap listing the endpoints reached for the deployment: the Atlas API and the
+	// hostnames and ports of the deployment nodes. It is kept up to date as the deployment changes, to help configuring
+	// the egress rules of clusters restricting the outbound traffic.
+	// The ConfigMap is removed when this field is unset
+	// +optional
+	EgressConfigMap *EgressConfigMapSpec `json:"egressConfigMap,omitempty"`
+
+	// IPAddressReport creates a ConfigMap listing the public IP addresses of the deployment nodes as reported by Atlas,
+	// for the teams maintaining the firewall allow-lists on the application side. It is refreshed periodically and an
+	// event is recorded when the addresses change. Atlas doesn't report the addresses of serverless instances.
+	// The ConfigMap is removed when this field is unset
+	// +optional
+	IPAddressReport *IPAddressReportSpec `json:"ipAddressReport,omitempty"`
+
+	// ConnectionInfo creates a Secret, or a ConfigMap, holding the connection strings of the deployment without the
+	// credentials of any database user, for the tools authenticating with X.509 certificates or AWS IAM which only
+	// need the hosts. The connection string policy of the namespace applies as for the connection Secrets.
+	// The object is removed when this field is unset
+	// +optional
+	ConnectionInfo *ConnectionInfoSpec `json:"connectionInfo,omitempty"`
+
+	// ConnectionSecretLabels are added to the connection Secrets of the database users for this deployment, e.g. to
+	// carry the ownership of a team. The labels set by the Operator can't be overridden
+	// +optional
+	ConnectionSecretLabels map[string]string `json:"connectionSecretLabels,omitempty"`
+
+	// ConnectionSecretAnnotations are added to the connection Secrets of the database users for this deployment, e.g.
+	// for secret replication or to restart the workloads on change. The annotations removed from this field are left
+	// on the existing Secrets
+	// +optional
+	ConnectionSecretAnnotations map[string]string `json:"connectionSecretAnnotations,omitempty"`
+
+	// UnsupportedOverrides is a JSON object merged as is into the requests creating and updating the advanced
+	// deployment in Atlas, for the cluster options the operator doesn't model yet.
+	// UNSUPPORTED: the overrides are neither validated nor compared with Atlas to detect drift, and can't set the
+	// fields of deploymentSpec. They may stop working, or be rejected, with any release of the operator.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	// +optional
+	UnsupportedOverrides *apiextensionsv1.JSON `json:"unsupportedOverrides,omitempty"`
+}
+
+// ExternalNameServiceSpec configures the ExternalName Service of the deployment
+type ExternalNameServiceSpec struct {
+	// Name of the Service, created in the namespace of the AtlasDeployment. Defaults to the name of the AtlasDeployment.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Labels added to the Service.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations added to the Service.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// EgressConfigMapSpec configures the ConfigMap listing the endpoints of the deployment
+type EgressConfigMapSpec struct {
+	// Name of the ConfigMap, created in the namespace of the AtlasDeployment.
+	// Defaults to the name of the AtlasDeployment followed by "-egress".
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-.a-z0-9]*[a-z0-9])?$`
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Labels added to the ConfigMap.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// IPAddressReportSpec configures the ConfigMap listing the public IP addresses of the deployment
+type IPAddressReportSpec struct {
+	// Name of the ConfigMap, created in the namespace of the AtlasDeployment.
+	// Defaults to the name of the AtlasDeployment followed by "-ip-addresses".
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-.a-z0-9]*[a-z0-9])?$`
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Labels added to the ConfigMap.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// RefreshInterval is how often the IP addresses are read from Atlas. Defaults to 1h.
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
 }
 
+// ConnectionInfoSpec configures the object holding the connection strings of the deployment
+type ConnectionInfoSpec struct {
+	// Name of the object, created in the namespace of the AtlasDeployment.
+	// Defaults to the name of the AtlasDeployment followed by "-connection".
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-.a-z0-9]*[a-z0-9])?$`
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Kind of the object holding the connection strings.
+	// +kubebuilder:validation:Enum=Secret;ConfigMap
+	// +kubebuilder:default=Secret
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Labels added to the object.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="!has(self.diskSizeGB) || !has(self.replicationSpecs) || size(self.replicationSpecs) == 0 || !has(self.replicationSpecs[0].regionConfigs) || self.replicationSpecs[0].regionConfigs.all(rc, !has(rc.providerName) || !(rc.providerName in ['AWS', 'GCP']) || (!has(rc.electableSpecs) || !has(rc.electableSpecs.instanceSize) || !(rc.electableSpecs.instanceSize in {'M10': 128, 'M20': 256, 'M30': 512, 'M40': 1024, 'R40': 1024}) || self.diskSizeGB <= {'M10': 128, 'M20': 256, 'M30': 512, 'M40': 1024, 'R40': 1024}[rc.electableSpecs.instanceSize]) && (!has(rc.readOnlySpecs) || !has(rc.readOnlySpecs.instanceSize) || !(rc.readOnlySpecs.instanceSize in {'M10': 128, 'M20': 256, 'M30': 512, 'M40': 1024, 'R40': 1024}) || self.diskSizeGB <= {'M10': 128, 'M20': 256, 'M30': 512, 'M40': 1024, 'R40': 1024}[rc.readOnlySpecs.instanceSize]) && (!has(rc.analyticsSpecs) || !has(rc.analyticsSpecs.instanceSize) || !(rc.analyticsSpecs.instanceSize in {'M10': 128, 'M20': 256, 'M30': 512, 'M40': 1024, 'R40': 1024}) || self.diskSizeGB <= {'M10': 128, 'M20': 256, 'M30': 512, 'M40': 1024, 'R40': 1024}[rc.analyticsSpecs.instanceSize]))",message="diskSizeGB exceeds the maximum disk size of the instance size"
 type AdvancedDeploymentSpec struct {
 	// Applicable only for M10+ deployments.
 	// Flag that indicates if the deployment uses Cloud Backups for backups.
@@ -100,7 +242,11 @@ type AdvancedDeploymentSpec struct {
 	// Each key and value has a maximum length of 255 characters.
 	// +optional
 	Labels []common.LabelSpec `json:"labels,omitempty"`
-	// Version of the deployment to deploy.
+	// Major MongoDB version the deployment is pinned to, e.g. "7.0".
+	// With the LTS release system Atlas keeps the deployment on this major version and applies the patch releases
+	// automatically during the maintenance window. It can't be set with the CONTINUOUS release system.
+	// The running version is reported in status.mongoDBVersion.
+	// +optional
 	MongoDBMajorVersion string `json:"mongoDBMajorVersion,omitempty"`
 	MongoDBVersion      string `json:"mongoDBVersion,omitempty"`
 	// Name of the advanced deployment as it appears in Atlas.
@@ -121,8 +267,13 @@ type AdvancedDeploymentSpec struct {
 	// Key-value pairs for resource tagging.
 	// +kubebuilder:validation:MaxItems=50
 	// +optional
-	Tags                 []*TagSpec `json:"tags,omitempty"`
-	VersionReleaseSystem string     `json:"versionReleaseSystem,omitempty"`
+	Tags []*TagSpec `json:"tags,omitempty"`
+	// Method by which the deployment maintains its MongoDB version.
+	// LTS keeps the deployment on mongoDBMajorVersion, CONTINUOUS upgrades it to the latest release as soon as
+	// Atlas makes it available. Atlas doesn't support switching a deployment from CONTINUOUS back to LTS.
+	// +kubebuilder:validation:Enum=LTS;CONTINUOUS
+	// +optional
+	VersionReleaseSystem string `json:"versionReleaseSystem,omitempty"`
 	// +optional
 	CustomZoneMapping []CustomZoneMapping `json:"customZoneMapping,omitempty"`
 	// +optional
@@ -130,6 +281,13 @@ type AdvancedDeploymentSpec struct {
 	// Flag that indicates whether termination protection is enabled on the cluster. If set to true, MongoDB Cloud won't delete the cluster. If set to false, MongoDB Cloud will delete the cluster.
 	// +kubebuilder:default:=false
 	TerminationProtectionEnabled bool `json:"terminationProtectionEnabled,omitempty"`
+	// Config server management mode of a sharded or geo-sharded deployment.
+	// ATLAS_MANAGED lets Atlas use an embedded config server (hosted on a shard) when possible,
+	// FIXED_TO_DEDICATED always uses a dedicated config server.
+	// The config server type in use is reported in status.configServerType.
+	// +kubebuilder:validation:Enum=ATLAS_MANAGED;FIXED_TO_DEDICATED
+	// +optional
+	ConfigServerManagementMode string `json:"configServerManagementMode,omitempty"`
 }
 
 // ToAtlas converts the AdvancedDeploymentSpec to native Atlas client ToAtlas format.
@@ -272,6 +430,7 @@ type AdvancedRegionConfig struct {
 	ProviderName string `json:"providerName,omitempty"`
 	// Physical location of your MongoDB deployment.
 	// The region you choose can affect network latency for clients accessing your databases.
+	// It must be the Atlas name of the region, e.g. US_EAST_1, rather than the cloud provider one, e.g. us-east-1
 	RegionName string `json:"regionName,omitempty"`
 }
 
@@ -342,17 +501,49 @@ type ComputeSpec struct {
 	MaxInstanceSize string `json:"maxInstanceSize,omitempty"`
 }
 
+// ProcessArgs are the advanced configuration options of the MongoDB processes of the deployment. The options left unset
+// keep their value in Atlas. The values are validated at admission by the CRD schema, the operator doesn't serve
+// admission webhooks
 type ProcessArgs struct {
-	DefaultReadConcern               string `json:"defaultReadConcern,omitempty"`
-	DefaultWriteConcern              string `json:"defaultWriteConcern,omitempty"`
-	MinimumEnabledTLSProtocol        string `json:"minimumEnabledTlsProtocol,omitempty"`
-	FailIndexKeyTooLong              *bool  `json:"failIndexKeyTooLong,omitempty"`
-	JavascriptEnabled                *bool  `json:"javascriptEnabled,omitempty"`
-	NoTableScan                      *bool  `json:"noTableScan,omitempty"`
-	OplogSizeMB                      *int64 `json:"oplogSizeMB,omitempty"`
-	SampleSizeBIConnector            *int64 `json:"sampleSizeBIConnector,omitempty"`
+	// DefaultReadConcern is the default level of acknowledgment requested from MongoDB for read operations
+	// +kubebuilder:validation:Enum=local;available;majority
+	// +optional
+	DefaultReadConcern string `json:"defaultReadConcern,omitempty"`
+	// DefaultWriteConcern is the default level of acknowledgment requested from MongoDB for write operations: majority
+	// or a number of nodes
+	// +kubebuilder:validation:Pattern="^(majority|[0-9]+)$"
+	// +optional
+	DefaultWriteConcern string `json:"defaultWriteConcern,omitempty"`
+	// MinimumEnabledTLSProtocol is the minimum TLS version accepted by the deployment for incoming connections
+	// +kubebuilder:validation:Enum=TLS1_0;TLS1_1;TLS1_2;TLS1_3
+	// +optional
+	MinimumEnabledTLSProtocol string `json:"minimumEnabledTlsProtocol,omitempty"`
+	// FailIndexKeyTooLong makes the deployment reject the documents with an index key too long to be indexed
+	// +optional
+	FailIndexKeyTooLong *bool `json:"failIndexKeyTooLong,omitempty"`
+	// JavascriptEnabled allows the deployment to execute operations running server-side JavaScript
+	// +optional
+	JavascriptEnabled *bool `json:"javascriptEnabled,omitempty"`
+	// NoTableScan makes the deployment reject the queries requiring a collection scan
+	// +optional
+	NoTableScan *bool `json:"noTableScan,omitempty"`
+	// OplogSizeMB is the storage limit of the oplog of the deployment, in megabytes
+	// +kubebuilder:validation:Minimum=990
+	// +optional
+	OplogSizeMB *int64 `json:"oplogSizeMB,omitempty"`
+	// SampleSizeBIConnector is the number of documents per database sampled by the BI Connector to build its schema
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SampleSizeBIConnector *int64 `json:"sampleSizeBIConnector,omitempty"`
+	// SampleRefreshIntervalBIConnector is the interval in seconds at which the BI Connector samples the documents
+	// again, 0 samples them only at startup
+	// +kubebuilder:validation:Minimum=0
+	// +optional
 	SampleRefreshIntervalBIConnector *int64 `json:"sampleRefreshIntervalBIConnector,omitempty"`
-	OplogMinRetentionHours           string `json:"oplogMinRetentionHours,omitempty"`
+	// OplogMinRetentionHours is the minimum retention window of the oplog, in hours, e.g. "24" or "1.5"
+	// +kubebuilder:validation:Pattern="^[0-9]+([.][0-9]+)?$"
+	// +optional
+	OplogMinRetentionHours string `json:"oplogMinRetentionHours,omitempty"`
 }
 
 func (specArgs ProcessArgs) ToAtlas() (*mongodbatlas.ProcessArgs, error) {
@@ -471,6 +662,7 @@ type ProviderSettingsSpec struct {
 
 	// Physical location of your MongoDB deployment.
 	// The region you choose can affect network latency for clients accessing your databases.
+	// It must be the Atlas name of the region, e.g. US_EAST_1, rather than the cloud provider one, e.g. us-east-1
 	// +optional
 	RegionName string `json:"regionName,omitempty"`
 
@@ -495,6 +687,7 @@ func (spec *AtlasDeploymentSpec) Deployment() (*mongodbatlas.AdvancedCluster, er
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
+// +kubebuilder:resource:categories={atlas,atlas-data}
 // +kubebuilder:subresource:status
 
 // AtlasDeployment is the Schema for the atlasdeployments API
@@ -0,0 +1,154 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasEnvironment{}, &AtlasEnvironmentList{})
+}
+
+// AtlasEnvironmentSpec defines an opinionated environment made of an Atlas project, one deployment and a database
+// user. The operator expands it into an AtlasProject, an AtlasDeployment and an AtlasDatabaseUser named after the
+// environment, plus a backup policy and schedule when backups are enabled. These resources are owned by the
+// environment: they are overwritten on every reconciliation and removed with it
+type AtlasEnvironmentSpec struct {
+	// ProjectName is the name of the Atlas project of the environment
+	// +kubebuilder:validation:MinLength=1
+	ProjectName string `json:"projectName"`
+
+	// ConnectionSecret is the Secret with the API keys used to reconcile the environment, the global operator Secret
+	// is used when not set
+	// +optional
+	ConnectionSecret *common.ResourceRefNamespaced `json:"connectionSecretRef,omitempty"`
+
+	// Deployment configures the deployment of the environment
+	// +optional
+	Deployment EnvironmentDeploymentSpec `json:"deployment,omitempty"`
+
+	// DatabaseUser configures the database user of the environment
+	DatabaseUser EnvironmentDatabaseUserSpec `json:"databaseUser"`
+
+	// IPAccessList are the entries added to the IP Access List of the project, on top of the egress IPs of the
+	// Kubernetes cluster
+	// +optional
+	IPAccessList []project.IPAccessList `json:"ipAccessList,omitempty"`
+
+	// EgressIPDiscovery adds the egress IPs of the Kubernetes cluster to the IP Access List, so that the workloads of
+	// the cluster can reach the deployment
+	// +kubebuilder:default:=true
+	// +optional
+	EgressIPDiscovery *bool `json:"egressIpDiscovery,omitempty"`
+
+	// Backup enables the cloud backups of the deployment with daily snapshots retained for 7 days
+	// +kubebuilder:default:=true
+	// +optional
+	Backup *bool `json:"backup,omitempty"`
+}
+
+// EnvironmentDeploymentSpec configures the replica set of an environment
+type EnvironmentDeploymentSpec struct {
+	// Name of the deployment in Atlas, defaults to the name of the environment
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// ProviderName is the cloud provider hosting the deployment
+	// +kubebuilder:validation:Enum=AWS;GCP;AZURE
+	// +kubebuilder:default:=AWS
+	// +optional
+	ProviderName provider.ProviderName `json:"providerName,omitempty"`
+
+	// RegionName is the Atlas name of the region hosting the deployment, e.g. US_EAST_1
+	// +kubebuilder:default:=US_EAST_1
+	// +optional
+	RegionName string `json:"regionName,omitempty"`
+
+	// InstanceSize of the 3 nodes of the replica set
+	// +kubebuilder:default:=M10
+	// +optional
+	InstanceSize string `json:"instanceSize,omitempty"`
+
+	// MongoDBMajorVersion of the deployment, the default version of Atlas when not set
+	// +optional
+	MongoDBMajorVersion string `json:"mongoDBMajorVersion,omitempty"`
+}
+
+// EnvironmentDatabaseUserSpec configures the database user of an environment
+type EnvironmentDatabaseUserSpec struct {
+	// Username of the database user, defaults to the name of the environment
+	// +optional
+	Username string `json:"username,omitempty"`
+
+	// PasswordSecret is a reference to the Secret keeping the user password
+	PasswordSecret common.ResourceRef `json:"passwordSecretRef"`
+
+	// Roles of the user, readWriteAnyDatabase on the admin database by default
+	// +optional
+	Roles []RoleSpec `json:"roles,omitempty"`
+}
+
+// AtlasEnvironment is the Schema for the atlasenvironments API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories={atlas,atlas-project}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Project",type=string,JSONPath=`.spec.projectName`
+// +kubebuilder:printcolumn:name="Connection Secret",type=string,JSONPath=`.status.connectionSecret`
+type AtlasEnvironment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasEnvironmentSpec          `json:"spec,omitempty"`
+	Status status.AtlasEnvironmentStatus `json:"status,omitempty"`
+}
+
+func (e *AtlasEnvironment) ConnectionSecretObjectKey() *client.ObjectKey {
+	return e.Spec.ConnectionSecret.GetObject(e.Namespace)
+}
+
+// DeploymentName returns the name of the deployment of the environment in Atlas
+func (e *AtlasEnvironment) DeploymentName() string {
+	if e.Spec.Deployment.Name != "" {
+		return e.Spec.Deployment.Name
+	}
+
+	return e.Name
+}
+
+// Username returns the name of the database user of the environment
+func (e *AtlasEnvironment) Username() string {
+	if e.Spec.DatabaseUser.Username != "" {
+		return e.Spec.DatabaseUser.Username
+	}
+
+	return e.Name
+}
+
+func (e *AtlasEnvironment) GetStatus() status.Status {
+	return e.Status
+}
+
+func (e *AtlasEnvironment) UpdateStatus(conditions []status.Condition, options ...status.Option) {
+	e.Status.Conditions = conditions
+	e.Status.ObservedGeneration = e.ObjectMeta.Generation
+
+	for _, opt := range options {
+		// This will fail if the Option passed is incorrect - which is expected
+		v := opt.(status.AtlasEnvironmentStatusOption)
+		v(&e.Status)
+	}
+}
+
+// AtlasEnvironmentList contains a list of AtlasEnvironment
+// +kubebuilder:object:root=true
+type AtlasEnvironmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasEnvironment `json:"items"`
+}
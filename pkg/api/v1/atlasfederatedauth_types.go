@@ -3,6 +3,7 @@ package v1
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"go.mongodb.org/atlas-sdk/v20231115004/admin"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -34,24 +35,91 @@ type AtlasFederatedAuthSpec struct {
 	// +kubebuilder:default:=false
 	// +optional
 	SSODebugEnabled *bool `json:"ssoDebugEnabled,omitempty"`
+	// SAMLIdentityProvider is the definition of the SAML identity provider the organization is connected to, updated
+	// in Atlas when it differs. Atlas doesn't support creating SAML identity providers through its API, the identity
+	// provider must be added to the federation beforehand.
+	// +optional
+	SAMLIdentityProvider *SAMLIdentityProviderSpec `json:"samlIdentityProvider,omitempty"`
 	// Atlas roles that are granted to a user in this organization after authenticating.
 	// +optional
 	PostAuthRoleGrants []string `json:"postAuthRoleGrants,omitempty"`
 	// Map IDP groups to Atlas roles.
 	// +optional
 	RoleMappings []RoleMapping `json:"roleMappings,omitempty"`
+	// Map IDP groups to database roles. The operator maintains an OIDC database user of type IDP_GROUP for every group
+	// in each of the projects, using the OIDC identity provider enabled for data access in the organization.
+	// +optional
+	DataAccessRoleMappings []DataAccessRoleMapping `json:"dataAccessRoleMappings,omitempty"`
+	// ConnectedOrganizations are the other organizations connected to the same federation whose configuration is
+	// managed along with the one of the organization of the connectionSecretRef.
+	// +optional
+	ConnectedOrganizations []ConnectedOrganization `json:"connectedOrganizations,omitempty"`
+}
+
+// ConnectedOrganization is the configuration of an organization connected to the federation
+type ConnectedOrganization struct {
+	// Connection secret with API credentials of the connected organization.
+	// These credentials must have OrganizationOwner permissions.
+	ConnectionSecretRef common.ResourceRefNamespaced `json:"connectionSecretRef"`
+	// Approved domains that restrict users who can join the organization based on their email address.
+	// +optional
+	DomainAllowList []string `json:"domainAllowList,omitempty"`
+	// Prevent users in the federation from accessing organizations outside of the federation, and creating new organizations.
+	// +kubebuilder:default:=false
+	DomainRestrictionEnabled *bool `json:"domainRestrictionEnabled,omitempty"`
+	// Atlas roles that are granted to a user in this organization after authenticating.
+	// +optional
+	PostAuthRoleGrants []string `json:"postAuthRoleGrants,omitempty"`
+	// Map IDP groups to Atlas roles.
+	// +optional
+	RoleMappings []RoleMapping `json:"roleMappings,omitempty"`
+}
+
+// ConnectionSecretObjectKey returns the key of the connection secret, which defaults to the namespace of the
+// AtlasFederatedAuth
+func (c *ConnectedOrganization) ConnectionSecretObjectKey(namespace string) client.ObjectKey {
+	if c.ConnectionSecretRef.Namespace != "" {
+		namespace = c.ConnectionSecretRef.Namespace
+	}
+
+	return kube.ObjectKey(namespace, c.ConnectionSecretRef.Name)
+}
+
+// ToAtlas converts the connected organization to the Atlas connected organization configuration
+func (c *ConnectedOrganization) ToAtlas(orgID, idpID string, projectNameToID map[string]string) (*admin.ConnectedOrgConfig, error) {
+	spec := &AtlasFederatedAuthSpec{
+		DomainAllowList:          c.DomainAllowList,
+		DomainRestrictionEnabled: c.DomainRestrictionEnabled,
+		PostAuthRoleGrants:       c.PostAuthRoleGrants,
+		RoleMappings:             c.RoleMappings,
+	}
+	if spec.DomainRestrictionEnabled == nil {
+		spec.DomainRestrictionEnabled = new(bool)
+	}
+
+	return spec.ToAtlas(orgID, idpID, projectNameToID)
 }
 
 func (f *AtlasFederatedAuthSpec) ToAtlas(orgID, idpID string, projectNameToID map[string]string) (*admin.ConnectedOrgConfig, error) {
 	var errs []error
 	atlasRoleMappings := make([]admin.AuthFederationRoleMapping, 0, len(f.RoleMappings))
+	groupNames := make(map[string]struct{}, len(f.RoleMappings))
 
 	for i := range f.RoleMappings {
 		roleMapping := &f.RoleMappings[i]
+		if _, ok := groupNames[roleMapping.ExternalGroupName]; ok {
+			errs = append(errs, fmt.Errorf("the IdP group '%s' is mapped more than once", roleMapping.ExternalGroupName))
+		}
+		groupNames[roleMapping.ExternalGroupName] = struct{}{}
+
 		atlasRoleAssignments := make([]admin.RoleAssignment, 0, len(roleMapping.RoleAssignments))
 		for j := range roleMapping.RoleAssignments {
 			atlasRoleAssignment := admin.RoleAssignment{}
 			roleAssignment := &roleMapping.RoleAssignments[j]
+			if err := roleAssignment.validateScope(); err != nil {
+				errs = append(errs, fmt.Errorf("role mapping of the IdP group '%s': %w", roleMapping.ExternalGroupName, err))
+				continue
+			}
 			if roleAssignment.ProjectName != "" {
 				id, ok := projectNameToID[roleAssignment.ProjectName]
 				if !ok {
@@ -87,6 +155,34 @@ func (f *AtlasFederatedAuthSpec) ToAtlas(orgID, idpID string, projectNameToID ma
 	return result, errors.Join(errs...)
 }
 
+// SAMLIdentityProviderSpec is the definition of a SAML identity provider. The fields left empty keep their value in
+// Atlas
+type SAMLIdentityProviderSpec struct {
+	// Human-readable label that identifies the identity provider.
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+	// Unique string that identifies the issuer of the SAML assertion.
+	// +optional
+	IssuerURI string `json:"issuerUri,omitempty"`
+	// URL of the receiver of the SAML authentication request.
+	// +optional
+	SSOURL string `json:"ssoUrl,omitempty"`
+	// SAML Authentication Request Protocol HTTP method binding used to send the authentication request.
+	// +kubebuilder:validation:Enum=HTTP-POST;HTTP-REDIRECT
+	// +optional
+	RequestBinding string `json:"requestBinding,omitempty"`
+	// Algorithm the identity provider uses to sign the SAML response.
+	// +kubebuilder:validation:Enum=SHA-1;SHA-256
+	// +optional
+	ResponseSignatureAlgorithm string `json:"responseSignatureAlgorithm,omitempty"`
+	// Domains whose users authenticate with the identity provider.
+	// +optional
+	AssociatedDomains []string `json:"associatedDomains,omitempty"`
+	// Secret holding the PEM encoded signing certificates of the identity provider under the certificates.pem key.
+	// +optional
+	CertificatesSecretRef *common.ResourceRefNamespaced `json:"certificatesSecretRef,omitempty"`
+}
+
 // RoleMapping maps an external group from an identity provider to roles within Atlas.
 type RoleMapping struct {
 	// ExternalGroupName is the name of the IDP group to which this mapping applies.
@@ -97,17 +193,49 @@ type RoleMapping struct {
 	RoleAssignments []RoleAssignment `json:"roleAssignments,omitempty"`
 }
 
+// DataAccessRoleMapping maps an external group from an identity provider to database roles within Atlas projects.
+type DataAccessRoleMapping struct {
+	// ExternalGroupName is the name of the IDP group to which this mapping applies.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=200
+	ExternalGroupName string `json:"externalGroupName"`
+	// ProjectNames are the Atlas projects in the same org in which the group members get the database access.
+	// +kubebuilder:validation:MinItems=1
+	ProjectNames []string `json:"projectNames"`
+	// Roles are the database roles given to the group members.
+	// +kubebuilder:validation:MinItems=1
+	Roles []RoleSpec `json:"roles"`
+	// Scopes restrict the access of the group members to the given clusters and Atlas Data Lakes.
+	// +optional
+	Scopes []ScopeSpec `json:"scopes,omitempty"`
+}
+
 type RoleAssignment struct {
 	// The Atlas project in the same org in which the role should be given.
+	// Leave it empty to give an organization role (ORG_*) to the group members, project roles (GROUP_*) require it.
+	// +optional
 	ProjectName string `json:"projectName,omitempty"`
 	// The role in Atlas that should be given to group members.
 	// +kubebuilder:validation:Enum=ORG_MEMBER;ORG_READ_ONLY;ORG_BILLING_ADMIN;ORG_GROUP_CREATOR;ORG_OWNER;ORG_BILLING_READ_ONLY;ORG_TEAM_MEMBERS_ADMIN;GROUP_AUTOMATION_ADMIN;GROUP_BACKUP_ADMIN;GROUP_MONITORING_ADMIN;GROUP_OWNER;GROUP_READ_ONLY;GROUP_USER_ADMIN;GROUP_BILLING_ADMIN;GROUP_DATA_ACCESS_ADMIN;GROUP_DATA_ACCESS_READ_ONLY;GROUP_DATA_ACCESS_READ_WRITE;GROUP_CHARTS_ADMIN;GROUP_CLUSTER_MANAGER;GROUP_SEARCH_INDEX_EDITOR
 	Role string `json:"role,omitempty"`
 }
 
+// validateScope checks organization roles are given in the organization and project roles in a project
+func (r *RoleAssignment) validateScope() error {
+	switch {
+	case strings.HasPrefix(r.Role, "ORG_") && r.ProjectName != "":
+		return fmt.Errorf("the organization role %s can't be given in the project '%s'", r.Role, r.ProjectName)
+	case strings.HasPrefix(r.Role, "GROUP_") && r.ProjectName == "":
+		return fmt.Errorf("the project role %s requires a projectName", r.Role)
+	}
+
+	return nil
+}
+
 // AtlasFederatedAuth is the Schema for the Atlasfederatedauth API
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
+// +kubebuilder:resource:categories={atlas,atlas-security}
 // +kubebuilder:subresource:status
 type AtlasFederatedAuth struct {
 	metav1.TypeMeta   `json:",inline"`
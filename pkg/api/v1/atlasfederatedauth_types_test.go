@@ -105,4 +105,51 @@ func Test_FederatedAuthSpec_ToAtlas(t *testing.T) {
 		assert.Error(t, err, "ToAtlas() should fail")
 		assert.NotNil(t, result, "ToAtlas() result should not be nil")
 	})
+
+	t.Run("Can give organization and project roles to a group", func(t *testing.T) {
+		spec := &AtlasFederatedAuthSpec{
+			DomainRestrictionEnabled: pointer.MakePtr(false),
+			RoleMappings: []RoleMapping{
+				{
+					ExternalGroupName: "admins",
+					RoleAssignments: []RoleAssignment{
+						{Role: "ORG_OWNER"},
+						{ProjectName: "test-project", Role: "GROUP_OWNER"},
+					},
+				},
+			},
+		}
+
+		result, err := spec.ToAtlas("test-org", "test-idp", map[string]string{"test-project": "test-project-id"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []admin.RoleAssignment{
+			{OrgId: pointer.MakePtr("test-org"), Role: pointer.MakePtr("ORG_OWNER")},
+			{GroupId: pointer.MakePtr("test-project-id"), Role: pointer.MakePtr("GROUP_OWNER")},
+		}, result.GetRoleMappings()[0].GetRoleAssignments())
+	})
+
+	t.Run("Should return an error when a role is given in the wrong scope", func(t *testing.T) {
+		spec := &AtlasFederatedAuthSpec{
+			DomainRestrictionEnabled: pointer.MakePtr(false),
+			RoleMappings: []RoleMapping{
+				{
+					ExternalGroupName: "admins",
+					RoleAssignments: []RoleAssignment{
+						{ProjectName: "test-project", Role: "ORG_OWNER"},
+						{Role: "GROUP_OWNER"},
+					},
+				},
+				{
+					ExternalGroupName: "admins",
+				},
+			},
+		}
+
+		_, err := spec.ToAtlas("test-org", "test-idp", map[string]string{"test-project": "test-project-id"})
+
+		assert.ErrorContains(t, err, "role mapping of the IdP group 'admins': the organization role ORG_OWNER can't be given in the project 'test-project'")
+		assert.ErrorContains(t, err, "role mapping of the IdP group 'admins': the project role GROUP_OWNER requires a projectName")
+		assert.ErrorContains(t, err, "the IdP group 'admins' is mapped more than once")
+	})
 }
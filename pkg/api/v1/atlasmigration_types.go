@@ -0,0 +1,115 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasMigration{}, &AtlasMigrationList{})
+}
+
+// AtlasMigrationSpec defines the desired state of a live migration (push) from a Cloud Manager or Ops Manager
+// cluster to an Atlas deployment
+type AtlasMigrationSpec struct {
+	// Source is the Cloud Manager or Ops Manager cluster the data is migrated from.
+	Source MigrationSource `json:"source"`
+
+	// DeploymentRef is a reference to the AtlasDeployment the data is migrated to.
+	// The migration uses the API credentials of the project of the deployment.
+	DeploymentRef common.ResourceRefNamespaced `json:"deploymentRef"`
+
+	// HostnameSchemaType is the type of hostnames the migration hosts use to reach the destination cluster.
+	// +kubebuilder:validation:Enum=PUBLIC;PRIVATE_LINK;VPC_PEERING
+	// +kubebuilder:default:=PUBLIC
+	// +optional
+	HostnameSchemaType string `json:"hostnameSchemaType,omitempty"`
+
+	// PrivateLinkID is the unique identifier of the private endpoint used when the hostnameSchemaType is PRIVATE_LINK.
+	// +optional
+	PrivateLinkID string `json:"privateLinkId,omitempty"`
+
+	// DropEnabled drops the collections of the destination cluster before the migration starts.
+	// +kubebuilder:default:=false
+	// +optional
+	DropEnabled bool `json:"dropEnabled,omitempty"`
+
+	// MigrationHosts are the migration hosts used to perform the migration.
+	// +optional
+	MigrationHosts []string `json:"migrationHosts,omitempty"`
+}
+
+// MigrationSource describes the Cloud Manager or Ops Manager cluster to migrate
+type MigrationSource struct {
+	// ProjectID is the unique identifier of the Cloud Manager or Ops Manager project of the source cluster.
+	ProjectID string `json:"projectId"`
+
+	// ClusterName is the name of the source cluster.
+	ClusterName string `json:"clusterName"`
+
+	// ManagedAuthentication lets the Cloud Manager or Ops Manager automation handle the authentication to the
+	// source cluster. When false, the credentials are read from the credentialsSecretRef.
+	// +kubebuilder:default:=false
+	// +optional
+	ManagedAuthentication bool `json:"managedAuthentication,omitempty"`
+
+	// CredentialsSecretRef is a reference to the Secret holding the "username" and "password" of the user
+	// authenticating to the source cluster.
+	// +optional
+	CredentialsSecretRef *common.ResourceRefNamespaced `json:"credentialsSecretRef,omitempty"`
+
+	// SSL enables TLS for the connections to the source cluster.
+	// +kubebuilder:default:=false
+	// +optional
+	SSL bool `json:"ssl,omitempty"`
+
+	// CACertificatePath is the path to the CA certificate used to verify the source cluster, on the migration hosts.
+	// +optional
+	CACertificatePath string `json:"caCertificatePath,omitempty"`
+}
+
+// AtlasMigration is the Schema for the atlasmigrations API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories={atlas,atlas-data}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Lag",type=integer,JSONPath=`.status.lagTimeSeconds`
+// +kubebuilder:printcolumn:name="Ready For Cutover",type=boolean,JSONPath=`.status.readyForCutover`
+type AtlasMigration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasMigrationSpec          `json:"spec,omitempty"`
+	Status status.AtlasMigrationStatus `json:"status,omitempty"`
+}
+
+func (m *AtlasMigration) DeploymentObjectKey() client.ObjectKey {
+	return *m.Spec.DeploymentRef.GetObject(m.Namespace)
+}
+
+func (m *AtlasMigration) GetStatus() status.Status {
+	return m.Status
+}
+
+func (m *AtlasMigration) UpdateStatus(conditions []status.Condition, options ...status.Option) {
+	m.Status.Conditions = conditions
+	m.Status.ObservedGeneration = m.ObjectMeta.Generation
+
+	for _, o := range options {
+		// This will fail if the Option passed is incorrect - which is expected
+		v := o.(status.AtlasMigrationStatusOption)
+		v(&m.Status)
+	}
+}
+
+// AtlasMigrationList contains a list of AtlasMigration
+// +kubebuilder:object:root=true
+type AtlasMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasMigration `json:"items"`
+}
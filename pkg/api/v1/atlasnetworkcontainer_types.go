@@ -0,0 +1,92 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasNetworkContainer{}, &AtlasNetworkContainerList{})
+}
+
+// AtlasNetworkContainerSpec defines the desired state of a network peering container of an Atlas project
+type AtlasNetworkContainerSpec struct {
+	// Project is a reference to the AtlasProject the container belongs to.
+	Project common.ResourceRefNamespaced `json:"projectRef"`
+
+	// Provider is the cloud provider of the container.
+	// +kubebuilder:validation:Enum=AWS;GCP;AZURE
+	// +kubebuilder:default:=AWS
+	// +optional
+	Provider provider.ProviderName `json:"provider,omitempty"`
+
+	// Region of the container in the Atlas format, e.g. US_EAST_1. Required for AWS and Azure, as Atlas holds one
+	// container per region for them. GCP containers span the regions of the project.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// Regions restricts the GCP regions the clusters of the project can be deployed to. Applicable only for GCP.
+	// +optional
+	Regions []string `json:"regions,omitempty"`
+
+	// AtlasCIDRBlock is the CIDR block Atlas assigns the IP addresses of the clusters of the container from.
+	// Atlas locks it once a dedicated cluster or a network peering connection uses the container.
+	AtlasCIDRBlock string `json:"atlasCidrBlock"`
+}
+
+// AtlasNetworkContainer is the Schema for the atlasnetworkcontainers API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories={atlas,atlas-network}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Provider",type=string,JSONPath=`.spec.provider`
+// +kubebuilder:printcolumn:name="Region",type=string,JSONPath=`.spec.region`
+// +kubebuilder:printcolumn:name="CIDR",type=string,JSONPath=`.spec.atlasCidrBlock`
+// +kubebuilder:printcolumn:name="ID",type=string,JSONPath=`.status.id`
+type AtlasNetworkContainer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasNetworkContainerSpec          `json:"spec,omitempty"`
+	Status status.AtlasNetworkContainerStatus `json:"status,omitempty"`
+}
+
+func (c *AtlasNetworkContainer) AtlasProjectObjectKey() client.ObjectKey {
+	return *c.Spec.Project.GetObject(c.Namespace)
+}
+
+// GetProvider returns the provider of the container, AWS by default
+func (c *AtlasNetworkContainer) GetProvider() provider.ProviderName {
+	if c.Spec.Provider == "" {
+		return provider.ProviderAWS
+	}
+
+	return c.Spec.Provider
+}
+
+func (c *AtlasNetworkContainer) GetStatus() status.Status {
+	return c.Status
+}
+
+func (c *AtlasNetworkContainer) UpdateStatus(conditions []status.Condition, options ...status.Option) {
+	c.Status.Conditions = conditions
+	c.Status.ObservedGeneration = c.ObjectMeta.Generation
+
+	for _, o := range options {
+		// This will fail if the Option passed is incorrect - which is expected
+		v := o.(status.AtlasNetworkContainerStatusOption)
+		v(&c.Status)
+	}
+}
+
+// AtlasNetworkContainerList contains a list of AtlasNetworkContainer
+// +kubebuilder:object:root=true
+type AtlasNetworkContainerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasNetworkContainer `json:"items"`
+}
@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasOrganization{}, &AtlasOrganizationList{})
+}
+
+// AtlasOrganizationSpec defines the desired state of an Atlas organization created through cross-organization billing.
+// The organization is created with an API key which is written into a Secret, so that the AtlasProjects of the new
+// organization can reference it as their connection Secret
+type AtlasOrganizationSpec struct {
+	// Name of the organization
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// ConnectionSecretRef is the Secret with the API keys of the paying organization, the global operator Secret is
+	// used when not set. The keys must have the Organization Owner role and cross-organization billing must be enabled.
+	// +optional
+	ConnectionSecretRef *common.ResourceRefNamespaced `json:"connectionSecretRef,omitempty"`
+
+	// OwnerID is the unique identifier of the Atlas user given the Organization Owner role. The user must be a member of
+	// the paying organization, or of an organization of the federation when FederationSettingsID is set.
+	// +kubebuilder:validation:MinLength=1
+	OwnerID string `json:"ownerId"`
+
+	// FederationSettingsID is the unique identifier of the federation the organization is linked to.
+	// +optional
+	FederationSettingsID string `json:"federationSettingsId,omitempty"`
+
+	// APIKeyRoles are the roles of the API key created with the organization. The key must have the Organization Owner
+	// role for the operator to remove the organization.
+	// +kubebuilder:default:={"ORG_OWNER"}
+	// +kubebuilder:validation:MinItems=1
+	// +optional
+	APIKeyRoles []OrgRole `json:"apiKeyRoles,omitempty"`
+
+	// SecretRef is the name of the Secret the API key of the organization is written into, in the namespace of the
+	// resource. It defaults to the name of the resource suffixed with -credentials.
+	// +optional
+	SecretRef *common.ResourceRef `json:"secretRef,omitempty"`
+}
+
+func (s *AtlasOrganizationSpec) APIKeyRoleNames() []string {
+	roles := make([]string, 0, len(s.APIKeyRoles))
+	for _, role := range s.APIKeyRoles {
+		roles = append(roles, string(role))
+	}
+
+	return roles
+}
+
+// AtlasOrganization is the Schema for the atlasorganizations API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories={atlas,atlas-project}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.spec.name`
+// +kubebuilder:printcolumn:name="Org ID",type=string,JSONPath=`.status.id`
+type AtlasOrganization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasOrganizationSpec          `json:"spec,omitempty"`
+	Status status.AtlasOrganizationStatus `json:"status,omitempty"`
+}
+
+func (o *AtlasOrganization) ConnectionSecretObjectKey() *client.ObjectKey {
+	return o.Spec.ConnectionSecretRef.GetObject(o.Namespace)
+}
+
+// SecretObjectKey returns the key of the Secret the API key of the organization is written into
+func (o *AtlasOrganization) SecretObjectKey() client.ObjectKey {
+	if o.Spec.SecretRef != nil && o.Spec.SecretRef.Name != "" {
+		return client.ObjectKey{Namespace: o.Namespace, Name: o.Spec.SecretRef.Name}
+	}
+
+	return client.ObjectKey{Namespace: o.Namespace, Name: fmt.Sprintf("%s-credentials", o.Name)}
+}
+
+func (o *AtlasOrganization) GetStatus() status.Status {
+	return o.Status
+}
+
+func (o *AtlasOrganization) UpdateStatus(conditions []status.Condition, options ...status.Option) {
+	o.Status.Conditions = conditions
+	o.Status.ObservedGeneration = o.ObjectMeta.Generation
+
+	for _, opt := range options {
+		// This will fail if the Option passed is incorrect - which is expected
+		v := opt.(status.AtlasOrganizationStatusOption)
+		v(&o.Status)
+	}
+}
+
+// AtlasOrganizationList contains a list of AtlasOrganization
+// +kubebuilder:object:root=true
+type AtlasOrganizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasOrganization `json:"items"`
+}
@@ -0,0 +1,106 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasOrgUser{}, &AtlasOrgUserList{})
+}
+
+// +kubebuilder:validation:Enum=ORG_MEMBER;ORG_READ_ONLY;ORG_BILLING_ADMIN;ORG_BILLING_READ_ONLY;ORG_GROUP_CREATOR;ORG_OWNER
+
+type OrgRole string
+
+// AtlasOrgUserSpec defines the desired state of a user of an Atlas organization. The user is invited to the
+// organization until they accept the invitation, which can't be automated, and is a member of it afterwards
+type AtlasOrgUserSpec struct {
+	// ConnectionSecretRef is the Secret with the API keys of the organization, the global operator Secret is used when
+	// not set. The keys must have the Organization User Admin role or higher.
+	// +optional
+	ConnectionSecretRef *common.ResourceRefNamespaced `json:"connectionSecretRef,omitempty"`
+
+	// Username is the email address of the user
+	// +kubebuilder:validation:MinLength=1
+	Username string `json:"username"`
+
+	// Roles the user has over the organization
+	// +kubebuilder:validation:MinItems=1
+	Roles []OrgRole `json:"roles"`
+
+	// TeamRefs are references to the AtlasTeams the user belongs to. The user joins the teams once they accept the
+	// invitation.
+	// +optional
+	TeamRefs []common.ResourceRefNamespaced `json:"teamRefs,omitempty"`
+
+	// AdoptExisting allows managing a user who already was a member of the organization, overwriting their organization
+	// roles. Such users are refused otherwise. The ORG_OWNER role is never removed from a user by the operator.
+	// +optional
+	AdoptExisting bool `json:"adoptExisting,omitempty"`
+}
+
+func (s *AtlasOrgUserSpec) RoleNames() []string {
+	roles := make([]string, 0, len(s.Roles))
+	for _, role := range s.Roles {
+		roles = append(roles, string(role))
+	}
+
+	return roles
+}
+
+// AtlasOrgUser is the Schema for the atlasorgusers API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories={atlas,atlas-security}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Username",type=string,JSONPath=`.spec.username`
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+type AtlasOrgUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasOrgUserSpec          `json:"spec,omitempty"`
+	Status status.AtlasOrgUserStatus `json:"status,omitempty"`
+}
+
+func (u *AtlasOrgUser) ConnectionSecretObjectKey() *client.ObjectKey {
+	return u.Spec.ConnectionSecretRef.GetObject(u.Namespace)
+}
+
+// ReferencesTeam returns true when the user belongs to the team of the given key
+func (u *AtlasOrgUser) ReferencesTeam(key client.ObjectKey) bool {
+	for i := range u.Spec.TeamRefs {
+		if *u.Spec.TeamRefs[i].GetObject(u.Namespace) == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (u *AtlasOrgUser) GetStatus() status.Status {
+	return u.Status
+}
+
+func (u *AtlasOrgUser) UpdateStatus(conditions []status.Condition, options ...status.Option) {
+	u.Status.Conditions = conditions
+	u.Status.ObservedGeneration = u.ObjectMeta.Generation
+
+	for _, o := range options {
+		// This will fail if the Option passed is incorrect - which is expected
+		v := o.(status.AtlasOrgUserStatusOption)
+		v(&u.Status)
+	}
+}
+
+// AtlasOrgUserList contains a list of AtlasOrgUser
+// +kubebuilder:object:root=true
+type AtlasOrgUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasOrgUser `json:"items"`
+}
@@ -0,0 +1,121 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasPrivateEndpoint{}, &AtlasPrivateEndpointList{})
+}
+
+// AtlasPrivateEndpointSpec defines the desired state of a private endpoint service of an Atlas project and of the
+// interface endpoints connected to it
+type AtlasPrivateEndpointSpec struct {
+	// Project is a reference to the AtlasProject the private endpoint service belongs to.
+	Project common.ResourceRefNamespaced `json:"projectRef"`
+
+	// Provider is the cloud provider of the private endpoint service.
+	// +kubebuilder:validation:Enum=AWS;GCP;AZURE
+	Provider provider.ProviderName `json:"provider"`
+
+	// Region of the private endpoint service, e.g. us-east-1 or US_EAST_1.
+	Region string `json:"region"`
+
+	// Interfaces are the endpoints created in the cloud provider to connect to the private endpoint service.
+	// +optional
+	Interfaces []PrivateEndpointInterface `json:"interfaces,omitempty"`
+
+	// Recreation enables the recreation of the interfaces Atlas reports as REJECTED or FAILED.
+	// +optional
+	Recreation *PrivateEndpointRecreationSpec `json:"recreation,omitempty"`
+}
+
+// PrivateEndpointRecreationSpec configures the automatic recreation of the interfaces stuck in a failed state
+type PrivateEndpointRecreationSpec struct {
+	// Backoff is the time an interface stays failed before it's recreated, doubled after every recreation.
+	// +kubebuilder:default="15m"
+	// +optional
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+	// MaxAttempts is the number of recreations of an interface after which it's left failed.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=3
+	// +optional
+	MaxAttempts int `json:"maxAttempts,omitempty"`
+}
+
+// PrivateEndpointInterface is an endpoint of the cloud provider connected to the private endpoint service
+type PrivateEndpointInterface struct {
+	// ID of the interface endpoint created in the AWS VPC, or of the private endpoint created in the Azure VNet.
+	// +optional
+	ID string `json:"id,omitempty"`
+	// IP is the private IP address of the private endpoint created in the Azure VNet.
+	// +optional
+	IP string `json:"ip,omitempty"`
+	// GCPProjectID is the Google Cloud project the endpoints were created in.
+	// +optional
+	GCPProjectID string `json:"gcpProjectId,omitempty"`
+	// EndpointGroupName is the name of the group of the endpoints created in Google Cloud.
+	// +optional
+	EndpointGroupName string `json:"endpointGroupName,omitempty"`
+	// Endpoints are the forwarding rules of the endpoint group created in Google Cloud, up to 50.
+	// +optional
+	Endpoints GCPEndpoints `json:"endpoints,omitempty"`
+}
+
+// InterfaceID returns the identifier of the interface in Atlas, the endpoint group name for GCP
+func (i PrivateEndpointInterface) InterfaceID() string {
+	if i.EndpointGroupName != "" {
+		return i.EndpointGroupName
+	}
+
+	return i.ID
+}
+
+// AtlasPrivateEndpoint is the Schema for the atlasprivateendpoints API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories={atlas,atlas-network}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Provider",type=string,JSONPath=`.spec.provider`
+// +kubebuilder:printcolumn:name="Region",type=string,JSONPath=`.spec.region`
+// +kubebuilder:printcolumn:name="Service",type=string,JSONPath=`.status.serviceStatus`
+// +kubebuilder:printcolumn:name="ID",type=string,JSONPath=`.status.serviceId`
+type AtlasPrivateEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasPrivateEndpointSpec          `json:"spec,omitempty"`
+	Status status.AtlasPrivateEndpointStatus `json:"status,omitempty"`
+}
+
+func (pe *AtlasPrivateEndpoint) AtlasProjectObjectKey() client.ObjectKey {
+	return *pe.Spec.Project.GetObject(pe.Namespace)
+}
+
+func (pe *AtlasPrivateEndpoint) GetStatus() status.Status {
+	return pe.Status
+}
+
+func (pe *AtlasPrivateEndpoint) UpdateStatus(conditions []status.Condition, options ...status.Option) {
+	pe.Status.Conditions = conditions
+	pe.Status.ObservedGeneration = pe.ObjectMeta.Generation
+
+	for _, o := range options {
+		// This will fail if the Option passed is incorrect - which is expected
+		v := o.(status.AtlasPrivateEndpointStatusOption)
+		v(&pe.Status)
+	}
+}
+
+// AtlasPrivateEndpointList contains a list of AtlasPrivateEndpoint
+// +kubebuilder:object:root=true
+type AtlasPrivateEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasPrivateEndpoint `json:"items"`
+}
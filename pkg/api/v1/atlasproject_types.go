@@ -54,6 +54,21 @@ type AtlasProjectSpec struct {
 	// +optional
 	RegionUsageRestrictions string `json:"regionUsageRestrictions,omitempty"`
 
+	// OrgID is the ID of the Atlas Organization the Project belongs to. The Operator refuses to reconcile the Project
+	// if the connection secret holds the API keys of another Organization. Defaults to the Organization of the
+	// connection secret.
+	// +kubebuilder:validation:Pattern="^[0-9a-f]{24}$"
+	// +optional
+	OrgID string `json:"orgId,omitempty"`
+
+	// CreationPolicy controls whether the Operator may create the Project in Atlas or must only bind to an existing one
+	// with the same name. MustNotExist refuses to take over a Project created outside of this resource, preventing
+	// duplicates when names drift.
+	// +kubebuilder:validation:Enum=CreateIfMissing;MustExist;MustNotExist
+	// +kubebuilder:default:=CreateIfMissing
+	// +optional
+	CreationPolicy common.CreationPolicy `json:"creationPolicy,omitempty"`
+
 	// ConnectionSecret is the name of the Kubernetes Secret which contains the information about the way to connect to
 	// Atlas (organization ID, API keys). The default Operator connection configuration will be used if not provided.
 	// +optional
@@ -64,6 +79,17 @@ type AtlasProjectSpec struct {
 	// +optional
 	ProjectIPAccessList []project.IPAccessList `json:"projectIpAccessList,omitempty"`
 
+	// ProjectIPAccessListConfigMapRef is a reference to a ConfigMap holding more IP Access List entries, e.g. the egress
+	// IPs published by another controller. Each value holds IP addresses or CIDR blocks separated by commas, spaces or
+	// new lines. The ConfigMap is watched and its entries are added to the ones of projectIpAccessList
+	// +optional
+	ProjectIPAccessListConfigMapRef *common.ResourceRefNamespaced `json:"projectIpAccessListConfigMapRef,omitempty"`
+
+	// EgressIPDiscovery adds the egress IPs of the Kubernetes cluster the operator runs in to the IP Access List, and
+	// removes them once they are no longer used
+	// +optional
+	EgressIPDiscovery *project.EgressIPDiscovery `json:"egressIpDiscovery,omitempty"`
+
 	// MaintenanceWindow allows to specify a preferred time in the week to run maintenance operations. See more
 	// information at https://www.mongodb.com/docs/atlas/reference/api/maintenance-windows/
 	// +optional
@@ -72,6 +98,13 @@ type AtlasProjectSpec struct {
 	// PrivateEndpoints is a list of Private Endpoints configured for the current Project.
 	PrivateEndpoints []PrivateEndpoint `json:"privateEndpoints,omitempty"`
 
+	// RegionalizedPrivateEndpoints enables the regionalized private endpoint mode of the project, required to connect to
+	// multi-region clusters through private endpoints in several regions of the same cloud provider. The mode can't be
+	// disabled while the project has private endpoints in several regions of a cloud provider.
+	// The mode is left as it is in Atlas when not set.
+	// +optional
+	RegionalizedPrivateEndpoints *bool `json:"regionalizedPrivateEndpoints,omitempty"`
+
 	// CloudProviderAccessRoles is a list of Cloud Provider Access Roles configured for the current Project.
 	// Deprecated: This configuration was deprecated in favor of CloudProviderIntegrations
 	CloudProviderAccessRoles []CloudProviderAccessRole `json:"cloudProviderAccessRoles,omitempty"`
@@ -120,9 +153,30 @@ type AtlasProjectSpec struct {
 	// +optional
 	CustomRoles []CustomRole `json:"customRoles,omitempty"`
 
+	// CustomRolesImport imports the custom roles defined in Atlas but missing from customRoles instead of deleting them,
+	// easing the migration of projects with roles defined in the Atlas UI.
+	// +optional
+	CustomRolesImport *CustomRolesImport `json:"customRolesImport,omitempty"`
+
 	// Teams enable you to grant project access roles to multiple users.
 	// +optional
 	Teams []Team `json:"teams,omitempty"`
+
+	// DefaultDatabaseUser creates an AtlasDatabaseUser in the namespace of the project, with a generated password kept
+	// in a Secret, both owned by the project. They are removed once the field is removed.
+	// +optional
+	DefaultDatabaseUser *DefaultDatabaseUser `json:"defaultDatabaseUser,omitempty"`
+
+	// ProjectInvitations invite users to the project with the given roles.
+	// Invitations must be accepted by the users, expired invitations are sent again.
+	// +optional
+	ProjectInvitations []ProjectInvitation `json:"projectInvitations,omitempty"`
+
+	// ReportUnmanagedDeployments lists in the status the deployments of the project in Atlas which no AtlasDeployment
+	// manages, e.g. created in the Atlas UI, so that they can be adopted or removed. They are never changed by the
+	// Operator. The list is refreshed every 10 minutes
+	// +optional
+	ReportUnmanagedDeployments bool `json:"reportUnmanagedDeployments,omitempty"`
 }
 
 const hiddenField = "*** redacted ***"
@@ -157,6 +211,7 @@ func (p AtlasProjectSpec) MarshalLogObject(e zapcore.ObjectEncoder) error {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
+// +kubebuilder:resource:categories={atlas,atlas-project}
 // +kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.spec.name`
 // +kubebuilder:subresource:status
 // +groupName:=atlas.mongodb.com
@@ -250,6 +305,11 @@ func (p *AtlasProject) WithAtlasName(name string) *AtlasProject {
 	return p
 }
 
+func (p *AtlasProject) WithOrgID(orgID string) *AtlasProject {
+	p.Spec.OrgID = orgID
+	return p
+}
+
 func (p *AtlasProject) WithConnectionSecret(name string) *AtlasProject {
 	if name != "" {
 		p.Spec.ConnectionSecret = &common.ResourceRefNamespaced{Name: name, Namespace: p.Namespace}
@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasProjectAPIKey{}, &AtlasProjectAPIKeyList{})
+}
+
+// AtlasProjectAPIKeySpec defines the desired state of a programmatic API key scoped to an Atlas project. The key pair is
+// written into a Secret with the same layout as the Atlas credentials Secrets of the operator
+type AtlasProjectAPIKeySpec struct {
+	// Project is a reference to the AtlasProject the key is scoped to.
+	Project common.ResourceRefNamespaced `json:"projectRef"`
+
+	// Description of the key in Atlas, the namespace and name of the resource when not set.
+	// +kubebuilder:validation:MaxLength=250
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Roles the key has over the project.
+	// +kubebuilder:validation:MinItems=1
+	Roles []TeamRole `json:"roles"`
+
+	// SecretRef is the name of the Secret the key pair is written into, in the namespace of the resource. It defaults
+	// to the name of the resource suffixed with -api-key.
+	// +optional
+	SecretRef *common.ResourceRef `json:"secretRef,omitempty"`
+
+	// TTL is how long a key is used before being rotated, the key is never rotated on its own when not set. The
+	// annotation mongodb.com/atlas-rotate-api-key rotates the key on demand.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
+
+func (s *AtlasProjectAPIKeySpec) RoleNames() []string {
+	roles := make([]string, 0, len(s.Roles))
+	for _, role := range s.Roles {
+		roles = append(roles, string(role))
+	}
+
+	return roles
+}
+
+// AtlasProjectAPIKey is the Schema for the atlasprojectapikeys API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories={atlas,atlas-security}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Public Key",type=string,JSONPath=`.status.publicKey`
+// +kubebuilder:printcolumn:name="Created",type=string,JSONPath=`.status.createdAt`
+type AtlasProjectAPIKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasProjectAPIKeySpec          `json:"spec,omitempty"`
+	Status status.AtlasProjectAPIKeyStatus `json:"status,omitempty"`
+}
+
+func (k *AtlasProjectAPIKey) AtlasProjectObjectKey() client.ObjectKey {
+	return *k.Spec.Project.GetObject(k.Namespace)
+}
+
+// SecretObjectKey returns the key of the Secret the key pair is written into
+func (k *AtlasProjectAPIKey) SecretObjectKey() client.ObjectKey {
+	if k.Spec.SecretRef != nil && k.Spec.SecretRef.Name != "" {
+		return client.ObjectKey{Namespace: k.Namespace, Name: k.Spec.SecretRef.Name}
+	}
+
+	return client.ObjectKey{Namespace: k.Namespace, Name: fmt.Sprintf("%s-api-key", k.Name)}
+}
+
+// KeyDescription returns the description of the key in Atlas
+func (k *AtlasProjectAPIKey) KeyDescription() string {
+	if k.Spec.Description != "" {
+		return k.Spec.Description
+	}
+
+	return fmt.Sprintf("%s/%s", k.Namespace, k.Name)
+}
+
+func (k *AtlasProjectAPIKey) GetStatus() status.Status {
+	return k.Status
+}
+
+func (k *AtlasProjectAPIKey) UpdateStatus(conditions []status.Condition, options ...status.Option) {
+	k.Status.Conditions = conditions
+	k.Status.ObservedGeneration = k.ObjectMeta.Generation
+
+	for _, o := range options {
+		// This will fail if the Option passed is incorrect - which is expected
+		v := o.(status.AtlasProjectAPIKeyStatusOption)
+		v(&k.Status)
+	}
+}
+
+// AtlasProjectAPIKeyList contains a list of AtlasProjectAPIKey
+// +kubebuilder:object:root=true
+type AtlasProjectAPIKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasProjectAPIKey `json:"items"`
+}
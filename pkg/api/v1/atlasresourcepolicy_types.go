@@ -0,0 +1,98 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasResourcePolicy{}, &AtlasResourcePolicyList{})
+}
+
+// +kubebuilder:validation:Enum=AWS;GCP;AZURE
+
+type ResourcePolicyCloudProvider string
+
+// ResourcePolicyRegion is a region of a cloud provider written as <provider>:<region> in lowercase
+// +kubebuilder:validation:Pattern=`^(aws|gcp|azure):[a-z0-9-]+$`
+type ResourcePolicyRegion string
+
+// AtlasResourcePolicySpec defines the desired state of an Atlas organization resource policy. The policy restricts the
+// configurations the users of the organization can apply to its projects and clusters. The rules are either set with
+// the dedicated fields or written as Cedar policies, and are all part of the same resource policy in Atlas
+type AtlasResourcePolicySpec struct {
+	// Name of the resource policy
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Description of the resource policy
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// ConnectionSecretRef is the Secret with the API keys of the organization, the global operator Secret is used when
+	// not set. The keys must have the Organization Owner role.
+	// +optional
+	ConnectionSecretRef *common.ResourceRefNamespaced `json:"connectionSecretRef,omitempty"`
+
+	// AllowedCloudProviders are the only cloud providers the clusters of the organization can be deployed to
+	// +optional
+	AllowedCloudProviders []ResourcePolicyCloudProvider `json:"allowedCloudProviders,omitempty"`
+
+	// ForbiddenRegions are the regions the clusters of the organization can't be deployed to, written as
+	// <provider>:<region> in lowercase, e.g. aws:us-east-1 or gcp:europe-west1
+	// +optional
+	ForbiddenRegions []ResourcePolicyRegion `json:"forbiddenRegions,omitempty"`
+
+	// ForbidPublicIPAccess forbids adding 0.0.0.0/0 to the IP access lists of the projects of the organization
+	// +optional
+	ForbidPublicIPAccess bool `json:"forbidPublicIpAccess,omitempty"`
+
+	// Policies are additional rules written in the Cedar language supported by Atlas
+	// +optional
+	Policies []string `json:"policies,omitempty"`
+}
+
+// AtlasResourcePolicy is the Schema for the atlasresourcepolicies API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories={atlas,atlas-security}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.spec.name`
+// +kubebuilder:printcolumn:name="Policy ID",type=string,JSONPath=`.status.id`
+type AtlasResourcePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasResourcePolicySpec          `json:"spec,omitempty"`
+	Status status.AtlasResourcePolicyStatus `json:"status,omitempty"`
+}
+
+func (p *AtlasResourcePolicy) ConnectionSecretObjectKey() *client.ObjectKey {
+	return p.Spec.ConnectionSecretRef.GetObject(p.Namespace)
+}
+
+func (p *AtlasResourcePolicy) GetStatus() status.Status {
+	return p.Status
+}
+
+func (p *AtlasResourcePolicy) UpdateStatus(conditions []status.Condition, options ...status.Option) {
+	p.Status.Conditions = conditions
+	p.Status.ObservedGeneration = p.ObjectMeta.Generation
+
+	for _, opt := range options {
+		// This will fail if the Option passed is incorrect - which is expected
+		v := opt.(status.AtlasResourcePolicyStatusOption)
+		v(&p.Status)
+	}
+}
+
+// AtlasResourcePolicyList contains a list of AtlasResourcePolicy
+// +kubebuilder:object:root=true
+type AtlasResourcePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasResourcePolicy `json:"items"`
+}
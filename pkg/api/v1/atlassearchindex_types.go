@@ -0,0 +1,205 @@
+package v1
+
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+const (
+	SearchIndexTypeSearch       = "search"
+	SearchIndexTypeVectorSearch = "vectorSearch"
+
+	VectorSearchFieldTypeVector = "vector"
+	VectorSearchFieldTypeFilter = "filter"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasSearchIndex{}, &AtlasSearchIndexList{})
+}
+
+// AtlasSearchIndexSpec defines the desired state of an Atlas Search index of a collection
+type AtlasSearchIndexSpec struct {
+	// DeploymentRef is a reference to the AtlasDeployment the index is created in.
+	// The index is managed with the API credentials of the project of the deployment.
+	DeploymentRef common.ResourceRefNamespaced `json:"deploymentRef"`
+
+	// Name of the index. It must be unique within the collection.
+	Name string `json:"name"`
+
+	// Database is the name of the database of the indexed collection.
+	Database string `json:"database"`
+
+	// CollectionName is the name of the indexed collection.
+	CollectionName string `json:"collectionName"`
+
+	// Type of the index: search for Atlas Search indexes, vectorSearch for Atlas Vector Search indexes.
+	// +kubebuilder:validation:Enum=search;vectorSearch
+	// +kubebuilder:default:=search
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// Analyzer converts the text of the fields when the index is built, e.g. "lucene.standard".
+	// +optional
+	Analyzer string `json:"analyzer,omitempty"`
+
+	// SearchAnalyzer converts the text of the queries. Defaults to the analyzer of the index.
+	// +optional
+	SearchAnalyzer string `json:"searchAnalyzer,omitempty"`
+
+	// Mappings defines how the fields of the documents are indexed. Required by the search indexes.
+	// +optional
+	Mappings *SearchIndexMappings `json:"mappings,omitempty"`
+
+	// Analyzers are the custom analyzers of the index.
+	// +optional
+	Analyzers []SearchIndexAnalyzer `json:"analyzers,omitempty"`
+
+	// Synonyms are the synonym mappings of the index.
+	// +optional
+	Synonyms []SearchIndexSynonym `json:"synonyms,omitempty"`
+
+	// StoredSource defines the fields of the documents stored on Atlas Search: true, false, {"include": [...]} or
+	// {"exclude": [...]}.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	// +optional
+	StoredSource *apiextensionsv1.JSON `json:"storedSource,omitempty"`
+
+	// Fields are the fields of a vectorSearch index: the vector fields holding the embeddings and the fields the
+	// queries can be filtered on. Required by the vectorSearch indexes.
+	// +optional
+	Fields []VectorSearchField `json:"fields,omitempty"`
+}
+
+// VectorSearchField is a field of a vectorSearch index
+type VectorSearchField struct {
+	// Type of the field: vector for the embeddings, filter for the fields used to pre-filter the queries.
+	// +kubebuilder:validation:Enum=vector;filter
+	Type string `json:"type"`
+
+	// Path is the name of the field, in dot notation for the embedded fields.
+	Path string `json:"path"`
+
+	// NumDimensions is the number of dimensions of the embeddings of a vector field.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4096
+	// +optional
+	NumDimensions int `json:"numDimensions,omitempty"`
+
+	// Similarity is the function measuring the similarity of the embeddings of a vector field.
+	// +kubebuilder:validation:Enum=euclidean;cosine;dotProduct
+	// +optional
+	Similarity string `json:"similarity,omitempty"`
+
+	// Quantization is the compression of the embeddings of a vector field. Defaults to none.
+	// +kubebuilder:validation:Enum=none;scalar;binary
+	// +optional
+	Quantization string `json:"quantization,omitempty"`
+}
+
+// SearchIndexMappings defines how the fields of the documents are indexed
+type SearchIndexMappings struct {
+	// Dynamic indexes all the fields of supported types automatically.
+	// +kubebuilder:default:=false
+	// +optional
+	Dynamic bool `json:"dynamic,omitempty"`
+
+	// Fields are the field mappings of the index, by field name, as documented by Atlas Search.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	// +optional
+	Fields *apiextensionsv1.JSON `json:"fields,omitempty"`
+}
+
+// SearchIndexAnalyzer is a custom analyzer of the index
+type SearchIndexAnalyzer struct {
+	// Name of the analyzer, it can't start with "lucene.", "builtin." or "mongodb.".
+	Name string `json:"name"`
+
+	// CharFilters are the character filters applied before the tokenization.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	// +optional
+	CharFilters []apiextensionsv1.JSON `json:"charFilters,omitempty"`
+
+	// Tokenizer splits the text into tokens.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	Tokenizer apiextensionsv1.JSON `json:"tokenizer"`
+
+	// TokenFilters are the filters applied to the tokens.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	// +optional
+	TokenFilters []apiextensionsv1.JSON `json:"tokenFilters,omitempty"`
+}
+
+// SearchIndexSynonym is a synonym mapping of the index
+type SearchIndexSynonym struct {
+	// Name of the synonym mapping, unique within the index.
+	Name string `json:"name"`
+
+	// Analyzer applied to the synonyms.
+	Analyzer string `json:"analyzer"`
+
+	// Source is the collection of the synonyms, in the database of the index.
+	Source SearchIndexSynonymSource `json:"source"`
+}
+
+// SearchIndexSynonymSource is the collection holding the synonyms
+type SearchIndexSynonymSource struct {
+	// Collection is the name of the collection holding the synonyms.
+	Collection string `json:"collection"`
+}
+
+// AtlasSearchIndex is the Schema for the atlassearchindices API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories={atlas,atlas-data}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
+// +kubebuilder:printcolumn:name="Database",type=string,JSONPath=`.spec.database`
+// +kubebuilder:printcolumn:name="Collection",type=string,JSONPath=`.spec.collectionName`
+// +kubebuilder:printcolumn:name="Atlas Status",type=string,JSONPath=`.status.atlasStatus`
+type AtlasSearchIndex struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasSearchIndexSpec          `json:"spec,omitempty"`
+	Status status.AtlasSearchIndexStatus `json:"status,omitempty"`
+}
+
+func (i *AtlasSearchIndex) DeploymentObjectKey() client.ObjectKey {
+	return *i.Spec.DeploymentRef.GetObject(i.Namespace)
+}
+
+func (i *AtlasSearchIndex) IsVectorSearch() bool {
+	return i.Spec.Type == SearchIndexTypeVectorSearch
+}
+
+func (i *AtlasSearchIndex) GetStatus() status.Status {
+	return i.Status
+}
+
+func (i *AtlasSearchIndex) UpdateStatus(conditions []status.Condition, options ...status.Option) {
+	i.Status.Conditions = conditions
+	i.Status.ObservedGeneration = i.ObjectMeta.Generation
+
+	for _, o := range options {
+		// This will fail if the Option passed is incorrect - which is expected
+		v := o.(status.AtlasSearchIndexStatusOption)
+		v(&i.Status)
+	}
+}
+
+// AtlasSearchIndexList contains a list of AtlasSearchIndex
+// +kubebuilder:object:root=true
+type AtlasSearchIndexList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasSearchIndex `json:"items"`
+}
@@ -18,6 +18,7 @@ package v1
 
 import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/compat"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
 
 	"go.mongodb.org/atlas/mongodbatlas"
@@ -25,6 +26,7 @@ import (
 )
 
 // +kubebuilder:object:root=true
+// +kubebuilder:resource:categories={atlas,atlas-security}
 // +kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.spec.name`
 // +kubebuilder:subresource:status
 
@@ -46,7 +48,17 @@ type TeamSpec struct {
 	// The name of the team you want to create.
 	Name string `json:"name"`
 	// Valid email addresses of users to add to the new team
-	Usernames []TeamUser `json:"usernames"`
+	// +optional
+	Usernames []TeamUser `json:"usernames,omitempty"`
+
+	// UsernamesConfigMapRef is a reference to a ConfigMap listing more users of the team, in any of its keys, separated
+	// by commas, spaces or new lines. The team is kept in sync with the ConfigMap, so that rosters maintained outside of
+	// the AtlasTeam don't require editing it.
+	// Sourcing the users from the groups of an identity provider isn't supported: neither Atlas nor the
+	// AtlasFederatedAuth expose the members of a group, so the operator can't list them. Give the groups access with
+	// the roleMappings of the AtlasFederatedAuth instead.
+	// +optional
+	UsernamesConfigMapRef *common.ResourceRefNamespaced `json:"usernamesConfigMapRef,omitempty"`
 }
 
 // +kubebuilder:object:root=true
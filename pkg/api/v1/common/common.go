@@ -41,6 +41,28 @@ type LabelSpec struct {
 	Value string `json:"value"`
 }
 
+// CreationPolicy controls whether the Operator may create a resource in Atlas or must only bind to an existing one
+type CreationPolicy string
+
+const (
+	// CreationPolicyCreateIfMissing binds to the resource with the same name in Atlas and creates it when missing
+	CreationPolicyCreateIfMissing CreationPolicy = "CreateIfMissing"
+	// CreationPolicyMustExist only binds to an existing resource, the Operator never creates it
+	CreationPolicyMustExist CreationPolicy = "MustExist"
+	// CreationPolicyMustNotExist only creates the resource, the Operator refuses to bind to one already in Atlas
+	CreationPolicyMustNotExist CreationPolicy = "MustNotExist"
+)
+
+// AllowsCreation reports whether the Operator may create the resource in Atlas
+func (p CreationPolicy) AllowsCreation() bool {
+	return p != CreationPolicyMustExist
+}
+
+// AllowsAcquisition reports whether the Operator may bind to a resource already in Atlas that it didn't create
+func (p CreationPolicy) AllowsAcquisition() bool {
+	return p != CreationPolicyMustNotExist
+}
+
 func (rn *ResourceRefNamespaced) GetObject(parentNamespace string) *client.ObjectKey {
 	if rn == nil {
 		return nil
@@ -15,6 +15,18 @@ type CustomRole struct {
 	Actions []Action `json:"actions,omitempty"`
 }
 
+// CustomRolesImport configures the adoption of the custom roles defined in Atlas, e.g. in the Atlas UI, but missing from
+// the spec: they are imported instead of being deleted as drift
+type CustomRolesImport struct {
+	// Enabled keeps the custom roles missing from the spec in Atlas and reports them as IMPORTED in the status. The roles
+	// removed from the spec are still deleted.
+	Enabled bool `json:"enabled"`
+	// ConfigMapName is the name of the ConfigMap, in the namespace of the project, the imported roles are written to
+	// as a customRoles spec snippet, ready to be copied into the AtlasProject.
+	// +optional
+	ConfigMapName string `json:"configMapName,omitempty"`
+}
+
 type Role struct {
 	// Human-readable label that identifies the role inherited.
 	Name string `json:"name"`
@@ -0,0 +1,14 @@
+package v1
+
+// DefaultDatabaseUser is the initial database user created with the project, so that simple environments don't need
+// to declare the AtlasDatabaseUser and its password Secret
+type DefaultDatabaseUser struct {
+	// Username of the database user. Default value is 'admin'.
+	// +kubebuilder:default=admin
+	// +optional
+	Username string `json:"username,omitempty"`
+
+	// Roles of the database user. The user is an atlasAdmin of the project when empty.
+	// +optional
+	Roles []RoleSpec `json:"roles,omitempty"`
+}
@@ -51,6 +51,29 @@ type NetworkPeer struct {
 	// GCP Network Peer Name. Its applicable only for GCP.
 	// +optional
 	NetworkName string `json:"networkName,omitempty"`
+	// AWSAutoAccept lets the operator accept the peering connection in the user's AWS account. Its applicable only for AWS.
+	// +optional
+	AWSAutoAccept *AWSPeeringAutoAccept `json:"awsAutoAccept,omitempty"`
+}
+
+// AWSPeeringAutoAccept configures the acceptance of an AWS peering connection by the operator, with the AWS credentials
+// it runs with (e.g. IAM Roles for Service Accounts)
+type AWSPeeringAutoAccept struct {
+	// Enabled accepts the peering connection once Atlas initiated it.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// UpdateRouteTables adds a route to the Atlas CIDR block through the peering connection once it is available.
+	// A route table already routing the Atlas CIDR block through another target is left unchanged and reported as an error.
+	// +optional
+	UpdateRouteTables bool `json:"updateRouteTables,omitempty"`
+	// RouteTableIDs are the route tables to update. All the route tables of the VPC are updated when not set.
+	// +optional
+	RouteTableIDs []string `json:"routeTableIds,omitempty"`
+}
+
+// IsEnabled reports whether the operator accepts the AWS peering connection
+func (in *AWSPeeringAutoAccept) IsEnabled() bool {
+	return in != nil && in.Enabled
 }
 
 // NewNetworkPeerFromAtlas creates a network peer based off a network peering connection from Atlas.
@@ -26,13 +26,22 @@ type PrivateEndpoint struct {
 	// +optional
 	GCPProjectID string `json:"gcpProjectId,omitempty"`
 	// Unique identifier of the endpoint group. The endpoint group encompasses all of the endpoints that you created in Google Cloud.
+	// A GCP region may be listed several times with different endpoint groups, all connected to the same Private Service Connect service.
 	// +optional
 	EndpointGroupName string `json:"endpointGroupName,omitempty"`
-	// Collection of individual private endpoints that comprise your endpoint group.
+	// Collection of individual private endpoints that comprise your endpoint group, up to 50.
 	// +optional
 	Endpoints GCPEndpoints `json:"endpoints,omitempty"`
+	// Name of the endpoint to create in the cloud provider, reported in the status to name and tag it. It can be a
+	// template using .Project, .Namespace, .Name, .Provider, .Region, .Environment, .Index and .Group, e.g.
+	// "{{ .Project }}-{{ .Environment }}-{{ .Region }}". .Index is the position of the endpoint in the list and .Group its
+	// endpointGroupName, to tell apart the GCP endpoint groups of a region. The names must be unique.
+	// +optional
+	Name string `json:"name,omitempty"`
 }
 
+// +kubebuilder:validation:MaxItems=50
+
 type GCPEndpoints []GCPEndpoint
 
 type GCPEndpoint struct {
@@ -0,0 +1,3 @@
+package project
+
+// +k8s:deepcopy-gen=package
@@ -43,6 +43,10 @@ type Integration struct {
 	OrgName string `json:"orgName,omitempty"`
 	// +optional
 	URL string `json:"url,omitempty"`
+	// URLRef references a Secret holding the URL of a WEBHOOK integration under the "password" key, instead of URL.
+	// The integration is updated in Atlas when the Secret changes, to rotate the URL of the webhook
+	// +optional
+	URLRef common.ResourceRefNamespaced `json:"urlRef,omitempty"`
 	// +optional
 	SecretRef common.ResourceRefNamespaced `json:"secretRef,omitempty"`
 	// +optional
@@ -59,6 +63,11 @@ type Integration struct {
 	Scheme string `json:"scheme,omitempty"`
 	// +optional
 	Enabled bool `json:"enabled,omitempty"`
+	// ValidateWebhook sends a test notification, signed with the secret, to a WEBHOOK integration once its URL or
+	// secret changed in Atlas. The result is reported in the status of the project. The notification is posted by the
+	// Operator from inside the cluster to the URL read from the Secret, whichever host it points to
+	// +optional
+	ValidateWebhook bool `json:"validateWebhook,omitempty"`
 }
 
 func (i Integration) ToAtlas(ctx context.Context, c client.Client, defaultNS string) (result *mongodbatlas.ThirdPartyIntegration, err error) {
@@ -97,6 +106,7 @@ func (i Integration) ToAtlas(ctx context.Context, c client.Client, defaultNS str
 	readPassword(i.APITokenRef, &result.APIToken, &errorList)
 	readPassword(i.RoutingKeyRef, &result.RoutingKey, &errorList)
 	readPassword(i.SecretRef, &result.Secret, &errorList)
+	readPassword(i.URLRef, &result.URL, &errorList)
 	readPassword(i.PasswordRef, &result.Password, &errorList)
 
 	if len(errorList) != 0 {
@@ -10,6 +10,7 @@ import (
 
 type IPAccessList struct {
 	// Unique identifier of AWS security group in this access list entry.
+	// The project must have a network peering connection with AWS to use security groups.
 	// +optional
 	AwsSecurityGroup string `json:"awsSecurityGroup,omitempty"`
 	// Range of IP addresses in CIDR notation in this access list entry.
@@ -26,6 +27,18 @@ type IPAccessList struct {
 	IPAddress string `json:"ipAddress,omitempty"`
 }
 
+// EgressIPDiscovery configures the registration of the egress IPs of the Kubernetes cluster in the IP Access List
+type EgressIPDiscovery struct {
+	// Enabled adds the egress IPs discovered by the operator, e.g. the external IPs of the nodes, to the IP Access List.
+	// The entries are updated as the nodes change
+	Enabled bool `json:"enabled"`
+}
+
+// IsEnabled returns whether the egress IPs are added to the IP Access List
+func (e *EgressIPDiscovery) IsEnabled() bool {
+	return e != nil && e.Enabled
+}
+
 // ToAtlas converts the ProjectIPAccessList to native Atlas client format.
 func (i IPAccessList) ToAtlas() (*mongodbatlas.ProjectIPAccessList, error) {
 	result := &mongodbatlas.ProjectIPAccessList{}
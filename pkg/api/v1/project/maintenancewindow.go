@@ -28,6 +28,22 @@ type MaintenanceWindow struct {
 	// Cannot be specified if startASAP is true
 	// +optional
 	Defer bool `json:"defer,omitempty"`
+	// Hours of the day during which Atlas doesn't start the standard updates of the project.
+	// Requires dayOfWeek to be specified
+	// +optional
+	ProtectedHours *ProtectedHours `json:"protectedHours,omitempty"`
+}
+
+// ProtectedHours defines the hours of the day during which Atlas doesn't start standard updates
+type ProtectedHours struct {
+	// Hour of the day when the protected hours start. This parameter uses the 24-hour clock, where midnight is 0, noon is 12.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=23
+	StartHourOfDay int `json:"startHourOfDay"`
+	// Hour of the day when the protected hours end. This parameter uses the 24-hour clock, where midnight is 0, noon is 12.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=23
+	EndHourOfDay int `json:"endHourOfDay"`
 }
 
 // ToAtlas converts the MaintenanceWindow to native Atlas client format.
@@ -72,3 +88,8 @@ func (m MaintenanceWindow) WithDefer(isDefer bool) MaintenanceWindow {
 	m.Defer = isDefer
 	return m
 }
+
+func (m MaintenanceWindow) WithProtectedHours(startHourOfDay, endHourOfDay int) MaintenanceWindow {
+	m.ProtectedHours = &ProtectedHours{StartHourOfDay: startHourOfDay, EndHourOfDay: endHourOfDay}
+	return m
+}
@@ -0,0 +1,21 @@
+package v1
+
+// ProjectInvitation invites a user to the project. The invited user joins the project with the roles once they
+// accept the invitation, which can't be automated
+type ProjectInvitation struct {
+	// Username is the email address of the invited user
+	// +kubebuilder:validation:MinLength=1
+	Username string `json:"username"`
+	// +kubebuilder:validation:MinItems=1
+	// Roles the invited user has over the project
+	Roles []TeamRole `json:"roles"`
+}
+
+func (in *ProjectInvitation) RoleNames() []string {
+	roles := make([]string, 0, len(in.Roles))
+	for _, role := range in.Roles {
+		roles = append(roles, string(role))
+	}
+
+	return roles
+}
@@ -0,0 +1,15 @@
+package provider
+
+// maxDiskSizeGB is the maximum disk size, per cloud provider, of the instance sizes which can't use the 4096 GB
+// allowed by the CRD schema. The disk sizes of Azure come in fixed tiers which are checked by Atlas
+var maxDiskSizeGB = map[ProviderName]map[string]int{
+	ProviderAWS: {"M10": 128, "M20": 256, "M30": 512, "M40": 1024, "R40": 1024},
+	ProviderGCP: {"M10": 128, "M20": 256, "M30": 512, "M40": 1024, "R40": 1024},
+}
+
+// MaxDiskSizeGB returns the maximum disk size of the instance size on the cloud provider, false when it isn't known
+func MaxDiskSizeGB(providerName ProviderName, instanceSize string) (int, bool) {
+	maxSize, ok := maxDiskSizeGB[providerName][instanceSize]
+
+	return maxSize, ok
+}
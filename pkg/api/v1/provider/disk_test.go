@@ -0,0 +1,19 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxDiskSizeGB(t *testing.T) {
+	maxSize, ok := MaxDiskSizeGB(ProviderAWS, "M10")
+	assert.True(t, ok)
+	assert.Equal(t, 128, maxSize)
+
+	_, ok = MaxDiskSizeGB(ProviderAWS, "M50")
+	assert.False(t, ok)
+
+	_, ok = MaxDiskSizeGB(ProviderAzure, "M10")
+	assert.False(t, ok)
+}
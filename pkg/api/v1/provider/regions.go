@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"sort"
+	"strings"
+)
+
+// Region is the Atlas name of a cloud provider region, e.g. US_EAST_1
+type Region string
+
+// atlasRegions maps the Atlas regions of each cloud provider to the region name used natively by the provider.
+// The Atlas names match the regionName values accepted by the Atlas Admin API.
+//
+// The table is copied from the "Cloud Providers and Regions" pages of the Atlas documentation and was last synced with
+// them on 2026-10-17:
+//   - https://www.mongodb.com/docs/atlas/reference/amazon-aws/
+//   - https://www.mongodb.com/docs/atlas/reference/google-gcp/
+//   - https://www.mongodb.com/docs/atlas/reference/microsoft-azure/
+//
+// Atlas adds regions over time, so a region missing here only produces a warning. Update the table and the date above
+// when syncing it again.
+var atlasRegions = map[ProviderName]map[Region]string{
+	ProviderAWS: {
+		"US_EAST_1":      "us-east-1",
+		"US_EAST_2":      "us-east-2",
+		"US_WEST_1":      "us-west-1",
+		"US_WEST_2":      "us-west-2",
+		"US_GOV_EAST_1":  "us-gov-east-1",
+		"US_GOV_WEST_1":  "us-gov-west-1",
+		"CA_CENTRAL_1":   "ca-central-1",
+		"CA_WEST_1":      "ca-west-1",
+		"SA_EAST_1":      "sa-east-1",
+		"EU_NORTH_1":     "eu-north-1",
+		"EU_WEST_1":      "eu-west-1",
+		"EU_WEST_2":      "eu-west-2",
+		"EU_WEST_3":      "eu-west-3",
+		"EU_CENTRAL_1":   "eu-central-1",
+		"EU_CENTRAL_2":   "eu-central-2",
+		"EU_SOUTH_1":     "eu-south-1",
+		"EU_SOUTH_2":     "eu-south-2",
+		"AP_EAST_1":      "ap-east-1",
+		"AP_NORTHEAST_1": "ap-northeast-1",
+		"AP_NORTHEAST_2": "ap-northeast-2",
+		"AP_NORTHEAST_3": "ap-northeast-3",
+		"AP_SOUTHEAST_1": "ap-southeast-1",
+		"AP_SOUTHEAST_2": "ap-southeast-2",
+		"AP_SOUTHEAST_3": "ap-southeast-3",
+		"AP_SOUTHEAST_4": "ap-southeast-4",
+		"AP_SOUTH_1":     "ap-south-1",
+		"AP_SOUTH_2":     "ap-south-2",
+		"CN_NORTH_1":     "cn-north-1",
+		"CN_NORTHWEST_1": "cn-northwest-1",
+		"ME_SOUTH_1":     "me-south-1",
+		"ME_CENTRAL_1":   "me-central-1",
+		"AF_SOUTH_1":     "af-south-1",
+		"IL_CENTRAL_1":   "il-central-1",
+	},
+	ProviderGCP: {
+		"CENTRAL_US":                "us-central1",
+		"EASTERN_US":                "us-east1",
+		"US_EAST_4":                 "us-east4",
+		"US_EAST_5":                 "us-east5",
+		"WESTERN_US":                "us-west1",
+		"US_WEST_2":                 "us-west2",
+		"US_WEST_3":                 "us-west3",
+		"US_WEST_4":                 "us-west4",
+		"US_SOUTH_1":                "us-south1",
+		"NORTH_AMERICA_NORTHEAST_1": "northamerica-northeast1",
+		"NORTH_AMERICA_NORTHEAST_2": "northamerica-northeast2",
+		"SOUTH_AMERICA_EAST_1":      "southamerica-east1",
+		"SOUTH_AMERICA_WEST_1":      "southamerica-west1",
+		"WESTERN_EUROPE":            "europe-west1",
+		"EUROPE_NORTH_1":            "europe-north1",
+		"EUROPE_WEST_2":             "europe-west2",
+		"EUROPE_WEST_3":             "europe-west3",
+		"EUROPE_WEST_4":             "europe-west4",
+		"EUROPE_WEST_6":             "europe-west6",
+		"EUROPE_WEST_8":             "europe-west8",
+		"EUROPE_WEST_9":             "europe-west9",
+		"EUROPE_WEST_10":            "europe-west10",
+		"EUROPE_WEST_12":            "europe-west12",
+		"EUROPE_CENTRAL_2":          "europe-central2",
+		"EUROPE_SOUTHWEST_1":        "europe-southwest1",
+		"EASTERN_ASIA_PACIFIC":      "asia-east1",
+		"ASIA_EAST_2":               "asia-east2",
+		"NORTHEASTERN_ASIA_PACIFIC": "asia-northeast1",
+		"ASIA_NORTHEAST_2":          "asia-northeast2",
+		"ASIA_NORTHEAST_3":          "asia-northeast3",
+		"SOUTHEASTERN_ASIA_PACIFIC": "asia-southeast1",
+		"ASIA_SOUTHEAST_2":          "asia-southeast2",
+		"ASIA_SOUTH_1":              "asia-south1",
+		"ASIA_SOUTH_2":              "asia-south2",
+		"AUSTRALIA_SOUTHEAST_1":     "australia-southeast1",
+		"AUSTRALIA_SOUTHEAST_2":     "australia-southeast2",
+		"MIDDLE_EAST_CENTRAL_1":     "me-central1",
+		"MIDDLE_EAST_CENTRAL_2":     "me-central2",
+		"MIDDLE_EAST_WEST_1":        "me-west1",
+		"AFRICA_SOUTH_1":            "africa-south1",
+	},
+	ProviderAzure: {
+		"US_CENTRAL":           "centralus",
+		"US_EAST":              "eastus",
+		"US_EAST_2":            "eastus2",
+		"US_NORTH_CENTRAL":     "northcentralus",
+		"US_WEST":              "westus",
+		"US_WEST_2":            "westus2",
+		"US_WEST_3":            "westus3",
+		"US_WEST_CENTRAL":      "westcentralus",
+		"US_SOUTH_CENTRAL":     "southcentralus",
+		"US_GOV_VIRGINIA":      "usgovvirginia",
+		"US_GOV_ARIZONA":       "usgovarizona",
+		"US_GOV_TEXAS":         "usgovtexas",
+		"BRAZIL_SOUTH":         "brazilsouth",
+		"BRAZIL_SOUTHEAST":     "brazilsoutheast",
+		"CANADA_EAST":          "canadaeast",
+		"CANADA_CENTRAL":       "canadacentral",
+		"EUROPE_NORTH":         "northeurope",
+		"EUROPE_WEST":          "westeurope",
+		"UK_SOUTH":             "uksouth",
+		"UK_WEST":              "ukwest",
+		"FRANCE_CENTRAL":       "francecentral",
+		"FRANCE_SOUTH":         "francesouth",
+		"ITALY_NORTH":          "italynorth",
+		"GERMANY_WEST_CENTRAL": "germanywestcentral",
+		"GERMANY_NORTH":        "germanynorth",
+		"POLAND_CENTRAL":       "polandcentral",
+		"SWITZERLAND_NORTH":    "switzerlandnorth",
+		"SWITZERLAND_WEST":     "switzerlandwest",
+		"NORWAY_EAST":          "norwayeast",
+		"NORWAY_WEST":          "norwaywest",
+		"SWEDEN_CENTRAL":       "swedencentral",
+		"SWEDEN_SOUTH":         "swedensouth",
+		"INDIA_CENTRAL":        "centralindia",
+		"INDIA_SOUTH":          "southindia",
+		"INDIA_WEST":           "westindia",
+		"CHINA_EAST":           "chinaeast",
+		"CHINA_NORTH":          "chinanorth",
+		"ASIA_EAST":            "eastasia",
+		"ASIA_SOUTH_EAST":      "southeastasia",
+		"JAPAN_EAST":           "japaneast",
+		"JAPAN_WEST":           "japanwest",
+		"KOREA_CENTRAL":        "koreacentral",
+		"KOREA_SOUTH":          "koreasouth",
+		"AUSTRALIA_EAST":       "australiaeast",
+		"AUSTRALIA_SOUTH_EAST": "australiasoutheast",
+		"AUSTRALIA_CENTRAL":    "australiacentral",
+		"AUSTRALIA_CENTRAL_2":  "australiacentral2",
+		"UAE_NORTH":            "uaenorth",
+		"UAE_CENTRAL":          "uaecentral",
+		"SOUTH_AFRICA_NORTH":   "southafricanorth",
+		"SOUTH_AFRICA_WEST":    "southafricawest",
+		"QATAR_CENTRAL":        "qatarcentral",
+		"ISRAEL_CENTRAL":       "israelcentral",
+	},
+}
+
+// HasRegions returns true if the Atlas regions of the provider are known
+func HasRegions(providerName ProviderName) bool {
+	_, ok := atlasRegions[providerName]
+
+	return ok
+}
+
+// IsRegionSupported returns true if the region is an Atlas region of the provider
+func IsRegionSupported(providerName ProviderName, region string) bool {
+	_, ok := atlasRegions[providerName][Region(region)]
+
+	return ok
+}
+
+// AtlasRegion returns the Atlas region matching the region name used natively by the provider, e.g. us-east-1 for AWS
+func AtlasRegion(providerName ProviderName, cloudRegion string) (Region, bool) {
+	for region, nativeName := range atlasRegions[providerName] {
+		if strings.EqualFold(nativeName, cloudRegion) {
+			return region, true
+		}
+	}
+
+	return "", false
+}
+
+// RegionProviders returns the providers on which the Atlas region exists
+func RegionProviders(region string) []ProviderName {
+	var providers []ProviderName
+	for providerName, regions := range atlasRegions {
+		if _, ok := regions[Region(region)]; ok {
+			providers = append(providers, providerName)
+		}
+	}
+
+	sort.Slice(providers, func(i, j int) bool { return providers[i] < providers[j] })
+
+	return providers
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsRegionSupported(t *testing.T) {
+	assert.True(t, IsRegionSupported(ProviderAWS, "US_EAST_1"))
+	assert.True(t, IsRegionSupported(ProviderGCP, "EASTERN_US"))
+	assert.True(t, IsRegionSupported(ProviderAzure, "US_EAST_2"))
+	assert.False(t, IsRegionSupported(ProviderAWS, "us-east-1"))
+	assert.False(t, IsRegionSupported(ProviderAWS, "EASTERN_US"))
+	assert.False(t, IsRegionSupported(ProviderTenant, "US_EAST_1"))
+}
+
+func TestAtlasRegion(t *testing.T) {
+	tests := map[string]struct {
+		provider    ProviderName
+		cloudRegion string
+		expected    Region
+		found       bool
+	}{
+		"AWS region":               {provider: ProviderAWS, cloudRegion: "us-east-1", expected: "US_EAST_1", found: true},
+		"AWS region in uppercase":  {provider: ProviderAWS, cloudRegion: "EU-WEST-2", expected: "EU_WEST_2", found: true},
+		"GCP region":               {provider: ProviderGCP, cloudRegion: "us-east1", expected: "EASTERN_US", found: true},
+		"Azure region":             {provider: ProviderAzure, cloudRegion: "westeurope", expected: "EUROPE_WEST", found: true},
+		"region of another cloud":  {provider: ProviderAzure, cloudRegion: "us-east-1"},
+		"provider without regions": {provider: ProviderServerless, cloudRegion: "us-east-1"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			region, ok := AtlasRegion(tt.provider, tt.cloudRegion)
+			assert.Equal(t, tt.found, ok)
+			assert.Equal(t, tt.expected, region)
+		})
+	}
+}
+
+func TestRegionProviders(t *testing.T) {
+	assert.Equal(t, []ProviderName{ProviderAWS}, RegionProviders("US_EAST_1"))
+	assert.Equal(t, []ProviderName{ProviderAWS, ProviderAzure, ProviderGCP}, RegionProviders("US_WEST_2"))
+	assert.Empty(t, RegionProviders("us-east-1"))
+}
@@ -8,6 +8,10 @@ import (
 
 type ServerlessPrivateEndpoint struct {
 	// Name is the name of the Serverless PrivateLink Service. Should be unique.
+	// It can be a template using .Deployment, .Namespace, .Name, .Provider, .Region, .Environment and .Index, the
+	// position of the endpoint in the list, e.g. "{{ .Deployment }}-{{ .Environment }}-{{ .Index }}", so that the
+	// endpoint can be traced back to the AtlasDeployment. The endpoints connected already keep the name they were
+	// created with.
 	Name string `json:"name,omitempty"`
 	// CloudProviderEndpointID is the identifier of the cloud provider endpoint.
 	CloudProviderEndpointID string `json:"cloudProviderEndpointID,omitempty"`
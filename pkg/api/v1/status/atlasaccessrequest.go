@@ -0,0 +1,69 @@
+package status
+
+// AccessRequestPhase is the step of its lifecycle the AtlasAccessRequest is at
+type AccessRequestPhase string
+
+const (
+	AccessRequestPhasePending AccessRequestPhase = "Pending"
+	AccessRequestPhaseGranted AccessRequestPhase = "Granted"
+	AccessRequestPhaseExpired AccessRequestPhase = "Expired"
+)
+
+type AtlasAccessRequestStatus struct {
+	Common `json:",inline"`
+
+	// Approval is set by the approver of the request. The roles are granted once it's set.
+	// +optional
+	Approval *AccessRequestApproval `json:"approval,omitempty"`
+
+	// Phase is the step of the request lifecycle: Pending, Granted or Expired.
+	// +optional
+	Phase AccessRequestPhase `json:"phase,omitempty"`
+
+	// GrantedRoles are the roles granted to the database user, as requested at the time of the approval.
+	// +optional
+	GrantedRoles []GrantedRole `json:"grantedRoles,omitempty"`
+
+	// GrantedAt is the time the roles were granted, in ISO 8601 format.
+	// +optional
+	GrantedAt string `json:"grantedAt,omitempty"`
+
+	// ExpiresAt is the time the granted roles are revoked, in ISO 8601 format.
+	// +optional
+	ExpiresAt string `json:"expiresAt,omitempty"`
+}
+
+// AccessRequestApproval records who approved the request
+type AccessRequestApproval struct {
+	// ApprovedBy identifies the approver of the request.
+	ApprovedBy string `json:"approvedBy"`
+
+	// Comment is an optional note of the approver.
+	// +optional
+	Comment string `json:"comment,omitempty"`
+}
+
+// GrantedRole is a role granted to the database user by the request
+type GrantedRole struct {
+	RoleName       string `json:"roleName"`
+	DatabaseName   string `json:"databaseName"`
+	CollectionName string `json:"collectionName,omitempty"`
+}
+
+// +k8s:deepcopy-gen=false
+
+type AtlasAccessRequestStatusOption func(s *AtlasAccessRequestStatus)
+
+func AtlasAccessRequestPhaseOption(phase AccessRequestPhase) AtlasAccessRequestStatusOption {
+	return func(s *AtlasAccessRequestStatus) {
+		s.Phase = phase
+	}
+}
+
+func AtlasAccessRequestGrantOption(roles []GrantedRole, grantedAt, expiresAt string) AtlasAccessRequestStatusOption {
+	return func(s *AtlasAccessRequestStatus) {
+		s.GrantedRoles = roles
+		s.GrantedAt = grantedAt
+		s.ExpiresAt = expiresAt
+	}
+}
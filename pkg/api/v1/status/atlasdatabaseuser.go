@@ -1,5 +1,7 @@
 package status
 
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 // +k8s:deepcopy-gen=false
 
 // AtlasDatabaseUserStatusOption is the option that is applied to Atlas Project Status
@@ -17,13 +19,92 @@ func AtlasDatabaseUserNameOption(name string) AtlasDatabaseUserStatusOption {
 	}
 }
 
+// AtlasDatabaseUserX509CertificateOption sets the certificate issued by Atlas for a MANAGED X.509 user, nil removes it
+func AtlasDatabaseUserX509CertificateOption(certificate *X509CertificateStatus) AtlasDatabaseUserStatusOption {
+	return func(s *AtlasDatabaseUserStatus) {
+		s.X509Certificate = certificate
+	}
+}
+
+// AtlasDatabaseUserPasswordRotationOption sets the state of the rotation of the password, nil removes it
+func AtlasDatabaseUserPasswordRotationOption(rotation *PasswordRotationStatus) AtlasDatabaseUserStatusOption {
+	return func(s *AtlasDatabaseUserStatus) {
+		s.PasswordRotation = rotation
+	}
+}
+
+// AtlasDatabaseUserDeleteAfterDateOption sets the deleteAfterDate the user was extended to, empty removes it
+func AtlasDatabaseUserDeleteAfterDateOption(deleteAfterDate string) AtlasDatabaseUserStatusOption {
+	return func(s *AtlasDatabaseUserStatus) {
+		s.DeleteAfterDate = deleteAfterDate
+	}
+}
+
+// AtlasDatabaseUserConnectionSecretStoreOption sets the connection credentials written to the external secret store,
+// nil removes them
+func AtlasDatabaseUserConnectionSecretStoreOption(store *ConnectionSecretStoreStatus) AtlasDatabaseUserStatusOption {
+	return func(s *AtlasDatabaseUserStatus) {
+		s.ConnectionSecretStore = store
+	}
+}
+
 // AtlasDatabaseUserStatus defines the observed state of AtlasProject
 type AtlasDatabaseUserStatus struct {
 	Common `json:",inline"`
 
-	// PasswordVersion is the 'ResourceVersion' of the password Secret that the Atlas Operator is aware of
+	// PasswordVersion is the 'ResourceVersion' of the password Secret that the Atlas Operator is aware of, or the
+	// version of the password in the external secret store prefixed by the name of the store
 	PasswordVersion string `json:"passwordVersion,omitempty"`
 
 	// UserName is the current name of database user.
 	UserName string `json:"name,omitempty"`
+
+	// X509Certificate is the certificate issued by Atlas for a MANAGED X.509 user
+	X509Certificate *X509CertificateStatus `json:"x509Certificate,omitempty"`
+
+	// PasswordRotation is the state of the periodic rotation of the password
+	PasswordRotation *PasswordRotationStatus `json:"passwordRotation,omitempty"`
+
+	// DeleteAfterDate is the deleteAfterDate applied in Atlas once the Operator has extended the user past the one of
+	// the spec, in ISO 8601 date and time format in UTC
+	DeleteAfterDate string `json:"deleteAfterDate,omitempty"`
+
+	// ConnectionSecretStore lists the connection credentials the Operator wrote to the external secret store, only
+	// these are deleted when they're not needed anymore
+	ConnectionSecretStore *ConnectionSecretStoreStatus `json:"connectionSecretStore,omitempty"`
+}
+
+// ConnectionSecretStoreStatus lists the secrets holding the connection credentials of a database user in an external
+// secret store
+type ConnectionSecretStoreStatus struct {
+	// Provider is the name of the secret store provider the secrets were written to
+	Provider string `json:"provider"`
+
+	// Paths are the paths of the secrets, relative to the path prefix configured for the provider in the Operator
+	Paths []string `json:"paths,omitempty"`
+}
+
+// PasswordRotationStatus is the state of the periodic rotation of the password of a database user
+type PasswordRotationStatus struct {
+	// LastRotation is the time the Operator last generated a new password
+	LastRotation *metav1.Time `json:"lastRotation,omitempty"`
+
+	// NextRotation is the time of the next rotation of the password
+	NextRotation metav1.Time `json:"nextRotation"`
+
+	// ConnectionSecretsPending is true from the generation of a new password until all the connection Secrets of the
+	// user hold it
+	ConnectionSecretsPending bool `json:"connectionSecretsPending,omitempty"`
+}
+
+// X509CertificateStatus is the certificate issued by Atlas for a MANAGED X.509 user
+type X509CertificateStatus struct {
+	// SecretName is the name of the Secret holding the certificate and its private key
+	SecretName string `json:"secretName"`
+
+	// SerialNumber is the serial number of the certificate
+	SerialNumber string `json:"serialNumber,omitempty"`
+
+	// NotAfter is the expiration date of the certificate, a new one is issued before it
+	NotAfter metav1.Time `json:"notAfter"`
 }
@@ -2,6 +2,7 @@ package status
 
 import (
 	"go.mongodb.org/atlas/mongodbatlas"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/compat"
 )
@@ -14,12 +15,48 @@ type AtlasDeploymentStatus struct {
 	// The possible states are: IDLE, CREATING, UPDATING, DELETING, DELETED, REPAIRING
 	StateName string `json:"stateName,omitempty"`
 
+	// StateChangedAt is the time the operator observed the last change of the state of the cluster.
+	// +optional
+	StateChangedAt *metav1.Time `json:"stateChangedAt,omitempty"`
+
 	// MongoDBVersion is the version of MongoDB the cluster runs, in <major version>.<minor version> format.
 	MongoDBVersion string `json:"mongoDBVersion,omitempty"`
 
+	// MongoDBMajorVersion is the major version of MongoDB the cluster runs.
+	// +optional
+	MongoDBMajorVersion string `json:"mongoDBMajorVersion,omitempty"`
+
+	// VersionReleaseSystem is the method by which Atlas maintains the MongoDB version of the cluster: LTS or CONTINUOUS.
+	// +optional
+	VersionReleaseSystem string `json:"versionReleaseSystem,omitempty"`
+
+	// PendingVersionChange is the change of MongoDB version requested in the spec that Atlas didn't apply yet.
+	// +optional
+	PendingVersionChange *VersionChange `json:"pendingVersionChange,omitempty"`
+
+	// ConfigServerType is the type of the config server of a sharded deployment: EMBEDDED or DEDICATED.
+	// It is reported when the config server management mode is set in the spec.
+	ConfigServerType string `json:"configServerType,omitempty"`
+
 	// ConnectionStrings is a set of connection strings that your applications use to connect to this cluster.
 	ConnectionStrings *ConnectionStrings `json:"connectionStrings,omitempty"`
 
+	// ExternalNameService is the name of the ExternalName Service pointing at the deployment.
+	ExternalNameService string `json:"externalNameService,omitempty"`
+
+	// EgressConfigMap is the name of the ConfigMap listing the endpoints of the deployment.
+	// +optional
+	EgressConfigMap string `json:"egressConfigMap,omitempty"`
+
+	// IPAddresses are the public IP addresses of the deployment nodes last reported by Atlas.
+	// +optional
+	IPAddresses *DeploymentIPAddresses `json:"ipAddresses,omitempty"`
+
+	// ConnectionInfo is the kind and name of the object holding the connection strings of the deployment,
+	// e.g. Secret/cluster0-connection.
+	// +optional
+	ConnectionInfo string `json:"connectionInfo,omitempty"`
+
 	ReplicaSets []ReplicaSet `json:"replicaSets,omitempty"`
 
 	ServerlessPrivateEndpoints []ServerlessPrivateEndpoint `json:"serverlessPrivateEndpoints,omitempty"`
@@ -28,9 +65,46 @@ type AtlasDeploymentStatus struct {
 
 	ManagedNamespaces []ManagedNamespace `json:"managedNamespaces,omitempty"`
 
+	// ServerlessUsage contains the consumption indicators of the serverless instance.
+	// The operator only collects them when the serverless usage stats interval is configured.
+	// +optional
+	ServerlessUsage *ServerlessUsage `json:"serverlessUsage,omitempty"`
+
+	// ScalingAdvisedAt is a timestamp in ISO 8601 date and time format in UTC when the scaling advisor last read the
+	// utilization of the deployment from Atlas.
+	// +optional
+	ScalingAdvisedAt string `json:"scalingAdvisedAt,omitempty"`
+
+	// DiskSizeGB is the capacity, in gigabytes, of the root volume of the deployment in Atlas. It grows beyond the
+	// diskSizeGB of the spec when disk auto-scaling is enabled.
+	// +optional
+	DiskSizeGB *int `json:"diskSizeGB,omitempty"`
+
+	// Backup is the backup configuration Atlas applies to the deployment.
+	// +optional
+	Backup *DeploymentBackup `json:"backup,omitempty"`
+
+	// ProvisioningStartedAt is the time the ongoing creation or update of the deployment in Atlas started.
+	// +optional
+	ProvisioningStartedAt *metav1.Time `json:"provisioningStartedAt,omitempty"`
+
 	// MongoURIUpdated is a timestamp in ISO 8601 date and time format in UTC when the connection string was last updated.
 	// The connection string changes if you update any of the other values.
 	MongoURIUpdated string `json:"mongoURIUpdated,omitempty"`
+
+	// ObservedAtlasState is the fingerprint of the deployment as last observed in Atlas.
+	// It is used to report the fields changed in Atlas outside of the operator.
+	// +optional
+	ObservedAtlasState *ObservedAtlasState `json:"observedAtlasState,omitempty"`
+}
+
+// ObservedAtlasState is a compact fingerprint of the state of a resource in Atlas
+type ObservedAtlasState struct {
+	// Generation is the generation of the resource the state was observed for.
+	Generation int64 `json:"generation"`
+
+	// Fields maps the path of every observed field to a short hash of its value.
+	Fields map[string]string `json:"fields,omitempty"`
 }
 
 const (
@@ -42,6 +116,39 @@ const (
 	StateREPAIRING = "REPAIRING"
 )
 
+// DeploymentBackup is the effective backup configuration of the deployment in Atlas
+type DeploymentBackup struct {
+	// CloudBackupEnabled is true when Atlas takes cloud backup snapshots of the deployment.
+	CloudBackupEnabled bool `json:"cloudBackupEnabled"`
+
+	// ContinuousBackupEnabled is true when continuous cloud backup is enabled, allowing point in time restores.
+	ContinuousBackupEnabled bool `json:"continuousBackupEnabled"`
+
+	// RestoreWindowDays is the number of days back in time the deployment can be restored to with continuous cloud backup.
+	// It is only reported when continuous cloud backup is enabled.
+	// +optional
+	RestoreWindowDays int64 `json:"restoreWindowDays,omitempty"`
+
+	// AutoExportEnabled is true when Atlas automatically exports the snapshots to a bucket.
+	// +optional
+	AutoExportEnabled bool `json:"autoExportEnabled,omitempty"`
+
+	// ExportFrequencyType is the frequency of the automatic export of the snapshots.
+	// +optional
+	ExportFrequencyType string `json:"exportFrequencyType,omitempty"`
+}
+
+// VersionChange is a change of the MongoDB version of the deployment requested in the spec
+type VersionChange struct {
+	// MongoDBMajorVersion is the major version of MongoDB the deployment is being moved to.
+	// +optional
+	MongoDBMajorVersion string `json:"mongoDBMajorVersion,omitempty"`
+
+	// VersionReleaseSystem is the release system the deployment is being moved to.
+	// +optional
+	VersionReleaseSystem string `json:"versionReleaseSystem,omitempty"`
+}
+
 type ReplicaSet struct {
 	ID       string `json:"id"`
 	ZoneName string `json:"zoneName,omitempty"`
@@ -110,6 +217,46 @@ type Endpoint struct {
 	IP string `json:"ip,omitempty"`
 }
 
+// ServerlessUsage contains the latest consumption indicators reported by Atlas for a serverless instance
+type ServerlessUsage struct {
+	// DataSizeBytes is the logical size of the data stored in the serverless instance, in bytes.
+	DataSizeBytes int64 `json:"dataSizeBytes,omitempty"`
+
+	// ReadProcessingUnits is the latest number of Read Processing Units reported for the serverless instance.
+	ReadProcessingUnits int64 `json:"readProcessingUnits,omitempty"`
+
+	// WriteProcessingUnits is the latest number of Write Processing Units reported for the serverless instance.
+	WriteProcessingUnits int64 `json:"writeProcessingUnits,omitempty"`
+
+	// Connections is the latest number of connections open to the serverless instance.
+	Connections int64 `json:"connections,omitempty"`
+
+	// LastUpdated is a timestamp in ISO 8601 date and time format in UTC when the usage was last collected.
+	LastUpdated string `json:"lastUpdated,omitempty"`
+}
+
+// DeploymentIPAddresses are the public IP addresses of the deployment nodes reported by Atlas
+type DeploymentIPAddresses struct {
+	// ConfigMap is the name of the ConfigMap listing the IP addresses.
+	// +optional
+	ConfigMap string `json:"configMap,omitempty"`
+
+	// Inbound are the IP addresses the applications connect to, to be allowed in their outbound firewall rules.
+	// +optional
+	Inbound []string `json:"inbound,omitempty"`
+
+	// Outbound are the IP addresses the deployment connects from, e.g. to reach the webhooks and KMS.
+	// +optional
+	Outbound []string `json:"outbound,omitempty"`
+
+	// LastUpdated is a timestamp in ISO 8601 date and time format in UTC when the addresses were last read from Atlas.
+	LastUpdated string `json:"lastUpdated,omitempty"`
+
+	// LastChanged is a timestamp in ISO 8601 date and time format in UTC when the addresses last changed.
+	// +optional
+	LastChanged string `json:"lastChanged,omitempty"`
+}
+
 // +k8s:deepcopy-gen=false
 
 // AtlasDeploymentStatusOption is the option that is applied to Atlas Deployment Status.
@@ -151,6 +298,43 @@ func AtlasDeploymentMongoDBVersionOption(mongoDBVersion string) AtlasDeploymentS
 	}
 }
 
+func AtlasDeploymentReleaseOption(mongoDBMajorVersion, versionReleaseSystem string) AtlasDeploymentStatusOption {
+	return func(s *AtlasDeploymentStatus) {
+		s.MongoDBMajorVersion = mongoDBMajorVersion
+		s.VersionReleaseSystem = versionReleaseSystem
+	}
+}
+
+func AtlasDeploymentPendingVersionChangeOption(change *VersionChange) AtlasDeploymentStatusOption {
+	return func(s *AtlasDeploymentStatus) {
+		s.PendingVersionChange = change
+	}
+}
+
+func AtlasDeploymentConfigServerTypeOption(configServerType string) AtlasDeploymentStatusOption {
+	return func(s *AtlasDeploymentStatus) {
+		s.ConfigServerType = configServerType
+	}
+}
+
+func AtlasDeploymentEgressConfigMapOption(configMapName string) AtlasDeploymentStatusOption {
+	return func(s *AtlasDeploymentStatus) {
+		s.EgressConfigMap = configMapName
+	}
+}
+
+func AtlasDeploymentConnectionInfoOption(connectionInfo string) AtlasDeploymentStatusOption {
+	return func(s *AtlasDeploymentStatus) {
+		s.ConnectionInfo = connectionInfo
+	}
+}
+
+func AtlasDeploymentExternalNameServiceOption(serviceName string) AtlasDeploymentStatusOption {
+	return func(s *AtlasDeploymentStatus) {
+		s.ExternalNameService = serviceName
+	}
+}
+
 func AtlasDeploymentConnectionStringsOption(connectionStrings *mongodbatlas.ConnectionStrings) AtlasDeploymentStatusOption {
 	return func(s *AtlasDeploymentStatus) {
 		cs := ConnectionStrings{}
@@ -162,6 +346,54 @@ func AtlasDeploymentConnectionStringsOption(connectionStrings *mongodbatlas.Conn
 	}
 }
 
+func AtlasDeploymentServerlessUsageOption(usage *ServerlessUsage) AtlasDeploymentStatusOption {
+	return func(s *AtlasDeploymentStatus) {
+		s.ServerlessUsage = usage
+	}
+}
+
+func AtlasDeploymentScalingAdvisedAtOption(advisedAt string) AtlasDeploymentStatusOption {
+	return func(s *AtlasDeploymentStatus) {
+		s.ScalingAdvisedAt = advisedAt
+	}
+}
+
+func AtlasDeploymentIPAddressesOption(ipAddresses *DeploymentIPAddresses) AtlasDeploymentStatusOption {
+	return func(s *AtlasDeploymentStatus) {
+		s.IPAddresses = ipAddresses
+	}
+}
+
+func AtlasDeploymentBackupOption(backup *DeploymentBackup) AtlasDeploymentStatusOption {
+	return func(s *AtlasDeploymentStatus) {
+		s.Backup = backup
+	}
+}
+
+func AtlasDeploymentDiskSizeOption(diskSizeGB *int) AtlasDeploymentStatusOption {
+	return func(s *AtlasDeploymentStatus) {
+		s.DiskSizeGB = diskSizeGB
+	}
+}
+
+func AtlasDeploymentProvisioningStartedAtOption(startedAt *metav1.Time) AtlasDeploymentStatusOption {
+	return func(s *AtlasDeploymentStatus) {
+		s.ProvisioningStartedAt = startedAt
+	}
+}
+
+func AtlasDeploymentStateChangedAtOption(changedAt *metav1.Time) AtlasDeploymentStatusOption {
+	return func(s *AtlasDeploymentStatus) {
+		s.StateChangedAt = changedAt
+	}
+}
+
+func AtlasDeploymentObservedAtlasStateOption(state *ObservedAtlasState) AtlasDeploymentStatusOption {
+	return func(s *AtlasDeploymentStatus) {
+		s.ObservedAtlasState = state
+	}
+}
+
 func AtlasDeploymentMongoURIUpdatedOption(mongoURIUpdated string) AtlasDeploymentStatusOption {
 	return func(s *AtlasDeploymentStatus) {
 		s.MongoURIUpdated = mongoURIUpdated
@@ -0,0 +1,54 @@
+package status
+
+type AtlasEnvironmentStatus struct {
+	Common `json:",inline"`
+
+	// ProjectID is the unique identifier of the Atlas project of the environment
+	// +optional
+	ProjectID string `json:"projectId,omitempty"`
+
+	// ConnectionSecret is the name of the Secret with the connection strings of the deployment for the database user
+	// +optional
+	ConnectionSecret string `json:"connectionSecret,omitempty"`
+
+	// Resources lists the resources the environment expanded into, and whether they are ready
+	// +optional
+	Resources []EnvironmentResource `json:"resources,omitempty"`
+}
+
+// EnvironmentResource is a resource an AtlasEnvironment expanded into
+type EnvironmentResource struct {
+	// Kind of the resource, e.g. AtlasDeployment
+	Kind string `json:"kind"`
+
+	// Name of the resource, in the namespace of the environment
+	Name string `json:"name"`
+
+	// Ready is true once the resource is reconciled in Atlas
+	Ready bool `json:"ready"`
+}
+
+// +k8s:deepcopy-gen=false
+
+type AtlasEnvironmentStatusOption func(s *AtlasEnvironmentStatus)
+
+// AtlasEnvironmentProjectIDOption sets the Atlas project of the environment
+func AtlasEnvironmentProjectIDOption(projectID string) AtlasEnvironmentStatusOption {
+	return func(s *AtlasEnvironmentStatus) {
+		s.ProjectID = projectID
+	}
+}
+
+// AtlasEnvironmentConnectionSecretOption sets the connection Secret of the database user of the environment
+func AtlasEnvironmentConnectionSecretOption(name string) AtlasEnvironmentStatusOption {
+	return func(s *AtlasEnvironmentStatus) {
+		s.ConnectionSecret = name
+	}
+}
+
+// AtlasEnvironmentResourcesOption sets the resources the environment expanded into
+func AtlasEnvironmentResourcesOption(resources []EnvironmentResource) AtlasEnvironmentStatusOption {
+	return func(s *AtlasEnvironmentStatus) {
+		s.Resources = resources
+	}
+}
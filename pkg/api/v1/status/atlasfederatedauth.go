@@ -2,8 +2,89 @@ package status
 
 type AtlasFederatedAuthStatus struct {
 	Common `json:",inline"`
+
+	// IdentityProviderID is the ID of the identity provider the organization is connected to.
+	// +optional
+	IdentityProviderID string `json:"identityProviderId,omitempty"`
+
+	// DataAccessUsers are the OIDC database users maintained for the data access role mappings.
+	// +optional
+	DataAccessUsers []DataAccessUser `json:"dataAccessUsers,omitempty"`
+
+	// RoleMappings are the role mappings of the IdP groups applied in Atlas.
+	// +optional
+	RoleMappings []FederatedAuthRoleMapping `json:"roleMappings,omitempty"`
+
+	// ConnectedOrganizations are the configurations applied to the other organizations connected to the federation.
+	// +optional
+	ConnectedOrganizations []ConnectedOrganization `json:"connectedOrganizations,omitempty"`
+}
+
+// ConnectedOrganization is the configuration applied to an organization connected to the federation
+type ConnectedOrganization struct {
+	// OrgID is the ID of the connected organization.
+	OrgID string `json:"orgId"`
+	// RoleMappings are the role mappings of the IdP groups applied to the organization.
+	// +optional
+	RoleMappings []FederatedAuthRoleMapping `json:"roleMappings,omitempty"`
+}
+
+// FederatedAuthRoleMapping is the Atlas roles given to the members of an IdP group
+type FederatedAuthRoleMapping struct {
+	// ExternalGroupName is the name of the IdP group.
+	ExternalGroupName string `json:"externalGroupName"`
+	// OrgRoles are the organization roles given to the group members.
+	// +optional
+	OrgRoles []string `json:"orgRoles,omitempty"`
+	// ProjectRoles are the project roles given to the group members.
+	// +optional
+	ProjectRoles []FederatedAuthProjectRole `json:"projectRoles,omitempty"`
+}
+
+// FederatedAuthProjectRole is a role given in a project
+type FederatedAuthProjectRole struct {
+	// ProjectID is the ID of the project the role is given in.
+	ProjectID string `json:"projectId"`
+	// Role is the name of the project role.
+	Role string `json:"role"`
+}
+
+// DataAccessUser is an OIDC database user of type IDP_GROUP maintained in a project
+type DataAccessUser struct {
+	// ProjectID is the ID of the project the user belongs to.
+	ProjectID string `json:"projectId"`
+	// Username is the OIDC identity provider ID and the group name, separated by a slash.
+	Username string `json:"username"`
 }
 
 // +k8s:deepcopy-gen=false
 
 type AtlasFederatedAuthStatusOption func(s *AtlasFederatedAuthStatus)
+
+// AtlasFederatedAuthDataAccessUsersOption sets the OIDC database users maintained for the data access role mappings
+func AtlasFederatedAuthDataAccessUsersOption(users []DataAccessUser) AtlasFederatedAuthStatusOption {
+	return func(s *AtlasFederatedAuthStatus) {
+		s.DataAccessUsers = users
+	}
+}
+
+// AtlasFederatedAuthRoleMappingsOption sets the role mappings applied in Atlas
+func AtlasFederatedAuthRoleMappingsOption(roleMappings []FederatedAuthRoleMapping) AtlasFederatedAuthStatusOption {
+	return func(s *AtlasFederatedAuthStatus) {
+		s.RoleMappings = roleMappings
+	}
+}
+
+// AtlasFederatedAuthConnectedOrganizationsOption sets the configurations applied to the other connected organizations
+func AtlasFederatedAuthConnectedOrganizationsOption(organizations []ConnectedOrganization) AtlasFederatedAuthStatusOption {
+	return func(s *AtlasFederatedAuthStatus) {
+		s.ConnectedOrganizations = organizations
+	}
+}
+
+// AtlasFederatedAuthIdentityProviderIDOption sets the ID of the identity provider the organization is connected to
+func AtlasFederatedAuthIdentityProviderIDOption(id string) AtlasFederatedAuthStatusOption {
+	return func(s *AtlasFederatedAuthStatus) {
+		s.IdentityProviderID = id
+	}
+}
@@ -0,0 +1,78 @@
+package status
+
+// MigrationPhase is the step of the live migration the AtlasMigration is at
+type MigrationPhase string
+
+const (
+	MigrationPhaseValidating       MigrationPhase = "Validating"
+	MigrationPhaseValidationFailed MigrationPhase = "ValidationFailed"
+	MigrationPhaseMigrating        MigrationPhase = "Migrating"
+	MigrationPhaseReadyForCutover  MigrationPhase = "ReadyForCutover"
+	MigrationPhaseCuttingOver      MigrationPhase = "CuttingOver"
+	MigrationPhaseCompleted        MigrationPhase = "Completed"
+	MigrationPhaseFailed           MigrationPhase = "Failed"
+)
+
+type AtlasMigrationStatus struct {
+	Common `json:",inline"`
+
+	// Phase is the step of the live migration: Validating, ValidationFailed, Migrating, ReadyForCutover, CuttingOver,
+	// Completed or Failed.
+	// +optional
+	Phase MigrationPhase `json:"phase,omitempty"`
+
+	// ValidationID is the unique identifier of the Atlas validation job of the migration.
+	// +optional
+	ValidationID string `json:"validationId,omitempty"`
+
+	// ValidationError is the reason why Atlas rejected the migration during the validation.
+	// +optional
+	ValidationError string `json:"validationError,omitempty"`
+
+	// MigrationID is the unique identifier of the Atlas live migration.
+	// +optional
+	MigrationID string `json:"migrationId,omitempty"`
+
+	// MigrationStatus is the status of the live migration as reported by Atlas.
+	// +optional
+	MigrationStatus string `json:"migrationStatus,omitempty"`
+
+	// LagTimeSeconds is the replication lag between the source and the destination cluster.
+	// +optional
+	LagTimeSeconds int64 `json:"lagTimeSeconds,omitempty"`
+
+	// ReadyForCutover is true when the destination cluster caught up with the source and the cutover can be triggered.
+	// +optional
+	ReadyForCutover bool `json:"readyForCutover,omitempty"`
+
+	// MigrationHosts are the migration hosts used to perform the migration.
+	// +optional
+	MigrationHosts []string `json:"migrationHosts,omitempty"`
+}
+
+// +k8s:deepcopy-gen=false
+
+type AtlasMigrationStatusOption func(s *AtlasMigrationStatus)
+
+func AtlasMigrationPhaseOption(phase MigrationPhase) AtlasMigrationStatusOption {
+	return func(s *AtlasMigrationStatus) {
+		s.Phase = phase
+	}
+}
+
+func AtlasMigrationValidationOption(validationID, validationError string) AtlasMigrationStatusOption {
+	return func(s *AtlasMigrationStatus) {
+		s.ValidationID = validationID
+		s.ValidationError = validationError
+	}
+}
+
+func AtlasMigrationProgressOption(migrationID, migrationStatus string, lagTimeSeconds int64, readyForCutover bool, migrationHosts []string) AtlasMigrationStatusOption {
+	return func(s *AtlasMigrationStatus) {
+		s.MigrationID = migrationID
+		s.MigrationStatus = migrationStatus
+		s.LagTimeSeconds = lagTimeSeconds
+		s.ReadyForCutover = readyForCutover
+		s.MigrationHosts = migrationHosts
+	}
+}
@@ -0,0 +1,45 @@
+package status
+
+type AtlasNetworkContainerStatus struct {
+	Common `json:",inline"`
+
+	// ID is the unique identifier of the container in Atlas.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Provisioned is true when clusters are deployed to the container.
+	// +optional
+	Provisioned bool `json:"provisioned,omitempty"`
+
+	// VpcID is the ID of the Atlas VPC. Applicable only for AWS.
+	// +optional
+	VpcID string `json:"vpcId,omitempty"`
+
+	// GCPProjectID is the GCP project of the Atlas network. Applicable only for GCP.
+	// +optional
+	GCPProjectID string `json:"gcpProjectId,omitempty"`
+
+	// NetworkName is the name of the Atlas network. Applicable only for GCP.
+	// +optional
+	NetworkName string `json:"networkName,omitempty"`
+
+	// VNetName is the name of the Atlas VNet. Applicable only for Azure.
+	// +optional
+	VNetName string `json:"vnetName,omitempty"`
+}
+
+// +k8s:deepcopy-gen=false
+
+type AtlasNetworkContainerStatusOption func(s *AtlasNetworkContainerStatus)
+
+// AtlasNetworkContainerOption sets the Atlas state of the container
+func AtlasNetworkContainerOption(id string, provisioned bool, vpcID, gcpProjectID, networkName, vnetName string) AtlasNetworkContainerStatusOption {
+	return func(s *AtlasNetworkContainerStatus) {
+		s.ID = id
+		s.Provisioned = provisioned
+		s.VpcID = vpcID
+		s.GCPProjectID = gcpProjectID
+		s.NetworkName = networkName
+		s.VNetName = vnetName
+	}
+}
@@ -0,0 +1,25 @@
+package status
+
+type AtlasOrganizationStatus struct {
+	Common `json:",inline"`
+
+	// ID is the unique identifier of the organization in Atlas
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// APIKeyID is the unique identifier of the API key created with the organization
+	// +optional
+	APIKeyID string `json:"apiKeyId,omitempty"`
+}
+
+// +k8s:deepcopy-gen=false
+
+type AtlasOrganizationStatusOption func(s *AtlasOrganizationStatus)
+
+// AtlasOrganizationIDOption sets the organization and the API key created with it
+func AtlasOrganizationIDOption(id, apiKeyID string) AtlasOrganizationStatusOption {
+	return func(s *AtlasOrganizationStatus) {
+		s.ID = id
+		s.APIKeyID = apiKeyID
+	}
+}
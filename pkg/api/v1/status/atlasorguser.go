@@ -0,0 +1,59 @@
+package status
+
+type OrgUserState string
+
+const (
+	// OrgUserInvited is the state of a user invited to the organization who hasn't accepted the invitation yet
+	OrgUserInvited OrgUserState = "INVITED"
+	// OrgUserMember is the state of a user who accepted the invitation and is a member of the organization
+	OrgUserMember OrgUserState = "MEMBER"
+)
+
+type AtlasOrgUserStatus struct {
+	Common `json:",inline"`
+
+	// State of the user: INVITED or MEMBER
+	// +optional
+	State OrgUserState `json:"state,omitempty"`
+
+	// UserID is the unique identifier of the user in Atlas, once they are a member of the organization
+	// +optional
+	UserID string `json:"userId,omitempty"`
+
+	// InvitationID is the unique identifier of the pending invitation in Atlas
+	// +optional
+	InvitationID string `json:"invitationId,omitempty"`
+
+	// ExpiresAt is the time the pending invitation expires, in ISO 8601 format. Expired invitations are sent again
+	// +optional
+	ExpiresAt string `json:"expiresAt,omitempty"`
+
+	// TeamIDs are the unique identifiers of the teams the operator added the user to
+	// +optional
+	TeamIDs []string `json:"teamIds,omitempty"`
+}
+
+// +k8s:deepcopy-gen=false
+
+type AtlasOrgUserStatusOption func(s *AtlasOrgUserStatus)
+
+// AtlasOrgUserInvitedOption sets the state of a user with a pending invitation
+func AtlasOrgUserInvitedOption(invitationID, expiresAt string) AtlasOrgUserStatusOption {
+	return func(s *AtlasOrgUserStatus) {
+		s.State = OrgUserInvited
+		s.UserID = ""
+		s.InvitationID = invitationID
+		s.ExpiresAt = expiresAt
+	}
+}
+
+// AtlasOrgUserMemberOption sets the state of a member of the organization and the teams the operator added them to
+func AtlasOrgUserMemberOption(userID string, teamIDs []string) AtlasOrgUserStatusOption {
+	return func(s *AtlasOrgUserStatus) {
+		s.State = OrgUserMember
+		s.UserID = userID
+		s.InvitationID = ""
+		s.ExpiresAt = ""
+		s.TeamIDs = teamIDs
+	}
+}
@@ -0,0 +1,92 @@
+package status
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type AtlasPrivateEndpointStatus struct {
+	Common `json:",inline"`
+
+	// ServiceID is the unique identifier of the private endpoint service in Atlas.
+	// +optional
+	ServiceID string `json:"serviceId,omitempty"`
+
+	// ServiceName is the name of the private endpoint service to connect the AWS interface endpoints or the Azure
+	// private endpoints to.
+	// +optional
+	ServiceName string `json:"serviceName,omitempty"`
+
+	// ServiceResourceID is the resource ID of the Azure Private Link Service.
+	// +optional
+	ServiceResourceID string `json:"serviceResourceId,omitempty"`
+
+	// ServiceAttachmentNames are the service attachments to connect the Google Cloud endpoints to.
+	// +optional
+	ServiceAttachmentNames []string `json:"serviceAttachmentNames,omitempty"`
+
+	// ServiceStatus is the state of the private endpoint service in Atlas.
+	// +optional
+	ServiceStatus string `json:"serviceStatus,omitempty"`
+
+	// Error is the error reported by Atlas for the private endpoint service.
+	// +optional
+	Error string `json:"error,omitempty"`
+
+	// Interfaces are the states of the endpoints connected to the private endpoint service.
+	// +optional
+	Interfaces []PrivateEndpointInterfaceStatus `json:"interfaces,omitempty"`
+}
+
+// PrivateEndpointInterfaceStatus is the state of an endpoint connected to the private endpoint service
+type PrivateEndpointInterfaceStatus struct {
+	// ID of the endpoint, the endpoint group name for Google Cloud.
+	ID string `json:"id"`
+
+	// ConnectionStatus is the state of the connection of the endpoint in Atlas.
+	// +optional
+	ConnectionStatus string `json:"connectionStatus,omitempty"`
+
+	// Error is the error reported by Atlas for the endpoint, the failure reason of the cloud provider for a rejected or
+	// failed endpoint.
+	// +optional
+	Error string `json:"error,omitempty"`
+
+	// FailedSince is the time the endpoint was first reported as REJECTED or FAILED.
+	// +optional
+	FailedSince *metav1.Time `json:"failedSince,omitempty"`
+
+	// Recreations is the number of times the endpoint was recreated after it failed, reset once it's available.
+	// +optional
+	Recreations int `json:"recreations,omitempty"`
+
+	// Endpoints are the forwarding rules of a Google Cloud endpoint group, with their state in Atlas.
+	// +optional
+	Endpoints []GCPEndpoint `json:"endpoints,omitempty"`
+
+	// Conditions is the list of statuses showing the current state of the endpoint.
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// +k8s:deepcopy-gen=false
+
+type AtlasPrivateEndpointStatusOption func(s *AtlasPrivateEndpointStatus)
+
+// AtlasPrivateEndpointServiceOption sets the Atlas state of the private endpoint service
+func AtlasPrivateEndpointServiceOption(id, name, resourceID string, attachmentNames []string, serviceStatus, errorMessage string) AtlasPrivateEndpointStatusOption {
+	return func(s *AtlasPrivateEndpointStatus) {
+		s.ServiceID = id
+		s.ServiceName = name
+		s.ServiceResourceID = resourceID
+		s.ServiceAttachmentNames = attachmentNames
+		s.ServiceStatus = serviceStatus
+		s.Error = errorMessage
+	}
+}
+
+// AtlasPrivateEndpointInterfacesOption sets the Atlas state of the endpoints connected to the private endpoint service
+func AtlasPrivateEndpointInterfacesOption(interfaces []PrivateEndpointInterfaceStatus) AtlasPrivateEndpointStatusOption {
+	return func(s *AtlasPrivateEndpointStatus) {
+		s.Interfaces = interfaces
+	}
+}
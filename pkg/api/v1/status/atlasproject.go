@@ -1,6 +1,8 @@
 package status
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/authmode"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/project"
 )
@@ -16,6 +18,13 @@ func AtlasProjectIDOption(id string) AtlasProjectStatusOption {
 	}
 }
 
+// AtlasProjectOrgIDOption records the organization the project was reconciled in
+func AtlasProjectOrgIDOption(orgID string) AtlasProjectStatusOption {
+	return func(s *AtlasProjectStatus) {
+		s.OrgID = orgID
+	}
+}
+
 func AtlasProjectExpiredIPAccessOption(lists []project.IPAccessList) AtlasProjectStatusOption {
 	return func(s *AtlasProjectStatus) {
 		s.ExpiredIPAccessList = lists
@@ -34,6 +43,16 @@ func AtlasProjectSetPrivateEndpointsOption(privateEndpoints []ProjectPrivateEndp
 	}
 }
 
+// AtlasProjectPrivateEndpointNamesOption sets the names rendered from the spec on the private endpoints, by their
+// identifier
+func AtlasProjectPrivateEndpointNamesOption(names map[string]string) AtlasProjectStatusOption {
+	return func(s *AtlasProjectStatus) {
+		for i := range s.PrivateEndpoints {
+			s.PrivateEndpoints[i].Name = names[s.PrivateEndpoints[i].Identifier().(string)]
+		}
+	}
+}
+
 func AtlasProjectSetNetworkPeerOption(networkPeers *[]AtlasNetworkPeer) AtlasProjectStatusOption {
 	return func(s *AtlasProjectStatus) {
 		s.NetworkPeers = *networkPeers
@@ -64,6 +83,12 @@ func AtlasProjectSetCustomRolesOption(customRoles *[]CustomRole) AtlasProjectSta
 	}
 }
 
+func AtlasProjectInvitationsOption(invitations []ProjectInvitationStatus) AtlasProjectStatusOption {
+	return func(s *AtlasProjectStatus) {
+		s.ProjectInvitations = invitations
+	}
+}
+
 func AtlasProjectSetTeamsOption(teams *[]ProjectTeamStatus) AtlasProjectStatusOption {
 	return func(s *AtlasProjectStatus) {
 		if teams == nil {
@@ -76,12 +101,89 @@ func AtlasProjectSetTeamsOption(teams *[]ProjectTeamStatus) AtlasProjectStatusOp
 	}
 }
 
+// AtlasProjectSyncProgressOption records the progress of the synchronization of a kind of resource, the progress is
+// removed when it is nil
+func AtlasProjectSyncProgressOption(resource string, progress *ResourceSyncProgress) AtlasProjectStatusOption {
+	return func(s *AtlasProjectStatus) {
+		syncProgress := make([]ResourceSyncProgress, 0, len(s.SyncProgress)+1)
+		for _, resourceProgress := range s.SyncProgress {
+			if resourceProgress.Resource != resource {
+				syncProgress = append(syncProgress, resourceProgress)
+			}
+		}
+
+		if progress != nil {
+			syncProgress = append(syncProgress, *progress)
+		}
+
+		if len(syncProgress) == 0 {
+			syncProgress = nil
+		}
+
+		s.SyncProgress = syncProgress
+	}
+}
+
+// AtlasProjectDeletionProtectionOption records the conclusion of the deletion protection check of a sub-resource, the
+// decision is removed when it is nil
+func AtlasProjectDeletionProtectionOption(subresource string, decision *DeletionProtectionDecision) AtlasProjectStatusOption {
+	return func(s *AtlasProjectStatus) {
+		decisions := make([]DeletionProtectionDecision, 0, len(s.DeletionProtection)+1)
+		for _, subresourceDecision := range s.DeletionProtection {
+			if subresourceDecision.Subresource != subresource {
+				decisions = append(decisions, subresourceDecision)
+			}
+		}
+
+		if decision != nil {
+			decisions = append(decisions, *decision)
+		}
+
+		if len(decisions) == 0 {
+			decisions = nil
+		}
+
+		s.DeletionProtection = decisions
+	}
+}
+
 func AtlasProjectPrometheusOption(prometheus *Prometheus) AtlasProjectStatusOption {
 	return func(s *AtlasProjectStatus) {
 		s.Prometheus = prometheus
 	}
 }
 
+// AtlasProjectPrometheusScrapeSecretOption records the name of the Prometheus scrape Secret. It must be applied after
+// AtlasProjectPrometheusOption, and is ignored when the Prometheus integration isn't reported
+func AtlasProjectPrometheusScrapeSecretOption(name string) AtlasProjectStatusOption {
+	return func(s *AtlasProjectStatus) {
+		if s.Prometheus != nil {
+			s.Prometheus.ScrapeSecret = name
+		}
+	}
+}
+
+func AtlasProjectWebhookIntegrationOption(webhook *WebhookIntegration) AtlasProjectStatusOption {
+	return func(s *AtlasProjectStatus) {
+		s.WebhookIntegration = webhook
+	}
+}
+
+// AtlasProjectNextMaintenanceOption records the time of the next maintenance scheduled by Atlas, it's removed when nil
+func AtlasProjectNextMaintenanceOption(nextMaintenance *metav1.Time) AtlasProjectStatusOption {
+	return func(s *AtlasProjectStatus) {
+		s.NextMaintenance = nextMaintenance
+	}
+}
+
+// AtlasProjectUnmanagedDeploymentsOption records the deployments of the project in Atlas which no AtlasDeployment
+// manages, they are removed when nil
+func AtlasProjectUnmanagedDeploymentsOption(deployments []UnmanagedDeployment) AtlasProjectStatusOption {
+	return func(s *AtlasProjectStatus) {
+		s.UnmanagedDeployments = deployments
+	}
+}
+
 // AtlasProjectStatus defines the observed state of AtlasProject
 type AtlasProjectStatus struct {
 	Common `json:",inline"`
@@ -90,6 +192,11 @@ type AtlasProjectStatus struct {
 	// +optional
 	ID string `json:"id,omitempty"`
 
+	// OrgID is the ID of the Atlas Organization the project was last reconciled in. A connection secret holding the API
+	// keys of another Organization is only accepted once the project was migrated to it in Atlas
+	// +optional
+	OrgID string `json:"orgId,omitempty"`
+
 	// The list of IP Access List entries that are expired due to 'deleteAfterDate' being less than the current date.
 	// Note, that this field is updated by the Atlas Operator only after specification changes
 	ExpiredIPAccessList []project.IPAccessList `json:"expiredIpAccessList,omitempty"`
@@ -117,8 +224,81 @@ type AtlasProjectStatus struct {
 	// Teams contains a list of teams assignment statuses
 	Teams []ProjectTeamStatus `json:"teams,omitempty"`
 
+	// ProjectInvitations contains the state of the invitations of users to the project
+	// +optional
+	ProjectInvitations []ProjectInvitationStatus `json:"projectInvitations,omitempty"`
+
 	// Prometheus contains the status for Prometheus integration
 	// including the prometheusDiscoveryURL
 	// +optional
 	Prometheus *Prometheus `json:"prometheus,omitempty"`
+
+	// WebhookIntegration reports the rotations and the validation of the WEBHOOK integration
+	// +optional
+	WebhookIntegration *WebhookIntegration `json:"webhookIntegration,omitempty"`
+
+	// SyncProgress reports the progress of the resources which are synchronized with Atlas over several reconciliations,
+	// e.g. when adopting a project holding a large number of existing resources
+	// +optional
+	SyncProgress []ResourceSyncProgress `json:"syncProgress,omitempty"`
+
+	// NextMaintenance is the time when Atlas starts the next scheduled maintenance of the project
+	// +optional
+	NextMaintenance *metav1.Time `json:"nextMaintenance,omitempty"`
+
+	// DeletionProtection reports, for each sub-resource checked while the deletion protection is enabled, whether the
+	// operator took the ownership of its configuration in Atlas and why
+	// +optional
+	DeletionProtection []DeletionProtectionDecision `json:"deletionProtection,omitempty"`
+
+	// UnmanagedDeployments lists the deployments of the project in Atlas which no AtlasDeployment manages, when
+	// spec.reportUnmanagedDeployments is set
+	// +optional
+	UnmanagedDeployments []UnmanagedDeployment `json:"unmanagedDeployments,omitempty"`
+}
+
+// UnmanagedDeployment is a deployment of the project in Atlas which no AtlasDeployment manages
+type UnmanagedDeployment struct {
+	// Name is the name of the deployment in Atlas
+	Name string `json:"name"`
+
+	// InstanceSize is the tier of the deployment, e.g. M10, or SERVERLESS for the serverless instances
+	// +optional
+	InstanceSize string `json:"instanceSize,omitempty"`
+
+	// StateName is the state of the deployment in Atlas, e.g. IDLE
+	// +optional
+	StateName string `json:"stateName,omitempty"`
+}
+
+// DeletionProtectionDecision is the conclusion of the deletion protection check of a project sub-resource
+type DeletionProtectionDecision struct {
+	// Subresource is the spec field of the project holding the sub-resource, e.g. projectIpAccessList
+	Subresource string `json:"subresource"`
+
+	// Reconciled is true when the operator is allowed to apply the spec to the sub-resource. It is false when the
+	// configuration in Atlas was changed outside the operator: it differs from both the spec and the last applied
+	// configuration
+	Reconciled bool `json:"reconciled"`
+
+	// Differences lists what differs between the configuration in Atlas and the spec when the sub-resource isn't
+	// reconciled. Aligning the spec with Atlas, or disabling the deletion protection, resumes the reconciliation
+	// +optional
+	Differences []string `json:"differences,omitempty"`
+}
+
+// ResourceSyncProgress is the progress of the synchronization of one kind of project resource with Atlas
+type ResourceSyncProgress struct {
+	// Resource is the kind of project resource being synchronized, e.g. alertConfigurations
+	Resource string `json:"resource"`
+
+	// Synced is the number of resources of the spec already synchronized with Atlas
+	Synced int `json:"synced"`
+
+	// Total is the number of resources of the spec
+	Total int `json:"total"`
+
+	// PendingDeletions is the number of resources still to be deleted from Atlas
+	// +optional
+	PendingDeletions int `json:"pendingDeletions,omitempty"`
 }
@@ -0,0 +1,41 @@
+package status
+
+type AtlasProjectAPIKeyStatus struct {
+	Common `json:",inline"`
+
+	// KeyID is the unique identifier of the key in Atlas.
+	// +optional
+	KeyID string `json:"keyId,omitempty"`
+
+	// PublicKey is the public part of the key, the private part is only written into the Secret.
+	// +optional
+	PublicKey string `json:"publicKey,omitempty"`
+
+	// CreatedAt is the time the key was created, in ISO 8601 format. The key is rotated once its TTL elapsed since then.
+	// +optional
+	CreatedAt string `json:"createdAt,omitempty"`
+
+	// RotationRequest is the value of the rotation annotation when the key was last rotated on demand.
+	// +optional
+	RotationRequest string `json:"rotationRequest,omitempty"`
+
+	// PendingKeyID is the unique identifier of the key created by a rotation which wasn't completed yet. The next
+	// reconciliation switches to it when it was written into the Secret, and deletes it otherwise.
+	// +optional
+	PendingKeyID string `json:"pendingKeyId,omitempty"`
+}
+
+// +k8s:deepcopy-gen=false
+
+type AtlasProjectAPIKeyStatusOption func(s *AtlasProjectAPIKeyStatus)
+
+// AtlasProjectAPIKeyOption sets the key currently written into the Secret, completing the pending rotation
+func AtlasProjectAPIKeyOption(keyID, publicKey, createdAt, rotationRequest string) AtlasProjectAPIKeyStatusOption {
+	return func(s *AtlasProjectAPIKeyStatus) {
+		s.KeyID = keyID
+		s.PublicKey = publicKey
+		s.CreatedAt = createdAt
+		s.RotationRequest = rotationRequest
+		s.PendingKeyID = ""
+	}
+}
@@ -0,0 +1,25 @@
+package status
+
+type AtlasResourcePolicyStatus struct {
+	Common `json:",inline"`
+
+	// ID is the unique identifier of the resource policy in Atlas
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Version of the resource policy in Atlas, increased on every update
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
+// +k8s:deepcopy-gen=false
+
+type AtlasResourcePolicyStatusOption func(s *AtlasResourcePolicyStatus)
+
+// AtlasResourcePolicyOption sets the resource policy and its version
+func AtlasResourcePolicyOption(id, version string) AtlasResourcePolicyStatusOption {
+	return func(s *AtlasResourcePolicyStatus) {
+		s.ID = id
+		s.Version = version
+	}
+}
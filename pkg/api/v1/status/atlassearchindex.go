@@ -0,0 +1,29 @@
+package status
+
+type AtlasSearchIndexStatus struct {
+	Common `json:",inline"`
+
+	// IndexID is the unique identifier of the index in Atlas.
+	// +optional
+	IndexID string `json:"indexId,omitempty"`
+
+	// AtlasStatus is the status of the index as reported by Atlas: IN_PROGRESS, STEADY, FAILED, MIGRATING or PAUSED.
+	// +optional
+	AtlasStatus string `json:"atlasStatus,omitempty"`
+}
+
+// +k8s:deepcopy-gen=false
+
+type AtlasSearchIndexStatusOption func(s *AtlasSearchIndexStatus)
+
+func AtlasSearchIndexIDOption(indexID string) AtlasSearchIndexStatusOption {
+	return func(s *AtlasSearchIndexStatus) {
+		s.IndexID = indexID
+	}
+}
+
+func AtlasSearchIndexAtlasStatusOption(atlasStatus string) AtlasSearchIndexStatusOption {
+	return func(s *AtlasSearchIndexStatus) {
+		s.AtlasStatus = atlasStatus
+	}
+}
@@ -28,39 +28,49 @@ conditions:
 type ConditionType string
 
 const (
-	ReadyType           ConditionType = "Ready"
-	ValidationSucceeded ConditionType = "ValidationSucceeded"
+	ReadyType                 ConditionType = "Ready"
+	ValidationSucceeded       ConditionType = "ValidationSucceeded"
+	MaintenanceInProgressType ConditionType = "MaintenanceInProgress"
 )
 
 // AtlasProject condition types
 const (
-	ProjectReadyType                  ConditionType = "ProjectReady"
-	IPAccessListReadyType             ConditionType = "IPAccessListReady"
-	MaintenanceWindowReadyType        ConditionType = "MaintenanceWindowReady"
-	PrivateEndpointServiceReadyType   ConditionType = "PrivateEndpointServiceReady"
-	PrivateEndpointReadyType          ConditionType = "PrivateEndpointReady"
-	NetworkPeerReadyType              ConditionType = "NetworkPeerReady"
-	CloudProviderIntegrationReadyType ConditionType = "CloudProviderIntegrationReady"
-	IntegrationReadyType              ConditionType = "ThirdPartyIntegrationReady"
-	AlertConfigurationReadyType       ConditionType = "AlertConfigurationReady"
-	EncryptionAtRestReadyType         ConditionType = "EncryptionAtRestReady"
-	AuditingReadyType                 ConditionType = "AuditingReady"
-	ProjectSettingsReadyType          ConditionType = "ProjectSettingsReady"
-	ProjectCustomRolesReadyType       ConditionType = "ProjectCustomRolesReady"
-	ProjectTeamsReadyType             ConditionType = "ProjectTeamsReady"
+	ProjectReadyType                     ConditionType = "ProjectReady"
+	IPAccessListReadyType                ConditionType = "IPAccessListReady"
+	MaintenanceWindowReadyType           ConditionType = "MaintenanceWindowReady"
+	PrivateEndpointServiceReadyType      ConditionType = "PrivateEndpointServiceReady"
+	PrivateEndpointReadyType             ConditionType = "PrivateEndpointReady"
+	RegionalizedPrivateEndpointReadyType ConditionType = "RegionalizedPrivateEndpointReady"
+	NetworkPeerReadyType                 ConditionType = "NetworkPeerReady"
+	CloudProviderIntegrationReadyType    ConditionType = "CloudProviderIntegrationReady"
+	IntegrationReadyType                 ConditionType = "ThirdPartyIntegrationReady"
+	AlertConfigurationReadyType          ConditionType = "AlertConfigurationReady"
+	EncryptionAtRestReadyType            ConditionType = "EncryptionAtRestReady"
+	AuditingReadyType                    ConditionType = "AuditingReady"
+	ProjectSettingsReadyType             ConditionType = "ProjectSettingsReady"
+	ProjectCustomRolesReadyType          ConditionType = "ProjectCustomRolesReady"
+	ProjectTeamsReadyType                ConditionType = "ProjectTeamsReady"
+	ProjectInvitationsReadyType          ConditionType = "ProjectInvitationsReady"
+	DefaultDatabaseUserReadyType         ConditionType = "DefaultDatabaseUserReady"
+	UnmanagedDeploymentsReportedType     ConditionType = "UnmanagedDeploymentsReported"
 )
 
 // AtlasDeployment condition types
 const (
-	DeploymentReadyType                ConditionType = "DeploymentReady"
-	ServerlessPrivateEndpointReadyType ConditionType = "ServerlessPrivateEndpointReady"
-	ManagedNamespacesReadyType         ConditionType = "ManagedNamespacesReady"
-	CustomZoneMappingReadyType         ConditionType = "CustomZoneMappingReady"
+	DeploymentReadyType                 ConditionType = "DeploymentReady"
+	ServerlessPrivateEndpointReadyType  ConditionType = "ServerlessPrivateEndpointReady"
+	ManagedNamespacesReadyType          ConditionType = "ManagedNamespacesReady"
+	CustomZoneMappingReadyType          ConditionType = "CustomZoneMappingReady"
+	DeploymentRightSizedType            ConditionType = "DeploymentRightSized"
+	DeploymentBackupCompatibleType      ConditionType = "DeploymentBackupCompatible"
+	DeploymentProvisioningTimedOutType  ConditionType = "ProvisioningTimedOut"
+	DeploymentCapabilitiesSupportedType ConditionType = "CapabilitiesSupported"
 )
 
 // AtlasDatabaseUser condition types
 const (
-	DatabaseUserReadyType ConditionType = "DatabaseUserReady"
+	DatabaseUserReadyType    ConditionType = "DatabaseUserReady"
+	DatabaseUserExpiringType ConditionType = "DatabaseUserExpiring"
 )
 
 // Atlas Data Federation condition types
@@ -71,13 +81,65 @@ const (
 
 // Atlas Federated Auth condition types
 const (
-	FederatedAuthReadyType      ConditionType = "FederatedAuthReady"
-	FederatedAuthRolesReadyType ConditionType = "RolesReady"
+	FederatedAuthReadyType           ConditionType = "FederatedAuthReady"
+	FederatedAuthRolesReadyType      ConditionType = "RolesReady"
+	FederatedAuthDataAccessReadyType ConditionType = "DataAccessReady"
+)
+
+// Atlas Migration condition types
+const (
+	MigrationValidatedType ConditionType = "MigrationValidated"
+	MigrationReadyType     ConditionType = "MigrationReady"
+)
+
+// Atlas Access Request condition types
+const (
+	AccessRequestGrantedType ConditionType = "AccessGranted"
+)
+
+// Atlas Search Index condition types
+const (
+	SearchIndexReadyType ConditionType = "SearchIndexReady"
+)
+
+// Atlas Network Container condition types
+const (
+	NetworkContainerReadyType ConditionType = "NetworkContainerReady"
+)
+
+// Atlas Org User condition types
+const (
+	OrgUserReadyType      ConditionType = "OrgUserReady"
+	OrgUserTeamsReadyType ConditionType = "OrgUserTeamsReady"
+)
+
+// Atlas Project API Key condition types
+const (
+	ProjectAPIKeyReadyType ConditionType = "ProjectAPIKeyReady"
+)
+
+// Atlas Organization condition types
+const (
+	OrganizationReadyType ConditionType = "OrganizationReady"
+)
+
+// Atlas Resource Policy condition types
+const (
+	ResourcePolicyReadyType ConditionType = "ResourcePolicyReady"
+)
+
+// Atlas Environment condition types
+const (
+	EnvironmentReadyType ConditionType = "EnvironmentReady"
 )
 
 // Generic condition type
 const (
 	ResourceVersionStatus ConditionType = "ResourceVersionIsValid"
+	PausedByOperatorType  ConditionType = "PausedByOperator"
+	DegradedType          ConditionType = "Degraded"
+
+	InsufficientAtlasPermissionsType ConditionType = "InsufficientAtlasPermissions"
 )
 
 // Condition describes the state of an Atlas Custom Resource at a certain point.
@@ -95,6 +157,40 @@ type Condition struct {
 	// A human readable message indicating details about the transition.
 	// +optional
 	Message string `json:"message,omitempty"`
+	// What caused the condition's last transition, when the reconciler identified it.
+	// +optional
+	Cause *ConditionCause `json:"cause,omitempty"`
+	// Details of the error returned by the Atlas Admin API which caused the condition's last transition.
+	// +optional
+	AtlasError *AtlasError `json:"atlasError,omitempty"`
+}
+
+// ConditionCause identifies the origin of a condition transition in a structured way so that specific failures can
+// be detected without parsing the condition message.
+type ConditionCause struct {
+	// Part of the Operator reconciliation which caused the transition, e.g. ipAccessList.
+	// +optional
+	Reconciler string `json:"reconciler,omitempty"`
+	// Atlas Admin API operation which caused the transition, e.g. createProjectIpAccessList.
+	// +optional
+	AtlasOperation string `json:"atlasOperation,omitempty"`
+}
+
+// AtlasError holds the details of an error returned by the Atlas Admin API so that specific errors can be detected from
+// their error code, e.g. OUT_OF_CAPACITY or DUPLICATE_CLUSTER_NAME.
+type AtlasError struct {
+	// HTTP status code of the Atlas response.
+	// +optional
+	HTTPStatus int `json:"httpStatus,omitempty"`
+	// Code identifying the error, see https://www.mongodb.com/docs/atlas/reference/api-errors/.
+	// +optional
+	ErrorCode string `json:"errorCode,omitempty"`
+	// Description of the error.
+	// +optional
+	Detail string `json:"detail,omitempty"`
+	// Values the description of the error refers to, e.g. the name of a cluster.
+	// +optional
+	Parameters []string `json:"parameters,omitempty"`
 }
 
 // TrueCondition returns the Condition that has the 'Status' set to 'true' and 'Type' to 'conditionType'.
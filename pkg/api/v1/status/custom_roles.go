@@ -5,12 +5,14 @@ type CustomRoleStatus string
 const (
 	CustomRoleStatusOK     CustomRoleStatus = "OK"
 	CustomRoleStatusFailed CustomRoleStatus = "FAILED"
+	// CustomRoleStatusImported is the status of the roles defined in Atlas only, which are kept when importing
+	CustomRoleStatusImported CustomRoleStatus = "IMPORTED"
 )
 
 type CustomRole struct {
 	// Role name which is unique
 	Name string `json:"name"`
-	// The status of the given custom role (OK or FAILED)
+	// The status of the given custom role (OK, FAILED or IMPORTED)
 	Status CustomRoleStatus `json:"status"`
 	// The message when the custom role is in the FAILED status
 	Error string `json:"error,omitempty"`
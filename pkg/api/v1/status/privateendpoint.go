@@ -11,6 +11,8 @@ import (
 type ProjectPrivateEndpoint struct {
 	// Unique identifier for AWS or AZURE Private Link Connection.
 	ID string `json:"id,omitempty"`
+	// Name of the endpoint to create in the cloud provider, as rendered from the spec.
+	Name string `json:"name,omitempty"`
 	// Cloud provider for which you want to retrieve a private endpoint service. Atlas accepts AWS or AZURE.
 	Provider provider.ProviderName `json:"provider"`
 	// Cloud provider region for which you want to create the private endpoint service.
@@ -28,9 +30,12 @@ type ProjectPrivateEndpoint struct {
 }
 
 type GCPEndpoint struct {
-	Status       string `json:"status"`
+	// State of the forwarding rule in Atlas.
+	Status string `json:"status"`
+	// Forwarding rule that corresponds to the endpoint you created in Google Cloud.
 	EndpointName string `json:"endpointName"`
-	IPAddress    string `json:"ipAddress"`
+	// Private IP address of the endpoint you created in Google Cloud.
+	IPAddress string `json:"ipAddress"`
 }
 
 func (pe ProjectPrivateEndpoint) Identifier() interface{} {
@@ -0,0 +1,31 @@
+package status
+
+type ProjectInvitationState string
+
+const (
+	// ProjectInvitationPending is the state of an invitation sent to a user who hasn't accepted it yet
+	ProjectInvitationPending ProjectInvitationState = "PENDING"
+	// ProjectInvitationAccepted is the state of an invitation accepted by the user, who is a member of the project
+	ProjectInvitationAccepted ProjectInvitationState = "ACCEPTED"
+	// ProjectInvitationFailed is the state of an invitation the operator failed to send or update
+	ProjectInvitationFailed ProjectInvitationState = "FAILED"
+)
+
+type ProjectInvitationStatus struct {
+	// Username is the email address of the invited user
+	Username string `json:"username"`
+	// State of the invitation: PENDING, ACCEPTED or FAILED
+	State ProjectInvitationState `json:"state"`
+	// InvitationID is the unique identifier of the pending invitation in Atlas
+	// +optional
+	InvitationID string `json:"invitationId,omitempty"`
+	// ExpiresAt is the time the pending invitation expires, in ISO 8601 format. Expired invitations are sent again
+	// +optional
+	ExpiresAt string `json:"expiresAt,omitempty"`
+	// Resent is the number of times the invitation was sent again after expiring
+	// +optional
+	Resent int `json:"resent,omitempty"`
+	// Error is the message when the invitation is in the FAILED state
+	// +optional
+	Error string `json:"error,omitempty"`
+}
@@ -5,4 +5,7 @@ type Prometheus struct {
 	Scheme string `json:"scheme,omitempty"`
 	// +optional
 	DiscoveryURL string `json:"prometheusDiscoveryURL,omitempty"`
+	// ScrapeSecret is the name of the Secret holding the discovery endpoint and the credentials to scrape the project
+	// +optional
+	ScrapeSecret string `json:"scrapeSecret,omitempty"`
 }
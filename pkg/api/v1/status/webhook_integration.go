@@ -0,0 +1,26 @@
+package status
+
+// WebhookIntegration reports the state of the WEBHOOK integration of the project
+type WebhookIntegration struct {
+	// CredentialsHash is a hash of the URL and the secret last applied to the webhook in Atlas.
+	// +optional
+	CredentialsHash string `json:"credentialsHash,omitempty"`
+	// LastRotated is a timestamp in ISO 8601 date and time format in UTC when the URL or the secret last changed.
+	// +optional
+	LastRotated string `json:"lastRotated,omitempty"`
+	// LastValidated is a timestamp in ISO 8601 date and time format in UTC when a test notification was last sent.
+	// +optional
+	LastValidated string `json:"lastValidated,omitempty"`
+	// LastSuccessfulProbe is a timestamp in ISO 8601 date and time format in UTC when a test notification was last
+	// accepted by the webhook. The test notifications are an operator probe sent from inside the cluster, they don't
+	// prove that the notifications of Atlas are delivered, which Atlas doesn't report.
+	// +optional
+	LastSuccessfulProbe string `json:"lastSuccessfulProbe,omitempty"`
+	// ValidationError is the reason the last test notification failed, empty when it was accepted.
+	// +optional
+	ValidationError string `json:"validationError,omitempty"`
+	// ValidationFailures is the number of consecutive test notifications that failed, the next one is sent after a
+	// delay growing with it.
+	// +optional
+	ValidationFailures int `json:"validationFailures,omitempty"`
+}
@@ -18,6 +18,21 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/project"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessRequestApproval) DeepCopyInto(out *AccessRequestApproval) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessRequestApproval.
+func (in *AccessRequestApproval) DeepCopy() *AccessRequestApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessRequestApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertConfiguration) DeepCopyInto(out *AlertConfiguration) {
 	*out = *in
@@ -65,10 +80,51 @@ func (in *AlertConfiguration) DeepCopy() *AlertConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasAccessRequestStatus) DeepCopyInto(out *AtlasAccessRequestStatus) {
+	*out = *in
+	in.Common.DeepCopyInto(&out.Common)
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(AccessRequestApproval)
+		**out = **in
+	}
+	if in.GrantedRoles != nil {
+		in, out := &in.GrantedRoles, &out.GrantedRoles
+		*out = make([]GrantedRole, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasAccessRequestStatus.
+func (in *AtlasAccessRequestStatus) DeepCopy() *AtlasAccessRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasAccessRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasDatabaseUserStatus) DeepCopyInto(out *AtlasDatabaseUserStatus) {
 	*out = *in
 	in.Common.DeepCopyInto(&out.Common)
+	if in.X509Certificate != nil {
+		in, out := &in.X509Certificate, &out.X509Certificate
+		*out = new(X509CertificateStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordRotation != nil {
+		in, out := &in.PasswordRotation, &out.PasswordRotation
+		*out = new(PasswordRotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionSecretStore != nil {
+		in, out := &in.ConnectionSecretStore, &out.ConnectionSecretStore
+		*out = new(ConnectionSecretStoreStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDatabaseUserStatus.
@@ -85,11 +141,25 @@ func (in *AtlasDatabaseUserStatus) DeepCopy() *AtlasDatabaseUserStatus {
 func (in *AtlasDeploymentStatus) DeepCopyInto(out *AtlasDeploymentStatus) {
 	*out = *in
 	in.Common.DeepCopyInto(&out.Common)
+	if in.StateChangedAt != nil {
+		in, out := &in.StateChangedAt, &out.StateChangedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.PendingVersionChange != nil {
+		in, out := &in.PendingVersionChange, &out.PendingVersionChange
+		*out = new(VersionChange)
+		**out = **in
+	}
 	if in.ConnectionStrings != nil {
 		in, out := &in.ConnectionStrings, &out.ConnectionStrings
 		*out = new(ConnectionStrings)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IPAddresses != nil {
+		in, out := &in.IPAddresses, &out.IPAddresses
+		*out = new(DeploymentIPAddresses)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ReplicaSets != nil {
 		in, out := &in.ReplicaSets, &out.ReplicaSets
 		*out = make([]ReplicaSet, len(*in))
@@ -112,6 +182,30 @@ func (in *AtlasDeploymentStatus) DeepCopyInto(out *AtlasDeploymentStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ServerlessUsage != nil {
+		in, out := &in.ServerlessUsage, &out.ServerlessUsage
+		*out = new(ServerlessUsage)
+		**out = **in
+	}
+	if in.DiskSizeGB != nil {
+		in, out := &in.DiskSizeGB, &out.DiskSizeGB
+		*out = new(int)
+		**out = **in
+	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(DeploymentBackup)
+		**out = **in
+	}
+	if in.ProvisioningStartedAt != nil {
+		in, out := &in.ProvisioningStartedAt, &out.ProvisioningStartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ObservedAtlasState != nil {
+		in, out := &in.ObservedAtlasState, &out.ObservedAtlasState
+		*out = new(ObservedAtlasState)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDeploymentStatus.
@@ -124,10 +218,70 @@ func (in *AtlasDeploymentStatus) DeepCopy() *AtlasDeploymentStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasEnvironmentStatus) DeepCopyInto(out *AtlasEnvironmentStatus) {
+	*out = *in
+	in.Common.DeepCopyInto(&out.Common)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]EnvironmentResource, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasEnvironmentStatus.
+func (in *AtlasEnvironmentStatus) DeepCopy() *AtlasEnvironmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasEnvironmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasError) DeepCopyInto(out *AtlasError) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasError.
+func (in *AtlasError) DeepCopy() *AtlasError {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasError)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasFederatedAuthStatus) DeepCopyInto(out *AtlasFederatedAuthStatus) {
 	*out = *in
 	in.Common.DeepCopyInto(&out.Common)
+	if in.DataAccessUsers != nil {
+		in, out := &in.DataAccessUsers, &out.DataAccessUsers
+		*out = make([]DataAccessUser, len(*in))
+		copy(*out, *in)
+	}
+	if in.RoleMappings != nil {
+		in, out := &in.RoleMappings, &out.RoleMappings
+		*out = make([]FederatedAuthRoleMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConnectedOrganizations != nil {
+		in, out := &in.ConnectedOrganizations, &out.ConnectedOrganizations
+		*out = make([]ConnectedOrganization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasFederatedAuthStatus.
@@ -140,6 +294,43 @@ func (in *AtlasFederatedAuthStatus) DeepCopy() *AtlasFederatedAuthStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasMigrationStatus) DeepCopyInto(out *AtlasMigrationStatus) {
+	*out = *in
+	in.Common.DeepCopyInto(&out.Common)
+	if in.MigrationHosts != nil {
+		in, out := &in.MigrationHosts, &out.MigrationHosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasMigrationStatus.
+func (in *AtlasMigrationStatus) DeepCopy() *AtlasMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasNetworkContainerStatus) DeepCopyInto(out *AtlasNetworkContainerStatus) {
+	*out = *in
+	in.Common.DeepCopyInto(&out.Common)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasNetworkContainerStatus.
+func (in *AtlasNetworkContainerStatus) DeepCopy() *AtlasNetworkContainerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasNetworkContainerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasNetworkPeer) DeepCopyInto(out *AtlasNetworkPeer) {
 	*out = *in
@@ -155,6 +346,87 @@ func (in *AtlasNetworkPeer) DeepCopy() *AtlasNetworkPeer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasOrgUserStatus) DeepCopyInto(out *AtlasOrgUserStatus) {
+	*out = *in
+	in.Common.DeepCopyInto(&out.Common)
+	if in.TeamIDs != nil {
+		in, out := &in.TeamIDs, &out.TeamIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOrgUserStatus.
+func (in *AtlasOrgUserStatus) DeepCopy() *AtlasOrgUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasOrgUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasOrganizationStatus) DeepCopyInto(out *AtlasOrganizationStatus) {
+	*out = *in
+	in.Common.DeepCopyInto(&out.Common)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOrganizationStatus.
+func (in *AtlasOrganizationStatus) DeepCopy() *AtlasOrganizationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasOrganizationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasPrivateEndpointStatus) DeepCopyInto(out *AtlasPrivateEndpointStatus) {
+	*out = *in
+	in.Common.DeepCopyInto(&out.Common)
+	if in.ServiceAttachmentNames != nil {
+		in, out := &in.ServiceAttachmentNames, &out.ServiceAttachmentNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]PrivateEndpointInterfaceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasPrivateEndpointStatus.
+func (in *AtlasPrivateEndpointStatus) DeepCopy() *AtlasPrivateEndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasPrivateEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasProjectAPIKeyStatus) DeepCopyInto(out *AtlasProjectAPIKeyStatus) {
+	*out = *in
+	in.Common.DeepCopyInto(&out.Common)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasProjectAPIKeyStatus.
+func (in *AtlasProjectAPIKeyStatus) DeepCopy() *AtlasProjectAPIKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasProjectAPIKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasProjectStatus) DeepCopyInto(out *AtlasProjectStatus) {
 	*out = *in
@@ -205,11 +477,42 @@ func (in *AtlasProjectStatus) DeepCopyInto(out *AtlasProjectStatus) {
 		*out = make([]ProjectTeamStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.ProjectInvitations != nil {
+		in, out := &in.ProjectInvitations, &out.ProjectInvitations
+		*out = make([]ProjectInvitationStatus, len(*in))
+		copy(*out, *in)
+	}
 	if in.Prometheus != nil {
 		in, out := &in.Prometheus, &out.Prometheus
 		*out = new(Prometheus)
 		**out = **in
 	}
+	if in.WebhookIntegration != nil {
+		in, out := &in.WebhookIntegration, &out.WebhookIntegration
+		*out = new(WebhookIntegration)
+		**out = **in
+	}
+	if in.SyncProgress != nil {
+		in, out := &in.SyncProgress, &out.SyncProgress
+		*out = make([]ResourceSyncProgress, len(*in))
+		copy(*out, *in)
+	}
+	if in.NextMaintenance != nil {
+		in, out := &in.NextMaintenance, &out.NextMaintenance
+		*out = (*in).DeepCopy()
+	}
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
+		*out = make([]DeletionProtectionDecision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UnmanagedDeployments != nil {
+		in, out := &in.UnmanagedDeployments, &out.UnmanagedDeployments
+		*out = make([]UnmanagedDeployment, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasProjectStatus.
@@ -222,6 +525,38 @@ func (in *AtlasProjectStatus) DeepCopy() *AtlasProjectStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasResourcePolicyStatus) DeepCopyInto(out *AtlasResourcePolicyStatus) {
+	*out = *in
+	in.Common.DeepCopyInto(&out.Common)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasResourcePolicyStatus.
+func (in *AtlasResourcePolicyStatus) DeepCopy() *AtlasResourcePolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasResourcePolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasSearchIndexStatus) DeepCopyInto(out *AtlasSearchIndexStatus) {
+	*out = *in
+	in.Common.DeepCopyInto(&out.Common)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasSearchIndexStatus.
+func (in *AtlasSearchIndexStatus) DeepCopy() *AtlasSearchIndexStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasSearchIndexStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupPolicyStatus) DeepCopyInto(out *BackupPolicyStatus) {
 	*out = *in
@@ -310,6 +645,16 @@ func (in *Common) DeepCopy() *Common {
 func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
 	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	if in.Cause != nil {
+		in, out := &in.Cause, &out.Cause
+		*out = new(ConditionCause)
+		**out = **in
+	}
+	if in.AtlasError != nil {
+		in, out := &in.AtlasError, &out.AtlasError
+		*out = new(AtlasError)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition.
@@ -322,6 +667,63 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionSecretStoreStatus) DeepCopyInto(out *ConnectionSecretStoreStatus) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionSecretStoreStatus.
+func (in *ConnectionSecretStoreStatus) DeepCopy() *ConnectionSecretStoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionSecretStoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionCause) DeepCopyInto(out *ConditionCause) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionCause.
+func (in *ConditionCause) DeepCopy() *ConditionCause {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionCause)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectedOrganization) DeepCopyInto(out *ConnectedOrganization) {
+	*out = *in
+	if in.RoleMappings != nil {
+		in, out := &in.RoleMappings, &out.RoleMappings
+		*out = make([]FederatedAuthRoleMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectedOrganization.
+func (in *ConnectedOrganization) DeepCopy() *ConnectedOrganization {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectedOrganization)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConnectionStrings) DeepCopyInto(out *ConnectionStrings) {
 	*out = *in
@@ -396,6 +798,21 @@ func (in *CustomZoneMapping) DeepCopy() *CustomZoneMapping {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataAccessUser) DeepCopyInto(out *DataAccessUser) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataAccessUser.
+func (in *DataAccessUser) DeepCopy() *DataAccessUser {
+	if in == nil {
+		return nil
+	}
+	out := new(DataAccessUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataFederationStatus) DeepCopyInto(out *DataFederationStatus) {
 	*out = *in
@@ -412,6 +829,66 @@ func (in *DataFederationStatus) DeepCopy() *DataFederationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeletionProtectionDecision) DeepCopyInto(out *DeletionProtectionDecision) {
+	*out = *in
+	if in.Differences != nil {
+		in, out := &in.Differences, &out.Differences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeletionProtectionDecision.
+func (in *DeletionProtectionDecision) DeepCopy() *DeletionProtectionDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(DeletionProtectionDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentBackup) DeepCopyInto(out *DeploymentBackup) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentBackup.
+func (in *DeploymentBackup) DeepCopy() *DeploymentBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentIPAddresses) DeepCopyInto(out *DeploymentIPAddresses) {
+	*out = *in
+	if in.Inbound != nil {
+		in, out := &in.Inbound, &out.Inbound
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Outbound != nil {
+		in, out := &in.Outbound, &out.Outbound
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentIPAddresses.
+func (in *DeploymentIPAddresses) DeepCopy() *DeploymentIPAddresses {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentIPAddresses)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Endpoint) DeepCopyInto(out *Endpoint) {
 	*out = *in
@@ -427,6 +904,21 @@ func (in *Endpoint) DeepCopy() *Endpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentResource) DeepCopyInto(out *EnvironmentResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentResource.
+func (in *EnvironmentResource) DeepCopy() *EnvironmentResource {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeatureUsage) DeepCopyInto(out *FeatureUsage) {
 	*out = *in
@@ -442,6 +934,46 @@ func (in *FeatureUsage) DeepCopy() *FeatureUsage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FederatedAuthProjectRole) DeepCopyInto(out *FederatedAuthProjectRole) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederatedAuthProjectRole.
+func (in *FederatedAuthProjectRole) DeepCopy() *FederatedAuthProjectRole {
+	if in == nil {
+		return nil
+	}
+	out := new(FederatedAuthProjectRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FederatedAuthRoleMapping) DeepCopyInto(out *FederatedAuthRoleMapping) {
+	*out = *in
+	if in.OrgRoles != nil {
+		in, out := &in.OrgRoles, &out.OrgRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProjectRoles != nil {
+		in, out := &in.ProjectRoles, &out.ProjectRoles
+		*out = make([]FederatedAuthProjectRole, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederatedAuthRoleMapping.
+func (in *FederatedAuthRoleMapping) DeepCopy() *FederatedAuthRoleMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(FederatedAuthRoleMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCPEndpoint) DeepCopyInto(out *GCPEndpoint) {
 	*out = *in
@@ -457,6 +989,21 @@ func (in *GCPEndpoint) DeepCopy() *GCPEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantedRole) DeepCopyInto(out *GrantedRole) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantedRole.
+func (in *GrantedRole) DeepCopy() *GrantedRole {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantedRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedNamespace) DeepCopyInto(out *ManagedNamespace) {
 	*out = *in
@@ -552,6 +1099,48 @@ func (in *Notification) DeepCopy() *Notification {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservedAtlasState) DeepCopyInto(out *ObservedAtlasState) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservedAtlasState.
+func (in *ObservedAtlasState) DeepCopy() *ObservedAtlasState {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservedAtlasState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordRotationStatus) DeepCopyInto(out *PasswordRotationStatus) {
+	*out = *in
+	if in.LastRotation != nil {
+		in, out := &in.LastRotation, &out.LastRotation
+		*out = (*in).DeepCopy()
+	}
+	in.NextRotation.DeepCopyInto(&out.NextRotation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordRotationStatus.
+func (in *PasswordRotationStatus) DeepCopy() *PasswordRotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordRotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrivateEndpoint) DeepCopyInto(out *PrivateEndpoint) {
 	*out = *in
@@ -572,6 +1161,52 @@ func (in *PrivateEndpoint) DeepCopy() *PrivateEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateEndpointInterfaceStatus) DeepCopyInto(out *PrivateEndpointInterfaceStatus) {
+	*out = *in
+	if in.FailedSince != nil {
+		in, out := &in.FailedSince, &out.FailedSince
+		*out = (*in).DeepCopy()
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]GCPEndpoint, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateEndpointInterfaceStatus.
+func (in *PrivateEndpointInterfaceStatus) DeepCopy() *PrivateEndpointInterfaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateEndpointInterfaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectInvitationStatus) DeepCopyInto(out *ProjectInvitationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectInvitationStatus.
+func (in *ProjectInvitationStatus) DeepCopy() *ProjectInvitationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectInvitationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectPrivateEndpoint) DeepCopyInto(out *ProjectPrivateEndpoint) {
 	*out = *in
@@ -643,6 +1278,21 @@ func (in *ReplicaSet) DeepCopy() *ReplicaSet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceSyncProgress) DeepCopyInto(out *ResourceSyncProgress) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceSyncProgress.
+func (in *ResourceSyncProgress) DeepCopy() *ResourceSyncProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceSyncProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerlessPrivateEndpoint) DeepCopyInto(out *ServerlessPrivateEndpoint) {
 	*out = *in
@@ -658,6 +1308,21 @@ func (in *ServerlessPrivateEndpoint) DeepCopy() *ServerlessPrivateEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerlessUsage) DeepCopyInto(out *ServerlessUsage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerlessUsage.
+func (in *ServerlessUsage) DeepCopy() *ServerlessUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerlessUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TeamProject) DeepCopyInto(out *TeamProject) {
 	*out = *in
@@ -708,3 +1373,64 @@ func (in *Threshold) DeepCopy() *Threshold {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnmanagedDeployment) DeepCopyInto(out *UnmanagedDeployment) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnmanagedDeployment.
+func (in *UnmanagedDeployment) DeepCopy() *UnmanagedDeployment {
+	if in == nil {
+		return nil
+	}
+	out := new(UnmanagedDeployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VersionChange) DeepCopyInto(out *VersionChange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionChange.
+func (in *VersionChange) DeepCopy() *VersionChange {
+	if in == nil {
+		return nil
+	}
+	out := new(VersionChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookIntegration) DeepCopyInto(out *WebhookIntegration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookIntegration.
+func (in *WebhookIntegration) DeepCopy() *WebhookIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *X509CertificateStatus) DeepCopyInto(out *X509CertificateStatus) {
+	*out = *in
+	in.NotAfter.DeepCopyInto(&out.NotAfter)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new X509CertificateStatus.
+func (in *X509CertificateStatus) DeepCopy() *X509CertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(X509CertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -14,12 +14,34 @@ a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
 package v1
 
 import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/project"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSPeeringAutoAccept) DeepCopyInto(out *AWSPeeringAutoAccept) {
+	*out = *in
+	if in.RouteTableIDs != nil {
+		in, out := &in.RouteTableIDs, &out.RouteTableIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSPeeringAutoAccept.
+func (in *AWSPeeringAutoAccept) DeepCopy() *AWSPeeringAutoAccept {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSPeeringAutoAccept)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSProviderConfig) DeepCopyInto(out *AWSProviderConfig) {
 	*out = *in
@@ -264,6 +286,87 @@ func (in *AlertConfiguration) DeepCopy() *AlertConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasAccessRequest) DeepCopyInto(out *AtlasAccessRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasAccessRequest.
+func (in *AtlasAccessRequest) DeepCopy() *AtlasAccessRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasAccessRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasAccessRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasAccessRequestList) DeepCopyInto(out *AtlasAccessRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasAccessRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasAccessRequestList.
+func (in *AtlasAccessRequestList) DeepCopy() *AtlasAccessRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasAccessRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasAccessRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasAccessRequestSpec) DeepCopyInto(out *AtlasAccessRequestSpec) {
+	*out = *in
+	out.DatabaseUserRef = in.DatabaseUserRef
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]RoleSpec, len(*in))
+		copy(*out, *in)
+	}
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasAccessRequestSpec.
+func (in *AtlasAccessRequestSpec) DeepCopy() *AtlasAccessRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasAccessRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasBackupExportSpec) DeepCopyInto(out *AtlasBackupExportSpec) {
 	*out = *in
@@ -582,6 +685,11 @@ func (in *AtlasDatabaseUserList) DeepCopyObject() runtime.Object {
 func (in *AtlasDatabaseUserSpec) DeepCopyInto(out *AtlasDatabaseUserSpec) {
 	*out = *in
 	out.Project = in.Project
+	if in.Expiration != nil {
+		in, out := &in.Expiration, &out.Expiration
+		*out = new(DatabaseUserExpirationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Labels != nil {
 		in, out := &in.Labels, &out.Labels
 		*out = make([]common.LabelSpec, len(*in))
@@ -597,11 +705,55 @@ func (in *AtlasDatabaseUserSpec) DeepCopyInto(out *AtlasDatabaseUserSpec) {
 		*out = make([]ScopeSpec, len(*in))
 		copy(*out, *in)
 	}
+	if in.SkipConnectionSecrets != nil {
+		in, out := &in.SkipConnectionSecrets, &out.SkipConnectionSecrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConnectionSecretLabels != nil {
+		in, out := &in.ConnectionSecretLabels, &out.ConnectionSecretLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ConnectionSecretAnnotations != nil {
+		in, out := &in.ConnectionSecretAnnotations, &out.ConnectionSecretAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ConnectionStringPolicy != nil {
+		in, out := &in.ConnectionStringPolicy, &out.ConnectionStringPolicy
+		*out = new(ConnectionStringPolicy)
+		**out = **in
+	}
 	if in.PasswordSecret != nil {
 		in, out := &in.PasswordSecret, &out.PasswordSecret
 		*out = new(common.ResourceRef)
 		**out = **in
 	}
+	if in.PasswordStore != nil {
+		in, out := &in.PasswordStore, &out.PasswordStore
+		*out = new(PasswordStoreRef)
+		**out = **in
+	}
+	if in.ConnectionSecretStore != nil {
+		in, out := &in.ConnectionSecretStore, &out.ConnectionSecretStore
+		*out = new(ConnectionSecretStoreRef)
+		**out = **in
+	}
+	if in.X509Certificate != nil {
+		in, out := &in.X509Certificate, &out.X509Certificate
+		*out = new(X509CertificateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordRotation != nil {
+		in, out := &in.PasswordRotation, &out.PasswordRotation
+		*out = new(PasswordRotationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDatabaseUserSpec.
@@ -693,6 +845,50 @@ func (in *AtlasDeploymentSpec) DeepCopyInto(out *AtlasDeploymentSpec) {
 		*out = new(ProcessArgs)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ProvisioningTimeout != nil {
+		in, out := &in.ProvisioningTimeout, &out.ProvisioningTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ExternalNameService != nil {
+		in, out := &in.ExternalNameService, &out.ExternalNameService
+		*out = new(ExternalNameServiceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressConfigMap != nil {
+		in, out := &in.EgressConfigMap, &out.EgressConfigMap
+		*out = new(EgressConfigMapSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPAddressReport != nil {
+		in, out := &in.IPAddressReport, &out.IPAddressReport
+		*out = new(IPAddressReportSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionInfo != nil {
+		in, out := &in.ConnectionInfo, &out.ConnectionInfo
+		*out = new(ConnectionInfoSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionSecretLabels != nil {
+		in, out := &in.ConnectionSecretLabels, &out.ConnectionSecretLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ConnectionSecretAnnotations != nil {
+		in, out := &in.ConnectionSecretAnnotations, &out.ConnectionSecretAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.UnsupportedOverrides != nil {
+		in, out := &in.UnsupportedOverrides, &out.UnsupportedOverrides
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDeploymentSpec.
@@ -705,6 +901,102 @@ func (in *AtlasDeploymentSpec) DeepCopy() *AtlasDeploymentSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasEnvironment) DeepCopyInto(out *AtlasEnvironment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasEnvironment.
+func (in *AtlasEnvironment) DeepCopy() *AtlasEnvironment {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasEnvironment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasEnvironment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasEnvironmentList) DeepCopyInto(out *AtlasEnvironmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasEnvironment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasEnvironmentList.
+func (in *AtlasEnvironmentList) DeepCopy() *AtlasEnvironmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasEnvironmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasEnvironmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasEnvironmentSpec) DeepCopyInto(out *AtlasEnvironmentSpec) {
+	*out = *in
+	if in.ConnectionSecret != nil {
+		in, out := &in.ConnectionSecret, &out.ConnectionSecret
+		*out = new(common.ResourceRefNamespaced)
+		**out = **in
+	}
+	out.Deployment = in.Deployment
+	in.DatabaseUser.DeepCopyInto(&out.DatabaseUser)
+	if in.IPAccessList != nil {
+		in, out := &in.IPAccessList, &out.IPAccessList
+		*out = make([]project.IPAccessList, len(*in))
+		copy(*out, *in)
+	}
+	if in.EgressIPDiscovery != nil {
+		in, out := &in.EgressIPDiscovery, &out.EgressIPDiscovery
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasEnvironmentSpec.
+func (in *AtlasEnvironmentSpec) DeepCopy() *AtlasEnvironmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasEnvironmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasFederatedAuth) DeepCopyInto(out *AtlasFederatedAuth) {
 	*out = *in
@@ -783,6 +1075,11 @@ func (in *AtlasFederatedAuthSpec) DeepCopyInto(out *AtlasFederatedAuthSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SAMLIdentityProvider != nil {
+		in, out := &in.SAMLIdentityProvider, &out.SAMLIdentityProvider
+		*out = new(SAMLIdentityProviderSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PostAuthRoleGrants != nil {
 		in, out := &in.PostAuthRoleGrants, &out.PostAuthRoleGrants
 		*out = make([]string, len(*in))
@@ -795,6 +1092,20 @@ func (in *AtlasFederatedAuthSpec) DeepCopyInto(out *AtlasFederatedAuthSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DataAccessRoleMappings != nil {
+		in, out := &in.DataAccessRoleMappings, &out.DataAccessRoleMappings
+		*out = make([]DataAccessRoleMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConnectedOrganizations != nil {
+		in, out := &in.ConnectedOrganizations, &out.ConnectedOrganizations
+		*out = make([]ConnectedOrganization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasFederatedAuthSpec.
@@ -808,7 +1119,7 @@ func (in *AtlasFederatedAuthSpec) DeepCopy() *AtlasFederatedAuthSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasProject) DeepCopyInto(out *AtlasProject) {
+func (in *AtlasMigration) DeepCopyInto(out *AtlasMigration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -816,18 +1127,18 @@ func (in *AtlasProject) DeepCopyInto(out *AtlasProject) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasProject.
-func (in *AtlasProject) DeepCopy() *AtlasProject {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasMigration.
+func (in *AtlasMigration) DeepCopy() *AtlasMigration {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasProject)
+	out := new(AtlasMigration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AtlasProject) DeepCopyObject() runtime.Object {
+func (in *AtlasMigration) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -835,31 +1146,31 @@ func (in *AtlasProject) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasProjectList) DeepCopyInto(out *AtlasProjectList) {
+func (in *AtlasMigrationList) DeepCopyInto(out *AtlasMigrationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AtlasProject, len(*in))
+		*out = make([]AtlasMigration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasProjectList.
-func (in *AtlasProjectList) DeepCopy() *AtlasProjectList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasMigrationList.
+func (in *AtlasMigrationList) DeepCopy() *AtlasMigrationList {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasProjectList)
+	out := new(AtlasMigrationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AtlasProjectList) DeepCopyObject() runtime.Object {
+func (in *AtlasMigrationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -867,47 +1178,580 @@ func (in *AtlasProjectList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasProjectSpec) DeepCopyInto(out *AtlasProjectSpec) {
+func (in *AtlasMigrationSpec) DeepCopyInto(out *AtlasMigrationSpec) {
 	*out = *in
-	if in.ConnectionSecret != nil {
-		in, out := &in.ConnectionSecret, &out.ConnectionSecret
-		*out = new(common.ResourceRefNamespaced)
-		**out = **in
-	}
-	if in.ProjectIPAccessList != nil {
-		in, out := &in.ProjectIPAccessList, &out.ProjectIPAccessList
-		*out = make([]project.IPAccessList, len(*in))
-		copy(*out, *in)
-	}
-	out.MaintenanceWindow = in.MaintenanceWindow
-	if in.PrivateEndpoints != nil {
-		in, out := &in.PrivateEndpoints, &out.PrivateEndpoints
-		*out = make([]PrivateEndpoint, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.CloudProviderAccessRoles != nil {
-		in, out := &in.CloudProviderAccessRoles, &out.CloudProviderAccessRoles
-		*out = make([]CloudProviderAccessRole, len(*in))
-		copy(*out, *in)
-	}
-	if in.CloudProviderIntegrations != nil {
-		in, out := &in.CloudProviderIntegrations, &out.CloudProviderIntegrations
-		*out = make([]CloudProviderIntegration, len(*in))
+	in.Source.DeepCopyInto(&out.Source)
+	out.DeploymentRef = in.DeploymentRef
+	if in.MigrationHosts != nil {
+		in, out := &in.MigrationHosts, &out.MigrationHosts
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.AlertConfigurations != nil {
-		in, out := &in.AlertConfigurations, &out.AlertConfigurations
-		*out = make([]AlertConfiguration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasMigrationSpec.
+func (in *AtlasMigrationSpec) DeepCopy() *AtlasMigrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasMigrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasNetworkContainer) DeepCopyInto(out *AtlasNetworkContainer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasNetworkContainer.
+func (in *AtlasNetworkContainer) DeepCopy() *AtlasNetworkContainer {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasNetworkContainer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasNetworkContainer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasNetworkContainerList) DeepCopyInto(out *AtlasNetworkContainerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasNetworkContainer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasNetworkContainerList.
+func (in *AtlasNetworkContainerList) DeepCopy() *AtlasNetworkContainerList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasNetworkContainerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasNetworkContainerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasNetworkContainerSpec) DeepCopyInto(out *AtlasNetworkContainerSpec) {
+	*out = *in
+	out.Project = in.Project
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasNetworkContainerSpec.
+func (in *AtlasNetworkContainerSpec) DeepCopy() *AtlasNetworkContainerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasNetworkContainerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasOrgUser) DeepCopyInto(out *AtlasOrgUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOrgUser.
+func (in *AtlasOrgUser) DeepCopy() *AtlasOrgUser {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasOrgUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasOrgUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasOrgUserList) DeepCopyInto(out *AtlasOrgUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasOrgUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOrgUserList.
+func (in *AtlasOrgUserList) DeepCopy() *AtlasOrgUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasOrgUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasOrgUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasOrgUserSpec) DeepCopyInto(out *AtlasOrgUserSpec) {
+	*out = *in
+	if in.ConnectionSecretRef != nil {
+		in, out := &in.ConnectionSecretRef, &out.ConnectionSecretRef
+		*out = new(common.ResourceRefNamespaced)
+		**out = **in
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]OrgRole, len(*in))
+		copy(*out, *in)
+	}
+	if in.TeamRefs != nil {
+		in, out := &in.TeamRefs, &out.TeamRefs
+		*out = make([]common.ResourceRefNamespaced, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOrgUserSpec.
+func (in *AtlasOrgUserSpec) DeepCopy() *AtlasOrgUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasOrgUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasOrganization) DeepCopyInto(out *AtlasOrganization) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOrganization.
+func (in *AtlasOrganization) DeepCopy() *AtlasOrganization {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasOrganization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasOrganization) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasOrganizationList) DeepCopyInto(out *AtlasOrganizationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasOrganization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOrganizationList.
+func (in *AtlasOrganizationList) DeepCopy() *AtlasOrganizationList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasOrganizationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasOrganizationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasOrganizationSpec) DeepCopyInto(out *AtlasOrganizationSpec) {
+	*out = *in
+	if in.ConnectionSecretRef != nil {
+		in, out := &in.ConnectionSecretRef, &out.ConnectionSecretRef
+		*out = new(common.ResourceRefNamespaced)
+		**out = **in
+	}
+	if in.APIKeyRoles != nil {
+		in, out := &in.APIKeyRoles, &out.APIKeyRoles
+		*out = make([]OrgRole, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(common.ResourceRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOrganizationSpec.
+func (in *AtlasOrganizationSpec) DeepCopy() *AtlasOrganizationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasOrganizationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasPrivateEndpoint) DeepCopyInto(out *AtlasPrivateEndpoint) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasPrivateEndpoint.
+func (in *AtlasPrivateEndpoint) DeepCopy() *AtlasPrivateEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasPrivateEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasPrivateEndpoint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasPrivateEndpointList) DeepCopyInto(out *AtlasPrivateEndpointList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasPrivateEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasPrivateEndpointList.
+func (in *AtlasPrivateEndpointList) DeepCopy() *AtlasPrivateEndpointList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasPrivateEndpointList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasPrivateEndpointList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasPrivateEndpointSpec) DeepCopyInto(out *AtlasPrivateEndpointSpec) {
+	*out = *in
+	out.Project = in.Project
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]PrivateEndpointInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Recreation != nil {
+		in, out := &in.Recreation, &out.Recreation
+		*out = new(PrivateEndpointRecreationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasPrivateEndpointSpec.
+func (in *AtlasPrivateEndpointSpec) DeepCopy() *AtlasPrivateEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasPrivateEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasProject) DeepCopyInto(out *AtlasProject) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasProject.
+func (in *AtlasProject) DeepCopy() *AtlasProject {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasProject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasProject) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasProjectAPIKey) DeepCopyInto(out *AtlasProjectAPIKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasProjectAPIKey.
+func (in *AtlasProjectAPIKey) DeepCopy() *AtlasProjectAPIKey {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasProjectAPIKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasProjectAPIKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasProjectAPIKeyList) DeepCopyInto(out *AtlasProjectAPIKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasProjectAPIKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasProjectAPIKeyList.
+func (in *AtlasProjectAPIKeyList) DeepCopy() *AtlasProjectAPIKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasProjectAPIKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasProjectAPIKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasProjectAPIKeySpec) DeepCopyInto(out *AtlasProjectAPIKeySpec) {
+	*out = *in
+	out.Project = in.Project
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]TeamRole, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(common.ResourceRef)
+		**out = **in
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasProjectAPIKeySpec.
+func (in *AtlasProjectAPIKeySpec) DeepCopy() *AtlasProjectAPIKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasProjectAPIKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasProjectList) DeepCopyInto(out *AtlasProjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasProject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasProjectList.
+func (in *AtlasProjectList) DeepCopy() *AtlasProjectList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasProjectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasProjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasProjectSpec) DeepCopyInto(out *AtlasProjectSpec) {
+	*out = *in
+	if in.ConnectionSecret != nil {
+		in, out := &in.ConnectionSecret, &out.ConnectionSecret
+		*out = new(common.ResourceRefNamespaced)
+		**out = **in
+	}
+	if in.ProjectIPAccessList != nil {
+		in, out := &in.ProjectIPAccessList, &out.ProjectIPAccessList
+		*out = make([]project.IPAccessList, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProjectIPAccessListConfigMapRef != nil {
+		in, out := &in.ProjectIPAccessListConfigMapRef, &out.ProjectIPAccessListConfigMapRef
+		*out = new(common.ResourceRefNamespaced)
+		**out = **in
+	}
+	if in.EgressIPDiscovery != nil {
+		in, out := &in.EgressIPDiscovery, &out.EgressIPDiscovery
+		*out = new(project.EgressIPDiscovery)
+		**out = **in
+	}
+	in.MaintenanceWindow.DeepCopyInto(&out.MaintenanceWindow)
+	if in.PrivateEndpoints != nil {
+		in, out := &in.PrivateEndpoints, &out.PrivateEndpoints
+		*out = make([]PrivateEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RegionalizedPrivateEndpoints != nil {
+		in, out := &in.RegionalizedPrivateEndpoints, &out.RegionalizedPrivateEndpoints
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CloudProviderAccessRoles != nil {
+		in, out := &in.CloudProviderAccessRoles, &out.CloudProviderAccessRoles
+		*out = make([]CloudProviderAccessRole, len(*in))
+		copy(*out, *in)
+	}
+	if in.CloudProviderIntegrations != nil {
+		in, out := &in.CloudProviderIntegrations, &out.CloudProviderIntegrations
+		*out = make([]CloudProviderIntegration, len(*in))
+		copy(*out, *in)
+	}
+	if in.AlertConfigurations != nil {
+		in, out := &in.AlertConfigurations, &out.AlertConfigurations
+		*out = make([]AlertConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.NetworkPeers != nil {
 		in, out := &in.NetworkPeers, &out.NetworkPeers
 		*out = make([]NetworkPeer, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.X509CertRef != nil {
 		in, out := &in.X509CertRef, &out.X509CertRef
@@ -929,33 +1773,246 @@ func (in *AtlasProjectSpec) DeepCopyInto(out *AtlasProjectSpec) {
 		*out = new(Auditing)
 		**out = **in
 	}
-	if in.Settings != nil {
-		in, out := &in.Settings, &out.Settings
-		*out = new(ProjectSettings)
-		(*in).DeepCopyInto(*out)
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = new(ProjectSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomRoles != nil {
+		in, out := &in.CustomRoles, &out.CustomRoles
+		*out = make([]CustomRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CustomRolesImport != nil {
+		in, out := &in.CustomRolesImport, &out.CustomRolesImport
+		*out = new(CustomRolesImport)
+		**out = **in
+	}
+	if in.Teams != nil {
+		in, out := &in.Teams, &out.Teams
+		*out = make([]Team, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DefaultDatabaseUser != nil {
+		in, out := &in.DefaultDatabaseUser, &out.DefaultDatabaseUser
+		*out = new(DefaultDatabaseUser)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectInvitations != nil {
+		in, out := &in.ProjectInvitations, &out.ProjectInvitations
+		*out = make([]ProjectInvitation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasProjectSpec.
+func (in *AtlasProjectSpec) DeepCopy() *AtlasProjectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasProjectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasResourcePolicy) DeepCopyInto(out *AtlasResourcePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasResourcePolicy.
+func (in *AtlasResourcePolicy) DeepCopy() *AtlasResourcePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasResourcePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasResourcePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasResourcePolicyList) DeepCopyInto(out *AtlasResourcePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasResourcePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasResourcePolicyList.
+func (in *AtlasResourcePolicyList) DeepCopy() *AtlasResourcePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasResourcePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasResourcePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasResourcePolicySpec) DeepCopyInto(out *AtlasResourcePolicySpec) {
+	*out = *in
+	if in.ConnectionSecretRef != nil {
+		in, out := &in.ConnectionSecretRef, &out.ConnectionSecretRef
+		*out = new(common.ResourceRefNamespaced)
+		**out = **in
+	}
+	if in.AllowedCloudProviders != nil {
+		in, out := &in.AllowedCloudProviders, &out.AllowedCloudProviders
+		*out = make([]ResourcePolicyCloudProvider, len(*in))
+		copy(*out, *in)
+	}
+	if in.ForbiddenRegions != nil {
+		in, out := &in.ForbiddenRegions, &out.ForbiddenRegions
+		*out = make([]ResourcePolicyRegion, len(*in))
+		copy(*out, *in)
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasResourcePolicySpec.
+func (in *AtlasResourcePolicySpec) DeepCopy() *AtlasResourcePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasResourcePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasSearchIndex) DeepCopyInto(out *AtlasSearchIndex) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasSearchIndex.
+func (in *AtlasSearchIndex) DeepCopy() *AtlasSearchIndex {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasSearchIndex)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasSearchIndex) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.CustomRoles != nil {
-		in, out := &in.CustomRoles, &out.CustomRoles
-		*out = make([]CustomRole, len(*in))
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasSearchIndexList) DeepCopyInto(out *AtlasSearchIndexList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasSearchIndex, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Teams != nil {
-		in, out := &in.Teams, &out.Teams
-		*out = make([]Team, len(*in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasSearchIndexList.
+func (in *AtlasSearchIndexList) DeepCopy() *AtlasSearchIndexList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasSearchIndexList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasSearchIndexList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasSearchIndexSpec) DeepCopyInto(out *AtlasSearchIndexSpec) {
+	*out = *in
+	out.DeploymentRef = in.DeploymentRef
+	if in.Mappings != nil {
+		in, out := &in.Mappings, &out.Mappings
+		*out = new(SearchIndexMappings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Analyzers != nil {
+		in, out := &in.Analyzers, &out.Analyzers
+		*out = make([]SearchIndexAnalyzer, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Synonyms != nil {
+		in, out := &in.Synonyms, &out.Synonyms
+		*out = make([]SearchIndexSynonym, len(*in))
+		copy(*out, *in)
+	}
+	if in.StoredSource != nil {
+		in, out := &in.StoredSource, &out.StoredSource
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]VectorSearchField, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasProjectSpec.
-func (in *AtlasProjectSpec) DeepCopy() *AtlasProjectSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasSearchIndexSpec.
+func (in *AtlasSearchIndexSpec) DeepCopy() *AtlasSearchIndexSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasProjectSpec)
+	out := new(AtlasSearchIndexSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1248,6 +2305,96 @@ func (in *ComputeSpec) DeepCopy() *ComputeSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectedOrganization) DeepCopyInto(out *ConnectedOrganization) {
+	*out = *in
+	out.ConnectionSecretRef = in.ConnectionSecretRef
+	if in.DomainAllowList != nil {
+		in, out := &in.DomainAllowList, &out.DomainAllowList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DomainRestrictionEnabled != nil {
+		in, out := &in.DomainRestrictionEnabled, &out.DomainRestrictionEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PostAuthRoleGrants != nil {
+		in, out := &in.PostAuthRoleGrants, &out.PostAuthRoleGrants
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RoleMappings != nil {
+		in, out := &in.RoleMappings, &out.RoleMappings
+		*out = make([]RoleMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectedOrganization.
+func (in *ConnectedOrganization) DeepCopy() *ConnectedOrganization {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectedOrganization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionInfoSpec) DeepCopyInto(out *ConnectionInfoSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionInfoSpec.
+func (in *ConnectionInfoSpec) DeepCopy() *ConnectionInfoSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionInfoSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionSecretStoreRef) DeepCopyInto(out *ConnectionSecretStoreRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionSecretStoreRef.
+func (in *ConnectionSecretStoreRef) DeepCopy() *ConnectionSecretStoreRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionSecretStoreRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionStringPolicy) DeepCopyInto(out *ConnectionStringPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionStringPolicy.
+func (in *ConnectionStringPolicy) DeepCopy() *ConnectionStringPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionStringPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConnectionStrings) DeepCopyInto(out *ConnectionStrings) {
 	*out = *in
@@ -1346,6 +2493,21 @@ func (in *CustomRole) DeepCopy() *CustomRole {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomRolesImport) DeepCopyInto(out *CustomRolesImport) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRolesImport.
+func (in *CustomRolesImport) DeepCopy() *CustomRolesImport {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomRolesImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomZoneMapping) DeepCopyInto(out *CustomZoneMapping) {
 	*out = *in
@@ -1361,6 +2523,36 @@ func (in *CustomZoneMapping) DeepCopy() *CustomZoneMapping {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataAccessRoleMapping) DeepCopyInto(out *DataAccessRoleMapping) {
+	*out = *in
+	if in.ProjectNames != nil {
+		in, out := &in.ProjectNames, &out.ProjectNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]RoleSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]ScopeSpec, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataAccessRoleMapping.
+func (in *DataAccessRoleMapping) DeepCopy() *DataAccessRoleMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(DataAccessRoleMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataFederationPE) DeepCopyInto(out *DataFederationPE) {
 	*out = *in
@@ -1464,65 +2656,197 @@ func (in *Database) DeepCopyInto(out *Database) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Database.
-func (in *Database) DeepCopy() *Database {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Database.
+func (in *Database) DeepCopy() *Database {
+	if in == nil {
+		return nil
+	}
+	out := new(Database)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseUserExpirationSpec) DeepCopyInto(out *DatabaseUserExpirationSpec) {
+	*out = *in
+	if in.WarnBefore != nil {
+		in, out := &in.WarnBefore, &out.WarnBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.AutoExtend != nil {
+		in, out := &in.AutoExtend, &out.AutoExtend
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseUserExpirationSpec.
+func (in *DatabaseUserExpirationSpec) DeepCopy() *DatabaseUserExpirationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseUserExpirationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultDatabaseUser) DeepCopyInto(out *DefaultDatabaseUser) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]RoleSpec, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultDatabaseUser.
+func (in *DefaultDatabaseUser) DeepCopy() *DefaultDatabaseUser {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultDatabaseUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskGB) DeepCopyInto(out *DiskGB) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskGB.
+func (in *DiskGB) DeepCopy() *DiskGB {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskGB)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressConfigMapSpec) DeepCopyInto(out *EgressConfigMapSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressConfigMapSpec.
+func (in *EgressConfigMapSpec) DeepCopy() *EgressConfigMapSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressConfigMapSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionAtRest) DeepCopyInto(out *EncryptionAtRest) {
+	*out = *in
+	in.AwsKms.DeepCopyInto(&out.AwsKms)
+	in.AzureKeyVault.DeepCopyInto(&out.AzureKeyVault)
+	in.GoogleCloudKms.DeepCopyInto(&out.GoogleCloudKms)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionAtRest.
+func (in *EncryptionAtRest) DeepCopy() *EncryptionAtRest {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionAtRest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointSpec) DeepCopyInto(out *EndpointSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointSpec.
+func (in *EndpointSpec) DeepCopy() *EndpointSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Database)
+	out := new(EndpointSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DiskGB) DeepCopyInto(out *DiskGB) {
+func (in *EnvironmentDatabaseUserSpec) DeepCopyInto(out *EnvironmentDatabaseUserSpec) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
+	out.PasswordSecret = in.PasswordSecret
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]RoleSpec, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskGB.
-func (in *DiskGB) DeepCopy() *DiskGB {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentDatabaseUserSpec.
+func (in *EnvironmentDatabaseUserSpec) DeepCopy() *EnvironmentDatabaseUserSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DiskGB)
+	out := new(EnvironmentDatabaseUserSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EncryptionAtRest) DeepCopyInto(out *EncryptionAtRest) {
+func (in *EnvironmentDeploymentSpec) DeepCopyInto(out *EnvironmentDeploymentSpec) {
 	*out = *in
-	in.AwsKms.DeepCopyInto(&out.AwsKms)
-	in.AzureKeyVault.DeepCopyInto(&out.AzureKeyVault)
-	in.GoogleCloudKms.DeepCopyInto(&out.GoogleCloudKms)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionAtRest.
-func (in *EncryptionAtRest) DeepCopy() *EncryptionAtRest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentDeploymentSpec.
+func (in *EnvironmentDeploymentSpec) DeepCopy() *EnvironmentDeploymentSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(EncryptionAtRest)
+	out := new(EnvironmentDeploymentSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EndpointSpec) DeepCopyInto(out *EndpointSpec) {
+func (in *ExternalNameServiceSpec) DeepCopyInto(out *ExternalNameServiceSpec) {
 	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointSpec.
-func (in *EndpointSpec) DeepCopy() *EndpointSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalNameServiceSpec.
+func (in *ExternalNameServiceSpec) DeepCopy() *ExternalNameServiceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(EndpointSpec)
+	out := new(ExternalNameServiceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1582,6 +2906,33 @@ func (in *GoogleCloudKms) DeepCopy() *GoogleCloudKms {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddressReportSpec) DeepCopyInto(out *IPAddressReportSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressReportSpec.
+func (in *IPAddressReportSpec) DeepCopy() *IPAddressReportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddressReportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedNamespace) DeepCopyInto(out *ManagedNamespace) {
 	*out = *in
@@ -1642,9 +2993,34 @@ func (in *MetricThreshold) DeepCopy() *MetricThreshold {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationSource) DeepCopyInto(out *MigrationSource) {
+	*out = *in
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(common.ResourceRefNamespaced)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationSource.
+func (in *MigrationSource) DeepCopy() *MigrationSource {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkPeer) DeepCopyInto(out *NetworkPeer) {
 	*out = *in
+	if in.AWSAutoAccept != nil {
+		in, out := &in.AWSAutoAccept, &out.AWSAutoAccept
+		*out = new(AWSPeeringAutoAccept)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPeer.
@@ -1681,6 +3057,8 @@ func (in *Notification) DeepCopyInto(out *Notification) {
 		**out = **in
 	}
 	out.VictorOpsSecretRef = in.VictorOpsSecretRef
+	out.WebhookSecretRef = in.WebhookSecretRef
+	out.MicrosoftTeamsWebhookURLRef = in.MicrosoftTeamsWebhookURLRef
 	if in.Roles != nil {
 		in, out := &in.Roles, &out.Roles
 		*out = make([]string, len(*in))
@@ -1698,6 +3076,41 @@ func (in *Notification) DeepCopy() *Notification {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordRotationSpec) DeepCopyInto(out *PasswordRotationSpec) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordRotationSpec.
+func (in *PasswordRotationSpec) DeepCopy() *PasswordRotationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordRotationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordStoreRef) DeepCopyInto(out *PasswordStoreRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordStoreRef.
+func (in *PasswordStoreRef) DeepCopy() *PasswordStoreRef {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordStoreRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrivateEndpoint) DeepCopyInto(out *PrivateEndpoint) {
 	*out = *in
@@ -1718,6 +3131,46 @@ func (in *PrivateEndpoint) DeepCopy() *PrivateEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateEndpointInterface) DeepCopyInto(out *PrivateEndpointInterface) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make(GCPEndpoints, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateEndpointInterface.
+func (in *PrivateEndpointInterface) DeepCopy() *PrivateEndpointInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateEndpointInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateEndpointRecreationSpec) DeepCopyInto(out *PrivateEndpointRecreationSpec) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateEndpointRecreationSpec.
+func (in *PrivateEndpointRecreationSpec) DeepCopy() *PrivateEndpointRecreationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateEndpointRecreationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrivateEndpointSpec) DeepCopyInto(out *PrivateEndpointSpec) {
 	*out = *in
@@ -1783,6 +3236,26 @@ func (in *ProcessArgs) DeepCopy() *ProcessArgs {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectInvitation) DeepCopyInto(out *ProjectInvitation) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]TeamRole, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectInvitation.
+func (in *ProjectInvitation) DeepCopy() *ProjectInvitation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectInvitation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectSettings) DeepCopyInto(out *ProjectSettings) {
 	*out = *in
@@ -1953,6 +3426,31 @@ func (in *RoleSpec) DeepCopy() *RoleSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLIdentityProviderSpec) DeepCopyInto(out *SAMLIdentityProviderSpec) {
+	*out = *in
+	if in.AssociatedDomains != nil {
+		in, out := &in.AssociatedDomains, &out.AssociatedDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CertificatesSecretRef != nil {
+		in, out := &in.CertificatesSecretRef, &out.CertificatesSecretRef
+		*out = new(common.ResourceRefNamespaced)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLIdentityProviderSpec.
+func (in *SAMLIdentityProviderSpec) DeepCopy() *SAMLIdentityProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLIdentityProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScopeSpec) DeepCopyInto(out *ScopeSpec) {
 	*out = *in
@@ -1968,6 +3466,87 @@ func (in *ScopeSpec) DeepCopy() *ScopeSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchIndexAnalyzer) DeepCopyInto(out *SearchIndexAnalyzer) {
+	*out = *in
+	if in.CharFilters != nil {
+		in, out := &in.CharFilters, &out.CharFilters
+		*out = make([]apiextensionsv1.JSON, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Tokenizer.DeepCopyInto(&out.Tokenizer)
+	if in.TokenFilters != nil {
+		in, out := &in.TokenFilters, &out.TokenFilters
+		*out = make([]apiextensionsv1.JSON, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchIndexAnalyzer.
+func (in *SearchIndexAnalyzer) DeepCopy() *SearchIndexAnalyzer {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchIndexAnalyzer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchIndexMappings) DeepCopyInto(out *SearchIndexMappings) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchIndexMappings.
+func (in *SearchIndexMappings) DeepCopy() *SearchIndexMappings {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchIndexMappings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchIndexSynonym) DeepCopyInto(out *SearchIndexSynonym) {
+	*out = *in
+	out.Source = in.Source
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchIndexSynonym.
+func (in *SearchIndexSynonym) DeepCopy() *SearchIndexSynonym {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchIndexSynonym)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchIndexSynonymSource) DeepCopyInto(out *SearchIndexSynonymSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchIndexSynonymSource.
+func (in *SearchIndexSynonymSource) DeepCopy() *SearchIndexSynonymSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchIndexSynonymSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerlessBackupOptions) DeepCopyInto(out *ServerlessBackupOptions) {
 	*out = *in
@@ -2153,6 +3732,11 @@ func (in *TeamSpec) DeepCopyInto(out *TeamSpec) {
 		*out = make([]TeamUser, len(*in))
 		copy(*out, *in)
 	}
+	if in.UsernamesConfigMapRef != nil {
+		in, out := &in.UsernamesConfigMapRef, &out.UsernamesConfigMapRef
+		*out = new(common.ResourceRefNamespaced)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamSpec.
@@ -2180,6 +3764,21 @@ func (in *Threshold) DeepCopy() *Threshold {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VectorSearchField) DeepCopyInto(out *VectorSearchField) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VectorSearchField.
+func (in *VectorSearchField) DeepCopy() *VectorSearchField {
+	if in == nil {
+		return nil
+	}
+	out := new(VectorSearchField)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *View) DeepCopyInto(out *View) {
 	*out = *in
@@ -2194,3 +3793,23 @@ func (in *View) DeepCopy() *View {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *X509CertificateSpec) DeepCopyInto(out *X509CertificateSpec) {
+	*out = *in
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new X509CertificateSpec.
+func (in *X509CertificateSpec) DeepCopy() *X509CertificateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(X509CertificateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,172 @@
+package apikeyrotation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/connectionsecret"
+)
+
+const (
+	// RotationAnnotation opts a credentials secret in the API key rotation when set to "true"
+	RotationAnnotation = "mongodb.com/atlas-api-key-rotation"
+	// RotatedAtAnnotation records when the API key stored in the secret was last rotated
+	RotatedAtAnnotation = "mongodb.com/atlas-api-key-rotated-at"
+	// PendingRevocationAnnotation records the ID of the previous API key until it is revoked
+	PendingRevocationAnnotation = "mongodb.com/atlas-api-key-pending-revocation"
+
+	APIKeyRotatedReason          = "APIKeyRotated"
+	APIKeyRotationFailedReason   = "APIKeyRotationFailed"
+	APIKeyRevocationFailedReason = "APIKeyRevocationFailed"
+)
+
+// APIKeyRotationReconciler periodically rotates the Atlas API keys stored in the credentials secrets opted in the rotation.
+// The keys are rotated with the parent secret credentials, which must hold an organization API key allowed to manage API keys.
+type APIKeyRotationReconciler struct {
+	Client           client.Client
+	Log              *zap.SugaredLogger
+	EventRecorder    record.EventRecorder
+	GlobalPredicates []predicate.Predicate
+	AtlasDomain      string
+	ParentSecret     client.ObjectKey
+	RotationInterval time.Duration
+	ValidateKey      KeyValidator
+}
+
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups="",namespace=default,resources=secrets,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *APIKeyRotationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.With("secret", req.NamespacedName)
+
+	if req.NamespacedName == r.ParentSecret {
+		return ctrl.Result{}, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Client.Get(ctx, req.NamespacedName, secret); err != nil {
+		if apiErrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	if !rotationEnabled(secret) {
+		return ctrl.Result{}, nil
+	}
+
+	orgID, publicKey, _, err := atlas.SecretCredentials(ctx, r.Client, req.NamespacedName)
+	if err != nil {
+		log.Errorf("unable to rotate the API key: %s", err)
+		return ctrl.Result{}, nil
+	}
+
+	_, parentPublicKey, parentPrivateKey, err := atlas.SecretCredentials(ctx, r.Client, r.ParentSecret)
+	if err != nil {
+		log.Errorf("unable to read the API key rotation parent secret: %s", err)
+		return ctrl.Result{RequeueAfter: r.RotationInterval}, nil
+	}
+
+	parentClient, err := atlas.NewClient(r.AtlasDomain, parentPublicKey, parentPrivateKey)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	keysAPI := parentClient.ProgrammaticAPIKeysApi
+
+	if keyID, ok := secret.Annotations[PendingRevocationAnnotation]; ok {
+		if err = r.revokePreviousKey(ctx, secret, orgID, keyID, keysAPI); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	now := time.Now().UTC()
+	if remaining := nextRotation(secret, r.RotationInterval).Sub(now); remaining > 0 {
+		return ctrl.Result{RequeueAfter: remaining}, nil
+	}
+
+	log.Infof("rotating the API key %s", publicKey)
+	newKey, previousKeyID, err := rotateKey(ctx, keysAPI, orgID, publicKey, r.ValidateKey, log)
+	if err != nil {
+		r.EventRecorder.Event(secret, corev1.EventTypeWarning, APIKeyRotationFailedReason, err.Error())
+		return ctrl.Result{}, fmt.Errorf("failed to rotate the API key: %w", err)
+	}
+
+	atlas.SetSecretAPIKey(secret, newKey.GetPublicKey(), newKey.GetPrivateKey())
+	secret.Annotations[RotatedAtAnnotation] = now.Format(time.RFC3339)
+	secret.Annotations[PendingRevocationAnnotation] = previousKeyID
+	if err = r.Client.Update(ctx, secret); err != nil {
+		if revokeErr := revokeKey(ctx, keysAPI, orgID, newKey.GetId()); revokeErr != nil {
+			log.Errorf("failed to delete the API key %s after an unsuccessful rotation: %s", newKey.GetPublicKey(), revokeErr)
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to store the new API key: %w", err)
+	}
+	r.EventRecorder.Eventf(secret, corev1.EventTypeNormal, APIKeyRotatedReason, "API key %s replaced by %s", publicKey, newKey.GetPublicKey())
+
+	if err = r.revokePreviousKey(ctx, secret, orgID, previousKeyID, keysAPI); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: r.RotationInterval}, nil
+}
+
+// revokePreviousKey revokes the API key replaced by the rotation and clears the pending revocation annotation.
+// Keeping the annotation until the revocation succeeds guarantees the previous key is revoked even after a restart.
+func (r *APIKeyRotationReconciler) revokePreviousKey(ctx context.Context, secret *corev1.Secret, orgID, keyID string, keysAPI admin.ProgrammaticAPIKeysApi) error {
+	if err := revokeKey(ctx, keysAPI, orgID, keyID); err != nil {
+		r.EventRecorder.Event(secret, corev1.EventTypeWarning, APIKeyRevocationFailedReason, err.Error())
+		return err
+	}
+
+	delete(secret.Annotations, PendingRevocationAnnotation)
+
+	return r.Client.Update(ctx, secret)
+}
+
+func (r *APIKeyRotationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.ValidateKey == nil {
+		r.ValidateKey = ValidateWithProjects(r.AtlasDomain)
+	}
+
+	predicates := append([]predicate.Predicate{predicate.NewPredicateFuncs(isRotatedSecret)}, r.GlobalPredicates...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("APIKeyRotation").
+		For(&corev1.Secret{}, builder.OnlyMetadata, builder.WithPredicates(predicates...)).
+		Complete(r)
+}
+
+// nextRotation returns when the API key stored in the secret is due for rotation.
+// A secret never rotated is due one interval after its creation.
+func nextRotation(secret *corev1.Secret, interval time.Duration) time.Time {
+	if rotatedAt, err := time.Parse(time.RFC3339, secret.Annotations[RotatedAtAnnotation]); err == nil {
+		return rotatedAt.Add(interval)
+	}
+
+	return secret.CreationTimestamp.Add(interval)
+}
+
+// isRotatedSecret filters the secrets to rotate, the secrets being watched through their metadata only
+func isRotatedSecret(object client.Object) bool {
+	return rotationEnabled(object)
+}
+
+func rotationEnabled(secret metav1.Object) bool {
+	return secret.GetLabels()[connectionsecret.TypeLabelKey] == connectionsecret.CredLabelVal &&
+		secret.GetAnnotations()[RotationAnnotation] == "true"
+}
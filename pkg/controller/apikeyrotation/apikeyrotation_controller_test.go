@@ -0,0 +1,154 @@
+package apikeyrotation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/connectionsecret"
+)
+
+func credentialsSecret(name string, annotations map[string]string, created time.Time) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			Labels:            map[string]string{connectionsecret.TypeLabelKey: connectionsecret.CredLabelVal},
+			Annotations:       annotations,
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Data: map[string][]byte{
+			"orgId":         []byte("org-id"),
+			"publicApiKey":  []byte(name + "-public-key"),
+			"privateApiKey": []byte(name + "-private-key"),
+		},
+	}
+}
+
+func testReconciler(t *testing.T, handler http.Handler, objects ...client.Object) (*APIKeyRotationReconciler, client.Client) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	sch := runtime.NewScheme()
+	sch.AddKnownTypes(corev1.SchemeGroupVersion, &corev1.Secret{})
+	k8sClient := fake.NewClientBuilder().WithScheme(sch).WithObjects(objects...).Build()
+
+	return &APIKeyRotationReconciler{
+		Client:           k8sClient,
+		Log:              zaptest.NewLogger(t).Sugar(),
+		EventRecorder:    record.NewFakeRecorder(10),
+		AtlasDomain:      server.URL,
+		ParentSecret:     client.ObjectKey{Namespace: "default", Name: "parent"},
+		RotationInterval: time.Hour * 24,
+		ValidateKey: func(context.Context, string, string) error {
+			return nil
+		},
+	}, k8sClient
+}
+
+func writeJSON(w http.ResponseWriter, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(body))
+}
+
+func TestReconcile(t *testing.T) {
+	enabled := map[string]string{RotationAnnotation: "true"}
+	request := ctrl.Request{NamespacedName: client.ObjectKey{Namespace: "default", Name: "project"}}
+
+	t.Run("should rotate the key when it is due and revoke the previous key", func(t *testing.T) {
+		revoked := false
+		mux := http.NewServeMux()
+		mux.HandleFunc("/api/atlas/v2/orgs/org-id/apiKeys", func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost {
+				writeJSON(w, `{"id":"new-key-id","publicKey":"new-public-key","privateKey":"new-private-key","roles":[{"orgId":"org-id","roleName":"ORG_MEMBER"}]}`)
+				return
+			}
+			writeJSON(w, `{"results":[{"id":"current-key-id","publicKey":"project-public-key","roles":[{"orgId":"org-id","roleName":"ORG_MEMBER"}]}],"totalCount":1}`)
+		})
+		mux.HandleFunc("/api/atlas/v2/orgs/org-id/apiKeys/current-key-id/accessList", func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, `{"results":[],"totalCount":0}`)
+		})
+		mux.HandleFunc("/api/atlas/v2/orgs/org-id/apiKeys/current-key-id", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+			revoked = true
+			writeJSON(w, `{}`)
+		})
+
+		reconciler, k8sClient := testReconciler(t, mux,
+			credentialsSecret("parent", nil, time.Now()),
+			credentialsSecret("project", enabled, time.Now().Add(-time.Hour*48)),
+		)
+
+		result, err := reconciler.Reconcile(context.Background(), request)
+		require.NoError(t, err)
+		assert.Equal(t, time.Hour*24, result.RequeueAfter)
+		assert.True(t, revoked)
+
+		secret := &corev1.Secret{}
+		require.NoError(t, k8sClient.Get(context.Background(), request.NamespacedName, secret))
+		assert.Equal(t, "new-public-key", string(secret.Data["publicApiKey"]))
+		assert.Equal(t, "new-private-key", string(secret.Data["privateApiKey"]))
+		assert.Equal(t, "org-id", string(secret.Data["orgId"]))
+		assert.NotEmpty(t, secret.Annotations[RotatedAtAnnotation])
+		assert.NotContains(t, secret.Annotations, PendingRevocationAnnotation)
+	})
+
+	t.Run("should not rotate the key before it is due", func(t *testing.T) {
+		rotatedAt := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+		reconciler, _ := testReconciler(t, http.NotFoundHandler(),
+			credentialsSecret("parent", nil, time.Now()),
+			credentialsSecret("project", map[string]string{RotationAnnotation: "true", RotatedAtAnnotation: rotatedAt}, time.Now().Add(-time.Hour*48)),
+		)
+
+		result, err := reconciler.Reconcile(context.Background(), request)
+		require.NoError(t, err)
+		assert.InDelta(t, (time.Hour * 23).Seconds(), result.RequeueAfter.Seconds(), 5)
+	})
+
+	t.Run("should ignore secrets not opted in the rotation", func(t *testing.T) {
+		reconciler, _ := testReconciler(t, http.NotFoundHandler(),
+			credentialsSecret("parent", nil, time.Now()),
+			credentialsSecret("project", nil, time.Now().Add(-time.Hour*48)),
+		)
+
+		result, err := reconciler.Reconcile(context.Background(), request)
+		require.NoError(t, err)
+		assert.Equal(t, ctrl.Result{}, result)
+	})
+}
+
+func TestNextRotation(t *testing.T) {
+	created := time.Date(2023, 11, 1, 12, 0, 0, 0, time.UTC)
+
+	secret := credentialsSecret("project", map[string]string{}, created)
+	assert.Equal(t, created.Add(time.Hour), nextRotation(secret, time.Hour))
+
+	secret.Annotations[RotatedAtAnnotation] = "2023-11-02T12:00:00Z"
+	assert.Equal(t, created.Add(time.Hour*25), nextRotation(secret, time.Hour))
+}
+
+func TestRotationEnabled(t *testing.T) {
+	secret := credentialsSecret("project", map[string]string{RotationAnnotation: "true"}, time.Now())
+	assert.True(t, rotationEnabled(secret))
+
+	secret.Annotations[RotationAnnotation] = "false"
+	assert.False(t, rotationEnabled(secret))
+
+	secret.Annotations[RotationAnnotation] = "true"
+	secret.Labels = nil
+	assert.False(t, rotationEnabled(secret))
+}
@@ -0,0 +1,172 @@
+package apikeyrotation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	"go.uber.org/zap"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+)
+
+const (
+	itemsPerPage          = 500
+	defaultOrgRole        = "ORG_MEMBER"
+	defaultKeyDescription = "Atlas Operator"
+)
+
+// KeyValidator checks the API key pair is accepted by Atlas
+type KeyValidator func(ctx context.Context, publicKey, privateKey string) error
+
+// rotateKey creates a new organization API key holding the same organization roles, project roles and access list
+// as the key identified by the public key. The new key is validated before being returned, the existing key is left untouched.
+// When any step fails the new key is deleted so that a failed rotation does not leak keys.
+func rotateKey(ctx context.Context, keysAPI admin.ProgrammaticAPIKeysApi, orgID, publicKey string, validate KeyValidator, log *zap.SugaredLogger) (*admin.ApiKeyUserDetails, string, error) {
+	currentKey, err := findKey(ctx, keysAPI, orgID, publicKey)
+	if err != nil {
+		return nil, "", err
+	}
+
+	orgRoles, projectRoles := keyRoles(currentKey, orgID)
+	newKey, _, err := keysAPI.CreateApiKey(ctx, orgID, &admin.CreateAtlasOrganizationApiKey{
+		Desc:  rotatedKeyDescription(currentKey.GetDesc()),
+		Roles: orgRoles,
+	}).Execute()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create the new API key: %w", err)
+	}
+
+	if err = copyKeyGrants(ctx, keysAPI, orgID, currentKey.GetId(), newKey.GetId(), projectRoles); err == nil {
+		err = validate(ctx, newKey.GetPublicKey(), newKey.GetPrivateKey())
+	}
+
+	if err != nil {
+		if _, _, deleteErr := keysAPI.DeleteApiKey(ctx, orgID, newKey.GetId()).Execute(); deleteErr != nil {
+			log.Errorf("failed to delete the API key %s after an unsuccessful rotation: %s", newKey.GetPublicKey(), deleteErr)
+		}
+
+		return nil, "", err
+	}
+
+	return newKey, currentKey.GetId(), nil
+}
+
+// revokeKey deletes the API key, a key which doesn't exist anymore is considered revoked
+func revokeKey(ctx context.Context, keysAPI admin.ProgrammaticAPIKeysApi, orgID, keyID string) error {
+	_, _, err := keysAPI.DeleteApiKey(ctx, orgID, keyID).Execute()
+	if err != nil && !admin.IsErrorCode(err, "API_KEY_NOT_FOUND") {
+		return fmt.Errorf("failed to revoke the API key %s: %w", keyID, err)
+	}
+
+	return nil
+}
+
+func findKey(ctx context.Context, keysAPI admin.ProgrammaticAPIKeysApi, orgID, publicKey string) (*admin.ApiKeyUserDetails, error) {
+	for pageNum := 1; ; pageNum++ {
+		keys, _, err := keysAPI.ListApiKeys(ctx, orgID).PageNum(pageNum).ItemsPerPage(itemsPerPage).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the API keys of the organization: %w", err)
+		}
+
+		for _, key := range keys.GetResults() {
+			if key.GetPublicKey() == publicKey {
+				return &key, nil
+			}
+		}
+
+		if len(keys.GetResults()) < itemsPerPage {
+			return nil, fmt.Errorf("the API key %s doesn't belong to the organization %s", publicKey, orgID)
+		}
+	}
+}
+
+// keyRoles splits the roles of the key into the organization roles and the roles of each project
+func keyRoles(key *admin.ApiKeyUserDetails, orgID string) ([]string, map[string][]string) {
+	var orgRoles []string
+	projectRoles := map[string][]string{}
+	for _, role := range key.GetRoles() {
+		switch {
+		case role.GetGroupId() != "":
+			projectRoles[role.GetGroupId()] = append(projectRoles[role.GetGroupId()], role.GetRoleName())
+		case role.GetOrgId() == orgID:
+			orgRoles = append(orgRoles, role.GetRoleName())
+		}
+	}
+
+	if len(orgRoles) == 0 {
+		orgRoles = []string{defaultOrgRole}
+	}
+
+	return orgRoles, projectRoles
+}
+
+func copyKeyGrants(ctx context.Context, keysAPI admin.ProgrammaticAPIKeysApi, orgID, currentKeyID, newKeyID string, projectRoles map[string][]string) error {
+	projectIDs := make([]string, 0, len(projectRoles))
+	for projectID := range projectRoles {
+		projectIDs = append(projectIDs, projectID)
+	}
+	sort.Strings(projectIDs)
+
+	for _, projectID := range projectIDs {
+		roles := projectRoles[projectID]
+		assignment := []admin.UserAccessRoleAssignment{{Roles: &roles}}
+		if _, _, err := keysAPI.AddProjectApiKey(ctx, projectID, newKeyID, &assignment).Execute(); err != nil {
+			return fmt.Errorf("failed to assign the new API key to the project %s: %w", projectID, err)
+		}
+	}
+
+	accessList, _, err := keysAPI.ListApiKeyAccessListsEntries(ctx, orgID, currentKeyID).ItemsPerPage(itemsPerPage).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list the access list of the API key: %w", err)
+	}
+
+	entries := make([]admin.UserAccessList, 0, len(accessList.GetResults()))
+	for _, entry := range accessList.GetResults() {
+		if entry.GetCidrBlock() != "" {
+			entries = append(entries, admin.UserAccessList{CidrBlock: entry.CidrBlock})
+		} else if entry.GetIpAddress() != "" {
+			entries = append(entries, admin.UserAccessList{IpAddress: entry.IpAddress})
+		}
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	if _, _, err = keysAPI.CreateApiKeyAccessList(ctx, orgID, newKeyID, &entries).Execute(); err != nil {
+		return fmt.Errorf("failed to copy the access list to the new API key: %w", err)
+	}
+
+	return nil
+}
+
+func rotatedKeyDescription(description string) string {
+	if description == "" {
+		return defaultKeyDescription
+	}
+
+	return description
+}
+
+// ValidateWithProjects builds a validator checking the key is able to read the projects it has access to
+func ValidateWithProjects(domain string) KeyValidator {
+	return func(ctx context.Context, publicKey, privateKey string) error {
+		if publicKey == "" || privateKey == "" {
+			return errors.New("the new API key was returned without its key pair")
+		}
+
+		sdkClient, err := atlas.NewClient(domain, publicKey, privateKey)
+		if err != nil {
+			return fmt.Errorf("failed to create a client with the new API key: %w", err)
+		}
+
+		if _, _, err = sdkClient.ProjectsApi.ListProjects(ctx).ItemsPerPage(1).Execute(); err != nil {
+			return fmt.Errorf("the new API key was rejected by Atlas: %w", err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,142 @@
+package apikeyrotation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	"go.uber.org/zap/zaptest"
+
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+)
+
+func currentAPIKey() admin.ApiKeyUserDetails {
+	return admin.ApiKeyUserDetails{
+		Id:        admin.PtrString("current-key-id"),
+		PublicKey: admin.PtrString("current-public-key"),
+		Desc:      admin.PtrString("operator key"),
+		Roles: &[]admin.CloudAccessRoleAssignment{
+			{OrgId: admin.PtrString("org-id"), RoleName: admin.PtrString("ORG_MEMBER")},
+			{GroupId: admin.PtrString("project-b"), RoleName: admin.PtrString("GROUP_OWNER")},
+			{GroupId: admin.PtrString("project-a"), RoleName: admin.PtrString("GROUP_READ_ONLY")},
+			{GroupId: admin.PtrString("project-a"), RoleName: admin.PtrString("GROUP_CLUSTER_MANAGER")},
+		},
+	}
+}
+
+func newAPIKey() *admin.ApiKeyUserDetails {
+	return &admin.ApiKeyUserDetails{
+		Id:         admin.PtrString("new-key-id"),
+		PublicKey:  admin.PtrString("new-public-key"),
+		PrivateKey: admin.PtrString("new-private-key"),
+	}
+}
+
+func expectKeyCreation(keysAPI *atlasmock.ProgrammaticAPIKeysApiMock) {
+	keysAPI.EXPECT().ListApiKeys(mock.Anything, "org-id").Return(admin.ListApiKeysApiRequest{ApiService: keysAPI})
+	keysAPI.EXPECT().ListApiKeysExecute(mock.Anything).
+		Return(&admin.PaginatedApiApiUser{Results: &[]admin.ApiKeyUserDetails{{PublicKey: admin.PtrString("other-key")}, currentAPIKey()}}, nil, nil)
+	keysAPI.EXPECT().CreateApiKey(mock.Anything, "org-id", &admin.CreateAtlasOrganizationApiKey{Desc: "operator key", Roles: []string{"ORG_MEMBER"}}).
+		Return(admin.CreateApiKeyApiRequest{ApiService: keysAPI})
+	keysAPI.EXPECT().CreateApiKeyExecute(mock.Anything).Return(newAPIKey(), nil, nil)
+}
+
+func TestRotateKey(t *testing.T) {
+	t.Run("should create a new key with the grants of the current key", func(t *testing.T) {
+		keysAPI := atlasmock.NewProgrammaticAPIKeysApiMock(t)
+		expectKeyCreation(keysAPI)
+		keysAPI.EXPECT().AddProjectApiKey(mock.Anything, "project-a", "new-key-id", &[]admin.UserAccessRoleAssignment{{Roles: &[]string{"GROUP_READ_ONLY", "GROUP_CLUSTER_MANAGER"}}}).
+			Return(admin.AddProjectApiKeyApiRequest{ApiService: keysAPI})
+		keysAPI.EXPECT().AddProjectApiKey(mock.Anything, "project-b", "new-key-id", &[]admin.UserAccessRoleAssignment{{Roles: &[]string{"GROUP_OWNER"}}}).
+			Return(admin.AddProjectApiKeyApiRequest{ApiService: keysAPI})
+		keysAPI.EXPECT().AddProjectApiKeyExecute(mock.Anything).Return(newAPIKey(), nil, nil).Twice()
+		keysAPI.EXPECT().ListApiKeyAccessListsEntries(mock.Anything, "org-id", "current-key-id").
+			Return(admin.ListApiKeyAccessListsEntriesApiRequest{ApiService: keysAPI})
+		keysAPI.EXPECT().ListApiKeyAccessListsEntriesExecute(mock.Anything).
+			Return(&admin.PaginatedApiUserAccessList{Results: &[]admin.UserAccessList{
+				{CidrBlock: admin.PtrString("10.0.0.0/24"), IpAddress: admin.PtrString("10.0.0.0")},
+				{IpAddress: admin.PtrString("192.168.0.1")},
+			}}, nil, nil)
+		keysAPI.EXPECT().CreateApiKeyAccessList(mock.Anything, "org-id", "new-key-id", &[]admin.UserAccessList{
+			{CidrBlock: admin.PtrString("10.0.0.0/24")},
+			{IpAddress: admin.PtrString("192.168.0.1")},
+		}).Return(admin.CreateApiKeyAccessListApiRequest{ApiService: keysAPI})
+		keysAPI.EXPECT().CreateApiKeyAccessListExecute(mock.Anything).Return(&admin.PaginatedApiUserAccessList{}, nil, nil)
+
+		var validated []string
+		validate := func(_ context.Context, publicKey, privateKey string) error {
+			validated = append(validated, publicKey, privateKey)
+			return nil
+		}
+
+		key, previousKeyID, err := rotateKey(context.Background(), keysAPI, "org-id", "current-public-key", validate, zaptest.NewLogger(t).Sugar())
+		require.NoError(t, err)
+		assert.Equal(t, newAPIKey(), key)
+		assert.Equal(t, "current-key-id", previousKeyID)
+		assert.Equal(t, []string{"new-public-key", "new-private-key"}, validated)
+	})
+
+	t.Run("should delete the new key when it is rejected by Atlas", func(t *testing.T) {
+		keysAPI := atlasmock.NewProgrammaticAPIKeysApiMock(t)
+		expectKeyCreation(keysAPI)
+		keysAPI.EXPECT().AddProjectApiKey(mock.Anything, mock.Anything, "new-key-id", mock.Anything).
+			Return(admin.AddProjectApiKeyApiRequest{ApiService: keysAPI})
+		keysAPI.EXPECT().AddProjectApiKeyExecute(mock.Anything).Return(newAPIKey(), nil, nil)
+		keysAPI.EXPECT().ListApiKeyAccessListsEntries(mock.Anything, "org-id", "current-key-id").
+			Return(admin.ListApiKeyAccessListsEntriesApiRequest{ApiService: keysAPI})
+		keysAPI.EXPECT().ListApiKeyAccessListsEntriesExecute(mock.Anything).Return(&admin.PaginatedApiUserAccessList{}, nil, nil)
+		keysAPI.EXPECT().DeleteApiKey(mock.Anything, "org-id", "new-key-id").Return(admin.DeleteApiKeyApiRequest{ApiService: keysAPI})
+		keysAPI.EXPECT().DeleteApiKeyExecute(mock.Anything).Return(nil, nil, nil)
+
+		validate := func(context.Context, string, string) error {
+			return errors.New("unauthorized")
+		}
+
+		_, _, err := rotateKey(context.Background(), keysAPI, "org-id", "current-public-key", validate, zaptest.NewLogger(t).Sugar())
+		assert.EqualError(t, err, "unauthorized")
+	})
+
+	t.Run("should fail when the key doesn't belong to the organization", func(t *testing.T) {
+		keysAPI := atlasmock.NewProgrammaticAPIKeysApiMock(t)
+		keysAPI.EXPECT().ListApiKeys(mock.Anything, "org-id").Return(admin.ListApiKeysApiRequest{ApiService: keysAPI})
+		keysAPI.EXPECT().ListApiKeysExecute(mock.Anything).Return(&admin.PaginatedApiApiUser{}, nil, nil)
+
+		_, _, err := rotateKey(context.Background(), keysAPI, "org-id", "current-public-key", nil, zaptest.NewLogger(t).Sugar())
+		assert.EqualError(t, err, "the API key current-public-key doesn't belong to the organization org-id")
+	})
+}
+
+func TestKeyRoles(t *testing.T) {
+	key := currentAPIKey()
+	orgRoles, projectRoles := keyRoles(&key, "org-id")
+	assert.Equal(t, []string{"ORG_MEMBER"}, orgRoles)
+	assert.Equal(t, map[string][]string{"project-a": {"GROUP_READ_ONLY", "GROUP_CLUSTER_MANAGER"}, "project-b": {"GROUP_OWNER"}}, projectRoles)
+
+	orgRoles, projectRoles = keyRoles(&admin.ApiKeyUserDetails{}, "org-id")
+	assert.Equal(t, []string{"ORG_MEMBER"}, orgRoles)
+	assert.Empty(t, projectRoles)
+}
+
+func TestRevokeKey(t *testing.T) {
+	t.Run("should consider a missing key as revoked", func(t *testing.T) {
+		keysAPI := atlasmock.NewProgrammaticAPIKeysApiMock(t)
+		keysAPI.EXPECT().DeleteApiKey(mock.Anything, "org-id", "key-id").Return(admin.DeleteApiKeyApiRequest{ApiService: keysAPI})
+		apiError := admin.GenericOpenAPIError{}
+		apiError.SetModel(admin.ApiError{ErrorCode: admin.PtrString("API_KEY_NOT_FOUND")})
+		keysAPI.EXPECT().DeleteApiKeyExecute(mock.Anything).Return(nil, nil, &apiError)
+
+		assert.NoError(t, revokeKey(context.Background(), keysAPI, "org-id", "key-id"))
+	})
+
+	t.Run("should fail when the key can't be deleted", func(t *testing.T) {
+		keysAPI := atlasmock.NewProgrammaticAPIKeysApiMock(t)
+		keysAPI.EXPECT().DeleteApiKey(mock.Anything, "org-id", "key-id").Return(admin.DeleteApiKeyApiRequest{ApiService: keysAPI})
+		keysAPI.EXPECT().DeleteApiKeyExecute(mock.Anything).Return(nil, nil, errors.New("server error"))
+
+		assert.EqualError(t, revokeKey(context.Background(), keysAPI, "org-id", "key-id"), "failed to revoke the API key key-id: server error")
+	})
+}
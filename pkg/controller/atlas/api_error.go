@@ -17,6 +17,9 @@ const (
 	// Error indicates that the cluster doesn't exist
 	ClusterNotFound = "CLUSTER_NOT_FOUND"
 
+	// ClusterMaintenanceInProgress indicates that Atlas rejected the change because a maintenance is running on the cluster
+	ClusterMaintenanceInProgress = "CLUSTER_MAINTENANCE_IN_PROGRESS"
+
 	// ServerlessClusterNotFound indicates that the serverless cluster doesn't exist
 	ServerlessInstanceNotFound = "SERVERLESS_INSTANCE_NOT_FOUND"
 
@@ -0,0 +1,177 @@
+package atlas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/httputil"
+)
+
+const (
+	apiV2Path = "/api/atlas/v2"
+
+	// PreviewAPIVersion is the version of the Atlas Admin API endpoints which aren't released yet
+	PreviewAPIVersion = "preview"
+
+	// SDKAPIVersion is the version of the Atlas Admin API the models of the Atlas SDK are generated from
+	SDKAPIVersion = "2023-11-15"
+)
+
+var (
+	apiVersionPattern   = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	versionedMediaTypes = regexp.MustCompile(`^application/vnd\.atlas\.(?:\d{4}-\d{2}-\d{2}|preview)\+(\w+)$`)
+)
+
+// APIVersions pins the version of the Atlas Admin API v2 resources per domain. The domain of a request is the first
+// segment of its path following the project or the organization ID, e.g. "clusters" or "databaseUsers", or the first
+// segment of the path otherwise. The endpoints of a domain which isn't pinned use the version of the Atlas SDK, so
+// that new versions and preview endpoints can be adopted one domain at a time.
+// The responses are still decoded with the models of the SDKAPIVersion version: only the versions whose payloads are
+// compatible with them for the fields the Operator uses are safe to pin
+type APIVersions map[string]string
+
+// ParseAPIVersions parses a comma-separated list of domain=version pins, the version is either a date in the
+// YYYY-MM-DD format or "preview"
+func ParseAPIVersions(value string) (APIVersions, error) {
+	versions := APIVersions{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		domain, version, ok := strings.Cut(entry, "=")
+		domain, version = strings.TrimSpace(domain), strings.TrimSpace(version)
+		if !ok || domain == "" {
+			return nil, fmt.Errorf("invalid API version pin %q, expected domain=version", entry)
+		}
+		if version != PreviewAPIVersion && !apiVersionPattern.MatchString(version) {
+			return nil, fmt.Errorf("invalid API version %q of the domain %s, expected a YYYY-MM-DD date or %q", version, domain, PreviewAPIVersion)
+		}
+		versions[domain] = version
+	}
+
+	return versions, nil
+}
+
+// Version returns the version pinned for the domain of the request path
+func (v APIVersions) Version(path string) (string, bool) {
+	domain := apiDomain(path)
+	if domain == "" {
+		return "", false
+	}
+
+	version, ok := v[domain]
+
+	return version, ok
+}
+
+// apiDomain returns the domain of an Atlas Admin API v2 request path, empty for the paths of other APIs
+func apiDomain(path string) string {
+	path, ok := strings.CutPrefix(path, apiV2Path+"/")
+	if !ok {
+		return ""
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if (segments[0] == "groups" || segments[0] == "orgs") && len(segments) > 2 {
+		return segments[2]
+	}
+
+	return segments[0]
+}
+
+// versionedMediaType returns the media types of the header with their version replaced, the other ones are kept
+func versionedMediaType(header, version string) string {
+	mediaTypes := strings.Split(header, ",")
+	for i, mediaType := range mediaTypes {
+		if match := versionedMediaTypes.FindStringSubmatch(strings.TrimSpace(mediaType)); match != nil {
+			mediaTypes[i] = fmt.Sprintf("application/vnd.atlas.%s+%s", version, match[1])
+		}
+	}
+
+	return strings.Join(mediaTypes, ",")
+}
+
+// apiVersionsTransport is the option sending the requests to the pinned domains with their version
+func apiVersionsTransport(versions APIVersions) httputil.ClientOpt {
+	return func(c *http.Client) error {
+		if len(versions) > 0 {
+			c.Transport = &apiVersionsRoundTripper{rt: c.Transport, versions: versions}
+		}
+
+		return nil
+	}
+}
+
+type apiVersionsRoundTripper struct {
+	rt       http.RoundTripper
+	versions APIVersions
+}
+
+func (t *apiVersionsRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	version, ok := t.versions.Version(request.URL.Path)
+	if !ok {
+		return t.rt.RoundTrip(request)
+	}
+
+	request = request.Clone(request.Context())
+	for _, header := range []string{"Accept", "Content-Type"} {
+		if value := request.Header.Get(header); value != "" {
+			request.Header.Set(header, versionedMediaType(value, version))
+		}
+	}
+
+	return t.rt.RoundTrip(request)
+}
+
+// checkAPIVersions requests the Atlas Admin API with each pinned version to fail early when Atlas doesn't support one
+// of them. Only an explicit rejection of a version fails the check, the versions which can't be checked (e.g. during
+// an outage of Atlas) and the ones Atlas announces a sunset date for are logged
+func checkAPIVersions(ctx context.Context, httpClient *http.Client, domain string, versions APIVersions, log *zap.SugaredLogger) error {
+	domainsByVersion := map[string][]string{}
+	for domain, version := range versions {
+		domainsByVersion[version] = append(domainsByVersion[version], domain)
+	}
+
+	var err error
+	for version, domains := range domainsByVersion {
+		sort.Strings(domains)
+		if version != SDKAPIVersion {
+			log.Warnw("The responses of the pinned Atlas Admin API version are decoded with the models of the Atlas SDK version",
+				"version", version, "domains", domains, "sdkVersion", SDKAPIVersion)
+		}
+
+		request, reqErr := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(domain, "/")+apiV2Path, nil)
+		if reqErr == nil {
+			request.Header.Set("Accept", fmt.Sprintf("application/vnd.atlas.%s+json", version))
+		}
+
+		var response *http.Response
+		if reqErr == nil {
+			response, reqErr = httpClient.Do(request)
+		}
+		if reqErr != nil {
+			log.Warnw("Unable to check the pinned Atlas Admin API version", "version", version, "domains", domains, "error", reqErr)
+			continue
+		}
+		_ = response.Body.Close()
+
+		if response.StatusCode == http.StatusNotAcceptable {
+			err = errors.Join(err, fmt.Errorf("the Atlas Admin API doesn't support the version %s pinned for %s", version, strings.Join(domains, ", ")))
+			continue
+		}
+		if sunset := response.Header.Get("Sunset"); sunset != "" {
+			log.Warnw("The pinned Atlas Admin API version is deprecated", "version", version, "domains", domains, "sunset", sunset)
+		}
+	}
+
+	return err
+}
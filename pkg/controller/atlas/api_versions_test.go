@@ -0,0 +1,127 @@
+package atlas
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/httputil"
+)
+
+func TestParseAPIVersions(t *testing.T) {
+	t.Run("should parse the pins", func(t *testing.T) {
+		versions, err := ParseAPIVersions(" clusters=2023-02-01, ,flexClusters=preview")
+
+		require.NoError(t, err)
+		assert.Equal(t, APIVersions{"clusters": "2023-02-01", "flexClusters": "preview"}, versions)
+	})
+
+	t.Run("should accept no pins", func(t *testing.T) {
+		versions, err := ParseAPIVersions("")
+
+		require.NoError(t, err)
+		assert.Empty(t, versions)
+	})
+
+	t.Run("should refuse invalid pins", func(t *testing.T) {
+		for _, value := range []string{"clusters", "=2023-02-01", "clusters=2023-02", "clusters=latest"} {
+			_, err := ParseAPIVersions(value)
+			assert.Error(t, err, value)
+		}
+	})
+}
+
+func TestAPIVersionsVersion(t *testing.T) {
+	versions := APIVersions{"clusters": "2023-02-01", "orgs": "2023-01-01", "invoices": "preview"}
+	tests := map[string]string{
+		"/api/atlas/v2/groups/6567/clusters/cluster0": "2023-02-01",
+		"/api/atlas/v2/groups/6567/clusters":          "2023-02-01",
+		"/api/atlas/v2/orgs":                          "2023-01-01",
+		"/api/atlas/v2/orgs/6567":                     "2023-01-01",
+		"/api/atlas/v2/orgs/6567/invoices":            "preview",
+		"/api/atlas/v2/groups/6567/databaseUsers":     "",
+		"/api/atlas/v1.0/groups/6567/clusters":        "",
+	}
+	for path, expected := range tests {
+		t.Run(path, func(t *testing.T) {
+			version, ok := versions.Version(path)
+
+			assert.Equal(t, expected != "", ok)
+			assert.Equal(t, expected, version)
+		})
+	}
+}
+
+func TestAPIVersionsTransport(t *testing.T) {
+	var accept, contentType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accept, contentType = r.Header.Get("Accept"), r.Header.Get("Content-Type")
+	}))
+	defer server.Close()
+	httpClient, err := httputil.DecorateClient(&http.Client{Transport: http.DefaultTransport}, apiVersionsTransport(APIVersions{"clusters": "2024-08-05"}))
+	require.NoError(t, err)
+	send := func(t *testing.T, path string) *http.Request {
+		request, err := http.NewRequest(http.MethodPatch, server.URL+path, nil)
+		require.NoError(t, err)
+		request.Header.Set("Accept", "application/vnd.atlas.2023-02-01+json,application/vnd.atlas.2023-01-01+gzip,application/json")
+		request.Header.Set("Content-Type", "application/vnd.atlas.2023-02-01+json")
+		response, err := httpClient.Do(request)
+		require.NoError(t, err)
+		require.NoError(t, response.Body.Close())
+
+		return request
+	}
+
+	t.Run("should send the pinned version", func(t *testing.T) {
+		request := send(t, "/api/atlas/v2/groups/6567/clusters/cluster0")
+
+		assert.Equal(t, "application/vnd.atlas.2024-08-05+json,application/vnd.atlas.2024-08-05+gzip,application/json", accept)
+		assert.Equal(t, "application/vnd.atlas.2024-08-05+json", contentType)
+		assert.Equal(t, "application/vnd.atlas.2023-02-01+json", request.Header.Get("Content-Type"), "the request must not be modified")
+	})
+
+	t.Run("should keep the version of the other domains", func(t *testing.T) {
+		send(t, "/api/atlas/v2/groups/6567/databaseUsers")
+
+		assert.Equal(t, "application/vnd.atlas.2023-02-01+json", contentType)
+	})
+}
+
+func TestCheckAPIVersions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("Accept") {
+		case "application/vnd.atlas.2099-01-01+json":
+			w.WriteHeader(http.StatusNotAcceptable)
+		case "application/vnd.atlas.2023-01-01+json":
+			w.Header().Set("Sunset", "Mon, 01 Jan 2029 00:00:00 GMT")
+		}
+	}))
+	defer server.Close()
+	log := zaptest.NewLogger(t).Sugar()
+
+	t.Run("should accept the supported versions", func(t *testing.T) {
+		err := checkAPIVersions(context.Background(), server.Client(), server.URL+"/", APIVersions{"clusters": "2023-02-01", "orgs": "2023-01-01"}, log)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should report the unsupported versions", func(t *testing.T) {
+		err := checkAPIVersions(context.Background(), server.Client(), server.URL, APIVersions{"clusters": "2099-01-01", "backup": "2099-01-01", "orgs": "2023-01-01"}, log)
+
+		assert.EqualError(t, err, "the Atlas Admin API doesn't support the version 2099-01-01 pinned for backup, clusters")
+	})
+
+	t.Run("should not fail when Atlas can't be reached", func(t *testing.T) {
+		unreachable := httptest.NewServer(http.NotFoundHandler())
+		unreachable.Close()
+
+		err := checkAPIVersions(context.Background(), unreachable.Client(), unreachable.URL, APIVersions{"clusters": "2099-01-01"}, log)
+
+		assert.NoError(t, err)
+	})
+}
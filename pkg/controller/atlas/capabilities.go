@@ -0,0 +1,128 @@
+package atlas
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+)
+
+// Capabilities are the instance sizes and regions of the cloud providers available to a project under the plan of
+// its organization
+type Capabilities struct {
+	// providers maps the cloud providers to their instance sizes and the regions where they are available
+	providers map[string]map[string]map[string]bool
+}
+
+// NewCapabilities builds the capabilities from the cloud provider regions returned by Atlas
+func NewCapabilities(cloudProviders *mongodbatlas.CloudProviders) *Capabilities {
+	capabilities := &Capabilities{providers: map[string]map[string]map[string]bool{}}
+	if cloudProviders == nil {
+		return capabilities
+	}
+
+	for _, cloudProvider := range cloudProviders.Results {
+		instanceSizes := map[string]map[string]bool{}
+		for _, instanceSize := range cloudProvider.InstanceSizes {
+			regions := map[string]bool{}
+			for _, region := range instanceSize.AvailableRegions {
+				regions[region.Name] = true
+			}
+			instanceSizes[instanceSize.Name] = regions
+		}
+		capabilities.providers[cloudProvider.Provider] = instanceSizes
+	}
+
+	return capabilities
+}
+
+// Unsupported returns why the instance size in the region of the cloud provider is unavailable, it is empty when the
+// instance size is available. The cloud providers unknown to the capabilities are considered available
+func (c *Capabilities) Unsupported(providerName, instanceSize, regionName string) string {
+	instanceSizes, ok := c.providers[providerName]
+	if !ok || instanceSize == "" {
+		return ""
+	}
+
+	regions, ok := instanceSizes[instanceSize]
+	if !ok {
+		return fmt.Sprintf("instance size %s is not available on %s for the project", instanceSize, providerName)
+	}
+
+	if regionName != "" && !regions[regionName] {
+		return fmt.Sprintf("instance size %s is not available in the %s region %s for the project", instanceSize, providerName, regionName)
+	}
+
+	return ""
+}
+
+// UnsupportedRegion returns why the region of the cloud provider is unavailable to the project, it is empty when at
+// least one instance size is available in the region. The cloud providers unknown to the capabilities are considered
+// available
+func (c *Capabilities) UnsupportedRegion(providerName, regionName string) string {
+	instanceSizes, ok := c.providers[providerName]
+	if !ok || regionName == "" {
+		return ""
+	}
+
+	for _, regions := range instanceSizes {
+		if regions[regionName] {
+			return ""
+		}
+	}
+
+	return fmt.Sprintf("region %s is not available on %s for the project", regionName, providerName)
+}
+
+type capabilitiesEntry struct {
+	capabilities *Capabilities
+	expiresAt    time.Time
+}
+
+// CapabilitiesCache caches the capabilities of the projects so that they are read from Atlas once per TTL instead of
+// on every reconciliation
+type CapabilitiesCache struct {
+	ttl     time.Duration
+	lock    sync.Mutex
+	entries map[string]capabilitiesEntry
+}
+
+func NewCapabilitiesCache(ttl time.Duration) *CapabilitiesCache {
+	return &CapabilitiesCache{
+		ttl:     ttl,
+		entries: map[string]capabilitiesEntry{},
+	}
+}
+
+// Get returns the capabilities of the project, reading them from Atlas when they aren't cached or expired
+func (c *CapabilitiesCache) Get(ctx context.Context, clusters mongodbatlas.ClustersService, projectID string) (*Capabilities, error) {
+	c.lock.Lock()
+	entry, ok := c.entries[projectID]
+	c.lock.Unlock()
+
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry.capabilities, nil
+	}
+
+	cloudProviders, _, err := clusters.ListCloudProviderRegions(ctx, projectID, &mongodbatlas.CloudProviderRegionsOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve the cloud provider regions of the project: %w", err)
+	}
+
+	capabilities := NewCapabilities(cloudProviders)
+
+	c.lock.Lock()
+	c.entries[projectID] = capabilitiesEntry{capabilities: capabilities, expiresAt: time.Now().Add(c.ttl)}
+	c.lock.Unlock()
+
+	return capabilities, nil
+}
+
+// Invalidate drops the cached capabilities of the project so that the next Get reads them from Atlas
+func (c *CapabilitiesCache) Invalidate(projectID string) {
+	c.lock.Lock()
+	delete(c.entries, projectID)
+	c.lock.Unlock()
+}
@@ -0,0 +1,149 @@
+package atlas
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+)
+
+func cloudProvidersClient(cloudProviders *mongodbatlas.CloudProviders, err error, calls *int) *atlasmock.ClustersClientMock {
+	return &atlasmock.ClustersClientMock{
+		ListCloudProviderRegionsFunc: func(projectID string, options *mongodbatlas.CloudProviderRegionsOptions) (*mongodbatlas.CloudProviders, *mongodbatlas.Response, error) {
+			*calls++
+
+			return cloudProviders, nil, err
+		},
+	}
+}
+
+func sampleCloudProviders() *mongodbatlas.CloudProviders {
+	return &mongodbatlas.CloudProviders{
+		Results: []*mongodbatlas.CloudProvider{
+			{
+				Provider: "AWS",
+				InstanceSizes: []*mongodbatlas.InstanceSize{
+					{Name: "M10", AvailableRegions: []*mongodbatlas.AvailableRegion{{Name: "US_EAST_1"}, {Name: "EU_WEST_1"}}},
+					{Name: "M30", AvailableRegions: []*mongodbatlas.AvailableRegion{{Name: "US_EAST_1"}}},
+				},
+			},
+		},
+	}
+}
+
+func TestCapabilitiesUnsupported(t *testing.T) {
+	capabilities := NewCapabilities(sampleCloudProviders())
+
+	tests := map[string]struct {
+		provider     string
+		instanceSize string
+		region       string
+		expected     string
+	}{
+		"should support an available instance size": {
+			provider:     "AWS",
+			instanceSize: "M30",
+			region:       "US_EAST_1",
+		},
+		"should reject an unavailable instance size": {
+			provider:     "AWS",
+			instanceSize: "M700",
+			region:       "US_EAST_1",
+			expected:     "instance size M700 is not available on AWS for the project",
+		},
+		"should reject an instance size unavailable in the region": {
+			provider:     "AWS",
+			instanceSize: "M30",
+			region:       "EU_WEST_1",
+			expected:     "instance size M30 is not available in the AWS region EU_WEST_1 for the project",
+		},
+		"should support the providers missing from the capabilities": {
+			provider:     "TENANT",
+			instanceSize: "M0",
+			region:       "US_EAST_1",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, capabilities.Unsupported(tt.provider, tt.instanceSize, tt.region))
+		})
+	}
+}
+
+func TestCapabilitiesUnsupportedRegion(t *testing.T) {
+	capabilities := NewCapabilities(sampleCloudProviders())
+
+	t.Run("should support a region with an available instance size", func(t *testing.T) {
+		assert.Empty(t, capabilities.UnsupportedRegion("AWS", "EU_WEST_1"))
+	})
+
+	t.Run("should reject a region without any available instance size", func(t *testing.T) {
+		assert.Equal(t, "region AP_SOUTH_1 is not available on AWS for the project", capabilities.UnsupportedRegion("AWS", "AP_SOUTH_1"))
+	})
+
+	t.Run("should support the providers missing from the capabilities", func(t *testing.T) {
+		assert.Empty(t, capabilities.UnsupportedRegion("TENANT", "US_EAST_1"))
+	})
+}
+
+func TestCapabilitiesCache(t *testing.T) {
+	t.Run("should read the capabilities once per TTL", func(t *testing.T) {
+		calls := 0
+		clusters := cloudProvidersClient(sampleCloudProviders(), nil, &calls)
+		cache := NewCapabilitiesCache(time.Hour)
+
+		for i := 0; i < 3; i++ {
+			capabilities, err := cache.Get(context.Background(), clusters, "project-id")
+			require.NoError(t, err)
+			assert.Empty(t, capabilities.Unsupported("AWS", "M10", "EU_WEST_1"))
+		}
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("should read again the expired capabilities", func(t *testing.T) {
+		calls := 0
+		clusters := cloudProvidersClient(sampleCloudProviders(), nil, &calls)
+		cache := NewCapabilitiesCache(0)
+
+		_, err := cache.Get(context.Background(), clusters, "project-id")
+		require.NoError(t, err)
+		_, err = cache.Get(context.Background(), clusters, "project-id")
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("should read again the invalidated capabilities", func(t *testing.T) {
+		calls := 0
+		clusters := cloudProvidersClient(sampleCloudProviders(), nil, &calls)
+		cache := NewCapabilitiesCache(time.Hour)
+
+		_, err := cache.Get(context.Background(), clusters, "project-id")
+		require.NoError(t, err)
+		cache.Invalidate("project-id")
+		_, err = cache.Get(context.Background(), clusters, "project-id")
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("should not cache the failed lookups", func(t *testing.T) {
+		calls := 0
+		clusters := cloudProvidersClient(nil, errors.New("unavailable"), &calls)
+		cache := NewCapabilitiesCache(time.Hour)
+
+		_, err := cache.Get(context.Background(), clusters, "project-id")
+		assert.ErrorContains(t, err, "unavailable")
+		_, err = cache.Get(context.Background(), clusters, "project-id")
+		assert.Error(t, err)
+
+		assert.Equal(t, 2, calls)
+	})
+}
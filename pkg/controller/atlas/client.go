@@ -1,11 +1,31 @@
 package atlas
 
-import "go.mongodb.org/atlas-sdk/v20231115004/admin"
+import (
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/httputil"
+)
 
 func NewClient(domain, publicKey, privateKey string) (*admin.APIClient, error) {
+	return newSdkClient(domain, httputil.Digest(publicKey, privateKey), nil)
+}
+
+func newSdkClient(domain string, authentication httputil.ClientOpt, versions APIVersions) (*admin.APIClient, error) {
+	httpClient, err := httputil.DecorateClient(
+		&http.Client{Transport: http.DefaultTransport},
+		authentication,
+		httputil.MetricsTransport(),
+		apiVersionsTransport(versions),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return admin.NewClient(
 		admin.UseBaseURL(domain),
-		admin.UseDigestAuth(publicKey, privateKey),
+		admin.UseHTTPClient(httpClient),
 		admin.UseUserAgent(operatorUserAgent()),
 	)
 }
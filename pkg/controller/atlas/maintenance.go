@@ -0,0 +1,26 @@
+package atlas
+
+import (
+	"errors"
+
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	"go.mongodb.org/atlas/mongodbatlas"
+)
+
+// IsMaintenanceInProgress returns true if Atlas rejected the request because of an ongoing maintenance
+func IsMaintenanceInProgress(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var apiError *mongodbatlas.ErrorResponse
+	if errors.As(err, &apiError) {
+		return apiError.ErrorCode == ClusterMaintenanceInProgress
+	}
+
+	if sdkError, ok := admin.AsError(err); ok {
+		return sdkError.GetErrorCode() == ClusterMaintenanceInProgress
+	}
+
+	return false
+}
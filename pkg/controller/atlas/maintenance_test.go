@@ -0,0 +1,25 @@
+package atlas
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/atlas/mongodbatlas"
+)
+
+func TestIsMaintenanceInProgress(t *testing.T) {
+	t.Run("should recognize the errors returned during a maintenance", func(t *testing.T) {
+		err := fmt.Errorf("failed to update: %w", &mongodbatlas.ErrorResponse{HTTPCode: 409, ErrorCode: ClusterMaintenanceInProgress})
+
+		assert.True(t, IsMaintenanceInProgress(err))
+	})
+
+	t.Run("should ignore other errors", func(t *testing.T) {
+		assert.False(t, IsMaintenanceInProgress(nil))
+		assert.False(t, IsMaintenanceInProgress(errors.New("failed")))
+		assert.False(t, IsMaintenanceInProgress(&mongodbatlas.ErrorResponse{HTTPCode: 404, ErrorCode: ClusterNotFound}))
+		assert.False(t, IsMaintenanceInProgress(&mongodbatlas.ErrorResponse{HTTPCode: 409, ErrorCode: "CANNOT_SCALE_DURING_MAINTENANCE_WINDOW"}))
+	})
+}
@@ -0,0 +1,95 @@
+package atlas
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+)
+
+// Roles of the Atlas API keys checked by the operator
+const (
+	OrgOwnerRole         = "ORG_OWNER"
+	OrgGroupCreatorRole  = "ORG_GROUP_CREATOR"
+	ProjectOwnerRole     = "GROUP_OWNER"
+	ProjectUserAdminRole = "GROUP_USER_ADMIN"
+)
+
+// RequiredRole describes the roles needed by an operation, any of the roles grants the permission
+type RequiredRole struct {
+	// Operation is the human-readable name of the operation requiring the roles
+	Operation string
+	// ProjectID scopes the roles to a project. The roles are organization roles when empty
+	ProjectID string
+	Roles     []string
+}
+
+func (r RequiredRole) String() string {
+	scope := "the organization"
+	if r.ProjectID != "" {
+		scope = fmt.Sprintf("project %s", r.ProjectID)
+	}
+
+	return fmt.Sprintf("%s on %s for %s", strings.Join(r.Roles, " or "), scope, r.Operation)
+}
+
+// MissingRoles returns the required roles the API key of the client doesn't have in the organization.
+// The roles of the API key are read from the Atlas system status
+func MissingRoles(ctx context.Context, rootAPI admin.RootApi, orgID string, required []RequiredRole) ([]RequiredRole, error) {
+	if len(required) == 0 {
+		return nil, nil
+	}
+
+	systemStatus, _, err := rootAPI.GetSystemStatus(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	return missingRoles(systemStatus.ApiKey.GetRoles(), orgID, required), nil
+}
+
+func missingRoles(assignments []admin.CloudAccessRoleAssignment, orgID string, required []RequiredRole) []RequiredRole {
+	orgRoles := map[string]bool{}
+	projectRoles := map[string]map[string]bool{}
+	for _, assignment := range assignments {
+		switch {
+		case assignment.GetOrgId() != "":
+			if assignment.GetOrgId() == orgID {
+				orgRoles[assignment.GetRoleName()] = true
+			}
+		case assignment.GetGroupId() != "":
+			if projectRoles[assignment.GetGroupId()] == nil {
+				projectRoles[assignment.GetGroupId()] = map[string]bool{}
+			}
+			projectRoles[assignment.GetGroupId()][assignment.GetRoleName()] = true
+		}
+	}
+
+	// the organization owner is granted every permission in the organization and its projects
+	if orgRoles[OrgOwnerRole] {
+		return nil
+	}
+
+	var missing []RequiredRole
+	for _, requirement := range required {
+		held := orgRoles
+		if requirement.ProjectID != "" {
+			held = projectRoles[requirement.ProjectID]
+		}
+
+		granted := false
+		for _, role := range requirement.Roles {
+			if held[role] {
+				granted = true
+				break
+			}
+		}
+
+		if !granted {
+			missing = append(missing, requirement)
+		}
+	}
+
+	return missing
+}
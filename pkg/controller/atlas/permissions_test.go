@@ -0,0 +1,65 @@
+package atlas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+)
+
+func TestMissingRoles(t *testing.T) {
+	peering := RequiredRole{Operation: "network peering", ProjectID: "project-id", Roles: []string{ProjectOwnerRole}}
+	creation := RequiredRole{Operation: "project creation", Roles: []string{OrgOwnerRole, OrgGroupCreatorRole}}
+	orgRole := func(orgID, role string) admin.CloudAccessRoleAssignment {
+		return admin.CloudAccessRoleAssignment{OrgId: pointer.MakePtr(orgID), RoleName: pointer.MakePtr(role)}
+	}
+	projectRole := func(projectID, role string) admin.CloudAccessRoleAssignment {
+		return admin.CloudAccessRoleAssignment{GroupId: pointer.MakePtr(projectID), RoleName: pointer.MakePtr(role)}
+	}
+
+	tests := map[string]struct {
+		assignments []admin.CloudAccessRoleAssignment
+		required    []RequiredRole
+		expected    []RequiredRole
+	}{
+		"should grant everything to the organization owner": {
+			assignments: []admin.CloudAccessRoleAssignment{orgRole("org-id", OrgOwnerRole)},
+			required:    []RequiredRole{peering, creation},
+		},
+		"should grant the operation with any of its roles": {
+			assignments: []admin.CloudAccessRoleAssignment{orgRole("org-id", OrgGroupCreatorRole), projectRole("project-id", ProjectOwnerRole)},
+			required:    []RequiredRole{peering, creation},
+		},
+		"should report the roles held in other projects as missing": {
+			assignments: []admin.CloudAccessRoleAssignment{orgRole("org-id", "ORG_MEMBER"), projectRole("other-id", ProjectOwnerRole)},
+			required:    []RequiredRole{peering, creation},
+			expected:    []RequiredRole{peering, creation},
+		},
+		"should ignore the roles held in other organizations": {
+			assignments: []admin.CloudAccessRoleAssignment{orgRole("other-id", OrgOwnerRole)},
+			required:    []RequiredRole{creation},
+			expected:    []RequiredRole{creation},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, missingRoles(tt.assignments, "org-id", tt.required))
+		})
+	}
+}
+
+func TestRequiredRoleString(t *testing.T) {
+	assert.Equal(
+		t,
+		"ORG_OWNER or ORG_GROUP_CREATOR on the organization for project creation",
+		RequiredRole{Operation: "project creation", Roles: []string{OrgOwnerRole, OrgGroupCreatorRole}}.String(),
+	)
+	assert.Equal(
+		t,
+		"GROUP_OWNER on project project-id for network peering",
+		RequiredRole{Operation: "network peering", ProjectID: "project-id", Roles: []string{ProjectOwnerRole}}.String(),
+	)
+}
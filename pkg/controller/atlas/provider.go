@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"runtime"
 	"strings"
+	"sync"
 
 	"go.mongodb.org/atlas-sdk/v20231115004/admin"
 	"go.mongodb.org/atlas/mongodbatlas"
@@ -37,12 +38,27 @@ type ProductionProvider struct {
 	k8sClient       client.Client
 	domain          string
 	globalSecretRef client.ObjectKey
+	apiVersions     APIVersions
+
+	allowExternalTokenURL bool
+	tokenSources          map[client.ObjectKey]serviceAccountTokenSource
+	tokenSourcesLock      sync.Mutex
 }
 
+// credentialsSecret holds either an API key pair or the credentials of a service account
 type credentialsSecret struct {
-	OrgID      string
-	PublicKey  string
-	PrivateKey string
+	SecretRef    client.ObjectKey
+	OrgID        string
+	PublicKey    string
+	PrivateKey   string
+	ClientID     string
+	ClientSecret string
+	TokenURL     string
+}
+
+// isServiceAccount returns true when the secret holds the credentials of a service account instead of an API key
+func (s *credentialsSecret) isServiceAccount() bool {
+	return s.ClientID != "" || s.ClientSecret != ""
 }
 
 func NewProductionProvider(atlasDomain string, globalSecretRef client.ObjectKey, k8sClient client.Client) *ProductionProvider {
@@ -50,9 +66,23 @@ func NewProductionProvider(atlasDomain string, globalSecretRef client.ObjectKey,
 		k8sClient:       k8sClient,
 		domain:          atlasDomain,
 		globalSecretRef: globalSecretRef,
+		tokenSources:    map[client.ObjectKey]serviceAccountTokenSource{},
 	}
 }
 
+// WithAPIVersions pins the versions of the Atlas Admin API v2 resources used by the clients of the provider
+func (p *ProductionProvider) WithAPIVersions(versions APIVersions) *ProductionProvider {
+	p.apiVersions = versions
+	return p
+}
+
+// WithExternalTokenURL allows the service accounts to request their access tokens from a token URL outside of the
+// Atlas domain
+func (p *ProductionProvider) WithExternalTokenURL(allowed bool) *ProductionProvider {
+	p.allowExternalTokenURL = allowed
+	return p
+}
+
 func (p *ProductionProvider) IsCloudGov() bool {
 	domainURL, err := url.Parse(p.domain)
 	if err != nil {
@@ -92,9 +122,16 @@ func (p *ProductionProvider) Client(ctx context.Context, secretRef *client.Objec
 		return nil, "", err
 	}
 
+	authentication, err := p.authentication(secretData)
+	if err != nil {
+		return nil, "", err
+	}
+
 	clientCfg := []httputil.ClientOpt{
-		httputil.Digest(secretData.PublicKey, secretData.PrivateKey),
+		authentication,
 		httputil.LoggingTransport(log),
+		httputil.MetricsTransport(),
+		apiVersionsTransport(p.apiVersions),
 	}
 	httpClient, err := httputil.DecorateClient(&http.Client{Transport: http.DefaultTransport}, clientCfg...)
 	if err != nil {
@@ -119,7 +156,12 @@ func (p *ProductionProvider) SdkClient(ctx context.Context, secretRef *client.Ob
 	//	return nil, "", err
 	//}
 
-	c, err := NewClient(p.domain, secretData.PublicKey, secretData.PrivateKey)
+	authentication, err := p.authentication(secretData)
+	if err != nil {
+		return nil, "", err
+	}
+
+	c, err := newSdkClient(p.domain, authentication, p.apiVersions)
 	if err != nil {
 		return nil, "", err
 	}
@@ -127,6 +169,88 @@ func (p *ProductionProvider) SdkClient(ctx context.Context, secretRef *client.Ob
 	return c, secretData.OrgID, nil
 }
 
+// CheckAPIVersions checks that Atlas supports the pinned versions of the Atlas Admin API, with the credentials of the
+// global secret. Only a version Atlas rejects fails the check, it is skipped when the Operator has no global secret or
+// Atlas can't be reached
+func (p *ProductionProvider) CheckAPIVersions(ctx context.Context, log *zap.SugaredLogger) error {
+	if len(p.apiVersions) == 0 {
+		return nil
+	}
+
+	secretData, err := getSecrets(ctx, p.k8sClient, nil, &p.globalSecretRef)
+	if err != nil {
+		log.Infow("Skipping the check of the pinned Atlas Admin API versions", "reason", err.Error())
+		return nil
+	}
+
+	authentication, err := p.authentication(secretData)
+	if err != nil {
+		log.Warnw("Unable to check the pinned Atlas Admin API versions", "error", err)
+		return nil
+	}
+
+	httpClient, err := httputil.DecorateClient(&http.Client{Transport: http.DefaultTransport}, authentication)
+	if err != nil {
+		log.Warnw("Unable to check the pinned Atlas Admin API versions", "error", err)
+		return nil
+	}
+
+	return checkAPIVersions(ctx, httpClient, p.domain, p.apiVersions, log)
+}
+
+// SetSecretCredentials writes the organization ID and the API key pair into the Secret, with the layout of the Atlas
+// credentials secrets
+func SetSecretCredentials(secret *corev1.Secret, orgID, publicKey, privateKey string) {
+	SetSecretAPIKey(secret, publicKey, privateKey)
+	secret.Data[orgIDKey] = []byte(orgID)
+}
+
+// SecretOrgID returns the organization ID stored in the Atlas credentials secret
+func SecretOrgID(secret *corev1.Secret) string {
+	return string(secret.Data[orgIDKey])
+}
+
+// SecretPublicKey returns the public part of the API key stored in the Atlas credentials secret
+func SecretPublicKey(secret *corev1.Secret) string {
+	return string(secret.Data[publicAPIKey])
+}
+
+// SecretCredentials returns the organization ID and the API key pair stored in the Atlas credentials secret.
+// It fails when the secret holds the credentials of a service account
+func SecretCredentials(ctx context.Context, k8sClient client.Client, secretRef client.ObjectKey) (string, string, string, error) {
+	secretData, err := getSecrets(ctx, k8sClient, &secretRef, nil)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	if secretData.isServiceAccount() {
+		return "", "", "", fmt.Errorf("the secret %s holds the credentials of a service account, an API key is expected", secretRef.String())
+	}
+
+	return secretData.OrgID, secretData.PublicKey, secretData.PrivateKey, nil
+}
+
+// SecretAuthentication returns the option authenticating the requests with the credentials stored in the Atlas
+// credentials secret, either an API key or a service account
+func SecretAuthentication(ctx context.Context, k8sClient client.Client, domain string, secretRef client.ObjectKey, allowExternalTokenURL bool) (httputil.ClientOpt, error) {
+	secretData, err := getSecrets(ctx, k8sClient, &secretRef, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewProductionProvider(domain, secretRef, k8sClient).WithExternalTokenURL(allowExternalTokenURL).authentication(secretData)
+}
+
+// SetSecretAPIKey replaces the API key pair stored in the Atlas credentials secret
+func SetSecretAPIKey(secret *corev1.Secret, publicKey, privateKey string) {
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+
+	secret.Data[publicAPIKey] = []byte(publicKey)
+	secret.Data[privateAPIKey] = []byte(privateKey)
+}
+
 func getSecrets(ctx context.Context, k8sClient client.Client, secretRef, fallbackRef *client.ObjectKey) (*credentialsSecret, error) {
 	if secretRef == nil {
 		secretRef = fallbackRef
@@ -138,9 +262,13 @@ func getSecrets(ctx context.Context, k8sClient client.Client, secretRef, fallbac
 	}
 
 	secretData := credentialsSecret{
-		OrgID:      string(secret.Data[orgIDKey]),
-		PublicKey:  string(secret.Data[publicAPIKey]),
-		PrivateKey: string(secret.Data[privateAPIKey]),
+		SecretRef:    *secretRef,
+		OrgID:        string(secret.Data[orgIDKey]),
+		PublicKey:    string(secret.Data[publicAPIKey]),
+		PrivateKey:   string(secret.Data[privateAPIKey]),
+		ClientID:     string(secret.Data[clientIDKey]),
+		ClientSecret: string(secret.Data[clientSecretKey]),
+		TokenURL:     string(secret.Data[tokenURLKey]),
 	}
 
 	if missingFields, valid := validateSecretData(&secretData); !valid {
@@ -157,6 +285,18 @@ func validateSecretData(secretData *credentialsSecret) ([]string, bool) {
 		missingFields = append(missingFields, orgIDKey)
 	}
 
+	if secretData.isServiceAccount() {
+		if secretData.ClientID == "" {
+			missingFields = append(missingFields, clientIDKey)
+		}
+
+		if secretData.ClientSecret == "" {
+			missingFields = append(missingFields, clientSecretKey)
+		}
+
+		return missingFields, len(missingFields) == 0
+	}
+
 	if secretData.PublicKey == "" {
 		missingFields = append(missingFields, publicAPIKey)
 	}
@@ -176,6 +176,18 @@ func TestValidateSecretData(t *testing.T) {
 		assert.True(t, ok)
 		assert.Empty(t, missing)
 	})
+
+	t.Run("should be invalid and client secret is missing", func(t *testing.T) {
+		missing, ok := validateSecretData(&credentialsSecret{OrgID: "my-org", ClientID: "mdb_sa_id"})
+		assert.False(t, ok)
+		assert.Equal(t, missing, []string{"clientSecret"})
+	})
+
+	t.Run("should be valid with a service account", func(t *testing.T) {
+		missing, ok := validateSecretData(&credentialsSecret{OrgID: "my-org", ClientID: "mdb_sa_id", ClientSecret: "mdb_sa_sk"})
+		assert.True(t, ok)
+		assert.Empty(t, missing)
+	})
 }
 
 func TestOperatorUserAgent(t *testing.T) {
@@ -184,3 +196,33 @@ func TestOperatorUserAgent(t *testing.T) {
 	require.Contains(t, userAgent, "MongoDBAtlasKubernetesOperator")
 	require.Contains(t, userAgent, version.Version)
 }
+
+func TestSecretCredentials(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "api-secret",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"orgId":         []byte("1234567890"),
+			"publicApiKey":  []byte("a1b2c3"),
+			"privateApiKey": []byte("abcdef123456"),
+		},
+	}
+
+	sch := runtime.NewScheme()
+	sch.AddKnownTypes(corev1.SchemeGroupVersion, &corev1.Secret{})
+	k8sClient := fake.NewClientBuilder().WithScheme(sch).WithObjects(secret).Build()
+
+	orgID, publicKey, privateKey, err := SecretCredentials(context.Background(), k8sClient, client.ObjectKeyFromObject(secret))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1234567890", "a1b2c3", "abcdef123456"}, []string{orgID, publicKey, privateKey})
+
+	SetSecretAPIKey(secret, "d4e5f6", "654321fedcba")
+	assert.Equal(t, "1234567890", string(secret.Data["orgId"]))
+	assert.Equal(t, "d4e5f6", string(secret.Data["publicApiKey"]))
+	assert.Equal(t, "654321fedcba", string(secret.Data["privateApiKey"]))
+
+	_, _, _, err = SecretCredentials(context.Background(), k8sClient, client.ObjectKey{Name: "missing", Namespace: "default"})
+	assert.Error(t, err)
+}
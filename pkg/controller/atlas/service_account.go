@@ -0,0 +1,101 @@
+package atlas
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/httputil"
+)
+
+const (
+	clientIDKey     = "clientId"
+	clientSecretKey = "clientSecret"
+	tokenURLKey     = "tokenUrl"
+
+	serviceAccountTokenPath = "/api/oauth/token"
+)
+
+// serviceAccountKey holds the credentials the token source of a service account was created with
+type serviceAccountKey struct {
+	clientID     string
+	clientSecret string
+	tokenURL     string
+}
+
+// serviceAccountTokenSource is the token source of the service account of a secret, the tokens are reused until they
+// expire
+type serviceAccountTokenSource struct {
+	key    serviceAccountKey
+	source oauth2.TokenSource
+}
+
+// authentication returns the option authenticating the requests with the credentials of the secret: the API key with
+// digest authentication, or the access tokens of the service account issued with the OAuth 2.0 client credentials flow.
+// The token source is kept per secret, and replaced once the credentials of the secret change
+func (p *ProductionProvider) authentication(secretData *credentialsSecret) (httputil.ClientOpt, error) {
+	if !secretData.isServiceAccount() {
+		return httputil.Digest(secretData.PublicKey, secretData.PrivateKey), nil
+	}
+
+	tokenURL, err := serviceAccountTokenURL(p.domain, secretData.TokenURL, p.allowExternalTokenURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid service account of the secret %s: %w", secretData.SecretRef, err)
+	}
+
+	key := serviceAccountKey{clientID: secretData.ClientID, clientSecret: secretData.ClientSecret, tokenURL: tokenURL}
+
+	p.tokenSourcesLock.Lock()
+	defer p.tokenSourcesLock.Unlock()
+
+	tokenSource, ok := p.tokenSources[secretData.SecretRef]
+	if !ok || tokenSource.key != key {
+		tokenSource = serviceAccountTokenSource{key: key, source: newServiceAccountTokenSource(key)}
+		if p.tokenSources == nil {
+			p.tokenSources = map[client.ObjectKey]serviceAccountTokenSource{}
+		}
+		p.tokenSources[secretData.SecretRef] = tokenSource
+	}
+
+	return httputil.OAuth2(tokenSource.source), nil
+}
+
+// newServiceAccountTokenSource returns a token source requesting the access tokens of the service account from the
+// token endpoint. A token is reused until it expires
+func newServiceAccountTokenSource(key serviceAccountKey) oauth2.TokenSource {
+	config := clientcredentials.Config{
+		ClientID:     key.clientID,
+		ClientSecret: key.clientSecret,
+		TokenURL:     key.tokenURL,
+		AuthStyle:    oauth2.AuthStyleInHeader,
+	}
+
+	return config.TokenSource(context.Background())
+}
+
+// serviceAccountTokenURL returns the token endpoint set in the secret, or the one of the Atlas domain when unset.
+// The credentials are sent to the token endpoint, it must be on the Atlas domain unless external ones are allowed
+func serviceAccountTokenURL(domain, tokenURL string, allowExternal bool) (string, error) {
+	domainURL, err := url.Parse(domain)
+	if err != nil {
+		return "", fmt.Errorf("invalid Atlas domain %q: %w", domain, err)
+	}
+
+	if tokenURL == "" {
+		return domainURL.JoinPath(serviceAccountTokenPath).String(), nil
+	}
+
+	endpoint, err := url.Parse(tokenURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid token URL %q: %w", tokenURL, err)
+	}
+	if !allowExternal && (endpoint.Scheme != domainURL.Scheme || endpoint.Host != domainURL.Host) {
+		return "", fmt.Errorf("the token URL %q isn't on the Atlas domain %q, the external token URLs must be allowed with the --allow-external-token-url flag", tokenURL, domain)
+	}
+
+	return tokenURL, nil
+}
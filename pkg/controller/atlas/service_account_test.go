@@ -0,0 +1,119 @@
+package atlas
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestProvider_ServiceAccount(t *testing.T) {
+	tokenRequests := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+		clientID, clientSecret, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "mdb_sa_id", clientID)
+		assert.Equal(t, "mdb_sa_sk", clientSecret)
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"access-token","token_type":"Bearer","expires_in":3600}`))
+	})
+	mux.HandleFunc("/api/atlas/v2/groups", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer access-token", r.Header.Get("Authorization"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"results":[],"totalCount":0}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "service-account",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"orgId":        []byte("1234567890"),
+			"clientId":     []byte("mdb_sa_id"),
+			"clientSecret": []byte("mdb_sa_sk"),
+		},
+	}
+	sch := runtime.NewScheme()
+	sch.AddKnownTypes(corev1.SchemeGroupVersion, &corev1.Secret{})
+	k8sClient := fake.NewClientBuilder().WithScheme(sch).WithObjects(secret).Build()
+	secretRef := client.ObjectKeyFromObject(secret)
+
+	t.Run("should authenticate the requests with the access token of the service account", func(t *testing.T) {
+		p := NewProductionProvider(server.URL, secretRef, k8sClient)
+
+		for i := 0; i < 2; i++ {
+			c, orgID, err := p.SdkClient(context.Background(), nil, zaptest.NewLogger(t).Sugar())
+			require.NoError(t, err)
+			assert.Equal(t, "1234567890", orgID)
+
+			_, _, err = c.ProjectsApi.ListProjects(context.Background()).Execute()
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, 1, tokenRequests)
+	})
+
+	t.Run("should not return the credentials of a service account as an API key", func(t *testing.T) {
+		_, _, _, err := SecretCredentials(context.Background(), k8sClient, secretRef)
+		assert.ErrorContains(t, err, "holds the credentials of a service account")
+	})
+}
+
+func TestAuthentication_ReplacesTokenSource(t *testing.T) {
+	secretRef := client.ObjectKey{Namespace: "default", Name: "service-account"}
+	p := NewProductionProvider("https://cloud.mongodb.com/", secretRef, nil)
+	credentials := &credentialsSecret{SecretRef: secretRef, OrgID: "1234567890", ClientID: "mdb_sa_id", ClientSecret: "mdb_sa_sk"}
+
+	_, err := p.authentication(credentials)
+	require.NoError(t, err)
+	first := p.tokenSources[secretRef].source
+
+	_, err = p.authentication(credentials)
+	require.NoError(t, err)
+	assert.Same(t, first, p.tokenSources[secretRef].source)
+
+	credentials.ClientSecret = "mdb_sa_sk_rotated"
+	_, err = p.authentication(credentials)
+	require.NoError(t, err)
+	assert.Len(t, p.tokenSources, 1)
+	assert.NotSame(t, first, p.tokenSources[secretRef].source)
+	assert.Equal(t, "mdb_sa_sk_rotated", p.tokenSources[secretRef].key.clientSecret)
+}
+
+func TestServiceAccountTokenURL(t *testing.T) {
+	tokenURL, err := serviceAccountTokenURL("https://cloud.mongodb.com/", "", false)
+	require.NoError(t, err)
+	assert.Equal(t, "https://cloud.mongodb.com/api/oauth/token", tokenURL)
+
+	tokenURL, err = serviceAccountTokenURL("https://cloud.mongodb.com/", "https://cloud.mongodb.com/api/oauth/token", false)
+	require.NoError(t, err)
+	assert.Equal(t, "https://cloud.mongodb.com/api/oauth/token", tokenURL)
+
+	_, err = serviceAccountTokenURL("https://cloud.mongodb.com/", "https://idp.example.com/token", false)
+	assert.ErrorContains(t, err, "isn't on the Atlas domain")
+
+	_, err = serviceAccountTokenURL("https://cloud.mongodb.com/", "http://cloud.mongodb.com/api/oauth/token", false)
+	assert.ErrorContains(t, err, "isn't on the Atlas domain")
+
+	tokenURL, err = serviceAccountTokenURL("https://cloud.mongodb.com/", "https://idp.example.com/token", true)
+	require.NoError(t, err)
+	assert.Equal(t, "https://idp.example.com/token", tokenURL)
+}
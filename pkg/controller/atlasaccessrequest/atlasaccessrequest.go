@@ -0,0 +1,106 @@
+package atlasaccessrequest
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/timeutil"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// ensureAccess moves the request through its lifecycle: it is Pending until the approval is recorded in its status,
+// Granted from the approval for the requested duration, then Expired. An expired request is never granted again
+func (r *AtlasAccessRequestReconciler) ensureAccess(ctx *workflow.Context, request *mdbv1.AtlasAccessRequest, now time.Time) workflow.Result {
+	switch request.Status.Phase {
+	case status.AccessRequestPhaseExpired:
+		return workflow.OK()
+	case status.AccessRequestPhaseGranted:
+		return r.checkExpiry(ctx, request, now)
+	}
+
+	if request.Spec.Duration.Duration <= 0 {
+		result := workflow.Terminate(workflow.AccessRequestInvalidSpec, "the duration of the access must be positive").WithoutRetry()
+		ctx.SetConditionFromResult(status.AccessRequestGrantedType, result)
+		return result
+	}
+
+	if forbidden := r.forbiddenRoles(request.Spec.Roles); len(forbidden) > 0 {
+		result := workflow.Terminate(workflow.AccessRequestInvalidSpec, fmt.Sprintf("the roles %s can't be granted by access requests", strings.Join(forbidden, ", "))).WithoutRetry()
+		ctx.SetConditionFromResult(status.AccessRequestGrantedType, result)
+		return result
+	}
+
+	if request.Status.Approval == nil || request.Status.Approval.ApprovedBy == "" {
+		ctx.EnsureStatusOption(status.AtlasAccessRequestPhaseOption(status.AccessRequestPhasePending))
+		result := workflow.InProgress(workflow.AccessRequestPendingApproval, "waiting for the approval of the request").WithoutRetry()
+		ctx.SetConditionFromResult(status.AccessRequestGrantedType, result)
+		return result
+	}
+
+	dbUser := &mdbv1.AtlasDatabaseUser{}
+	if err := r.Client.Get(ctx.Context, request.DatabaseUserObjectKey(), dbUser); err != nil {
+		result := workflow.Terminate(workflow.Internal, err.Error())
+		if apiErrors.IsNotFound(err) {
+			result = workflow.Terminate(workflow.AccessRequestUserNotFound, fmt.Sprintf("the database user %s doesn't exist", request.DatabaseUserObjectKey()))
+		}
+		ctx.SetConditionFromResult(status.AccessRequestGrantedType, result)
+		return result
+	}
+
+	roles := make([]status.GrantedRole, 0, len(request.Spec.Roles))
+	for _, role := range request.Spec.Roles {
+		roles = append(roles, status.GrantedRole{RoleName: role.RoleName, DatabaseName: role.DatabaseName, CollectionName: role.CollectionName})
+	}
+	expiresAt := now.Add(request.Spec.Duration.Duration)
+	ctx.EnsureStatusOption(status.AtlasAccessRequestGrantOption(roles, timeutil.FormatISO8601(now), timeutil.FormatISO8601(expiresAt)))
+	ctx.EnsureStatusOption(status.AtlasAccessRequestPhaseOption(status.AccessRequestPhaseGranted))
+	ctx.SetConditionTrue(status.AccessRequestGrantedType)
+
+	ctx.Log.Infow("Access granted", "user", request.DatabaseUserObjectKey(), "approvedBy", request.Status.Approval.ApprovedBy, "expiresAt", expiresAt)
+	r.EventRecorder.Eventf(request, "Normal", "AccessGranted", "Roles granted to %s until %s, approved by %s",
+		request.DatabaseUserObjectKey(), timeutil.FormatISO8601(expiresAt), request.Status.Approval.ApprovedBy)
+
+	return workflow.OK().WithRetry(request.Spec.Duration.Duration)
+}
+
+// forbiddenRoles returns the names of the roles which aren't grantable
+func (r *AtlasAccessRequestReconciler) forbiddenRoles(roles []mdbv1.RoleSpec) []string {
+	var forbidden []string
+	for _, role := range roles {
+		if !slices.Contains(r.GrantableRoles, role.RoleName) && !slices.Contains(forbidden, role.RoleName) {
+			forbidden = append(forbidden, role.RoleName)
+		}
+	}
+
+	return forbidden
+}
+
+// checkExpiry expires the granted request once its expiry time, bounded by the requested duration, is reached,
+// otherwise requeues it for that time
+func (r *AtlasAccessRequestReconciler) checkExpiry(ctx *workflow.Context, request *mdbv1.AtlasAccessRequest, now time.Time) workflow.Result {
+	expiresAt, err := request.GrantExpiresAt()
+	if err != nil {
+		return workflow.Terminate(workflow.Internal, err.Error())
+	}
+
+	if now.Before(expiresAt) {
+		ctx.SetConditionTrue(status.AccessRequestGrantedType)
+		return workflow.OK().WithRetry(expiresAt.Sub(now))
+	}
+
+	ctx.EnsureStatusOption(status.AtlasAccessRequestPhaseOption(status.AccessRequestPhaseExpired))
+	ctx.SetConditionFromResult(
+		status.AccessRequestGrantedType,
+		workflow.InProgress(workflow.AccessRequestExpired, fmt.Sprintf("the access expired at %s", timeutil.FormatISO8601(expiresAt))),
+	)
+	ctx.Log.Infow("Access expired", "user", request.DatabaseUserObjectKey())
+	r.EventRecorder.Eventf(request, "Normal", string(workflow.AccessRequestExpired), "Roles granted to %s revoked", request.DatabaseUserObjectKey())
+
+	return workflow.OK()
+}
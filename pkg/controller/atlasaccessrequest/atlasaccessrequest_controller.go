@@ -0,0 +1,126 @@
+package atlasaccessrequest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/notifier"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/statushandler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// AtlasAccessRequestReconciler reconciles an AtlasAccessRequest object.
+// It only tracks the lifecycle of the request, the granted roles are applied to the database user in Atlas by the
+// AtlasDatabaseUser controller for as long as the request is in the Granted phase
+type AtlasAccessRequestReconciler struct {
+	Client           client.Client
+	Log              *zap.SugaredLogger
+	Scheme           *runtime.Scheme
+	GlobalPredicates []predicate.Predicate
+	EventRecorder    record.EventRecorder
+	FailureNotifier  *notifier.Notifier
+	// GrantableRoles are the names of the roles the requests may grant, the requests for other roles are rejected
+	GrantableRoles []string
+}
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasaccessrequests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasaccessrequests/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasaccessrequests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasaccessrequests/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *AtlasAccessRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, _ error) {
+	log := r.Log.With("atlasaccessrequest", req.NamespacedName)
+
+	request := &mdbv1.AtlasAccessRequest{}
+	result := customresource.PrepareResource(ctx, r.Client, req, request, log)
+	if !result.IsOk() {
+		return result.ReconcileResult(), nil
+	}
+
+	if customresource.ReconciliationShouldBeSkipped(request) {
+		log.Infow(fmt.Sprintf("-> Skipping AtlasAccessRequest reconciliation as annotation %s=%s", customresource.ReconciliationPolicyAnnotation, customresource.ReconciliationPolicySkip), "spec", request.Spec)
+		return workflow.OK().ReconcileResult(), nil
+	}
+
+	if customresource.NamespaceReconciliationPaused(ctx, r.Client, request.Namespace, log) {
+		log.Infow(fmt.Sprintf("-> Pausing AtlasAccessRequest reconciliation as annotation %s=true is set on the namespace", customresource.ReconciliationPausedAnnotation))
+		return customresource.MarkReconciliationPaused(ctx, r.Client, r.EventRecorder, request, log).ReconcileResult(), nil
+	}
+
+	if !request.GetDeletionTimestamp().IsZero() {
+		log.Info("AtlasAccessRequest is being deleted, the granted roles are revoked by the AtlasDatabaseUser controller")
+		return workflow.OK().ReconcileResult(), nil
+	}
+
+	workflowCtx := customresource.MarkReconciliationStarted(r.Client, request, log, ctx)
+	log.Infow("-> Starting AtlasAccessRequest reconciliation", "spec", request.Spec, "status", request.Status)
+
+	if workflowCtx.Degraded(request) {
+		log.Infow("-> Skipping the reconciliation of the degraded AtlasAccessRequest, change its spec or set the reapply annotation to retry")
+		return ctrl.Result{}, nil
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			res = workflowCtx.RecoverPanic("AtlasAccessRequest", p).ReconcileResult()
+		}
+		res = workflowCtx.CapRetries("AtlasAccessRequest", request, res)
+		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, r.FailureNotifier, request)
+	}()
+
+	resourceVersionIsValid := customresource.ValidateResourceVersion(workflowCtx, request, r.Log)
+	if !resourceVersionIsValid.IsOk() {
+		r.Log.Debugf("AtlasAccessRequest validation result: %v", resourceVersionIsValid)
+		return resourceVersionIsValid.ReconcileResult(), nil
+	}
+
+	result = r.ensureAccess(workflowCtx, request, time.Now().UTC())
+	workflowCtx.SetConditionFromResult(status.ReadyType, result)
+
+	return result.ReconcileResult(), nil
+}
+
+func (r *AtlasAccessRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("AtlasAccessRequest").
+		For(&mdbv1.AtlasAccessRequest{}, builder.WithPredicates(r.GlobalPredicates...)).
+		// the approval is recorded in the status, such changes are filtered out by the global predicates
+		Watches(&mdbv1.AtlasAccessRequest{}, &handler.EnqueueRequestForObject{}, builder.WithPredicates(approvalRecorded())).
+		Complete(r)
+}
+
+// approvalRecorded passes the updates setting the approval of a request
+func approvalRecorded() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(event.GenericEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldRequest, okOld := e.ObjectOld.(*mdbv1.AtlasAccessRequest)
+			newRequest, okNew := e.ObjectNew.(*mdbv1.AtlasAccessRequest)
+
+			return okOld && okNew && oldRequest.Status.Approval == nil && newRequest.Status.Approval != nil
+		},
+	}
+}
@@ -0,0 +1,165 @@
+package atlasaccessrequest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/timeutil"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func newAccessRequest(requestStatus status.AtlasAccessRequestStatus) *mdbv1.AtlasAccessRequest {
+	return &mdbv1.AtlasAccessRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "request", Namespace: "default"},
+		Spec: mdbv1.AtlasAccessRequestSpec{
+			DatabaseUserRef: common.ResourceRef{Name: "user"},
+			Roles:           []mdbv1.RoleSpec{{RoleName: "readWriteAnyDatabase", DatabaseName: "admin"}},
+			Duration:        metav1.Duration{Duration: time.Hour},
+		},
+		Status: requestStatus,
+	}
+}
+
+func newReconciler(t *testing.T, objects ...*mdbv1.AtlasDatabaseUser) *AtlasAccessRequestReconciler {
+	sch := runtime.NewScheme()
+	require.NoError(t, mdbv1.AddToScheme(sch))
+	builder := fake.NewClientBuilder().WithScheme(sch)
+	for _, object := range objects {
+		builder.WithObjects(object)
+	}
+
+	return &AtlasAccessRequestReconciler{
+		Client:         builder.Build(),
+		Log:            zaptest.NewLogger(t).Sugar(),
+		EventRecorder:  record.NewFakeRecorder(10),
+		GrantableRoles: []string{"readWriteAnyDatabase"},
+	}
+}
+
+func newContext(t *testing.T) *workflow.Context {
+	return workflow.NewContext(zaptest.NewLogger(t).Sugar(), []status.Condition{}, context.Background())
+}
+
+func reconciledStatus(ctx *workflow.Context, request *mdbv1.AtlasAccessRequest) status.AtlasAccessRequestStatus {
+	request.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+
+	return request.Status
+}
+
+func TestEnsureAccess(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	dbUser := &mdbv1.AtlasDatabaseUser{ObjectMeta: metav1.ObjectMeta{Name: "user", Namespace: "default"}}
+
+	t.Run("should wait for the approval", func(t *testing.T) {
+		ctx := newContext(t)
+		request := newAccessRequest(status.AtlasAccessRequestStatus{})
+
+		result := newReconciler(t, dbUser).ensureAccess(ctx, request, now)
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.AccessRequestPendingApproval, result.GetReason())
+		assert.Equal(t, status.AccessRequestPhasePending, reconciledStatus(ctx, request).Phase)
+	})
+
+	t.Run("should reject a request without duration", func(t *testing.T) {
+		request := newAccessRequest(status.AtlasAccessRequestStatus{})
+		request.Spec.Duration = metav1.Duration{}
+
+		result := newReconciler(t, dbUser).ensureAccess(newContext(t), request, now)
+
+		assert.Equal(t, workflow.AccessRequestInvalidSpec, result.GetReason())
+	})
+
+	t.Run("should reject a request for roles which aren't grantable", func(t *testing.T) {
+		request := newAccessRequest(status.AtlasAccessRequestStatus{Approval: &status.AccessRequestApproval{ApprovedBy: "jane"}})
+		request.Spec.Roles = append(request.Spec.Roles, mdbv1.RoleSpec{RoleName: "atlasAdmin", DatabaseName: "admin"})
+
+		result := newReconciler(t, dbUser).ensureAccess(newContext(t), request, now)
+
+		assert.Equal(t, workflow.AccessRequestInvalidSpec, result.GetReason())
+		assert.Equal(t, "the roles atlasAdmin can't be granted by access requests", result.GetMessage())
+		assert.Empty(t, request.Status.GrantedRoles)
+	})
+
+	t.Run("should fail when the database user doesn't exist", func(t *testing.T) {
+		request := newAccessRequest(status.AtlasAccessRequestStatus{Approval: &status.AccessRequestApproval{ApprovedBy: "jane"}})
+
+		result := newReconciler(t).ensureAccess(newContext(t), request, now)
+
+		assert.Equal(t, workflow.AccessRequestUserNotFound, result.GetReason())
+	})
+
+	t.Run("should grant the roles of an approved request", func(t *testing.T) {
+		ctx := newContext(t)
+		request := newAccessRequest(status.AtlasAccessRequestStatus{Approval: &status.AccessRequestApproval{ApprovedBy: "jane"}})
+
+		result := newReconciler(t, dbUser).ensureAccess(ctx, request, now)
+
+		assert.True(t, result.IsOk())
+		assert.Equal(t, time.Hour, result.ReconcileResult().RequeueAfter)
+		requestStatus := reconciledStatus(ctx, request)
+		assert.Equal(t, status.AccessRequestPhaseGranted, requestStatus.Phase)
+		assert.Equal(t, []status.GrantedRole{{RoleName: "readWriteAnyDatabase", DatabaseName: "admin"}}, requestStatus.GrantedRoles)
+		assert.Equal(t, timeutil.FormatISO8601(now), requestStatus.GrantedAt)
+		assert.Equal(t, timeutil.FormatISO8601(now.Add(time.Hour)), requestStatus.ExpiresAt)
+	})
+
+	t.Run("should requeue a granted request until its expiry", func(t *testing.T) {
+		request := newAccessRequest(status.AtlasAccessRequestStatus{
+			Phase:     status.AccessRequestPhaseGranted,
+			GrantedAt: timeutil.FormatISO8601(now.Add(-50 * time.Minute)),
+			ExpiresAt: timeutil.FormatISO8601(now.Add(10 * time.Minute)),
+		})
+
+		result := newReconciler(t, dbUser).ensureAccess(newContext(t), request, now)
+
+		assert.True(t, result.IsOk())
+		assert.Equal(t, 10*time.Minute, result.ReconcileResult().RequeueAfter)
+	})
+
+	t.Run("should expire a granted request", func(t *testing.T) {
+		ctx := newContext(t)
+		request := newAccessRequest(status.AtlasAccessRequestStatus{
+			Phase:     status.AccessRequestPhaseGranted,
+			GrantedAt: timeutil.FormatISO8601(now.Add(-time.Hour)),
+			ExpiresAt: timeutil.FormatISO8601(now),
+		})
+
+		result := newReconciler(t, dbUser).ensureAccess(ctx, request, now)
+
+		assert.True(t, result.IsOk())
+		requestStatus := reconciledStatus(ctx, request)
+		assert.Equal(t, status.AccessRequestPhaseExpired, requestStatus.Phase)
+		for _, condition := range requestStatus.Conditions {
+			if condition.Type == status.AccessRequestGrantedType {
+				assert.Equal(t, string(workflow.AccessRequestExpired), condition.Reason)
+			}
+		}
+	})
+
+	t.Run("should expire a granted request at the end of the requested duration whatever its status holds", func(t *testing.T) {
+		ctx := newContext(t)
+		request := newAccessRequest(status.AtlasAccessRequestStatus{
+			Phase:     status.AccessRequestPhaseGranted,
+			GrantedAt: timeutil.FormatISO8601(now.Add(-2 * time.Hour)),
+			ExpiresAt: timeutil.FormatISO8601(now.AddDate(75, 0, 0)),
+		})
+
+		result := newReconciler(t, dbUser).ensureAccess(ctx, request, now)
+
+		assert.True(t, result.IsOk())
+		assert.Equal(t, status.AccessRequestPhaseExpired, reconciledStatus(ctx, request).Phase)
+	})
+}
@@ -0,0 +1,93 @@
+package atlasdatabaseuser
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+// accessRequestRoles returns the roles granted to the database user by the AtlasAccessRequests of its namespace which
+// haven't expired yet. The status of the requests is written by their approvers, so only the grantable roles requested
+// by the spec are returned, until the expiry bounded by the requested duration
+func accessRequestRoles(ctx context.Context, k8sClient client.Client, dbUser mdbv1.AtlasDatabaseUser, grantable []string, now time.Time) ([]mdbv1.RoleSpec, error) {
+	requests := &mdbv1.AtlasAccessRequestList{}
+	if err := k8sClient.List(ctx, requests, client.InNamespace(dbUser.Namespace)); err != nil {
+		return nil, err
+	}
+
+	var roles []mdbv1.RoleSpec
+	for i := range requests.Items {
+		request := &requests.Items[i]
+		if request.DatabaseUserObjectKey() != client.ObjectKeyFromObject(&dbUser) || request.Status.Phase != status.AccessRequestPhaseGranted {
+			continue
+		}
+		expiresAt, err := request.GrantExpiresAt()
+		if err != nil || !now.Before(expiresAt) {
+			continue
+		}
+		for _, role := range request.GrantedSpecRoles() {
+			if slices.Contains(grantable, role.RoleName) {
+				roles = append(roles, role)
+			}
+		}
+	}
+
+	return roles, nil
+}
+
+// withAccessRequestRoles returns the database user with the granted roles added to its own roles.
+// The roles of the resource are not modified
+func withAccessRequestRoles(dbUser mdbv1.AtlasDatabaseUser, granted []mdbv1.RoleSpec) mdbv1.AtlasDatabaseUser {
+	if len(granted) == 0 {
+		return dbUser
+	}
+
+	roles := make([]mdbv1.RoleSpec, 0, len(dbUser.Spec.Roles)+len(granted))
+	seen := map[mdbv1.RoleSpec]bool{}
+	for _, role := range append(append([]mdbv1.RoleSpec{}, dbUser.Spec.Roles...), granted...) {
+		if seen[role] {
+			continue
+		}
+		seen[role] = true
+		roles = append(roles, role)
+	}
+	dbUser.Spec.Roles = roles
+
+	return dbUser
+}
+
+// accessRequestHandler enqueues the database user referenced by an AtlasAccessRequest
+func accessRequestHandler() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(_ context.Context, obj client.Object) []reconcile.Request {
+		request, ok := obj.(*mdbv1.AtlasAccessRequest)
+		if !ok {
+			return nil
+		}
+
+		return []reconcile.Request{{NamespacedName: request.DatabaseUserObjectKey()}}
+	})
+}
+
+// accessRequestPhaseChanged passes the events which may change the roles granted by an AtlasAccessRequest
+func accessRequestPhaseChanged() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldRequest, okOld := e.ObjectOld.(*mdbv1.AtlasAccessRequest)
+			newRequest, okNew := e.ObjectNew.(*mdbv1.AtlasAccessRequest)
+
+			return okOld && okNew && oldRequest.Status.Phase != newRequest.Status.Phase
+		},
+		GenericFunc: func(event.GenericEvent) bool {
+			return false
+		},
+	}
+}
@@ -0,0 +1,87 @@
+package atlasdatabaseuser
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/timeutil"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+func TestAccessRequestRoles(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	dbUser := mdbv1.AtlasDatabaseUser{
+		ObjectMeta: metav1.ObjectMeta{Name: "user", Namespace: "default"},
+		Spec: mdbv1.AtlasDatabaseUserSpec{
+			Roles: []mdbv1.RoleSpec{{RoleName: "read", DatabaseName: "orders"}},
+		},
+	}
+	request := func(name, namespace, userName string, phase status.AccessRequestPhase, expiresAt time.Time, role string) *mdbv1.AtlasAccessRequest {
+		return &mdbv1.AtlasAccessRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: mdbv1.AtlasAccessRequestSpec{
+				DatabaseUserRef: common.ResourceRef{Name: userName},
+				Roles:           []mdbv1.RoleSpec{{RoleName: role, DatabaseName: "orders"}},
+				Duration:        metav1.Duration{Duration: time.Hour},
+			},
+			Status: status.AtlasAccessRequestStatus{
+				Phase:        phase,
+				GrantedAt:    timeutil.FormatISO8601(expiresAt.Add(-time.Hour)),
+				ExpiresAt:    timeutil.FormatISO8601(expiresAt),
+				GrantedRoles: []status.GrantedRole{{RoleName: role, DatabaseName: "orders"}},
+			},
+		}
+	}
+
+	// the approver extends the expiry and adds a role by writing the status of an approved request
+	tampered := request("tampered", "default", "user", status.AccessRequestPhaseGranted, now.Add(time.Hour), "dbAdmin")
+	tampered.Status.GrantedAt = timeutil.FormatISO8601(now.Add(-2 * time.Hour))
+	tampered.Status.ExpiresAt = timeutil.FormatISO8601(now.AddDate(75, 0, 0))
+	tampered.Status.GrantedRoles = append(tampered.Status.GrantedRoles, status.GrantedRole{RoleName: "dbOwner", DatabaseName: "orders"})
+	extraRole := request("extra-role", "default", "user", status.AccessRequestPhaseGranted, now.Add(time.Hour), "readWrite")
+	extraRole.Status.GrantedRoles = append(extraRole.Status.GrantedRoles, status.GrantedRole{RoleName: "dbOwner", DatabaseName: "orders"})
+
+	sch := runtime.NewScheme()
+	require.NoError(t, mdbv1.AddToScheme(sch))
+	k8sClient := fake.NewClientBuilder().WithScheme(sch).WithObjects(
+		tampered,
+		extraRole,
+		request("granted", "default", "user", status.AccessRequestPhaseGranted, now.Add(time.Hour), "readWrite"),
+		request("duplicated", "default", "user", status.AccessRequestPhaseGranted, now.Add(time.Hour), "read"),
+		request("pending", "default", "user", status.AccessRequestPhasePending, now.Add(time.Hour), "dbAdmin"),
+		request("expired", "default", "user", status.AccessRequestPhaseGranted, now.Add(-time.Minute), "dbOwner"),
+		request("other-user", "default", "other", status.AccessRequestPhaseGranted, now.Add(time.Hour), "dbOwner"),
+		request("other-namespace", "other", "user", status.AccessRequestPhaseGranted, now.Add(time.Hour), "dbOwner"),
+		request("not-grantable", "default", "user", status.AccessRequestPhaseGranted, now.Add(time.Hour), "atlasAdmin"),
+	).Build()
+	grantable := []string{"read", "readWrite", "dbAdmin", "dbOwner"}
+
+	t.Run("should return the grantable roles of the active requests of the user only", func(t *testing.T) {
+		roles, err := accessRequestRoles(context.Background(), k8sClient, dbUser, grantable, now)
+
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []mdbv1.RoleSpec{
+			{RoleName: "readWrite", DatabaseName: "orders"},
+			{RoleName: "readWrite", DatabaseName: "orders"},
+			{RoleName: "read", DatabaseName: "orders"},
+		}, roles)
+	})
+
+	t.Run("should add the granted roles without duplicates nor modifying the user", func(t *testing.T) {
+		granted := []mdbv1.RoleSpec{{RoleName: "read", DatabaseName: "orders"}, {RoleName: "readWrite", DatabaseName: "orders"}}
+
+		merged := withAccessRequestRoles(dbUser, granted)
+
+		assert.Equal(t, []mdbv1.RoleSpec{{RoleName: "read", DatabaseName: "orders"}, {RoleName: "readWrite", DatabaseName: "orders"}}, merged.Spec.Roles)
+		assert.Equal(t, []mdbv1.RoleSpec{{RoleName: "read", DatabaseName: "orders"}}, dbUser.Spec.Roles)
+	})
+}
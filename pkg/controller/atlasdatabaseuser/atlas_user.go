@@ -0,0 +1,89 @@
+package atlasdatabaseuser
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+)
+
+// databaseUsersPath is the path of the database users of a project, as requested by the Atlas client
+const databaseUsersPath = "api/atlas/v1.0/groups/%s/databaseUsers"
+
+// atlasDatabaseUser is the database user in Atlas along with the fields the Atlas client doesn't model
+type atlasDatabaseUser struct {
+	mongodbatlas.DatabaseUser
+	// Description is the description of the user displayed in the Atlas UI. It's nil when Atlas doesn't report it,
+	// and sent even when empty so that a cleared description is removed
+	Description *string `json:"description,omitempty"`
+}
+
+func getDatabaseUser(ctx context.Context, atlasClient *mongodbatlas.Client, databaseName, projectID, username string) (*atlasDatabaseUser, error) {
+	path := fmt.Sprintf(databaseUsersPath+"/%s/%s", projectID, databaseName, url.PathEscape(username))
+
+	return sendDatabaseUserRequest(ctx, atlasClient, http.MethodGet, path, nil)
+}
+
+func createDatabaseUser(ctx context.Context, atlasClient *mongodbatlas.Client, projectID string, user *atlasDatabaseUser) error {
+	_, err := sendDatabaseUserRequest(ctx, atlasClient, http.MethodPost, fmt.Sprintf(databaseUsersPath, projectID), user)
+
+	return err
+}
+
+func updateDatabaseUser(ctx context.Context, atlasClient *mongodbatlas.Client, projectID string, user *atlasDatabaseUser) error {
+	path := fmt.Sprintf(databaseUsersPath+"/%s/%s", projectID, user.GetAuthDB(), url.PathEscape(user.Username))
+	_, err := sendDatabaseUserRequest(ctx, atlasClient, http.MethodPatch, path, user)
+
+	return err
+}
+
+func sendDatabaseUserRequest(ctx context.Context, atlasClient *mongodbatlas.Client, method, path string, body *atlasDatabaseUser) (*atlasDatabaseUser, error) {
+	var reqBody interface{}
+	if body != nil {
+		reqBody = body
+	}
+
+	req, err := atlasClient.NewRequest(ctx, method, path, reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &atlasDatabaseUser{}
+	if _, err = atlasClient.Do(ctx, req, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// propagatedLabels returns the labels of the spec followed by the Kubernetes labels of the resource with the given keys.
+// The labels of the spec take precedence
+func propagatedLabels(dbUser *mdbv1.AtlasDatabaseUser, keys []string) []common.LabelSpec {
+	result := make([]common.LabelSpec, 0, len(dbUser.Spec.Labels)+len(keys))
+	result = append(result, dbUser.Spec.Labels...)
+
+	for _, key := range keys {
+		value, ok := dbUser.Labels[key]
+		if !ok || hasLabel(dbUser.Spec.Labels, key) {
+			continue
+		}
+		result = append(result, common.LabelSpec{Key: key, Value: value})
+	}
+
+	return result
+}
+
+func hasLabel(labels []common.LabelSpec, key string) bool {
+	for _, label := range labels {
+		if label.Key == key {
+			return true
+		}
+	}
+
+	return false
+}
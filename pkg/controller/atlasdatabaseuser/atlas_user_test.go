@@ -0,0 +1,112 @@
+package atlasdatabaseuser
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	"go.uber.org/zap"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+)
+
+func TestDatabaseUserRequests(t *testing.T) {
+	t.Run("should read the description of the user", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/atlas/v1.0/groups/project-id/databaseUsers/admin/app", r.URL.Path)
+			_, _ = w.Write([]byte(`{"username":"app","databaseName":"admin","description":"Billing service"}`))
+		}))
+		defer server.Close()
+		atlasClient, err := mongodbatlas.New(server.Client(), mongodbatlas.SetBaseURL(server.URL+"/"))
+		require.NoError(t, err)
+
+		user, err := getDatabaseUser(context.Background(), atlasClient, "admin", "project-id", "app")
+
+		require.NoError(t, err)
+		assert.Equal(t, "app", user.Username)
+		assert.Equal(t, pointer.MakePtr("Billing service"), user.Description)
+	})
+
+	t.Run("should send the description of the user", func(t *testing.T) {
+		var received map[string]interface{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPatch, r.Method)
+			assert.Equal(t, "/api/atlas/v1.0/groups/project-id/databaseUsers/admin/app", r.URL.Path)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			_, _ = w.Write([]byte(`{}`))
+		}))
+		defer server.Close()
+		atlasClient, err := mongodbatlas.New(server.Client(), mongodbatlas.SetBaseURL(server.URL+"/"))
+		require.NoError(t, err)
+
+		user := &atlasDatabaseUser{DatabaseUser: mongodbatlas.DatabaseUser{Username: "app", DatabaseName: "admin"}, Description: pointer.MakePtr("Billing service")}
+
+		require.NoError(t, updateDatabaseUser(context.Background(), atlasClient, "project-id", user))
+		assert.Equal(t, "Billing service", received["description"])
+		assert.Equal(t, "app", received["username"])
+
+		user.Description = pointer.MakePtr("")
+		require.NoError(t, updateDatabaseUser(context.Background(), atlasClient, "project-id", user))
+		assert.Contains(t, received, "description")
+		assert.Equal(t, "", received["description"])
+	})
+}
+
+func TestPropagatedLabels(t *testing.T) {
+	dbUser := mdbv1.NewDBUser("ns", "user", "app", "project")
+	dbUser.Labels = map[string]string{"team": "billing", "cost-center": "42", "other": "value"}
+	dbUser.Spec.Labels = []common.LabelSpec{{Key: "cost-center", Value: "7"}}
+
+	t.Run("should add the selected Kubernetes labels after the ones of the spec", func(t *testing.T) {
+		labels := propagatedLabels(dbUser, []string{"cost-center", "missing", "team"})
+
+		assert.Equal(t, []common.LabelSpec{{Key: "cost-center", Value: "7"}, {Key: "team", Value: "billing"}}, labels)
+	})
+
+	t.Run("should return the labels of the spec without selected keys", func(t *testing.T) {
+		assert.Equal(t, dbUser.Spec.Labels, propagatedLabels(dbUser, nil))
+	})
+}
+
+func TestUserMatchesSpecDescription(t *testing.T) {
+	t.Run("should not match a user with another description", func(t *testing.T) {
+		atlasUser := &atlasDatabaseUser{DatabaseUser: mongodbatlas.DatabaseUser{Username: "app", DatabaseName: "admin"}, Description: pointer.MakePtr("old")}
+		spec := mdbv1.AtlasDatabaseUserSpec{Username: "app", DatabaseName: "admin", Description: "new"}
+
+		matches, err := userMatchesSpec(zap.S(), atlasUser, spec)
+
+		require.NoError(t, err)
+		assert.False(t, matches)
+	})
+
+	t.Run("should not compare the description Atlas doesn't report", func(t *testing.T) {
+		atlasUser := &atlasDatabaseUser{DatabaseUser: mongodbatlas.DatabaseUser{Username: "app", DatabaseName: "admin"}}
+		spec := mdbv1.AtlasDatabaseUserSpec{Username: "app", DatabaseName: "admin", Description: "new"}
+
+		matches, err := userMatchesSpec(zap.S(), atlasUser, spec)
+
+		require.NoError(t, err)
+		assert.True(t, matches)
+	})
+}
+
+func TestShouldUpdateOnReapply(t *testing.T) {
+	atlasUser := &atlasDatabaseUser{DatabaseUser: mongodbatlas.DatabaseUser{Username: "app", DatabaseName: "admin"}}
+	dbUser := mdbv1.AtlasDatabaseUser{Spec: mdbv1.AtlasDatabaseUserSpec{Username: "app", DatabaseName: "admin"}}
+
+	update, err := shouldUpdate(zap.S(), atlasUser, dbUser, "", false)
+	require.NoError(t, err)
+	assert.False(t, update)
+
+	update, err = shouldUpdate(zap.S(), atlasUser, dbUser, "", true)
+	require.NoError(t, err)
+	assert.True(t, update)
+}
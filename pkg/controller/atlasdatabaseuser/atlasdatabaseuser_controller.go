@@ -37,6 +37,9 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/connectionsecret"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/deletion"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/notifier"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/secretstore"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/statushandler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/validate"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/watch"
@@ -53,24 +56,37 @@ type AtlasDatabaseUserReconciler struct {
 	Log                           *zap.SugaredLogger
 	Scheme                        *runtime.Scheme
 	EventRecorder                 record.EventRecorder
+	FailureNotifier               *notifier.Notifier
 	AtlasProvider                 atlas.Provider
 	GlobalPredicates              []predicate.Predicate
 	ObjectDeletionProtection      bool
 	SubObjectDeletionProtection   bool
 	FeaturePreviewOIDCAuthEnabled bool
+	// ConnectionSecretMetadata holds the labels and annotations added to all the connection Secrets
+	ConnectionSecretMetadata connectionsecret.Metadata
+	// PropagatedLabels are the keys of the Kubernetes labels copied to the labels of the database users in Atlas
+	PropagatedLabels []string
+	// DeletionScheduler throttles the deletions of the database users in Atlas, they aren't throttled when it's nil
+	DeletionScheduler *deletion.Scheduler
+	// SecretStores are the external secret stores the passwords of the database users can be read from
+	SecretStores secretstore.Registry
+	// GrantableRoles are the names of the roles the AtlasAccessRequests may grant to the database users
+	GrantableRoles []string
 }
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasdatabaseusers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasdatabaseusers/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasaccessrequests,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasdatabaseusers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasdatabaseusers/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",namespace=default,resources=secrets,verbs=create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasaccessrequests,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",namespace=default,resources=events,verbs=create;patch
 
-func (r *AtlasDatabaseUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *AtlasDatabaseUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, _ error) {
 	log := r.Log.With("atlasdatabaseuser", req.NamespacedName)
 
 	databaseUser := &mdbv1.AtlasDatabaseUser{}
@@ -84,14 +100,30 @@ func (r *AtlasDatabaseUserReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return workflow.OK().ReconcileResult(), nil
 	}
 
+	if customresource.NamespaceReconciliationPaused(ctx, r.Client, databaseUser.Namespace, log) {
+		log.Infow(fmt.Sprintf("-> Pausing AtlasDatabaseUser reconciliation as annotation %s=true is set on the namespace", customresource.ReconciliationPausedAnnotation))
+		return customresource.MarkReconciliationPaused(ctx, r.Client, r.EventRecorder, databaseUser, log).ReconcileResult(), nil
+	}
+
 	workflowCtx := customresource.MarkReconciliationStarted(r.Client, databaseUser, log, ctx)
 	log.Infow("-> Starting AtlasDatabaseUser reconciliation", "spec", databaseUser.Spec, "status", databaseUser.Status)
 	if databaseUser.Spec.PasswordSecret != nil {
 		workflowCtx.AddResourcesToWatch(watch.WatchedObject{ResourceKind: "Secret", Resource: *databaseUser.PasswordSecretObjectKey()})
 	}
+
+	if workflowCtx.Degraded(databaseUser) {
+		log.Infow("-> Skipping the reconciliation of the degraded AtlasDatabaseUser, change its spec or set the reapply annotation to retry")
+		return ctrl.Result{}, nil
+	}
+
 	defer func() {
-		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, databaseUser)
+		if p := recover(); p != nil {
+			res = workflowCtx.RecoverPanic("AtlasDatabaseUser", p).ReconcileResult()
+		}
+		res = workflowCtx.CapRetries("AtlasDatabaseUser", databaseUser, res)
+		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, r.FailureNotifier, databaseUser)
 		r.EnsureMultiplesResourcesAreWatched(req.NamespacedName, log, workflowCtx.ListResourcesToWatch()...)
+		workflowCtx.LogStepTimings()
 	}()
 
 	resourceVersionIsValid := customresource.ValidateResourceVersion(workflowCtx, databaseUser, r.Log)
@@ -133,7 +165,16 @@ func (r *AtlasDatabaseUserReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	workflowCtx.OrgID = orgID
 	workflowCtx.Client = atlasClient
 
-	owner, err := customresource.IsOwner(databaseUser, r.ObjectDeletionProtection, customresource.IsResourceManagedByOperator, managedByAtlas(ctx, atlasClient, project.ID(), log))
+	atlasSdkClient, _, err := r.AtlasProvider.SdkClient(ctx, project.ConnectionSecretObjectKey(), log)
+	if err != nil {
+		result = workflow.Terminate(workflow.AtlasAPIAccessNotConfigured, err.Error())
+		workflowCtx.SetConditionFromResult(status.DatabaseUserReadyType, result)
+
+		return result.ReconcileResult(), nil
+	}
+	workflowCtx.SdkClient = atlasSdkClient
+
+	owner, err := customresource.IsOwnerByMarker(databaseUser, r.ObjectDeletionProtection, markedInAtlas(ctx, atlasClient, project.ID()), customresource.IsResourceManagedByOperator, managedByAtlas(ctx, atlasClient, project.ID(), r.PropagatedLabels, log))
 	if err != nil {
 		result = workflow.Terminate(workflow.Internal, fmt.Sprintf("enable to resolve ownership for deletion protection: %s", err))
 		workflowCtx.SetConditionFromResult(status.DatabaseUserReadyType, result)
@@ -238,10 +279,13 @@ func (r *AtlasDatabaseUserReconciler) handleDeletion(
 	}
 
 	if customresource.HaveFinalizer(dbUser, customresource.FinalizerLabel) {
-		err := connectionsecret.RemoveStaleSecretsByUserName(ctx, r.Client, project.ID(), dbUser.Spec.Username, *dbUser, log)
+		err := connectionsecret.RemoveStaleSecretsByUserName(ctx, r.Client, project.ID(), dbUser.AtlasUsername(), *dbUser, log)
 		if err != nil {
 			return true, workflow.Terminate(workflow.DatabaseUserConnectionSecretsNotDeleted, err.Error())
 		}
+		if err = connectionsecret.RemoveFromStore(ctx, r.SecretStores, dbUser, log); err != nil {
+			return true, workflow.Terminate(workflow.DatabaseUserConnectionSecretsNotDeleted, err.Error())
+		}
 	}
 
 	if customresource.IsResourcePolicyKeepOrDefault(dbUser, r.ObjectDeletionProtection) {
@@ -255,11 +299,16 @@ func (r *AtlasDatabaseUserReconciler) handleDeletion(
 		return true, workflow.OK()
 	}
 
-	_, err := atlasClient.DatabaseUsers.Delete(ctx, dbUser.Spec.DatabaseName, project.ID(), dbUser.Spec.Username)
+	release, result := r.DeletionScheduler.Acquire(ctx, r.Client, dbUser)
+	if !result.IsOk() {
+		return true, result
+	}
+	_, err := atlasClient.DatabaseUsers.Delete(ctx, dbUser.Spec.DatabaseName, project.ID(), dbUser.AtlasUsername())
+	release(err)
 	if err != nil {
 		var apiError *mongodbatlas.ErrorResponse
 		if errors.As(err, &apiError) && apiError.ErrorCode != atlas.UsernameNotFound {
-			return true, workflow.Terminate(workflow.DatabaseUserNotDeletedInAtlas, err.Error())
+			return true, workflow.Terminate(workflow.DatabaseUserNotDeletedInAtlas, err.Error()).WithAtlasError(err)
 		}
 
 		log.Info("Database user doesn't exist or is already deleted")
@@ -277,18 +326,44 @@ func (r *AtlasDatabaseUserReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("AtlasDatabaseUser").
 		For(&mdbv1.AtlasDatabaseUser{}, builder.WithPredicates(r.GlobalPredicates...)).
-		Watches(&corev1.Secret{}, watch.NewSecretHandler(r.WatchedResources)).
+		Watches(&corev1.Secret{}, watch.NewSecretHandler(r.WatchedResources), builder.OnlyMetadata).
+		Watches(&mdbv1.AtlasAccessRequest{}, accessRequestHandler(), builder.WithPredicates(accessRequestPhaseChanged())).
+		Watches(&mdbv1.AtlasProject{}, watch.NewProjectDependentsHandler(r.Client, &mdbv1.AtlasDatabaseUserList{}), builder.WithPredicates(watch.ProjectOrganizationChanged())).
 		Complete(r)
 }
 
-func managedByAtlas(ctx context.Context, atlasClient *mongodbatlas.Client, projectID string, log *zap.SugaredLogger) customresource.AtlasChecker {
+// markedInAtlas reads the ownership marker from the labels of the database user in Atlas
+func markedInAtlas(ctx context.Context, atlasClient *mongodbatlas.Client, projectID string) customresource.MarkerChecker {
+	return func(resource mdbv1.AtlasCustomResource) (bool, bool, error) {
+		dbUser, ok := resource.(*mdbv1.AtlasDatabaseUser)
+		if !ok {
+			return false, false, errors.New("failed to match resource type as AtlasDatabaseUser")
+		}
+
+		atlasDBUser, _, err := atlasClient.DatabaseUsers.Get(ctx, dbUser.Spec.DatabaseName, projectID, dbUser.AtlasUsername())
+		if err != nil {
+			var apiError *mongodbatlas.ErrorResponse
+			if errors.As(err, &apiError) && apiError.ErrorCode == atlas.UsernameNotFound {
+				return false, false, nil
+			}
+
+			return false, false, err
+		}
+
+		marker, marked := ownershipMarker(atlasDBUser)
+
+		return marked, marked && marker.Owns(dbUser), nil
+	}
+}
+
+func managedByAtlas(ctx context.Context, atlasClient *mongodbatlas.Client, projectID string, propagatedKeys []string, log *zap.SugaredLogger) customresource.AtlasChecker {
 	return func(resource mdbv1.AtlasCustomResource) (bool, error) {
 		dbUser, ok := resource.(*mdbv1.AtlasDatabaseUser)
 		if !ok {
 			return false, errors.New("failed to match resource type as AtlasDatabaseUser")
 		}
 
-		atlasDBUser, _, err := atlasClient.DatabaseUsers.Get(ctx, dbUser.Spec.DatabaseName, projectID, dbUser.Spec.Username)
+		atlasDBUser, err := getDatabaseUser(ctx, atlasClient, dbUser.Spec.DatabaseName, projectID, dbUser.AtlasUsername())
 		if err != nil {
 			var apiError *mongodbatlas.ErrorResponse
 			if errors.As(err, &apiError) && apiError.ErrorCode == atlas.UsernameNotFound {
@@ -298,7 +373,9 @@ func managedByAtlas(ctx context.Context, atlasClient *mongodbatlas.Client, proje
 			return false, err
 		}
 
-		isSame, err := userMatchesSpec(log, atlasDBUser, dbUser.Spec)
+		spec := dbUser.Spec
+		spec.Labels = propagatedLabels(dbUser, propagatedKeys)
+		isSame, err := userMatchesSpec(log, atlasDBUser, spec)
 		if err != nil {
 			return true, err
 		}
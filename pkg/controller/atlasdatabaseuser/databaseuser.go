@@ -10,24 +10,81 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"go.mongodb.org/atlas/mongodbatlas"
 	"go.uber.org/zap"
-	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/compat"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nametemplate"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/timeutil"
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlasdeployment"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/connectionsecret"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/ownership"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
 
+const databaseUserReconciler = "databaseUser"
+
+// maxUsernameLength is the maximum length of the name of a database user in Atlas
+const maxUsernameLength = 1024
+
+// passwordStoreRefreshInterval is how often the users reading their password from an external secret store are
+// reconciled, as the changes of the store aren't watched
+const passwordStoreRefreshInterval = 5 * time.Minute
+
+// databaseUserStepTimeout is the deadline of each of the database user sub-reconcilers, so that a slow or stuck call
+// to Atlas doesn't hold the reconciliation of the user forever
+const databaseUserStepTimeout = 2 * time.Minute
+
 func (r *AtlasDatabaseUserReconciler) ensureDatabaseUser(ctx *workflow.Context, project mdbv1.AtlasProject, dbUser mdbv1.AtlasDatabaseUser) workflow.Result {
+	// The roles temporarily granted by the access requests are applied on top of the roles of the user, they are
+	// revoked by the first reconciliation after the requests expire
+	grantedRoles, err := accessRequestRoles(ctx.Context, r.Client, dbUser, r.GrantableRoles, time.Now().UTC())
+	if err != nil {
+		return workflow.Terminate(workflow.Internal, err.Error())
+	}
+	dbUser = withAccessRequestRoles(dbUser, grantedRoles)
+
+	// The user is reconciled with its name in Atlas, the template of the username is kept in the resource
+	if dbUser.Spec.Username, err = renderUsername(&project, &dbUser); err != nil {
+		return workflow.Terminate(workflow.DatabaseUserInvalidSpec, err.Error()).WithoutRetry()
+	}
+	dbUser.Spec.Labels = propagatedLabels(&dbUser, r.PropagatedLabels)
+
+	// A rotated password is written to the password Secret first, so that it's set in Atlas by this reconciliation
+	var rotation *status.PasswordRotationStatus
+	result := ctx.RunStep("passwordRotation", databaseUserStepTimeout, func() (result workflow.Result) {
+		rotation, result = r.ensurePasswordRotation(ctx, &dbUser, time.Now())
+		return result
+	})
+	if !result.IsOk() {
+		return result
+	}
+
+	// The deleteAfterDate of an expiring user is pushed back before the user is sent to Atlas
+	var expirationCheck time.Time
+	result = ctx.RunStep("userExpiration", databaseUserStepTimeout, func() (result workflow.Result) {
+		expirationCheck, result = r.ensureUserExpiration(ctx, &dbUser, time.Now())
+		return result
+	})
+	if !result.IsOk() {
+		return result
+	}
+
+	password, passwordVersion, err := r.SecretStores.ReadPassword(ctx.Context, r.Client, &dbUser)
+	if err != nil {
+		return workflow.Terminate(workflow.Internal, err.Error())
+	}
+
 	apiUser, err := dbUser.ToAtlas(ctx.Context, r.Client)
 	if err != nil {
 		return workflow.Terminate(workflow.Internal, err.Error())
 	}
+	apiUser.Password = password
+	apiUser.Labels = withOwnershipLabels(apiUser.Labels, &dbUser)
+	atlasUser := &atlasDatabaseUser{DatabaseUser: *apiUser, Description: pointer.MakePtr(dbUser.Spec.Description)}
 
 	if result := checkUserExpired(ctx.Context, ctx.Log, r.Client, project.ID(), dbUser); !result.IsOk() {
 		return result
@@ -37,27 +94,58 @@ func (r *AtlasDatabaseUserReconciler) ensureDatabaseUser(ctx *workflow.Context,
 		return workflow.Terminate(workflow.DatabaseUserInvalidSpec, err.Error())
 	}
 
-	if result := performUpdateInAtlas(ctx, r.Client, project, dbUser, apiUser); !result.IsOk() {
+	if result := ctx.RunStep(databaseUserReconciler, databaseUserStepTimeout, func() workflow.Result {
+		return performUpdateInAtlas(ctx, project, dbUser, atlasUser, passwordVersion)
+	}); !result.IsOk() {
 		return result
 	}
 
-	if result := checkDeploymentsHaveReachedGoalState(ctx, project.ID(), dbUser); !result.IsOk() {
+	var certificateRenewal time.Time
+	result = ctx.RunStep("x509Certificate", databaseUserStepTimeout, func() (result workflow.Result) {
+		certificateRenewal, result = r.ensureX509Certificate(ctx, project.ID(), &dbUser, time.Now())
+		return result
+	})
+	if !result.IsOk() {
+		return result
+	}
+
+	if result := ctx.RunStep("deploymentsGoalState", databaseUserStepTimeout, func() workflow.Result {
+		return checkDeploymentsHaveReachedGoalState(ctx, project.ID(), dbUser)
+	}); !result.IsOk() {
 		return result
 	}
 
-	if result := connectionsecret.CreateOrUpdateConnectionSecrets(ctx, r.Client, r.EventRecorder, project, dbUser); !result.IsOk() {
+	if result := ctx.RunStep("connectionSecrets", databaseUserStepTimeout, func() workflow.Result {
+		return connectionsecret.CreateOrUpdateConnectionSecrets(ctx, r.Client, r.EventRecorder, project, dbUser, password, r.ConnectionSecretMetadata, r.SecretStores)
+	}); !result.IsOk() {
 		return result
 	}
 
 	// We need to remove the old Atlas User right after all the connection secrets are ensured if username has changed.
-	if result := handleUserNameChange(ctx, project.ID(), dbUser); !result.IsOk() {
+	if result := ctx.RunStep("usernameChange", databaseUserStepTimeout, func() workflow.Result {
+		return handleUserNameChange(ctx, project.ID(), dbUser)
+	}); !result.IsOk() {
 		return result
 	}
 
 	// We mark the status.Username only when everything is finished including connection secrets
 	ctx.EnsureStatusOption(status.AtlasDatabaseUserNameOption(dbUser.Spec.Username))
+	r.reportPasswordRotation(ctx, &dbUser, rotation)
+
+	result = workflow.OK()
+	switch {
+	case rotation != nil && !rotation.NextRotation.IsZero():
+		result = workflow.OK().WithRetry(time.Until(rotation.NextRotation.Time))
+	case dbUser.Spec.PasswordStore != nil:
+		result = workflow.OK().WithRetry(passwordStoreRefreshInterval)
+	}
+	for _, check := range []time.Time{expirationCheck, certificateRenewal} {
+		if retry := result.ReconcileResult().RequeueAfter; !check.IsZero() && (retry == 0 || time.Until(check) < retry) {
+			result = workflow.OK().WithRetry(time.Until(check))
+		}
+	}
 
-	return workflow.OK()
+	return result
 }
 
 func handleUserNameChange(ctx *workflow.Context, projectID string, dbUser mdbv1.AtlasDatabaseUser) workflow.Result {
@@ -97,45 +185,38 @@ func checkUserExpired(ctx context.Context, log *zap.SugaredLogger, k8sClient cli
 	return workflow.OK()
 }
 
-func performUpdateInAtlas(ctx *workflow.Context, k8sClient client.Client, project mdbv1.AtlasProject, dbUser mdbv1.AtlasDatabaseUser, apiUser *mongodbatlas.DatabaseUser) workflow.Result {
+// performUpdateInAtlas creates or updates the user in Atlas, the password is sent again whenever its version changes
+func performUpdateInAtlas(ctx *workflow.Context, project mdbv1.AtlasProject, dbUser mdbv1.AtlasDatabaseUser, apiUser *atlasDatabaseUser, currentPasswordResourceVersion string) workflow.Result {
 	log := ctx.Log
 
-	secret := &corev1.Secret{}
-	passwordKey := dbUser.PasswordSecretObjectKey()
-	var currentPasswordResourceVersion string
-	if passwordKey != nil {
-		if err := k8sClient.Get(ctx.Context, *passwordKey, secret); err != nil {
-			return workflow.Terminate(workflow.Internal, err.Error())
-		}
-		currentPasswordResourceVersion = secret.ResourceVersion
-	}
-
 	retryAfterUpdate := workflow.InProgress(workflow.DatabaseUserDeploymentAppliedChanges, "Clusters are scheduled to handle database users updates")
 
 	// Try to find the user
-	u, _, err := ctx.Client.DatabaseUsers.Get(ctx.Context, dbUser.Spec.DatabaseName, project.ID(), dbUser.Spec.Username)
+	u, err := getDatabaseUser(ctx.Context, ctx.Client, dbUser.Spec.DatabaseName, project.ID(), dbUser.Spec.Username)
 	if err != nil {
 		var apiError *mongodbatlas.ErrorResponse
 		if errors.As(err, &apiError) && apiError.ErrorCode == atlas.UsernameNotFound {
 			log.Debugw("User doesn't exist. Create new user", "apiUser", apiUser)
-			if _, _, err = ctx.Client.DatabaseUsers.Create(ctx.Context, project.ID(), apiUser); err != nil {
-				return workflow.Terminate(workflow.DatabaseUserNotCreatedInAtlas, err.Error())
+			if err = createDatabaseUser(ctx.Context, ctx.Client, project.ID(), apiUser); err != nil {
+				return workflow.Terminate(workflow.DatabaseUserNotCreatedInAtlas, err.Error()).WithAtlasError(err).
+					WithCause(databaseUserReconciler, "createDatabaseUser")
 			}
 			ctx.EnsureStatusOption(status.AtlasDatabaseUserPasswordVersion(currentPasswordResourceVersion))
 
 			ctx.Log.Infow("Created Atlas Database User", "name", dbUser.Spec.Username)
 			return retryAfterUpdate
 		} else {
-			return workflow.Terminate(workflow.DatabaseUserNotCreatedInAtlas, err.Error())
+			return workflow.Terminate(workflow.DatabaseUserNotCreatedInAtlas, err.Error()).WithAtlasError(err).
+				WithCause(databaseUserReconciler, "getDatabaseUser")
 		}
 	}
 	// Update if the spec has changed
-	if shouldUpdate, err := shouldUpdate(ctx.Log, u, dbUser, currentPasswordResourceVersion); err != nil {
+	if shouldUpdate, err := shouldUpdate(ctx.Log, u, dbUser, currentPasswordResourceVersion, ctx.Reapply); err != nil {
 		return workflow.Terminate(workflow.Internal, err.Error())
 	} else if shouldUpdate {
-		_, _, err = ctx.Client.DatabaseUsers.Update(ctx.Context, project.ID(), dbUser.Spec.Username, apiUser)
-		if err != nil {
-			return workflow.Terminate(workflow.DatabaseUserNotUpdatedInAtlas, err.Error())
+		if err = updateDatabaseUser(ctx.Context, ctx.Client, project.ID(), apiUser); err != nil {
+			return workflow.Terminate(workflow.DatabaseUserNotUpdatedInAtlas, err.Error()).WithAtlasError(err).
+				WithCause(databaseUserReconciler, "updateDatabaseUser")
 		}
 		// Update the status password resource version so that next time no API update call happened
 		ctx.EnsureStatusOption(status.AtlasDatabaseUserPasswordVersion(currentPasswordResourceVersion))
@@ -218,7 +299,14 @@ func filterScopeDeployments(user mdbv1.AtlasDatabaseUser, allDeploymentsInProjec
 	return deploymentsToCheck
 }
 
-func shouldUpdate(log *zap.SugaredLogger, atlasSpec *mongodbatlas.DatabaseUser, operatorDBUser mdbv1.AtlasDatabaseUser, currentPasswordResourceVersion string) (bool, error) {
+// shouldUpdate returns true if the user in Atlas differs from the spec, its password changed or a full resynchronization
+// was requested
+func shouldUpdate(log *zap.SugaredLogger, atlasSpec *atlasDatabaseUser, operatorDBUser mdbv1.AtlasDatabaseUser, currentPasswordResourceVersion string, reapply bool) (bool, error) {
+	if reapply {
+		log.Debug("Database User resynchronization requested - making the request to Atlas")
+		return true, nil
+	}
+
 	matches, err := userMatchesSpec(log, atlasSpec, operatorDBUser.Spec)
 	if err != nil {
 		return false, err
@@ -235,7 +323,16 @@ func shouldUpdate(log *zap.SugaredLogger, atlasSpec *mongodbatlas.DatabaseUser,
 }
 
 // TODO move to a separate utils (reuse from deployments)
-func userMatchesSpec(log *zap.SugaredLogger, atlasSpec *mongodbatlas.DatabaseUser, operatorSpec mdbv1.AtlasDatabaseUserSpec) (bool, error) {
+func userMatchesSpec(log *zap.SugaredLogger, atlasUser *atlasDatabaseUser, operatorSpec mdbv1.AtlasDatabaseUserSpec) (bool, error) {
+	// the description is only compared when Atlas reports it, as the user would be updated forever otherwise
+	if atlasUser.Description != nil && *atlasUser.Description != operatorSpec.Description {
+		log.Debugf("Users differs from spec: description %q, expected %q", *atlasUser.Description, operatorSpec.Description)
+		return false, nil
+	}
+
+	// the ownership labels are stamped by the operator on top of the labels of the spec
+	atlasSpec := withoutOwnershipLabels(&atlasUser.DatabaseUser)
+
 	userMerged := mongodbatlas.DatabaseUser{}
 	if err := compat.JSONCopy(&userMerged, atlasSpec); err != nil {
 		return false, err
@@ -267,3 +364,51 @@ func userMatchesSpec(log *zap.SugaredLogger, atlasSpec *mongodbatlas.DatabaseUse
 
 	return d == "", nil
 }
+
+// renderUsername expands the template of the username, see nametemplate.Generate
+func renderUsername(project *mdbv1.AtlasProject, dbUser *mdbv1.AtlasDatabaseUser) (string, error) {
+	values := nametemplate.NewValues()
+	values.Project = project.Spec.Name
+	values.Namespace = dbUser.Namespace
+	values.Name = dbUser.Name
+
+	return nametemplate.Generate(dbUser.Spec.Username, values, maxUsernameLength)
+}
+
+// withOwnershipLabels returns the labels of the spec followed by the ownership marker of the database user
+func withOwnershipLabels(labels []mongodbatlas.Label, dbUser *mdbv1.AtlasDatabaseUser) []mongodbatlas.Label {
+	result := make([]mongodbatlas.Label, 0, len(labels)+4)
+	for _, label := range labels {
+		if !ownership.IsKey(label.Key) {
+			result = append(result, label)
+		}
+	}
+	for _, entry := range ownership.For(dbUser).Entries() {
+		result = append(result, mongodbatlas.Label{Key: entry.Key, Value: entry.Value})
+	}
+
+	return result
+}
+
+// withoutOwnershipLabels returns a copy of the user in Atlas without the labels of the ownership marker
+func withoutOwnershipLabels(atlasUser *mongodbatlas.DatabaseUser) *mongodbatlas.DatabaseUser {
+	result := *atlasUser
+	result.Labels = nil
+	for _, label := range atlasUser.Labels {
+		if !ownership.IsKey(label.Key) {
+			result.Labels = append(result.Labels, label)
+		}
+	}
+
+	return &result
+}
+
+// ownershipMarker returns the ownership marker found in the labels of the user in Atlas
+func ownershipMarker(atlasUser *mongodbatlas.DatabaseUser) (ownership.Marker, bool) {
+	entries := make([]ownership.Entry, 0, len(atlasUser.Labels))
+	for _, label := range atlasUser.Labels {
+		entries = append(entries, ownership.Entry{Key: label.Key, Value: label.Value})
+	}
+
+	return ownership.FromEntries(entries)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/atlas/mongodbatlas"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -17,7 +18,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nametemplate"
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/connectionsecret"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
@@ -122,3 +125,62 @@ func dataForSecret() connectionsecret.ConnectionData {
 		Password:   "m@gick%",
 	}
 }
+
+func TestOwnershipLabels(t *testing.T) {
+	dbUser := mdbv1.NewDBUser("ns", "user", "app", "project")
+
+	t.Run("should stamp the ownership marker after the labels of the spec", func(t *testing.T) {
+		labels := withOwnershipLabels([]mongodbatlas.Label{{Key: "team", Value: "platform"}}, dbUser)
+
+		assert.Equal(t, mongodbatlas.Label{Key: "team", Value: "platform"}, labels[0])
+		marker, ok := ownershipMarker(&mongodbatlas.DatabaseUser{Labels: labels})
+		assert.True(t, ok)
+		assert.True(t, marker.Owns(dbUser))
+	})
+
+	t.Run("should not update a user because of its ownership labels", func(t *testing.T) {
+		dbUser := dbUser.DeepCopy()
+		dbUser.Spec.Labels = []common.LabelSpec{{Key: "team", Value: "platform"}}
+		atlasUser := &atlasDatabaseUser{DatabaseUser: mongodbatlas.DatabaseUser{
+			Username:     "app",
+			DatabaseName: "admin",
+			Labels:       withOwnershipLabels([]mongodbatlas.Label{{Key: "team", Value: "platform"}}, dbUser),
+		}}
+
+		matches, err := userMatchesSpec(zap.S(), atlasUser, dbUser.Spec)
+
+		assert.NoError(t, err)
+		assert.True(t, matches)
+	})
+}
+
+func TestRenderUsername(t *testing.T) {
+	project := mdbv1.NewProject("ns", "project", "Test Project")
+
+	t.Run("should keep a username without a template", func(t *testing.T) {
+		username, err := renderUsername(project, mdbv1.NewDBUser("ns", "user", "app", "project"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "app", username)
+	})
+
+	t.Run("should expand the template with the prefix of the operator", func(t *testing.T) {
+		nametemplate.Prefix = "run42-"
+		defer func() { nametemplate.Prefix = "" }()
+
+		username, err := renderUsername(project, mdbv1.NewDBUser("ns", "user", "{{ .Namespace }}-app", "project"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "run42-ns-app", username)
+	})
+
+	t.Run("should reject an invalid template", func(t *testing.T) {
+		_, err := renderUsername(project, mdbv1.NewDBUser("ns", "user", "{{ .Deployment", "project"))
+
+		assert.ErrorContains(t, err, "invalid name template")
+	})
+}
+
+func newContext(t *testing.T) *workflow.Context {
+	return workflow.NewContext(zaptest.NewLogger(t).Sugar(), []status.Condition{}, context.Background())
+}
@@ -0,0 +1,111 @@
+package atlasdatabaseuser
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/timeutil"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const defaultExpirationWarnBefore = 24 * time.Hour
+
+// ensureUserExpiration sets the deleteAfterDate to apply in Atlas on the user, pushing it back when the user is
+// expiring and its expiration policy auto extends it. An expiring user is reported with the DatabaseUserExpiring
+// condition and a Warning event. It returns the next time the expiration of the user must be checked, the zero time
+// when the user has no deleteAfterDate
+func (r *AtlasDatabaseUserReconciler) ensureUserExpiration(ctx *workflow.Context, dbUser *mdbv1.AtlasDatabaseUser, now time.Time) (time.Time, workflow.Result) {
+	if dbUser.Spec.DeleteAfterDate == "" {
+		ctx.EnsureStatusOption(status.AtlasDatabaseUserDeleteAfterDateOption(""))
+		ctx.UnsetCondition(status.DatabaseUserExpiringType)
+
+		return time.Time{}, workflow.OK()
+	}
+
+	specDeleteAfter, err := timeutil.ParseISO8601(dbUser.Spec.DeleteAfterDate)
+	if err != nil {
+		return time.Time{}, workflow.Terminate(workflow.DatabaseUserInvalidSpec, err.Error()).WithoutRetry()
+	}
+
+	policy := dbUser.Spec.Expiration
+	if policy == nil {
+		policy = &mdbv1.DatabaseUserExpirationSpec{}
+	}
+	warnBefore := defaultExpirationWarnBefore
+	if policy.WarnBefore != nil {
+		warnBefore = policy.WarnBefore.Duration
+	}
+
+	// an extension is kept until the spec sets a later date or the auto extension is disabled
+	deleteAfter := specDeleteAfter
+	if extended, err := timeutil.ParseISO8601(dbUser.Status.DeleteAfterDate); err == nil && policy.AutoExtend != nil && extended.After(deleteAfter) {
+		deleteAfter = extended
+	}
+
+	expiring := !now.Before(deleteAfter.Add(-warnBefore)) && now.Before(deleteAfter)
+	if expiring && policy.AutoExtend != nil {
+		extended, err := extendedDeleteAfterDate(policy, now)
+		if err != nil {
+			return time.Time{}, workflow.Terminate(workflow.DatabaseUserInvalidSpec, err.Error()).WithoutRetry()
+		}
+		if extended.After(deleteAfter) {
+			deleteAfter = extended
+			ctx.Log.Infow("Extended the database user", "deleteAfterDate", deleteAfter)
+			r.EventRecorder.Eventf(dbUser, "Normal", "DatabaseUserExtended", "The deleteAfterDate of the user was extended to %s", timeutil.FormatISO8601(deleteAfter.UTC()))
+		}
+		expiring = !now.Before(deleteAfter.Add(-warnBefore))
+	}
+
+	dbUser.Spec.DeleteAfterDate = timeutil.FormatISO8601(deleteAfter.UTC())
+	if deleteAfter.Equal(specDeleteAfter) {
+		ctx.EnsureStatusOption(status.AtlasDatabaseUserDeleteAfterDateOption(""))
+	} else {
+		ctx.EnsureStatusOption(status.AtlasDatabaseUserDeleteAfterDateOption(dbUser.Spec.DeleteAfterDate))
+	}
+
+	if !expiring {
+		ctx.UnsetCondition(status.DatabaseUserExpiringType)
+
+		return deleteAfter.Add(-warnBefore), workflow.OK()
+	}
+
+	condition := status.TrueCondition(status.DatabaseUserExpiringType).WithReason(string(workflow.DatabaseUserCloseToExpiry))
+	condition.Message = fmt.Sprintf("the database user expires on %s and will be deleted from Atlas", dbUser.Spec.DeleteAfterDate)
+	if !hasCondition(dbUser.Status.Conditions, status.DatabaseUserExpiringType) {
+		r.EventRecorder.Event(dbUser, "Warning", condition.Reason, condition.Message)
+	}
+	ctx.EnsureCondition(condition)
+
+	return deleteAfter, workflow.OK()
+}
+
+// extendedDeleteAfterDate returns the deleteAfterDate an expiring user is extended to, bounded by the extendUntil date
+// of its policy
+func extendedDeleteAfterDate(policy *mdbv1.DatabaseUserExpirationSpec, now time.Time) (time.Time, error) {
+	extended := now.Add(policy.AutoExtend.Duration).Truncate(time.Second)
+	if policy.ExtendUntil == "" {
+		return extended, nil
+	}
+
+	until, err := timeutil.ParseISO8601(policy.ExtendUntil)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid extendUntil %q: %w", policy.ExtendUntil, err)
+	}
+	if extended.After(until) {
+		return until, nil
+	}
+
+	return extended, nil
+}
+
+func hasCondition(conditions []status.Condition, conditionType status.ConditionType) bool {
+	for _, condition := range conditions {
+		if condition.Type == conditionType {
+			return true
+		}
+	}
+
+	return false
+}
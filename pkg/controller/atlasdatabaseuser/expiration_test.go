@@ -0,0 +1,145 @@
+package atlasdatabaseuser
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestEnsureUserExpiration(t *testing.T) {
+	now := time.Date(2024, 1, 10, 10, 0, 0, 0, time.UTC)
+	newReconciler := func() *AtlasDatabaseUserReconciler {
+		return &AtlasDatabaseUserReconciler{EventRecorder: record.NewFakeRecorder(10)}
+	}
+	temporaryUser := func(deleteAfterDate string, expiration *mdbv1.DatabaseUserExpirationSpec) *mdbv1.AtlasDatabaseUser {
+		return &mdbv1.AtlasDatabaseUser{
+			ObjectMeta: metav1.ObjectMeta{Name: "user", Namespace: "default"},
+			Spec: mdbv1.AtlasDatabaseUserSpec{
+				Username:        "user",
+				DeleteAfterDate: deleteAfterDate,
+				Expiration:      expiration,
+			},
+		}
+	}
+	events := func(r *AtlasDatabaseUserReconciler) chan string {
+		return r.EventRecorder.(*record.FakeRecorder).Events
+	}
+
+	t.Run("should check the user again once it's expiring", func(t *testing.T) {
+		r := newReconciler()
+		workflowCtx := newContext(t)
+		dbUser := temporaryUser("2024-01-13T10:00:00Z", nil)
+
+		check, result := r.ensureUserExpiration(workflowCtx, dbUser, now)
+
+		assert.True(t, result.IsOk())
+		assert.Equal(t, time.Date(2024, 1, 12, 10, 0, 0, 0, time.UTC), check)
+		assert.Empty(t, events(r))
+		dbUser.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+		assert.Empty(t, dbUser.Status.Conditions)
+	})
+
+	t.Run("should warn once the user is close to expiry", func(t *testing.T) {
+		r := newReconciler()
+		workflowCtx := newContext(t)
+		dbUser := temporaryUser("2024-01-11T08:00:00Z", nil)
+
+		check, result := r.ensureUserExpiration(workflowCtx, dbUser, now)
+
+		assert.True(t, result.IsOk())
+		assert.Equal(t, time.Date(2024, 1, 11, 8, 0, 0, 0, time.UTC), check)
+		condition := workflowCtx.Conditions()[0]
+		assert.Equal(t, status.DatabaseUserExpiringType, condition.Type)
+		assert.Equal(t, corev1.ConditionTrue, condition.Status)
+		assert.Equal(t, string(workflow.DatabaseUserCloseToExpiry), condition.Reason)
+		require.Len(t, events(r), 1)
+		assert.Contains(t, <-events(r), "Warning DatabaseUserCloseToExpiry")
+	})
+
+	t.Run("should warn only once", func(t *testing.T) {
+		r := newReconciler()
+		dbUser := temporaryUser("2024-01-11T08:00:00Z", nil)
+		dbUser.Status.Conditions = []status.Condition{status.TrueCondition(status.DatabaseUserExpiringType)}
+
+		r.ensureUserExpiration(newContext(t), dbUser, now)
+
+		assert.Empty(t, events(r))
+	})
+
+	t.Run("should extend an expiring user", func(t *testing.T) {
+		r := newReconciler()
+		workflowCtx := newContext(t)
+		dbUser := temporaryUser("2024-01-11T08:00:00Z", &mdbv1.DatabaseUserExpirationSpec{AutoExtend: &metav1.Duration{Duration: 72 * time.Hour}})
+
+		check, result := r.ensureUserExpiration(workflowCtx, dbUser, now)
+
+		assert.True(t, result.IsOk())
+		assert.Equal(t, "2024-01-13T10:00:00Z", dbUser.Spec.DeleteAfterDate)
+		assert.Equal(t, time.Date(2024, 1, 12, 10, 0, 0, 0, time.UTC), check)
+		require.Len(t, events(r), 1)
+		assert.Contains(t, <-events(r), "Normal DatabaseUserExtended")
+		dbUser.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+		assert.Equal(t, "2024-01-13T10:00:00Z", dbUser.Status.DeleteAfterDate)
+		assert.Empty(t, dbUser.Status.Conditions)
+	})
+
+	t.Run("should keep the extension of the user", func(t *testing.T) {
+		r := newReconciler()
+		dbUser := temporaryUser("2024-01-11T08:00:00Z", &mdbv1.DatabaseUserExpirationSpec{AutoExtend: &metav1.Duration{Duration: 72 * time.Hour}})
+		dbUser.Status.DeleteAfterDate = "2024-01-13T08:00:00Z"
+
+		_, result := r.ensureUserExpiration(newContext(t), dbUser, now)
+
+		assert.True(t, result.IsOk())
+		assert.Equal(t, "2024-01-13T08:00:00Z", dbUser.Spec.DeleteAfterDate)
+		assert.Empty(t, events(r))
+	})
+
+	t.Run("should stop extending the user at its extendUntil date", func(t *testing.T) {
+		r := newReconciler()
+		workflowCtx := newContext(t)
+		dbUser := temporaryUser("2024-01-11T08:00:00Z", &mdbv1.DatabaseUserExpirationSpec{
+			AutoExtend:  &metav1.Duration{Duration: 72 * time.Hour},
+			ExtendUntil: "2024-01-11T09:00:00Z",
+		})
+
+		check, result := r.ensureUserExpiration(workflowCtx, dbUser, now)
+
+		assert.True(t, result.IsOk())
+		assert.Equal(t, "2024-01-11T09:00:00Z", dbUser.Spec.DeleteAfterDate)
+		assert.Equal(t, time.Date(2024, 1, 11, 9, 0, 0, 0, time.UTC), check)
+		require.Len(t, events(r), 2)
+		assert.Contains(t, <-events(r), "Normal DatabaseUserExtended")
+		assert.Contains(t, <-events(r), "Warning DatabaseUserCloseToExpiry")
+	})
+
+	t.Run("should forget the expiration of a permanent user", func(t *testing.T) {
+		r := newReconciler()
+		workflowCtx := workflow.NewContext(
+			zaptest.NewLogger(t).Sugar(),
+			[]status.Condition{status.TrueCondition(status.DatabaseUserExpiringType)},
+			context.Background(),
+		)
+		dbUser := temporaryUser("", nil)
+		dbUser.Status.DeleteAfterDate = "2024-01-13T08:00:00Z"
+
+		check, result := r.ensureUserExpiration(workflowCtx, dbUser, now)
+
+		assert.True(t, result.IsOk())
+		assert.True(t, check.IsZero())
+		dbUser.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+		assert.Empty(t, dbUser.Status.DeleteAfterDate)
+		assert.Empty(t, dbUser.Status.Conditions)
+	})
+}
@@ -0,0 +1,118 @@
+package atlasdatabaseuser
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/stringutil"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/timeutil"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const defaultRotatedPasswordLength = 32
+
+// ensurePasswordRotation writes a new password to the password Secret of the user once its rotation is due. The user
+// in Atlas and the connection Secrets are then updated with it as for any other change of the password. The first
+// rotation is scheduled from the creation of the password Secret. It returns the state of the rotation, nil when the
+// password isn't rotated
+func (r *AtlasDatabaseUserReconciler) ensurePasswordRotation(ctx *workflow.Context, dbUser *mdbv1.AtlasDatabaseUser, now time.Time) (*status.PasswordRotationStatus, workflow.Result) {
+	rotation := dbUser.Spec.PasswordRotation
+	if rotation == nil {
+		if dbUser.Status.PasswordRotation != nil {
+			ctx.EnsureStatusOption(status.AtlasDatabaseUserPasswordRotationOption(nil))
+		}
+
+		return nil, workflow.OK()
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Client.Get(ctx.Context, *dbUser.PasswordSecretObjectKey(), secret); err != nil {
+		return nil, workflow.Terminate(workflow.DatabaseUserPasswordNotRotated, err.Error())
+	}
+
+	current := status.PasswordRotationStatus{}
+	if dbUser.Status.PasswordRotation != nil {
+		current = *dbUser.Status.PasswordRotation
+	}
+	last := secret.CreationTimestamp.Time
+	if current.LastRotation != nil {
+		last = current.LastRotation.Time
+	}
+	if last.IsZero() {
+		last = now
+	}
+
+	next, err := nextPasswordRotation(rotation, last)
+	if err != nil {
+		return nil, workflow.Terminate(workflow.DatabaseUserInvalidSpec, err.Error()).WithoutRetry()
+	}
+	if next.IsZero() || now.Before(next) {
+		current.NextRotation = metav1.NewTime(next)
+		ctx.EnsureStatusOption(status.AtlasDatabaseUserPasswordRotationOption(&current))
+
+		return &current, workflow.OK()
+	}
+
+	length := defaultRotatedPasswordLength
+	if rotation.PasswordLength > 0 {
+		length = rotation.PasswordLength
+	}
+	password, err := stringutil.GeneratePassword(length)
+	if err != nil {
+		return nil, workflow.Terminate(workflow.DatabaseUserPasswordNotRotated, err.Error())
+	}
+
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data["password"] = []byte(password)
+	if err = r.Client.Update(ctx.Context, secret); err != nil {
+		return nil, workflow.Terminate(workflow.DatabaseUserPasswordNotRotated, fmt.Sprintf("failed to write the new password: %s", err))
+	}
+
+	// the rotation is due so the schedule is valid
+	next, _ = nextPasswordRotation(rotation, now)
+	rotatedAt := metav1.NewTime(now)
+	current = status.PasswordRotationStatus{
+		LastRotation:             &rotatedAt,
+		NextRotation:             metav1.NewTime(next),
+		ConnectionSecretsPending: true,
+	}
+	ctx.EnsureStatusOption(status.AtlasDatabaseUserPasswordRotationOption(&current))
+	ctx.Log.Infow("Rotated the password of the database user", "secret", secret.Name, "nextRotation", next)
+
+	return &current, workflow.OK()
+}
+
+// reportPasswordRotation emits the PasswordRotated event once all the connection Secrets hold the rotated password,
+// so that their consumers can restart
+func (r *AtlasDatabaseUserReconciler) reportPasswordRotation(ctx *workflow.Context, dbUser *mdbv1.AtlasDatabaseUser, rotation *status.PasswordRotationStatus) {
+	if rotation == nil || !rotation.ConnectionSecretsPending {
+		return
+	}
+
+	done := *rotation
+	done.ConnectionSecretsPending = false
+	ctx.EnsureStatusOption(status.AtlasDatabaseUserPasswordRotationOption(&done))
+	r.EventRecorder.Event(dbUser, "Normal", "PasswordRotated", "The password was rotated and all the connection Secrets were updated")
+}
+
+// nextPasswordRotation returns the time of the first rotation after the given one, or the zero time when the schedule
+// never happens
+func nextPasswordRotation(rotation *mdbv1.PasswordRotationSpec, after time.Time) (time.Time, error) {
+	if rotation.Interval != nil {
+		return after.Add(rotation.Interval.Duration), nil
+	}
+
+	schedule, err := timeutil.ParseCron(rotation.Schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return schedule.Next(after.UTC()), nil
+}
@@ -0,0 +1,136 @@
+package atlasdatabaseuser
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+func TestEnsurePasswordRotation(t *testing.T) {
+	now := time.Date(2024, 1, 10, 10, 0, 0, 0, time.UTC)
+	created := metav1.NewTime(now.AddDate(0, 0, -20))
+	newReconciler := func(t *testing.T, objects ...client.Object) *AtlasDatabaseUserReconciler {
+		sch := runtime.NewScheme()
+		require.NoError(t, mdbv1.AddToScheme(sch))
+		require.NoError(t, corev1.AddToScheme(sch))
+
+		return &AtlasDatabaseUserReconciler{
+			Client:        fake.NewClientBuilder().WithScheme(sch).WithObjects(objects...).Build(),
+			Scheme:        sch,
+			EventRecorder: record.NewFakeRecorder(10),
+		}
+	}
+	passwordSecret := func() *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "user-password", Namespace: "default", CreationTimestamp: created},
+			Data:       map[string][]byte{"password": []byte("initial")},
+		}
+	}
+	rotatedUser := func(rotation mdbv1.PasswordRotationSpec) *mdbv1.AtlasDatabaseUser {
+		return &mdbv1.AtlasDatabaseUser{
+			ObjectMeta: metav1.ObjectMeta{Name: "user", Namespace: "default"},
+			Spec: mdbv1.AtlasDatabaseUserSpec{
+				Username:         "user",
+				PasswordSecret:   &common.ResourceRef{Name: "user-password"},
+				PasswordRotation: &rotation,
+			},
+		}
+	}
+	readPassword := func(t *testing.T, r *AtlasDatabaseUserReconciler) string {
+		secret := &corev1.Secret{}
+		require.NoError(t, r.Client.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "user-password"}, secret))
+
+		return string(secret.Data["password"])
+	}
+
+	t.Run("should schedule the first rotation from the creation of the password Secret", func(t *testing.T) {
+		r := newReconciler(t, passwordSecret())
+		workflowCtx := newContext(t)
+		dbUser := rotatedUser(mdbv1.PasswordRotationSpec{Interval: &metav1.Duration{Duration: 30 * 24 * time.Hour}})
+
+		rotation, result := r.ensurePasswordRotation(workflowCtx, dbUser, now)
+
+		assert.True(t, result.IsOk())
+		assert.Equal(t, "initial", readPassword(t, r))
+		assert.Nil(t, rotation.LastRotation)
+		assert.True(t, created.AddDate(0, 0, 30).Equal(rotation.NextRotation.Time))
+		dbUser.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+		assert.Equal(t, rotation, dbUser.Status.PasswordRotation)
+	})
+
+	t.Run("should write a new password once the rotation is due", func(t *testing.T) {
+		r := newReconciler(t, passwordSecret())
+		workflowCtx := newContext(t)
+		dbUser := rotatedUser(mdbv1.PasswordRotationSpec{Interval: &metav1.Duration{Duration: 7 * 24 * time.Hour}, PasswordLength: 40})
+
+		rotation, result := r.ensurePasswordRotation(workflowCtx, dbUser, now)
+
+		assert.True(t, result.IsOk())
+		password := readPassword(t, r)
+		assert.Len(t, password, 40)
+		assert.NotEqual(t, "initial", password)
+		rotatedAt := metav1.NewTime(now)
+		assert.Equal(
+			t,
+			&status.PasswordRotationStatus{LastRotation: &rotatedAt, NextRotation: metav1.NewTime(now.AddDate(0, 0, 7)), ConnectionSecretsPending: true},
+			rotation,
+		)
+	})
+
+	t.Run("should rotate the password on a cron schedule", func(t *testing.T) {
+		r := newReconciler(t, passwordSecret())
+		dbUser := rotatedUser(mdbv1.PasswordRotationSpec{Schedule: "0 3 1 * *"})
+		lastRotation := metav1.NewTime(time.Date(2023, 12, 1, 3, 0, 0, 0, time.UTC))
+		dbUser.Status.PasswordRotation = &status.PasswordRotationStatus{LastRotation: &lastRotation}
+
+		rotation, result := r.ensurePasswordRotation(newContext(t), dbUser, now)
+
+		assert.True(t, result.IsOk())
+		assert.Len(t, readPassword(t, r), defaultRotatedPasswordLength)
+		assert.Equal(t, time.Date(2024, 2, 1, 3, 0, 0, 0, time.UTC), rotation.NextRotation.Time)
+		assert.True(t, rotation.ConnectionSecretsPending)
+	})
+
+	t.Run("should report the rotation once the connection Secrets are updated", func(t *testing.T) {
+		r := newReconciler(t)
+		workflowCtx := newContext(t)
+		dbUser := rotatedUser(mdbv1.PasswordRotationSpec{Schedule: "0 3 1 * *"})
+		rotatedAt := metav1.NewTime(now)
+
+		r.reportPasswordRotation(workflowCtx, dbUser, &status.PasswordRotationStatus{LastRotation: &rotatedAt, ConnectionSecretsPending: true})
+
+		dbUser.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+		assert.False(t, dbUser.Status.PasswordRotation.ConnectionSecretsPending)
+		events := r.EventRecorder.(*record.FakeRecorder).Events
+		require.Len(t, events, 1)
+		assert.Contains(t, <-events, "PasswordRotated")
+	})
+
+	t.Run("should forget the rotation once it's disabled", func(t *testing.T) {
+		r := newReconciler(t, passwordSecret())
+		workflowCtx := newContext(t)
+		dbUser := rotatedUser(mdbv1.PasswordRotationSpec{})
+		dbUser.Spec.PasswordRotation = nil
+		dbUser.Status.PasswordRotation = &status.PasswordRotationStatus{}
+
+		rotation, result := r.ensurePasswordRotation(workflowCtx, dbUser, now)
+
+		assert.True(t, result.IsOk())
+		assert.Nil(t, rotation)
+		dbUser.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+		assert.Nil(t, dbUser.Status.PasswordRotation)
+	})
+}
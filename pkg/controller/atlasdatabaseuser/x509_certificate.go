@@ -0,0 +1,110 @@
+package atlasdatabaseuser
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// X509CertificateKey is the key of the Secret holding the certificate issued by Atlas and its private key
+const X509CertificateKey = "certificate.pem"
+
+// ensureX509Certificate issues the certificate of a MANAGED X.509 user in Atlas and writes it to a Secret owned by the
+// user. A new certificate is issued when the Secret is missing or moved, and before the current one expires. The
+// Secret is removed once the user no longer authenticates with a certificate issued by Atlas. The time the current
+// certificate must be renewed at is returned, for the user to be reconciled then
+func (r *AtlasDatabaseUserReconciler) ensureX509Certificate(ctx *workflow.Context, projectID string, dbUser *mdbv1.AtlasDatabaseUser, now time.Time) (time.Time, workflow.Result) {
+	current := dbUser.Status.X509Certificate
+	if dbUser.Spec.X509Type != mdbv1.X509TypeManaged {
+		if current == nil {
+			return time.Time{}, workflow.OK()
+		}
+		if err := r.deleteX509CertificateSecret(ctx, dbUser.Namespace, current.SecretName); err != nil {
+			return time.Time{}, workflow.Terminate(workflow.DatabaseUserX509CertificateNotIssued, fmt.Sprintf("failed to remove the certificate Secret: %s", err))
+		}
+		ctx.EnsureStatusOption(status.AtlasDatabaseUserX509CertificateOption(nil))
+
+		return time.Time{}, workflow.OK()
+	}
+
+	key := dbUser.X509CertificateSecretObjectKey()
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+	err := r.Client.Get(ctx.Context, key, secret)
+	if err != nil && !apiErrors.IsNotFound(err) {
+		return time.Time{}, workflow.Terminate(workflow.DatabaseUserX509CertificateNotIssued, err.Error())
+	}
+
+	months, renewBefore := dbUser.X509CertificateSettings()
+	if err == nil && current != nil && current.SecretName == key.Name && now.Before(current.NotAfter.Add(-renewBefore)) {
+		return current.NotAfter.Add(-renewBefore), workflow.OK()
+	}
+
+	certificate, _, err := ctx.Client.X509AuthDBUsers.CreateUserCertificate(ctx.Context, projectID, dbUser.Spec.Username, months)
+	if err != nil {
+		return time.Time{}, workflow.Terminate(workflow.DatabaseUserX509CertificateNotIssued, err.Error()).WithAtlasError(err)
+	}
+
+	issued, err := parseX509Certificate(certificate.Certificate)
+	if err != nil {
+		return time.Time{}, workflow.Terminate(workflow.DatabaseUserX509CertificateNotIssued, err.Error())
+	}
+
+	_, err = controllerutil.CreateOrUpdate(ctx.Context, r.Client, secret, func() error {
+		secret.Data = map[string][]byte{X509CertificateKey: []byte(certificate.Certificate)}
+
+		return controllerutil.SetControllerReference(dbUser, secret, r.Scheme)
+	})
+	if err != nil {
+		return time.Time{}, workflow.Terminate(workflow.DatabaseUserX509CertificateNotIssued, fmt.Sprintf("failed to write the certificate Secret: %s", err))
+	}
+
+	if current != nil && current.SecretName != key.Name {
+		if err = r.deleteX509CertificateSecret(ctx, dbUser.Namespace, current.SecretName); err != nil {
+			return time.Time{}, workflow.Terminate(workflow.DatabaseUserX509CertificateNotIssued, fmt.Sprintf("failed to remove the previous certificate Secret: %s", err))
+		}
+	}
+
+	ctx.EnsureStatusOption(status.AtlasDatabaseUserX509CertificateOption(&status.X509CertificateStatus{
+		SecretName:   key.Name,
+		SerialNumber: issued.SerialNumber.String(),
+		NotAfter:     metav1.NewTime(issued.NotAfter),
+	}))
+	ctx.Log.Infow("Issued the X.509 certificate of the database user", "secret", key, "notAfter", issued.NotAfter)
+	r.EventRecorder.Eventf(dbUser, "Normal", "X509CertificateIssued", "The X.509 certificate expiring on %s was written to the Secret %s", issued.NotAfter.Format(time.RFC3339), key.Name)
+
+	return issued.NotAfter.Add(-renewBefore), workflow.OK()
+}
+
+func (r *AtlasDatabaseUserReconciler) deleteX509CertificateSecret(ctx *workflow.Context, namespace, name string) error {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+
+	return client.IgnoreNotFound(r.Client.Delete(ctx.Context, secret))
+}
+
+// parseX509Certificate reads the certificate of the PEM returned by Atlas, which holds its private key as well
+func parseX509Certificate(data string) (*x509.Certificate, error) {
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, errors.New("the PEM returned by Atlas holds no certificate")
+		}
+
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package atlasdatabaseuser
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestEnsureX509Certificate(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	notAfter := now.AddDate(0, 3, 0).Truncate(time.Second)
+	newReconciler := func(t *testing.T, objects ...client.Object) *AtlasDatabaseUserReconciler {
+		sch := runtime.NewScheme()
+		require.NoError(t, mdbv1.AddToScheme(sch))
+		require.NoError(t, corev1.AddToScheme(sch))
+
+		return &AtlasDatabaseUserReconciler{
+			Client:        fake.NewClientBuilder().WithScheme(sch).WithObjects(objects...).Build(),
+			Scheme:        sch,
+			EventRecorder: record.NewFakeRecorder(10),
+		}
+	}
+	newContext := func(t *testing.T, users *atlas.X509AuthDBUsersClientMock) *workflow.Context {
+		return &workflow.Context{
+			Client:  &mongodbatlas.Client{X509AuthDBUsers: users},
+			Context: context.Background(),
+			Log:     zaptest.NewLogger(t).Sugar(),
+		}
+	}
+	managedUser := func() *mdbv1.AtlasDatabaseUser {
+		return &mdbv1.AtlasDatabaseUser{
+			ObjectMeta: metav1.ObjectMeta{Name: "user", Namespace: "default", UID: "user-uid"},
+			Spec: mdbv1.AtlasDatabaseUserSpec{
+				Username:     "CN=app",
+				DatabaseName: mdbv1.ExternalDatabaseName,
+				X509Type:     mdbv1.X509TypeManaged,
+			},
+		}
+	}
+	issuingUsers := func(t *testing.T) *atlas.X509AuthDBUsersClientMock {
+		return &atlas.X509AuthDBUsersClientMock{
+			CreateUserCertificateFunc: func(projectID, username string, months int) (*mongodbatlas.UserCertificate, *mongodbatlas.Response, error) {
+				return &mongodbatlas.UserCertificate{Username: username, Certificate: testCertificatePEM(t, notAfter)}, nil, nil
+			},
+		}
+	}
+
+	t.Run("should issue the certificate and write it to a Secret", func(t *testing.T) {
+		r := newReconciler(t)
+		users := issuingUsers(t)
+		workflowCtx := newContext(t, users)
+
+		renewal, result := r.ensureX509Certificate(workflowCtx, "project-id", managedUser(), now)
+
+		assert.True(t, result.IsOk())
+		assert.True(t, notAfter.Add(-mdbv1.DefaultX509RenewBefore).Equal(renewal))
+		assert.Equal(t, map[string]int{"project-id.CN=app": 3}, users.CreateUserCertificateRequests)
+		secret := &corev1.Secret{}
+		require.NoError(t, r.Client.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "user-x509"}, secret))
+		assert.Contains(t, string(secret.Data[X509CertificateKey]), "-----BEGIN CERTIFICATE-----")
+		assert.Equal(t, "user", secret.OwnerReferences[0].Name)
+		dbUser := &mdbv1.AtlasDatabaseUser{}
+		dbUser.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+		assert.Equal(t, "user-x509", dbUser.Status.X509Certificate.SecretName)
+		assert.True(t, notAfter.Equal(dbUser.Status.X509Certificate.NotAfter.Time))
+	})
+
+	t.Run("should keep the certificate until it must be renewed", func(t *testing.T) {
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "user-x509", Namespace: "default"}}
+		r := newReconciler(t, secret)
+		users := issuingUsers(t)
+		dbUser := managedUser()
+		dbUser.Status.X509Certificate = &status.X509CertificateStatus{SecretName: "user-x509", NotAfter: metav1.NewTime(now.Add(31 * 24 * time.Hour))}
+
+		renewal, result := r.ensureX509Certificate(newContext(t, users), "project-id", dbUser, now)
+
+		assert.True(t, result.IsOk())
+		assert.True(t, now.Add(24*time.Hour).Equal(renewal))
+		assert.Empty(t, users.CreateUserCertificateRequests)
+	})
+
+	t.Run("should renew the certificate before it expires", func(t *testing.T) {
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "user-x509", Namespace: "default"}}
+		r := newReconciler(t, secret)
+		users := issuingUsers(t)
+		dbUser := managedUser()
+		dbUser.Status.X509Certificate = &status.X509CertificateStatus{SecretName: "user-x509", NotAfter: metav1.NewTime(now.Add(29 * 24 * time.Hour))}
+
+		_, result := r.ensureX509Certificate(newContext(t, users), "project-id", dbUser, now)
+
+		assert.True(t, result.IsOk())
+		assert.Len(t, users.CreateUserCertificateRequests, 1)
+	})
+
+	t.Run("should remove the certificate of a user no longer managed by Atlas", func(t *testing.T) {
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "user-x509", Namespace: "default"}}
+		r := newReconciler(t, secret)
+		dbUser := managedUser()
+		dbUser.Spec.X509Type = mdbv1.X509TypeCustomer
+		dbUser.Status.X509Certificate = &status.X509CertificateStatus{SecretName: "user-x509"}
+		workflowCtx := newContext(t, &atlas.X509AuthDBUsersClientMock{})
+
+		_, result := r.ensureX509Certificate(workflowCtx, "project-id", dbUser, now)
+
+		assert.True(t, result.IsOk())
+		err := r.Client.Get(context.Background(), client.ObjectKeyFromObject(secret), &corev1.Secret{})
+		assert.True(t, apiErrors.IsNotFound(err))
+		dbUser.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+		assert.Nil(t, dbUser.Status.X509Certificate)
+	})
+}
+
+func testCertificatePEM(t *testing.T, notAfter time.Time) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(42),
+		Subject:      pkix.Name{CommonName: "app"},
+		NotBefore:    notAfter.AddDate(0, -3, 0),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})) +
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
@@ -7,7 +7,6 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/stringutil"
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/connectionsecret"
@@ -23,7 +22,7 @@ func (r *AtlasDataFederationReconciler) ensureConnectionSecrets(ctx *workflow.Co
 
 	atlasDF, _, err := ctx.Client.DataFederation.Get(ctx.Context, project.ID(), df.Spec.Name)
 	if err != nil {
-		return workflow.Terminate(workflow.Internal, err.Error())
+		return workflow.Terminate(workflow.Internal, err.Error()).WithCause(dataFederationConnectionReconciler, "getFederatedDatabase")
 	}
 
 	connectionHosts := atlasDF.Hostnames
@@ -49,25 +48,49 @@ func (r *AtlasDataFederationReconciler) ensureConnectionSecrets(ctx *workflow.Co
 			continue
 		}
 
-		scopes := dbUser.GetScopes(mdbv1.DeploymentScopeType)
-		if len(scopes) != 0 && !stringutil.Contains(scopes, df.Spec.Name) {
+		if !dbUser.HasConnectionSecret(df.Spec.Name) {
+			continue
+		}
+
+		if dbUser.Spec.ConnectionSecretStore != nil {
+			// the connection credentials published to an external secret store are written and tracked by the
+			// AtlasDatabaseUser controller
 			continue
 		}
 
-		password, err := dbUser.ReadPassword(ctx.Context, r.Client)
+		password, _, err := r.SecretStores.ReadPassword(ctx.Context, r.Client, &dbUser)
 		if err != nil {
 			return workflow.Terminate(workflow.DeploymentConnectionSecretsNotCreated, err.Error())
 		}
 
 		var connURLs []string
 		for _, host := range connectionHosts {
-			connURLs = append(connURLs, fmt.Sprintf("mongodb://%s:%s@%s?ssl=true", dbUser.Spec.Username, password, host))
+			connURLs = append(connURLs, fmt.Sprintf("mongodb://%s:%s@%s?ssl=true", dbUser.AtlasUsername(), password, host))
+		}
+		connURL := strings.Join(connURLs, ",")
+		if dbUser.ExternalAuthMechanism() != "" {
+			// the users authenticated outside of MongoDB have no credentials in the connection string, the authentication mechanism is added
+			// to a single connection string listing all the hosts
+			connURL = fmt.Sprintf("mongodb://%s/?ssl=true", strings.Join(connectionHosts, ","))
+		}
+
+		policy, err := connectionsecret.UserConnectionStringPolicy(ctx.Context, r.Client, &dbUser)
+		if err != nil {
+			return workflow.Terminate(workflow.DeploymentConnectionSecretsNotCreated, err.Error())
+		}
+		store, err := connectionsecret.UserStore(r.SecretStores, &dbUser)
+		if err != nil {
+			return workflow.Terminate(workflow.DeploymentConnectionSecretsNotCreated, err.Error())
 		}
 
 		data := connectionsecret.ConnectionData{
-			DBUserName: dbUser.Spec.Username,
-			Password:   password,
-			ConnURL:    strings.Join(connURLs, ","),
+			DBUserName:    dbUser.AtlasUsername(),
+			Password:      password,
+			ConnURL:       connURL,
+			Metadata:      connectionsecret.ResourceMetadata(r.ConnectionSecretMetadata, nil, &dbUser),
+			AuthMechanism: dbUser.ExternalAuthMechanism(),
+			Policy:        policy,
+			Store:         store,
 		}
 
 		ctx.Log.Debugw("Creating a connection Secret", "data", data)
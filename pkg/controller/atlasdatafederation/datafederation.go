@@ -15,6 +15,12 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
 
+const (
+	dataFederationReconciler                = "dataFederation"
+	dataFederationPrivateEndpointReconciler = "dataFederationPrivateEndpoint"
+	dataFederationConnectionReconciler      = "dataFederationConnectionSecrets"
+)
+
 func (r *AtlasDataFederationReconciler) ensureDataFederation(ctx *workflow.Context, project *mdbv1.AtlasProject, dataFederation *mdbv1.AtlasDataFederation) workflow.Result {
 	log := ctx.Log
 
@@ -29,16 +35,16 @@ func (r *AtlasDataFederationReconciler) ensureDataFederation(ctx *workflow.Conte
 	atlasSpec, resp, err := ctx.Client.DataFederation.Get(ctx.Context, projectID, operatorSpec.Name)
 	if err != nil {
 		if resp == nil {
-			return workflow.Terminate(workflow.Internal, err.Error())
+			return workflow.Terminate(workflow.Internal, err.Error()).WithCause(dataFederationReconciler, "getFederatedDatabase")
 		}
 
 		if resp.StatusCode != http.StatusNotFound {
-			return workflow.Terminate(workflow.DataFederationNotCreatedInAtlas, err.Error())
+			return workflow.Terminate(workflow.DataFederationNotCreatedInAtlas, err.Error()).WithCause(dataFederationReconciler, "getFederatedDatabase")
 		}
 
 		_, _, err = ctx.Client.DataFederation.Create(ctx.Context, projectID, dataFederationToAtlas)
 		if err != nil {
-			return workflow.Terminate(workflow.DataFederationNotCreatedInAtlas, err.Error())
+			return workflow.Terminate(workflow.DataFederationNotCreatedInAtlas, err.Error()).WithCause(dataFederationReconciler, "createFederatedDatabase")
 		}
 
 		return workflow.InProgress(workflow.DataFederationCreating, "Data Federation is being created")
@@ -49,13 +55,13 @@ func (r *AtlasDataFederationReconciler) ensureDataFederation(ctx *workflow.Conte
 		return workflow.Terminate(workflow.Internal, "can not convert DataFederation (atlas -> operator)")
 	}
 
-	if areEqual, _ := dataFederationEqual(*dfFromAtlas, *operatorSpec, log); areEqual {
+	if areEqual, _ := dataFederationEqual(*dfFromAtlas, *operatorSpec, log); areEqual && !ctx.Reapply {
 		return workflow.OK()
 	}
 
 	_, _, err = ctx.Client.DataFederation.Update(ctx.Context, projectID, dataFederation.Spec.Name, dataFederationToAtlas, nil)
 	if err != nil {
-		return workflow.Terminate(workflow.DataFederationNotUpdatedInAtlas, err.Error())
+		return workflow.Terminate(workflow.DataFederationNotUpdatedInAtlas, err.Error()).WithCause(dataFederationReconciler, "updateFederatedDatabase")
 	}
 
 	return workflow.InProgress(workflow.DataFederationUpdating, "Data Federation is being updated")
@@ -26,6 +26,8 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/notifier"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/secretstore"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/statushandler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/watch"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
@@ -39,9 +41,14 @@ type AtlasDataFederationReconciler struct {
 	Scheme                      *runtime.Scheme
 	GlobalPredicates            []predicate.Predicate
 	EventRecorder               record.EventRecorder
+	FailureNotifier             *notifier.Notifier
 	AtlasProvider               atlas.Provider
 	ObjectDeletionProtection    bool
 	SubObjectDeletionProtection bool
+	// ConnectionSecretMetadata holds the labels and annotations added to all the connection Secrets
+	ConnectionSecretMetadata connectionsecret.Metadata
+	// SecretStores are the external secret stores the passwords of the database users can be read from
+	SecretStores secretstore.Registry
 }
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasdatafederations,verbs=get;list;watch;create;update;patch;delete
@@ -50,7 +57,7 @@ type AtlasDataFederationReconciler struct {
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasdatafederations/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
-func (r *AtlasDataFederationReconciler) Reconcile(context context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *AtlasDataFederationReconciler) Reconcile(context context.Context, req ctrl.Request) (res ctrl.Result, _ error) {
 	log := r.Log.With("atlasdatafederation", req.NamespacedName)
 
 	dataFederation := &mdbv1.AtlasDataFederation{}
@@ -72,9 +79,25 @@ func (r *AtlasDataFederationReconciler) Reconcile(context context.Context, req c
 		return workflow.OK().ReconcileResult(), nil
 	}
 
+	if customresource.NamespaceReconciliationPaused(context, r.Client, dataFederation.Namespace, log) {
+		log.Infow(fmt.Sprintf("-> Pausing AtlasDataFederation reconciliation as annotation %s=true is set on the namespace", customresource.ReconciliationPausedAnnotation))
+		return customresource.MarkReconciliationPaused(context, r.Client, r.EventRecorder, dataFederation, log).ReconcileResult(), nil
+	}
+
 	ctx := customresource.MarkReconciliationStarted(r.Client, dataFederation, log, context)
 	log.Infow("-> Starting AtlasDataFederation reconciliation", "spec", dataFederation.Spec, "status", dataFederation.Status)
-	defer statushandler.Update(ctx, r.Client, r.EventRecorder, dataFederation)
+	if ctx.Degraded(dataFederation) {
+		log.Infow("-> Skipping the reconciliation of the degraded AtlasDataFederation, change its spec or set the reapply annotation to retry")
+		return ctrl.Result{}, nil
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			res = ctx.RecoverPanic("AtlasDataFederation", p).ReconcileResult()
+		}
+		res = ctx.CapRetries("AtlasDataFederation", dataFederation, res)
+		statushandler.Update(ctx, r.Client, r.EventRecorder, r.FailureNotifier, dataFederation)
+	}()
 
 	resourceVersionIsValid := customresource.ValidateResourceVersion(ctx, dataFederation, r.Log)
 	if !resourceVersionIsValid.IsOk() {
@@ -106,7 +129,8 @@ func (r *AtlasDataFederationReconciler) Reconcile(context context.Context, req c
 
 	owner, err := customresource.IsOwner(dataFederation, r.ObjectDeletionProtection, customresource.IsResourceManagedByOperator, managedByAtlas(context, atlasClient, project.ID(), log))
 	if err != nil {
-		result = workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err))
+		result = workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err)).
+			WithCause(dataFederationReconciler, "getFederatedDatabase")
 		ctx.SetConditionFromResult(status.DataFederationReadyType, result)
 		log.Error(result.GetMessage())
 
@@ -161,7 +185,7 @@ func (r *AtlasDataFederationReconciler) Reconcile(context context.Context, req c
 			} else {
 				if err = r.deleteDataFederationFromAtlas(context, atlasClient, dataFederation, project, log); err != nil {
 					log.Errorf("failed to remove DataFederation from Atlas: %s", err)
-					result = workflow.Terminate(workflow.Internal, err.Error())
+					result = workflow.Terminate(workflow.Internal, err.Error()).WithCause(dataFederationReconciler, "deleteFederatedDatabase")
 					ctx.SetConditionFromResult(status.DataFederationReadyType, result)
 					return result.ReconcileResult(), nil
 				}
@@ -220,6 +244,7 @@ func (r *AtlasDataFederationReconciler) SetupWithManager(mgr ctrl.Manager) error
 		Named("AtlasDataFederation").
 		Watches(&mdbv1.AtlasDataFederation{}, &watch.EventHandlerWithDelete{Controller: r}, builder.WithPredicates(r.GlobalPredicates...)).
 		For(&mdbv1.AtlasDataFederation{}, builder.WithPredicates(r.GlobalPredicates...)).
+		Watches(&mdbv1.AtlasProject{}, watch.NewProjectDependentsHandler(r.Client, &mdbv1.AtlasDataFederationList{}), builder.WithPredicates(watch.ProjectOrganizationChanged())).
 		Complete(r)
 }
 
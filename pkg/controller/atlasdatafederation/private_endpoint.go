@@ -35,7 +35,8 @@ func syncPrivateEndpointsWithAtlas(ctx *workflow.Context, clientDF *DataFederati
 	for _, e := range endpointsToCreate {
 		endpoint := e.(mdbv1.DataFederationPE)
 		if _, _, err := clientDF.CreateOnePrivateEndpoint(ctx.Context, projectID, endpoint); err != nil {
-			return workflow.Terminate(workflow.Internal, err.Error())
+			return workflow.Terminate(workflow.Internal, err.Error()).
+				WithCause(dataFederationPrivateEndpointReconciler, "createDataFederationPrivateEndpoint")
 		}
 	}
 
@@ -44,7 +45,8 @@ func syncPrivateEndpointsWithAtlas(ctx *workflow.Context, clientDF *DataFederati
 	for _, item := range endpointsToDelete {
 		endpoint := item.(mdbv1.DataFederationPE)
 		if _, _, err := clientDF.DeleteOnePrivateEndpoint(ctx.Context, projectID, endpoint.EndpointID); err != nil {
-			return workflow.Terminate(workflow.Internal, err.Error())
+			return workflow.Terminate(workflow.Internal, err.Error()).
+				WithCause(dataFederationPrivateEndpointReconciler, "deleteDataFederationPrivateEndpoint")
 		}
 	}
 
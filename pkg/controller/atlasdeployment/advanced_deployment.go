@@ -15,18 +15,27 @@ import (
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/compat"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/stringutil"
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/connectionsecret"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
 
-const FreeTier = "M0"
+const (
+	FreeTier = "M0"
+
+	advancedDeploymentReconciler = "advancedDeployment"
+)
 
 func (r *AtlasDeploymentReconciler) ensureAdvancedDeploymentState(ctx *workflow.Context, project *mdbv1.AtlasProject, deployment *mdbv1.AtlasDeployment) (*mongodbatlas.AdvancedCluster, workflow.Result) {
 	advancedDeploymentSpec := deployment.Spec.DeploymentSpec
 
+	overrides, err := parseUnsupportedOverrides(deployment.Spec.UnsupportedOverrides)
+	if err != nil {
+		return nil, workflow.Terminate(workflow.DeploymentUnsupportedOverridesInvalid, err.Error())
+	}
+
 	advancedDeployment, resp, err := ctx.Client.AdvancedClusters.Get(ctx.Context, project.Status.ID, advancedDeploymentSpec.Name)
 
 	if err != nil {
@@ -35,7 +44,12 @@ func (r *AtlasDeploymentReconciler) ensureAdvancedDeploymentState(ctx *workflow.
 		}
 
 		if resp.StatusCode != http.StatusNotFound {
-			return advancedDeployment, workflow.Terminate(workflow.DeploymentNotCreatedInAtlas, err.Error())
+			return advancedDeployment, workflow.Terminate(workflow.DeploymentNotCreatedInAtlas, err.Error()).WithAtlasError(err).
+				WithCause(advancedDeploymentReconciler, "getCluster")
+		}
+
+		if result := ensureCanCreate(deployment); !result.IsOk() {
+			return nil, result
 		}
 
 		advancedDeployment, err = advancedDeploymentSpec.ToAtlas()
@@ -43,11 +57,16 @@ func (r *AtlasDeploymentReconciler) ensureAdvancedDeploymentState(ctx *workflow.
 			return advancedDeployment, workflow.Terminate(workflow.Internal, err.Error())
 		}
 
+		advancedDeployment.Tags = append(advancedDeployment.Tags, trackingTags(deployment)...)
+
 		ctx.Log.Infof("Advanced Deployment %s doesn't exist in Atlas - creating", advancedDeploymentSpec.Name)
-		advancedDeployment, _, err = ctx.Client.AdvancedClusters.Create(ctx.Context, project.Status.ID, advancedDeployment)
+		advancedDeployment, err = createAdvancedCluster(ctx, project.Status.ID, advancedDeployment, overrides)
 		if err != nil {
-			return advancedDeployment, workflow.Terminate(workflow.DeploymentNotCreatedInAtlas, err.Error())
+			return advancedDeployment, workflow.Terminate(workflow.DeploymentNotCreatedInAtlas, err.Error()).WithAtlasError(err).
+				WithCause(advancedDeploymentReconciler, "createCluster")
 		}
+	} else if result := ensureCanAcquire(deployment, advancedDeployment.Tags); !result.IsOk() {
+		return nil, result
 	}
 
 	result := EnsureCustomZoneMapping(ctx, project.ID(), deployment.Spec.DeploymentSpec.CustomZoneMapping, advancedDeployment.Name)
@@ -62,14 +81,25 @@ func (r *AtlasDeploymentReconciler) ensureAdvancedDeploymentState(ctx *workflow.
 
 	switch advancedDeployment.StateName {
 	case "IDLE":
-		return advancedDeploymentIdle(ctx, project, deployment, advancedDeployment)
+		if result = ensureConfigServer(ctx, project.ID(), deployment); !result.IsOk() {
+			return advancedDeployment, result
+		}
+
+		observed := r.reportAtlasDrift(ctx, deployment, advancedDeployment)
+		advancedDeployment, result = advancedDeploymentIdle(ctx, project, deployment, advancedDeployment, overrides)
+		trackObservedAtlasState(ctx, observed, result)
+
+		return advancedDeployment, result
 
 	case "CREATING":
 		return advancedDeployment, workflow.InProgress(workflow.DeploymentCreating, "deployment is provisioning")
 
-	case "UPDATING", "REPAIRING":
+	case "UPDATING":
 		return advancedDeployment, workflow.InProgress(workflow.DeploymentUpdating, "deployment is updating")
 
+	case "REPAIRING":
+		return advancedDeployment, maintenanceInProgress("Atlas is running a maintenance on the deployment")
+
 	// TODO: add "DELETING", "DELETED", handle 404 on delete
 
 	default:
@@ -77,13 +107,16 @@ func (r *AtlasDeploymentReconciler) ensureAdvancedDeploymentState(ctx *workflow.
 	}
 }
 
-func advancedDeploymentIdle(ctx *workflow.Context, project *mdbv1.AtlasProject, deployment *mdbv1.AtlasDeployment, atlasDeploymentAsAtlas *mongodbatlas.AdvancedCluster) (*mongodbatlas.AdvancedCluster, workflow.Result) {
+func advancedDeploymentIdle(ctx *workflow.Context, project *mdbv1.AtlasProject, deployment *mdbv1.AtlasDeployment, atlasDeploymentAsAtlas *mongodbatlas.AdvancedCluster, overrides map[string]interface{}) (*mongodbatlas.AdvancedCluster, workflow.Result) {
 	specDeployment, atlasDeployment, err := MergedAdvancedDeployment(*atlasDeploymentAsAtlas, *deployment.Spec.DeploymentSpec)
 	if err != nil {
 		return atlasDeploymentAsAtlas, workflow.Terminate(workflow.Internal, err.Error())
 	}
+	specDeployment.Tags = keepTrackingTags(specDeployment.Tags, atlasDeployment.Tags)
 
-	if areEqual, _ := AdvancedDeploymentsEqual(ctx.Log, &specDeployment, &atlasDeployment); areEqual {
+	// a requested resynchronization pushes the spec to Atlas even if it looks unchanged, as do changed unsupported
+	// overrides
+	if areEqual, _ := AdvancedDeploymentsEqual(ctx.Log, &specDeployment, &atlasDeployment); areEqual && !ctx.Reapply && !unsupportedOverridesChanged(deployment) {
 		return atlasDeploymentAsAtlas, workflow.OK()
 	}
 
@@ -94,6 +127,7 @@ func advancedDeploymentIdle(ctx *workflow.Context, project *mdbv1.AtlasProject,
 			specDeployment = mdbv1.AdvancedDeploymentSpec{
 				Paused: deployment.Spec.DeploymentSpec.Paused,
 			}
+			overrides = nil
 		} else {
 			// otherwise, don't send the paused field
 			specDeployment.Paused = nil
@@ -109,9 +143,13 @@ func advancedDeploymentIdle(ctx *workflow.Context, project *mdbv1.AtlasProject,
 
 	// TODO: Potential bug with disabling autoscaling if it was previously enabled
 
-	atlasDeploymentAsAtlas, _, err = ctx.Client.AdvancedClusters.Update(ctx.Context, project.Status.ID, deployment.Spec.DeploymentSpec.Name, deploymentAsAtlas)
+	atlasDeploymentAsAtlas, err = updateAdvancedCluster(ctx, project.Status.ID, deployment.Spec.DeploymentSpec.Name, deploymentAsAtlas, overrides)
+	if atlas.IsMaintenanceInProgress(err) {
+		return nil, maintenanceInProgress(fmt.Sprintf("Atlas rejected the update during a maintenance: %s", err))
+	}
 	if err != nil {
-		return atlasDeploymentAsAtlas, workflow.Terminate(workflow.DeploymentNotUpdatedInAtlas, err.Error())
+		return atlasDeploymentAsAtlas, workflow.Terminate(workflow.DeploymentNotUpdatedInAtlas, err.Error()).WithAtlasError(err).
+			WithCause(advancedDeploymentReconciler, "updateCluster")
 	}
 
 	return nil, workflow.InProgress(workflow.DeploymentUpdating, "deployment is updating")
@@ -153,6 +191,9 @@ func MergedAdvancedDeployment(atlasDeploymentAsAtlas mongodbatlas.AdvancedCluste
 	atlasDeployment.MongoDBVersion = ""
 	mergedDeployment.MongoDBVersion = ""
 
+	// the config server management mode is not returned by the Atlas client, it is compared by ensureConfigServer
+	mergedDeployment.ConfigServerManagementMode = ""
+
 	return
 }
 
@@ -185,14 +226,19 @@ func AdvancedDeploymentFromAtlas(advancedDeployment mongodbatlas.AdvancedCluster
 }
 
 func convertDiskSizeField(result *mdbv1.AdvancedDeploymentSpec, atlas *mongodbatlas.AdvancedCluster) {
-	var value *int
-	if atlas.DiskSizeGB != nil && *atlas.DiskSizeGB >= 1 {
-		value = pointer.MakePtr(int(*atlas.DiskSizeGB))
-	}
-	result.DiskSizeGB = value
+	result.DiskSizeGB = atlasDiskSizeGB(atlas)
 	atlas.DiskSizeGB = nil
 }
 
+// atlasDiskSizeGB returns the disk size of the deployment in Atlas, nil when Atlas reports none, e.g. for the free tier
+func atlasDiskSizeGB(atlas *mongodbatlas.AdvancedCluster) *int {
+	if atlas.DiskSizeGB == nil || *atlas.DiskSizeGB < 1 {
+		return nil
+	}
+
+	return pointer.MakePtr(int(*atlas.DiskSizeGB))
+}
+
 // AdvancedDeploymentsEqual compares two Atlas Advanced Deployments
 func AdvancedDeploymentsEqual(log *zap.SugaredLogger, deploymentOperator *mdbv1.AdvancedDeploymentSpec, deploymentAtlas *mdbv1.AdvancedDeploymentSpec) (areEqual bool, diff string) {
 	expected := deploymentOperator.DeepCopy()
@@ -204,6 +250,10 @@ func AdvancedDeploymentsEqual(log *zap.SugaredLogger, deploymentOperator *mdbv1.
 			if isComputeAutoScalingEnabled(rc.AutoScaling) {
 				ignoreInstanceSize(rc)
 			}
+			if isDiskAutoScalingEnabled(rc.AutoScaling) {
+				expected.DiskSizeGB = nil
+				actualCleaned.DiskSizeGB = nil
+			}
 		}
 	}
 	d := cmp.Diff(actualCleaned, expected, cmpopts.EquateEmpty(), cmpopts.SortSlices(mdbv1.LessAD))
@@ -246,6 +296,9 @@ func cleanupFieldsToCompare(atlas, operator *mdbv1.AdvancedDeploymentSpec) *mdbv
 			if isComputeAutoScalingEnabled(regionConfig.AutoScaling) {
 				ignoreInstanceSize(regionConfig)
 			}
+			if isDiskAutoScalingEnabled(regionConfig.AutoScaling) {
+				atlas.DiskSizeGB = nil
+			}
 		}
 	}
 
@@ -268,7 +321,7 @@ func GetAllDeploymentNames(ctx context.Context, client *mongodbatlas.Client, pro
 	return deploymentNames, nil
 }
 
-func (r *AtlasDeploymentReconciler) ensureConnectionSecrets(ctx *workflow.Context, project *mdbv1.AtlasProject, name string, connectionStrings *mongodbatlas.ConnectionStrings, deploymentResource *mdbv1.AtlasDeployment) workflow.Result {
+func (r *AtlasDeploymentReconciler) ensureConnectionSecrets(ctx *workflow.Context, project *mdbv1.AtlasProject, name string, connectionStrings *mongodbatlas.ConnectionStrings, analyticsNodes, archivable bool, zones map[string][]string, deploymentResource *mdbv1.AtlasDeployment) workflow.Result {
 	databaseUsers := mdbv1.AtlasDatabaseUserList{}
 	err := r.Client.List(ctx.Context, &databaseUsers, &client.ListOptions{})
 	if err != nil {
@@ -276,6 +329,8 @@ func (r *AtlasDeploymentReconciler) ensureConnectionSecrets(ctx *workflow.Contex
 	}
 
 	secrets := make([]string, 0)
+	// the online archive is read once a user needs a connection Secret, only the advanced deployments are archivable
+	var onlineArchive *connectionsecret.OnlineArchiveConnURLs
 	for i := range databaseUsers.Items {
 		dbUser := databaseUsers.Items[i]
 
@@ -296,21 +351,49 @@ func (r *AtlasDeploymentReconciler) ensureConnectionSecrets(ctx *workflow.Contex
 			continue
 		}
 
-		scopes := dbUser.GetScopes(mdbv1.DeploymentScopeType)
-		if len(scopes) != 0 && !stringutil.Contains(scopes, name) {
+		if !dbUser.HasConnectionSecret(name) {
 			continue
 		}
 
-		password, err := dbUser.ReadPassword(ctx.Context, r.Client)
+		if dbUser.Spec.ConnectionSecretStore != nil {
+			// the connection credentials published to an external secret store are written and tracked by the
+			// AtlasDatabaseUser controller
+			continue
+		}
+
+		password, _, err := r.SecretStores.ReadPassword(ctx.Context, r.Client, &dbUser)
+		if err != nil {
+			return workflow.Terminate(workflow.DeploymentConnectionSecretsNotCreated, err.Error())
+		}
+
+		if onlineArchive == nil {
+			onlineArchive = &connectionsecret.OnlineArchiveConnURLs{}
+			if archivable {
+				*onlineArchive = connectionsecret.ListOnlineArchiveConnURLs(ctx, project.ID(), []string{name})[name]
+			}
+		}
+
+		policy, err := connectionsecret.UserConnectionStringPolicy(ctx.Context, r.Client, &dbUser)
+		if err != nil {
+			return workflow.Terminate(workflow.DeploymentConnectionSecretsNotCreated, err.Error())
+		}
+		store, err := connectionsecret.UserStore(r.SecretStores, &dbUser)
 		if err != nil {
 			return workflow.Terminate(workflow.DeploymentConnectionSecretsNotCreated, err.Error())
 		}
 
 		data := connectionsecret.ConnectionData{
-			DBUserName: dbUser.Spec.Username,
-			Password:   password,
-			ConnURL:    connectionStrings.Standard,
-			SrvConnURL: connectionStrings.StandardSrv,
+			DBUserName:     dbUser.AtlasUsername(),
+			Password:       password,
+			ConnURL:        connectionStrings.Standard,
+			SrvConnURL:     connectionStrings.StandardSrv,
+			Metadata:       connectionsecret.ResourceMetadata(r.ConnectionSecretMetadata, deploymentResource, &dbUser),
+			AnalyticsNodes: analyticsNodes,
+			AuthMechanism:  dbUser.ExternalAuthMechanism(),
+			OnlineArchive:  *onlineArchive,
+			Zones:          zones,
+			Policy:         policy,
+			Store:          store,
 		}
 		connectionsecret.FillPrivateConnStrings(connectionStrings, &data)
 
@@ -105,6 +105,48 @@ func TestAdvancedDeploymentsEqual(t *testing.T) {
 		assert.Equal(t, beforeAtlas, &atlas, "Comparison should not change original atlas values")
 	})
 
+	t.Run("Advanced deployments are equal when disk autoscaling is ON and only differ on disk sizes", func(t *testing.T) {
+		advancedCluster := mdbv1.DefaultAwsAdvancedDeployment("default", "my-project")
+		advancedCluster.Spec.DeploymentSpec.DiskSizeGB = pointer.MakePtr(20)
+		advancedCluster.Spec.DeploymentSpec.ReplicationSpecs[0].RegionConfigs[0].AutoScaling = &mdbv1.AdvancedAutoScalingSpec{
+			DiskGB: &mdbv1.DiskGB{
+				Enabled: pointer.MakePtr(true),
+			},
+		}
+
+		merged, atlas, err := MergedAdvancedDeployment(*defaultAtlas, *advancedCluster.Spec.DeploymentSpec)
+		atlas.ReplicationSpecs[0].RegionConfigs[0].AutoScaling = &mdbv1.AdvancedAutoScalingSpec{
+			DiskGB: &mdbv1.DiskGB{
+				Enabled: pointer.MakePtr(true),
+			},
+		}
+		// inject difference
+		atlas.DiskSizeGB = pointer.MakePtr(40)
+		assert.NoError(t, err)
+		beforeSpec := merged.DeepCopy()
+		beforeAtlas := atlas.DeepCopy()
+
+		logger, _ := zap.NewProduction()
+		areEqual, _ := AdvancedDeploymentsEqual(logger.Sugar(), &merged, &atlas)
+		assert.True(t, areEqual, "Deployments should be equal")
+		assert.Equal(t, beforeSpec, &merged, "Comparison should not change original spec values")
+		assert.Equal(t, beforeAtlas, &atlas, "Comparison should not change original atlas values")
+	})
+
+	t.Run("Advanced deployments are different when disk autoscaling is OFF and only differ on disk sizes", func(t *testing.T) {
+		advancedCluster := mdbv1.DefaultAwsAdvancedDeployment("default", "my-project")
+		advancedCluster.Spec.DeploymentSpec.DiskSizeGB = pointer.MakePtr(20)
+
+		merged, atlas, err := MergedAdvancedDeployment(*defaultAtlas, *advancedCluster.Spec.DeploymentSpec)
+		// inject difference
+		atlas.DiskSizeGB = pointer.MakePtr(40)
+		assert.NoError(t, err)
+
+		logger, _ := zap.NewProduction()
+		areEqual, _ := AdvancedDeploymentsEqual(logger.Sugar(), &merged, &atlas)
+		assert.False(t, areEqual, "Deployments should be different")
+	})
+
 	t.Run("Advanced deployments are different when autoscaling is OFF and only differ on instance sizes", func(t *testing.T) {
 		advancedCluster := mdbv1.DefaultAwsAdvancedDeployment("default", "my-project")
 
@@ -0,0 +1,89 @@
+package atlasdeployment
+
+import (
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/drift"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// AtlasDriftDetectedEvent is the reason of the event emitted when the deployment was changed in Atlas outside of the operator
+const AtlasDriftDetectedEvent = "AtlasDriftDetected"
+
+// reportAtlasDrift fingerprints the deployment observed in Atlas and compares it with the fingerprint recorded by the
+// previous reconciliation. When the AtlasDeployment wasn't changed meanwhile, any difference was made in Atlas outside
+// of the operator and is reported with a Warning event listing the changed fields.
+// The current fingerprint is returned so that it's recorded once the deployment is in sync
+func (r *AtlasDeploymentReconciler) reportAtlasDrift(ctx *workflow.Context, deployment *mdbv1.AtlasDeployment, advancedDeployment *mongodbatlas.AdvancedCluster) *status.ObservedAtlasState {
+	fields, err := atlasFingerprint(advancedDeployment)
+	if err != nil {
+		ctx.Log.Warnw("failed to fingerprint the deployment in Atlas", "error", err)
+		return nil
+	}
+
+	observed := &status.ObservedAtlasState{Generation: deployment.Generation, Fields: fields}
+
+	previous := deployment.Status.ObservedAtlasState
+	if previous == nil || previous.Generation != deployment.Generation {
+		return observed
+	}
+
+	changed := drift.ChangedFields(previous.Fields, fields)
+	if len(changed) == 0 {
+		return observed
+	}
+
+	ctx.Log.Warnw("The deployment was changed in Atlas outside of the operator", "fields", changed)
+	r.EventRecorder.Eventf(deployment, "Warning", AtlasDriftDetectedEvent, "Atlas fields changed outside of the operator: %s", strings.Join(changed, ", "))
+
+	return observed
+}
+
+// trackObservedAtlasState records the fingerprint of the deployment once it's in sync with Atlas, and forgets it when the
+// operator updates the deployment so that its own changes aren't reported as drift
+func trackObservedAtlasState(ctx *workflow.Context, observed *status.ObservedAtlasState, result workflow.Result) {
+	switch {
+	case result.IsOk() && observed != nil:
+		ctx.EnsureStatusOption(status.AtlasDeploymentObservedAtlasStateOption(observed))
+	case result.GetReason() == workflow.DeploymentUpdating:
+		ctx.EnsureStatusOption(status.AtlasDeploymentObservedAtlasStateOption(nil))
+	}
+}
+
+// atlasFingerprint fingerprints the deployment as the operator compares it. The fields changed by Atlas on its own,
+// i.e. the MongoDB patch version and the sizes managed by auto-scaling, are left out
+func atlasFingerprint(advancedDeployment *mongodbatlas.AdvancedCluster) (map[string]string, error) {
+	if advancedDeployment == nil {
+		return nil, fmt.Errorf("no deployment to fingerprint")
+	}
+
+	atlasDeployment, err := AdvancedDeploymentFromAtlas(*advancedDeployment)
+	if err != nil {
+		return nil, err
+	}
+
+	atlasDeployment.MongoDBVersion = ""
+	for _, replicationSpec := range atlasDeployment.ReplicationSpecs {
+		if replicationSpec == nil {
+			continue
+		}
+		for _, regionConfig := range replicationSpec.RegionConfigs {
+			if regionConfig == nil {
+				continue
+			}
+			if isDiskAutoScalingEnabled(regionConfig.AutoScaling) {
+				atlasDeployment.DiskSizeGB = nil
+			}
+			if isComputeAutoScalingEnabled(regionConfig.AutoScaling) {
+				ignoreInstanceSize(regionConfig)
+			}
+		}
+	}
+
+	return drift.Fingerprint(atlasDeployment)
+}
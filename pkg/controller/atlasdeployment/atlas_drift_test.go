@@ -0,0 +1,99 @@
+package atlasdeployment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestReportAtlasDrift(t *testing.T) {
+	newCluster := func(instanceSize string, computeAutoScaling bool) *mongodbatlas.AdvancedCluster {
+		return &mongodbatlas.AdvancedCluster{
+			Name:           "cluster0",
+			ClusterType:    "REPLICASET",
+			MongoDBVersion: "6.0.11",
+			DiskSizeGB:     pointer.MakePtr(10.0),
+			ReplicationSpecs: []*mongodbatlas.AdvancedReplicationSpec{{
+				NumShards: 1,
+				RegionConfigs: []*mongodbatlas.AdvancedRegionConfig{{
+					ProviderName:   "AWS",
+					RegionName:     "US_EAST_1",
+					Priority:       pointer.MakePtr(7),
+					ElectableSpecs: &mongodbatlas.Specs{InstanceSize: instanceSize, NodeCount: pointer.MakePtr(3)},
+					AutoScaling: &mongodbatlas.AdvancedAutoScaling{
+						Compute: &mongodbatlas.Compute{Enabled: pointer.MakePtr(computeAutoScaling)},
+					},
+				}},
+			}},
+		}
+	}
+	observe := func(t *testing.T, cluster *mongodbatlas.AdvancedCluster, generation int64) *status.ObservedAtlasState {
+		fields, err := atlasFingerprint(cluster)
+		require.NoError(t, err)
+
+		return &status.ObservedAtlasState{Generation: generation, Fields: fields}
+	}
+	newDeployment := func(observed *status.ObservedAtlasState) *mdbv1.AtlasDeployment {
+		deployment := mdbv1.NewDeployment("ns", "deployment", "cluster0")
+		deployment.Generation = 2
+		deployment.Status.ObservedAtlasState = observed
+
+		return deployment
+	}
+
+	t.Run("should report the fields changed in Atlas outside of the operator", func(t *testing.T) {
+		recorder := record.NewFakeRecorder(10)
+		r := &AtlasDeploymentReconciler{EventRecorder: recorder}
+		deployment := newDeployment(observe(t, newCluster("M10", false), 2))
+
+		observed := r.reportAtlasDrift(newContext(t), deployment, newCluster("M20", false))
+
+		require.NotNil(t, observed)
+		assert.Equal(t, int64(2), observed.Generation)
+		require.Len(t, recorder.Events, 1)
+		assert.Equal(t, "Warning AtlasDriftDetected Atlas fields changed outside of the operator: replicationSpecs[0].regionConfigs[0].electableSpecs.instanceSize", <-recorder.Events)
+	})
+
+	t.Run("should not report changes when the resource was updated", func(t *testing.T) {
+		recorder := record.NewFakeRecorder(10)
+		r := &AtlasDeploymentReconciler{EventRecorder: recorder}
+		deployment := newDeployment(observe(t, newCluster("M10", false), 1))
+
+		assert.NotNil(t, r.reportAtlasDrift(newContext(t), deployment, newCluster("M20", false)))
+		assert.Empty(t, recorder.Events)
+	})
+
+	t.Run("should not report changes made by auto-scaling or version upgrades", func(t *testing.T) {
+		recorder := record.NewFakeRecorder(10)
+		r := &AtlasDeploymentReconciler{EventRecorder: recorder}
+		deployment := newDeployment(observe(t, newCluster("M10", true), 2))
+		cluster := newCluster("M20", true)
+		cluster.MongoDBVersion = "6.0.12"
+
+		assert.NotNil(t, r.reportAtlasDrift(newContext(t), deployment, cluster))
+		assert.Empty(t, recorder.Events)
+	})
+
+	t.Run("should record the state once in sync and forget it while updating", func(t *testing.T) {
+		observed := observe(t, newCluster("M10", false), 2)
+		deployment := newDeployment(nil)
+
+		ctx := newContext(t)
+		trackObservedAtlasState(ctx, observed, workflow.OK())
+		deployment.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+		assert.Equal(t, observed, deployment.Status.ObservedAtlasState)
+
+		ctx = newContext(t)
+		trackObservedAtlasState(ctx, observed, workflow.InProgress(workflow.DeploymentUpdating, "deployment is updating"))
+		deployment.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+		assert.Nil(t, deployment.Status.ObservedAtlasState)
+	})
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package atlasdeployment
 
 import (
+	"time"
+
 	"context"
 	"errors"
 	"fmt"
@@ -40,12 +42,17 @@ import (
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/compat"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/connectionsecret"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/deletion"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/metrics"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/notifier"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/secretstore"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/statushandler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/validate"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/watch"
@@ -60,9 +67,27 @@ type AtlasDeploymentReconciler struct {
 	Scheme                      *runtime.Scheme
 	GlobalPredicates            []predicate.Predicate
 	EventRecorder               record.EventRecorder
+	FailureNotifier             *notifier.Notifier
 	AtlasProvider               atlas.Provider
 	ObjectDeletionProtection    bool
 	SubObjectDeletionProtection bool
+	// ServerlessUsageStatsInterval is how often the usage of serverless instances is collected into their status.
+	// The collection is disabled when it's zero
+	ServerlessUsageStatsInterval time.Duration
+	// ScalingAdvisorInterval is how often the sizing of deployments is evaluated from their Atlas metrics.
+	// The evaluation is disabled when it's zero
+	ScalingAdvisorInterval time.Duration
+	// CapabilitiesCache caches the instance sizes and regions available to the projects.
+	// The deployments aren't validated against them when it's nil
+	CapabilitiesCache *atlas.CapabilitiesCache
+	// DeletionScheduler throttles the deletions of the deployments in Atlas, they aren't throttled when it's nil
+	DeletionScheduler *deletion.Scheduler
+	// ConnectionSecretMetadata holds the labels and annotations added to all the connection Secrets
+	ConnectionSecretMetadata connectionsecret.Metadata
+	// AtlasDomain is the URL of the Atlas API, listed in the egress ConfigMaps of the deployments
+	AtlasDomain string
+	// SecretStores are the external secret stores the passwords of the database users can be read from
+	SecretStores secretstore.Registry
 }
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasdeployments,verbs=get;list;watch;create;update;patch;delete
@@ -70,6 +95,10 @@ type AtlasDeploymentReconciler struct {
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasdeployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasdeployments/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",namespace=default,resources=services,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",namespace=default,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasbackupschedules,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasbackupschedules/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasbackupschedules,verbs=get;list;watch;create;update;patch;delete
@@ -82,7 +111,7 @@ type AtlasDeploymentReconciler struct {
 
 // +kubebuilder:rbac:groups="",namespace=default,resources=events,verbs=create;patch
 
-func (r *AtlasDeploymentReconciler) Reconcile(context context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *AtlasDeploymentReconciler) Reconcile(context context.Context, req ctrl.Request) (res ctrl.Result, _ error) {
 	log := r.Log.With("atlasdeployment", req.NamespacedName)
 
 	deployment := &mdbv1.AtlasDeployment{}
@@ -105,11 +134,26 @@ func (r *AtlasDeploymentReconciler) Reconcile(context context.Context, req ctrl.
 		return workflow.OK().ReconcileResult(), nil
 	}
 
+	if customresource.NamespaceReconciliationPaused(context, r.Client, deployment.Namespace, log) {
+		log.Infow(fmt.Sprintf("-> Pausing AtlasDeployment reconciliation as annotation %s=true is set on the namespace", customresource.ReconciliationPausedAnnotation))
+		return customresource.MarkReconciliationPaused(context, r.Client, r.EventRecorder, deployment, log).ReconcileResult(), nil
+	}
+
 	workflowCtx := customresource.MarkReconciliationStarted(r.Client, deployment, log, context)
 	log.Infow("-> Starting AtlasDeployment reconciliation", "spec", deployment.Spec, "status", deployment.Status)
+	if workflowCtx.Degraded(deployment) {
+		log.Infow("-> Skipping the reconciliation of the degraded AtlasDeployment, change its spec or set the reapply annotation to retry")
+		return ctrl.Result{}, nil
+	}
+
 	defer func() {
-		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, deployment)
+		if p := recover(); p != nil {
+			res = workflowCtx.RecoverPanic("AtlasDeployment", p).ReconcileResult()
+		}
+		res = workflowCtx.CapRetries("AtlasDeployment", deployment, res)
+		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, r.FailureNotifier, deployment)
 		r.EnsureMultiplesResourcesAreWatched(req.NamespacedName, log, workflowCtx.ListResourcesToWatch()...)
+		workflowCtx.LogStepTimings()
 	}()
 
 	resourceVersionIsValid := customresource.ValidateResourceVersion(workflowCtx, deployment, r.Log)
@@ -124,12 +168,22 @@ func (r *AtlasDeploymentReconciler) Reconcile(context context.Context, req ctrl.
 		return result.ReconcileResult(), nil
 	}
 
-	if err := validate.DeploymentSpec(&deployment.Spec, r.AtlasProvider.IsCloudGov(), project.Spec.RegionUsageRestrictions); err != nil {
+	err := validate.DeploymentSpec(&deployment.Spec, r.AtlasProvider.IsCloudGov(), project.Spec.RegionUsageRestrictions)
+	if err == nil {
+		err = validateVersionReleaseSystem(deployment)
+	}
+	if err != nil {
 		result := workflow.Terminate(workflow.Internal, err.Error())
 		workflowCtx.SetConditionFromResult(status.ValidationSucceeded, result)
 		return result.ReconcileResult(), nil
 	}
 	workflowCtx.SetConditionTrue(status.ValidationSucceeded)
+	if !r.AtlasProvider.IsCloudGov() {
+		for _, warning := range validate.DeploymentRegionWarnings(&deployment.Spec) {
+			log.Warnw("The region may not be supported by Atlas", "warning", warning)
+			r.EventRecorder.Event(deployment, "Warning", UnknownRegionEvent, warning)
+		}
+	}
 
 	if !r.AtlasProvider.IsResourceSupported(deployment) {
 		result := workflow.Terminate(workflow.AtlasGovUnsupported, "the AtlasDeployment is not supported by Atlas for government").
@@ -147,6 +201,14 @@ func (r *AtlasDeploymentReconciler) Reconcile(context context.Context, req ctrl.
 	workflowCtx.OrgID = orgID
 	workflowCtx.Client = atlasClient
 
+	atlasSdkClient, _, err := r.AtlasProvider.SdkClient(workflowCtx.Context, project.ConnectionSecretObjectKey(), log)
+	if err != nil {
+		result := workflow.Terminate(workflow.AtlasAPIAccessNotConfigured, err.Error())
+		workflowCtx.SetConditionFromResult(status.DeploymentReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+	workflowCtx.SdkClient = atlasSdkClient
+
 	// Allow users to specify M0/M2/M5 deployments without providing TENANT for Normal and Serverless deployments
 	r.verifyNonTenantCase(deployment)
 
@@ -163,6 +225,11 @@ func (r *AtlasDeploymentReconciler) Reconcile(context context.Context, req ctrl.
 		return result.ReconcileResult(), nil
 	}
 
+	if result := r.ensureImmutableFields(workflowCtx, project, deployment); !result.IsOk() {
+		workflowCtx.SetConditionFromResult(status.DeploymentReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+
 	if err := uniqueKey(&convertedDeployment.Spec); err != nil {
 		log.Errorw("failed to validate tags", "error", err)
 		result := workflow.Terminate(workflow.Internal, err.Error())
@@ -170,20 +237,52 @@ func (r *AtlasDeploymentReconciler) Reconcile(context context.Context, req ctrl.
 		return result.ReconcileResult(), nil
 	}
 
+	if result := workflowCtx.RunStep("capabilities", deploymentStepTimeout, func() workflow.Result {
+		return r.ensureDeploymentCapabilities(workflowCtx, project.ID(), convertedDeployment)
+	}); !result.IsOk() {
+		workflowCtx.SetConditionFromResult(status.DeploymentReadyType, result)
+		return r.registerConfigAndReturn(workflowCtx, log, deployment, result), nil
+	}
+
 	handleDeployment := r.selectDeploymentHandler(convertedDeployment)
-	if result, _ := handleDeployment(workflowCtx, project, convertedDeployment, req); !result.IsOk() {
+	result, _ = handleDeployment(workflowCtx, project, convertedDeployment, req)
+	result = ensureMaintenanceCondition(workflowCtx, result)
+	result = ensureProvisioningPolling(workflowCtx, deployment, result, time.Now())
+	if result = r.ensureProvisioningTimeout(workflowCtx, deployment, result); !result.IsOk() {
 		workflowCtx.SetConditionFromResult(status.DeploymentReadyType, result)
 		return r.registerConfigAndReturn(workflowCtx, log, deployment, result), nil
 	}
 
 	if !convertedDeployment.IsServerless() {
-		if result := r.handleAdvancedOptions(workflowCtx, project, convertedDeployment); !result.IsOk() {
+		if result := workflowCtx.RunStep(processArgsReconciler, deploymentStepTimeout, func() workflow.Result {
+			return r.handleAdvancedOptions(workflowCtx, project, convertedDeployment)
+		}); !result.IsOk() {
 			workflowCtx.SetConditionFromResult(status.DeploymentReadyType, result)
 			return r.registerConfigAndReturn(workflowCtx, log, deployment, result), nil
 		}
 	}
 
-	return r.registerConfigAndReturn(workflowCtx, log, deployment, workflow.OK()), nil
+	if convertedDeployment.IsServerless() && r.ServerlessUsageStatsInterval > 0 {
+		workflowCtx.RunStep("serverlessUsageStats", deploymentStepTimeout, func() workflow.Result {
+			r.ensureServerlessUsageStats(workflowCtx, project, deployment)
+			return workflow.OK()
+		})
+		return r.registerConfigAndReturn(workflowCtx, log, deployment, workflow.OK().WithRetry(r.ServerlessUsageStatsInterval)), nil
+	}
+
+	if !convertedDeployment.IsServerless() && r.ScalingAdvisorInterval > 0 {
+		if retry := result.ReconcileResult().RequeueAfter; retry == 0 || retry > r.ScalingAdvisorInterval {
+			result = workflow.OK().WithRetry(r.ScalingAdvisorInterval)
+		}
+	}
+
+	if interval := ipAddressReportInterval(convertedDeployment); !convertedDeployment.IsServerless() && result.IsOk() && interval > 0 {
+		if retry := result.ReconcileResult().RequeueAfter; retry == 0 || retry > interval {
+			result = workflow.OK().WithRetry(interval)
+		}
+	}
+
+	return r.registerConfigAndReturn(workflowCtx, log, deployment, result), nil
 }
 
 func (r *AtlasDeploymentReconciler) registerConfigAndReturn(
@@ -225,9 +324,10 @@ func (r *AtlasDeploymentReconciler) checkDeploymentIsManaged(
 	project *mdbv1.AtlasProject,
 	deployment *mdbv1.AtlasDeployment,
 ) workflow.Result {
-	owner, err := customresource.IsOwner(
+	owner, err := customresource.IsOwnerByMarker(
 		deployment,
 		r.ObjectDeletionProtection,
+		markedInAtlas(workflowCtx, project.ID()),
 		customresource.IsResourceManagedByOperator,
 		managedByAtlas(workflowCtx, project.ID(), log),
 	)
@@ -286,6 +386,7 @@ func (r *AtlasDeploymentReconciler) handleDeletion(
 		return true, result
 	}
 
+	keep := true
 	switch {
 	case customresource.IsResourcePolicyKeepOrDefault(deployment, r.ObjectDeletionProtection):
 		log.Info("Not removing Atlas deployment from Atlas as per configuration")
@@ -296,19 +397,37 @@ func (r *AtlasDeploymentReconciler) handleDeletion(
 		log.Info(msg)
 		r.EventRecorder.Event(deployment, "Warning", "AtlasDeploymentTermination", msg)
 	default:
-		if err := r.deleteDeploymentFromAtlas(workflowCtx, log, project, deployment); err != nil {
+		keep = false
+		release, result := r.DeletionScheduler.Acquire(workflowCtx.Context, r.Client, deployment)
+		if !result.IsOk() {
+			workflowCtx.SetConditionFromResult(status.DeploymentReadyType, result)
+			return true, result
+		}
+		err := r.deleteDeploymentFromAtlas(workflowCtx, log, project, deployment)
+		release(err)
+		if err != nil {
 			log.Errorf("failed to remove deployment from Atlas: %s", err)
-			result := workflow.Terminate(workflow.Internal, err.Error())
+			result := workflow.Terminate(workflow.Internal, err.Error()).WithAtlasError(err)
 			workflowCtx.SetConditionFromResult(status.DeploymentReadyType, result)
 			return true, result
 		}
 	}
 
+	// the deployment kept in Atlas is no longer managed by the operator, it must not be reported as left behind
+	if keep && project.ID() != "" {
+		if err := untrackDeployment(workflowCtx, project.ID(), deployment); err != nil {
+			result := workflow.Terminate(workflow.Internal, fmt.Sprintf("failed to remove the tracking tags of the deployment: %s", err))
+			log.Errorw("failed to remove the tracking tags of the deployment kept in Atlas", "error", err)
+			return true, result
+		}
+	}
+
 	if err := customresource.ManageFinalizer(workflowCtx.Context, r.Client, deployment, customresource.UnsetFinalizer); err != nil {
 		result := workflow.Terminate(workflow.Internal, err.Error())
 		log.Errorw("failed to remove finalizer", "error", err)
 		return true, result
 	}
+	metrics.DeleteDeploymentUtilization(deployment.Namespace, deployment.Name)
 
 	return true, prevResult
 }
@@ -356,9 +475,14 @@ func (r *AtlasDeploymentReconciler) handleAdvancedDeployment(
 	project *mdbv1.AtlasProject,
 	deployment *mdbv1.AtlasDeployment,
 	req reconcile.Request) (workflow.Result, error) {
-	c, result := r.ensureAdvancedDeploymentState(workflowCtx, project, deployment)
+	var c *mongodbatlas.AdvancedCluster
+	result := workflowCtx.RunStep(advancedDeploymentReconciler, deploymentStepTimeout, func() (result workflow.Result) {
+		c, result = r.ensureAdvancedDeploymentState(workflowCtx, project, deployment)
+		return result
+	})
 	if c != nil && c.StateName != "" {
 		workflowCtx.EnsureStatusOption(status.AtlasDeploymentStateNameOption(c.StateName))
+		r.ensureVersionStatus(workflowCtx, deployment, c)
 	}
 
 	if !result.IsOk() {
@@ -383,23 +507,70 @@ func (r *AtlasDeploymentReconciler) handleAdvancedDeployment(
 		backupEnabled = *c.BackupEnabled
 	}
 
-	if err := r.ensureBackupScheduleAndPolicy(
-		workflowCtx, project.ID(),
-		deployment,
-		backupEnabled,
-	); err != nil {
-		result := workflow.Terminate(workflow.Internal, err.Error())
-		workflowCtx.SetConditionFromResult(status.DeploymentReadyType, result)
-		return result, nil
+	var backupPolicy *mongodbatlas.CloudProviderSnapshotBackupPolicy
+	var referenceTimeChange time.Time
+	if backupResult := workflowCtx.RunStep("backup", deploymentStepTimeout, func() workflow.Result {
+		r.ensureBackupCompatibility(workflowCtx, deployment)
+
+		var err error
+		backupPolicy, referenceTimeChange, err = r.ensureBackupScheduleAndPolicy(
+			workflowCtx, project.ID(),
+			deployment,
+			backupEnabled,
+		)
+		if err != nil {
+			return workflow.Terminate(workflow.Internal, err.Error())
+		}
+
+		return workflow.OK()
+	}); !backupResult.IsOk() {
+		workflowCtx.SetConditionFromResult(status.DeploymentReadyType, backupResult)
+		return backupResult, nil
+	}
+	workflowCtx.EnsureStatusOption(status.AtlasDeploymentBackupOption(deploymentBackup(c, backupPolicy)))
+	workflowCtx.EnsureStatusOption(status.AtlasDeploymentDiskSizeOption(atlasDiskSizeGB(c)))
+	// the reference time of the snapshots is updated in Atlas when the daylight saving time of the schedule changes
+	if !referenceTimeChange.IsZero() {
+		result = result.WithRetry(time.Until(referenceTimeChange))
 	}
 
-	if csResult := r.ensureConnectionSecrets(workflowCtx, project, c.Name, c.ConnectionStrings, deployment); !csResult.IsOk() {
+	if csResult := workflowCtx.RunStep("connectionSecrets", deploymentStepTimeout, func() workflow.Result {
+		return r.ensureConnectionSecrets(workflowCtx, project, c.Name, c.ConnectionStrings, connectionsecret.HasAnalyticsNodes(c), true, connectionsecret.GlobalClusterZones(c), deployment)
+	}); !csResult.IsOk() {
 		return csResult, nil
 	}
 
+	if serviceResult := workflowCtx.RunStep("externalNameService", deploymentStepTimeout, func() workflow.Result {
+		return r.ensureExternalNameService(workflowCtx, deployment, c.ConnectionStrings)
+	}); !serviceResult.IsOk() {
+		return serviceResult, nil
+	}
+
+	if egressResult := workflowCtx.RunStep("egressConfigMap", deploymentStepTimeout, func() workflow.Result {
+		return r.ensureEgressConfigMap(workflowCtx, deployment, c.ConnectionStrings)
+	}); !egressResult.IsOk() {
+		return egressResult, nil
+	}
+
+	if ipResult := workflowCtx.RunStep("ipAddressReport", deploymentStepTimeout, func() workflow.Result {
+		return r.ensureIPAddressReport(workflowCtx, project.ID(), deployment)
+	}); !ipResult.IsOk() {
+		return ipResult, nil
+	}
+
+	if infoResult := workflowCtx.RunStep("connectionInfo", deploymentStepTimeout, func() workflow.Result {
+		return r.ensureConnectionInfo(workflowCtx, deployment, c.ConnectionStrings)
+	}); !infoResult.IsOk() {
+		return infoResult, nil
+	}
+
+	workflowCtx.RunStep("scalingAdvice", deploymentStepTimeout, func() workflow.Result {
+		r.ensureScalingAdvice(workflowCtx, project, deployment)
+		return workflow.OK()
+	})
+
 	workflowCtx.
 		SetConditionTrue(status.DeploymentReadyType).
-		EnsureStatusOption(status.AtlasDeploymentMongoDBVersionOption(c.MongoDBVersion)).
 		EnsureStatusOption(status.AtlasDeploymentConnectionStringsOption(c.ConnectionStrings))
 
 	workflowCtx.SetConditionTrue(status.ReadyType)
@@ -412,7 +583,11 @@ func (r *AtlasDeploymentReconciler) handleServerlessInstance(
 	project *mdbv1.AtlasProject,
 	deployment *mdbv1.AtlasDeployment,
 	req reconcile.Request) (workflow.Result, error) {
-	c, result := r.ensureServerlessInstanceState(workflowCtx, project, deployment)
+	var c *mongodbatlas.Cluster
+	result := workflowCtx.RunStep("serverlessInstance", deploymentStepTimeout, func() (result workflow.Result) {
+		c, result = r.ensureServerlessInstanceState(workflowCtx, project, deployment)
+		return result
+	})
 	return r.ensureConnectionSecretsAndSetStatusOptions(workflowCtx, project, deployment, result, c)
 }
 
@@ -432,10 +607,30 @@ func (r *AtlasDeploymentReconciler) ensureConnectionSecretsAndSetStatusOptions(
 		return result, nil
 	}
 
-	if csResult := r.ensureConnectionSecrets(ctx, project, d.Name, d.ConnectionStrings, deployment); !csResult.IsOk() {
+	if csResult := ctx.RunStep("connectionSecrets", deploymentStepTimeout, func() workflow.Result {
+		return r.ensureConnectionSecrets(ctx, project, d.Name, d.ConnectionStrings, false, false, nil, deployment)
+	}); !csResult.IsOk() {
 		return csResult, nil
 	}
 
+	if serviceResult := ctx.RunStep("externalNameService", deploymentStepTimeout, func() workflow.Result {
+		return r.ensureExternalNameService(ctx, deployment, d.ConnectionStrings)
+	}); !serviceResult.IsOk() {
+		return serviceResult, nil
+	}
+
+	if egressResult := ctx.RunStep("egressConfigMap", deploymentStepTimeout, func() workflow.Result {
+		return r.ensureEgressConfigMap(ctx, deployment, d.ConnectionStrings)
+	}); !egressResult.IsOk() {
+		return egressResult, nil
+	}
+
+	if infoResult := ctx.RunStep("connectionInfo", deploymentStepTimeout, func() workflow.Result {
+		return r.ensureConnectionInfo(ctx, deployment, d.ConnectionStrings)
+	}); !infoResult.IsOk() {
+		return infoResult, nil
+	}
+
 	ctx.
 		SetConditionTrue(status.DeploymentReadyType).
 		EnsureStatusOption(status.AtlasDeploymentMongoDBVersionOption(d.MongoDBVersion)).
@@ -455,10 +650,10 @@ func (r *AtlasDeploymentReconciler) handleAdvancedOptions(
 	}
 
 	deploymentName := deployment.GetDeploymentName()
-	context := context.Background()
-	atlasArgs, _, err := ctx.Client.Clusters.GetProcessArgs(context, project.Status.ID, deploymentName)
+	atlasArgs, _, err := ctx.Client.Clusters.GetProcessArgs(ctx.Context, project.Status.ID, deploymentName)
 	if err != nil {
-		return workflow.Terminate(workflow.DeploymentAdvancedOptionsReady, "cannot get process args")
+		return workflow.Terminate(workflow.DeploymentAdvancedOptionsReady, "cannot get process args").
+			WithCause(processArgsReconciler, "getClusterAdvancedConfiguration")
 	}
 
 	if !deployment.Spec.ProcessArgs.IsEqual(atlasArgs) {
@@ -467,10 +662,11 @@ func (r *AtlasDeploymentReconciler) handleAdvancedOptions(
 			return workflow.Terminate(workflow.DeploymentAdvancedOptionsReady, "cannot convert process args to atlas")
 		}
 
-		args, resp, err := ctx.Client.Clusters.UpdateProcessArgs(context, project.Status.ID, deploymentName, options)
+		args, resp, err := ctx.Client.Clusters.UpdateProcessArgs(ctx.Context, project.Status.ID, deploymentName, options)
 		ctx.Log.Debugw("ProcessArgs Update", "args", args, "resp", resp.Body, "err", err)
 		if err != nil {
-			return workflow.Terminate(workflow.DeploymentAdvancedOptionsReady, "cannot update process args")
+			return workflow.Terminate(workflow.DeploymentAdvancedOptionsReady, "cannot update process args").
+				WithCause(processArgsReconciler, "updateClusterAdvancedConfiguration")
 		}
 
 		// TODO(helderjs): Revisit the advanced options configuration to check if this condition should exist or not
@@ -511,6 +707,12 @@ func (r *AtlasDeploymentReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		return err
 	}
 
+	// Watch for the projects moved to another organization
+	err = c.Watch(source.Kind(mgr.GetCache(), &mdbv1.AtlasProject{}), watch.NewProjectDependentsHandler(r.Client, &mdbv1.AtlasDeploymentList{}), watch.ProjectOrganizationChanged())
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -590,6 +792,16 @@ type deploymentHandlerFunc func(workflowCtx *workflow.Context, project *mdbv1.At
 
 type atlasClusterType int
 
+const processArgsReconciler = "processArgs"
+
+// deploymentStepTimeout is the deadline of each of the deployment sub-reconcilers, so that a slow or stuck call to
+// Atlas doesn't hold the reconciliation of the deployment forever
+const deploymentStepTimeout = 2 * time.Minute
+
+// UnknownRegionEvent is the reason of the Warning event emitted for the regions which aren't known Atlas regions of
+// their cloud provider. They are sent to Atlas regardless, as they may be regions added after the operator was released
+const UnknownRegionEvent = "UnknownRegion"
+
 const (
 	Unset atlasClusterType = iota
 	Advanced
@@ -623,6 +835,32 @@ func managedByAtlas(workflowCtx *workflow.Context, projectID string, log *zap.Su
 	}
 }
 
+// markedInAtlas reads the ownership marker from the tags of the deployment in Atlas
+func markedInAtlas(workflowCtx *workflow.Context, projectID string) customresource.MarkerChecker {
+	return func(resource mdbv1.AtlasCustomResource) (bool, bool, error) {
+		deployment, ok := resource.(*mdbv1.AtlasDeployment)
+		if !ok {
+			return false, false, errors.New("failed to match resource type as AtlasDeployment")
+		}
+
+		typedAtlasCluster, err := findTypedAtlasCluster(workflowCtx, projectID, deployment.GetDeploymentName())
+		if typedAtlasCluster == nil || err != nil {
+			return false, false, err
+		}
+
+		var tags []*mongodbatlas.Tag
+		if typedAtlasCluster.clusterType == Serverless {
+			tags = pointer.GetOrDefault(typedAtlasCluster.serverless.Tags, nil)
+		} else {
+			tags = typedAtlasCluster.advanced.Tags
+		}
+
+		marker, marked := trackingMarker(tags)
+
+		return marked, marked && marker.Owns(deployment), nil
+	}
+}
+
 func findTypedAtlasCluster(workflowCtx *workflow.Context, projectID, deploymentName string) (*atlasTypedCluster, error) {
 	advancedCluster, _, err := workflowCtx.Client.AdvancedClusters.Get(workflowCtx.Context, projectID, deploymentName)
 	if err == nil {
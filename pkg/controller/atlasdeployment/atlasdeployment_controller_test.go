@@ -26,6 +26,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
 	"go.mongodb.org/atlas/mongodbatlas"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
@@ -284,6 +285,9 @@ func TestDeploymentDeletionProtection(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.title, func(t *testing.T) {
 			advancedClusterClient := &atlasmock.AdvancedClustersClientMock{
+				GetFunc: func(groupID string, clusterName string) (*mongodbatlas.AdvancedCluster, *mongodbatlas.Response, error) {
+					return &mongodbatlas.AdvancedCluster{Name: clusterName}, nil, nil
+				},
 				DeleteFunc: func(groupID string, clusterName string) (*mongodbatlas.Response, error) {
 					return nil, nil
 				},
@@ -331,6 +335,9 @@ func TestKeepAnnotatedDeploymentAlwaysRemain(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.title, func(t *testing.T) {
 			advancedClusterClient := &atlasmock.AdvancedClustersClientMock{
+				GetFunc: func(groupID string, clusterName string) (*mongodbatlas.AdvancedCluster, *mongodbatlas.Response, error) {
+					return &mongodbatlas.AdvancedCluster{Name: clusterName}, nil, nil
+				},
 				DeleteFunc: func(groupID string, clusterName string) (*mongodbatlas.Response, error) {
 					return nil, nil
 				},
@@ -655,6 +662,10 @@ func testLog(t *testing.T) *zap.SugaredLogger {
 	return zaptest.NewLogger(t).Sugar()
 }
 
+func newContext(t *testing.T) *workflow.Context {
+	return workflow.NewContext(testLog(t), []status.Condition{}, context.Background())
+}
+
 func testPrevResult() workflow.Result {
 	return workflow.Result{}.WithMessage("unchanged")
 }
@@ -946,6 +957,9 @@ func TestReconciliation(t *testing.T) {
 					},
 				}, "0987654321", nil
 			},
+			SdkClientFunc: func(secretRef *client.ObjectKey, log *zap.SugaredLogger) (*admin.APIClient, string, error) {
+				return &admin.APIClient{}, "0987654321", nil
+			},
 			IsCloudGovFunc: func() bool {
 				return false
 			},
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/validate"
 
@@ -30,19 +32,19 @@ func (r *AtlasDeploymentReconciler) ensureBackupScheduleAndPolicy(
 	projectID string,
 	deployment *mdbv1.AtlasDeployment,
 	isEnabled bool,
-) error {
+) (*mongodbatlas.CloudProviderSnapshotBackupPolicy, time.Time, error) {
 	if deployment.Spec.BackupScheduleRef.Name == "" {
 		r.Log.Debug("no backup schedule configured for the deployment")
 
 		err := r.garbageCollectBackupResource(service.Context, deployment.GetDeploymentName())
 		if err != nil {
-			return err
+			return nil, time.Time{}, err
 		}
-		return nil
+		return nil, time.Time{}, nil
 	}
 
 	if !isEnabled {
-		return fmt.Errorf("can not proceed with backup configuration. Backups are not enabled for cluster %s", deployment.GetDeploymentName())
+		return nil, time.Time{}, fmt.Errorf("can not proceed with backup configuration. Backups are not enabled for cluster %s", deployment.GetDeploymentName())
 	}
 
 	resourcesToWatch := []watch.WatchedObject{}
@@ -53,17 +55,106 @@ func (r *AtlasDeploymentReconciler) ensureBackupScheduleAndPolicy(
 
 	bSchedule, err := r.ensureBackupSchedule(service, deployment, &resourcesToWatch)
 	if err != nil {
-		return err
+		return nil, time.Time{}, err
 	}
 
 	bPolicy, err := r.ensureBackupPolicy(service, bSchedule, &resourcesToWatch)
 	if err != nil {
-		return err
+		return nil, time.Time{}, err
+	}
+
+	if err = validate.BackupScheduleRestoreWindow(bSchedule, bPolicy); err != nil {
+		return nil, time.Time{}, err
 	}
 
 	return r.updateBackupScheduleAndPolicy(service.Context, service, projectID, deployment, bSchedule, bPolicy)
 }
 
+// ensureBackupCompatibility warns when the backup configured for the deployment can't be honoured by its tier.
+// The incompatibilities are only reported in a condition and events, they don't prevent the reconciliation
+func (r *AtlasDeploymentReconciler) ensureBackupCompatibility(service *workflow.Context, deployment *mdbv1.AtlasDeployment) {
+	var bSchedule *mdbv1.AtlasBackupSchedule
+	if deployment.Spec.BackupScheduleRef.Name != "" {
+		bSchedule = &mdbv1.AtlasBackupSchedule{}
+		if err := r.Client.Get(service.Context, *deployment.Spec.BackupScheduleRef.GetObject(deployment.Namespace), bSchedule); err != nil {
+			bSchedule = nil
+		}
+	}
+
+	condition := status.TrueCondition(status.DeploymentBackupCompatibleType)
+	if incompatibilities := backupIncompatibilities(deployment, bSchedule); len(incompatibilities) > 0 {
+		condition = status.FalseCondition(status.DeploymentBackupCompatibleType).WithReason(string(workflow.DeploymentBackupIncompatible))
+		condition.Message = strings.Join(incompatibilities, "; ")
+
+		if previous, ok := findCondition(deployment.Status.Conditions, status.DeploymentBackupCompatibleType); !ok || previous.Message != condition.Message {
+			r.EventRecorder.Event(deployment, "Warning", condition.Reason, condition.Message)
+		}
+	}
+	service.EnsureCondition(condition)
+}
+
+// backupIncompatibilities lists the backup settings of the deployment and of its backup schedule which are not
+// supported by the deployment tier. The backup schedule is nil when the deployment doesn't reference one
+func backupIncompatibilities(deployment *mdbv1.AtlasDeployment, bSchedule *mdbv1.AtlasBackupSchedule) []string {
+	var incompatibilities []string
+	instanceSize := deploymentInstanceSize(deployment)
+	sharedTier := isSharedTier(instanceSize)
+	pitEnabled := deployment.Spec.DeploymentSpec.PitEnabled != nil && *deployment.Spec.DeploymentSpec.PitEnabled
+
+	if sharedTier && pitEnabled {
+		incompatibilities = append(incompatibilities, fmt.Sprintf("continuous cloud backup (pitEnabled) is not available on the %s tier, it requires a dedicated cluster", instanceSize))
+	}
+
+	if bSchedule == nil {
+		return incompatibilities
+	}
+
+	if sharedTier {
+		incompatibilities = append(incompatibilities, fmt.Sprintf("backup schedules are not available on the %s tier, shared clusters are only backed up by Atlas snapshots", instanceSize))
+	}
+
+	if !pitEnabled && bSchedule.Spec.RestoreWindowDays > 1 {
+		incompatibilities = append(incompatibilities, fmt.Sprintf("restoreWindowDays %d only applies to continuous cloud backup, which is not enabled on the deployment", bSchedule.Spec.RestoreWindowDays))
+	}
+
+	return incompatibilities
+}
+
+// deploymentBackup returns the backup status of the deployment from the Atlas deployment and its backup policy.
+// The backup policy is nil when the deployment doesn't reference a backup schedule
+func deploymentBackup(deployment *mongodbatlas.AdvancedCluster, backupPolicy *mongodbatlas.CloudProviderSnapshotBackupPolicy) *status.DeploymentBackup {
+	backup := &status.DeploymentBackup{
+		CloudBackupEnabled:      deployment.BackupEnabled != nil && *deployment.BackupEnabled,
+		ContinuousBackupEnabled: deployment.PitEnabled != nil && *deployment.PitEnabled,
+	}
+
+	if backupPolicy == nil {
+		return backup
+	}
+
+	if backup.ContinuousBackupEnabled && backupPolicy.RestoreWindowDays != nil {
+		backup.RestoreWindowDays = *backupPolicy.RestoreWindowDays
+	}
+
+	if backupPolicy.AutoExportEnabled != nil && *backupPolicy.AutoExportEnabled {
+		backup.AutoExportEnabled = true
+		if backupPolicy.Export != nil {
+			backup.ExportFrequencyType = backupPolicy.Export.FrequencyType
+		}
+	}
+
+	return backup
+}
+
+func isSharedTier(instanceSize string) bool {
+	switch instanceSize {
+	case "M0", "M2", "M5":
+		return true
+	}
+
+	return false
+}
+
 func (r *AtlasDeploymentReconciler) ensureBackupSchedule(
 	service *workflow.Context,
 	deployment *mdbv1.AtlasDeployment,
@@ -177,6 +268,9 @@ func (r *AtlasDeploymentReconciler) ensureBackupPolicy(
 	return bPolicy, nil
 }
 
+// updateBackupScheduleAndPolicy applies the backup schedule and policy to the deployment and returns the backup
+// configuration in effect in Atlas, with the time the reference time of the snapshots changes in UTC when the time
+// zone of the schedule has daylight saving time
 func (r *AtlasDeploymentReconciler) updateBackupScheduleAndPolicy(
 	ctx context.Context,
 	service *workflow.Context,
@@ -184,17 +278,17 @@ func (r *AtlasDeploymentReconciler) updateBackupScheduleAndPolicy(
 	deployment *mdbv1.AtlasDeployment,
 	bSchedule *mdbv1.AtlasBackupSchedule,
 	bPolicy *mdbv1.AtlasBackupPolicy,
-) error {
+) (*mongodbatlas.CloudProviderSnapshotBackupPolicy, time.Time, error) {
 	clusterName := deployment.GetDeploymentName()
 	currentSchedule, response, err := service.Client.CloudProviderSnapshotBackupPolicies.Get(ctx, projectID, clusterName)
 	if err != nil {
 		errMessage := "unable to get current backup configuration for project"
 		r.Log.Debugf("%s: %s:%s, %v", errMessage, projectID, clusterName, err)
-		return fmt.Errorf("%s: %s:%s, %w", errMessage, projectID, clusterName, err)
+		return nil, time.Time{}, fmt.Errorf("%s: %s:%s, %w", errMessage, projectID, clusterName, err)
 	}
 
 	if currentSchedule == nil && response != nil {
-		return fmt.Errorf("can not get сurrent backup configuration. response status: %s", response.Status)
+		return nil, time.Time{}, fmt.Errorf("can not get сurrent backup configuration. response status: %s", response.Status)
 	}
 
 	r.Log.Debugf("successfully received backup configuration: %v", currentSchedule)
@@ -202,26 +296,33 @@ func (r *AtlasDeploymentReconciler) updateBackupScheduleAndPolicy(
 	r.Log.Debugf("updating backup configuration for the atlas deployment: %v", clusterName)
 
 	apiScheduleReq := bSchedule.ToAtlas(currentSchedule.ClusterID, clusterName, deployment.GetReplicationSetID(), bPolicy)
+	referenceHour, referenceMinute, referenceTimeChange, err := bSchedule.ReferenceTimeUTC(time.Now())
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	apiScheduleReq.ReferenceHourOfDay = &referenceHour
+	apiScheduleReq.ReferenceMinuteOfHour = &referenceMinute
 
 	// There is only one policy, always
 	apiScheduleReq.Policies[0].ID = currentSchedule.Policies[0].ID
 
 	equal, err := backupSchedulesAreEqual(currentSchedule, apiScheduleReq)
 	if err != nil {
-		return fmt.Errorf("can not compare BackupSchedule resources: %w", err)
+		return nil, time.Time{}, fmt.Errorf("can not compare BackupSchedule resources: %w", err)
 	}
 
 	if equal {
 		r.Log.Debug("backup schedules are equal, nothing to change")
-		return nil
+		return currentSchedule, referenceTimeChange, nil
 	}
 
 	r.Log.Debugf("applying backup configuration: %v", *bSchedule)
-	if _, _, err := service.Client.CloudProviderSnapshotBackupPolicies.Update(ctx, projectID, clusterName, apiScheduleReq); err != nil {
-		return fmt.Errorf("unable to create backup schedule %s. e: %w", client.ObjectKeyFromObject(bSchedule).String(), err)
+	updatedSchedule, _, err := service.Client.CloudProviderSnapshotBackupPolicies.Update(ctx, projectID, clusterName, apiScheduleReq)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("unable to create backup schedule %s. e: %w", client.ObjectKeyFromObject(bSchedule).String(), err)
 	}
 	r.Log.Infof("successfully updated backup configuration for deployment %v", clusterName)
-	return nil
+	return updatedSchedule, referenceTimeChange, nil
 }
 
 func backupSchedulesAreEqual(currentSchedule *mongodbatlas.CloudProviderSnapshotBackupPolicy, newSchedule *mongodbatlas.CloudProviderSnapshotBackupPolicy) (bool, error) {
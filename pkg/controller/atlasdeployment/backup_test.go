@@ -0,0 +1,96 @@
+package atlasdeployment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/atlas/mongodbatlas"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+func TestBackupIncompatibilities(t *testing.T) {
+	schedule := func(restoreWindowDays int64) *mdbv1.AtlasBackupSchedule {
+		return &mdbv1.AtlasBackupSchedule{Spec: mdbv1.AtlasBackupScheduleSpec{RestoreWindowDays: restoreWindowDays}}
+	}
+
+	tests := map[string]struct {
+		instanceSize string
+		pitEnabled   *bool
+		schedule     *mdbv1.AtlasBackupSchedule
+		expected     []string
+	}{
+		"dedicated deployment with continuous backup": {
+			instanceSize: "M10",
+			pitEnabled:   pointer.MakePtr(true),
+			schedule:     schedule(7),
+		},
+		"dedicated deployment with the default restore window": {
+			instanceSize: "M10",
+			schedule:     schedule(1),
+		},
+		"restore window without continuous backup": {
+			instanceSize: "M10",
+			pitEnabled:   pointer.MakePtr(false),
+			schedule:     schedule(7),
+			expected:     []string{"restoreWindowDays 7 only applies to continuous cloud backup, which is not enabled on the deployment"},
+		},
+		"continuous backup on a shared tier": {
+			instanceSize: "M2",
+			pitEnabled:   pointer.MakePtr(true),
+			expected:     []string{"continuous cloud backup (pitEnabled) is not available on the M2 tier, it requires a dedicated cluster"},
+		},
+		"backup schedule on a shared tier": {
+			instanceSize: "M5",
+			schedule:     schedule(1),
+			expected:     []string{"backup schedules are not available on the M5 tier, shared clusters are only backed up by Atlas snapshots"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			deployment := mdbv1.NewDeployment("ns", "deployment", "deployment").WithInstanceSize(tt.instanceSize)
+			deployment.Spec.DeploymentSpec.PitEnabled = tt.pitEnabled
+
+			assert.Equal(t, tt.expected, backupIncompatibilities(deployment, tt.schedule))
+		})
+	}
+}
+
+func TestDeploymentBackup(t *testing.T) {
+	t.Run("should report the backup flags without a backup policy", func(t *testing.T) {
+		deployment := &mongodbatlas.AdvancedCluster{BackupEnabled: pointer.MakePtr(true)}
+
+		assert.Equal(t, &status.DeploymentBackup{CloudBackupEnabled: true}, deploymentBackup(deployment, nil))
+	})
+
+	t.Run("should report the restore window of continuous backup and the export frequency", func(t *testing.T) {
+		deployment := &mongodbatlas.AdvancedCluster{BackupEnabled: pointer.MakePtr(true), PitEnabled: pointer.MakePtr(true)}
+		policy := &mongodbatlas.CloudProviderSnapshotBackupPolicy{
+			RestoreWindowDays: pointer.MakePtr(int64(5)),
+			AutoExportEnabled: pointer.MakePtr(true),
+			Export:            &mongodbatlas.Export{ExportBucketID: "bucket", FrequencyType: "monthly"},
+		}
+
+		assert.Equal(
+			t,
+			&status.DeploymentBackup{
+				CloudBackupEnabled:      true,
+				ContinuousBackupEnabled: true,
+				RestoreWindowDays:       5,
+				AutoExportEnabled:       true,
+				ExportFrequencyType:     "monthly",
+			},
+			deploymentBackup(deployment, policy),
+		)
+	})
+
+	t.Run("should not report the restore window without continuous backup", func(t *testing.T) {
+		deployment := &mongodbatlas.AdvancedCluster{BackupEnabled: pointer.MakePtr(true), PitEnabled: pointer.MakePtr(false)}
+		policy := &mongodbatlas.CloudProviderSnapshotBackupPolicy{RestoreWindowDays: pointer.MakePtr(int64(5))}
+
+		assert.Equal(t, &status.DeploymentBackup{CloudBackupEnabled: true}, deploymentBackup(deployment, policy))
+	})
+}
@@ -0,0 +1,93 @@
+package atlasdeployment
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// ensureDeploymentCapabilities verifies the instance sizes and regions of the deployment are available to the project
+// before they are sent to Atlas, so that a deployment the plan doesn't support is reported in the CapabilitiesSupported
+// condition instead of failing the create or update calls on every reconciliation.
+// The validation is skipped when the capabilities cache is disabled or the capabilities can't be read
+func (r *AtlasDeploymentReconciler) ensureDeploymentCapabilities(workflowCtx *workflow.Context, projectID string, deployment *mdbv1.AtlasDeployment) workflow.Result {
+	if r.CapabilitiesCache == nil || deployment.IsServerless() {
+		return workflow.OK()
+	}
+
+	if workflowCtx.Reapply {
+		r.CapabilitiesCache.Invalidate(projectID)
+	}
+
+	capabilities, err := r.CapabilitiesCache.Get(workflowCtx.Context, workflowCtx.Client.Clusters, projectID)
+	if err != nil {
+		workflowCtx.Log.Debugf("unable to verify the capabilities of the project: %s", err)
+		return workflow.OK()
+	}
+
+	if unsupported := unsupportedCapabilities(capabilities, deployment.Spec.DeploymentSpec); len(unsupported) > 0 {
+		result := workflow.Terminate(workflow.DeploymentCapabilityUnsupported, strings.Join(unsupported, "; "))
+		workflowCtx.SetConditionFromResult(status.DeploymentCapabilitiesSupportedType, result)
+
+		return result
+	}
+
+	workflowCtx.SetConditionTrue(status.DeploymentCapabilitiesSupportedType)
+
+	return workflow.OK()
+}
+
+// unsupportedCapabilities lists, per region of the deployment spec, why the region or its instance sizes are
+// unavailable. The regions shared by several replication specs are reported once
+func unsupportedCapabilities(capabilities *atlas.Capabilities, spec *mdbv1.AdvancedDeploymentSpec) []string {
+	var unsupported []string
+	seen := map[string]bool{}
+	for _, replicationSpec := range spec.ReplicationSpecs {
+		if replicationSpec == nil {
+			continue
+		}
+
+		for _, regionConfig := range replicationSpec.RegionConfigs {
+			if regionConfig == nil {
+				continue
+			}
+
+			region := fmt.Sprintf("%s %s", regionConfig.ProviderName, regionConfig.RegionName)
+			if seen[region] {
+				continue
+			}
+			seen[region] = true
+
+			if reasons := unsupportedRegionConfig(capabilities, regionConfig); len(reasons) > 0 {
+				unsupported = append(unsupported, fmt.Sprintf("%s: %s", region, strings.Join(reasons, ", ")))
+			}
+		}
+	}
+
+	return unsupported
+}
+
+func unsupportedRegionConfig(capabilities *atlas.Capabilities, regionConfig *mdbv1.AdvancedRegionConfig) []string {
+	if reason := capabilities.UnsupportedRegion(regionConfig.ProviderName, regionConfig.RegionName); reason != "" {
+		return []string{reason}
+	}
+
+	var reasons []string
+	for _, specs := range []*mdbv1.Specs{regionConfig.ElectableSpecs, regionConfig.ReadOnlySpecs, regionConfig.AnalyticsSpecs} {
+		if specs == nil {
+			continue
+		}
+
+		reason := capabilities.Unsupported(regionConfig.ProviderName, specs.InstanceSize, regionConfig.RegionName)
+		if reason != "" && !slices.Contains(reasons, reason) {
+			reasons = append(reasons, reason)
+		}
+	}
+
+	return reasons
+}
@@ -0,0 +1,101 @@
+package atlasdeployment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	corev1 "k8s.io/api/core/v1"
+
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestEnsureDeploymentCapabilities(t *testing.T) {
+	newRegionsContext := func(t *testing.T) *workflow.Context {
+		workflowCtx := newContext(t)
+		workflowCtx.Client = &mongodbatlas.Client{
+			Clusters: &atlasmock.ClustersClientMock{
+				ListCloudProviderRegionsFunc: func(projectID string, options *mongodbatlas.CloudProviderRegionsOptions) (*mongodbatlas.CloudProviders, *mongodbatlas.Response, error) {
+					return &mongodbatlas.CloudProviders{
+						Results: []*mongodbatlas.CloudProvider{
+							{
+								Provider: "AWS",
+								InstanceSizes: []*mongodbatlas.InstanceSize{
+									{Name: "M10", AvailableRegions: []*mongodbatlas.AvailableRegion{{Name: "US_EAST_1"}}},
+								},
+							},
+						},
+					}, nil, nil
+				},
+			},
+		}
+
+		return workflowCtx
+	}
+
+	t.Run("should skip the validation when the cache is disabled", func(t *testing.T) {
+		reconciler := &AtlasDeploymentReconciler{}
+		workflowCtx := newContext(t)
+
+		result := reconciler.ensureDeploymentCapabilities(workflowCtx, "project-id", mdbv1.DefaultAwsAdvancedDeployment("ns", "project"))
+
+		assert.True(t, result.IsOk())
+		assert.Empty(t, workflowCtx.Conditions())
+	})
+
+	t.Run("should report the supported capabilities", func(t *testing.T) {
+		reconciler := &AtlasDeploymentReconciler{CapabilitiesCache: atlas.NewCapabilitiesCache(time.Hour)}
+		workflowCtx := newRegionsContext(t)
+
+		result := reconciler.ensureDeploymentCapabilities(workflowCtx, "project-id", mdbv1.DefaultAwsAdvancedDeployment("ns", "project"))
+
+		assert.True(t, result.IsOk())
+		condition, ok := findCondition(workflowCtx.Conditions(), status.DeploymentCapabilitiesSupportedType)
+		require.True(t, ok)
+		assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	})
+
+	t.Run("should reject the unavailable instance sizes", func(t *testing.T) {
+		reconciler := &AtlasDeploymentReconciler{CapabilitiesCache: atlas.NewCapabilitiesCache(time.Hour)}
+		workflowCtx := newRegionsContext(t)
+		deployment := mdbv1.DefaultAwsAdvancedDeployment("ns", "project")
+		deployment.Spec.DeploymentSpec.ReplicationSpecs[0].RegionConfigs[0].ElectableSpecs.InstanceSize = "M700"
+
+		result := reconciler.ensureDeploymentCapabilities(workflowCtx, "project-id", deployment)
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.DeploymentCapabilityUnsupported, result.GetReason())
+		condition, ok := findCondition(workflowCtx.Conditions(), status.DeploymentCapabilitiesSupportedType)
+		require.True(t, ok)
+		assert.Equal(t, corev1.ConditionFalse, condition.Status)
+		assert.Equal(t, "AWS US_EAST_1: instance size M700 is not available on AWS for the project", condition.Message)
+	})
+
+	t.Run("should report the errors of every region of a multi-region deployment", func(t *testing.T) {
+		reconciler := &AtlasDeploymentReconciler{CapabilitiesCache: atlas.NewCapabilitiesCache(time.Hour)}
+		workflowCtx := newRegionsContext(t)
+		deployment := mdbv1.DefaultAwsAdvancedDeployment("ns", "project")
+		regionConfigs := deployment.Spec.DeploymentSpec.ReplicationSpecs[0].RegionConfigs
+		regionConfigs[0].ReadOnlySpecs = &mdbv1.Specs{InstanceSize: "M30"}
+		regionConfigs = append(regionConfigs, &mdbv1.AdvancedRegionConfig{
+			ProviderName:   "AWS",
+			RegionName:     "EU_WEST_1",
+			ElectableSpecs: &mdbv1.Specs{InstanceSize: "M10"},
+		})
+		deployment.Spec.DeploymentSpec.ReplicationSpecs[0].RegionConfigs = regionConfigs
+
+		result := reconciler.ensureDeploymentCapabilities(workflowCtx, "project-id", deployment)
+
+		assert.False(t, result.IsOk())
+		condition, ok := findCondition(workflowCtx.Conditions(), status.DeploymentCapabilitiesSupportedType)
+		require.True(t, ok)
+		assert.Equal(t, "AWS US_EAST_1: instance size M30 is not available on AWS for the project; "+
+			"AWS EU_WEST_1: region EU_WEST_1 is not available on AWS for the project", condition.Message)
+	})
+}
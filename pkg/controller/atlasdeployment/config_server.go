@@ -0,0 +1,94 @@
+package atlasdeployment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	configServerPath = "api/atlas/v2/groups/%s/clusters/%s"
+	// configServerMediaType is the version of the Atlas Admin API the config server fields were released with
+	configServerMediaType = "application/vnd.atlas.2024-08-05+json"
+)
+
+// configServerSettings holds the config server fields of an Atlas cluster
+// TODO: Replace with the atlas-go-client AdvancedCluster fields when they are available
+type configServerSettings struct {
+	ConfigServerManagementMode string `json:"configServerManagementMode,omitempty"`
+	ConfigServerType           string `json:"configServerType,omitempty"`
+}
+
+func getConfigServerSettings(ctx context.Context, client *mongodbatlas.Client, projectID, deploymentName string) (*configServerSettings, error) {
+	req, err := client.NewRequest(ctx, http.MethodGet, fmt.Sprintf(configServerPath, projectID, deploymentName), nil)
+	if err != nil {
+		return nil, err
+	}
+	setConfigServerMediaType(req)
+
+	settings := &configServerSettings{}
+	if _, err = client.Do(ctx, req, settings); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func updateConfigServerManagementMode(ctx context.Context, client *mongodbatlas.Client, projectID, deploymentName, mode string) error {
+	body := &configServerSettings{ConfigServerManagementMode: mode}
+	req, err := client.NewRequest(ctx, http.MethodPatch, fmt.Sprintf(configServerPath, projectID, deploymentName), body)
+	if err != nil {
+		return err
+	}
+	setConfigServerMediaType(req)
+
+	_, err = client.Do(ctx, req, nil)
+
+	return err
+}
+
+// setConfigServerMediaType selects the version of the Admin API the config server fields are available in
+func setConfigServerMediaType(req *http.Request) {
+	req.Header.Set("Accept", configServerMediaType)
+	if req.Header.Get("Content-Type") != "" {
+		req.Header.Set("Content-Type", configServerMediaType)
+	}
+}
+
+// ensureConfigServer reports the config server type of a sharded deployment and applies its config server
+// management mode. The mode isn't part of the advanced deployment comparison as the Atlas client doesn't return it.
+// Nothing is read when the mode is left to Atlas, and a failed read only delays applying the mode
+func ensureConfigServer(ctx *workflow.Context, projectID string, deployment *mdbv1.AtlasDeployment) workflow.Result {
+	spec := deployment.Spec.DeploymentSpec
+	if spec.ConfigServerManagementMode == "" ||
+		spec.ClusterType != string(mdbv1.TypeSharded) && spec.ClusterType != string(mdbv1.TypeGeoSharded) {
+		ctx.EnsureStatusOption(status.AtlasDeploymentConfigServerTypeOption(""))
+		return workflow.OK()
+	}
+
+	settings, err := getConfigServerSettings(ctx.Context, ctx.Client, projectID, spec.Name)
+	if err != nil {
+		ctx.Log.Warnw("failed to read the config server of the deployment, its management mode is applied later", "error", err)
+		return workflow.OK()
+	}
+
+	ctx.EnsureStatusOption(status.AtlasDeploymentConfigServerTypeOption(settings.ConfigServerType))
+
+	if spec.ConfigServerManagementMode == settings.ConfigServerManagementMode {
+		return workflow.OK()
+	}
+
+	ctx.Log.Infof("updating the config server management mode from %q to %q", settings.ConfigServerManagementMode, spec.ConfigServerManagementMode)
+	if err = updateConfigServerManagementMode(ctx.Context, ctx.Client, projectID, spec.Name, spec.ConfigServerManagementMode); err != nil {
+		return workflow.Terminate(workflow.DeploymentNotUpdatedInAtlas, err.Error()).WithAtlasError(err).
+			WithCause(advancedDeploymentReconciler, "updateConfigServer")
+	}
+
+	return workflow.InProgress(workflow.DeploymentUpdating, "config server management mode is updating")
+}
@@ -0,0 +1,107 @@
+package atlasdeployment
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func testConfigServerContext(t *testing.T, handler http.Handler) *workflow.Context {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := mongodbatlas.New(server.Client(), mongodbatlas.SetBaseURL(server.URL+"/"))
+	require.NoError(t, err)
+
+	workflowCtx := newContext(t)
+	workflowCtx.Client = client
+
+	return workflowCtx
+}
+
+func shardedDeployment(mode string) *mdbv1.AtlasDeployment {
+	deployment := mdbv1.DefaultAwsAdvancedDeployment("ns", "project")
+	deployment.Spec.DeploymentSpec.ClusterType = string(mdbv1.TypeSharded)
+	deployment.Spec.DeploymentSpec.ConfigServerManagementMode = mode
+
+	return deployment
+}
+
+func TestEnsureConfigServer(t *testing.T) {
+	t.Run("should skip the replica sets", func(t *testing.T) {
+		workflowCtx := testConfigServerContext(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}))
+
+		result := ensureConfigServer(workflowCtx, "project-id", mdbv1.DefaultAwsAdvancedDeployment("ns", "project"))
+
+		assert.True(t, result.IsOk())
+	})
+
+	t.Run("should report the config server type when the mode is up to date", func(t *testing.T) {
+		workflowCtx := testConfigServerContext(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/atlas/v2/groups/project-id/clusters/test-deployment-advanced", r.URL.Path)
+			assert.Equal(t, "application/vnd.atlas.2024-08-05+json", r.Header.Get("Accept"))
+			writeJSON(w, `{"configServerManagementMode":"ATLAS_MANAGED","configServerType":"EMBEDDED"}`)
+		}))
+		deployment := shardedDeployment(mdbv1.ConfigServerManagementModeAtlasManaged)
+
+		result := ensureConfigServer(workflowCtx, "project-id", deployment)
+
+		assert.True(t, result.IsOk())
+		deploymentStatus := status.AtlasDeploymentStatus{}
+		for _, option := range workflowCtx.StatusOptions() {
+			option.(status.AtlasDeploymentStatusOption)(&deploymentStatus)
+		}
+		assert.Equal(t, "EMBEDDED", deploymentStatus.ConfigServerType)
+	})
+
+	t.Run("should update a drifted config server management mode", func(t *testing.T) {
+		var patched *configServerSettings
+		workflowCtx := testConfigServerContext(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPatch {
+				assert.Equal(t, "application/vnd.atlas.2024-08-05+json", r.Header.Get("Content-Type"))
+				patched = &configServerSettings{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(patched))
+				writeJSON(w, `{}`)
+				return
+			}
+			writeJSON(w, `{"configServerManagementMode":"FIXED_TO_DEDICATED","configServerType":"DEDICATED"}`)
+		}))
+
+		result := ensureConfigServer(workflowCtx, "project-id", shardedDeployment(mdbv1.ConfigServerManagementModeAtlasManaged))
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.DeploymentUpdating, result.GetReason())
+		require.NotNil(t, patched)
+		assert.Equal(t, &configServerSettings{ConfigServerManagementMode: "ATLAS_MANAGED"}, patched)
+	})
+
+	t.Run("should not read the config server when the mode is left to Atlas", func(t *testing.T) {
+		workflowCtx := testConfigServerContext(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}))
+
+		assert.True(t, ensureConfigServer(workflowCtx, "project-id", shardedDeployment("")).IsOk())
+	})
+
+	t.Run("should not fail when the config server can't be read", func(t *testing.T) {
+		workflowCtx := testConfigServerContext(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+
+		assert.True(t, ensureConfigServer(workflowCtx, "project-id", shardedDeployment(mdbv1.ConfigServerManagementModeAtlasManaged)).IsOk())
+	})
+}
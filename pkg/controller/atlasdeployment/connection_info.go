@@ -0,0 +1,139 @@
+package atlasdeployment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/connectionsecret"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	connectionInfoSuffix = "-connection"
+
+	connectionInfoSecret    = "Secret"
+	connectionInfoConfigMap = "ConfigMap"
+)
+
+// ensureConnectionInfo creates or updates the Secret or the ConfigMap holding the connection strings of the deployment,
+// and removes the object previously created when it's disabled, renamed or changes kind. The object is owned by the
+// AtlasDeployment so that it's garbage collected along with it
+func (r *AtlasDeploymentReconciler) ensureConnectionInfo(ctx *workflow.Context, deployment *mdbv1.AtlasDeployment, connectionStrings *mongodbatlas.ConnectionStrings) workflow.Result {
+	ref := connectionInfoRef(deployment)
+
+	if current := deployment.Status.ConnectionInfo; current != "" && current != ref {
+		if err := r.deleteConnectionInfo(ctx.Context, deployment, current); err != nil {
+			return workflow.Terminate(workflow.DeploymentConnectionInfoNotCreated, err.Error())
+		}
+		ctx.EnsureStatusOption(status.AtlasDeploymentConnectionInfoOption(""))
+	}
+
+	if ref == "" {
+		return workflow.OK()
+	}
+
+	policy, err := connectionsecret.NamespaceConnectionStringPolicy(ctx.Context, r.Client, deployment.Namespace)
+	if err != nil {
+		return workflow.Terminate(workflow.DeploymentConnectionInfoNotCreated, err.Error())
+	}
+	info := connectionsecret.ConnectionInfo(connectionStrings, policy)
+	if len(info) == 0 {
+		ctx.Log.Debugw("The connection strings of the deployment aren't available yet, skipping its connection info")
+		return workflow.OK()
+	}
+
+	kind, name, _ := strings.Cut(ref, "/")
+	object := newConnectionInfoObject(kind, deployment.Namespace, name)
+	_, err = controllerutil.CreateOrUpdate(ctx.Context, r.Client, object, func() error {
+		if object.GetResourceVersion() != "" && !metav1.IsControlledBy(object, deployment) {
+			return fmt.Errorf("the %s %s already exists and isn't managed by the AtlasDeployment", kind, name)
+		}
+
+		labels := object.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		for k, v := range deployment.Spec.ConnectionInfo.Labels {
+			labels[k] = v
+		}
+		labels[ExternalNameServiceDeploymentLabel] = deployment.Name
+		object.SetLabels(labels)
+
+		switch o := object.(type) {
+		case *corev1.Secret:
+			o.StringData = nil
+			o.Data = map[string][]byte{}
+			for k, v := range info {
+				o.Data[k] = []byte(v)
+			}
+		case *corev1.ConfigMap:
+			o.Data = info
+		}
+
+		return controllerutil.SetControllerReference(deployment, object, r.Scheme)
+	})
+	if err != nil {
+		return workflow.Terminate(workflow.DeploymentConnectionInfoNotCreated, err.Error())
+	}
+
+	ctx.EnsureStatusOption(status.AtlasDeploymentConnectionInfoOption(ref))
+
+	return workflow.OK()
+}
+
+// deleteConnectionInfo removes the object referenced as kind/name if it's managed by the deployment
+func (r *AtlasDeploymentReconciler) deleteConnectionInfo(ctx context.Context, deployment *mdbv1.AtlasDeployment, ref string) error {
+	kind, name, _ := strings.Cut(ref, "/")
+	object := newConnectionInfoObject(kind, deployment.Namespace, name)
+	err := r.Client.Get(ctx, client.ObjectKeyFromObject(object), object)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !metav1.IsControlledBy(object, deployment) {
+		return nil
+	}
+
+	return client.IgnoreNotFound(r.Client.Delete(ctx, object))
+}
+
+// connectionInfoRef returns the kind and name of the object holding the connection strings, empty when disabled
+func connectionInfoRef(deployment *mdbv1.AtlasDeployment) string {
+	spec := deployment.Spec.ConnectionInfo
+	if spec == nil {
+		return ""
+	}
+
+	kind := spec.Kind
+	if kind == "" {
+		kind = connectionInfoSecret
+	}
+	name := spec.Name
+	if name == "" {
+		name = deployment.Name + connectionInfoSuffix
+	}
+
+	return kind + "/" + name
+}
+
+func newConnectionInfoObject(kind, namespace, name string) client.Object {
+	meta := metav1.ObjectMeta{Name: name, Namespace: namespace}
+	if kind == connectionInfoConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: meta}
+	}
+
+	return &corev1.Secret{ObjectMeta: meta}
+}
@@ -0,0 +1,153 @@
+package atlasdeployment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestEnsureConnectionInfo(t *testing.T) {
+	connectionStrings := &mongodbatlas.ConnectionStrings{
+		Standard:    "mongodb://cluster0-shard-00-00.abcde.mongodb.net:27017/?ssl=true",
+		StandardSrv: "mongodb+srv://cluster0.abcde.mongodb.net",
+	}
+
+	newReconciler := func(t *testing.T, objects ...client.Object) *AtlasDeploymentReconciler {
+		sch := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(sch))
+		require.NoError(t, mdbv1.AddToScheme(sch))
+
+		return &AtlasDeploymentReconciler{
+			Client: fake.NewClientBuilder().WithScheme(sch).WithObjects(objects...).Build(),
+			Scheme: sch,
+		}
+	}
+	newDeployment := func(spec *mdbv1.ConnectionInfoSpec) *mdbv1.AtlasDeployment {
+		deployment := mdbv1.DefaultAWSDeployment("ns", "project")
+		deployment.UID = types.UID("deployment-uid")
+		deployment.Spec.ConnectionInfo = spec
+
+		return deployment
+	}
+	infoStatus := func(ctx *workflow.Context) (string, bool) {
+		deploymentStatus := status.AtlasDeploymentStatus{ConnectionInfo: "unset"}
+		for _, option := range ctx.StatusOptions() {
+			option.(status.AtlasDeploymentStatusOption)(&deploymentStatus)
+		}
+
+		return deploymentStatus.ConnectionInfo, deploymentStatus.ConnectionInfo != "unset"
+	}
+
+	t.Run("should create a Secret with the connection strings by default", func(t *testing.T) {
+		r := newReconciler(t)
+		ctx := newContext(t)
+		deployment := newDeployment(&mdbv1.ConnectionInfoSpec{Labels: map[string]string{"team": "orders"}})
+
+		result := r.ensureConnectionInfo(ctx, deployment, connectionStrings)
+
+		require.True(t, result.IsOk())
+		name := deployment.Name + "-connection"
+		secret := &corev1.Secret{}
+		require.NoError(t, r.Client.Get(context.Background(), client.ObjectKey{Namespace: "ns", Name: name}, secret))
+		assert.Equal(t, map[string][]byte{
+			"connectionStringStandard":    []byte(connectionStrings.Standard),
+			"connectionStringStandardSrv": []byte(connectionStrings.StandardSrv),
+		}, secret.Data)
+		assert.Equal(t, "orders", secret.Labels["team"])
+		assert.Equal(t, deployment.Name, secret.Labels[ExternalNameServiceDeploymentLabel])
+		assert.True(t, metav1.IsControlledBy(secret, deployment))
+		ref, _ := infoStatus(ctx)
+		assert.Equal(t, "Secret/"+name, ref)
+	})
+
+	t.Run("should create a ConfigMap when requested", func(t *testing.T) {
+		r := newReconciler(t)
+		ctx := newContext(t)
+		deployment := newDeployment(&mdbv1.ConnectionInfoSpec{Name: "hosts", Kind: "ConfigMap"})
+
+		result := r.ensureConnectionInfo(ctx, deployment, connectionStrings)
+
+		require.True(t, result.IsOk())
+		configMap := &corev1.ConfigMap{}
+		require.NoError(t, r.Client.Get(context.Background(), client.ObjectKey{Namespace: "ns", Name: "hosts"}, configMap))
+		assert.Equal(t, connectionStrings.StandardSrv, configMap.Data["connectionStringStandardSrv"])
+		ref, _ := infoStatus(ctx)
+		assert.Equal(t, "ConfigMap/hosts", ref)
+	})
+
+	t.Run("should wait for the connection strings", func(t *testing.T) {
+		r := newReconciler(t)
+		ctx := newContext(t)
+		deployment := newDeployment(&mdbv1.ConnectionInfoSpec{})
+
+		result := r.ensureConnectionInfo(ctx, deployment, &mongodbatlas.ConnectionStrings{})
+
+		require.True(t, result.IsOk())
+		_, set := infoStatus(ctx)
+		assert.False(t, set)
+	})
+
+	t.Run("should refuse to overwrite an object it doesn't manage", func(t *testing.T) {
+		deployment := newDeployment(&mdbv1.ConnectionInfoSpec{})
+		existing := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: deployment.Name + "-connection", Namespace: "ns"}}
+		r := newReconciler(t, existing)
+		ctx := newContext(t)
+
+		result := r.ensureConnectionInfo(ctx, deployment, connectionStrings)
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.DeploymentConnectionInfoNotCreated, result.GetReason())
+	})
+
+	t.Run("should delete the previous object when the kind changes", func(t *testing.T) {
+		r := newReconciler(t)
+		deployment := newDeployment(&mdbv1.ConnectionInfoSpec{})
+		ctx := newContext(t)
+		require.True(t, r.ensureConnectionInfo(ctx, deployment, connectionStrings).IsOk())
+
+		name := deployment.Name + "-connection"
+		deployment.Status.ConnectionInfo = "Secret/" + name
+		deployment.Spec.ConnectionInfo.Kind = "ConfigMap"
+		ctx = newContext(t)
+
+		require.True(t, r.ensureConnectionInfo(ctx, deployment, connectionStrings).IsOk())
+		err := r.Client.Get(context.Background(), client.ObjectKey{Namespace: "ns", Name: name}, &corev1.Secret{})
+		assert.True(t, k8serrors.IsNotFound(err))
+		require.NoError(t, r.Client.Get(context.Background(), client.ObjectKey{Namespace: "ns", Name: name}, &corev1.ConfigMap{}))
+		ref, _ := infoStatus(ctx)
+		assert.Equal(t, "ConfigMap/"+name, ref)
+	})
+
+	t.Run("should remove the object once disabled", func(t *testing.T) {
+		r := newReconciler(t)
+		deployment := newDeployment(&mdbv1.ConnectionInfoSpec{})
+		ctx := newContext(t)
+		require.True(t, r.ensureConnectionInfo(ctx, deployment, connectionStrings).IsOk())
+
+		name := deployment.Name + "-connection"
+		deployment.Status.ConnectionInfo = "Secret/" + name
+		deployment.Spec.ConnectionInfo = nil
+		ctx = newContext(t)
+
+		require.True(t, r.ensureConnectionInfo(ctx, deployment, connectionStrings).IsOk())
+		err := r.Client.Get(context.Background(), client.ObjectKey{Namespace: "ns", Name: name}, &corev1.Secret{})
+		assert.True(t, k8serrors.IsNotFound(err))
+		ref, set := infoStatus(ctx)
+		assert.True(t, set)
+		assert.Empty(t, ref)
+	})
+}
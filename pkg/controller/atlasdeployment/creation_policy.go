@@ -0,0 +1,39 @@
+package atlasdeployment
+
+import (
+	"fmt"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// ensureCanCreate checks that the creation policy of the deployment allows the operator to create it in Atlas
+func ensureCanCreate(deployment *mdbv1.AtlasDeployment) workflow.Result {
+	if deployment.Spec.CreationPolicy.AllowsCreation() {
+		return workflow.OK()
+	}
+
+	return workflow.Terminate(
+		workflow.DeploymentCreationPolicyNotMet,
+		fmt.Sprintf("the deployment %s doesn't exist in Atlas and the creation policy is %s", deployment.GetDeploymentName(), deployment.Spec.CreationPolicy),
+	)
+}
+
+// ensureCanAcquire checks that the creation policy of the deployment allows the operator to bind to the deployment
+// found in Atlas. A deployment already reconciled or tagged as created for this resource is always kept
+func ensureCanAcquire(deployment *mdbv1.AtlasDeployment, tags []*mongodbatlas.Tag) workflow.Result {
+	if deployment.Spec.CreationPolicy.AllowsAcquisition() || deployment.Status.StateName != "" {
+		return workflow.OK()
+	}
+
+	if marker, marked := trackingMarker(tags); marked && marker.Owns(deployment) {
+		return workflow.OK()
+	}
+
+	return workflow.Terminate(
+		workflow.DeploymentCreationPolicyNotMet,
+		fmt.Sprintf("the deployment %s already exists in Atlas and the creation policy is %s", deployment.GetDeploymentName(), deployment.Spec.CreationPolicy),
+	)
+}
@@ -0,0 +1,63 @@
+package atlasdeployment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/atlas/mongodbatlas"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestEnsureCanCreate(t *testing.T) {
+	t.Run("should create the deployment by default", func(t *testing.T) {
+		assert.True(t, ensureCanCreate(mdbv1.DefaultAWSDeployment("ns", "project")).IsOk())
+	})
+
+	t.Run("should not create the deployment when it must exist", func(t *testing.T) {
+		deployment := mdbv1.DefaultAWSDeployment("ns", "project")
+		deployment.Spec.CreationPolicy = common.CreationPolicyMustExist
+
+		result := ensureCanCreate(deployment)
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.DeploymentCreationPolicyNotMet, result.GetReason())
+		assert.Equal(t, "the deployment test-deployment-aws doesn't exist in Atlas and the creation policy is MustExist", result.GetMessage())
+	})
+}
+
+func TestEnsureCanAcquire(t *testing.T) {
+	mustNotExist := func() *mdbv1.AtlasDeployment {
+		deployment := mdbv1.DefaultAWSDeployment("ns", "project")
+		deployment.Spec.CreationPolicy = common.CreationPolicyMustNotExist
+
+		return deployment
+	}
+
+	t.Run("should bind to an existing deployment by default", func(t *testing.T) {
+		assert.True(t, ensureCanAcquire(mdbv1.DefaultAWSDeployment("ns", "project"), nil).IsOk())
+	})
+
+	t.Run("should not bind to a deployment created outside of the resource when it must not exist", func(t *testing.T) {
+		result := ensureCanAcquire(mustNotExist(), []*mongodbatlas.Tag{{Key: "team", Value: "data"}})
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.DeploymentCreationPolicyNotMet, result.GetReason())
+		assert.Equal(t, "the deployment test-deployment-aws already exists in Atlas and the creation policy is MustNotExist", result.GetMessage())
+	})
+
+	t.Run("should keep the deployment created for the resource when it must not exist", func(t *testing.T) {
+		deployment := mustNotExist()
+
+		assert.True(t, ensureCanAcquire(deployment, trackingTags(deployment)).IsOk())
+	})
+
+	t.Run("should keep the deployment already reconciled when it must not exist", func(t *testing.T) {
+		deployment := mustNotExist()
+		deployment.Status.StateName = "IDLE"
+
+		assert.True(t, ensureCanAcquire(deployment, nil).IsOk())
+	})
+}
@@ -0,0 +1,183 @@
+package atlasdeployment
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	// EgressConfigMapAtlasAPIKey is the key of the egress ConfigMap holding the endpoint of the Atlas API
+	EgressConfigMapAtlasAPIKey = "atlasAPI"
+	// EgressConfigMapDeploymentKey is the key of the egress ConfigMap holding the endpoints of the deployment nodes,
+	// one host:port per line
+	EgressConfigMapDeploymentKey = "deployment"
+
+	egressConfigMapSuffix = "-egress"
+)
+
+// ensureEgressConfigMap creates or updates the ConfigMap listing the endpoints of the deployment, and removes the
+// ConfigMap previously created when it's disabled or renamed. The ConfigMap is owned by the AtlasDeployment so that
+// it's garbage collected along with it
+func (r *AtlasDeploymentReconciler) ensureEgressConfigMap(ctx *workflow.Context, deployment *mdbv1.AtlasDeployment, connectionStrings *mongodbatlas.ConnectionStrings) workflow.Result {
+	name := egressConfigMapName(deployment)
+
+	if current := deployment.Status.EgressConfigMap; current != "" && current != name {
+		if err := r.deleteOwnedConfigMap(ctx.Context, deployment, current); err != nil {
+			return workflow.Terminate(workflow.DeploymentEgressConfigMapNotCreated, err.Error())
+		}
+		ctx.EnsureStatusOption(status.AtlasDeploymentEgressConfigMapOption(""))
+	}
+
+	if name == "" {
+		return workflow.OK()
+	}
+
+	endpoints := deploymentEndpoints(connectionStrings)
+	if len(endpoints) == 0 {
+		ctx.Log.Debugw("The endpoints of the deployment aren't available yet, skipping its egress ConfigMap")
+		return workflow.OK()
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: deployment.Namespace}}
+	_, err := controllerutil.CreateOrUpdate(ctx.Context, r.Client, configMap, func() error {
+		if configMap.ResourceVersion != "" && !metav1.IsControlledBy(configMap, deployment) {
+			return fmt.Errorf("the ConfigMap %s already exists and isn't managed by the AtlasDeployment", name)
+		}
+
+		if configMap.Labels == nil {
+			configMap.Labels = map[string]string{}
+		}
+		for k, v := range deployment.Spec.EgressConfigMap.Labels {
+			configMap.Labels[k] = v
+		}
+		configMap.Labels[ExternalNameServiceDeploymentLabel] = deployment.Name
+
+		configMap.Data = map[string]string{
+			EgressConfigMapAtlasAPIKey:   atlasAPIEndpoint(r.AtlasDomain),
+			EgressConfigMapDeploymentKey: strings.Join(endpoints, "\n"),
+		}
+
+		return controllerutil.SetControllerReference(deployment, configMap, r.Scheme)
+	})
+	if err != nil {
+		return workflow.Terminate(workflow.DeploymentEgressConfigMapNotCreated, err.Error())
+	}
+
+	ctx.EnsureStatusOption(status.AtlasDeploymentEgressConfigMapOption(name))
+
+	return workflow.OK()
+}
+
+// deleteOwnedConfigMap removes the ConfigMap if it's managed by the deployment
+func (r *AtlasDeploymentReconciler) deleteOwnedConfigMap(ctx context.Context, deployment *mdbv1.AtlasDeployment, name string) error {
+	configMap := &corev1.ConfigMap{}
+	err := r.Client.Get(ctx, client.ObjectKey{Namespace: deployment.Namespace, Name: name}, configMap)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !metav1.IsControlledBy(configMap, deployment) {
+		return nil
+	}
+
+	return client.IgnoreNotFound(r.Client.Delete(ctx, configMap))
+}
+
+func egressConfigMapName(deployment *mdbv1.AtlasDeployment) string {
+	if deployment.Spec.EgressConfigMap == nil {
+		return ""
+	}
+
+	if deployment.Spec.EgressConfigMap.Name != "" {
+		return deployment.Spec.EgressConfigMap.Name
+	}
+
+	return deployment.Name + egressConfigMapSuffix
+}
+
+// deploymentEndpoints returns the sorted host:port endpoints of the deployment nodes, reached through the public
+// network and the private endpoints. Serverless instances only expose their SRV hostname, which is listed instead
+func deploymentEndpoints(connectionStrings *mongodbatlas.ConnectionStrings) []string {
+	if connectionStrings == nil {
+		return nil
+	}
+
+	endpoints := connectionStringHosts(connectionStrings.Standard)
+	for _, privateEndpoint := range connectionStrings.PrivateEndpoint {
+		endpoints = append(endpoints, connectionStringHosts(privateEndpoint.ConnectionString)...)
+	}
+
+	if len(endpoints) == 0 {
+		if hostname := srvHostname(connectionStrings); hostname != "" {
+			endpoints = append(endpoints, net.JoinHostPort(hostname, fmt.Sprint(mongoDBPort)))
+		}
+	}
+
+	slices.Sort(endpoints)
+
+	return slices.Compact(endpoints)
+}
+
+// connectionStringHosts returns the host:port list of a standard mongodb:// connection string
+func connectionStringHosts(connectionString string) []string {
+	hosts, ok := strings.CutPrefix(connectionString, "mongodb://")
+	if !ok {
+		return nil
+	}
+
+	if i := strings.IndexAny(hosts, "/?"); i >= 0 {
+		hosts = hosts[:i]
+	}
+	if i := strings.LastIndex(hosts, "@"); i >= 0 {
+		hosts = hosts[i+1:]
+	}
+
+	var result []string
+	for _, host := range strings.Split(hosts, ",") {
+		if host == "" {
+			continue
+		}
+		if _, _, err := net.SplitHostPort(host); err != nil {
+			host = net.JoinHostPort(host, fmt.Sprint(mongoDBPort))
+		}
+		result = append(result, host)
+	}
+
+	return result
+}
+
+// atlasAPIEndpoint returns the host:port of the Atlas API
+func atlasAPIEndpoint(atlasDomain string) string {
+	u, err := url.Parse(atlasDomain)
+	if err != nil || u.Hostname() == "" {
+		return ""
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = "443"
+		if u.Scheme == "http" {
+			port = "80"
+		}
+	}
+
+	return net.JoinHostPort(u.Hostname(), port)
+}
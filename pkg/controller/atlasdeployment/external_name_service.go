@@ -0,0 +1,175 @@
+package atlasdeployment
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	// ExternalNameServiceDeploymentLabel holds the name of the AtlasDeployment an ExternalName Service points at, or an
+	// egress ConfigMap lists the endpoints of
+	ExternalNameServiceDeploymentLabel = "atlas.mongodb.com/deployment"
+
+	mongoDBPort = 27017
+)
+
+// ensureExternalNameService creates or updates the ExternalName Service of the deployment, and removes the Service
+// previously created when it's disabled or renamed. The Service is owned by the AtlasDeployment so that it's garbage
+// collected along with it
+func (r *AtlasDeploymentReconciler) ensureExternalNameService(ctx *workflow.Context, deployment *mdbv1.AtlasDeployment, connectionStrings *mongodbatlas.ConnectionStrings) workflow.Result {
+	name := externalNameServiceName(deployment)
+
+	if current := deployment.Status.ExternalNameService; current != "" && current != name {
+		if err := r.deleteExternalNameService(ctx.Context, deployment, current); err != nil {
+			return workflow.Terminate(workflow.DeploymentServiceNotCreated, err.Error())
+		}
+		ctx.EnsureStatusOption(status.AtlasDeploymentExternalNameServiceOption(""))
+	}
+
+	if name == "" {
+		return workflow.OK()
+	}
+
+	if errs := validation.IsDNS1035Label(name); len(errs) > 0 {
+		return workflow.Terminate(workflow.DeploymentServiceNotCreated, fmt.Sprintf("invalid Service name %q: %s", name, strings.Join(errs, ", ")))
+	}
+
+	hostname, port := standardHost(connectionStrings)
+	if hostname == "" {
+		ctx.Log.Debugw("The hosts of the deployment aren't available yet, skipping its ExternalName Service")
+		return workflow.OK()
+	}
+
+	spec := deployment.Spec.ExternalNameService
+	service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: deployment.Namespace}}
+	_, err := controllerutil.CreateOrUpdate(ctx.Context, r.Client, service, func() error {
+		if service.ResourceVersion != "" && !metav1.IsControlledBy(service, deployment) {
+			return fmt.Errorf("the Service %s already exists and isn't managed by the AtlasDeployment", name)
+		}
+
+		if service.Labels == nil {
+			service.Labels = map[string]string{}
+		}
+		for k, v := range spec.Labels {
+			service.Labels[k] = v
+		}
+		service.Labels[ExternalNameServiceDeploymentLabel] = deployment.Name
+
+		if len(spec.Annotations) > 0 && service.Annotations == nil {
+			service.Annotations = map[string]string{}
+		}
+		for k, v := range spec.Annotations {
+			service.Annotations[k] = v
+		}
+
+		service.Spec.Type = corev1.ServiceTypeExternalName
+		service.Spec.ExternalName = hostname
+		service.Spec.Ports = []corev1.ServicePort{{
+			Name:       "mongodb",
+			Protocol:   corev1.ProtocolTCP,
+			Port:       int32(port),
+			TargetPort: intstr.FromInt(port),
+		}}
+
+		return controllerutil.SetControllerReference(deployment, service, r.Scheme)
+	})
+	if err != nil {
+		return workflow.Terminate(workflow.DeploymentServiceNotCreated, err.Error())
+	}
+
+	ctx.EnsureStatusOption(status.AtlasDeploymentExternalNameServiceOption(name))
+
+	return workflow.OK()
+}
+
+// deleteExternalNameService removes the Service if it's managed by the deployment
+func (r *AtlasDeploymentReconciler) deleteExternalNameService(ctx context.Context, deployment *mdbv1.AtlasDeployment, name string) error {
+	service := &corev1.Service{}
+	err := r.Client.Get(ctx, client.ObjectKey{Namespace: deployment.Namespace, Name: name}, service)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !metav1.IsControlledBy(service, deployment) {
+		return nil
+	}
+
+	return client.IgnoreNotFound(r.Client.Delete(ctx, service))
+}
+
+func externalNameServiceName(deployment *mdbv1.AtlasDeployment) string {
+	if deployment.Spec.ExternalNameService == nil {
+		return ""
+	}
+
+	if deployment.Spec.ExternalNameService.Name != "" {
+		return deployment.Spec.ExternalNameService.Name
+	}
+
+	return deployment.Name
+}
+
+// standardHost returns the first host of the standard connection string of the deployment, with its port. The SRV
+// hostname can't be used by an ExternalName Service as it only has SRV and TXT records. The drivers discover the other
+// members of the deployment from the host they connect to
+func standardHost(connectionStrings *mongodbatlas.ConnectionStrings) (string, int) {
+	if connectionStrings == nil {
+		return "", 0
+	}
+
+	hosts, found := strings.CutPrefix(connectionStrings.Standard, "mongodb://")
+	if !found {
+		return "", 0
+	}
+	hosts, _, _ = strings.Cut(hosts, "/")
+	hosts, _, _ = strings.Cut(hosts, "?")
+	host, _, _ := strings.Cut(hosts, ",")
+	if host == "" {
+		return "", 0
+	}
+
+	hostname, portValue, err := net.SplitHostPort(host)
+	if err != nil {
+		return host, mongoDBPort
+	}
+	port, err := strconv.Atoi(portValue)
+	if err != nil {
+		return "", 0
+	}
+
+	return hostname, port
+}
+
+// srvHostname returns the hostname of the SRV connection string of the deployment
+func srvHostname(connectionStrings *mongodbatlas.ConnectionStrings) string {
+	if connectionStrings == nil || connectionStrings.StandardSrv == "" {
+		return ""
+	}
+
+	u, err := url.Parse(connectionStrings.StandardSrv)
+	if err != nil {
+		return ""
+	}
+
+	return u.Hostname()
+}
@@ -0,0 +1,131 @@
+package atlasdeployment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestEnsureExternalNameService(t *testing.T) {
+	connectionStrings := &mongodbatlas.ConnectionStrings{
+		Standard:    "mongodb://cluster0-shard-00-00.abcde.mongodb.net:27017,cluster0-shard-00-01.abcde.mongodb.net:27017/?ssl=true",
+		StandardSrv: "mongodb+srv://cluster0.abcde.mongodb.net",
+	}
+
+	newReconciler := func(t *testing.T, objects ...client.Object) *AtlasDeploymentReconciler {
+		sch := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(sch))
+		require.NoError(t, mdbv1.AddToScheme(sch))
+
+		return &AtlasDeploymentReconciler{
+			Client: fake.NewClientBuilder().WithScheme(sch).WithObjects(objects...).Build(),
+			Scheme: sch,
+		}
+	}
+	newDeployment := func(spec *mdbv1.ExternalNameServiceSpec) *mdbv1.AtlasDeployment {
+		deployment := mdbv1.DefaultAWSDeployment("ns", "project")
+		deployment.UID = types.UID("deployment-uid")
+		deployment.Spec.ExternalNameService = spec
+
+		return deployment
+	}
+	serviceStatus := func(ctx *workflow.Context) string {
+		deploymentStatus := status.AtlasDeploymentStatus{}
+		for _, option := range ctx.StatusOptions() {
+			option.(status.AtlasDeploymentStatusOption)(&deploymentStatus)
+		}
+
+		return deploymentStatus.ExternalNameService
+	}
+
+	t.Run("should create the Service pointing at the first host of the standard connection string", func(t *testing.T) {
+		r := newReconciler(t)
+		ctx := newContext(t)
+		deployment := newDeployment(&mdbv1.ExternalNameServiceSpec{Name: "orders-db", Labels: map[string]string{"team": "orders"}})
+
+		result := r.ensureExternalNameService(ctx, deployment, connectionStrings)
+
+		require.True(t, result.IsOk())
+		service := &corev1.Service{}
+		require.NoError(t, r.Client.Get(context.Background(), client.ObjectKey{Namespace: "ns", Name: "orders-db"}, service))
+		assert.Equal(t, corev1.ServiceTypeExternalName, service.Spec.Type)
+		assert.Equal(t, "cluster0-shard-00-00.abcde.mongodb.net", service.Spec.ExternalName)
+		assert.Equal(t, int32(27017), service.Spec.Ports[0].Port)
+		assert.Equal(t, "orders", service.Labels["team"])
+		assert.Equal(t, deployment.Name, service.Labels[ExternalNameServiceDeploymentLabel])
+		assert.True(t, metav1.IsControlledBy(service, deployment))
+		assert.Equal(t, "orders-db", serviceStatus(ctx))
+	})
+
+	t.Run("should not take over a Service it doesn't manage", func(t *testing.T) {
+		r := newReconciler(t, &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "orders-db", Namespace: "ns"}})
+		ctx := newContext(t)
+
+		result := r.ensureExternalNameService(ctx, newDeployment(&mdbv1.ExternalNameServiceSpec{Name: "orders-db"}), connectionStrings)
+
+		assert.Equal(t, workflow.DeploymentServiceNotCreated, result.GetReason())
+	})
+
+	t.Run("should remove the Service once disabled", func(t *testing.T) {
+		r := newReconciler(t)
+		deployment := newDeployment(&mdbv1.ExternalNameServiceSpec{})
+		ctx := newContext(t)
+		require.True(t, r.ensureExternalNameService(ctx, deployment, connectionStrings).IsOk())
+
+		deployment.Spec.ExternalNameService = nil
+		deployment.Status.ExternalNameService = deployment.Name
+		ctx = newContext(t)
+
+		require.True(t, r.ensureExternalNameService(ctx, deployment, connectionStrings).IsOk())
+		err := r.Client.Get(context.Background(), client.ObjectKey{Namespace: "ns", Name: deployment.Name}, &corev1.Service{})
+		assert.True(t, client.IgnoreNotFound(err) == nil && err != nil)
+		assert.Empty(t, serviceStatus(ctx))
+	})
+}
+
+func TestStandardHost(t *testing.T) {
+	tests := map[string]struct {
+		standard         string
+		expectedHostname string
+		expectedPort     int
+	}{
+		"replica set": {
+			standard:         "mongodb://cluster0-shard-00-00.abcde.mongodb.net:27017,cluster0-shard-00-01.abcde.mongodb.net:27017/?ssl=true",
+			expectedHostname: "cluster0-shard-00-00.abcde.mongodb.net",
+			expectedPort:     27017,
+		},
+		"sharded deployment": {
+			standard:         "mongodb://cluster0-shard-00-00.abcde.mongodb.net:27016?ssl=true",
+			expectedHostname: "cluster0-shard-00-00.abcde.mongodb.net",
+			expectedPort:     27016,
+		},
+		"host without port": {
+			standard:         "mongodb://cluster0-shard-00-00.abcde.mongodb.net/",
+			expectedHostname: "cluster0-shard-00-00.abcde.mongodb.net",
+			expectedPort:     27017,
+		},
+		"not available yet": {},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			hostname, port := standardHost(&mongodbatlas.ConnectionStrings{Standard: tt.standard})
+
+			assert.Equal(t, tt.expectedHostname, hostname)
+			assert.Equal(t, tt.expectedPort, port)
+		})
+	}
+}
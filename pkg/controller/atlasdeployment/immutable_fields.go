@@ -0,0 +1,234 @@
+package atlasdeployment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	// RecreateAnnotation allows changing the fields Atlas can't update in place. When set to "true", the operator
+	// deletes the deployment in Atlas and creates it again from the spec
+	RecreateAnnotation = "mongodb.com/atlas-recreate"
+	// DeploymentRecreatingEvent is the reason of the event emitted when the operator deletes a deployment to recreate it
+	DeploymentRecreatingEvent = "DeploymentRecreating"
+)
+
+// ensureImmutableFields compares the spec with the last applied one and rejects the changes Atlas can't perform in
+// place, i.e. renaming the deployment, switching between serverless and dedicated, changing the provider of shared
+// and serverless deployments. With the recreate annotation the previous deployment is deleted from Atlas instead, so
+// that the new one is created by the rest of the reconciliation. The annotation is consumed by the deletion so that
+// it never applies to a later change, and it's refused when the deployment must be kept in Atlas on deletion
+func (r *AtlasDeploymentReconciler) ensureImmutableFields(workflowCtx *workflow.Context, project *mdbv1.AtlasProject, deployment *mdbv1.AtlasDeployment) workflow.Result {
+	previous, err := lastAppliedDeployment(deployment)
+	if err != nil {
+		return workflow.Terminate(workflow.Internal, fmt.Sprintf("failed to read the last applied configuration: %s", err))
+	}
+	if previous == nil {
+		return workflow.OK()
+	}
+
+	changes := immutableFieldChanges(previous, deployment)
+	if len(changes) == 0 {
+		return workflow.OK()
+	}
+
+	stateName, err := atlasDeploymentState(workflowCtx, project.ID(), previous)
+	if err != nil {
+		return workflow.Terminate(workflow.DeploymentNotUpdatedInAtlas, err.Error()).WithAtlasError(err)
+	}
+	switch stateName {
+	case "":
+		// the previous deployment is gone, the rest of the reconciliation creates the new one
+		return workflow.OK()
+	case status.StateDELETING:
+		return workflow.InProgress(workflow.DeploymentRecreating, fmt.Sprintf("deployment %s is being deleted to be recreated", previous.GetDeploymentName()))
+	}
+
+	if !isRecreateRequested(deployment) {
+		return workflow.Terminate(
+			workflow.DeploymentImmutableFieldChanged,
+			fmt.Sprintf("%s. Revert the change, or set the annotation %s=true to delete the deployment in Atlas and create it again",
+				strings.Join(changes, "; "), RecreateAnnotation),
+		).WithoutRetry()
+	}
+
+	if customresource.IsResourcePolicyKeepOrDefault(deployment, r.ObjectDeletionProtection) {
+		return workflow.Terminate(
+			workflow.DeploymentImmutableFieldChanged,
+			fmt.Sprintf("%s. The deployment can't be recreated as it's kept in Atlas by the %s annotation or the deletion protection of the operator",
+				strings.Join(changes, "; "), customresource.ResourcePolicyAnnotation),
+		).WithoutRetry()
+	}
+
+	if isTerminationProtectionEnabled(previous) {
+		return workflow.Terminate(
+			workflow.DeploymentImmutableFieldChanged,
+			fmt.Sprintf("termination protection is enabled for the deployment %s, disable it before recreating the deployment", previous.GetDeploymentName()),
+		)
+	}
+
+	return r.deletePreviousDeployment(workflowCtx, project, deployment, previous)
+}
+
+// deletePreviousDeployment consumes the recreate annotation and deletes the previous deployment from Atlas. The
+// annotation is removed first, so that a failed deletion never leaves it behind for a later change
+func (r *AtlasDeploymentReconciler) deletePreviousDeployment(workflowCtx *workflow.Context, project *mdbv1.AtlasProject, deployment, previous *mdbv1.AtlasDeployment) workflow.Result {
+	if err := r.consumeRecreateAnnotation(workflowCtx.Context, deployment); err != nil {
+		return workflow.Terminate(workflow.DeploymentNotUpdatedInAtlas, fmt.Sprintf("failed to remove the %s annotation: %s", RecreateAnnotation, err))
+	}
+
+	if err := r.deleteDeploymentFromAtlas(workflowCtx, workflowCtx.Log, project, previous); err != nil {
+		return workflow.Terminate(workflow.DeploymentNotUpdatedInAtlas, err.Error()).WithAtlasError(err)
+	}
+	r.EventRecorder.Eventf(deployment, "Normal", DeploymentRecreatingEvent, "Deleting deployment %s in Atlas to recreate it", previous.GetDeploymentName())
+
+	return workflow.InProgress(workflow.DeploymentRecreating, fmt.Sprintf("deployment %s is being deleted to be recreated", previous.GetDeploymentName()))
+}
+
+func (r *AtlasDeploymentReconciler) consumeRecreateAnnotation(ctx context.Context, deployment *mdbv1.AtlasDeployment) error {
+	patch := client.MergeFrom(deployment.DeepCopy())
+	annotations := deployment.GetAnnotations()
+	delete(annotations, RecreateAnnotation)
+	deployment.SetAnnotations(annotations)
+
+	return r.Client.Patch(ctx, deployment, patch)
+}
+
+// atlasDeploymentState returns the state of the deployment in Atlas, or an empty state when it doesn't exist
+func atlasDeploymentState(workflowCtx *workflow.Context, projectID string, deployment *mdbv1.AtlasDeployment) (string, error) {
+	var stateName string
+	var err error
+	if deployment.IsServerless() {
+		var instance *mongodbatlas.Cluster
+		instance, _, err = workflowCtx.Client.ServerlessInstances.Get(workflowCtx.Context, projectID, deployment.GetDeploymentName())
+		if instance != nil {
+			stateName = instance.StateName
+		}
+	} else {
+		var cluster *mongodbatlas.AdvancedCluster
+		cluster, _, err = workflowCtx.Client.AdvancedClusters.Get(workflowCtx.Context, projectID, deployment.GetDeploymentName())
+		if cluster != nil {
+			stateName = cluster.StateName
+		}
+	}
+
+	var apiError *mongodbatlas.ErrorResponse
+	if errors.As(err, &apiError) && (apiError.ErrorCode == atlas.ClusterNotFound || apiError.ErrorCode == atlas.ServerlessInstanceNotFound) {
+		return "", nil
+	}
+
+	return stateName, err
+}
+
+func isRecreateRequested(deployment *mdbv1.AtlasDeployment) bool {
+	return strings.EqualFold(deployment.GetAnnotations()[RecreateAnnotation], "true")
+}
+
+// lastAppliedDeployment returns the deployment as it was last applied to Atlas, or nil if it never was
+func lastAppliedDeployment(deployment *mdbv1.AtlasDeployment) (*mdbv1.AtlasDeployment, error) {
+	lastApplied, ok := deployment.GetAnnotations()[customresource.AnnotationLastAppliedConfiguration]
+	if !ok {
+		return nil, nil
+	}
+
+	previous := deployment.DeepCopy()
+	previous.Spec = mdbv1.AtlasDeploymentSpec{}
+	if err := json.Unmarshal([]byte(lastApplied), &previous.Spec); err != nil {
+		return nil, err
+	}
+	if !previous.IsServerless() && !previous.IsAdvancedDeployment() {
+		return nil, nil
+	}
+
+	return previous, nil
+}
+
+// validateVersionReleaseSystem rejects going back from the CONTINUOUS to the LTS version release system, which Atlas
+// doesn't support. The change is a validation error only: unlike the immutable fields it is never fixed by recreating
+// the deployment
+func validateVersionReleaseSystem(deployment *mdbv1.AtlasDeployment) error {
+	previous, err := lastAppliedDeployment(deployment)
+	if err != nil || previous == nil || !previous.IsAdvancedDeployment() || !deployment.IsAdvancedDeployment() {
+		return nil
+	}
+
+	if previous.Spec.DeploymentSpec.VersionReleaseSystem == mdbv1.VersionReleaseSystemContinuous &&
+		deployment.Spec.DeploymentSpec.VersionReleaseSystem == mdbv1.VersionReleaseSystemLTS {
+		return errors.New("spec.deploymentSpec.versionReleaseSystem can't be changed from CONTINUOUS back to LTS")
+	}
+
+	return nil
+}
+
+// immutableFieldChanges lists the changes between both deployments that Atlas can't perform in place
+func immutableFieldChanges(previous, current *mdbv1.AtlasDeployment) []string {
+	var changes []string
+
+	switch {
+	case previous.IsServerless() && current.IsAdvancedDeployment():
+		return []string{"a serverless instance can't be changed to a dedicated deployment"}
+	case previous.IsAdvancedDeployment() && current.IsServerless():
+		return []string{"a dedicated deployment can't be changed to a serverless instance"}
+	case current.IsServerless():
+		if previous.GetDeploymentName() != current.GetDeploymentName() {
+			changes = append(changes, fmt.Sprintf("spec.serverlessSpec.name can't be changed from %q to %q", previous.GetDeploymentName(), current.GetDeploymentName()))
+		}
+		previousProvider := serverlessBackingProvider(previous.Spec.ServerlessSpec)
+		currentProvider := serverlessBackingProvider(current.Spec.ServerlessSpec)
+		if previousProvider != currentProvider {
+			changes = append(changes, fmt.Sprintf("spec.serverlessSpec.providerSettings.backingProviderName can't be changed from %q to %q", previousProvider, currentProvider))
+		}
+	case current.IsAdvancedDeployment():
+		if previous.GetDeploymentName() != current.GetDeploymentName() {
+			changes = append(changes, fmt.Sprintf("spec.deploymentSpec.name can't be changed from %q to %q", previous.GetDeploymentName(), current.GetDeploymentName()))
+		}
+		previousTenant, previousBacking := tenantBackingProvider(previous.Spec.DeploymentSpec)
+		currentTenant, currentBacking := tenantBackingProvider(current.Spec.DeploymentSpec)
+		switch {
+		case !previousTenant && currentTenant:
+			changes = append(changes, "a dedicated deployment can't be changed to a shared (TENANT) deployment")
+		case previousTenant && currentTenant && previousBacking != currentBacking:
+			changes = append(changes, fmt.Sprintf("the backingProviderName of a shared deployment can't be changed from %q to %q", previousBacking, currentBacking))
+		}
+	}
+
+	return changes
+}
+
+func serverlessBackingProvider(spec *mdbv1.ServerlessSpec) string {
+	if spec.ProviderSettings == nil {
+		return ""
+	}
+
+	return spec.ProviderSettings.BackingProviderName
+}
+
+// tenantBackingProvider reports whether the deployment is a shared one, and the provider hosting it. Shared
+// deployments can be upgraded to dedicated ones in place, but not the other way round
+func tenantBackingProvider(spec *mdbv1.AdvancedDeploymentSpec) (bool, string) {
+	for _, replicationSpec := range spec.ReplicationSpecs {
+		if replicationSpec == nil {
+			continue
+		}
+		for _, regionConfig := range replicationSpec.RegionConfigs {
+			if regionConfig != nil && regionConfig.ProviderName == string(provider.ProviderTenant) {
+				return true, regionConfig.BackingProviderName
+			}
+		}
+	}
+
+	return false, ""
+}
@@ -0,0 +1,249 @@
+package atlasdeployment
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	atlasapi "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func withLastApplied(t *testing.T, deployment, lastApplied *mdbv1.AtlasDeployment) *mdbv1.AtlasDeployment {
+	t.Helper()
+	js, err := json.Marshal(lastApplied.Spec)
+	require.NoError(t, err)
+	deployment.SetAnnotations(map[string]string{customresource.AnnotationLastAppliedConfiguration: string(js)})
+
+	return deployment
+}
+
+func TestImmutableFieldChanges(t *testing.T) {
+	tenant := func(backingProvider string) *mdbv1.AtlasDeployment {
+		return mdbv1.NewDeployment("ns", "deployment", "cluster0").
+			WithProviderName(provider.ProviderTenant).
+			WithBackingProvider(backingProvider).
+			WithInstanceSize("M2")
+	}
+
+	serverless := func(name, backingProvider string) *mdbv1.AtlasDeployment {
+		deployment := mdbv1.NewDefaultAWSServerlessInstance("ns", "project")
+		deployment.Spec.ServerlessSpec.Name = name
+		deployment.Spec.ServerlessSpec.ProviderSettings.BackingProviderName = backingProvider
+
+		return deployment
+	}
+
+	withReleaseSystem := func(deployment *mdbv1.AtlasDeployment, releaseSystem string) *mdbv1.AtlasDeployment {
+		deployment.Spec.DeploymentSpec.VersionReleaseSystem = releaseSystem
+
+		return deployment
+	}
+
+	tests := map[string]struct {
+		previous *mdbv1.AtlasDeployment
+		current  *mdbv1.AtlasDeployment
+		changes  []string
+	}{
+		"should allow mutable changes": {
+			previous: mdbv1.NewDeployment("ns", "deployment", "cluster0"),
+			current:  mdbv1.NewDeployment("ns", "deployment", "cluster0").WithInstanceSize("M20").WithProviderName(provider.ProviderGCP),
+		},
+		"should reject renaming a deployment": {
+			previous: mdbv1.NewDeployment("ns", "deployment", "cluster0"),
+			current:  mdbv1.NewDeployment("ns", "deployment", "cluster1"),
+			changes:  []string{`spec.deploymentSpec.name can't be changed from "cluster0" to "cluster1"`},
+		},
+		"should reject renaming a serverless instance": {
+			previous: serverless("serverless0", "AWS"),
+			current:  serverless("serverless1", "AWS"),
+			changes:  []string{`spec.serverlessSpec.name can't be changed from "serverless0" to "serverless1"`},
+		},
+		"should reject changing the provider of a serverless instance": {
+			previous: serverless("serverless0", "AWS"),
+			current:  serverless("serverless0", "GCP"),
+			changes:  []string{`spec.serverlessSpec.providerSettings.backingProviderName can't be changed from "AWS" to "GCP"`},
+		},
+		"should reject switching a serverless instance to a dedicated deployment": {
+			previous: serverless("serverless0", "AWS"),
+			current:  mdbv1.NewDeployment("ns", "deployment", "serverless0"),
+			changes:  []string{"a serverless instance can't be changed to a dedicated deployment"},
+		},
+		"should allow upgrading a shared deployment to a dedicated one": {
+			previous: tenant("AWS"),
+			current:  mdbv1.NewDeployment("ns", "deployment", "cluster0"),
+		},
+		"should reject downgrading a dedicated deployment to a shared one": {
+			previous: mdbv1.NewDeployment("ns", "deployment", "cluster0"),
+			current:  tenant("AWS"),
+			changes:  []string{"a dedicated deployment can't be changed to a shared (TENANT) deployment"},
+		},
+		"should reject changing the provider of a shared deployment": {
+			previous: tenant("AWS"),
+			current:  tenant("GCP"),
+			changes:  []string{`the backingProviderName of a shared deployment can't be changed from "AWS" to "GCP"`},
+		},
+		"should allow moving a deployment from LTS to CONTINUOUS releases": {
+			previous: withReleaseSystem(mdbv1.NewDeployment("ns", "deployment", "cluster0"), mdbv1.VersionReleaseSystemLTS),
+			current:  withReleaseSystem(mdbv1.NewDeployment("ns", "deployment", "cluster0"), mdbv1.VersionReleaseSystemContinuous),
+		},
+		"should leave moving a deployment from CONTINUOUS back to LTS releases to the validation": {
+			previous: withReleaseSystem(mdbv1.NewDeployment("ns", "deployment", "cluster0"), mdbv1.VersionReleaseSystemContinuous),
+			current:  withReleaseSystem(mdbv1.NewDeployment("ns", "deployment", "cluster0"), mdbv1.VersionReleaseSystemLTS),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.changes, immutableFieldChanges(tt.previous, tt.current))
+		})
+	}
+}
+
+func TestValidateVersionReleaseSystem(t *testing.T) {
+	deployment := func(previous, current string) *mdbv1.AtlasDeployment {
+		lastApplied := mdbv1.NewDeployment("ns", "deployment", "cluster0")
+		lastApplied.Spec.DeploymentSpec.VersionReleaseSystem = previous
+		deployment := mdbv1.NewDeployment("ns", "deployment", "cluster0")
+		deployment.Spec.DeploymentSpec.VersionReleaseSystem = current
+
+		return withLastApplied(t, deployment, lastApplied)
+	}
+
+	t.Run("should reject moving a deployment from CONTINUOUS back to LTS releases", func(t *testing.T) {
+		err := validateVersionReleaseSystem(deployment(mdbv1.VersionReleaseSystemContinuous, mdbv1.VersionReleaseSystemLTS))
+
+		assert.EqualError(t, err, "spec.deploymentSpec.versionReleaseSystem can't be changed from CONTINUOUS back to LTS")
+	})
+
+	t.Run("should allow the other changes", func(t *testing.T) {
+		assert.NoError(t, validateVersionReleaseSystem(deployment(mdbv1.VersionReleaseSystemLTS, mdbv1.VersionReleaseSystemContinuous)))
+		assert.NoError(t, validateVersionReleaseSystem(mdbv1.NewDeployment("ns", "deployment", "cluster0")))
+	})
+}
+
+func TestEnsureImmutableFields(t *testing.T) {
+	project := &mdbv1.AtlasProject{Status: status.AtlasProjectStatus{ID: "project-id"}}
+	renamed := func(t *testing.T, recreate bool) *mdbv1.AtlasDeployment {
+		deployment := withLastApplied(t, mdbv1.NewDeployment("ns", "deployment", "cluster1"), mdbv1.NewDeployment("ns", "deployment", "cluster0"))
+		if recreate {
+			deployment.Annotations[RecreateAnnotation] = "true"
+		}
+
+		return deployment
+	}
+	newReconciler := func(clusters *atlas.AdvancedClustersClientMock, objects ...client.Object) (*AtlasDeploymentReconciler, *workflow.Context, *record.FakeRecorder) {
+		recorder := record.NewFakeRecorder(10)
+		workflowCtx := &workflow.Context{
+			Client:  &mongodbatlas.Client{AdvancedClusters: clusters},
+			Context: context.Background(),
+			Log:     testLog(t),
+		}
+		k8sClient := testK8sClient()
+		for _, object := range objects {
+			require.NoError(t, k8sClient.Create(context.Background(), object))
+		}
+
+		return &AtlasDeploymentReconciler{Client: k8sClient, EventRecorder: recorder}, workflowCtx, recorder
+	}
+	idleCluster := func(projectID string, clusterName string) (*mongodbatlas.AdvancedCluster, *mongodbatlas.Response, error) {
+		return &mongodbatlas.AdvancedCluster{Name: clusterName, StateName: status.StateIDLE}, nil, nil
+	}
+
+	t.Run("should accept deployments never applied", func(t *testing.T) {
+		r, workflowCtx, _ := newReconciler(&atlas.AdvancedClustersClientMock{})
+
+		assert.True(t, r.ensureImmutableFields(workflowCtx, project, mdbv1.NewDeployment("ns", "deployment", "cluster0")).IsOk())
+	})
+
+	t.Run("should reject renaming the deployment without the recreate annotation", func(t *testing.T) {
+		r, workflowCtx, _ := newReconciler(&atlas.AdvancedClustersClientMock{GetFunc: idleCluster})
+
+		result := r.ensureImmutableFields(workflowCtx, project, renamed(t, false))
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.DeploymentImmutableFieldChanged, result.GetReason())
+		assert.Contains(t, result.GetMessage(), RecreateAnnotation)
+	})
+
+	t.Run("should delete the previous deployment when recreating and consume the annotation", func(t *testing.T) {
+		deleted := ""
+		deployment := renamed(t, true)
+		r, workflowCtx, recorder := newReconciler(&atlas.AdvancedClustersClientMock{
+			GetFunc: idleCluster,
+			DeleteFunc: func(projectID string, clusterName string) (*mongodbatlas.Response, error) {
+				deleted = clusterName
+				return nil, nil
+			},
+		}, deployment)
+
+		result := r.ensureImmutableFields(workflowCtx, project, deployment)
+
+		assert.True(t, result.IsInProgress())
+		assert.Equal(t, workflow.DeploymentRecreating, result.GetReason())
+		assert.Equal(t, "cluster0", deleted)
+		require.Len(t, recorder.Events, 1)
+		assert.Equal(t, "Normal DeploymentRecreating Deleting deployment cluster0 in Atlas to recreate it", <-recorder.Events)
+		updated := &mdbv1.AtlasDeployment{}
+		require.NoError(t, r.Client.Get(context.Background(), client.ObjectKeyFromObject(deployment), updated))
+		assert.NotContains(t, updated.Annotations, RecreateAnnotation)
+		assert.Contains(t, updated.Annotations, customresource.AnnotationLastAppliedConfiguration)
+	})
+
+	t.Run("should refuse to recreate a deployment kept in Atlas", func(t *testing.T) {
+		kept := renamed(t, true)
+		kept.Annotations[customresource.ResourcePolicyAnnotation] = customresource.ResourcePolicyKeep
+		protected := renamed(t, true)
+		for _, deployment := range []*mdbv1.AtlasDeployment{kept, protected} {
+			r, workflowCtx, _ := newReconciler(&atlas.AdvancedClustersClientMock{
+				GetFunc: idleCluster,
+				DeleteFunc: func(projectID string, clusterName string) (*mongodbatlas.Response, error) {
+					t.Fatal("the deployment must not be deleted")
+					return nil, nil
+				},
+			})
+			r.ObjectDeletionProtection = deployment == protected
+
+			result := r.ensureImmutableFields(workflowCtx, project, deployment)
+
+			assert.False(t, result.IsOk())
+			assert.Equal(t, workflow.DeploymentImmutableFieldChanged, result.GetReason())
+			assert.Contains(t, result.GetMessage(), "can't be recreated")
+		}
+	})
+
+	t.Run("should wait for the previous deployment to be deleted", func(t *testing.T) {
+		r, workflowCtx, _ := newReconciler(&atlas.AdvancedClustersClientMock{
+			GetFunc: func(projectID string, clusterName string) (*mongodbatlas.AdvancedCluster, *mongodbatlas.Response, error) {
+				return &mongodbatlas.AdvancedCluster{Name: clusterName, StateName: status.StateDELETING}, nil, nil
+			},
+		})
+
+		result := r.ensureImmutableFields(workflowCtx, project, renamed(t, false))
+
+		assert.True(t, result.IsInProgress())
+		assert.Equal(t, workflow.DeploymentRecreating, result.GetReason())
+	})
+
+	t.Run("should proceed once the previous deployment is gone", func(t *testing.T) {
+		r, workflowCtx, _ := newReconciler(&atlas.AdvancedClustersClientMock{
+			GetFunc: func(projectID string, clusterName string) (*mongodbatlas.AdvancedCluster, *mongodbatlas.Response, error) {
+				return nil, nil, &mongodbatlas.ErrorResponse{HTTPCode: http.StatusNotFound, ErrorCode: atlasapi.ClusterNotFound}
+			},
+		})
+
+		assert.True(t, r.ensureImmutableFields(workflowCtx, project, renamed(t, false)).IsOk())
+	})
+}
@@ -0,0 +1,219 @@
+package atlasdeployment
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	// IPAddressReportInboundKey is the key of the IP address ConfigMap holding the addresses the applications connect
+	// to, one per line
+	IPAddressReportInboundKey = "inbound"
+	// IPAddressReportOutboundKey is the key of the IP address ConfigMap holding the addresses the deployment connects
+	// from, one per line
+	IPAddressReportOutboundKey = "outbound"
+	// IPAddressReportLastChangedKey is the key of the IP address ConfigMap holding the time the addresses last changed
+	IPAddressReportLastChangedKey = "lastChanged"
+
+	ipAddressReportSuffix = "-ip-addresses"
+
+	defaultIPAddressReportInterval = time.Hour
+)
+
+// ensureIPAddressReport creates or updates the ConfigMap listing the public IP addresses of the deployment nodes,
+// and removes the ConfigMap previously created when it's disabled or renamed. The addresses are read from Atlas once
+// per refresh interval and a Normal event is recorded when they change.
+// Failing to read them is not a reason to fail the reconciliation, the previously read addresses are kept instead.
+func (r *AtlasDeploymentReconciler) ensureIPAddressReport(ctx *workflow.Context, projectID string, deployment *mdbv1.AtlasDeployment) workflow.Result {
+	name := ipAddressReportName(deployment)
+	current := deployment.Status.IPAddresses
+
+	if current != nil && current.ConfigMap != "" && current.ConfigMap != name {
+		if err := r.deleteOwnedConfigMap(ctx.Context, deployment, current.ConfigMap); err != nil {
+			return workflow.Terminate(workflow.DeploymentIPAddressReportNotCreated, err.Error())
+		}
+	}
+
+	if name == "" {
+		if current != nil {
+			ctx.EnsureStatusOption(status.AtlasDeploymentIPAddressesOption(nil))
+		}
+		return workflow.OK()
+	}
+
+	now := time.Now().UTC()
+	report := current.DeepCopy()
+	if ctx.Reapply || ipAddressesOutdated(report, ipAddressReportInterval(deployment), now) {
+		ipAddresses, err := deploymentIPAddresses(ctx.Context, ctx.SdkClient.ProjectsApi, projectID, deployment.GetDeploymentName())
+		switch {
+		case err != nil:
+			ctx.Log.Warnf("unable to read the IP addresses of the deployment: %s", err)
+		case ipAddresses == nil:
+			ctx.Log.Debugw("Atlas doesn't report the IP addresses of the deployment yet, skipping its IP address ConfigMap")
+		default:
+			report = r.updateIPAddresses(deployment, report, ipAddresses, now)
+		}
+	}
+
+	if report == nil {
+		return workflow.OK()
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: deployment.Namespace}}
+	_, err := controllerutil.CreateOrUpdate(ctx.Context, r.Client, configMap, func() error {
+		if configMap.ResourceVersion != "" && !metav1.IsControlledBy(configMap, deployment) {
+			return fmt.Errorf("the ConfigMap %s already exists and isn't managed by the AtlasDeployment", name)
+		}
+
+		if configMap.Labels == nil {
+			configMap.Labels = map[string]string{}
+		}
+		for k, v := range deployment.Spec.IPAddressReport.Labels {
+			configMap.Labels[k] = v
+		}
+		configMap.Labels[ExternalNameServiceDeploymentLabel] = deployment.Name
+
+		configMap.Data = map[string]string{
+			IPAddressReportInboundKey:     strings.Join(report.Inbound, "\n"),
+			IPAddressReportOutboundKey:    strings.Join(report.Outbound, "\n"),
+			IPAddressReportLastChangedKey: report.LastChanged,
+		}
+
+		return controllerutil.SetControllerReference(deployment, configMap, r.Scheme)
+	})
+	if err != nil {
+		return workflow.Terminate(workflow.DeploymentIPAddressReportNotCreated, err.Error())
+	}
+
+	report.ConfigMap = name
+	ctx.EnsureStatusOption(status.AtlasDeploymentIPAddressesOption(report))
+
+	return workflow.OK()
+}
+
+// updateIPAddresses returns the report holding the addresses just read from Atlas, and records an event listing the
+// addresses added and removed when they differ from the previously reported ones
+func (r *AtlasDeploymentReconciler) updateIPAddresses(deployment *mdbv1.AtlasDeployment, previous, current *status.DeploymentIPAddresses, now time.Time) *status.DeploymentIPAddresses {
+	current.LastUpdated = now.Format(time.RFC3339)
+	current.LastChanged = current.LastUpdated
+
+	if previous == nil || previous.LastUpdated == "" {
+		return current
+	}
+
+	if slices.Equal(previous.Inbound, current.Inbound) && slices.Equal(previous.Outbound, current.Outbound) {
+		current.LastChanged = previous.LastChanged
+		return current
+	}
+
+	added, removed := diffIPAddresses(
+		append(slices.Clone(previous.Inbound), previous.Outbound...),
+		append(slices.Clone(current.Inbound), current.Outbound...),
+	)
+	r.EventRecorder.Eventf(deployment, "Normal", "IPAddressesChanged",
+		"The IP addresses of the deployment changed, added: [%s], removed: [%s]", strings.Join(added, ", "), strings.Join(removed, ", "))
+
+	return current
+}
+
+// deploymentIPAddresses returns the sorted inbound and outbound IP addresses Atlas reports for the deployment, or nil
+// when the deployment isn't listed
+func deploymentIPAddresses(ctx context.Context, projectsAPI admin.ProjectsApi, projectID, deploymentName string) (*status.DeploymentIPAddresses, error) {
+	ipAddresses, _, err := projectsAPI.ReturnAllIPAddresses(ctx, projectID).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the IP addresses of the project: %w", err)
+	}
+
+	services := ipAddresses.GetServices()
+	for _, cluster := range services.GetClusters() {
+		if cluster.GetClusterName() != deploymentName {
+			continue
+		}
+
+		inbound := sortedIPAddresses(cluster.GetInbound())
+		outbound := sortedIPAddresses(cluster.GetOutbound())
+		if len(inbound) == 0 && len(outbound) == 0 {
+			return nil, nil
+		}
+
+		return &status.DeploymentIPAddresses{Inbound: inbound, Outbound: outbound}, nil
+	}
+
+	return nil, nil
+}
+
+func sortedIPAddresses(addresses []string) []string {
+	sorted := slices.Clone(addresses)
+	slices.Sort(sorted)
+
+	return slices.Compact(sorted)
+}
+
+// diffIPAddresses returns the sorted addresses only present in current, and those only present in previous
+func diffIPAddresses(previous, current []string) (added, removed []string) {
+	for _, address := range current {
+		if !slices.Contains(previous, address) && !slices.Contains(added, address) {
+			added = append(added, address)
+		}
+	}
+	for _, address := range previous {
+		if !slices.Contains(current, address) && !slices.Contains(removed, address) {
+			removed = append(removed, address)
+		}
+	}
+	slices.Sort(added)
+	slices.Sort(removed)
+
+	return added, removed
+}
+
+// ipAddressesOutdated returns true if the addresses were never read or if they were read more than one interval ago
+func ipAddressesOutdated(ipAddresses *status.DeploymentIPAddresses, interval time.Duration, now time.Time) bool {
+	if ipAddresses == nil {
+		return true
+	}
+
+	lastUpdated, err := time.Parse(time.RFC3339, ipAddresses.LastUpdated)
+	if err != nil {
+		return true
+	}
+
+	return now.Sub(lastUpdated) >= interval
+}
+
+// ipAddressReportInterval returns how often the IP addresses of the deployment are read, zero when they aren't
+func ipAddressReportInterval(deployment *mdbv1.AtlasDeployment) time.Duration {
+	if deployment.Spec.IPAddressReport == nil {
+		return 0
+	}
+
+	if interval := deployment.Spec.IPAddressReport.RefreshInterval; interval != nil && interval.Duration > 0 {
+		return interval.Duration
+	}
+
+	return defaultIPAddressReportInterval
+}
+
+func ipAddressReportName(deployment *mdbv1.AtlasDeployment) string {
+	if deployment.Spec.IPAddressReport == nil {
+		return ""
+	}
+
+	if deployment.Spec.IPAddressReport.Name != "" {
+		return deployment.Spec.IPAddressReport.Name
+	}
+
+	return deployment.Name + ipAddressReportSuffix
+}
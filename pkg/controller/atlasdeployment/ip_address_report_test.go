@@ -0,0 +1,180 @@
+package atlasdeployment
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestEnsureIPAddressReport(t *testing.T) {
+	newReconciler := func(t *testing.T, objects ...client.Object) *AtlasDeploymentReconciler {
+		sch := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(sch))
+		require.NoError(t, mdbv1.AddToScheme(sch))
+
+		return &AtlasDeploymentReconciler{
+			Client:        fake.NewClientBuilder().WithScheme(sch).WithObjects(objects...).Build(),
+			Scheme:        sch,
+			EventRecorder: record.NewFakeRecorder(10),
+		}
+	}
+	newReportContext := func(t *testing.T, body string) *workflow.Context {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/api/atlas/v2/groups/project-id/ipAddresses", func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, body)
+		})
+		server := httptest.NewServer(mux)
+		t.Cleanup(server.Close)
+		sdkClient, err := admin.NewClient(admin.UseBaseURL(server.URL))
+		require.NoError(t, err)
+
+		ctx := newContext(t)
+		ctx.SdkClient = sdkClient
+
+		return ctx
+	}
+	newDeployment := func(spec *mdbv1.IPAddressReportSpec) *mdbv1.AtlasDeployment {
+		deployment := mdbv1.DefaultAWSDeployment("ns", "project")
+		deployment.UID = types.UID("deployment-uid")
+		deployment.Spec.IPAddressReport = spec
+
+		return deployment
+	}
+	ipAddressesStatus := func(ctx *workflow.Context) *status.DeploymentIPAddresses {
+		deploymentStatus := status.AtlasDeploymentStatus{}
+		for _, option := range ctx.StatusOptions() {
+			option.(status.AtlasDeploymentStatusOption)(&deploymentStatus)
+		}
+
+		return deploymentStatus.IPAddresses
+	}
+	clusterIPAddresses := `{"services":{"clusters":[
+		{"clusterName":"other","inbound":["10.0.0.1"]},
+		{"clusterName":"test-deployment-aws","inbound":["3.3.3.3","1.1.1.1","2.2.2.2"],"outbound":["4.4.4.4"]}
+	]}}`
+
+	t.Run("should list the IP addresses of the deployment", func(t *testing.T) {
+		r := newReconciler(t)
+		ctx := newReportContext(t, clusterIPAddresses)
+		deployment := newDeployment(&mdbv1.IPAddressReportSpec{Labels: map[string]string{"team": "orders"}})
+
+		result := r.ensureIPAddressReport(ctx, "project-id", deployment)
+
+		require.True(t, result.IsOk())
+		name := deployment.Name + "-ip-addresses"
+		configMap := &corev1.ConfigMap{}
+		require.NoError(t, r.Client.Get(context.Background(), client.ObjectKey{Namespace: "ns", Name: name}, configMap))
+		report := ipAddressesStatus(ctx)
+		require.NotNil(t, report)
+		assert.Equal(t, map[string]string{
+			IPAddressReportInboundKey:     "1.1.1.1\n2.2.2.2\n3.3.3.3",
+			IPAddressReportOutboundKey:    "4.4.4.4",
+			IPAddressReportLastChangedKey: report.LastUpdated,
+		}, configMap.Data)
+		assert.Equal(t, "orders", configMap.Labels["team"])
+		assert.True(t, metav1.IsControlledBy(configMap, deployment))
+		assert.Equal(t, name, report.ConfigMap)
+		assert.Equal(t, []string{"1.1.1.1", "2.2.2.2", "3.3.3.3"}, report.Inbound)
+		assert.Empty(t, r.EventRecorder.(*record.FakeRecorder).Events)
+	})
+
+	t.Run("should record an event when the IP addresses change", func(t *testing.T) {
+		r := newReconciler(t)
+		ctx := newReportContext(t, clusterIPAddresses)
+		deployment := newDeployment(&mdbv1.IPAddressReportSpec{})
+		deployment.Status.IPAddresses = &status.DeploymentIPAddresses{
+			Inbound:     []string{"1.1.1.1", "2.2.2.2", "9.9.9.9"},
+			Outbound:    []string{"4.4.4.4"},
+			LastUpdated: "2023-11-01T10:00:00Z",
+			LastChanged: "2023-10-01T10:00:00Z",
+		}
+
+		result := r.ensureIPAddressReport(ctx, "project-id", deployment)
+
+		require.True(t, result.IsOk())
+		assert.NotEqual(t, "2023-10-01T10:00:00Z", ipAddressesStatus(ctx).LastChanged)
+		events := r.EventRecorder.(*record.FakeRecorder).Events
+		require.Len(t, events, 1)
+		assert.Equal(t, "Normal IPAddressesChanged The IP addresses of the deployment changed, added: [3.3.3.3], removed: [9.9.9.9]", <-events)
+	})
+
+	t.Run("should keep the IP addresses until the refresh interval elapses", func(t *testing.T) {
+		r := newReconciler(t)
+		ctx := newReportContext(t, `{"services":{"clusters":[]}}`)
+		deployment := newDeployment(&mdbv1.IPAddressReportSpec{})
+		lastUpdated := time.Now().UTC().Add(-time.Minute).Format(time.RFC3339)
+		deployment.Status.IPAddresses = &status.DeploymentIPAddresses{
+			Inbound:     []string{"1.1.1.1"},
+			LastUpdated: lastUpdated,
+			LastChanged: "2023-10-01T10:00:00Z",
+		}
+
+		result := r.ensureIPAddressReport(ctx, "project-id", deployment)
+
+		require.True(t, result.IsOk())
+		report := ipAddressesStatus(ctx)
+		assert.Equal(t, []string{"1.1.1.1"}, report.Inbound)
+		assert.Equal(t, lastUpdated, report.LastUpdated)
+		assert.Equal(t, "2023-10-01T10:00:00Z", report.LastChanged)
+	})
+
+	t.Run("should skip the ConfigMap when Atlas doesn't report the deployment", func(t *testing.T) {
+		r := newReconciler(t)
+		ctx := newReportContext(t, `{"services":{"clusters":[{"clusterName":"other","inbound":["10.0.0.1"]}]}}`)
+		deployment := newDeployment(&mdbv1.IPAddressReportSpec{})
+
+		result := r.ensureIPAddressReport(ctx, "project-id", deployment)
+
+		require.True(t, result.IsOk())
+		assert.Nil(t, ipAddressesStatus(ctx))
+		err := r.Client.Get(context.Background(), client.ObjectKey{Namespace: "ns", Name: deployment.Name + "-ip-addresses"}, &corev1.ConfigMap{})
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+
+	t.Run("should remove the ConfigMap once disabled", func(t *testing.T) {
+		r := newReconciler(t)
+		deployment := newDeployment(&mdbv1.IPAddressReportSpec{Name: "orders-ip-addresses"})
+		ctx := newReportContext(t, clusterIPAddresses)
+		require.True(t, r.ensureIPAddressReport(ctx, "project-id", deployment).IsOk())
+
+		deployment.Status.IPAddresses = ipAddressesStatus(ctx)
+		deployment.Spec.IPAddressReport = nil
+		ctx = newReportContext(t, clusterIPAddresses)
+
+		result := r.ensureIPAddressReport(ctx, "project-id", deployment)
+
+		require.True(t, result.IsOk())
+		err := r.Client.Get(context.Background(), client.ObjectKey{Namespace: "ns", Name: "orders-ip-addresses"}, &corev1.ConfigMap{})
+		assert.True(t, k8serrors.IsNotFound(err))
+		assert.Len(t, ctx.StatusOptions(), 1)
+		assert.Nil(t, ipAddressesStatus(ctx))
+	})
+}
+
+func TestIPAddressReportInterval(t *testing.T) {
+	assert.Zero(t, ipAddressReportInterval(&mdbv1.AtlasDeployment{}))
+	assert.Equal(t, time.Hour, ipAddressReportInterval(&mdbv1.AtlasDeployment{
+		Spec: mdbv1.AtlasDeploymentSpec{IPAddressReport: &mdbv1.IPAddressReportSpec{}},
+	}))
+	assert.Equal(t, 10*time.Minute, ipAddressReportInterval(&mdbv1.AtlasDeployment{
+		Spec: mdbv1.AtlasDeploymentSpec{IPAddressReport: &mdbv1.IPAddressReportSpec{RefreshInterval: &metav1.Duration{Duration: 10 * time.Minute}}},
+	}))
+}
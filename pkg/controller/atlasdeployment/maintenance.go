@@ -0,0 +1,32 @@
+package atlasdeployment
+
+import (
+	"time"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// maintenanceRetry is the delay between two checks of a deployment Atlas is running a maintenance on
+const maintenanceRetry = time.Minute
+
+// maintenanceInProgress is the result of a reconciliation backing off while Atlas is running a maintenance on the
+// deployment, as Atlas rejects the changes meanwhile
+func maintenanceInProgress(msg string) workflow.Result {
+	return workflow.InProgress(workflow.AtlasMaintenanceInProgress, msg)
+}
+
+// ensureMaintenanceCondition flags the deployment with the MaintenanceInProgress condition while Atlas is running a
+// maintenance on it and slows down the polling until the maintenance is over
+func ensureMaintenanceCondition(workflowCtx *workflow.Context, result workflow.Result) workflow.Result {
+	if result.GetReason() != workflow.AtlasMaintenanceInProgress {
+		workflowCtx.UnsetCondition(status.MaintenanceInProgressType)
+		return result
+	}
+
+	workflowCtx.EnsureCondition(status.TrueCondition(status.MaintenanceInProgressType).
+		WithReason(string(workflow.AtlasMaintenanceInProgress)).
+		WithMessageRegexp(result.GetMessage()))
+
+	return result.WithRetry(maintenanceRetry)
+}
@@ -0,0 +1,38 @@
+package atlasdeployment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestEnsureMaintenanceCondition(t *testing.T) {
+
+	t.Run("should flag the deployment and slow down the polling during a maintenance", func(t *testing.T) {
+		workflowCtx := newContext(t)
+
+		result := ensureMaintenanceCondition(workflowCtx, maintenanceInProgress("Atlas is running a maintenance on the deployment"))
+
+		assert.True(t, result.IsInProgress())
+		assert.Equal(t, maintenanceRetry, result.ReconcileResult().RequeueAfter)
+		condition, ok := findCondition(workflowCtx.Conditions(), status.MaintenanceInProgressType)
+		require.True(t, ok)
+		assert.Equal(t, string(workflow.AtlasMaintenanceInProgress), condition.Reason)
+		assert.Equal(t, "Atlas is running a maintenance on the deployment", condition.Message)
+	})
+
+	t.Run("should clear the flag once the maintenance is over", func(t *testing.T) {
+		workflowCtx := newContext(t)
+		ensureMaintenanceCondition(workflowCtx, maintenanceInProgress("Atlas is running a maintenance on the deployment"))
+
+		result := ensureMaintenanceCondition(workflowCtx, workflow.OK())
+
+		assert.True(t, result.IsOk())
+		_, ok := findCondition(workflowCtx.Conditions(), status.MaintenanceInProgressType)
+		assert.False(t, ok)
+	})
+}
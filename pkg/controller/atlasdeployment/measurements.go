@@ -0,0 +1,70 @@
+package atlasdeployment
+
+import (
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+)
+
+// processTypesWithoutData are the types of the processes which don't hold data and are ignored when reading measurements
+var processTypesWithoutData = map[string]bool{
+	"SHARD_MONGOS":           true,
+	"SHARD_CONFIG_PRIMARY":   true,
+	"SHARD_CONFIG_SECONDARY": true,
+}
+
+// deploymentProcessIDs returns the identifiers of the data bearing processes of the deployment.
+// Atlas names the hosts of a deployment after it, e.g. <deployment>-shard-00-00.<domain>
+func deploymentProcessIDs(processes []admin.ApiHostViewAtlas, deploymentName string) []string {
+	prefix := strings.ToLower(deploymentName) + "-"
+
+	var ids []string
+	for _, process := range processes {
+		if processTypesWithoutData[process.GetTypeName()] {
+			continue
+		}
+		if strings.HasPrefix(strings.ToLower(process.GetHostname()), prefix) {
+			ids = append(ids, process.GetId())
+		}
+	}
+
+	return ids
+}
+
+// latestDataPoint returns the value of the most recent data point holding a value
+func latestDataPoint(dataPoints []admin.MetricDataPointAtlas) (int64, bool) {
+	var latest *admin.MetricDataPointAtlas
+	for i := range dataPoints {
+		if !dataPoints[i].HasValue() {
+			continue
+		}
+		if latest == nil || dataPoints[i].GetTimestamp().After(latest.GetTimestamp()) {
+			latest = &dataPoints[i]
+		}
+	}
+
+	if latest == nil {
+		return 0, false
+	}
+
+	return int64(latest.GetValue()), true
+}
+
+// averageDataPoint returns the average value of the data points holding a value
+func averageDataPoint(dataPoints []admin.MetricDataPointAtlas) (float64, bool) {
+	var sum float64
+	var count int
+	for _, dataPoint := range dataPoints {
+		if !dataPoint.HasValue() {
+			continue
+		}
+		sum += float64(dataPoint.GetValue())
+		count++
+	}
+
+	if count == 0 {
+		return 0, false
+	}
+
+	return sum / float64(count), true
+}
@@ -0,0 +1,48 @@
+package atlasdeployment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+)
+
+func TestDeploymentProcessIDs(t *testing.T) {
+	processes := []admin.ApiHostViewAtlas{
+		{Id: admin.PtrString("serverless-shard-00-00.abcd.mongodb.net:27017"), Hostname: admin.PtrString("serverless-shard-00-00.abcd.mongodb.net")},
+		{Id: admin.PtrString("serverless-shard-00-01.abcd.mongodb.net:27017"), Hostname: admin.PtrString("Serverless-shard-00-01.abcd.mongodb.net")},
+		{Id: admin.PtrString("serverless-config-00-00.abcd.mongodb.net:27017"), Hostname: admin.PtrString("serverless-config-00-00.abcd.mongodb.net"), TypeName: admin.PtrString("SHARD_CONFIG_PRIMARY")},
+		{Id: admin.PtrString("serverless2-shard-00-00.abcd.mongodb.net:27017"), Hostname: admin.PtrString("serverless2-shard-00-00.abcd.mongodb.net")},
+		{Id: admin.PtrString("cluster0-shard-00-00.abcd.mongodb.net:27017"), Hostname: admin.PtrString("cluster0-shard-00-00.abcd.mongodb.net")},
+	}
+
+	assert.Equal(t,
+		[]string{"serverless-shard-00-00.abcd.mongodb.net:27017", "serverless-shard-00-01.abcd.mongodb.net:27017"},
+		deploymentProcessIDs(processes, "Serverless"),
+	)
+	assert.Empty(t, deploymentProcessIDs(processes, "unknown"))
+}
+
+func TestDataPoints(t *testing.T) {
+	older := time.Date(2023, 11, 1, 11, 55, 0, 0, time.UTC)
+	newer := time.Date(2023, 11, 1, 12, 0, 0, 0, time.UTC)
+	dataPoints := []admin.MetricDataPointAtlas{
+		{Timestamp: &newer, Value: admin.PtrFloat32(30)},
+		{Timestamp: &older, Value: admin.PtrFloat32(10)},
+		{Timestamp: &newer},
+	}
+
+	latest, ok := latestDataPoint(dataPoints)
+	assert.True(t, ok)
+	assert.Equal(t, int64(30), latest)
+
+	average, ok := averageDataPoint(dataPoints)
+	assert.True(t, ok)
+	assert.Equal(t, float64(20), average)
+
+	_, ok = latestDataPoint([]admin.MetricDataPointAtlas{{Timestamp: &newer}})
+	assert.False(t, ok)
+	_, ok = averageDataPoint(nil)
+	assert.False(t, ok)
+}
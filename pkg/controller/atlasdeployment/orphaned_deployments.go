@@ -0,0 +1,215 @@
+package atlasdeployment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+	"go.uber.org/zap"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+)
+
+const (
+	// OrphanedDeploymentEvent is the reason of the event emitted on the AtlasProject for each deployment left behind
+	OrphanedDeploymentEvent = "OrphanedDeployment"
+	// OrphanedDeploymentDeletedEvent is the reason of the event emitted on the AtlasProject when a deployment left
+	// behind is deleted
+	OrphanedDeploymentDeletedEvent = "OrphanedDeploymentDeleted"
+)
+
+// OrphanedDeploymentsJanitor periodically looks for the deployments created in Atlas by the operator whose
+// AtlasDeployment no longer exists, e.g. because the resource was deleted while the creation was in flight. They are
+// found with their tracking tags and reported with an event on their AtlasProject. When a grace period is set, they
+// are deleted once they are older than it
+type OrphanedDeploymentsJanitor struct {
+	Client        client.Client
+	Log           *zap.SugaredLogger
+	EventRecorder record.EventRecorder
+	AtlasProvider atlas.Provider
+	// Interval is the time between two searches
+	Interval time.Duration
+	// DeletionGracePeriod is the age the orphaned deployments are deleted at. They are only reported when zero
+	DeletionGracePeriod time.Duration
+	// ObjectDeletionProtection prevents the deletion of the orphaned deployments
+	ObjectDeletionProtection bool
+}
+
+// orphanCandidate is a deployment found in Atlas, either dedicated or serverless
+type orphanCandidate struct {
+	name                  string
+	serverless            bool
+	stateName             string
+	createDate            string
+	terminationProtection bool
+	tags                  []*mongodbatlas.Tag
+}
+
+// NeedLeaderElection makes sure a single replica of the operator deletes the orphaned deployments
+func (j *OrphanedDeploymentsJanitor) NeedLeaderElection() bool {
+	return true
+}
+
+// Start runs the search every interval until the context is done
+func (j *OrphanedDeploymentsJanitor) Start(ctx context.Context) error {
+	ticker := time.NewTicker(j.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			j.collect(ctx, time.Now())
+		}
+	}
+}
+
+func (j *OrphanedDeploymentsJanitor) collect(ctx context.Context, now time.Time) {
+	projects := &mdbv1.AtlasProjectList{}
+	if err := j.Client.List(ctx, projects); err != nil {
+		j.Log.Errorw("failed to list the projects to look for orphaned deployments", "error", err)
+		return
+	}
+
+	for i := range projects.Items {
+		project := &projects.Items[i]
+		if project.ID() == "" || !project.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+
+		if err := j.collectProject(ctx, project, now); err != nil {
+			j.Log.Errorw("failed to look for orphaned deployments", "project", kube.ObjectKeyFromObject(project), "error", err)
+		}
+	}
+}
+
+func (j *OrphanedDeploymentsJanitor) collectProject(ctx context.Context, project *mdbv1.AtlasProject, now time.Time) error {
+	atlasClient, _, err := j.AtlasProvider.Client(ctx, project.ConnectionSecretObjectKey(), j.Log)
+	if err != nil {
+		return err
+	}
+
+	candidates, err := listOrphanCandidates(ctx, atlasClient, project.ID())
+	if err != nil {
+		return err
+	}
+
+	for _, candidate := range candidates {
+		// the deployments created by an operator in another cluster can't be checked from this one
+		marker, tracked := trackingMarker(candidate.tags)
+		if !tracked || !marker.SameCluster() || candidate.stateName == status.StateDELETING {
+			continue
+		}
+		key := client.ObjectKey{Namespace: marker.Namespace, Name: marker.Name}
+
+		orphaned, err := j.isOrphaned(ctx, project, key, candidate.name)
+		if err != nil {
+			return err
+		}
+		if !orphaned {
+			continue
+		}
+
+		// a deployment is only deleted when it was created from this cluster for sure, it's otherwise only reported as
+		// the operators of other clusters sharing the project may manage it
+		if err = j.cleanup(ctx, atlasClient, project, key, candidate, marker.KnownSameCluster(), now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isOrphaned reports whether the AtlasDeployment named by the tracking tags no longer manages the Atlas deployment
+func (j *OrphanedDeploymentsJanitor) isOrphaned(ctx context.Context, project *mdbv1.AtlasProject, key client.ObjectKey, name string) (bool, error) {
+	deployment := &mdbv1.AtlasDeployment{}
+	err := j.Client.Get(ctx, key, deployment)
+	if apiErrors.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return deployment.AtlasProjectObjectKey() != kube.ObjectKeyFromObject(project) || deployment.GetDeploymentName() != name, nil
+}
+
+func (j *OrphanedDeploymentsJanitor) cleanup(ctx context.Context, atlasClient *mongodbatlas.Client, project *mdbv1.AtlasProject, key client.ObjectKey, candidate orphanCandidate, deletable bool, now time.Time) error {
+	j.Log.Warnw("Found a deployment in Atlas which is no longer managed by its AtlasDeployment", "project", project.ID(), "deployment", candidate.name, "atlasDeployment", key)
+	j.EventRecorder.Eventf(project, "Warning", OrphanedDeploymentEvent,
+		"Deployment %s was created in Atlas for the AtlasDeployment %s which no longer manages it", candidate.name, key)
+
+	if !deletable || j.DeletionGracePeriod == 0 || j.ObjectDeletionProtection || candidate.terminationProtection {
+		return nil
+	}
+
+	created, err := time.Parse(time.RFC3339, candidate.createDate)
+	if err != nil || now.Sub(created) < j.DeletionGracePeriod {
+		return nil
+	}
+
+	if candidate.serverless {
+		_, err = atlasClient.ServerlessInstances.Delete(ctx, project.ID(), candidate.name)
+	} else {
+		_, err = atlasClient.AdvancedClusters.Delete(ctx, project.ID(), candidate.name, nil)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete the orphaned deployment %s: %w", candidate.name, err)
+	}
+
+	j.Log.Infow("Deleted the orphaned deployment", "project", project.ID(), "deployment", candidate.name)
+	j.EventRecorder.Eventf(project, "Normal", OrphanedDeploymentDeletedEvent,
+		"Deleted deployment %s left behind in Atlas for more than %s", candidate.name, j.DeletionGracePeriod)
+
+	return nil
+}
+
+func listOrphanCandidates(ctx context.Context, atlasClient *mongodbatlas.Client, projectID string) ([]orphanCandidate, error) {
+	var candidates []orphanCandidate
+
+	clusters, _, err := atlasClient.AdvancedClusters.List(ctx, projectID, &mongodbatlas.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if clusters == nil {
+		clusters = &mongodbatlas.AdvancedClustersResponse{}
+	}
+	for _, cluster := range clusters.Results {
+		candidates = append(candidates, orphanCandidate{
+			name:                  cluster.Name,
+			stateName:             cluster.StateName,
+			createDate:            cluster.CreateDate,
+			terminationProtection: pointer.GetOrDefault(cluster.TerminationProtectionEnabled, false),
+			tags:                  cluster.Tags,
+		})
+	}
+
+	instances, _, err := atlasClient.ServerlessInstances.List(ctx, projectID, &mongodbatlas.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if instances == nil {
+		instances = &mongodbatlas.ClustersResponse{}
+	}
+	for _, instance := range instances.Results {
+		candidates = append(candidates, orphanCandidate{
+			name:                  instance.Name,
+			serverless:            true,
+			stateName:             instance.StateName,
+			createDate:            instance.CreateDate,
+			terminationProtection: pointer.GetOrDefault(instance.TerminationProtectionEnabled, false),
+			tags:                  pointer.GetOrDefault(instance.Tags, nil),
+		})
+	}
+
+	return candidates, nil
+}
@@ -0,0 +1,150 @@
+package atlasdeployment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/ownership"
+)
+
+func TestOrphanedDeploymentsJanitor(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	project := mdbv1.NewProject("ns", "project", "project")
+	project.Status.ID = "project-id"
+	tagged := func(name, deploymentName string, created time.Time) *mongodbatlas.AdvancedCluster {
+		return &mongodbatlas.AdvancedCluster{
+			Name:       name,
+			StateName:  "IDLE",
+			CreateDate: created.Format(time.RFC3339),
+			Tags:       trackingTags(mdbv1.NewDeployment("ns", deploymentName, name)),
+		}
+	}
+	newJanitor := func(t *testing.T, gracePeriod time.Duration, clusters []*mongodbatlas.AdvancedCluster, objects ...client.Object) (*OrphanedDeploymentsJanitor, *atlas.AdvancedClustersClientMock, *record.FakeRecorder) {
+		sch := runtime.NewScheme()
+		require.NoError(t, mdbv1.AddToScheme(sch))
+		clustersMock := &atlas.AdvancedClustersClientMock{
+			ListFunc: func(projectID string) (*mongodbatlas.AdvancedClustersResponse, *mongodbatlas.Response, error) {
+				return &mongodbatlas.AdvancedClustersResponse{Results: clusters}, nil, nil
+			},
+			DeleteFunc: func(projectID string, clusterName string) (*mongodbatlas.Response, error) {
+				return nil, nil
+			},
+		}
+		serverlessMock := &atlas.ServerlessInstancesClientMock{
+			ListFunc: func(projectID string) (*mongodbatlas.ClustersResponse, *mongodbatlas.Response, error) {
+				return &mongodbatlas.ClustersResponse{}, nil, nil
+			},
+		}
+		recorder := record.NewFakeRecorder(10)
+
+		return &OrphanedDeploymentsJanitor{
+			Client:        fake.NewClientBuilder().WithScheme(sch).WithObjects(append(objects, project.DeepCopy())...).Build(),
+			Log:           testLog(t),
+			EventRecorder: recorder,
+			AtlasProvider: &atlas.TestProvider{
+				ClientFunc: func(secretRef *client.ObjectKey, log *zap.SugaredLogger) (*mongodbatlas.Client, string, error) {
+					return &mongodbatlas.Client{AdvancedClusters: clustersMock, ServerlessInstances: serverlessMock}, "org-id", nil
+				},
+			},
+			DeletionGracePeriod: gracePeriod,
+		}, clustersMock, recorder
+	}
+
+	t.Run("should ignore the deployments managed by their AtlasDeployment and the untagged ones", func(t *testing.T) {
+		janitor, clusters, recorder := newJanitor(t, time.Hour,
+			[]*mongodbatlas.AdvancedCluster{
+				tagged("cluster0", "deployment", now.Add(-24*time.Hour)),
+				{Name: "manual", StateName: "IDLE", CreateDate: now.Add(-24 * time.Hour).Format(time.RFC3339)},
+			},
+			mdbv1.NewDeployment("ns", "deployment", "cluster0").WithProjectName("project"),
+		)
+
+		janitor.collect(context.Background(), now)
+
+		assert.Empty(t, recorder.Events)
+		assert.Empty(t, clusters.DeleteRequests)
+	})
+
+	t.Run("should only report the orphaned deployments without grace period", func(t *testing.T) {
+		janitor, clusters, recorder := newJanitor(t, 0, []*mongodbatlas.AdvancedCluster{tagged("cluster0", "deployment", now.Add(-24*time.Hour))})
+
+		janitor.collect(context.Background(), now)
+
+		require.Len(t, recorder.Events, 1)
+		assert.Equal(t, "Warning OrphanedDeployment Deployment cluster0 was created in Atlas for the AtlasDeployment ns/deployment which no longer manages it", <-recorder.Events)
+		assert.Empty(t, clusters.DeleteRequests)
+	})
+
+	t.Run("should ignore the deployments created from another cluster", func(t *testing.T) {
+		cluster := tagged("cluster0", "deployment", now.Add(-24*time.Hour))
+		cluster.Tags = append(cluster.Tags, &mongodbatlas.Tag{Key: ownership.ClusterIDKey, Value: "other-cluster-uid"})
+		ownership.ClusterID = "cluster-uid"
+		defer func() { ownership.ClusterID = "" }()
+		janitor, clusters, recorder := newJanitor(t, time.Hour, []*mongodbatlas.AdvancedCluster{cluster})
+
+		janitor.collect(context.Background(), now)
+
+		assert.Empty(t, recorder.Events)
+		assert.Empty(t, clusters.DeleteRequests)
+	})
+
+	t.Run("should report the deployments left behind by a renamed AtlasDeployment", func(t *testing.T) {
+		janitor, _, recorder := newJanitor(t, 0,
+			[]*mongodbatlas.AdvancedCluster{tagged("cluster0", "deployment", now)},
+			mdbv1.NewDeployment("ns", "deployment", "cluster1").WithProjectName("project"),
+		)
+
+		janitor.collect(context.Background(), now)
+
+		assert.Len(t, recorder.Events, 1)
+	})
+
+	t.Run("should only report the orphaned deployments when the cluster is unknown", func(t *testing.T) {
+		janitor, clusters, recorder := newJanitor(t, time.Hour, []*mongodbatlas.AdvancedCluster{tagged("cluster0", "deployment", now.Add(-2*time.Hour))})
+
+		janitor.collect(context.Background(), now)
+
+		assert.Len(t, recorder.Events, 1)
+		assert.Empty(t, clusters.DeleteRequests)
+	})
+
+	t.Run("should delete the orphaned deployments older than the grace period", func(t *testing.T) {
+		ownership.ClusterID = "cluster-uid"
+		defer func() { ownership.ClusterID = "" }()
+		janitor, clusters, recorder := newJanitor(t, time.Hour, []*mongodbatlas.AdvancedCluster{
+			tagged("cluster0", "deployment", now.Add(-2*time.Hour)),
+			tagged("cluster1", "other", now.Add(-time.Minute)),
+		})
+
+		janitor.collect(context.Background(), now)
+
+		assert.Equal(t, map[string]struct{}{"project-id.cluster0": {}}, clusters.DeleteRequests)
+		require.Len(t, recorder.Events, 3)
+		<-recorder.Events
+		assert.Equal(t, "Normal OrphanedDeploymentDeleted Deleted deployment cluster0 left behind in Atlas for more than 1h0m0s", <-recorder.Events)
+	})
+
+	t.Run("should not delete the orphaned deployments protected from termination or deletion", func(t *testing.T) {
+		protected := tagged("cluster0", "deployment", now.Add(-2*time.Hour))
+		protected.TerminationProtectionEnabled = pointer.MakePtr(true)
+		janitor, clusters, _ := newJanitor(t, time.Hour, []*mongodbatlas.AdvancedCluster{protected, tagged("cluster1", "other", now.Add(-2*time.Hour))})
+		janitor.ObjectDeletionProtection = true
+
+		janitor.collect(context.Background(), now)
+
+		assert.Empty(t, clusters.DeleteRequests)
+	})
+}
@@ -0,0 +1,63 @@
+package atlasdeployment
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	// provisioningFastPollingPeriod is how long after a change of its state a provisioning deployment is polled at
+	// the shortest interval, most updates complete within it
+	provisioningFastPollingPeriod = 2 * time.Minute
+
+	// provisioningPollingBackoffRatio is the fraction of the time spent in the same state the deployment waits for
+	// before polling Atlas again
+	provisioningPollingBackoffRatio = 10
+
+	// provisioningMaxPollingInterval is the longest interval between two polls of a provisioning deployment
+	provisioningMaxPollingInterval = 5 * time.Minute
+)
+
+// ensureProvisioningPolling tracks the changes of the state of the deployment in Atlas and, while the deployment is
+// being created or updated, spaces the polls out as the time spent in the same state grows. Long provisions, e.g.
+// of large instance sizes, then don't consume the API quota with reconciliations every few seconds, while any change
+// of the state brings the polling back to the shortest interval
+func ensureProvisioningPolling(workflowCtx *workflow.Context, deployment *mdbv1.AtlasDeployment, result workflow.Result, now time.Time) workflow.Result {
+	observed := deployment.DeepCopy()
+	observed.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+
+	changedAt := deployment.Status.StateChangedAt
+	if observed.Status.StateName != "" && (changedAt == nil || observed.Status.StateName != deployment.Status.StateName) {
+		changedAt = &metav1.Time{Time: now}
+		workflowCtx.EnsureStatusOption(status.AtlasDeploymentStateChangedAtOption(changedAt))
+	}
+
+	if reason := result.GetReason(); changedAt == nil || (reason != workflow.DeploymentCreating && reason != workflow.DeploymentUpdating) {
+		return result
+	}
+
+	return result.WithRetry(provisioningPollingInterval(now.Sub(changedAt.Time)))
+}
+
+// provisioningPollingInterval returns the delay before polling a deployment which has been in the same provisioning
+// state for the given duration
+func provisioningPollingInterval(inState time.Duration) time.Duration {
+	if inState < provisioningFastPollingPeriod {
+		return workflow.DefaultRetry
+	}
+
+	interval := inState / provisioningPollingBackoffRatio
+	switch {
+	case interval < workflow.DefaultRetry:
+		return workflow.DefaultRetry
+	case interval > provisioningMaxPollingInterval:
+		return provisioningMaxPollingInterval
+	default:
+		return interval.Round(time.Second)
+	}
+}
@@ -0,0 +1,98 @@
+package atlasdeployment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestEnsureProvisioningPolling(t *testing.T) {
+	now := time.Date(2024, 1, 10, 10, 0, 0, 0, time.UTC)
+	provisioning := workflow.InProgress(workflow.DeploymentCreating, "deployment is provisioning")
+	newDeployment := func(stateName string, changedAt time.Time) *mdbv1.AtlasDeployment {
+		deployment := mdbv1.NewDeployment("ns", "deployment", "deployment")
+		deployment.Status.StateName = stateName
+		if !changedAt.IsZero() {
+			deployment.Status.StateChangedAt = &metav1.Time{Time: changedAt}
+		}
+
+		return deployment
+	}
+	newStateContext := func(stateName string) *workflow.Context {
+		workflowCtx := newContext(t)
+		workflowCtx.EnsureStatusOption(status.AtlasDeploymentStateNameOption(stateName))
+
+		return workflowCtx
+	}
+
+	t.Run("should poll quickly once the provisioning starts", func(t *testing.T) {
+		deployment := newDeployment("", time.Time{})
+		workflowCtx := newStateContext("CREATING")
+
+		result := ensureProvisioningPolling(workflowCtx, deployment, provisioning, now)
+
+		assert.Equal(t, workflow.DefaultRetry, result.ReconcileResult().RequeueAfter)
+		deployment.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+		assert.Equal(t, now, deployment.Status.StateChangedAt.Time)
+	})
+
+	t.Run("should back off while the state doesn't change", func(t *testing.T) {
+		deployment := newDeployment("CREATING", now.Add(-20*time.Minute))
+		workflowCtx := newStateContext("CREATING")
+
+		result := ensureProvisioningPolling(workflowCtx, deployment, provisioning, now)
+
+		assert.True(t, result.IsInProgress())
+		assert.Equal(t, 2*time.Minute, result.ReconcileResult().RequeueAfter)
+		assert.Len(t, workflowCtx.StatusOptions(), 1)
+	})
+
+	t.Run("should poll quickly again once the state changes", func(t *testing.T) {
+		deployment := newDeployment("CREATING", now.Add(-time.Hour))
+		workflowCtx := newStateContext("UPDATING")
+		updating := workflow.InProgress(workflow.DeploymentUpdating, "deployment is updating")
+
+		result := ensureProvisioningPolling(workflowCtx, deployment, updating, now)
+
+		assert.Equal(t, workflow.DefaultRetry, result.ReconcileResult().RequeueAfter)
+		deployment.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+		assert.Equal(t, now, deployment.Status.StateChangedAt.Time)
+	})
+
+	t.Run("should track the state without changing other results", func(t *testing.T) {
+		deployment := newDeployment("UPDATING", now.Add(-time.Hour))
+		workflowCtx := newStateContext("IDLE")
+		failure := workflow.Terminate(workflow.Internal, "error")
+
+		result := ensureProvisioningPolling(workflowCtx, deployment, failure, now)
+
+		assert.Equal(t, failure, result)
+		deployment.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+		assert.Equal(t, now, deployment.Status.StateChangedAt.Time)
+	})
+}
+
+func TestProvisioningPollingInterval(t *testing.T) {
+	tests := []struct {
+		inState  time.Duration
+		expected time.Duration
+	}{
+		{inState: 0, expected: workflow.DefaultRetry},
+		{inState: time.Minute, expected: workflow.DefaultRetry},
+		{inState: 3 * time.Minute, expected: 18 * time.Second},
+		{inState: 10 * time.Minute, expected: time.Minute},
+		{inState: 50 * time.Minute, expected: 5 * time.Minute},
+		{inState: 5 * time.Hour, expected: provisioningMaxPollingInterval},
+	}
+	for _, tt := range tests {
+		t.Run(tt.inState.String(), func(t *testing.T) {
+			assert.Equal(t, tt.expected, provisioningPollingInterval(tt.inState))
+		})
+	}
+}
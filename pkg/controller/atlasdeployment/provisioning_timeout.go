@@ -0,0 +1,63 @@
+package atlasdeployment
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// provisioningTimedOutRetry is the delay between two checks of a deployment whose provisioning timed out
+const provisioningTimedOutRetry = 10 * time.Minute
+
+// ensureProvisioningTimeout tracks how long the creation or the update of the deployment in Atlas lasts. Once the
+// provisioning timeout of the deployment is exceeded, the ProvisioningTimedOut condition is set, a Warning event is
+// emitted and the result is requeued after a longer delay so that the stuck deployment isn't polled continuously
+func (r *AtlasDeploymentReconciler) ensureProvisioningTimeout(workflowCtx *workflow.Context, deployment *mdbv1.AtlasDeployment, result workflow.Result) workflow.Result {
+	if result.IsOk() {
+		workflowCtx.EnsureStatusOption(status.AtlasDeploymentProvisioningStartedAtOption(nil))
+		workflowCtx.UnsetCondition(status.DeploymentProvisioningTimedOutType)
+		return result
+	}
+
+	// errors while provisioning don't reset the tracking, the provisioning is still ongoing in Atlas
+	if reason := result.GetReason(); reason != workflow.DeploymentCreating && reason != workflow.DeploymentUpdating {
+		return result
+	}
+
+	startedAt := deployment.Status.ProvisioningStartedAt
+	if startedAt == nil {
+		now := metav1.Now()
+		startedAt = &now
+		workflowCtx.EnsureStatusOption(status.AtlasDeploymentProvisioningStartedAtOption(startedAt))
+	}
+
+	timeout := deployment.Spec.ProvisioningTimeout
+	if timeout == nil || timeout.Duration <= 0 {
+		return result
+	}
+
+	elapsed := time.Since(startedAt.Time)
+	if elapsed < timeout.Duration {
+		return result
+	}
+
+	condition := status.TrueCondition(status.DeploymentProvisioningTimedOutType).
+		WithReason(string(workflow.DeploymentProvisioningTimedOut))
+	condition.Message = fmt.Sprintf(
+		"the deployment has been provisioning for %s, exceeding its provisioning timeout of %s: %s",
+		elapsed.Round(time.Minute),
+		timeout.Duration,
+		result.GetMessage(),
+	)
+	if _, ok := findCondition(deployment.Status.Conditions, status.DeploymentProvisioningTimedOutType); !ok {
+		r.EventRecorder.Event(deployment, "Warning", condition.Reason, condition.Message)
+	}
+	workflowCtx.EnsureCondition(condition)
+
+	return result.WithRetry(provisioningTimedOutRetry)
+}
@@ -0,0 +1,103 @@
+package atlasdeployment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestEnsureProvisioningTimeout(t *testing.T) {
+	provisioning := workflow.InProgress(workflow.DeploymentCreating, "deployment is provisioning")
+	newDeployment := func(startedAt time.Time) *mdbv1.AtlasDeployment {
+		deployment := mdbv1.NewDeployment("ns", "deployment", "deployment")
+		deployment.Spec.ProvisioningTimeout = &metav1.Duration{Duration: time.Hour}
+		if !startedAt.IsZero() {
+			deployment.Status.ProvisioningStartedAt = &metav1.Time{Time: startedAt}
+		}
+
+		return deployment
+	}
+
+	t.Run("should record the start of the provisioning", func(t *testing.T) {
+		recorder := record.NewFakeRecorder(10)
+		reconciler := &AtlasDeploymentReconciler{EventRecorder: recorder}
+		deployment := newDeployment(time.Time{})
+		workflowCtx := newContext(t)
+
+		result := reconciler.ensureProvisioningTimeout(workflowCtx, deployment, provisioning)
+
+		assert.Equal(t, provisioning, result)
+		deployment.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+		assert.NotNil(t, deployment.Status.ProvisioningStartedAt)
+		assert.Empty(t, recorder.Events)
+	})
+
+	t.Run("should flag the deployment and slow down the polling once timed out", func(t *testing.T) {
+		recorder := record.NewFakeRecorder(10)
+		reconciler := &AtlasDeploymentReconciler{EventRecorder: recorder}
+		deployment := newDeployment(time.Now().Add(-2 * time.Hour))
+		workflowCtx := newContext(t)
+
+		result := reconciler.ensureProvisioningTimeout(workflowCtx, deployment, provisioning)
+
+		assert.True(t, result.IsInProgress())
+		assert.Equal(t, provisioningTimedOutRetry, result.ReconcileResult().RequeueAfter)
+		condition, ok := findCondition(workflowCtx.Conditions(), status.DeploymentProvisioningTimedOutType)
+		require.True(t, ok)
+		assert.Equal(t, corev1.ConditionTrue, condition.Status)
+		assert.Equal(t, "the deployment has been provisioning for 2h0m0s, exceeding its provisioning timeout of 1h0m0s: deployment is provisioning", condition.Message)
+		require.Len(t, recorder.Events, 1)
+		assert.Contains(t, <-recorder.Events, "Warning DeploymentProvisioningTimedOut")
+	})
+
+	t.Run("should emit the event only once", func(t *testing.T) {
+		recorder := record.NewFakeRecorder(10)
+		reconciler := &AtlasDeploymentReconciler{EventRecorder: recorder}
+		deployment := newDeployment(time.Now().Add(-2 * time.Hour))
+		deployment.Status.Conditions = []status.Condition{status.TrueCondition(status.DeploymentProvisioningTimedOutType)}
+
+		reconciler.ensureProvisioningTimeout(newContext(t), deployment, provisioning)
+
+		assert.Empty(t, recorder.Events)
+	})
+
+	t.Run("should keep tracking the provisioning on errors", func(t *testing.T) {
+		reconciler := &AtlasDeploymentReconciler{EventRecorder: record.NewFakeRecorder(10)}
+		deployment := newDeployment(time.Now().Add(-2 * time.Hour))
+		workflowCtx := newContext(t)
+		failure := workflow.Terminate(workflow.Internal, "error")
+
+		result := reconciler.ensureProvisioningTimeout(workflowCtx, deployment, failure)
+
+		assert.Equal(t, failure, result)
+		assert.Empty(t, workflowCtx.StatusOptions())
+	})
+
+	t.Run("should clear the tracking once provisioned", func(t *testing.T) {
+		reconciler := &AtlasDeploymentReconciler{EventRecorder: record.NewFakeRecorder(10)}
+		deployment := newDeployment(time.Now().Add(-2 * time.Hour))
+		workflowCtx := workflow.NewContext(
+			zaptest.NewLogger(t).Sugar(),
+			[]status.Condition{status.TrueCondition(status.DeploymentProvisioningTimedOutType)},
+			context.Background(),
+		)
+
+		result := reconciler.ensureProvisioningTimeout(workflowCtx, deployment, workflow.OK())
+
+		assert.True(t, result.IsOk())
+		deployment.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+		assert.Nil(t, deployment.Status.ProvisioningStartedAt)
+		assert.Empty(t, deployment.Status.Conditions)
+	})
+}
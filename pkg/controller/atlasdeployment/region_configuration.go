@@ -21,8 +21,9 @@ func syncRegionConfiguration(deploymentSpec *mdbv1.AdvancedDeploymentSpec, atlas
 	}
 
 	for _, regionSpec := range deploymentSpec.ReplicationSpecs[0].RegionConfigs {
-		// When disc auto-scaling is enabled and there's no updated on disk size, unset disc size letting auto-scaling config control it
-		if isDiskAutoScalingEnabled(regionSpec.AutoScaling) && !hasDiskSizeChanged(deploymentSpec.DiskSizeGB, atlasCluster.DiskSizeGB) {
+		// When disc auto-scaling is enabled, unset disc size letting auto-scaling config control it: the disk size of the
+		// spec is stale once Atlas grew the disk and sending it would shrink the disk on any unrelated update
+		if isDiskAutoScalingEnabled(regionSpec.AutoScaling) {
 			deploymentSpec.DiskSizeGB = nil
 		}
 	}
@@ -69,26 +70,6 @@ func isDiskAutoScalingEnabled(autoScalingSpec *mdbv1.AdvancedAutoScalingSpec) bo
 	return autoScalingSpec != nil && autoScalingSpec.DiskGB != nil && autoScalingSpec.DiskGB.Enabled != nil && *autoScalingSpec.DiskGB.Enabled
 }
 
-func hasDiskSizeChanged(deploymentDiskSize *int, clusterDiskSize *float64) bool {
-	if deploymentDiskSize == nil && clusterDiskSize == nil {
-		return false
-	}
-
-	if deploymentDiskSize == nil && clusterDiskSize != nil {
-		return true
-	}
-
-	if deploymentDiskSize != nil && clusterDiskSize == nil {
-		return true
-	}
-
-	if *clusterDiskSize < 0 {
-		return true
-	}
-
-	return *deploymentDiskSize != int(*clusterDiskSize)
-}
-
 func regionsConfigHasChanged(deploymentRegions []*mdbv1.AdvancedRegionConfig, atlasRegions []*mongodbatlas.AdvancedRegionConfig) bool {
 	if len(deploymentRegions) != len(atlasRegions) {
 		return true
@@ -716,7 +716,7 @@ func TestSyncComputeConfiguration(t *testing.T) {
 		assert.Equal(t, expected, advancedDeployment)
 	})
 
-	t.Run("should keep disc size for existing region with disc autoscaling enabled but disk size has be changed", func(t *testing.T) {
+	t.Run("should unset disc size for existing region with disc autoscaling enabled even when disk size has be changed", func(t *testing.T) {
 		advancedDeployment := &mdbv1.AdvancedDeploymentSpec{
 			DiskSizeGB: pointer.MakePtr(30),
 			ReplicationSpecs: []*mdbv1.AdvancedReplicationSpec{
@@ -755,7 +755,6 @@ func TestSyncComputeConfiguration(t *testing.T) {
 			},
 		}
 		expected := &mdbv1.AdvancedDeploymentSpec{
-			DiskSizeGB: pointer.MakePtr(30),
 			ReplicationSpecs: []*mdbv1.AdvancedReplicationSpec{
 				{
 					RegionConfigs: []*mdbv1.AdvancedRegionConfig{
@@ -0,0 +1,227 @@
+package atlasdeployment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	corev1 "k8s.io/api/core/v1"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/metrics"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	cpuUtilizationMetric        = "SYSTEM_NORMALIZED_CPU_USER"
+	connectionsMetric           = "CONNECTIONS"
+	diskUtilizationMetric       = "DISK_PARTITION_SPACE_PERCENT_USED"
+	dataPartitionName           = "data"
+	scalingAdvisorPeriod        = "PT1H"
+	scalingAdvisorGranularity   = "PT5M"
+	underProvisionedUtilization = 0.8
+	overProvisionedUtilization  = 0.2
+
+	cpuResource         = "cpu"
+	diskResource        = "disk"
+	connectionsResource = "connections"
+)
+
+// maxConnectionsByInstanceSize is the maximum number of connections Atlas allows per instance size
+var maxConnectionsByInstanceSize = map[string]float64{
+	"M10": 1500, "M20": 3000, "M30": 3000, "M40": 6000, "M50": 16000, "M60": 32000,
+	"M80": 96000, "M140": 96000, "M200": 128000, "M300": 128000, "M400": 128000, "M700": 128000,
+	"R40": 6000, "R50": 16000, "R60": 32000, "R80": 96000, "R200": 128000, "R300": 128000, "R400": 128000, "R700": 128000,
+}
+
+// deploymentUtilization holds the utilization ratios of the deployment resources.
+// A resource missing from the map couldn't be measured
+type deploymentUtilization map[string]float64
+
+// ensureScalingAdvice evaluates whether the deployment is right sized from its Atlas metrics, once per scaling advisor
+// interval. The advice is only reported in a condition, events and metrics: the deployment spec is never changed
+func (r *AtlasDeploymentReconciler) ensureScalingAdvice(workflowCtx *workflow.Context, project *mdbv1.AtlasProject, deployment *mdbv1.AtlasDeployment) {
+	if r.ScalingAdvisorInterval <= 0 {
+		workflowCtx.UnsetCondition(status.DeploymentRightSizedType)
+		workflowCtx.EnsureStatusOption(status.AtlasDeploymentScalingAdvisedAtOption(""))
+		return
+	}
+
+	now := time.Now().UTC()
+	if !workflowCtx.Reapply && !scalingAdviceOutdated(deployment.Status.ScalingAdvisedAt, r.ScalingAdvisorInterval, now) {
+		return
+	}
+
+	sdkClient, _, err := r.AtlasProvider.SdkClient(workflowCtx.Context, project.ConnectionSecretObjectKey(), workflowCtx.Log)
+	if err != nil {
+		workflowCtx.Log.Warnf("unable to evaluate the deployment scaling advice: %s", err)
+		return
+	}
+
+	// the measurements are read at most once per interval, even when they fail
+	workflowCtx.EnsureStatusOption(status.AtlasDeploymentScalingAdvisedAtOption(now.Format(time.RFC3339)))
+	instanceSize := deploymentInstanceSize(deployment)
+	utilization, err := collectDeploymentUtilization(workflowCtx.Context, sdkClient.MonitoringAndLogsApi, project.ID(), deployment.GetDeploymentName(), instanceSize)
+	if err != nil {
+		workflowCtx.Log.Warnf("unable to evaluate the deployment scaling advice: %s", err)
+		return
+	}
+
+	for resource, ratio := range utilization {
+		metrics.SetDeploymentUtilization(deployment.Namespace, deployment.Name, resource, ratio)
+	}
+
+	condition := adviseScaling(utilization, instanceSize, deployment.Spec.DeploymentSpec.DiskSizeGB != nil)
+	if previous, ok := findCondition(deployment.Status.Conditions, status.DeploymentRightSizedType); !ok || previous.Reason != condition.Reason {
+		if condition.Status == corev1.ConditionFalse {
+			r.EventRecorder.Event(deployment, "Warning", condition.Reason, condition.Message)
+		}
+	}
+	workflowCtx.EnsureCondition(condition)
+}
+
+// scalingAdviceOutdated tells whether the interval elapsed since the utilization of the deployment was last read
+func scalingAdviceOutdated(advisedAt string, interval time.Duration, now time.Time) bool {
+	lastAdvised, err := time.Parse(time.RFC3339, advisedAt)
+	if err != nil {
+		return true
+	}
+
+	return now.Sub(lastAdvised) >= interval
+}
+
+// adviseScaling returns the DeploymentRightSized condition matching the utilization of the deployment
+func adviseScaling(utilization deploymentUtilization, instanceSize string, customDiskSize bool) status.Condition {
+	var findings, advices []string
+	for _, resource := range []string{cpuResource, connectionsResource} {
+		if ratio, ok := utilization[resource]; ok && ratio >= underProvisionedUtilization {
+			findings = append(findings, fmt.Sprintf("%s utilization is %.0f%%", resource, ratio*100))
+		}
+	}
+	if len(findings) > 0 {
+		advices = append(advices, fmt.Sprintf("consider a larger instanceSize than %s", instanceSize))
+	}
+	if disk, ok := utilization[diskResource]; ok && disk >= underProvisionedUtilization {
+		findings = append(findings, fmt.Sprintf("disk utilization is %.0f%%", disk*100))
+		advices = append(advices, "consider increasing diskSizeGB")
+	}
+	if len(findings) > 0 {
+		return sizingCondition(workflow.DeploymentUnderProvisioned, findings, advices)
+	}
+
+	cpu, cpuOk := utilization[cpuResource]
+	connections, connectionsOk := utilization[connectionsResource]
+	if cpuOk && connectionsOk && cpu < overProvisionedUtilization && connections < overProvisionedUtilization {
+		findings = append(findings, fmt.Sprintf("cpu utilization is %.0f%%", cpu*100), fmt.Sprintf("connections utilization is %.0f%%", connections*100))
+		advices = append(advices, fmt.Sprintf("consider a smaller instanceSize than %s", instanceSize))
+	}
+	if disk, ok := utilization[diskResource]; ok && customDiskSize && disk < overProvisionedUtilization {
+		findings = append(findings, fmt.Sprintf("disk utilization is %.0f%%", disk*100))
+		advices = append(advices, "consider decreasing diskSizeGB")
+	}
+	if len(findings) > 0 {
+		return sizingCondition(workflow.DeploymentOverProvisioned, findings, advices)
+	}
+
+	return status.TrueCondition(status.DeploymentRightSizedType)
+}
+
+func sizingCondition(reason workflow.ConditionReason, findings, advices []string) status.Condition {
+	condition := status.FalseCondition(status.DeploymentRightSizedType).WithReason(string(reason))
+	condition.Message = fmt.Sprintf("%s: %s", strings.Join(findings, ", "), strings.Join(advices, ", "))
+
+	return condition
+}
+
+// collectDeploymentUtilization reads the highest utilization among the data bearing processes of the deployment
+func collectDeploymentUtilization(ctx context.Context, monitoringAPI admin.MonitoringAndLogsApi, projectID, deploymentName, instanceSize string) (deploymentUtilization, error) {
+	processes, _, err := monitoringAPI.ListAtlasProcesses(ctx, projectID).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the processes of the project: %w", err)
+	}
+
+	processIDs := deploymentProcessIDs(processes.GetResults(), deploymentName)
+	if len(processIDs) == 0 {
+		return nil, fmt.Errorf("no processes reported for the deployment %s", deploymentName)
+	}
+
+	utilization := deploymentUtilization{}
+	setMax := func(resource string, ratio float64) {
+		if current, ok := utilization[resource]; !ok || ratio > current {
+			utilization[resource] = ratio
+		}
+	}
+
+	for _, processID := range processIDs {
+		measurements, _, err := monitoringAPI.GetHostMeasurements(ctx, projectID, processID).
+			M([]string{cpuUtilizationMetric, connectionsMetric}).
+			Period(scalingAdvisorPeriod).
+			Granularity(scalingAdvisorGranularity).
+			Execute()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the measurements of the process %s: %w", processID, err)
+		}
+
+		for _, measurement := range measurements.GetMeasurements() {
+			value, ok := averageDataPoint(measurement.GetDataPoints())
+			if !ok {
+				continue
+			}
+
+			switch measurement.GetName() {
+			case cpuUtilizationMetric:
+				setMax(cpuResource, value/100)
+			case connectionsMetric:
+				if maxConnections, ok := maxConnectionsByInstanceSize[instanceSize]; ok {
+					setMax(connectionsResource, value/maxConnections)
+				}
+			}
+		}
+
+		diskMeasurements, _, err := monitoringAPI.GetDiskMeasurements(ctx, projectID, dataPartitionName, processID).
+			M([]string{diskUtilizationMetric}).
+			Period(scalingAdvisorPeriod).
+			Granularity(scalingAdvisorGranularity).
+			Execute()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the disk measurements of the process %s: %w", processID, err)
+		}
+
+		for _, measurement := range diskMeasurements.GetMeasurements() {
+			if value, ok := averageDataPoint(measurement.GetDataPoints()); ok && measurement.GetName() == diskUtilizationMetric {
+				setMax(diskResource, value/100)
+			}
+		}
+	}
+
+	return utilization, nil
+}
+
+// deploymentInstanceSize returns the instance size of the electable nodes of the deployment
+func deploymentInstanceSize(deployment *mdbv1.AtlasDeployment) string {
+	for _, replicationSpec := range deployment.Spec.DeploymentSpec.ReplicationSpecs {
+		if replicationSpec == nil {
+			continue
+		}
+		for _, regionConfig := range replicationSpec.RegionConfigs {
+			if regionConfig != nil && regionConfig.ElectableSpecs != nil && regionConfig.ElectableSpecs.InstanceSize != "" {
+				return regionConfig.ElectableSpecs.InstanceSize
+			}
+		}
+	}
+
+	return ""
+}
+
+func findCondition(conditions []status.Condition, conditionType status.ConditionType) (status.Condition, bool) {
+	for _, condition := range conditions {
+		if condition.Type == conditionType {
+			return condition, true
+		}
+	}
+
+	return status.Condition{}, false
+}
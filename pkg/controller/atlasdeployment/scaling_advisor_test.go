@@ -0,0 +1,134 @@
+package atlasdeployment
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestAdviseScaling(t *testing.T) {
+	tests := map[string]struct {
+		utilization    deploymentUtilization
+		customDiskSize bool
+		expectedStatus corev1.ConditionStatus
+		expectedReason workflow.ConditionReason
+		expectedMsg    string
+	}{
+		"right sized": {
+			utilization:    deploymentUtilization{cpuResource: 0.5, connectionsResource: 0.3, diskResource: 0.5},
+			expectedStatus: corev1.ConditionTrue,
+		},
+		"nothing measured": {
+			utilization:    deploymentUtilization{},
+			expectedStatus: corev1.ConditionTrue,
+		},
+		"cpu under provisioned": {
+			utilization:    deploymentUtilization{cpuResource: 0.9, connectionsResource: 0.3, diskResource: 0.5},
+			expectedStatus: corev1.ConditionFalse,
+			expectedReason: workflow.DeploymentUnderProvisioned,
+			expectedMsg:    "cpu utilization is 90%: consider a larger instanceSize than M30",
+		},
+		"disk under provisioned": {
+			utilization:    deploymentUtilization{cpuResource: 0.5, connectionsResource: 0.3, diskResource: 0.85},
+			expectedStatus: corev1.ConditionFalse,
+			expectedReason: workflow.DeploymentUnderProvisioned,
+			expectedMsg:    "disk utilization is 85%: consider increasing diskSizeGB",
+		},
+		"connections and disk under provisioned": {
+			utilization:    deploymentUtilization{cpuResource: 0.1, connectionsResource: 0.95, diskResource: 0.85},
+			expectedStatus: corev1.ConditionFalse,
+			expectedReason: workflow.DeploymentUnderProvisioned,
+			expectedMsg:    "connections utilization is 95%, disk utilization is 85%: consider a larger instanceSize than M30, consider increasing diskSizeGB",
+		},
+		"instance over provisioned": {
+			utilization:    deploymentUtilization{cpuResource: 0.05, connectionsResource: 0.01, diskResource: 0.5},
+			expectedStatus: corev1.ConditionFalse,
+			expectedReason: workflow.DeploymentOverProvisioned,
+			expectedMsg:    "cpu utilization is 5%, connections utilization is 1%: consider a smaller instanceSize than M30",
+		},
+		"instance not over provisioned when connections are unknown": {
+			utilization:    deploymentUtilization{cpuResource: 0.05},
+			expectedStatus: corev1.ConditionTrue,
+		},
+		"disk over provisioned": {
+			utilization:    deploymentUtilization{cpuResource: 0.5, connectionsResource: 0.3, diskResource: 0.1},
+			customDiskSize: true,
+			expectedStatus: corev1.ConditionFalse,
+			expectedReason: workflow.DeploymentOverProvisioned,
+			expectedMsg:    "disk utilization is 10%: consider decreasing diskSizeGB",
+		},
+		"disk not over provisioned with the default disk size": {
+			utilization:    deploymentUtilization{cpuResource: 0.5, connectionsResource: 0.3, diskResource: 0.1},
+			expectedStatus: corev1.ConditionTrue,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			condition := adviseScaling(tt.utilization, "M30", tt.customDiskSize)
+
+			assert.Equal(t, status.DeploymentRightSizedType, condition.Type)
+			assert.Equal(t, tt.expectedStatus, condition.Status)
+			assert.Equal(t, string(tt.expectedReason), condition.Reason)
+			assert.Equal(t, tt.expectedMsg, condition.Message)
+		})
+	}
+}
+
+func TestCollectDeploymentUtilization(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/atlas/v2/groups/project-id/processes", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, `{"results":[
+			{"id":"cluster0-shard-00-00.abcd.mongodb.net:27017","hostname":"cluster0-shard-00-00.abcd.mongodb.net","typeName":"REPLICA_PRIMARY"},
+			{"id":"cluster0-shard-00-01.abcd.mongodb.net:27017","hostname":"cluster0-shard-00-01.abcd.mongodb.net","typeName":"REPLICA_SECONDARY"}
+		],"totalCount":2}`)
+	})
+	mux.HandleFunc("/api/atlas/v2/groups/project-id/processes/cluster0-shard-00-00.abcd.mongodb.net:27017/measurements", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, `{"measurements":[
+			{"name":"SYSTEM_NORMALIZED_CPU_USER","dataPoints":[{"timestamp":"2023-11-01T11:55:00Z","value":40},{"timestamp":"2023-11-01T12:00:00Z","value":60}]},
+			{"name":"CONNECTIONS","dataPoints":[{"timestamp":"2023-11-01T12:00:00Z","value":300}]}
+		]}`)
+	})
+	mux.HandleFunc("/api/atlas/v2/groups/project-id/processes/cluster0-shard-00-01.abcd.mongodb.net:27017/measurements", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, `{"measurements":[
+			{"name":"SYSTEM_NORMALIZED_CPU_USER","dataPoints":[{"timestamp":"2023-11-01T12:00:00Z","value":20}]},
+			{"name":"CONNECTIONS","dataPoints":[{"timestamp":"2023-11-01T12:00:00Z","value":600}]}
+		]}`)
+	})
+	mux.HandleFunc("/api/atlas/v2/groups/project-id/processes/cluster0-shard-00-00.abcd.mongodb.net:27017/disks/data/measurements", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, `{"measurements":[{"name":"DISK_PARTITION_SPACE_PERCENT_USED","dataPoints":[{"timestamp":"2023-11-01T12:00:00Z","value":25}]}]}`)
+	})
+	mux.HandleFunc("/api/atlas/v2/groups/project-id/processes/cluster0-shard-00-01.abcd.mongodb.net:27017/disks/data/measurements", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, `{"measurements":[{"name":"DISK_PARTITION_SPACE_PERCENT_USED","dataPoints":[{"timestamp":"2023-11-01T12:00:00Z","value":30}]}]}`)
+	})
+
+	utilization, err := collectDeploymentUtilization(context.Background(), testMonitoringAPI(t, mux), "project-id", "cluster0", "M10")
+	require.NoError(t, err)
+	assert.Equal(t, deploymentUtilization{cpuResource: 0.5, connectionsResource: 0.4, diskResource: 0.3}, utilization)
+}
+
+func TestDeploymentInstanceSize(t *testing.T) {
+	deployment := mdbv1.DefaultAwsAdvancedDeployment("ns", "project")
+	assert.Equal(t, "M10", deploymentInstanceSize(deployment))
+
+	deployment.Spec.DeploymentSpec.ReplicationSpecs = nil
+	assert.Empty(t, deploymentInstanceSize(deployment))
+}
+
+func TestScalingAdviceOutdated(t *testing.T) {
+	now := time.Date(2023, 11, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.True(t, scalingAdviceOutdated("", time.Hour, now))
+	assert.True(t, scalingAdviceOutdated("invalid", time.Hour, now))
+	assert.True(t, scalingAdviceOutdated("2023-11-01T11:00:00Z", time.Hour, now))
+	assert.False(t, scalingAdviceOutdated("2023-11-01T11:30:00Z", time.Hour, now))
+}
@@ -8,7 +8,9 @@ import (
 
 	"go.mongodb.org/atlas/mongodbatlas"
 
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
 
@@ -24,7 +26,11 @@ func (r *AtlasDeploymentReconciler) ensureServerlessInstanceState(workflowCtx *w
 		}
 
 		if resp.StatusCode != http.StatusNotFound {
-			return atlasDeployment, workflow.Terminate(workflow.DeploymentNotCreatedInAtlas, err.Error())
+			return atlasDeployment, workflow.Terminate(workflow.DeploymentNotCreatedInAtlas, err.Error()).WithAtlasError(err)
+		}
+
+		if result := ensureCanCreate(deployment); !result.IsOk() {
+			return nil, result
 		}
 
 		atlasDeployment, err = serverlessSpec.ToAtlas()
@@ -39,11 +45,13 @@ func (r *AtlasDeploymentReconciler) ensureServerlessInstanceState(workflowCtx *w
 				ProviderName:        string(serverlessSpec.ProviderSettings.ProviderName),
 				RegionName:          serverlessSpec.ProviderSettings.RegionName,
 			},
-			Tag: atlasDeployment.Tags,
+			Tag: pointer.MakePtr(append(pointer.GetOrDefault(atlasDeployment.Tags, nil), trackingTags(deployment)...)),
 		})
 		if err != nil {
-			return atlasDeployment, workflow.Terminate(workflow.DeploymentNotCreatedInAtlas, err.Error())
+			return atlasDeployment, workflow.Terminate(workflow.DeploymentNotCreatedInAtlas, err.Error()).WithAtlasError(err)
 		}
+	} else if result := ensureCanAcquire(deployment, pointer.GetOrDefault(atlasDeployment.Tags, nil)); !result.IsOk() {
+		return nil, result
 	}
 
 	switch atlasDeployment.StateName {
@@ -52,9 +60,10 @@ func (r *AtlasDeploymentReconciler) ensureServerlessInstanceState(workflowCtx *w
 		if err != nil {
 			return atlasDeployment, workflow.Terminate(workflow.Internal, err.Error())
 		}
-		if convertedDeployment.Tags == nil {
-			convertedDeployment.Tags = &[]*mongodbatlas.Tag{}
-		}
+		convertedDeployment.Tags = pointer.MakePtr(keepServerlessTrackingTags(
+			pointer.GetOrDefault(convertedDeployment.Tags, nil),
+			pointer.GetOrDefault(atlasDeployment.Tags, nil),
+		))
 		if !isTagsEqual(*(atlasDeployment.Tags), *(convertedDeployment.Tags)) {
 			atlasDeployment, _, err = workflowCtx.Client.ServerlessInstances.Update(workflowCtx.Context, project.Status.ID, serverlessSpec.Name, &mongodbatlas.ServerlessUpdateRequestParams{
 				Tag: convertedDeployment.Tags,
@@ -63,8 +72,11 @@ func (r *AtlasDeploymentReconciler) ensureServerlessInstanceState(workflowCtx *w
 				},
 				TerminationProtectionEnabled: &serverlessSpec.TerminationProtectionEnabled,
 			})
+			if atlas.IsMaintenanceInProgress(err) {
+				return atlasDeployment, maintenanceInProgress(fmt.Sprintf("Atlas rejected the update during a maintenance: %s", err))
+			}
 			if err != nil {
-				return atlasDeployment, workflow.Terminate(workflow.DeploymentNotUpdatedInAtlas, err.Error())
+				return atlasDeployment, workflow.Terminate(workflow.DeploymentNotUpdatedInAtlas, err.Error()).WithAtlasError(err)
 			}
 			return atlasDeployment, workflow.InProgress(workflow.DeploymentUpdating, "deployment is updating")
 		}
@@ -74,9 +86,12 @@ func (r *AtlasDeploymentReconciler) ensureServerlessInstanceState(workflowCtx *w
 	case status.StateCREATING:
 		return atlasDeployment, workflow.InProgress(workflow.DeploymentCreating, "deployment is provisioning")
 
-	case status.StateUPDATING, status.StateREPAIRING:
+	case status.StateUPDATING:
 		return atlasDeployment, workflow.InProgress(workflow.DeploymentUpdating, "deployment is updating")
 
+	case status.StateREPAIRING:
+		return atlasDeployment, maintenanceInProgress("Atlas is running a maintenance on the deployment")
+
 	// TODO: add "DELETING", "DELETED", handle 404 on delete
 
 	default:
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nametemplate"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/stringutil"
 
 	"go.uber.org/zap"
@@ -55,6 +56,14 @@ func ensureServerlessPrivateEndpoints(service *workflow.Context, groupID string,
 		return result
 	}
 
+	privateEndpoints, err := renderServerlessPENames(deploymentSpec.PrivateEndpoints, deployment)
+	if err != nil {
+		result := workflow.Terminate(workflow.ServerlessPENameTemplateInvalid, err.Error())
+		service.SetConditionFromResult(status.ServerlessPrivateEndpointReadyType, result)
+
+		return result
+	}
+
 	providerName := GetServerlessProvider(deploymentSpec)
 	if providerName == provider.ProviderGCP {
 		if len(deploymentSpec.PrivateEndpoints) == 0 {
@@ -65,7 +74,7 @@ func ensureServerlessPrivateEndpoints(service *workflow.Context, groupID string,
 		}
 	}
 
-	result := syncServerlessPrivateEndpoints(service, groupID, deploymentName, providerName, deploymentSpec.PrivateEndpoints)
+	result := syncServerlessPrivateEndpoints(service, groupID, deploymentName, providerName, privateEndpoints)
 	if !result.IsOk() {
 		service.SetConditionFromResult(status.ServerlessPrivateEndpointReadyType, result)
 		return result
@@ -104,8 +113,15 @@ func canServerlessPrivateEndpointsReconcile(service *workflow.Context, protected
 	}
 
 	logger := service.Log
-	prevCfg := prevPEConfig(latestConfig)
-	if matchingPEs(logger, deployment.Spec.ServerlessSpec.PrivateEndpoints, existingPE) ||
+	specPEs, err := renderServerlessPENames(deployment.Spec.ServerlessSpec.PrivateEndpoints, deployment)
+	if err != nil {
+		return false, err
+	}
+	prevCfg, err := renderServerlessPENames(prevPEConfig(latestConfig), deployment)
+	if err != nil {
+		return false, err
+	}
+	if matchingPEs(logger, specPEs, existingPE) ||
 		matchingPEs(logger, prevCfg, existingPE) {
 		return true, nil
 	}
@@ -156,6 +172,37 @@ func prevPEConfig(deploymentSpec *mdbv1.AtlasDeploymentSpec) []mdbv1.ServerlessP
 	return deploymentSpec.ServerlessSpec.PrivateEndpoints
 }
 
+// renderServerlessPENames expands the name templates of the private endpoints. The rendered names are the comments
+// identifying the private endpoints in Atlas until they are connected, the connected ones are identified by their cloud
+// provider endpoint so that a change of the template doesn't recreate them
+func renderServerlessPENames(privateEndpoints []mdbv1.ServerlessPrivateEndpoint, deployment *mdbv1.AtlasDeployment) ([]mdbv1.ServerlessPrivateEndpoint, error) {
+	if privateEndpoints == nil {
+		return nil, nil
+	}
+
+	values := nametemplate.NewValues()
+	values.Deployment = deployment.Spec.ServerlessSpec.Name
+	values.Namespace = deployment.Namespace
+	values.Name = deployment.Name
+	if deployment.Spec.ServerlessSpec.ProviderSettings != nil {
+		values.Provider = string(GetServerlessProvider(deployment.Spec.ServerlessSpec))
+		values.Region = deployment.Spec.ServerlessSpec.ProviderSettings.RegionName
+	}
+
+	rendered := make([]mdbv1.ServerlessPrivateEndpoint, 0, len(privateEndpoints))
+	for i, pe := range privateEndpoints {
+		values.Index = i
+		name, err := nametemplate.Generate(pe.Name, values, nametemplate.MaxLength)
+		if err != nil {
+			return nil, err
+		}
+		pe.Name = name
+		rendered = append(rendered, pe)
+	}
+
+	return rendered, nil
+}
+
 func GetServerlessProvider(deploymentSpec *mdbv1.ServerlessSpec) provider.ProviderName {
 	if deploymentSpec.ProviderSettings.ProviderName != provider.ProviderServerless {
 		return deploymentSpec.ProviderSettings.ProviderName
@@ -407,8 +454,14 @@ func sortReadySPE(existingPEs []mongodbatlas.ServerlessPrivateEndpointConnection
 	return &result
 }
 
+// isReadySPEEqual matches a connected private endpoint by its cloud provider endpoint rather than by its name, which
+// may be rendered differently from the comment it was created with
 func isReadySPEEqual(existingPE mongodbatlas.ServerlessPrivateEndpointConnection, desiredPE mdbv1.ServerlessPrivateEndpoint) bool {
-	return existingPE.Comment == desiredPE.Name && desiredPE.CloudProviderEndpointID == existingPE.CloudProviderEndpointID && desiredPE.PrivateEndpointIPAddress == existingPE.PrivateEndpointIPAddress
+	if desiredPE.CloudProviderEndpointID == "" {
+		return existingPE.Comment == desiredPE.Name && existingPE.CloudProviderEndpointID == "" && desiredPE.PrivateEndpointIPAddress == existingPE.PrivateEndpointIPAddress
+	}
+
+	return desiredPE.CloudProviderEndpointID == existingPE.CloudProviderEndpointID && desiredPE.PrivateEndpointIPAddress == existingPE.PrivateEndpointIPAddress
 }
 
 func sortSPEToConnect(existingPEs []mongodbatlas.ServerlessPrivateEndpointConnection, desiredPEs []mdbv1.ServerlessPrivateEndpoint, uniqueComments []string) *SPEDiff {
@@ -11,6 +11,7 @@ import (
 	"go.mongodb.org/atlas/mongodbatlas"
 	"go.uber.org/zap"
 
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nametemplate"
 	v1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
@@ -212,6 +213,72 @@ func TestCanReconcileServerlessPrivateEndpointsFail(t *testing.T) {
 	})
 }
 
+func TestRenderServerlessPENames(t *testing.T) {
+	nametemplate.Environment = "production"
+	defer func() { nametemplate.Environment = "" }()
+	deployment := sampleServerlessDeployment()
+	deployment.Namespace = "ns"
+	deployment.Name = "deployment"
+
+	t.Run("should expand the name templates", func(t *testing.T) {
+		rendered, err := renderServerlessPENames([]v1.ServerlessPrivateEndpoint{
+			{Name: "{{ .Deployment }}-{{ .Environment }}-{{ .Namespace }}-{{ .Name }}", CloudProviderEndpointID: "vpce-1"},
+			{Name: "static"},
+		}, deployment)
+
+		require.NoError(t, err)
+		assert.Equal(t, []v1.ServerlessPrivateEndpoint{
+			{Name: fakeInstanceName + "-production-ns-deployment", CloudProviderEndpointID: "vpce-1"},
+			{Name: "static"},
+		}, rendered)
+	})
+
+	t.Run("should reject invalid templates", func(t *testing.T) {
+		_, err := renderServerlessPENames([]v1.ServerlessPrivateEndpoint{{Name: "{{ .Cluster }}"}}, deployment)
+
+		require.ErrorContains(t, err, "invalid name template")
+	})
+
+	t.Run("should match the endpoints of Atlas by their rendered name", func(t *testing.T) {
+		endpointsConfig := sampleAtlasSPEConfig()
+		endpointsConfig[0].Comment = fakeInstanceName + "-production"
+		client := mongodbatlas.Client{
+			ServerlessPrivateEndpoints: ServerlessPrivateEndpointClientMock{
+				ListFn: func(groupID string, instanceName string, opts *mongodbatlas.ListOptions) ([]mongodbatlas.ServerlessPrivateEndpointConnection, *mongodbatlas.Response, error) {
+					return endpointsConfig, nil, nil
+				},
+			},
+		}
+		endpoints := endpointsFrom(endpointsConfig)
+		endpoints[0].Name = "{{ .Deployment }}-{{ .Environment }}"
+		workflowCtx := workflow.Context{Client: &client, Log: debugLogger(t), Context: context.Background()}
+
+		result, err := canServerlessPrivateEndpointsReconcile(&workflowCtx, true, fakeProjectID, sampleAnnotatedServerlessDeployment(endpoints))
+
+		require.NoError(t, err)
+		assert.True(t, result)
+	})
+
+	t.Run("should expand the index of the endpoints", func(t *testing.T) {
+		rendered, err := renderServerlessPENames([]v1.ServerlessPrivateEndpoint{{Name: "app-{{ .Index }}"}, {Name: "app-{{ .Index }}"}}, deployment)
+
+		require.NoError(t, err)
+		assert.Equal(t, []v1.ServerlessPrivateEndpoint{{Name: "app-0"}, {Name: "app-1"}}, rendered)
+	})
+
+	t.Run("should keep the connected endpoints whose name changed", func(t *testing.T) {
+		endpointsConfig := sampleAtlasSPEConfig()
+		endpoints := endpointsFrom(endpointsConfig)
+		endpoints[0].Name = "renamed"
+
+		diff := sortReadySPE(endpointsConfig, endpoints)
+
+		assert.Len(t, diff.PEToUpdateStatus, 2)
+		assert.Empty(t, diff.PEToCreate)
+		assert.Empty(t, diff.PEToDelete)
+	})
+}
+
 func sampleServerlessDeployment() *v1.AtlasDeployment {
 	return &v1.AtlasDeployment{
 		Spec: v1.AtlasDeploymentSpec{
@@ -0,0 +1,112 @@
+package atlasdeployment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	serverlessDataSizeMetric    = "SERVERLESS_DATA_SIZE_TOTAL"
+	serverlessReadUnitsMetric   = "SERVERLESS_TOTAL_READ_UNITS"
+	serverlessWriteUnitsMetric  = "SERVERLESS_TOTAL_WRITE_UNITS"
+	serverlessConnectionsMetric = "SERVERLESS_CONNECTIONS"
+
+	serverlessUsagePeriod      = "PT1H"
+	serverlessUsageGranularity = "PT5M"
+)
+
+// ensureServerlessUsageStats collects the consumption indicators of the serverless instance into the status.
+// Failing to collect them is not a reason to fail the reconciliation, the previously collected values are kept instead.
+func (r *AtlasDeploymentReconciler) ensureServerlessUsageStats(workflowCtx *workflow.Context, project *mdbv1.AtlasProject, deployment *mdbv1.AtlasDeployment) {
+	now := time.Now().UTC()
+	if !workflowCtx.Reapply && !serverlessUsageOutdated(deployment.Status.ServerlessUsage, r.ServerlessUsageStatsInterval, now) {
+		return
+	}
+
+	sdkClient, _, err := r.AtlasProvider.SdkClient(workflowCtx.Context, project.ConnectionSecretObjectKey(), workflowCtx.Log)
+	if err != nil {
+		workflowCtx.Log.Warnf("unable to collect serverless usage stats: %s", err)
+		return
+	}
+
+	usage, err := collectServerlessUsage(workflowCtx.Context, sdkClient.MonitoringAndLogsApi, project.ID(), deployment.GetDeploymentName(), now)
+	if err != nil {
+		workflowCtx.Log.Warnf("unable to collect serverless usage stats: %s", err)
+		return
+	}
+
+	workflowCtx.EnsureStatusOption(status.AtlasDeploymentServerlessUsageOption(usage))
+}
+
+// serverlessUsageOutdated returns true if the usage was never collected or if it was collected more than one interval ago
+func serverlessUsageOutdated(usage *status.ServerlessUsage, interval time.Duration, now time.Time) bool {
+	if usage == nil {
+		return true
+	}
+
+	lastUpdated, err := time.Parse(time.RFC3339, usage.LastUpdated)
+	if err != nil {
+		return true
+	}
+
+	return now.Sub(lastUpdated) >= interval
+}
+
+func collectServerlessUsage(ctx context.Context, monitoringAPI admin.MonitoringAndLogsApi, projectID, instanceName string, now time.Time) (*status.ServerlessUsage, error) {
+	processes, _, err := monitoringAPI.ListAtlasProcesses(ctx, projectID).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the processes of the project: %w", err)
+	}
+
+	processIDs := deploymentProcessIDs(processes.GetResults(), instanceName)
+	if len(processIDs) == 0 {
+		return nil, fmt.Errorf("no processes reported for the serverless instance %s", instanceName)
+	}
+
+	usage := &status.ServerlessUsage{LastUpdated: now.Format(time.RFC3339)}
+	for _, processID := range processIDs {
+		measurements, _, err := monitoringAPI.GetHostMeasurements(ctx, projectID, processID).
+			M([]string{serverlessDataSizeMetric, serverlessReadUnitsMetric, serverlessWriteUnitsMetric, serverlessConnectionsMetric}).
+			Period(serverlessUsagePeriod).
+			Granularity(serverlessUsageGranularity).
+			Execute()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the measurements of the process %s: %w", processID, err)
+		}
+
+		addServerlessMeasurements(usage, measurements.GetMeasurements())
+	}
+
+	return usage, nil
+}
+
+// addServerlessMeasurements adds the latest data points of the process measurements to the usage.
+// The data size is shared by all the processes of the instance so the largest value is kept.
+func addServerlessMeasurements(usage *status.ServerlessUsage, measurements []admin.MetricsMeasurementAtlas) {
+	for _, measurement := range measurements {
+		value, ok := latestDataPoint(measurement.GetDataPoints())
+		if !ok {
+			continue
+		}
+
+		switch measurement.GetName() {
+		case serverlessDataSizeMetric:
+			if value > usage.DataSizeBytes {
+				usage.DataSizeBytes = value
+			}
+		case serverlessReadUnitsMetric:
+			usage.ReadProcessingUnits += value
+		case serverlessWriteUnitsMetric:
+			usage.WriteProcessingUnits += value
+		case serverlessConnectionsMetric:
+			usage.Connections += value
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package atlasdeployment
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+func TestServerlessUsageOutdated(t *testing.T) {
+	now := time.Date(2023, 11, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.True(t, serverlessUsageOutdated(nil, time.Hour, now))
+	assert.True(t, serverlessUsageOutdated(&status.ServerlessUsage{LastUpdated: "invalid"}, time.Hour, now))
+	assert.True(t, serverlessUsageOutdated(&status.ServerlessUsage{LastUpdated: "2023-11-01T10:59:00Z"}, time.Hour, now))
+	assert.False(t, serverlessUsageOutdated(&status.ServerlessUsage{LastUpdated: "2023-11-01T11:30:00Z"}, time.Hour, now))
+}
+
+func TestAddServerlessMeasurements(t *testing.T) {
+	older := time.Date(2023, 11, 1, 11, 55, 0, 0, time.UTC)
+	newer := time.Date(2023, 11, 1, 12, 0, 0, 0, time.UTC)
+	dataPoints := func(oldValue, newValue float32) *[]admin.MetricDataPointAtlas {
+		return &[]admin.MetricDataPointAtlas{
+			{Timestamp: &newer, Value: &newValue},
+			{Timestamp: &older, Value: &oldValue},
+			{Timestamp: &newer},
+		}
+	}
+
+	usage := &status.ServerlessUsage{}
+	for _, value := range []float32{1024, 2048} {
+		addServerlessMeasurements(usage, []admin.MetricsMeasurementAtlas{
+			{Name: admin.PtrString(serverlessDataSizeMetric), DataPoints: dataPoints(1, value)},
+			{Name: admin.PtrString(serverlessReadUnitsMetric), DataPoints: dataPoints(1, 10)},
+			{Name: admin.PtrString(serverlessWriteUnitsMetric), DataPoints: dataPoints(1, 5)},
+			{Name: admin.PtrString(serverlessConnectionsMetric), DataPoints: dataPoints(1, 3)},
+			{Name: admin.PtrString("OTHER_METRIC"), DataPoints: dataPoints(1, 100)},
+			{Name: admin.PtrString(serverlessConnectionsMetric)},
+		})
+	}
+
+	assert.Equal(t, &status.ServerlessUsage{
+		DataSizeBytes:        2048,
+		ReadProcessingUnits:  20,
+		WriteProcessingUnits: 10,
+		Connections:          6,
+	}, usage)
+}
+
+func TestCollectServerlessUsage(t *testing.T) {
+	now := time.Date(2023, 11, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("collects the usage of the serverless processes", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/api/atlas/v2/groups/project-id/processes", func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, `{"results":[{"id":"instance-shard-00-00.abcd.mongodb.net:27017","hostname":"instance-shard-00-00.abcd.mongodb.net"}],"totalCount":1}`)
+		})
+		mux.HandleFunc("/api/atlas/v2/groups/project-id/processes/instance-shard-00-00.abcd.mongodb.net:27017/measurements", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, serverlessUsagePeriod, r.URL.Query().Get("period"))
+			writeJSON(w, `{"measurements":[
+				{"name":"SERVERLESS_DATA_SIZE_TOTAL","dataPoints":[{"timestamp":"2023-11-01T12:00:00Z","value":4096}]},
+				{"name":"SERVERLESS_TOTAL_READ_UNITS","dataPoints":[{"timestamp":"2023-11-01T12:00:00Z","value":12}]}
+			]}`)
+		})
+
+		usage, err := collectServerlessUsage(context.Background(), testMonitoringAPI(t, mux), "project-id", "instance", now)
+		require.NoError(t, err)
+		assert.Equal(t, &status.ServerlessUsage{
+			DataSizeBytes:       4096,
+			ReadProcessingUnits: 12,
+			LastUpdated:         "2023-11-01T12:00:00Z",
+		}, usage)
+	})
+
+	t.Run("fails when the instance has no processes", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/api/atlas/v2/groups/project-id/processes", func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, `{"results":[],"totalCount":0}`)
+		})
+
+		_, err := collectServerlessUsage(context.Background(), testMonitoringAPI(t, mux), "project-id", "instance", now)
+		assert.ErrorContains(t, err, "no processes reported")
+	})
+}
+
+func writeJSON(w http.ResponseWriter, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(body))
+}
+
+func testMonitoringAPI(t *testing.T, handler http.Handler) admin.MonitoringAndLogsApi {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := admin.NewClient(admin.UseBaseURL(server.URL))
+	require.NoError(t, err)
+
+	return client.MonitoringAndLogsApi
+}
@@ -0,0 +1,150 @@
+package atlasdeployment
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/ownership"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	// TrackingTagNamespace and TrackingTagName are stamped on the deployments the operator creates in Atlas, along
+	// with the rest of the ownership marker. They name the AtlasDeployment the deployment was created for, so that the
+	// deployments left behind in Atlas can be found
+	TrackingTagNamespace = ownership.NamespaceKey
+	TrackingTagName      = ownership.NameKey
+
+	advancedDeploymentTagsPath = "api/atlas/v1.5/groups/%s/clusters/%s"
+	serverlessTagsPath         = "api/atlas/v1.0/groups/%s/serverless/%s"
+)
+
+// deploymentTags is the body of the request updating the tags of a deployment. Unlike the Atlas client types, an
+// empty list is sent to remove all the tags
+type deploymentTags struct {
+	Tags []*mongodbatlas.Tag `json:"tags"`
+}
+
+func trackingTags(deployment *mdbv1.AtlasDeployment) []*mongodbatlas.Tag {
+	entries := ownership.For(deployment).Entries()
+	tags := make([]*mongodbatlas.Tag, 0, len(entries))
+	for _, entry := range entries {
+		tags = append(tags, &mongodbatlas.Tag{Key: entry.Key, Value: entry.Value})
+	}
+
+	return tags
+}
+
+func isTrackingTag(key string) bool {
+	return ownership.IsKey(key)
+}
+
+// trackingMarker returns the ownership marker found in the tags of a deployment
+func trackingMarker(tags []*mongodbatlas.Tag) (ownership.Marker, bool) {
+	entries := make([]ownership.Entry, 0, len(tags))
+	for _, tag := range tags {
+		if tag != nil {
+			entries = append(entries, ownership.Entry{Key: tag.Key, Value: tag.Value})
+		}
+	}
+
+	return ownership.FromEntries(entries)
+}
+
+// TrackedDeployment returns the key of the AtlasDeployment an Atlas deployment was created for, as found in its
+// tracking tags
+func TrackedDeployment(tags []*mongodbatlas.Tag) (client.ObjectKey, bool) {
+	marker, ok := trackingMarker(tags)
+
+	return client.ObjectKey{Namespace: marker.Namespace, Name: marker.Name}, ok
+}
+
+// keepTrackingTags returns the tags of the spec followed by the tracking tags found in Atlas, so that updating the
+// tags of a deployment doesn't remove them
+func keepTrackingTags(specTags, atlasTags []*mdbv1.TagSpec) []*mdbv1.TagSpec {
+	var result []*mdbv1.TagSpec
+	for _, tag := range specTags {
+		if !isTrackingTag(tag.Key) {
+			result = append(result, tag)
+		}
+	}
+	for _, tag := range atlasTags {
+		if isTrackingTag(tag.Key) {
+			result = append(result, tag)
+		}
+	}
+
+	return result
+}
+
+// keepServerlessTrackingTags is keepTrackingTags for the tags of serverless instances
+func keepServerlessTrackingTags(specTags, atlasTags []*mongodbatlas.Tag) []*mongodbatlas.Tag {
+	result := []*mongodbatlas.Tag{}
+	for _, tag := range specTags {
+		if !isTrackingTag(tag.Key) {
+			result = append(result, tag)
+		}
+	}
+	for _, tag := range atlasTags {
+		if isTrackingTag(tag.Key) {
+			result = append(result, tag)
+		}
+	}
+
+	return result
+}
+
+// untrackDeployment removes the tracking tags of a deployment kept in Atlas when its AtlasDeployment is deleted, so
+// that it isn't reported as left behind
+func untrackDeployment(workflowCtx *workflow.Context, projectID string, deployment *mdbv1.AtlasDeployment) error {
+	var tags []*mongodbatlas.Tag
+	var path string
+	var err error
+	if deployment.IsServerless() {
+		var instance *mongodbatlas.Cluster
+		instance, _, err = workflowCtx.Client.ServerlessInstances.Get(workflowCtx.Context, projectID, deployment.GetDeploymentName())
+		if instance != nil && instance.Tags != nil {
+			tags = *instance.Tags
+		}
+		path = fmt.Sprintf(serverlessTagsPath, projectID, deployment.GetDeploymentName())
+	} else {
+		var cluster *mongodbatlas.AdvancedCluster
+		cluster, _, err = workflowCtx.Client.AdvancedClusters.Get(workflowCtx.Context, projectID, deployment.GetDeploymentName())
+		if cluster != nil {
+			tags = cluster.Tags
+		}
+		path = fmt.Sprintf(advancedDeploymentTagsPath, projectID, deployment.GetDeploymentName())
+	}
+
+	var apiError *mongodbatlas.ErrorResponse
+	if errors.As(err, &apiError) && (apiError.ErrorCode == atlas.ClusterNotFound || apiError.ErrorCode == atlas.ServerlessInstanceNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	body := &deploymentTags{Tags: []*mongodbatlas.Tag{}}
+	for _, tag := range tags {
+		if !isTrackingTag(tag.Key) {
+			body.Tags = append(body.Tags, tag)
+		}
+	}
+	if len(body.Tags) == len(tags) {
+		return nil
+	}
+
+	req, err := workflowCtx.Client.NewRequest(workflowCtx.Context, http.MethodPatch, path, body)
+	if err != nil {
+		return err
+	}
+	_, err = workflowCtx.Client.Do(workflowCtx.Context, req, nil)
+
+	return err
+}
@@ -0,0 +1,112 @@
+package atlasdeployment
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestTrackedDeployment(t *testing.T) {
+	t.Run("should return the AtlasDeployment of the tracking tags", func(t *testing.T) {
+		key, tracked := TrackedDeployment(append(
+			[]*mongodbatlas.Tag{{Key: "team", Value: "platform"}},
+			trackingTags(mdbv1.NewDeployment("ns", "deployment", "cluster0"))...,
+		))
+
+		assert.True(t, tracked)
+		assert.Equal(t, client.ObjectKey{Namespace: "ns", Name: "deployment"}, key)
+	})
+
+	t.Run("should ignore the deployments without tracking tags", func(t *testing.T) {
+		_, tracked := TrackedDeployment([]*mongodbatlas.Tag{{Key: TrackingTagName, Value: "deployment"}})
+
+		assert.False(t, tracked)
+	})
+}
+
+func TestKeepTrackingTags(t *testing.T) {
+	atlasTags := []*mdbv1.TagSpec{
+		{Key: "team", Value: "platform"},
+		{Key: TrackingTagNamespace, Value: "ns"},
+		{Key: TrackingTagName, Value: "deployment"},
+	}
+
+	t.Run("should keep the tracking tags when the tags of the spec change", func(t *testing.T) {
+		assert.Equal(
+			t,
+			[]*mdbv1.TagSpec{{Key: "env", Value: "prod"}, atlasTags[1], atlasTags[2]},
+			keepTrackingTags([]*mdbv1.TagSpec{{Key: "env", Value: "prod"}}, atlasTags),
+		)
+	})
+
+	t.Run("should not duplicate the tracking tags", func(t *testing.T) {
+		assert.Equal(t, atlasTags, keepTrackingTags(atlasTags, atlasTags))
+	})
+
+	t.Run("should keep the serverless tracking tags", func(t *testing.T) {
+		assert.Equal(
+			t,
+			[]*mongodbatlas.Tag{{Key: TrackingTagName, Value: "deployment"}},
+			keepServerlessTrackingTags(nil, []*mongodbatlas.Tag{{Key: "team", Value: "platform"}, {Key: TrackingTagName, Value: "deployment"}}),
+		)
+	})
+}
+
+func TestUntrackDeployment(t *testing.T) {
+	newContext := func(t *testing.T, handler http.HandlerFunc, clusters *atlas.AdvancedClustersClientMock) *workflow.Context {
+		server := httptest.NewServer(handler)
+		t.Cleanup(server.Close)
+		atlasClient, err := mongodbatlas.New(server.Client(), mongodbatlas.SetBaseURL(server.URL+"/"))
+		require.NoError(t, err)
+		atlasClient.AdvancedClusters = clusters
+
+		return &workflow.Context{Client: atlasClient, Context: context.Background(), Log: testLog(t)}
+	}
+	deployment := mdbv1.NewDeployment("ns", "deployment", "cluster0")
+
+	t.Run("should remove the tracking tags only", func(t *testing.T) {
+		var patched deploymentTags
+		workflowCtx := newContext(t,
+			func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPatch, r.Method)
+				assert.Equal(t, "/api/atlas/v1.5/groups/project-id/clusters/cluster0", r.URL.Path)
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&patched))
+				_, _ = w.Write([]byte("{}"))
+			},
+			&atlas.AdvancedClustersClientMock{
+				GetFunc: func(projectID string, clusterName string) (*mongodbatlas.AdvancedCluster, *mongodbatlas.Response, error) {
+					return &mongodbatlas.AdvancedCluster{Name: clusterName, Tags: append([]*mongodbatlas.Tag{{Key: "team", Value: "platform"}}, trackingTags(deployment)...)}, nil, nil
+				},
+			},
+		)
+
+		require.NoError(t, untrackDeployment(workflowCtx, "project-id", deployment))
+		assert.Equal(t, []*mongodbatlas.Tag{{Key: "team", Value: "platform"}}, patched.Tags)
+	})
+
+	t.Run("should not update the deployments without tracking tags", func(t *testing.T) {
+		workflowCtx := newContext(t,
+			func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			},
+			&atlas.AdvancedClustersClientMock{
+				GetFunc: func(projectID string, clusterName string) (*mongodbatlas.AdvancedCluster, *mongodbatlas.Response, error) {
+					return &mongodbatlas.AdvancedCluster{Name: clusterName}, nil, nil
+				},
+			},
+		)
+
+		assert.NoError(t, untrackDeployment(workflowCtx, "project-id", deployment))
+	})
+}
@@ -0,0 +1,172 @@
+package atlasdeployment
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// advancedClustersPath is the path of the advanced clusters of a project, as requested by the Atlas client
+const advancedClustersPath = "api/atlas/v1.5/groups/%s/clusters"
+
+// parseUnsupportedOverrides returns the unsupported overrides of the deployment. They must be a JSON object not
+// setting any field modeled by deploymentSpec
+func parseUnsupportedOverrides(overrides *apiextensionsv1.JSON) (map[string]interface{}, error) {
+	if overrides == nil || len(overrides.Raw) == 0 {
+		return nil, nil
+	}
+
+	var parsed map[string]interface{}
+	if err := json.Unmarshal(overrides.Raw, &parsed); err != nil {
+		return nil, errors.New("spec.unsupportedOverrides must be a JSON object")
+	}
+
+	modeled := modeledDeploymentFields()
+	var conflicts []string
+	for key := range parsed {
+		if _, ok := modeled[key]; ok {
+			conflicts = append(conflicts, key)
+		}
+	}
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		return nil, fmt.Errorf("spec.unsupportedOverrides can't set the fields of spec.deploymentSpec: %s", strings.Join(conflicts, ", "))
+	}
+
+	return parsed, nil
+}
+
+// modeledDeploymentFields returns the JSON names of the fields of the advanced deployment spec
+func modeledDeploymentFields() map[string]struct{} {
+	fields := map[string]struct{}{}
+	specType := reflect.TypeOf(mdbv1.AdvancedDeploymentSpec{})
+	for i := 0; i < specType.NumField(); i++ {
+		name, _, _ := strings.Cut(specType.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			fields[name] = struct{}{}
+		}
+	}
+
+	return fields
+}
+
+// mergeUnsupportedOverrides returns the request body of the cluster with the overrides merged into it. Nested objects
+// are merged, any other value replaces the one of the cluster
+func mergeUnsupportedOverrides(cluster *mongodbatlas.AdvancedCluster, overrides map[string]interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{}
+	if err = json.Unmarshal(data, &body); err != nil {
+		return nil, err
+	}
+
+	mergeJSONObjects(body, overrides)
+
+	return body, nil
+}
+
+func mergeJSONObjects(dst, src map[string]interface{}) {
+	for key, value := range src {
+		srcObject, srcIsObject := value.(map[string]interface{})
+		dstObject, dstIsObject := dst[key].(map[string]interface{})
+		if srcIsObject && dstIsObject {
+			mergeJSONObjects(dstObject, srcObject)
+			continue
+		}
+
+		dst[key] = value
+	}
+}
+
+// createAdvancedCluster creates the cluster in Atlas, merging the unsupported overrides of the deployment into the
+// request
+func createAdvancedCluster(ctx *workflow.Context, projectID string, cluster *mongodbatlas.AdvancedCluster, overrides map[string]interface{}) (*mongodbatlas.AdvancedCluster, error) {
+	if len(overrides) == 0 {
+		created, _, err := ctx.Client.AdvancedClusters.Create(ctx.Context, projectID, cluster)
+		return created, err
+	}
+
+	return sendAdvancedClusterRequest(ctx, http.MethodPost, fmt.Sprintf(advancedClustersPath, projectID), cluster, overrides)
+}
+
+// updateAdvancedCluster updates the cluster in Atlas, merging the unsupported overrides of the deployment into the
+// request
+func updateAdvancedCluster(ctx *workflow.Context, projectID, name string, cluster *mongodbatlas.AdvancedCluster, overrides map[string]interface{}) (*mongodbatlas.AdvancedCluster, error) {
+	if len(overrides) == 0 {
+		updated, _, err := ctx.Client.AdvancedClusters.Update(ctx.Context, projectID, name, cluster)
+		return updated, err
+	}
+
+	path := fmt.Sprintf(advancedClustersPath+"/%s", projectID, url.PathEscape(name))
+
+	return sendAdvancedClusterRequest(ctx, http.MethodPatch, path, cluster, overrides)
+}
+
+func sendAdvancedClusterRequest(ctx *workflow.Context, method, path string, cluster *mongodbatlas.AdvancedCluster, overrides map[string]interface{}) (*mongodbatlas.AdvancedCluster, error) {
+	body, err := mergeUnsupportedOverrides(cluster, overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.Log.Warnw("Sending the unsupported overrides of the deployment to Atlas", "fields", overrideKeys(overrides))
+
+	req, err := ctx.Client.NewRequest(ctx.Context, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &mongodbatlas.AdvancedCluster{}
+	if _, err = ctx.Client.Do(ctx.Context, req, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func overrideKeys(overrides map[string]interface{}) []string {
+	keys := make([]string, 0, len(overrides))
+	for key := range overrides {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// unsupportedOverridesChanged reports whether the unsupported overrides changed since the deployment was last applied.
+// They are not compared with Atlas, as the operator doesn't know the fields they set
+func unsupportedOverridesChanged(deployment *mdbv1.AtlasDeployment) bool {
+	previous, err := lastAppliedDeployment(deployment)
+	if err != nil || previous == nil {
+		return deployment.Spec.UnsupportedOverrides != nil
+	}
+
+	return !reflect.DeepEqual(normalizedOverrides(previous.Spec.UnsupportedOverrides), normalizedOverrides(deployment.Spec.UnsupportedOverrides))
+}
+
+func normalizedOverrides(overrides *apiextensionsv1.JSON) interface{} {
+	if overrides == nil || len(overrides.Raw) == 0 {
+		return nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(overrides.Raw, &value); err != nil {
+		return string(overrides.Raw)
+	}
+
+	return value
+}
@@ -0,0 +1,125 @@
+package atlasdeployment
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestParseUnsupportedOverrides(t *testing.T) {
+	t.Run("should return no overrides when unset", func(t *testing.T) {
+		overrides, err := parseUnsupportedOverrides(nil)
+
+		require.NoError(t, err)
+		assert.Nil(t, overrides)
+	})
+
+	t.Run("should parse a JSON object", func(t *testing.T) {
+		overrides, err := parseUnsupportedOverrides(&apiextensionsv1.JSON{Raw: []byte(`{"newOption":{"enabled":true}}`)})
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"newOption": map[string]interface{}{"enabled": true}}, overrides)
+	})
+
+	t.Run("should reject anything but a JSON object", func(t *testing.T) {
+		_, err := parseUnsupportedOverrides(&apiextensionsv1.JSON{Raw: []byte(`["newOption"]`)})
+
+		require.EqualError(t, err, "spec.unsupportedOverrides must be a JSON object")
+	})
+
+	t.Run("should reject the fields of the deployment spec", func(t *testing.T) {
+		_, err := parseUnsupportedOverrides(&apiextensionsv1.JSON{Raw: []byte(`{"paused":true,"diskSizeGB":20,"newOption":1}`)})
+
+		require.EqualError(t, err, "spec.unsupportedOverrides can't set the fields of spec.deploymentSpec: diskSizeGB, paused")
+	})
+}
+
+func TestMergeUnsupportedOverrides(t *testing.T) {
+	t.Run("should merge the nested objects and replace the other values", func(t *testing.T) {
+		cluster := &mongodbatlas.AdvancedCluster{
+			Name:              "cluster0",
+			ClusterType:       "REPLICASET",
+			ConnectionStrings: &mongodbatlas.ConnectionStrings{Standard: "mongodb://cluster0"},
+		}
+
+		body, err := mergeUnsupportedOverrides(cluster, map[string]interface{}{
+			"connectionStrings": map[string]interface{}{"newString": "value"},
+			"clusterType":       "SHARDED",
+			"newOption":         true,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"standard": "mongodb://cluster0", "newString": "value"}, body["connectionStrings"])
+		assert.Equal(t, "SHARDED", body["clusterType"])
+		assert.Equal(t, true, body["newOption"])
+		assert.Equal(t, "cluster0", body["name"])
+	})
+}
+
+func TestUpdateAdvancedClusterWithOverrides(t *testing.T) {
+	t.Run("should send the overrides in the update request", func(t *testing.T) {
+		var received map[string]interface{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPatch, r.Method)
+			assert.Equal(t, "/api/atlas/v1.5/groups/project-id/clusters/cluster0", r.URL.Path)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			_, _ = w.Write([]byte(`{"name":"cluster0","stateName":"UPDATING"}`))
+		}))
+		defer server.Close()
+
+		atlasClient, err := mongodbatlas.New(server.Client(), mongodbatlas.SetBaseURL(server.URL+"/"))
+		require.NoError(t, err)
+		ctx := &workflow.Context{Client: atlasClient, Context: context.Background(), Log: testLog(t)}
+
+		updated, err := updateAdvancedCluster(ctx, "project-id", "cluster0", &mongodbatlas.AdvancedCluster{ClusterType: "REPLICASET"}, map[string]interface{}{"newOption": "on"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "UPDATING", updated.StateName)
+		assert.Equal(t, map[string]interface{}{"clusterType": "REPLICASET", "newOption": "on"}, received)
+	})
+}
+
+func TestUnsupportedOverridesChanged(t *testing.T) {
+	newDeployment := func(overrides, lastApplied string) *mdbv1.AtlasDeployment {
+		deployment := mdbv1.NewDeployment("ns", "deployment", "cluster0")
+		if overrides != "" {
+			deployment.Spec.UnsupportedOverrides = &apiextensionsv1.JSON{Raw: []byte(overrides)}
+		}
+		if lastApplied != "" {
+			deployment.SetAnnotations(map[string]string{customresource.AnnotationLastAppliedConfiguration: lastApplied})
+		}
+
+		return deployment
+	}
+
+	t.Run("should be unchanged without overrides", func(t *testing.T) {
+		assert.False(t, unsupportedOverridesChanged(newDeployment("", `{"deploymentSpec":{"name":"cluster0"}}`)))
+	})
+
+	t.Run("should be unchanged when the overrides are the last applied ones", func(t *testing.T) {
+		deployment := newDeployment(`{"a": 1, "b": 2}`, `{"deploymentSpec":{"name":"cluster0"},"unsupportedOverrides":{"b":2,"a":1}}`)
+
+		assert.False(t, unsupportedOverridesChanged(deployment))
+	})
+
+	t.Run("should be changed when the overrides differ from the last applied ones", func(t *testing.T) {
+		deployment := newDeployment(`{"a": 2}`, `{"deploymentSpec":{"name":"cluster0"},"unsupportedOverrides":{"a":1}}`)
+
+		assert.True(t, unsupportedOverridesChanged(deployment))
+	})
+
+	t.Run("should be changed when the deployment was never applied", func(t *testing.T) {
+		assert.True(t, unsupportedOverridesChanged(newDeployment(`{"a": 1}`, "")))
+	})
+}
@@ -0,0 +1,50 @@
+package atlasdeployment
+
+import (
+	"go.mongodb.org/atlas/mongodbatlas"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// MongoDBVersionChangedEvent is the reason of the event emitted when the MongoDB version running in Atlas changes
+const MongoDBVersionChangedEvent = "MongoDBVersionChanged"
+
+// ensureVersionStatus reports the MongoDB version the deployment runs in Atlas, and the change of major version or
+// release system requested in the spec which Atlas didn't apply yet. The patch releases are applied by Atlas on its
+// own during the maintenance window, an event records every change of the running version
+func (r *AtlasDeploymentReconciler) ensureVersionStatus(workflowCtx *workflow.Context, deployment *mdbv1.AtlasDeployment, cluster *mongodbatlas.AdvancedCluster) {
+	if cluster.MongoDBVersion == "" {
+		return
+	}
+
+	previous := deployment.Status.MongoDBVersion
+	if previous != "" && previous != cluster.MongoDBVersion {
+		r.EventRecorder.Eventf(deployment, "Normal", MongoDBVersionChangedEvent,
+			"MongoDB version changed from %s to %s", previous, cluster.MongoDBVersion)
+	}
+
+	workflowCtx.
+		EnsureStatusOption(status.AtlasDeploymentMongoDBVersionOption(cluster.MongoDBVersion)).
+		EnsureStatusOption(status.AtlasDeploymentReleaseOption(cluster.MongoDBMajorVersion, cluster.VersionReleaseSystem)).
+		EnsureStatusOption(status.AtlasDeploymentPendingVersionChangeOption(pendingVersionChange(deployment.Spec.DeploymentSpec, cluster)))
+}
+
+// pendingVersionChange returns the major version and release system requested in the spec that differ from the ones
+// of the deployment in Atlas, or nil when Atlas is up to date
+func pendingVersionChange(spec *mdbv1.AdvancedDeploymentSpec, cluster *mongodbatlas.AdvancedCluster) *status.VersionChange {
+	change := status.VersionChange{}
+	if spec.MongoDBMajorVersion != "" && spec.MongoDBMajorVersion != cluster.MongoDBMajorVersion {
+		change.MongoDBMajorVersion = spec.MongoDBMajorVersion
+	}
+	if spec.VersionReleaseSystem != "" && spec.VersionReleaseSystem != cluster.VersionReleaseSystem {
+		change.VersionReleaseSystem = spec.VersionReleaseSystem
+	}
+
+	if change == (status.VersionChange{}) {
+		return nil
+	}
+
+	return &change
+}
@@ -0,0 +1,78 @@
+package atlasdeployment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/atlas/mongodbatlas"
+	"k8s.io/client-go/tools/record"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestPendingVersionChange(t *testing.T) {
+	cluster := &mongodbatlas.AdvancedCluster{MongoDBMajorVersion: "6.0", MongoDBVersion: "6.0.14", VersionReleaseSystem: "LTS"}
+
+	t.Run("should report nothing when the spec doesn't pin the version", func(t *testing.T) {
+		assert.Nil(t, pendingVersionChange(&mdbv1.AdvancedDeploymentSpec{}, cluster))
+	})
+
+	t.Run("should report nothing when Atlas runs the pinned major version", func(t *testing.T) {
+		spec := &mdbv1.AdvancedDeploymentSpec{MongoDBMajorVersion: "6.0", VersionReleaseSystem: "LTS"}
+
+		assert.Nil(t, pendingVersionChange(spec, cluster))
+	})
+
+	t.Run("should report the major version upgrade not applied yet", func(t *testing.T) {
+		spec := &mdbv1.AdvancedDeploymentSpec{MongoDBMajorVersion: "7.0"}
+
+		assert.Equal(t, &status.VersionChange{MongoDBMajorVersion: "7.0"}, pendingVersionChange(spec, cluster))
+	})
+
+	t.Run("should report the move to continuous releases not applied yet", func(t *testing.T) {
+		spec := &mdbv1.AdvancedDeploymentSpec{VersionReleaseSystem: "CONTINUOUS"}
+
+		assert.Equal(t, &status.VersionChange{VersionReleaseSystem: "CONTINUOUS"}, pendingVersionChange(spec, cluster))
+	})
+}
+
+func TestEnsureVersionStatus(t *testing.T) {
+	cluster := &mongodbatlas.AdvancedCluster{MongoDBMajorVersion: "6.0", MongoDBVersion: "6.0.14", VersionReleaseSystem: "LTS"}
+	ensure := func(deployment *mdbv1.AtlasDeployment) (*record.FakeRecorder, *workflow.Context) {
+		recorder := record.NewFakeRecorder(10)
+		workflowCtx := workflow.NewContext(testLog(t), []status.Condition{}, context.Background())
+		reconciler := &AtlasDeploymentReconciler{EventRecorder: recorder}
+
+		reconciler.ensureVersionStatus(workflowCtx, deployment, cluster)
+		deployment.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+
+		return recorder, workflowCtx
+	}
+
+	t.Run("should report the running version and the pending change", func(t *testing.T) {
+		deployment := mdbv1.DefaultAwsAdvancedDeployment("ns", "project")
+		deployment.Spec.DeploymentSpec.MongoDBMajorVersion = "7.0"
+
+		recorder, _ := ensure(deployment)
+
+		assert.Equal(t, "6.0.14", deployment.Status.MongoDBVersion)
+		assert.Equal(t, "6.0", deployment.Status.MongoDBMajorVersion)
+		assert.Equal(t, "LTS", deployment.Status.VersionReleaseSystem)
+		assert.Equal(t, &status.VersionChange{MongoDBMajorVersion: "7.0"}, deployment.Status.PendingVersionChange)
+		assert.Empty(t, recorder.Events)
+	})
+
+	t.Run("should record the patch releases applied by Atlas", func(t *testing.T) {
+		deployment := mdbv1.DefaultAwsAdvancedDeployment("ns", "project")
+		deployment.Status.MongoDBVersion = "6.0.13"
+		deployment.Status.PendingVersionChange = &status.VersionChange{MongoDBMajorVersion: "6.0"}
+
+		recorder, _ := ensure(deployment)
+
+		assert.Nil(t, deployment.Status.PendingVersionChange)
+		assert.Equal(t, "Normal MongoDBVersionChanged MongoDB version changed from 6.0.13 to 6.0.14", <-recorder.Events)
+	})
+}
@@ -0,0 +1,104 @@
+package atlasenvironment
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/notifier"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/statushandler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// AtlasEnvironmentReconciler reconciles an AtlasEnvironment object. It doesn't call Atlas itself: the environment is
+// expanded into the resources reconciled by the other controllers, and reports their readiness
+type AtlasEnvironmentReconciler struct {
+	Client           client.Client
+	Log              *zap.SugaredLogger
+	Scheme           *runtime.Scheme
+	GlobalPredicates []predicate.Predicate
+	EventRecorder    record.EventRecorder
+	FailureNotifier  *notifier.Notifier
+}
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasenvironments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasenvironments/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasenvironments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasenvironments/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *AtlasEnvironmentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, _ error) {
+	log := r.Log.With("atlasenvironment", req.NamespacedName)
+
+	environment := &mdbv1.AtlasEnvironment{}
+	result := customresource.PrepareResource(ctx, r.Client, req, environment, log)
+	if !result.IsOk() {
+		return result.ReconcileResult(), nil
+	}
+
+	if customresource.ReconciliationShouldBeSkipped(environment) {
+		log.Infow(fmt.Sprintf("-> Skipping AtlasEnvironment reconciliation as annotation %s=%s", customresource.ReconciliationPolicyAnnotation, customresource.ReconciliationPolicySkip), "spec", environment.Spec)
+		return workflow.OK().ReconcileResult(), nil
+	}
+
+	if customresource.NamespaceReconciliationPaused(ctx, r.Client, environment.Namespace, log) {
+		log.Infow(fmt.Sprintf("-> Pausing AtlasEnvironment reconciliation as annotation %s=true is set on the namespace", customresource.ReconciliationPausedAnnotation))
+		return customresource.MarkReconciliationPaused(ctx, r.Client, r.EventRecorder, environment, log).ReconcileResult(), nil
+	}
+
+	workflowCtx := customresource.MarkReconciliationStarted(r.Client, environment, log, ctx)
+	log.Infow("-> Starting AtlasEnvironment reconciliation", "spec", environment.Spec, "status", environment.Status)
+
+	if workflowCtx.Degraded(environment) {
+		log.Infow("-> Skipping the reconciliation of the degraded AtlasEnvironment, change its spec or set the reapply annotation to retry")
+		return ctrl.Result{}, nil
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			res = workflowCtx.RecoverPanic("AtlasEnvironment", p).ReconcileResult()
+		}
+		res = workflowCtx.CapRetries("AtlasEnvironment", environment, res)
+		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, r.FailureNotifier, environment)
+	}()
+
+	resourceVersionIsValid := customresource.ValidateResourceVersion(workflowCtx, environment, r.Log)
+	if !resourceVersionIsValid.IsOk() {
+		r.Log.Debugf("AtlasEnvironment validation result: %v", resourceVersionIsValid)
+		return resourceVersionIsValid.ReconcileResult(), nil
+	}
+
+	// the resources of the environment are owned by it and garbage collected with it, each of them removing its
+	// Atlas counterpart as per its deletion protection
+	if !environment.GetDeletionTimestamp().IsZero() {
+		return workflow.OK().ReconcileResult(), nil
+	}
+
+	result = r.ensureEnvironment(workflowCtx, environment)
+	workflowCtx.SetConditionFromResult(status.EnvironmentReadyType, result)
+	workflowCtx.SetConditionFromResult(status.ReadyType, result)
+
+	return result.ReconcileResult(), nil
+}
+
+func (r *AtlasEnvironmentReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("AtlasEnvironment").
+		For(&mdbv1.AtlasEnvironment{}, builder.WithPredicates(r.GlobalPredicates...)).
+		Owns(&mdbv1.AtlasProject{}).
+		Owns(&mdbv1.AtlasDeployment{}).
+		Owns(&mdbv1.AtlasDatabaseUser{}).
+		Owns(&mdbv1.AtlasBackupSchedule{}).
+		Owns(&mdbv1.AtlasBackupPolicy{}).
+		Complete(r)
+}
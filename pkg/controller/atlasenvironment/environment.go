@@ -0,0 +1,221 @@
+package atlasenvironment
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/connectionsecret"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// environmentResource is a resource the environment expands into
+type environmentResource struct {
+	kind     string
+	resource mdbv1.AtlasCustomResource
+}
+
+// ensureEnvironment applies the resources of the environment and reports whether they are all ready. The resources
+// are named after the environment, the deployment is applied before the removal of the backup schedule it references
+func (r *AtlasEnvironmentReconciler) ensureEnvironment(ctx *workflow.Context, environment *mdbv1.AtlasEnvironment) workflow.Result {
+	backupEnabled := pointer.GetOrDefault(environment.Spec.Backup, true)
+
+	atlasProject := &mdbv1.AtlasProject{ObjectMeta: childMeta(environment)}
+	deployment := &mdbv1.AtlasDeployment{ObjectMeta: childMeta(environment)}
+	dbUser := &mdbv1.AtlasDatabaseUser{ObjectMeta: childMeta(environment)}
+	policy := &mdbv1.AtlasBackupPolicy{ObjectMeta: childMeta(environment)}
+	schedule := &mdbv1.AtlasBackupSchedule{ObjectMeta: childMeta(environment)}
+
+	resources := []environmentResource{{kind: "AtlasProject", resource: atlasProject}}
+	if err := r.apply(ctx, environment, atlasProject, func() { expandProject(environment, atlasProject) }); err != nil {
+		return workflow.Terminate(workflow.EnvironmentResourcesNotApplied, err.Error())
+	}
+
+	if backupEnabled {
+		resources = append(resources, environmentResource{kind: "AtlasBackupPolicy", resource: policy}, environmentResource{kind: "AtlasBackupSchedule", resource: schedule})
+		if err := r.apply(ctx, environment, policy, func() { expandBackupPolicy(policy) }); err != nil {
+			return workflow.Terminate(workflow.EnvironmentResourcesNotApplied, err.Error())
+		}
+		if err := r.apply(ctx, environment, schedule, func() { expandBackupSchedule(environment, schedule) }); err != nil {
+			return workflow.Terminate(workflow.EnvironmentResourcesNotApplied, err.Error())
+		}
+	}
+
+	resources = append(resources, environmentResource{kind: "AtlasDeployment", resource: deployment})
+	if err := r.apply(ctx, environment, deployment, func() { expandDeployment(environment, deployment, backupEnabled) }); err != nil {
+		return workflow.Terminate(workflow.EnvironmentResourcesNotApplied, err.Error())
+	}
+
+	resources = append(resources, environmentResource{kind: "AtlasDatabaseUser", resource: dbUser})
+	if err := r.apply(ctx, environment, dbUser, func() { expandDatabaseUser(environment, dbUser) }); err != nil {
+		return workflow.Terminate(workflow.EnvironmentResourcesNotApplied, err.Error())
+	}
+
+	if !backupEnabled {
+		for _, obj := range []client.Object{schedule, policy} {
+			if err := r.deleteOwned(ctx, environment, obj); err != nil {
+				return workflow.Terminate(workflow.EnvironmentResourcesNotApplied, fmt.Sprintf("failed to remove the backup resources: %s", err))
+			}
+		}
+	}
+
+	ctx.EnsureStatusOption(status.AtlasEnvironmentProjectIDOption(atlasProject.ID()))
+	if dbUser.Status.UserName != "" {
+		ctx.EnsureStatusOption(status.AtlasEnvironmentConnectionSecretOption(
+			connectionsecret.FormatSecretName(environment.Spec.ProjectName, environment.DeploymentName(), dbUser.Status.UserName),
+		))
+	}
+
+	return reportResources(ctx, resources)
+}
+
+// apply creates or updates a resource of the environment, expand sets the fields the environment manages. A resource
+// of the same name which isn't controlled by the environment is never adopted nor changed
+func (r *AtlasEnvironmentReconciler) apply(ctx *workflow.Context, environment *mdbv1.AtlasEnvironment, obj client.Object, expand func()) error {
+	_, err := controllerutil.CreateOrUpdate(ctx.Context, r.Client, obj, func() error {
+		if obj.GetResourceVersion() != "" && !metav1.IsControlledBy(obj, environment) {
+			return errors.New("it already exists and isn't managed by the AtlasEnvironment")
+		}
+		expand()
+
+		return controllerutil.SetControllerReference(environment, obj, r.Scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to apply the %T %s: %w", obj, obj.GetName(), err)
+	}
+
+	return nil
+}
+
+// deleteOwned deletes a resource of the environment, unless it doesn't exist or isn't controlled by the environment
+func (r *AtlasEnvironmentReconciler) deleteOwned(ctx *workflow.Context, environment *mdbv1.AtlasEnvironment, obj client.Object) error {
+	if err := r.Client.Get(ctx.Context, client.ObjectKeyFromObject(obj), obj); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !metav1.IsControlledBy(obj, environment) {
+		return nil
+	}
+
+	return client.IgnoreNotFound(r.Client.Delete(ctx.Context, obj))
+}
+
+// reportResources lists the resources of the environment in the status, the environment is ready once all of them are
+func reportResources(ctx *workflow.Context, resources []environmentResource) workflow.Result {
+	reported := make([]status.EnvironmentResource, 0, len(resources))
+	var pending []string
+	for _, r := range resources {
+		ready := isReady(r.resource)
+		reported = append(reported, status.EnvironmentResource{Kind: r.kind, Name: r.resource.GetName(), Ready: ready})
+		if !ready {
+			pending = append(pending, r.kind)
+		}
+	}
+	ctx.EnsureStatusOption(status.AtlasEnvironmentResourcesOption(reported))
+
+	if len(pending) > 0 {
+		return workflow.InProgress(workflow.EnvironmentResourcesNotReady, fmt.Sprintf("waiting for the %s to be ready", strings.Join(pending, ", ")))
+	}
+
+	return workflow.OK()
+}
+
+func isReady(resource mdbv1.AtlasCustomResource) bool {
+	for _, condition := range resource.GetStatus().GetConditions() {
+		if condition.Type == status.ReadyType {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+func childMeta(environment *mdbv1.AtlasEnvironment) metav1.ObjectMeta {
+	return metav1.ObjectMeta{Name: environment.Name, Namespace: environment.Namespace}
+}
+
+func expandProject(environment *mdbv1.AtlasEnvironment, atlasProject *mdbv1.AtlasProject) {
+	atlasProject.Spec.Name = environment.Spec.ProjectName
+	atlasProject.Spec.ConnectionSecret = environment.Spec.ConnectionSecret
+	atlasProject.Spec.ProjectIPAccessList = environment.Spec.IPAccessList
+	atlasProject.Spec.EgressIPDiscovery = &project.EgressIPDiscovery{Enabled: pointer.GetOrDefault(environment.Spec.EgressIPDiscovery, true)}
+}
+
+func expandBackupPolicy(policy *mdbv1.AtlasBackupPolicy) {
+	policy.Spec.Items = []mdbv1.AtlasBackupPolicyItem{
+		{FrequencyType: "daily", FrequencyInterval: 1, RetentionUnit: "days", RetentionValue: 7},
+	}
+}
+
+func expandBackupSchedule(environment *mdbv1.AtlasEnvironment, schedule *mdbv1.AtlasBackupSchedule) {
+	schedule.Spec.PolicyRef = common.ResourceRefNamespaced{Name: environment.Name, Namespace: environment.Namespace}
+}
+
+func expandDeployment(environment *mdbv1.AtlasEnvironment, deployment *mdbv1.AtlasDeployment, backupEnabled bool) {
+	spec := environment.Spec.Deployment
+	providerName := spec.ProviderName
+	if providerName == "" {
+		providerName = provider.ProviderAWS
+	}
+
+	deployment.Spec.Project = common.ResourceRefNamespaced{Name: environment.Name, Namespace: environment.Namespace}
+	deployment.Spec.BackupScheduleRef = common.ResourceRefNamespaced{}
+	if backupEnabled {
+		deployment.Spec.BackupScheduleRef = common.ResourceRefNamespaced{Name: environment.Name, Namespace: environment.Namespace}
+	}
+	deployment.Spec.DeploymentSpec = &mdbv1.AdvancedDeploymentSpec{
+		Name:                environment.DeploymentName(),
+		ClusterType:         "REPLICASET",
+		BackupEnabled:       pointer.MakePtr(backupEnabled),
+		MongoDBMajorVersion: spec.MongoDBMajorVersion,
+		ReplicationSpecs: []*mdbv1.AdvancedReplicationSpec{
+			{
+				NumShards: 1,
+				ZoneName:  "Zone 1",
+				RegionConfigs: []*mdbv1.AdvancedRegionConfig{
+					{
+						ProviderName: string(providerName),
+						RegionName:   stringOrDefault(spec.RegionName, "US_EAST_1"),
+						Priority:     pointer.MakePtr(7),
+						ElectableSpecs: &mdbv1.Specs{
+							InstanceSize: stringOrDefault(spec.InstanceSize, "M10"),
+							NodeCount:    pointer.MakePtr(3),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandDatabaseUser(environment *mdbv1.AtlasEnvironment, dbUser *mdbv1.AtlasDatabaseUser) {
+	roles := environment.Spec.DatabaseUser.Roles
+	if len(roles) == 0 {
+		roles = []mdbv1.RoleSpec{{RoleName: "readWriteAnyDatabase", DatabaseName: "admin"}}
+	}
+
+	dbUser.Spec.Project = common.ResourceRefNamespaced{Name: environment.Name, Namespace: environment.Namespace}
+	dbUser.Spec.DatabaseName = "admin"
+	dbUser.Spec.Username = environment.Username()
+	dbUser.Spec.PasswordSecret = &common.ResourceRef{Name: environment.Spec.DatabaseUser.PasswordSecret.Name}
+	dbUser.Spec.Roles = roles
+	dbUser.Spec.Scopes = []mdbv1.ScopeSpec{{Name: environment.DeploymentName(), Type: mdbv1.DeploymentScopeType}}
+}
+
+func stringOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+
+	return value
+}
@@ -0,0 +1,171 @@
+package atlasenvironment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestEnsureEnvironment(t *testing.T) {
+	newEnvironment := func() *mdbv1.AtlasEnvironment {
+		return &mdbv1.AtlasEnvironment{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "team", UID: "environment-uid"},
+			Spec: mdbv1.AtlasEnvironmentSpec{
+				ProjectName:  "Team Dev",
+				DatabaseUser: mdbv1.EnvironmentDatabaseUserSpec{PasswordSecret: common.ResourceRef{Name: "dev-password"}},
+			},
+		}
+	}
+	newReconciler := func(t *testing.T, objects ...client.Object) *AtlasEnvironmentReconciler {
+		sch := runtime.NewScheme()
+		require.NoError(t, mdbv1.AddToScheme(sch))
+
+		return &AtlasEnvironmentReconciler{
+			Client: fake.NewClientBuilder().WithScheme(sch).WithObjects(objects...).Build(),
+			Scheme: sch,
+		}
+	}
+	newContext := func(t *testing.T) *workflow.Context {
+		return workflow.NewContext(zaptest.NewLogger(t).Sugar(), []status.Condition{}, context.Background())
+	}
+	key := client.ObjectKey{Namespace: "team", Name: "dev"}
+	ownedMeta := func() metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: "dev", Namespace: "team", OwnerReferences: []metav1.OwnerReference{{
+			APIVersion: mdbv1.GroupVersion.String(),
+			Kind:       "AtlasEnvironment",
+			Name:       "dev",
+			UID:        "environment-uid",
+			Controller: pointer.MakePtr(true),
+		}}}
+	}
+
+	t.Run("should expand the environment with the defaults", func(t *testing.T) {
+		r := newReconciler(t)
+		workflowCtx := newContext(t)
+
+		result := r.ensureEnvironment(workflowCtx, newEnvironment())
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.EnvironmentResourcesNotReady, result.GetReason())
+
+		atlasProject := &mdbv1.AtlasProject{}
+		require.NoError(t, r.Client.Get(context.Background(), key, atlasProject))
+		assert.Equal(t, "Team Dev", atlasProject.Spec.Name)
+		assert.True(t, atlasProject.Spec.EgressIPDiscovery.IsEnabled())
+		assert.Equal(t, "dev", atlasProject.OwnerReferences[0].Name)
+
+		deployment := &mdbv1.AtlasDeployment{}
+		require.NoError(t, r.Client.Get(context.Background(), key, deployment))
+		assert.Equal(t, "dev", deployment.Spec.DeploymentSpec.Name)
+		assert.Equal(t, "dev", deployment.Spec.BackupScheduleRef.Name)
+		regionConfig := deployment.Spec.DeploymentSpec.ReplicationSpecs[0].RegionConfigs[0]
+		assert.Equal(t, "AWS", regionConfig.ProviderName)
+		assert.Equal(t, "US_EAST_1", regionConfig.RegionName)
+		assert.Equal(t, "M10", regionConfig.ElectableSpecs.InstanceSize)
+
+		dbUser := &mdbv1.AtlasDatabaseUser{}
+		require.NoError(t, r.Client.Get(context.Background(), key, dbUser))
+		assert.Equal(t, "dev", dbUser.Spec.Username)
+		assert.Equal(t, []mdbv1.RoleSpec{{RoleName: "readWriteAnyDatabase", DatabaseName: "admin"}}, dbUser.Spec.Roles)
+		assert.Equal(t, []mdbv1.ScopeSpec{{Name: "dev", Type: mdbv1.DeploymentScopeType}}, dbUser.Spec.Scopes)
+
+		policy := &mdbv1.AtlasBackupPolicy{}
+		require.NoError(t, r.Client.Get(context.Background(), key, policy))
+		assert.Equal(t, []mdbv1.AtlasBackupPolicyItem{{FrequencyType: "daily", FrequencyInterval: 1, RetentionUnit: "days", RetentionValue: 7}}, policy.Spec.Items)
+		require.NoError(t, r.Client.Get(context.Background(), key, &mdbv1.AtlasBackupSchedule{}))
+	})
+
+	t.Run("should be ready once all the resources are ready", func(t *testing.T) {
+		ready := status.Common{Conditions: []status.Condition{{Type: status.ReadyType, Status: corev1.ConditionTrue}}}
+		atlasProject := &mdbv1.AtlasProject{ObjectMeta: ownedMeta()}
+		atlasProject.Status.Common = ready
+		atlasProject.Status.ID = "project-id"
+		deployment := &mdbv1.AtlasDeployment{ObjectMeta: ownedMeta()}
+		deployment.Status.Common = ready
+		dbUser := &mdbv1.AtlasDatabaseUser{ObjectMeta: ownedMeta()}
+		dbUser.Status.Common = ready
+		dbUser.Status.UserName = "dev"
+		environment := newEnvironment()
+		environment.Spec.Backup = pointer.MakePtr(false)
+		r := newReconciler(t, atlasProject, deployment, dbUser)
+		workflowCtx := newContext(t)
+
+		result := r.ensureEnvironment(workflowCtx, environment)
+
+		assert.True(t, result.IsOk())
+		environment.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+		assert.Equal(t, "project-id", environment.Status.ProjectID)
+		assert.Equal(t, "team-dev-dev-dev", environment.Status.ConnectionSecret)
+		assert.Equal(
+			t,
+			[]status.EnvironmentResource{
+				{Kind: "AtlasProject", Name: "dev", Ready: true},
+				{Kind: "AtlasDeployment", Name: "dev", Ready: true},
+				{Kind: "AtlasDatabaseUser", Name: "dev", Ready: true},
+			},
+			environment.Status.Resources,
+		)
+	})
+
+	t.Run("should remove the backup resources once backups are disabled", func(t *testing.T) {
+		policy := &mdbv1.AtlasBackupPolicy{ObjectMeta: ownedMeta()}
+		schedule := &mdbv1.AtlasBackupSchedule{ObjectMeta: ownedMeta()}
+		environment := newEnvironment()
+		environment.Spec.Backup = pointer.MakePtr(false)
+		r := newReconciler(t, policy, schedule)
+
+		r.ensureEnvironment(newContext(t), environment)
+
+		assert.True(t, apiErrors.IsNotFound(r.Client.Get(context.Background(), key, &mdbv1.AtlasBackupPolicy{})))
+		assert.True(t, apiErrors.IsNotFound(r.Client.Get(context.Background(), key, &mdbv1.AtlasBackupSchedule{})))
+		deployment := &mdbv1.AtlasDeployment{}
+		require.NoError(t, r.Client.Get(context.Background(), key, deployment))
+		assert.Empty(t, deployment.Spec.BackupScheduleRef.Name)
+		assert.False(t, *deployment.Spec.DeploymentSpec.BackupEnabled)
+	})
+
+	t.Run("should never adopt nor change a resource of the same name", func(t *testing.T) {
+		production := &mdbv1.AtlasDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "team"},
+			Spec:       mdbv1.AtlasDeploymentSpec{DeploymentSpec: &mdbv1.AdvancedDeploymentSpec{Name: "production", ClusterType: "SHARDED"}},
+		}
+		atlasProject := &mdbv1.AtlasProject{ObjectMeta: ownedMeta()}
+		r := newReconciler(t, atlasProject, production)
+
+		result := r.ensureEnvironment(newContext(t), newEnvironment())
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.EnvironmentResourcesNotApplied, result.GetReason())
+		assert.Contains(t, result.GetMessage(), "already exists and isn't managed by the AtlasEnvironment")
+		deployment := &mdbv1.AtlasDeployment{}
+		require.NoError(t, r.Client.Get(context.Background(), key, deployment))
+		assert.Equal(t, "production", deployment.Spec.DeploymentSpec.Name)
+		assert.Empty(t, deployment.OwnerReferences)
+	})
+
+	t.Run("should keep the backup resources it doesn't manage", func(t *testing.T) {
+		policy := &mdbv1.AtlasBackupPolicy{ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "team"}}
+		environment := newEnvironment()
+		environment.Spec.Backup = pointer.MakePtr(false)
+		r := newReconciler(t, policy)
+
+		r.ensureEnvironment(newContext(t), environment)
+
+		assert.NoError(t, r.Client.Get(context.Background(), key, &mdbv1.AtlasBackupPolicy{}))
+	})
+}
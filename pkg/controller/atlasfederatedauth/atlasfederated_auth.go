@@ -4,14 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/google/go-cmp/cmp"
 	"go.mongodb.org/atlas-sdk/v20231115004/admin"
 
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
 
+const federatedAuthReconciler = "federatedAuth"
+
 func (r *AtlasFederatedAuthReconciler) ensureFederatedAuth(service *workflow.Context, fedauth *mdbv1.AtlasFederatedAuth) workflow.Result {
 	// If disabled, skip with no error
 	if !fedauth.Spec.Enabled {
@@ -23,12 +27,13 @@ func (r *AtlasFederatedAuthReconciler) ensureFederatedAuth(service *workflow.Con
 		GetFederationSettings(service.Context, service.OrgID).
 		Execute()
 	if err != nil {
-		return workflow.Terminate(workflow.FederatedAuthNotAvailable, err.Error())
+		return workflow.Terminate(workflow.FederatedAuthNotAvailable, err.Error()).WithCause(federatedAuthReconciler, "getFederationSettings")
 	}
 
 	identityProvider, err := GetIdentityProviderForFederatedSettings(service.Context, service.SdkClient, atlasFedSettings)
 	if err != nil {
-		return workflow.Terminate(workflow.FederatedAuthNotAvailable, err.Error())
+		return workflow.Terminate(workflow.FederatedAuthNotAvailable, err.Error()).
+			WithCause(federatedAuthReconciler, workflow.AtlasOperation(err))
 	}
 
 	// Get current Org config
@@ -36,32 +41,68 @@ func (r *AtlasFederatedAuthReconciler) ensureFederatedAuth(service *workflow.Con
 		GetConnectedOrgConfig(service.Context, atlasFedSettings.GetId(), service.OrgID).
 		Execute()
 	if err != nil {
-		return workflow.Terminate(workflow.FederatedAuthOrgNotConnected, err.Error())
+		return workflow.Terminate(workflow.FederatedAuthOrgNotConnected, err.Error()).WithCause(federatedAuthReconciler, "getConnectedOrgConfig")
 	}
 
 	projectList, err := prepareProjectList(service.Context, service.SdkClient)
 	if err != nil {
-		return workflow.Terminate(workflow.Internal, fmt.Sprintf("Can not list projects for org ID %s. %s", service.OrgID, err.Error()))
+		return workflow.Terminate(workflow.Internal, fmt.Sprintf("Can not list projects for org ID %s. %s", service.OrgID, err.Error())).
+			WithCause(federatedAuthReconciler, workflow.AtlasOperation(err))
 	}
 
 	operatorConf, err := fedauth.Spec.ToAtlas(service.OrgID, identityProvider.GetOktaIdpId(), projectList)
 	if err != nil {
-		return workflow.Terminate(workflow.Internal, fmt.Sprintln("Can not convert Federated Auth spec to Atlas", err.Error()))
+		return workflow.Terminate(workflow.FederatedAuthRoleMappingsInvalid, fmt.Sprintln("Can not convert Federated Auth spec to Atlas", err.Error()))
 	}
 
 	if result := r.ensureIDPSettings(service.Context, atlasFedSettings.GetId(), identityProvider, fedauth, service.SdkClient); !result.IsOk() {
 		return result
 	}
+	service.EnsureStatusOption(status.AtlasFederatedAuthIdentityProviderIDOption(identityProvider.GetId()))
 
+	var managedUsers []status.DataAccessUser
+	if len(fedauth.Spec.DataAccessRoleMappings) > 0 || len(fedauth.Status.DataAccessUsers) > 0 {
+		managedUsers, err = managedDatabaseUsers(service.Context, r.Client)
+		if err != nil {
+			result := workflow.Terminate(workflow.FederatedAuthDataAccessFailed, err.Error()).WithCause(dataAccessReconciler, "")
+			service.SetConditionFromResult(status.FederatedAuthDataAccessReadyType, result)
+			return result
+		}
+	}
+	if result := ensureDataAccess(service, fedauth, orgConfig, projectList, managedUsers); !result.IsOk() {
+		service.SetConditionFromResult(status.FederatedAuthDataAccessReadyType, result)
+		return result
+	}
+	if len(fedauth.Spec.DataAccessRoleMappings) > 0 {
+		service.SetConditionTrue(status.FederatedAuthDataAccessReadyType)
+	} else {
+		service.UnsetCondition(status.FederatedAuthDataAccessReadyType)
+	}
+
+	applied, result := applyConnectedOrgConfig(service.Context, service.SdkClient, federatedAuthReconciler, atlasFedSettings.GetId(), service.OrgID, operatorConf, orgConfig)
+	if applied != nil {
+		service.EnsureStatusOption(status.AtlasFederatedAuthRoleMappingsOption(appliedRoleMappings(applied)))
+	}
+	if !result.IsOk() {
+		return result
+	}
+
+	return r.ensureConnectedOrganizations(service, fedauth, atlasFedSettings.GetId())
+}
+
+// applyConnectedOrgConfig updates the configuration of the connected organization when it differs from the operator
+// one, and returns the configuration applied in Atlas
+func applyConnectedOrgConfig(ctx context.Context, client *admin.APIClient, reconciler, federationSettingsID, orgID string, operatorConf, orgConfig *admin.ConnectedOrgConfig) (*admin.ConnectedOrgConfig, workflow.Result) {
 	if federatedSettingsAreEqual(operatorConf, orgConfig) {
-		return workflow.OK()
+		return orgConfig, workflow.OK()
 	}
 
-	updatedSettings, _, err := service.SdkClient.FederatedAuthenticationApi.
-		UpdateConnectedOrgConfig(service.Context, atlasFedSettings.GetId(), service.OrgID, operatorConf).
+	updatedSettings, _, err := client.FederatedAuthenticationApi.
+		UpdateConnectedOrgConfig(ctx, federationSettingsID, orgID, operatorConf).
 		Execute()
 	if err != nil {
-		return workflow.Terminate(workflow.Internal, fmt.Sprintln("Can not update federation settings", err.Error()))
+		return nil, workflow.Terminate(workflow.Internal, fmt.Sprintln("Can not update federation settings", err.Error())).
+			WithCause(reconciler, "updateConnectedOrgConfig")
 	}
 
 	if updatedSettings.UserConflicts != nil && len(*updatedSettings.UserConflicts) != 0 {
@@ -70,11 +111,40 @@ func (r *AtlasFederatedAuthReconciler) ensureFederatedAuth(service *workflow.Con
 			users = append(users, (*updatedSettings.UserConflicts)[i].EmailAddress)
 		}
 
-		return workflow.Terminate(workflow.FederatedAuthUsersConflict,
-			fmt.Sprintln("The following users are in conflict", users))
+		return updatedSettings, workflow.Terminate(workflow.FederatedAuthUsersConflict,
+			fmt.Sprintln("The following users are in conflict", users)).WithCause(reconciler, "updateConnectedOrgConfig")
 	}
 
-	return workflow.OK()
+	return updatedSettings, workflow.OK()
+}
+
+// appliedRoleMappings returns the role mappings of the connected organization configuration, sorted by IdP group
+func appliedRoleMappings(config *admin.ConnectedOrgConfig) []status.FederatedAuthRoleMapping {
+	if len(config.GetRoleMappings()) == 0 {
+		return nil
+	}
+
+	roleMappings := make([]status.FederatedAuthRoleMapping, 0, len(config.GetRoleMappings()))
+	for _, atlasRoleMapping := range config.GetRoleMappings() {
+		roleMapping := status.FederatedAuthRoleMapping{ExternalGroupName: atlasRoleMapping.ExternalGroupName}
+		for _, roleAssignment := range atlasRoleMapping.GetRoleAssignments() {
+			if roleAssignment.GetGroupId() != "" {
+				roleMapping.ProjectRoles = append(roleMapping.ProjectRoles, status.FederatedAuthProjectRole{
+					ProjectID: roleAssignment.GetGroupId(),
+					Role:      roleAssignment.GetRole(),
+				})
+			} else {
+				roleMapping.OrgRoles = append(roleMapping.OrgRoles, roleAssignment.GetRole())
+			}
+		}
+		roleMappings = append(roleMappings, roleMapping)
+	}
+
+	sort.Slice(roleMappings, func(i, j int) bool {
+		return roleMappings[i].ExternalGroupName < roleMappings[j].ExternalGroupName
+	})
+
+	return roleMappings
 }
 
 func prepareProjectList(ctx context.Context, client *admin.APIClient) (map[string]string, error) {
@@ -84,7 +154,7 @@ func prepareProjectList(ctx context.Context, client *admin.APIClient) (map[strin
 
 	projects, _, err := client.ProjectsApi.ListProjects(ctx).Execute()
 	if err != nil {
-		return nil, err
+		return nil, workflow.NewAtlasOperationError("listProjects", err)
 	}
 
 	result := make(map[string]string, len(projects.GetResults()))
@@ -96,24 +166,35 @@ func prepareProjectList(ctx context.Context, client *admin.APIClient) (map[strin
 }
 
 func (r *AtlasFederatedAuthReconciler) ensureIDPSettings(ctx context.Context, federationSettingsID string, idp *admin.FederationIdentityProvider, fedauth *mdbv1.AtlasFederatedAuth, client *admin.APIClient) workflow.Result {
-	if fedauth.Spec.SSODebugEnabled != nil {
-		if idp.GetSsoDebugEnabled() == *fedauth.Spec.SSODebugEnabled {
-			return workflow.OK()
-		}
+	idpUpdate := admin.IdentityProviderUpdate{
+		DisplayName: idp.DisplayName,
+		IssuerUri:   idp.IssuerUri,
+		SsoUrl:      idp.SsoUrl,
+	}
+	changed := false
 
-		idpUpdate := admin.IdentityProviderUpdate{
-			DisplayName:     idp.DisplayName,
-			IssuerUri:       idp.IssuerUri,
-			SsoUrl:          idp.SsoUrl,
-			SsoDebugEnabled: fedauth.Spec.SSODebugEnabled,
-		}
-		_, _, err := client.FederatedAuthenticationApi.UpdateIdentityProvider(ctx, federationSettingsID, idp.GetId(), &idpUpdate).Execute()
+	if fedauth.Spec.SSODebugEnabled != nil && idp.GetSsoDebugEnabled() != *fedauth.Spec.SSODebugEnabled {
+		idpUpdate.SsoDebugEnabled = fedauth.Spec.SSODebugEnabled
+		changed = true
+	}
+
+	if fedauth.Spec.SAMLIdentityProvider != nil {
+		samlChanged, err := r.samlIdentityProviderUpdate(ctx, fedauth.Namespace, fedauth.Spec.SAMLIdentityProvider, idp, &idpUpdate)
 		if err != nil {
-			return workflow.Terminate(workflow.Internal, err.Error())
+			return workflow.Terminate(workflow.FederatedAuthIdentityProviderInvalid, err.Error()).WithCause(federatedAuthReconciler, "")
 		}
+		changed = changed || samlChanged
+	}
+
+	if !changed {
+		return workflow.OK()
+	}
+
+	_, _, err := client.FederatedAuthenticationApi.UpdateIdentityProvider(ctx, federationSettingsID, idp.GetId(), &idpUpdate).Execute()
+	if err != nil {
+		return workflow.Terminate(workflow.Internal, err.Error()).WithCause(federatedAuthReconciler, "updateIdentityProvider")
 	}
 
-	// TODO: Add more IDP settings
 	return workflow.OK()
 }
 
@@ -126,7 +207,7 @@ func federatedSettingsAreEqual(operator, atlas *admin.ConnectedOrgConfig) bool {
 func GetIdentityProviderForFederatedSettings(ctx context.Context, atlasClient *admin.APIClient, fedSettings *admin.OrgFederationSettings) (*admin.FederationIdentityProvider, error) {
 	identityProviders, _, err := atlasClient.FederatedAuthenticationApi.ListIdentityProviders(ctx, fedSettings.GetId()).Execute()
 	if err != nil {
-		return nil, err
+		return nil, workflow.NewAtlasOperationError("listIdentityProviders", err)
 	}
 
 	for _, identityProvider := range identityProviders.GetResults() {
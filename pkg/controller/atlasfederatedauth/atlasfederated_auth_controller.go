@@ -19,6 +19,7 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/notifier"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/statushandler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/watch"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
@@ -32,6 +33,7 @@ type AtlasFederatedAuthReconciler struct {
 	Scheme                      *runtime.Scheme
 	GlobalPredicates            []predicate.Predicate
 	EventRecorder               record.EventRecorder
+	FailureNotifier             *notifier.Notifier
 	AtlasProvider               atlas.Provider
 	ObjectDeletionProtection    bool
 	SubObjectDeletionProtection bool
@@ -44,7 +46,7 @@ type AtlasFederatedAuthReconciler struct {
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 
-func (r *AtlasFederatedAuthReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *AtlasFederatedAuthReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, _ error) {
 	log := r.Log.With("atlasfederatedauth", req.NamespacedName)
 
 	fedauth := &mdbv1.AtlasFederatedAuth{}
@@ -65,10 +67,26 @@ func (r *AtlasFederatedAuthReconciler) Reconcile(ctx context.Context, req ctrl.R
 		return workflow.OK().ReconcileResult(), nil
 	}
 
+	if customresource.NamespaceReconciliationPaused(ctx, r.Client, fedauth.Namespace, log) {
+		log.Infow(fmt.Sprintf("-> Pausing AtlasFederatedAuth reconciliation as annotation %s=true is set on the namespace", customresource.ReconciliationPausedAnnotation))
+		return customresource.MarkReconciliationPaused(ctx, r.Client, r.EventRecorder, fedauth, log).ReconcileResult(), nil
+	}
+
 	workflowCtx := customresource.MarkReconciliationStarted(r.Client, fedauth, log, ctx)
 	log.Infow("-> Starting AtlasFederatedAuth reconciliation")
 
-	defer statushandler.Update(workflowCtx, r.Client, r.EventRecorder, fedauth)
+	if workflowCtx.Degraded(fedauth) {
+		log.Infow("-> Skipping the reconciliation of the degraded AtlasFederatedAuth, change its spec or set the reapply annotation to retry")
+		return ctrl.Result{}, nil
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			res = workflowCtx.RecoverPanic("AtlasFederatedAuth", p).ReconcileResult()
+		}
+		res = workflowCtx.CapRetries("AtlasFederatedAuth", fedauth, res)
+		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, r.FailureNotifier, fedauth)
+	}()
 
 	resourceVersionIsValid := customresource.ValidateResourceVersion(workflowCtx, fedauth, r.Log)
 	if !resourceVersionIsValid.IsOk() {
@@ -92,9 +110,19 @@ func (r *AtlasFederatedAuthReconciler) Reconcile(ctx context.Context, req ctrl.R
 	workflowCtx.SdkClient = atlasClient
 	workflowCtx.OrgID = orgID
 
+	result = customresource.ValidateAtlasPermissions(
+		workflowCtx,
+		atlas.RequiredRole{Operation: "federated authentication", Roles: []string{atlas.OrgOwnerRole}},
+	)
+	if !result.IsOk() {
+		setCondition(workflowCtx, status.FederatedAuthReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+
 	owner, err := customresource.IsOwner(fedauth, r.ObjectDeletionProtection, customresource.IsResourceManagedByOperator, managedByAtlas(ctx, atlasClient, orgID))
 	if err != nil {
-		result = workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err))
+		result = workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err)).
+			WithCause(federatedAuthReconciler, workflow.AtlasOperation(err))
 		workflowCtx.SetConditionFromResult(status.FederatedAuthReadyType, result)
 		log.Error(result.GetMessage())
 
@@ -123,7 +151,7 @@ func (r *AtlasFederatedAuthReconciler) SetupWithManager(mgr ctrl.Manager) error
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("AtlasFederatedAuth").
 		For(&mdbv1.AtlasFederatedAuth{}, builder.WithPredicates(r.GlobalPredicates...)).
-		Watches(&corev1.Secret{}, watch.NewSecretHandler(r.WatchedResources)).
+		Watches(&corev1.Secret{}, watch.NewSecretHandler(r.WatchedResources), builder.OnlyMetadata).
 		Complete(r)
 }
 
@@ -147,14 +175,14 @@ func managedByAtlas(ctx context.Context, atlasClient *admin.APIClient, orgID str
 
 		atlasFedSettings, _, err := atlasClient.FederatedAuthenticationApi.GetFederationSettings(ctx, orgID).Execute()
 		if err != nil {
-			return false, err
+			return false, workflow.NewAtlasOperationError("getFederationSettings", err)
 		}
 
 		atlasFedAuth, _, err := atlasClient.FederatedAuthenticationApi.
 			GetConnectedOrgConfig(ctx, atlasFedSettings.GetId(), orgID).
 			Execute()
 		if err != nil {
-			return false, err
+			return false, workflow.NewAtlasOperationError("getConnectedOrgConfig", err)
 		}
 
 		projectlist, err := prepareProjectList(ctx, atlasClient)
@@ -87,7 +87,7 @@ func TestReconcile(t *testing.T) {
 
 		logger := zaptest.NewLogger(t).Sugar()
 		fedAuthAPI := atlasmock.NewFederatedAuthenticationApiMock(t)
-		fedAuthAPI.EXPECT().GetFederationSettings(context.Background(), orgID).
+		fedAuthAPI.EXPECT().GetFederationSettings(mock.Anything, orgID).
 			Return(admin.GetFederationSettingsApiRequest{ApiService: fedAuthAPI})
 		fedAuthAPI.EXPECT().GetFederationSettingsExecute(mock.Anything).
 			Return(
@@ -101,7 +101,7 @@ func TestReconcile(t *testing.T) {
 				&http.Response{},
 				nil,
 			)
-		fedAuthAPI.EXPECT().ListIdentityProviders(context.Background(), fedSettingsID).
+		fedAuthAPI.EXPECT().ListIdentityProviders(mock.Anything, fedSettingsID).
 			Return(admin.ListIdentityProvidersApiRequest{ApiService: fedAuthAPI})
 		fedAuthAPI.EXPECT().ListIdentityProvidersExecute(mock.Anything).
 			Return(
@@ -117,7 +117,7 @@ func TestReconcile(t *testing.T) {
 				&http.Response{},
 				nil,
 			)
-		fedAuthAPI.EXPECT().GetConnectedOrgConfig(context.Background(), fedSettingsID, orgID).
+		fedAuthAPI.EXPECT().GetConnectedOrgConfig(mock.Anything, fedSettingsID, orgID).
 			Return(admin.GetConnectedOrgConfigApiRequest{ApiService: fedAuthAPI})
 		fedAuthAPI.EXPECT().GetConnectedOrgConfigExecute(mock.Anything).
 			Return(
@@ -132,7 +132,7 @@ func TestReconcile(t *testing.T) {
 				nil,
 			)
 		groupAPI := atlasmock.NewProjectsApiMock(t)
-		groupAPI.EXPECT().ListProjects(context.Background()).
+		groupAPI.EXPECT().ListProjects(mock.Anything).
 			Return(admin.ListProjectsApiRequest{ApiService: groupAPI})
 		groupAPI.EXPECT().ListProjectsExecute(mock.Anything).
 			Twice().
@@ -149,11 +149,27 @@ func TestReconcile(t *testing.T) {
 				&http.Response{},
 				nil,
 			)
+		rootAPI := atlasmock.NewRootApiMock(t)
+		rootAPI.EXPECT().GetSystemStatus(mock.Anything).
+			Return(admin.GetSystemStatusApiRequest{ApiService: rootAPI})
+		rootAPI.EXPECT().GetSystemStatusExecute(mock.Anything).
+			Return(
+				&admin.SystemStatus{
+					ApiKey: admin.ApiKey{
+						Roles: &[]admin.CloudAccessRoleAssignment{
+							{OrgId: pointer.MakePtr(orgID), RoleName: pointer.MakePtr("ORG_OWNER")},
+						},
+					},
+				},
+				&http.Response{},
+				nil,
+			)
 		atlasProvider := atlasmock.TestProvider{
 			SdkClientFunc: func(secretRef *client.ObjectKey, log *zap.SugaredLogger) (*admin.APIClient, string, error) {
 				return &admin.APIClient{
 					ProjectsApi:                groupAPI,
 					FederatedAuthenticationApi: fedAuthAPI,
+					RootApi:                    rootAPI,
 				}, orgID, nil
 			},
 			IsCloudGovFunc: func() bool {
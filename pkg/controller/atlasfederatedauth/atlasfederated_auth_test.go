@@ -0,0 +1,56 @@
+package atlasfederatedauth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestAppliedRoleMappings(t *testing.T) {
+	t.Run("should report nothing without role mappings", func(t *testing.T) {
+		assert.Nil(t, appliedRoleMappings(&admin.ConnectedOrgConfig{}))
+	})
+
+	t.Run("should split the organization and project roles of every group", func(t *testing.T) {
+		config := &admin.ConnectedOrgConfig{
+			RoleMappings: &[]admin.AuthFederationRoleMapping{
+				{
+					ExternalGroupName: "developers",
+					RoleAssignments: &[]admin.RoleAssignment{
+						{GroupId: pointer.MakePtr("project-id"), Role: pointer.MakePtr("GROUP_READ_ONLY")},
+					},
+				},
+				{
+					ExternalGroupName: "admins",
+					RoleAssignments: &[]admin.RoleAssignment{
+						{OrgId: pointer.MakePtr("org-id"), Role: pointer.MakePtr("ORG_OWNER")},
+						{GroupId: pointer.MakePtr("project-id"), Role: pointer.MakePtr("GROUP_OWNER")},
+					},
+				},
+			},
+		}
+
+		assert.Equal(t, []status.FederatedAuthRoleMapping{
+			{
+				ExternalGroupName: "admins",
+				OrgRoles:          []string{"ORG_OWNER"},
+				ProjectRoles:      []status.FederatedAuthProjectRole{{ProjectID: "project-id", Role: "GROUP_OWNER"}},
+			},
+			{
+				ExternalGroupName: "developers",
+				ProjectRoles:      []status.FederatedAuthProjectRole{{ProjectID: "project-id", Role: "GROUP_READ_ONLY"}},
+			},
+		}, appliedRoleMappings(config))
+	})
+}
+
+func newContext(t *testing.T) *workflow.Context {
+	return workflow.NewContext(zaptest.NewLogger(t).Sugar(), []status.Condition{}, context.Background())
+}
@@ -0,0 +1,100 @@
+package atlasfederatedauth
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const connectedOrganizationsReconciler = "connectedOrganizations"
+
+// ensureConnectedOrganizations applies the configuration of the other organizations connected to the federation,
+// each one with its own API credentials. The organizations must belong to the federation of the main organization
+func (r *AtlasFederatedAuthReconciler) ensureConnectedOrganizations(service *workflow.Context, fedauth *mdbv1.AtlasFederatedAuth, federationSettingsID string) workflow.Result {
+	if len(fedauth.Spec.ConnectedOrganizations) == 0 {
+		service.EnsureStatusOption(status.AtlasFederatedAuthConnectedOrganizationsOption(nil))
+		return workflow.OK()
+	}
+
+	organizations := make([]status.ConnectedOrganization, 0, len(fedauth.Spec.ConnectedOrganizations))
+	defer func() {
+		service.EnsureStatusOption(status.AtlasFederatedAuthConnectedOrganizationsOption(organizations))
+	}()
+
+	orgIDs := map[string]struct{}{service.OrgID: {}}
+	for i := range fedauth.Spec.ConnectedOrganizations {
+		connected := &fedauth.Spec.ConnectedOrganizations[i]
+		secretKey := connected.ConnectionSecretObjectKey(fedauth.Namespace)
+		atlasClient, orgID, err := r.AtlasProvider.SdkClient(service.Context, &secretKey, service.Log)
+		if err != nil {
+			return workflow.Terminate(workflow.AtlasAPIAccessNotConfigured, err.Error()).WithCause(connectedOrganizationsReconciler, "")
+		}
+
+		if _, ok := orgIDs[orgID]; ok {
+			return workflow.Terminate(workflow.FederatedAuthRoleMappingsInvalid,
+				fmt.Sprintf("the organization %s of the connection secret %s is configured more than once", orgID, secretKey)).
+				WithCause(connectedOrganizationsReconciler, "")
+		}
+		orgIDs[orgID] = struct{}{}
+
+		applied, result := ensureConnectedOrganization(service.Context, atlasClient, federationSettingsID, orgID, connected)
+		if applied != nil {
+			organizations = append(organizations, status.ConnectedOrganization{OrgID: orgID, RoleMappings: appliedRoleMappings(applied)})
+		}
+		if !result.IsOk() {
+			return result
+		}
+	}
+
+	return workflow.OK()
+}
+
+// ensureConnectedOrganization applies the configuration of an organization connected to the federation and returns
+// the configuration applied in Atlas
+func ensureConnectedOrganization(ctx context.Context, atlasClient *admin.APIClient, federationSettingsID, orgID string, connected *mdbv1.ConnectedOrganization) (*admin.ConnectedOrgConfig, workflow.Result) {
+	atlasFedSettings, _, err := atlasClient.FederatedAuthenticationApi.
+		GetFederationSettings(ctx, orgID).
+		Execute()
+	if err != nil {
+		return nil, workflow.Terminate(workflow.FederatedAuthNotAvailable, err.Error()).WithCause(connectedOrganizationsReconciler, "getFederationSettings")
+	}
+
+	if atlasFedSettings.GetId() != federationSettingsID {
+		return nil, workflow.Terminate(workflow.FederatedAuthOrgNotConnected,
+			fmt.Sprintf("the organization %s belongs to the federation %s instead of %s", orgID, atlasFedSettings.GetId(), federationSettingsID)).
+			WithCause(connectedOrganizationsReconciler, "")
+	}
+
+	identityProvider, err := GetIdentityProviderForFederatedSettings(ctx, atlasClient, atlasFedSettings)
+	if err != nil {
+		return nil, workflow.Terminate(workflow.FederatedAuthNotAvailable, err.Error()).
+			WithCause(connectedOrganizationsReconciler, workflow.AtlasOperation(err))
+	}
+
+	orgConfig, _, err := atlasClient.FederatedAuthenticationApi.
+		GetConnectedOrgConfig(ctx, federationSettingsID, orgID).
+		Execute()
+	if err != nil {
+		return nil, workflow.Terminate(workflow.FederatedAuthOrgNotConnected, err.Error()).WithCause(connectedOrganizationsReconciler, "getConnectedOrgConfig")
+	}
+
+	projectList, err := prepareProjectList(ctx, atlasClient)
+	if err != nil {
+		return nil, workflow.Terminate(workflow.Internal, fmt.Sprintf("Can not list projects for org ID %s. %s", orgID, err.Error())).
+			WithCause(connectedOrganizationsReconciler, workflow.AtlasOperation(err))
+	}
+
+	operatorConf, err := connected.ToAtlas(orgID, identityProvider.GetOktaIdpId(), projectList)
+	if err != nil {
+		return nil, workflow.Terminate(workflow.FederatedAuthRoleMappingsInvalid,
+			fmt.Sprintf("Can not convert the configuration of the connected organization %s to Atlas: %s", orgID, err)).
+			WithCause(connectedOrganizationsReconciler, "")
+	}
+
+	return applyConnectedOrgConfig(ctx, atlasClient, connectedOrganizationsReconciler, federationSettingsID, orgID, operatorConf, orgConfig)
+}
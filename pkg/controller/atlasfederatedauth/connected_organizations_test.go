@@ -0,0 +1,146 @@
+package atlasfederatedauth
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	"go.uber.org/zap"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func connectedOrgClient(t *testing.T, federationSettingsID string, orgConfig *admin.ConnectedOrgConfig) (*admin.APIClient, *atlasmock.FederatedAuthenticationApiMock) {
+	fedAuthAPI := atlasmock.NewFederatedAuthenticationApiMock(t)
+	fedAuthAPI.EXPECT().GetFederationSettings(mock.Anything, "other-org-id").
+		Return(admin.GetFederationSettingsApiRequest{ApiService: fedAuthAPI})
+	fedAuthAPI.EXPECT().GetFederationSettingsExecute(mock.Anything).
+		Return(&admin.OrgFederationSettings{Id: pointer.MakePtr(federationSettingsID), IdentityProviderId: pointer.MakePtr("idp-id")}, &http.Response{}, nil)
+	if orgConfig == nil {
+		return &admin.APIClient{FederatedAuthenticationApi: fedAuthAPI}, fedAuthAPI
+	}
+
+	fedAuthAPI.EXPECT().ListIdentityProviders(mock.Anything, federationSettingsID).
+		Return(admin.ListIdentityProvidersApiRequest{ApiService: fedAuthAPI})
+	fedAuthAPI.EXPECT().ListIdentityProvidersExecute(mock.Anything).
+		Return(&admin.PaginatedFederationIdentityProvider{Results: &[]admin.FederationIdentityProvider{{OktaIdpId: "idp-id"}}}, &http.Response{}, nil)
+	fedAuthAPI.EXPECT().GetConnectedOrgConfig(mock.Anything, federationSettingsID, "other-org-id").
+		Return(admin.GetConnectedOrgConfigApiRequest{ApiService: fedAuthAPI})
+	fedAuthAPI.EXPECT().GetConnectedOrgConfigExecute(mock.Anything).
+		Return(orgConfig, &http.Response{}, nil)
+
+	projectsAPI := atlasmock.NewProjectsApiMock(t)
+	projectsAPI.EXPECT().ListProjects(mock.Anything).
+		Return(admin.ListProjectsApiRequest{ApiService: projectsAPI})
+	projectsAPI.EXPECT().ListProjectsExecute(mock.Anything).
+		Return(&admin.PaginatedAtlasGroup{Results: &[]admin.Group{{Id: pointer.MakePtr("project-id"), Name: "project"}}}, &http.Response{}, nil)
+
+	return &admin.APIClient{FederatedAuthenticationApi: fedAuthAPI, ProjectsApi: projectsAPI}, fedAuthAPI
+}
+
+func newConnectedOrgReconciler(atlasClient *admin.APIClient) *AtlasFederatedAuthReconciler {
+	return &AtlasFederatedAuthReconciler{
+		AtlasProvider: &atlasmock.TestProvider{
+			SdkClientFunc: func(secretRef *client.ObjectKey, log *zap.SugaredLogger) (*admin.APIClient, string, error) {
+				return atlasClient, "other-org-id", nil
+			},
+		},
+	}
+}
+
+func newConnectedOrgFedAuth() *mdbv1.AtlasFederatedAuth {
+	return &mdbv1.AtlasFederatedAuth{
+		Spec: mdbv1.AtlasFederatedAuthSpec{
+			ConnectedOrganizations: []mdbv1.ConnectedOrganization{
+				{
+					ConnectionSecretRef: common.ResourceRefNamespaced{Name: "other-org-secret"},
+					RoleMappings: []mdbv1.RoleMapping{
+						{
+							ExternalGroupName: "admins",
+							RoleAssignments:   []mdbv1.RoleAssignment{{Role: "ORG_OWNER"}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func connectedOrganizationsStatus(service *workflow.Context) []status.ConnectedOrganization {
+	fedAuthStatus := status.AtlasFederatedAuthStatus{}
+	for _, option := range service.StatusOptions() {
+		option.(status.AtlasFederatedAuthStatusOption)(&fedAuthStatus)
+	}
+
+	return fedAuthStatus.ConnectedOrganizations
+}
+
+func TestEnsureConnectedOrganizations(t *testing.T) {
+	newService := func(t *testing.T) *workflow.Context {
+		service := newContext(t)
+		service.OrgID = "org-id"
+
+		return service
+	}
+
+	t.Run("should update the configuration of a connected organization", func(t *testing.T) {
+		atlasClient, fedAuthAPI := connectedOrgClient(t, "fed-id", &admin.ConnectedOrgConfig{OrgId: "other-org-id", IdentityProviderId: "idp-id"})
+		fedAuthAPI.EXPECT().UpdateConnectedOrgConfig(mock.Anything, "fed-id", "other-org-id", mock.Anything).
+			RunAndReturn(func(_ context.Context, _, _ string, config *admin.ConnectedOrgConfig) admin.UpdateConnectedOrgConfigApiRequest {
+				assert.Equal(t, "other-org-id", config.GetRoleMappings()[0].GetRoleAssignments()[0].GetOrgId())
+				return admin.UpdateConnectedOrgConfigApiRequest{ApiService: fedAuthAPI}
+			})
+		fedAuthAPI.EXPECT().UpdateConnectedOrgConfigExecute(mock.Anything).
+			Return(&admin.ConnectedOrgConfig{
+				OrgId: "other-org-id",
+				RoleMappings: &[]admin.AuthFederationRoleMapping{
+					{
+						ExternalGroupName: "admins",
+						RoleAssignments:   &[]admin.RoleAssignment{{OrgId: pointer.MakePtr("other-org-id"), Role: pointer.MakePtr("ORG_OWNER")}},
+					},
+				},
+			}, &http.Response{}, nil)
+		service := newService(t)
+
+		result := newConnectedOrgReconciler(atlasClient).ensureConnectedOrganizations(service, newConnectedOrgFedAuth(), "fed-id")
+
+		require.True(t, result.IsOk(), result.GetMessage())
+		assert.Equal(t, []status.ConnectedOrganization{
+			{
+				OrgID:        "other-org-id",
+				RoleMappings: []status.FederatedAuthRoleMapping{{ExternalGroupName: "admins", OrgRoles: []string{"ORG_OWNER"}}},
+			},
+		}, connectedOrganizationsStatus(service))
+	})
+
+	t.Run("should reject an organization of another federation", func(t *testing.T) {
+		atlasClient, _ := connectedOrgClient(t, "other-fed-id", nil)
+		service := newService(t)
+
+		result := newConnectedOrgReconciler(atlasClient).ensureConnectedOrganizations(service, newConnectedOrgFedAuth(), "fed-id")
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.FederatedAuthOrgNotConnected, result.GetReason())
+		assert.Empty(t, connectedOrganizationsStatus(service))
+	})
+
+	t.Run("should reject the organization of the main connection secret", func(t *testing.T) {
+		service := newService(t)
+		service.OrgID = "other-org-id"
+
+		result := newConnectedOrgReconciler(nil).ensureConnectedOrganizations(service, newConnectedOrgFedAuth(), "fed-id")
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.FederatedAuthRoleMappingsInvalid, result.GetReason())
+	})
+}
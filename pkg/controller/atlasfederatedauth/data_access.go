@@ -0,0 +1,221 @@
+package atlasfederatedauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	dataAccessReconciler = "federatedAuthDataAccess"
+
+	oidcAuthTypeIDPGroup = "IDP_GROUP"
+	// OIDC database users always authenticate against the admin database
+	oidcDatabaseName = "admin"
+)
+
+// ensureDataAccess maintains an OIDC database user of type IDP_GROUP for every group and project of the data access
+// role mappings, and removes the users it maintained for the mappings which are gone. The users managed by
+// AtlasDatabaseUser resources are left untouched
+func ensureDataAccess(ctx *workflow.Context, fedauth *mdbv1.AtlasFederatedAuth, orgConfig *admin.ConnectedOrgConfig, projectNameToID map[string]string, managedElsewhere []status.DataAccessUser) workflow.Result {
+	var desired []admin.CloudDatabaseUser
+	if len(fedauth.Spec.DataAccessRoleMappings) > 0 {
+		idpID, err := dataAccessIdentityProviderID(orgConfig)
+		if err != nil {
+			return workflow.Terminate(workflow.FederatedAuthDataAccessFailed, err.Error()).WithCause(dataAccessReconciler, "")
+		}
+
+		desired, err = dataAccessUsers(fedauth.Spec.DataAccessRoleMappings, idpID, projectNameToID)
+		if err != nil {
+			return workflow.Terminate(workflow.FederatedAuthDataAccessFailed, err.Error()).WithCause(dataAccessReconciler, "")
+		}
+	}
+
+	var errs []error
+	maintained := make([]status.DataAccessUser, 0, len(desired))
+	for i := range desired {
+		user := status.DataAccessUser{ProjectID: desired[i].GroupId, Username: desired[i].Username}
+		if slices.Contains(managedElsewhere, user) {
+			ctx.Log.Warnf("the database user %s of the project %s is managed by an AtlasDatabaseUser resource, skipping it", user.Username, user.ProjectID)
+			continue
+		}
+
+		if err := ensureDatabaseUser(ctx, &desired[i]); err != nil {
+			errs = append(errs, err)
+		}
+		maintained = append(maintained, user)
+	}
+
+	for _, user := range fedauth.Status.DataAccessUsers {
+		if slices.Contains(maintained, user) || slices.Contains(managedElsewhere, user) {
+			continue
+		}
+
+		_, resp, err := ctx.SdkClient.DatabaseUsersApi.DeleteDatabaseUser(ctx.Context, user.ProjectID, oidcDatabaseName, user.Username).Execute()
+		if err != nil && !isNotFound(resp) {
+			errs = append(errs, workflow.NewAtlasOperationError("deleteDatabaseUser",
+				fmt.Errorf("failed to delete the database user %s of the project %s: %w", user.Username, user.ProjectID, err)))
+			maintained = append(maintained, user)
+		}
+	}
+
+	ctx.EnsureStatusOption(status.AtlasFederatedAuthDataAccessUsersOption(maintained))
+	if err := errors.Join(errs...); err != nil {
+		return workflow.Terminate(workflow.FederatedAuthDataAccessFailed, err.Error()).
+			WithCause(dataAccessReconciler, workflow.AtlasOperation(err))
+	}
+
+	return workflow.OK()
+}
+
+// dataAccessIdentityProviderID returns the ID of the OIDC identity provider enabled for data access in the organization
+func dataAccessIdentityProviderID(orgConfig *admin.ConnectedOrgConfig) (string, error) {
+	ids := orgConfig.GetDataAccessIdentityProviderIds()
+	switch len(ids) {
+	case 0:
+		return "", errors.New("no OIDC identity provider is enabled for data access in the organization")
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("the data access role mappings need a single OIDC identity provider enabled for data access in the organization, found %d", len(ids))
+	}
+}
+
+// managedDatabaseUsers returns the OIDC database users of type IDP_GROUP which AtlasDatabaseUser resources manage
+func managedDatabaseUsers(ctx context.Context, k8sClient client.Client) ([]status.DataAccessUser, error) {
+	dbUsers := &mdbv1.AtlasDatabaseUserList{}
+	if err := k8sClient.List(ctx, dbUsers); err != nil {
+		return nil, fmt.Errorf("failed to list the AtlasDatabaseUser resources: %w", err)
+	}
+
+	var users []status.DataAccessUser
+	for i := range dbUsers.Items {
+		dbUser := &dbUsers.Items[i]
+		if dbUser.Spec.OIDCAuthType != oidcAuthTypeIDPGroup {
+			continue
+		}
+
+		project := &mdbv1.AtlasProject{}
+		if err := k8sClient.Get(ctx, dbUser.AtlasProjectObjectKey(), project); err != nil {
+			if apiErrors.IsNotFound(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("failed to retrieve the project of the AtlasDatabaseUser %s: %w", dbUser.Name, err)
+		}
+		if project.ID() == "" {
+			continue
+		}
+
+		users = append(users, status.DataAccessUser{ProjectID: project.ID(), Username: dbUser.AtlasUsername()})
+	}
+
+	return users, nil
+}
+
+// dataAccessUsers returns the OIDC database users of the mappings, named after the identity provider and the group
+func dataAccessUsers(mappings []mdbv1.DataAccessRoleMapping, idpID string, projectNameToID map[string]string) ([]admin.CloudDatabaseUser, error) {
+	var errs []error
+	seen := map[status.DataAccessUser]struct{}{}
+	users := make([]admin.CloudDatabaseUser, 0, len(mappings))
+	for i := range mappings {
+		mapping := &mappings[i]
+		roles := make([]admin.DatabaseUserRole, 0, len(mapping.Roles))
+		for _, role := range mapping.Roles {
+			atlasRole := admin.DatabaseUserRole{DatabaseName: role.DatabaseName, RoleName: role.RoleName}
+			if role.CollectionName != "" {
+				atlasRole.SetCollectionName(role.CollectionName)
+			}
+			roles = append(roles, atlasRole)
+		}
+
+		scopes := make([]admin.UserScope, 0, len(mapping.Scopes))
+		for _, scope := range mapping.Scopes {
+			scopes = append(scopes, admin.UserScope{Name: scope.Name, Type: string(scope.Type)})
+		}
+
+		for _, projectName := range mapping.ProjectNames {
+			projectID, ok := projectNameToID[projectName]
+			if !ok {
+				errs = append(errs, fmt.Errorf("project name '%s' doesn't exists in the organization", projectName))
+				continue
+			}
+
+			username := fmt.Sprintf("%s/%s", idpID, mapping.ExternalGroupName)
+			key := status.DataAccessUser{ProjectID: projectID, Username: username}
+			if _, ok := seen[key]; ok {
+				errs = append(errs, fmt.Errorf("the external group %s is mapped to the project %s more than once", mapping.ExternalGroupName, projectName))
+				continue
+			}
+			seen[key] = struct{}{}
+
+			user := admin.CloudDatabaseUser{
+				DatabaseName: oidcDatabaseName,
+				GroupId:      projectID,
+				Username:     username,
+				OidcAuthType: admin.PtrString(oidcAuthTypeIDPGroup),
+				Roles:        &roles,
+			}
+			if len(scopes) > 0 {
+				user.SetScopes(scopes)
+			}
+			users = append(users, user)
+		}
+	}
+
+	return users, errors.Join(errs...)
+}
+
+func ensureDatabaseUser(ctx *workflow.Context, desired *admin.CloudDatabaseUser) error {
+	current, resp, err := ctx.SdkClient.DatabaseUsersApi.
+		GetDatabaseUser(ctx.Context, desired.GroupId, oidcDatabaseName, desired.Username).
+		Execute()
+	if err != nil {
+		if !isNotFound(resp) {
+			return workflow.NewAtlasOperationError("getDatabaseUser",
+				fmt.Errorf("failed to retrieve the database user %s of the project %s: %w", desired.Username, desired.GroupId, err))
+		}
+
+		if _, _, err = ctx.SdkClient.DatabaseUsersApi.CreateDatabaseUser(ctx.Context, desired.GroupId, desired).Execute(); err != nil {
+			return workflow.NewAtlasOperationError("createDatabaseUser",
+				fmt.Errorf("failed to create the database user %s in the project %s: %w", desired.Username, desired.GroupId, err))
+		}
+
+		return nil
+	}
+
+	if sameDatabaseAccess(current, desired) {
+		return nil
+	}
+
+	_, _, err = ctx.SdkClient.DatabaseUsersApi.
+		UpdateDatabaseUser(ctx.Context, desired.GroupId, oidcDatabaseName, desired.Username, desired).
+		Execute()
+	if err != nil {
+		return workflow.NewAtlasOperationError("updateDatabaseUser",
+			fmt.Errorf("failed to update the database user %s of the project %s: %w", desired.Username, desired.GroupId, err))
+	}
+
+	return nil
+}
+
+func sameDatabaseAccess(current, desired *admin.CloudDatabaseUser) bool {
+	return cmp.Equal(current.GetRoles(), desired.GetRoles(), cmpopts.EquateEmpty()) &&
+		cmp.Equal(current.GetScopes(), desired.GetScopes(), cmpopts.EquateEmpty())
+}
+
+func isNotFound(resp *http.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,165 @@
+package atlasfederatedauth
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func newDataAccessContext(t *testing.T, usersAPI admin.DatabaseUsersApi) *workflow.Context {
+	ctx := newContext(t)
+	ctx.SdkClient = &admin.APIClient{DatabaseUsersApi: usersAPI}
+
+	return ctx
+}
+
+func newDataAccessFedAuth(mappings ...mdbv1.DataAccessRoleMapping) *mdbv1.AtlasFederatedAuth {
+	return &mdbv1.AtlasFederatedAuth{Spec: mdbv1.AtlasFederatedAuthSpec{DataAccessRoleMappings: mappings}}
+}
+
+func dataAccessOrgConfig(idpIDs ...string) *admin.ConnectedOrgConfig {
+	return &admin.ConnectedOrgConfig{DataAccessIdentityProviderIds: &idpIDs}
+}
+
+func TestEnsureDataAccess(t *testing.T) {
+	projects := map[string]string{"project": "project-id"}
+	mapping := mdbv1.DataAccessRoleMapping{
+		ExternalGroupName: "dbas",
+		ProjectNames:      []string{"project"},
+		Roles:             []mdbv1.RoleSpec{{RoleName: "readWrite", DatabaseName: "app"}},
+	}
+
+	t.Run("should create the OIDC database user of a group", func(t *testing.T) {
+		usersAPI := atlasmock.NewDatabaseUsersApiMock(t)
+		usersAPI.EXPECT().GetDatabaseUser(mock.Anything, "project-id", "admin", "idp-id/dbas").
+			Return(admin.GetDatabaseUserApiRequest{ApiService: usersAPI})
+		usersAPI.EXPECT().GetDatabaseUserExecute(mock.Anything).Return(nil, &http.Response{StatusCode: http.StatusNotFound}, assert.AnError)
+		usersAPI.EXPECT().CreateDatabaseUser(mock.Anything, "project-id", &admin.CloudDatabaseUser{
+			DatabaseName: "admin",
+			GroupId:      "project-id",
+			Username:     "idp-id/dbas",
+			OidcAuthType: admin.PtrString("IDP_GROUP"),
+			Roles:        &[]admin.DatabaseUserRole{{RoleName: "readWrite", DatabaseName: "app"}},
+		}).Return(admin.CreateDatabaseUserApiRequest{ApiService: usersAPI})
+		usersAPI.EXPECT().CreateDatabaseUserExecute(mock.Anything).Return(nil, nil, nil)
+		ctx := newDataAccessContext(t, usersAPI)
+		fedauth := newDataAccessFedAuth(mapping)
+
+		result := ensureDataAccess(ctx, fedauth, dataAccessOrgConfig("idp-id"), projects, nil)
+
+		require.True(t, result.IsOk())
+		fedauth.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+		assert.Equal(t, []status.DataAccessUser{{ProjectID: "project-id", Username: "idp-id/dbas"}}, fedauth.Status.DataAccessUsers)
+	})
+
+	t.Run("should update the roles of an existing OIDC database user", func(t *testing.T) {
+		usersAPI := atlasmock.NewDatabaseUsersApiMock(t)
+		usersAPI.EXPECT().GetDatabaseUser(mock.Anything, "project-id", "admin", "idp-id/dbas").
+			Return(admin.GetDatabaseUserApiRequest{ApiService: usersAPI})
+		usersAPI.EXPECT().GetDatabaseUserExecute(mock.Anything).Return(&admin.CloudDatabaseUser{
+			Roles: &[]admin.DatabaseUserRole{{RoleName: "read", DatabaseName: "app"}},
+		}, nil, nil)
+		usersAPI.EXPECT().UpdateDatabaseUser(mock.Anything, "project-id", "admin", "idp-id/dbas", mock.Anything).
+			Return(admin.UpdateDatabaseUserApiRequest{ApiService: usersAPI})
+		usersAPI.EXPECT().UpdateDatabaseUserExecute(mock.Anything).Return(nil, nil, nil)
+
+		result := ensureDataAccess(newDataAccessContext(t, usersAPI), newDataAccessFedAuth(mapping), dataAccessOrgConfig("idp-id"), projects, nil)
+
+		require.True(t, result.IsOk())
+	})
+
+	t.Run("should delete the OIDC database users of removed mappings", func(t *testing.T) {
+		usersAPI := atlasmock.NewDatabaseUsersApiMock(t)
+		usersAPI.EXPECT().DeleteDatabaseUser(mock.Anything, "project-id", "admin", "idp-id/old").
+			Return(admin.DeleteDatabaseUserApiRequest{ApiService: usersAPI})
+		usersAPI.EXPECT().DeleteDatabaseUserExecute(mock.Anything).Return(nil, nil, nil)
+		ctx := newDataAccessContext(t, usersAPI)
+		fedauth := newDataAccessFedAuth()
+		fedauth.Status.DataAccessUsers = []status.DataAccessUser{{ProjectID: "project-id", Username: "idp-id/old"}}
+
+		result := ensureDataAccess(ctx, fedauth, dataAccessOrgConfig(), projects, nil)
+
+		require.True(t, result.IsOk())
+		fedauth.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+		assert.Empty(t, fedauth.Status.DataAccessUsers)
+	})
+
+	t.Run("should fail without an OIDC identity provider for data access", func(t *testing.T) {
+		result := ensureDataAccess(newDataAccessContext(t, nil), newDataAccessFedAuth(mapping), dataAccessOrgConfig(), projects, nil)
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.FederatedAuthDataAccessFailed, result.GetReason())
+	})
+
+	t.Run("should fail for a project missing from the organization", func(t *testing.T) {
+		mapping := mapping
+		mapping.ProjectNames = []string{"missing"}
+
+		result := ensureDataAccess(newDataAccessContext(t, nil), newDataAccessFedAuth(mapping), dataAccessOrgConfig("idp-id"), projects, nil)
+
+		assert.False(t, result.IsOk())
+	})
+	t.Run("should skip the OIDC database users managed by an AtlasDatabaseUser", func(t *testing.T) {
+		ctx := newDataAccessContext(t, atlasmock.NewDatabaseUsersApiMock(t))
+		fedauth := newDataAccessFedAuth(mapping)
+		fedauth.Status.DataAccessUsers = []status.DataAccessUser{{ProjectID: "project-id", Username: "idp-id/dbas"}}
+		managed := []status.DataAccessUser{{ProjectID: "project-id", Username: "idp-id/dbas"}}
+
+		result := ensureDataAccess(ctx, fedauth, dataAccessOrgConfig("idp-id"), projects, managed)
+
+		require.True(t, result.IsOk())
+		fedauth.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+		assert.Empty(t, fedauth.Status.DataAccessUsers)
+	})
+
+	t.Run("should fail for a group mapped twice to the same project", func(t *testing.T) {
+		result := ensureDataAccess(newDataAccessContext(t, nil), newDataAccessFedAuth(mapping, mapping), dataAccessOrgConfig("idp-id"), projects, nil)
+
+		assert.False(t, result.IsOk())
+		assert.Contains(t, result.GetMessage(), "the external group dbas is mapped to the project project more than once")
+	})
+}
+
+func TestManagedDatabaseUsers(t *testing.T) {
+	sch := runtime.NewScheme()
+	require.NoError(t, mdbv1.AddToScheme(sch))
+	project := &mdbv1.AtlasProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "project", Namespace: "default"},
+		Status:     status.AtlasProjectStatus{ID: "project-id"},
+	}
+	groupUser := &mdbv1.AtlasDatabaseUser{
+		ObjectMeta: metav1.ObjectMeta{Name: "group-user", Namespace: "default"},
+		Spec: mdbv1.AtlasDatabaseUserSpec{
+			Project:      common.ResourceRefNamespaced{Name: "project"},
+			Username:     "idp-id/dbas",
+			OIDCAuthType: "IDP_GROUP",
+		},
+	}
+	passwordUser := &mdbv1.AtlasDatabaseUser{
+		ObjectMeta: metav1.ObjectMeta{Name: "password-user", Namespace: "default"},
+		Spec: mdbv1.AtlasDatabaseUserSpec{
+			Project:  common.ResourceRefNamespaced{Name: "project"},
+			Username: "app",
+		},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(sch).WithObjects(project, groupUser, passwordUser).Build()
+
+	users, err := managedDatabaseUsers(context.Background(), k8sClient)
+
+	require.NoError(t, err)
+	assert.Equal(t, []status.DataAccessUser{{ProjectID: "project-id", Username: "idp-id/dbas"}}, users)
+}
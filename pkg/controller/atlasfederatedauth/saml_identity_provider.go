@@ -0,0 +1,138 @@
+package atlasfederatedauth
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+)
+
+const (
+	// CertificatesSecretKey is the key of the Secret holding the PEM encoded certificates of the identity provider
+	CertificatesSecretKey = "certificates.pem"
+
+	samlProtocol = "SAML"
+)
+
+// samlIdentityProviderUpdate sets the fields of the identity provider update differing from the SAML identity
+// provider spec, and reports whether any did. Atlas only returns the validity of the certificates, which is compared
+// with the one of the certificates of the Secret
+func (r *AtlasFederatedAuthReconciler) samlIdentityProviderUpdate(ctx context.Context, namespace string, spec *mdbv1.SAMLIdentityProviderSpec, idp *admin.FederationIdentityProvider, update *admin.IdentityProviderUpdate) (bool, error) {
+	if protocol := idp.GetProtocol(); protocol != "" && protocol != samlProtocol {
+		return false, fmt.Errorf("the identity provider %s uses the %s protocol instead of %s", idp.GetId(), protocol, samlProtocol)
+	}
+
+	changed := false
+	set := func(current string, desired string, field **string) {
+		if desired != "" && desired != current {
+			*field = &desired
+			changed = true
+		}
+	}
+	set(idp.GetDisplayName(), spec.DisplayName, &update.DisplayName)
+	set(idp.GetIssuerUri(), spec.IssuerURI, &update.IssuerUri)
+	set(idp.GetSsoUrl(), spec.SSOURL, &update.SsoUrl)
+	set(idp.GetRequestBinding(), spec.RequestBinding, &update.RequestBinding)
+	set(idp.GetResponseSignatureAlgorithm(), spec.ResponseSignatureAlgorithm, &update.ResponseSignatureAlgorithm)
+
+	if spec.AssociatedDomains != nil && !sameElements(spec.AssociatedDomains, idp.GetAssociatedDomains()) {
+		update.AssociatedDomains = &spec.AssociatedDomains
+		changed = true
+	}
+
+	if spec.CertificatesSecretRef != nil {
+		certificates, err := r.readCertificates(ctx, spec.CertificatesSecretRef.GetObject(namespace))
+		if err != nil {
+			return false, err
+		}
+
+		if !sameCertificates(certificates, idp.PemFileInfo) {
+			fileName := CertificatesSecretKey
+			update.PemFileInfo = &admin.PemFileInfoUpdate{Certificates: &certificates, FileName: &fileName}
+			changed = true
+		}
+	}
+
+	return changed, nil
+}
+
+// readCertificates returns the PEM encoded certificates of the Secret along with their validity
+func (r *AtlasFederatedAuthReconciler) readCertificates(ctx context.Context, key *client.ObjectKey) ([]admin.X509CertificateUpdate, error) {
+	secret := &corev1.Secret{}
+	if err := r.Client.Get(ctx, *key, secret); err != nil {
+		return nil, fmt.Errorf("failed to read the certificates of the identity provider: %w", err)
+	}
+
+	rest := secret.Data[CertificatesSecretKey]
+	var certificates []admin.X509CertificateUpdate
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate in the Secret %s: %w", key, err)
+		}
+		content := string(pem.EncodeToMemory(block))
+		certificates = append(certificates, admin.X509CertificateUpdate{
+			Content:   &content,
+			NotBefore: &certificate.NotBefore,
+			NotAfter:  &certificate.NotAfter,
+		})
+	}
+
+	if len(certificates) == 0 {
+		return nil, fmt.Errorf("the Secret %s holds no PEM encoded certificate under the %s key", key, CertificatesSecretKey)
+	}
+
+	return certificates, nil
+}
+
+// sameCertificates compares the validity of the certificates, the only information Atlas returns about them
+func sameCertificates(certificates []admin.X509CertificateUpdate, pemFileInfo *admin.PemFileInfo) bool {
+	if pemFileInfo == nil {
+		return false
+	}
+
+	desired := make([]string, 0, len(certificates))
+	for _, certificate := range certificates {
+		desired = append(desired, validity(certificate.NotBefore, certificate.NotAfter))
+	}
+	current := make([]string, 0, len(pemFileInfo.GetCertificates()))
+	for _, certificate := range pemFileInfo.GetCertificates() {
+		current = append(current, validity(certificate.NotBefore, certificate.NotAfter))
+	}
+
+	return sameElements(desired, current)
+}
+
+func validity(notBefore, notAfter *time.Time) string {
+	if notBefore == nil || notAfter == nil {
+		return ""
+	}
+
+	return notBefore.UTC().Format(time.RFC3339) + "/" + notAfter.UTC().Format(time.RFC3339)
+}
+
+func sameElements(a, b []string) bool {
+	a = slices.Clone(a)
+	b = slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+
+	return slices.Equal(a, b)
+}
@@ -0,0 +1,142 @@
+package atlasfederatedauth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+)
+
+func selfSignedCertificate(t *testing.T, notBefore, notAfter time.Time) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestSAMLIdentityProviderUpdate(t *testing.T) {
+	notBefore := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	notAfter := notBefore.AddDate(1, 0, 0)
+	certificatesSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "idp-certificates", Namespace: "ns"},
+		Data:       map[string][]byte{CertificatesSecretKey: selfSignedCertificate(t, notBefore, notAfter)},
+	}
+	newReconciler := func(t *testing.T) *AtlasFederatedAuthReconciler {
+		sch := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(sch))
+
+		return &AtlasFederatedAuthReconciler{Client: fake.NewClientBuilder().WithScheme(sch).WithObjects(certificatesSecret).Build()}
+	}
+	newIdentityProvider := func() *admin.FederationIdentityProvider {
+		return &admin.FederationIdentityProvider{
+			Id:                         "idp-id",
+			Protocol:                   pointer.MakePtr("SAML"),
+			SsoUrl:                     pointer.MakePtr("https://idp.example.com/sso"),
+			IssuerUri:                  pointer.MakePtr("urn:idp"),
+			RequestBinding:             pointer.MakePtr("HTTP-POST"),
+			ResponseSignatureAlgorithm: pointer.MakePtr("SHA-256"),
+			AssociatedDomains:          &[]string{"example.com"},
+			PemFileInfo: &admin.PemFileInfo{
+				Certificates: &[]admin.X509Certificate{{NotBefore: &notBefore, NotAfter: &notAfter}},
+			},
+		}
+	}
+	newSpec := func() *mdbv1.SAMLIdentityProviderSpec {
+		return &mdbv1.SAMLIdentityProviderSpec{
+			SSOURL:                     "https://idp.example.com/sso",
+			IssuerURI:                  "urn:idp",
+			RequestBinding:             "HTTP-POST",
+			ResponseSignatureAlgorithm: "SHA-256",
+			AssociatedDomains:          []string{"example.com"},
+			CertificatesSecretRef:      &common.ResourceRefNamespaced{Name: "idp-certificates"},
+		}
+	}
+
+	t.Run("should leave an identity provider in sync untouched", func(t *testing.T) {
+		update := &admin.IdentityProviderUpdate{}
+
+		changed, err := newReconciler(t).samlIdentityProviderUpdate(context.Background(), "ns", newSpec(), newIdentityProvider(), update)
+
+		require.NoError(t, err)
+		assert.False(t, changed)
+		assert.Equal(t, &admin.IdentityProviderUpdate{}, update)
+	})
+
+	t.Run("should update the fields differing from the spec", func(t *testing.T) {
+		spec := newSpec()
+		spec.SSOURL = "https://idp.example.com/sso/v2"
+		spec.RequestBinding = "HTTP-REDIRECT"
+		spec.AssociatedDomains = []string{"example.com", "example.org"}
+		update := &admin.IdentityProviderUpdate{}
+
+		changed, err := newReconciler(t).samlIdentityProviderUpdate(context.Background(), "ns", spec, newIdentityProvider(), update)
+
+		require.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, "https://idp.example.com/sso/v2", update.GetSsoUrl())
+		assert.Equal(t, "HTTP-REDIRECT", update.GetRequestBinding())
+		assert.Equal(t, []string{"example.com", "example.org"}, update.GetAssociatedDomains())
+		assert.Nil(t, update.IssuerUri)
+		assert.Nil(t, update.PemFileInfo)
+	})
+
+	t.Run("should upload the certificates of the Secret when they differ", func(t *testing.T) {
+		idp := newIdentityProvider()
+		expired := notBefore.AddDate(-1, 0, 0)
+		idp.PemFileInfo.Certificates = &[]admin.X509Certificate{{NotBefore: &expired, NotAfter: &notBefore}}
+		update := &admin.IdentityProviderUpdate{}
+
+		changed, err := newReconciler(t).samlIdentityProviderUpdate(context.Background(), "ns", newSpec(), idp, update)
+
+		require.NoError(t, err)
+		assert.True(t, changed)
+		require.Len(t, update.PemFileInfo.GetCertificates(), 1)
+		assert.Equal(t, string(certificatesSecret.Data[CertificatesSecretKey]), update.PemFileInfo.GetCertificates()[0].GetContent())
+		assert.Equal(t, notAfter, update.PemFileInfo.GetCertificates()[0].GetNotAfter().UTC())
+	})
+
+	t.Run("should reject a Secret without certificates", func(t *testing.T) {
+		spec := newSpec()
+		spec.CertificatesSecretRef.Name = "missing"
+
+		_, err := newReconciler(t).samlIdentityProviderUpdate(context.Background(), "ns", spec, newIdentityProvider(), &admin.IdentityProviderUpdate{})
+
+		assert.ErrorContains(t, err, "failed to read the certificates of the identity provider")
+	})
+
+	t.Run("should reject an OIDC identity provider", func(t *testing.T) {
+		idp := newIdentityProvider()
+		idp.Protocol = pointer.MakePtr("OIDC")
+
+		_, err := newReconciler(t).samlIdentityProviderUpdate(context.Background(), "ns", newSpec(), idp, &admin.IdentityProviderUpdate{})
+
+		assert.ErrorContains(t, err, "the identity provider idp-id uses the OIDC protocol instead of SAML")
+	})
+}
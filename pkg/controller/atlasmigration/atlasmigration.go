@@ -0,0 +1,227 @@
+package atlasmigration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// CutoverAnnotation triggers the cutover of the live migration once Atlas reports it ready for cutover
+const CutoverAnnotation = "mongodb.com/atlas-migration-cutover"
+
+const (
+	validationSuccess = "SUCCESS"
+	validationFailed  = "FAILED"
+
+	migrationFailed   = "FAILED"
+	migrationComplete = "COMPLETE"
+	migrationExpired  = "EXPIRED"
+)
+
+// ensureMigration moves the live migration forward: it validates the migration, starts it once the validation
+// succeeded, tracks its progress and triggers the cutover when requested. Failed validations and migrations are only
+// retried once the spec of the AtlasMigration changes
+func (r *AtlasMigrationReconciler) ensureMigration(ctx *workflow.Context, migration *mdbv1.AtlasMigration, projectID, clusterName string, specChanged bool) workflow.Result {
+	switch migration.Status.Phase {
+	case status.MigrationPhaseCompleted:
+		return workflow.OK()
+	case status.MigrationPhaseValidationFailed, status.MigrationPhaseFailed:
+		if !specChanged {
+			return failedResult(migration.Status)
+		}
+
+		ctx.EnsureStatusOption(status.AtlasMigrationValidationOption("", ""))
+		ctx.EnsureStatusOption(status.AtlasMigrationProgressOption("", "", 0, false, nil))
+		return r.validateMigration(ctx, migration, projectID, clusterName)
+	}
+
+	switch {
+	case migration.Status.MigrationID != "":
+		return trackMigration(ctx, migration, projectID)
+	case migration.Status.ValidationID != "":
+		return r.trackValidation(ctx, migration, projectID, clusterName)
+	}
+
+	return r.validateMigration(ctx, migration, projectID, clusterName)
+}
+
+func (r *AtlasMigrationReconciler) validateMigration(ctx *workflow.Context, migration *mdbv1.AtlasMigration, projectID, clusterName string) workflow.Result {
+	request, err := r.migrationRequest(ctx.Context, migration, projectID, clusterName)
+	if err != nil {
+		return workflow.Terminate(workflow.MigrationInvalidSpec, err.Error())
+	}
+
+	validation, _, err := ctx.SdkClient.CloudMigrationServiceApi.ValidateMigration(ctx.Context, projectID, request).Execute()
+	if err != nil {
+		return workflow.Terminate(workflow.Internal, err.Error())
+	}
+
+	ctx.EnsureStatusOption(status.AtlasMigrationValidationOption(validation.GetId(), ""))
+	ctx.EnsureStatusOption(status.AtlasMigrationPhaseOption(status.MigrationPhaseValidating))
+
+	return workflow.InProgress(workflow.MigrationValidating, "Atlas is validating the migration")
+}
+
+func (r *AtlasMigrationReconciler) trackValidation(ctx *workflow.Context, migration *mdbv1.AtlasMigration, projectID, clusterName string) workflow.Result {
+	validation, _, err := ctx.SdkClient.CloudMigrationServiceApi.
+		GetValidationStatus(ctx.Context, projectID, migration.Status.ValidationID).
+		Execute()
+	if err != nil {
+		return workflow.Terminate(workflow.Internal, err.Error())
+	}
+
+	switch validation.GetStatus() {
+	case validationFailed:
+		ctx.EnsureStatusOption(status.AtlasMigrationValidationOption(migration.Status.ValidationID, validation.GetErrorMessage()))
+		ctx.EnsureStatusOption(status.AtlasMigrationPhaseOption(status.MigrationPhaseValidationFailed))
+		result := workflow.Terminate(workflow.MigrationValidationFailed, validation.GetErrorMessage()).WithoutRetry()
+		ctx.SetConditionFromResult(status.MigrationValidatedType, result)
+
+		return result
+	case validationSuccess:
+		ctx.SetConditionTrue(status.MigrationValidatedType)
+	default:
+		return workflow.InProgress(workflow.MigrationValidating, fmt.Sprintf("Atlas is validating the migration, validation is %s", validation.GetStatus()))
+	}
+
+	request, err := r.migrationRequest(ctx.Context, migration, projectID, clusterName)
+	if err != nil {
+		return workflow.Terminate(workflow.MigrationInvalidSpec, err.Error())
+	}
+
+	liveMigration, _, err := ctx.SdkClient.CloudMigrationServiceApi.CreatePushMigration(ctx.Context, projectID, request).Execute()
+	if err != nil {
+		return workflow.Terminate(workflow.Internal, err.Error())
+	}
+
+	return migrationProgress(ctx, migration, liveMigration)
+}
+
+func trackMigration(ctx *workflow.Context, migration *mdbv1.AtlasMigration, projectID string) workflow.Result {
+	liveMigration, _, err := ctx.SdkClient.CloudMigrationServiceApi.
+		GetPushMigration(ctx.Context, projectID, migration.Status.MigrationID).
+		Execute()
+	if err != nil {
+		return workflow.Terminate(workflow.Internal, err.Error())
+	}
+
+	if liveMigration.GetReadyForCutover() && migration.Status.Phase != status.MigrationPhaseCuttingOver &&
+		migration.GetAnnotations()[CutoverAnnotation] == "true" {
+		if _, err = ctx.SdkClient.CloudMigrationServiceApi.CutoverMigration(ctx.Context, projectID, migration.Status.MigrationID).Execute(); err != nil {
+			return workflow.Terminate(workflow.Internal, err.Error())
+		}
+
+		ctx.EnsureStatusOption(status.AtlasMigrationPhaseOption(status.MigrationPhaseCuttingOver))
+		return workflow.InProgress(workflow.MigrationCuttingOver, "the cutover of the migration was triggered")
+	}
+
+	return migrationProgress(ctx, migration, liveMigration)
+}
+
+// migrationProgress reports the progress of the live migration in the status
+func migrationProgress(ctx *workflow.Context, migration *mdbv1.AtlasMigration, liveMigration *admin.LiveMigrationResponse) workflow.Result {
+	ctx.EnsureStatusOption(
+		status.AtlasMigrationProgressOption(
+			liveMigration.GetId(),
+			liveMigration.GetStatus(),
+			liveMigration.GetLagTimeSeconds(),
+			liveMigration.GetReadyForCutover(),
+			liveMigration.GetMigrationHosts(),
+		),
+	)
+
+	switch {
+	case liveMigration.GetStatus() == migrationComplete:
+		ctx.EnsureStatusOption(status.AtlasMigrationPhaseOption(status.MigrationPhaseCompleted))
+		return workflow.OK()
+	case liveMigration.GetStatus() == migrationFailed || liveMigration.GetStatus() == migrationExpired:
+		ctx.EnsureStatusOption(status.AtlasMigrationPhaseOption(status.MigrationPhaseFailed))
+		return workflow.Terminate(workflow.MigrationFailed, fmt.Sprintf("the live migration %s is %s", liveMigration.GetId(), liveMigration.GetStatus())).
+			WithoutRetry()
+	case migration.Status.Phase == status.MigrationPhaseCuttingOver:
+		return workflow.InProgress(workflow.MigrationCuttingOver, "waiting for the cutover of the migration to complete")
+	case liveMigration.GetReadyForCutover():
+		ctx.EnsureStatusOption(status.AtlasMigrationPhaseOption(status.MigrationPhaseReadyForCutover))
+		return workflow.InProgress(
+			workflow.MigrationReadyForCutover,
+			fmt.Sprintf("the migration is ready for cutover, set the annotation %s=true to trigger it", CutoverAnnotation),
+		)
+	}
+
+	ctx.EnsureStatusOption(status.AtlasMigrationPhaseOption(status.MigrationPhaseMigrating))
+	return workflow.InProgress(workflow.MigrationInProgress, fmt.Sprintf("the migration is %s, lag is %ds", liveMigration.GetStatus(), liveMigration.GetLagTimeSeconds()))
+}
+
+func failedResult(migrationStatus status.AtlasMigrationStatus) workflow.Result {
+	if migrationStatus.Phase == status.MigrationPhaseValidationFailed {
+		return workflow.Terminate(workflow.MigrationValidationFailed, migrationStatus.ValidationError).WithoutRetry()
+	}
+
+	return workflow.Terminate(
+		workflow.MigrationFailed,
+		fmt.Sprintf("the live migration %s is %s", migrationStatus.MigrationID, migrationStatus.MigrationStatus),
+	).WithoutRetry()
+}
+
+func (r *AtlasMigrationReconciler) migrationRequest(ctx context.Context, migration *mdbv1.AtlasMigration, projectID, clusterName string) (*admin.LiveMigrationRequest, error) {
+	source := migration.Spec.Source
+	request := &admin.LiveMigrationRequest{
+		Destination: admin.Destination{
+			ClusterName:        clusterName,
+			GroupId:            projectID,
+			HostnameSchemaType: migration.Spec.HostnameSchemaType,
+		},
+		DropEnabled: migration.Spec.DropEnabled,
+		Source: admin.Source{
+			ClusterName:           source.ClusterName,
+			GroupId:               source.ProjectID,
+			ManagedAuthentication: source.ManagedAuthentication,
+			Ssl:                   source.SSL,
+		},
+	}
+
+	if request.Destination.HostnameSchemaType == "" {
+		request.Destination.HostnameSchemaType = "PUBLIC"
+	}
+	if migration.Spec.PrivateLinkID != "" {
+		request.Destination.PrivateLinkId = pointer.MakePtr(migration.Spec.PrivateLinkID)
+	}
+	if len(migration.Spec.MigrationHosts) > 0 {
+		request.MigrationHosts = &migration.Spec.MigrationHosts
+	}
+	if source.CACertificatePath != "" {
+		request.Source.CaCertificatePath = pointer.MakePtr(source.CACertificatePath)
+	}
+
+	if source.ManagedAuthentication {
+		return request, nil
+	}
+
+	if source.CredentialsSecretRef == nil {
+		return nil, errors.New("the credentialsSecretRef of the source is required when managedAuthentication is disabled")
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Client.Get(ctx, *source.CredentialsSecretRef.GetObject(migration.Namespace), secret); err != nil {
+		return nil, err
+	}
+
+	for _, key := range []string{"username", "password"} {
+		if len(secret.Data[key]) == 0 {
+			return nil, fmt.Errorf("secret %s is invalid: the '%s' field is missing or empty", client.ObjectKeyFromObject(secret), key)
+		}
+	}
+	request.Source.Username = pointer.MakePtr(string(secret.Data["username"]))
+	request.Source.Password = pointer.MakePtr(string(secret.Data["password"]))
+
+	return request, nil
+}
@@ -0,0 +1,161 @@
+package atlasmigration
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/notifier"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/statushandler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// AtlasMigrationReconciler reconciles an AtlasMigration object.
+// Atlas doesn't allow to cancel or delete a live migration, so removing an AtlasMigration only stops its tracking by
+// the operator
+type AtlasMigrationReconciler struct {
+	Client           client.Client
+	Log              *zap.SugaredLogger
+	Scheme           *runtime.Scheme
+	GlobalPredicates []predicate.Predicate
+	EventRecorder    record.EventRecorder
+	FailureNotifier  *notifier.Notifier
+	AtlasProvider    atlas.Provider
+}
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasmigrations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasmigrations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasmigrations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasmigrations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+func (r *AtlasMigrationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, _ error) {
+	log := r.Log.With("atlasmigration", req.NamespacedName)
+
+	migration := &mdbv1.AtlasMigration{}
+	result := customresource.PrepareResource(ctx, r.Client, req, migration, log)
+	if !result.IsOk() {
+		return result.ReconcileResult(), nil
+	}
+
+	if customresource.ReconciliationShouldBeSkipped(migration) {
+		log.Infow(fmt.Sprintf("-> Skipping AtlasMigration reconciliation as annotation %s=%s", customresource.ReconciliationPolicyAnnotation, customresource.ReconciliationPolicySkip), "spec", migration.Spec)
+		return workflow.OK().ReconcileResult(), nil
+	}
+
+	if customresource.NamespaceReconciliationPaused(ctx, r.Client, migration.Namespace, log) {
+		log.Infow(fmt.Sprintf("-> Pausing AtlasMigration reconciliation as annotation %s=true is set on the namespace", customresource.ReconciliationPausedAnnotation))
+		return customresource.MarkReconciliationPaused(ctx, r.Client, r.EventRecorder, migration, log).ReconcileResult(), nil
+	}
+
+	if !migration.GetDeletionTimestamp().IsZero() {
+		log.Info("AtlasMigration is being deleted, the live migration is left untouched in Atlas")
+		return workflow.OK().ReconcileResult(), nil
+	}
+
+	// the observed generation is updated when the reconciliation starts, a failed migration is only retried on changes
+	specChanged := migration.Status.ObservedGeneration != migration.Generation
+	workflowCtx := customresource.MarkReconciliationStarted(r.Client, migration, log, ctx)
+	log.Infow("-> Starting AtlasMigration reconciliation", "spec", migration.Spec, "status", migration.Status)
+
+	if workflowCtx.Degraded(migration) {
+		log.Infow("-> Skipping the reconciliation of the degraded AtlasMigration, change its spec or set the reapply annotation to retry")
+		return ctrl.Result{}, nil
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			res = workflowCtx.RecoverPanic("AtlasMigration", p).ReconcileResult()
+		}
+		res = workflowCtx.CapRetries("AtlasMigration", migration, res)
+		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, r.FailureNotifier, migration)
+	}()
+
+	resourceVersionIsValid := customresource.ValidateResourceVersion(workflowCtx, migration, r.Log)
+	if !resourceVersionIsValid.IsOk() {
+		r.Log.Debugf("AtlasMigration validation result: %v", resourceVersionIsValid)
+		return resourceVersionIsValid.ReconcileResult(), nil
+	}
+
+	if !r.AtlasProvider.IsResourceSupported(migration) {
+		result = workflow.Terminate(workflow.AtlasGovUnsupported, "the AtlasMigration is not supported by Atlas for government").
+			WithoutRetry()
+		workflowCtx.SetConditionFromResult(status.MigrationReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+
+	deployment := &mdbv1.AtlasDeployment{}
+	project := &mdbv1.AtlasProject{}
+	if result = r.readDestination(ctx, migration, deployment, project); !result.IsOk() {
+		workflowCtx.SetConditionFromResult(status.MigrationReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+
+	atlasClient, orgID, err := r.AtlasProvider.SdkClient(workflowCtx.Context, project.ConnectionSecretObjectKey(), log)
+	if err != nil {
+		result = workflow.Terminate(workflow.AtlasAPIAccessNotConfigured, err.Error())
+		workflowCtx.SetConditionFromResult(status.MigrationReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+	workflowCtx.SdkClient = atlasClient
+	workflowCtx.OrgID = orgID
+
+	result = r.ensureMigration(workflowCtx, migration, project.ID(), deployment.GetDeploymentName(), specChanged)
+	workflowCtx.SetConditionFromResult(status.MigrationReadyType, result)
+	workflowCtx.SetConditionFromResult(status.ReadyType, result)
+
+	return result.ReconcileResult(), nil
+}
+
+// readDestination reads the AtlasDeployment the data is migrated to and its AtlasProject, both must be ready in Atlas
+// before the migration starts
+func (r *AtlasMigrationReconciler) readDestination(ctx context.Context, migration *mdbv1.AtlasMigration, deployment *mdbv1.AtlasDeployment, project *mdbv1.AtlasProject) workflow.Result {
+	if err := r.Client.Get(ctx, migration.DeploymentObjectKey(), deployment); err != nil {
+		return workflow.Terminate(workflow.Internal, err.Error())
+	}
+
+	if !deployment.IsAdvancedDeployment() {
+		return workflow.Terminate(workflow.MigrationInvalidSpec, "the destination of a live migration must be a dedicated deployment").
+			WithoutRetry()
+	}
+
+	if !isReady(deployment.Status.Conditions) {
+		return workflow.InProgress(workflow.MigrationDeploymentNotReady, fmt.Sprintf("waiting for the deployment %s to be ready", deployment.GetDeploymentName()))
+	}
+
+	if err := r.Client.Get(ctx, deployment.AtlasProjectObjectKey(), project); err != nil {
+		return workflow.Terminate(workflow.Internal, err.Error())
+	}
+
+	return workflow.OK()
+}
+
+func (r *AtlasMigrationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("AtlasMigration").
+		For(&mdbv1.AtlasMigration{}, builder.WithPredicates(r.GlobalPredicates...)).
+		Complete(r)
+}
+
+func isReady(conditions []status.Condition) bool {
+	for _, c := range conditions {
+		if c.Type == status.ReadyType && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,229 @@
+package atlasmigration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const projectID = "project-id"
+
+func newMigration(migrationStatus status.AtlasMigrationStatus) *mdbv1.AtlasMigration {
+	return &mdbv1.AtlasMigration{
+		ObjectMeta: metav1.ObjectMeta{Name: "migration", Namespace: "default"},
+		Spec: mdbv1.AtlasMigrationSpec{
+			Source: mdbv1.MigrationSource{
+				ProjectID:            "source-project-id",
+				ClusterName:          "source-cluster",
+				CredentialsSecretRef: &common.ResourceRefNamespaced{Name: "source-credentials"},
+			},
+			DeploymentRef: common.ResourceRefNamespaced{Name: "deployment"},
+		},
+		Status: migrationStatus,
+	}
+}
+
+func newReconciler(t *testing.T) *AtlasMigrationReconciler {
+	sch := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(sch))
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "source-credentials", Namespace: "default"},
+		Data:       map[string][]byte{"username": []byte("user"), "password": []byte("pass")},
+	}
+
+	return &AtlasMigrationReconciler{
+		Client: fake.NewClientBuilder().WithScheme(sch).WithObjects(secret).Build(),
+		Log:    zaptest.NewLogger(t).Sugar(),
+	}
+}
+
+func newContext(t *testing.T, migrationAPI admin.CloudMigrationServiceApi) *workflow.Context {
+	return &workflow.Context{
+		Context:   context.Background(),
+		Log:       zaptest.NewLogger(t).Sugar(),
+		SdkClient: &admin.APIClient{CloudMigrationServiceApi: migrationAPI},
+	}
+}
+
+func reconciledStatus(ctx *workflow.Context, migration *mdbv1.AtlasMigration) status.AtlasMigrationStatus {
+	migration.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+
+	return migration.Status
+}
+
+func TestEnsureMigration(t *testing.T) {
+	t.Run("should start the validation of a new migration", func(t *testing.T) {
+		migrationAPI := atlasmock.NewCloudMigrationServiceApiMock(t)
+		migrationAPI.EXPECT().ValidateMigration(context.Background(), projectID, mock.AnythingOfType("*admin.LiveMigrationRequest")).
+			RunAndReturn(func(ctx context.Context, groupID string, request *admin.LiveMigrationRequest) admin.ValidateMigrationApiRequest {
+				assert.Equal(t, "deployment-name", request.Destination.ClusterName)
+				assert.Equal(t, "PUBLIC", request.Destination.HostnameSchemaType)
+				assert.Equal(t, "user", request.Source.GetUsername())
+				assert.Equal(t, "pass", request.Source.GetPassword())
+
+				return admin.ValidateMigrationApiRequest{ApiService: migrationAPI}
+			})
+		migrationAPI.EXPECT().ValidateMigrationExecute(mock.Anything).
+			Return(&admin.LiveImportValidation{Id: pointer.MakePtr("validation-id")}, nil, nil)
+		ctx := newContext(t, migrationAPI)
+		migration := newMigration(status.AtlasMigrationStatus{})
+
+		result := newReconciler(t).ensureMigration(ctx, migration, projectID, "deployment-name", true)
+
+		assert.True(t, result.IsInProgress())
+		migrationStatus := reconciledStatus(ctx, migration)
+		assert.Equal(t, status.MigrationPhaseValidating, migrationStatus.Phase)
+		assert.Equal(t, "validation-id", migrationStatus.ValidationID)
+	})
+
+	t.Run("should start the migration once validated", func(t *testing.T) {
+		migrationAPI := atlasmock.NewCloudMigrationServiceApiMock(t)
+		migrationAPI.EXPECT().GetValidationStatus(context.Background(), projectID, "validation-id").
+			Return(admin.GetValidationStatusApiRequest{ApiService: migrationAPI})
+		migrationAPI.EXPECT().GetValidationStatusExecute(mock.Anything).
+			Return(&admin.LiveImportValidation{Id: pointer.MakePtr("validation-id"), Status: pointer.MakePtr("SUCCESS")}, nil, nil)
+		migrationAPI.EXPECT().CreatePushMigration(context.Background(), projectID, mock.AnythingOfType("*admin.LiveMigrationRequest")).
+			Return(admin.CreatePushMigrationApiRequest{ApiService: migrationAPI})
+		migrationAPI.EXPECT().CreatePushMigrationExecute(mock.Anything).
+			Return(&admin.LiveMigrationResponse{Id: pointer.MakePtr("migration-id"), Status: pointer.MakePtr("NEW")}, nil, nil)
+		ctx := newContext(t, migrationAPI)
+		migration := newMigration(status.AtlasMigrationStatus{Phase: status.MigrationPhaseValidating, ValidationID: "validation-id"})
+
+		result := newReconciler(t).ensureMigration(ctx, migration, projectID, "deployment-name", false)
+
+		assert.True(t, result.IsInProgress())
+		migrationStatus := reconciledStatus(ctx, migration)
+		assert.Equal(t, status.MigrationPhaseMigrating, migrationStatus.Phase)
+		assert.Equal(t, "migration-id", migrationStatus.MigrationID)
+		assert.Equal(t, status.MigrationValidatedType, migrationStatus.Conditions[0].Type)
+		assert.Equal(t, corev1.ConditionTrue, migrationStatus.Conditions[0].Status)
+	})
+
+	t.Run("should report the failure of the validation", func(t *testing.T) {
+		migrationAPI := atlasmock.NewCloudMigrationServiceApiMock(t)
+		migrationAPI.EXPECT().GetValidationStatus(context.Background(), projectID, "validation-id").
+			Return(admin.GetValidationStatusApiRequest{ApiService: migrationAPI})
+		migrationAPI.EXPECT().GetValidationStatusExecute(mock.Anything).
+			Return(&admin.LiveImportValidation{Status: pointer.MakePtr("FAILED"), ErrorMessage: pointer.MakePtr("source unreachable")}, nil, nil)
+		ctx := newContext(t, migrationAPI)
+		migration := newMigration(status.AtlasMigrationStatus{Phase: status.MigrationPhaseValidating, ValidationID: "validation-id"})
+
+		result := newReconciler(t).ensureMigration(ctx, migration, projectID, "deployment-name", false)
+
+		assert.Equal(t, workflow.Terminate(workflow.MigrationValidationFailed, "source unreachable").WithoutRetry(), result)
+		migrationStatus := reconciledStatus(ctx, migration)
+		assert.Equal(t, status.MigrationPhaseValidationFailed, migrationStatus.Phase)
+		assert.Equal(t, "source unreachable", migrationStatus.ValidationError)
+	})
+
+	t.Run("should wait for the cutover to be requested", func(t *testing.T) {
+		migrationAPI := atlasmock.NewCloudMigrationServiceApiMock(t)
+		migrationAPI.EXPECT().GetPushMigration(context.Background(), projectID, "migration-id").
+			Return(admin.GetPushMigrationApiRequest{ApiService: migrationAPI})
+		migrationAPI.EXPECT().GetPushMigrationExecute(mock.Anything).
+			Return(
+				&admin.LiveMigrationResponse{
+					Id:              pointer.MakePtr("migration-id"),
+					Status:          pointer.MakePtr("WORKING"),
+					LagTimeSeconds:  pointer.MakePtr(int64(2)),
+					ReadyForCutover: pointer.MakePtr(true),
+				},
+				nil,
+				nil,
+			)
+		ctx := newContext(t, migrationAPI)
+		migration := newMigration(status.AtlasMigrationStatus{Phase: status.MigrationPhaseMigrating, MigrationID: "migration-id"})
+
+		result := newReconciler(t).ensureMigration(ctx, migration, projectID, "deployment-name", false)
+
+		assert.True(t, result.IsInProgress())
+		migrationStatus := reconciledStatus(ctx, migration)
+		assert.Equal(t, status.MigrationPhaseReadyForCutover, migrationStatus.Phase)
+		assert.Equal(t, int64(2), migrationStatus.LagTimeSeconds)
+		assert.True(t, migrationStatus.ReadyForCutover)
+	})
+
+	t.Run("should trigger the cutover when annotated", func(t *testing.T) {
+		migrationAPI := atlasmock.NewCloudMigrationServiceApiMock(t)
+		migrationAPI.EXPECT().GetPushMigration(context.Background(), projectID, "migration-id").
+			Return(admin.GetPushMigrationApiRequest{ApiService: migrationAPI})
+		migrationAPI.EXPECT().GetPushMigrationExecute(mock.Anything).
+			Return(&admin.LiveMigrationResponse{Id: pointer.MakePtr("migration-id"), Status: pointer.MakePtr("WORKING"), ReadyForCutover: pointer.MakePtr(true)}, nil, nil)
+		migrationAPI.EXPECT().CutoverMigration(context.Background(), projectID, "migration-id").
+			Return(admin.CutoverMigrationApiRequest{ApiService: migrationAPI})
+		migrationAPI.EXPECT().CutoverMigrationExecute(mock.Anything).
+			Return(nil, nil)
+		ctx := newContext(t, migrationAPI)
+		migration := newMigration(status.AtlasMigrationStatus{Phase: status.MigrationPhaseReadyForCutover, MigrationID: "migration-id"})
+		migration.SetAnnotations(map[string]string{CutoverAnnotation: "true"})
+
+		result := newReconciler(t).ensureMigration(ctx, migration, projectID, "deployment-name", false)
+
+		assert.True(t, result.IsInProgress())
+		assert.Equal(t, status.MigrationPhaseCuttingOver, reconciledStatus(ctx, migration).Phase)
+	})
+
+	t.Run("should complete the migration", func(t *testing.T) {
+		migrationAPI := atlasmock.NewCloudMigrationServiceApiMock(t)
+		migrationAPI.EXPECT().GetPushMigration(context.Background(), projectID, "migration-id").
+			Return(admin.GetPushMigrationApiRequest{ApiService: migrationAPI})
+		migrationAPI.EXPECT().GetPushMigrationExecute(mock.Anything).
+			Return(&admin.LiveMigrationResponse{Id: pointer.MakePtr("migration-id"), Status: pointer.MakePtr("COMPLETE")}, nil, nil)
+		ctx := newContext(t, migrationAPI)
+		migration := newMigration(status.AtlasMigrationStatus{Phase: status.MigrationPhaseCuttingOver, MigrationID: "migration-id"})
+		migration.SetAnnotations(map[string]string{CutoverAnnotation: "true"})
+
+		result := newReconciler(t).ensureMigration(ctx, migration, projectID, "deployment-name", false)
+
+		assert.True(t, result.IsOk())
+		assert.Equal(t, status.MigrationPhaseCompleted, reconciledStatus(ctx, migration).Phase)
+	})
+
+	t.Run("should not retry a failed migration until the spec changes", func(t *testing.T) {
+		ctx := newContext(t, atlasmock.NewCloudMigrationServiceApiMock(t))
+		migration := newMigration(status.AtlasMigrationStatus{Phase: status.MigrationPhaseFailed, MigrationID: "migration-id", MigrationStatus: "EXPIRED"})
+
+		result := newReconciler(t).ensureMigration(ctx, migration, projectID, "deployment-name", false)
+
+		assert.Equal(t, workflow.Terminate(workflow.MigrationFailed, "the live migration migration-id is EXPIRED").WithoutRetry(), result)
+	})
+}
+
+func TestMigrationRequest(t *testing.T) {
+	t.Run("should not require credentials with managed authentication", func(t *testing.T) {
+		migration := newMigration(status.AtlasMigrationStatus{})
+		migration.Spec.Source.ManagedAuthentication = true
+		migration.Spec.Source.CredentialsSecretRef = nil
+
+		request, err := newReconciler(t).migrationRequest(context.Background(), migration, projectID, "deployment-name")
+
+		require.NoError(t, err)
+		assert.Nil(t, request.Source.Username)
+		assert.True(t, request.Source.ManagedAuthentication)
+	})
+
+	t.Run("should fail without credentials", func(t *testing.T) {
+		migration := newMigration(status.AtlasMigrationStatus{})
+		migration.Spec.Source.CredentialsSecretRef = nil
+
+		_, err := newReconciler(t).migrationRequest(context.Background(), migration, projectID, "deployment-name")
+
+		assert.EqualError(t, err, "the credentialsSecretRef of the source is required when managedAuthentication is disabled")
+	})
+}
@@ -0,0 +1,206 @@
+package atlasnetworkcontainer
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/notifier"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/statushandler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/watch"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// AtlasNetworkContainerReconciler reconciles an AtlasNetworkContainer object
+type AtlasNetworkContainerReconciler struct {
+	Client                   client.Client
+	Log                      *zap.SugaredLogger
+	Scheme                   *runtime.Scheme
+	GlobalPredicates         []predicate.Predicate
+	EventRecorder            record.EventRecorder
+	FailureNotifier          *notifier.Notifier
+	AtlasProvider            atlas.Provider
+	ObjectDeletionProtection bool
+}
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasnetworkcontainers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasnetworkcontainers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasnetworkcontainers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasnetworkcontainers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *AtlasNetworkContainerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, _ error) {
+	log := r.Log.With("atlasnetworkcontainer", req.NamespacedName)
+
+	container := &mdbv1.AtlasNetworkContainer{}
+	result := customresource.PrepareResource(ctx, r.Client, req, container, log)
+	if !result.IsOk() {
+		return result.ReconcileResult(), nil
+	}
+
+	if customresource.ReconciliationShouldBeSkipped(container) {
+		log.Infow(fmt.Sprintf("-> Skipping AtlasNetworkContainer reconciliation as annotation %s=%s", customresource.ReconciliationPolicyAnnotation, customresource.ReconciliationPolicySkip), "spec", container.Spec)
+		if !container.GetDeletionTimestamp().IsZero() {
+			if err := customresource.ManageFinalizer(ctx, r.Client, container, customresource.UnsetFinalizer); err != nil {
+				log.Errorw("failed to remove finalizer", "error", err)
+				return workflow.Terminate(workflow.Internal, err.Error()).ReconcileResult(), nil
+			}
+		}
+		return workflow.OK().ReconcileResult(), nil
+	}
+
+	if customresource.NamespaceReconciliationPaused(ctx, r.Client, container.Namespace, log) {
+		log.Infow(fmt.Sprintf("-> Pausing AtlasNetworkContainer reconciliation as annotation %s=true is set on the namespace", customresource.ReconciliationPausedAnnotation))
+		return customresource.MarkReconciliationPaused(ctx, r.Client, r.EventRecorder, container, log).ReconcileResult(), nil
+	}
+
+	workflowCtx := customresource.MarkReconciliationStarted(r.Client, container, log, ctx)
+	log.Infow("-> Starting AtlasNetworkContainer reconciliation", "spec", container.Spec, "status", container.Status)
+
+	if workflowCtx.Degraded(container) {
+		log.Infow("-> Skipping the reconciliation of the degraded AtlasNetworkContainer, change its spec or set the reapply annotation to retry")
+		return ctrl.Result{}, nil
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			res = workflowCtx.RecoverPanic("AtlasNetworkContainer", p).ReconcileResult()
+		}
+		res = workflowCtx.CapRetries("AtlasNetworkContainer", container, res)
+		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, r.FailureNotifier, container)
+	}()
+
+	resourceVersionIsValid := customresource.ValidateResourceVersion(workflowCtx, container, r.Log)
+	if !resourceVersionIsValid.IsOk() {
+		r.Log.Debugf("AtlasNetworkContainer validation result: %v", resourceVersionIsValid)
+		return resourceVersionIsValid.ReconcileResult(), nil
+	}
+
+	project := &mdbv1.AtlasProject{}
+	err := r.Client.Get(ctx, container.AtlasProjectObjectKey(), project)
+	if apiErrors.IsNotFound(err) && !container.GetDeletionTimestamp().IsZero() {
+		// the container was removed from Atlas along with its project
+		return r.removeFinalizer(workflowCtx, container).ReconcileResult(), nil
+	}
+	if err != nil {
+		result = workflow.Terminate(workflow.Internal, err.Error())
+		workflowCtx.SetConditionFromResult(status.NetworkContainerReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+
+	atlasClient, orgID, err := r.AtlasProvider.SdkClient(workflowCtx.Context, project.ConnectionSecretObjectKey(), log)
+	if err != nil {
+		result = workflow.Terminate(workflow.AtlasAPIAccessNotConfigured, err.Error())
+		workflowCtx.SetConditionFromResult(status.NetworkContainerReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+	workflowCtx.SdkClient = atlasClient
+	workflowCtx.OrgID = orgID
+
+	if !container.GetDeletionTimestamp().IsZero() {
+		return r.handleDeletion(workflowCtx, container, project.ID()).ReconcileResult(), nil
+	}
+
+	if !customresource.HaveFinalizer(container, customresource.FinalizerLabel) {
+		if err = customresource.ManageFinalizer(ctx, r.Client, container, customresource.SetFinalizer); err != nil {
+			result = workflow.Terminate(workflow.Internal, err.Error())
+			log.Errorw("failed to add finalizer", "error", err)
+			return result.ReconcileResult(), nil
+		}
+	}
+
+	if project.ID() == "" {
+		result = workflow.InProgress(workflow.NetworkContainerProjectNotReady, fmt.Sprintf("waiting for the project %s to be created in Atlas", project.Spec.Name))
+		workflowCtx.SetConditionFromResult(status.NetworkContainerReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+
+	inUse, err := r.containersInUse(ctx, container, project)
+	if err != nil {
+		result = workflow.Terminate(workflow.Internal, fmt.Sprintf("failed to list the network containers in use: %s", err))
+		workflowCtx.SetConditionFromResult(status.NetworkContainerReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+
+	result = ensureNetworkContainer(workflowCtx, container, project.ID(), inUse)
+	workflowCtx.SetConditionFromResult(status.NetworkContainerReadyType, result)
+	workflowCtx.SetConditionFromResult(status.ReadyType, result)
+
+	return result.ReconcileResult(), nil
+}
+
+// containersInUse returns the ids of the containers of the project used by its network peers or managed by other
+// AtlasNetworkContainer resources
+func (r *AtlasNetworkContainerReconciler) containersInUse(ctx context.Context, container *mdbv1.AtlasNetworkContainer, project *mdbv1.AtlasProject) ([]string, error) {
+	var ids []string
+	for _, peer := range project.Status.NetworkPeers {
+		if peer.ContainerID != "" {
+			ids = append(ids, peer.ContainerID)
+		}
+	}
+
+	containers := &mdbv1.AtlasNetworkContainerList{}
+	if err := r.Client.List(ctx, containers); err != nil {
+		return nil, err
+	}
+
+	for i := range containers.Items {
+		other := &containers.Items[i]
+		if other.Status.ID == "" || other.AtlasProjectObjectKey() != container.AtlasProjectObjectKey() {
+			continue
+		}
+		if kube.ObjectKeyFromObject(other) != kube.ObjectKeyFromObject(container) {
+			ids = append(ids, other.Status.ID)
+		}
+	}
+
+	return ids, nil
+}
+
+func (r *AtlasNetworkContainerReconciler) handleDeletion(ctx *workflow.Context, container *mdbv1.AtlasNetworkContainer, projectID string) workflow.Result {
+	if !customresource.HaveFinalizer(container, customresource.FinalizerLabel) {
+		return workflow.OK()
+	}
+
+	if customresource.IsResourcePolicyKeepOrDefault(container, r.ObjectDeletionProtection) {
+		ctx.Log.Info("Not removing the network container from Atlas as per configuration")
+	} else if container.Status.ID != "" && projectID != "" {
+		if err := deleteNetworkContainer(ctx, projectID, container.Status.ID); err != nil {
+			result := workflow.Terminate(workflow.NetworkContainerNotDeletedInAtlas, err.Error())
+			ctx.SetConditionFromResult(status.NetworkContainerReadyType, result)
+			return result
+		}
+	}
+
+	return r.removeFinalizer(ctx, container)
+}
+
+func (r *AtlasNetworkContainerReconciler) removeFinalizer(ctx *workflow.Context, container *mdbv1.AtlasNetworkContainer) workflow.Result {
+	if err := customresource.ManageFinalizer(ctx.Context, r.Client, container, customresource.UnsetFinalizer); err != nil {
+		ctx.Log.Errorw("failed to remove finalizer", "error", err)
+		return workflow.Terminate(workflow.AtlasFinalizerNotRemoved, err.Error())
+	}
+
+	return workflow.OK()
+}
+
+func (r *AtlasNetworkContainerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("AtlasNetworkContainer").
+		For(&mdbv1.AtlasNetworkContainer{}, builder.WithPredicates(r.GlobalPredicates...)).
+		Watches(&mdbv1.AtlasProject{}, watch.NewProjectDependentsHandler(r.Client, &mdbv1.AtlasNetworkContainerList{}), builder.WithPredicates(watch.ProjectOrganizationChanged())).
+		Complete(r)
+}
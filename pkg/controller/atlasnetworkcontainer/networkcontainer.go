@@ -0,0 +1,184 @@
+package atlasnetworkcontainer
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func validateNetworkContainer(container *mdbv1.AtlasNetworkContainer) error {
+	switch container.GetProvider() {
+	case provider.ProviderGCP:
+		if container.Spec.Region != "" {
+			return errors.New("the region can't be set for a GCP container, set the regions instead")
+		}
+	default:
+		if container.Spec.Region == "" {
+			return fmt.Errorf("the region is required for an %s container", container.GetProvider())
+		}
+		if len(container.Spec.Regions) > 0 {
+			return errors.New("the regions can only be set for a GCP container")
+		}
+	}
+
+	return nil
+}
+
+// toAtlas converts the spec of the container to its Atlas representation
+func toAtlas(container *mdbv1.AtlasNetworkContainer) *admin.CloudProviderContainer {
+	atlasContainer := &admin.CloudProviderContainer{
+		ProviderName:   pointer.MakePtr(string(container.GetProvider())),
+		AtlasCidrBlock: pointer.MakePtr(container.Spec.AtlasCIDRBlock),
+	}
+
+	switch container.GetProvider() {
+	case provider.ProviderAWS:
+		atlasContainer.RegionName = pointer.MakePtr(container.Spec.Region)
+	case provider.ProviderAzure:
+		atlasContainer.Region = pointer.MakePtr(container.Spec.Region)
+	case provider.ProviderGCP:
+		if len(container.Spec.Regions) > 0 {
+			atlasContainer.Regions = pointer.MakePtr(container.Spec.Regions)
+		}
+	}
+
+	return atlasContainer
+}
+
+// containerRegion returns the region Atlas holds a single container of the provider for, empty for GCP
+func containerRegion(container *admin.CloudProviderContainer) string {
+	if container.GetProviderName() == string(provider.ProviderAzure) {
+		return container.GetRegion()
+	}
+
+	return container.GetRegionName()
+}
+
+// MatchesRegion tells whether the Atlas container is the one of the provider and region of the resource, as Atlas holds a
+// single container per provider and region, and a single GCP container
+func MatchesRegion(container *mdbv1.AtlasNetworkContainer, existing *admin.CloudProviderContainer) bool {
+	if existing.GetProviderName() != string(container.GetProvider()) {
+		return false
+	}
+
+	return container.GetProvider() == provider.ProviderGCP || containerRegion(existing) == container.Spec.Region
+}
+
+// containerMatches compares the settings of the containers Atlas can update
+func containerMatches(desired, existing *admin.CloudProviderContainer) bool {
+	if desired.GetAtlasCidrBlock() != existing.GetAtlasCidrBlock() {
+		return false
+	}
+
+	if desired.Regions == nil {
+		return true
+	}
+
+	desiredRegions := append([]string{}, desired.GetRegions()...)
+	existingRegions := append([]string{}, existing.GetRegions()...)
+	sort.Strings(desiredRegions)
+	sort.Strings(existingRegions)
+
+	return fmt.Sprint(desiredRegions) == fmt.Sprint(existingRegions)
+}
+
+// ensureNetworkContainer creates or updates the container in Atlas. The container is found by its id, or by its
+// provider and region when it isn't known yet, so that the existing containers are adopted. The containers in use by the
+// project or by other resources are never adopted
+func ensureNetworkContainer(ctx *workflow.Context, container *mdbv1.AtlasNetworkContainer, projectID string, inUse []string) workflow.Result {
+	if err := validateNetworkContainer(container); err != nil {
+		return workflow.Terminate(workflow.NetworkContainerInvalidSpec, err.Error()).WithoutRetry()
+	}
+
+	existing, err := readNetworkContainer(ctx, container, projectID, inUse)
+	if err != nil {
+		return workflow.Terminate(workflow.NetworkContainerNotUpdatedInAtlas, err.Error())
+	}
+
+	desired := toAtlas(container)
+	switch {
+	case existing == nil:
+		ctx.Log.Infow("Creating the network container", "provider", container.GetProvider(), "region", container.Spec.Region)
+		existing, _, err = ctx.SdkClient.NetworkPeeringApi.CreatePeeringContainer(ctx.Context, projectID, desired).Execute()
+		if err != nil {
+			return workflow.Terminate(workflow.NetworkContainerNotCreatedInAtlas, err.Error())
+		}
+	case !containerMatches(desired, existing):
+		ctx.Log.Infow("Updating the network container", "containerID", existing.GetId())
+		existing, _, err = ctx.SdkClient.NetworkPeeringApi.UpdatePeeringContainer(ctx.Context, projectID, existing.GetId(), desired).Execute()
+		if err != nil {
+			return workflow.Terminate(workflow.NetworkContainerNotUpdatedInAtlas, err.Error())
+		}
+	}
+
+	ctx.EnsureStatusOption(status.AtlasNetworkContainerOption(
+		existing.GetId(),
+		existing.GetProvisioned(),
+		existing.GetVpcId(),
+		existing.GetGcpProjectId(),
+		existing.GetNetworkName(),
+		existing.GetVnetName(),
+	))
+
+	return workflow.OK()
+}
+
+func readNetworkContainer(ctx *workflow.Context, container *mdbv1.AtlasNetworkContainer, projectID string, inUse []string) (*admin.CloudProviderContainer, error) {
+	if container.Status.ID != "" {
+		existing, resp, err := ctx.SdkClient.NetworkPeeringApi.GetPeeringContainer(ctx.Context, projectID, container.Status.ID).Execute()
+		if err == nil {
+			return existing, nil
+		}
+		if !isNotFound(resp) {
+			return nil, err
+		}
+	}
+
+	return findNetworkContainer(ctx, container, projectID, inUse)
+}
+
+func findNetworkContainer(ctx *workflow.Context, container *mdbv1.AtlasNetworkContainer, projectID string, inUse []string) (*admin.CloudProviderContainer, error) {
+	containers, _, err := ctx.SdkClient.NetworkPeeringApi.ListPeeringContainerByCloudProvider(ctx.Context, projectID).
+		ProviderName(string(container.GetProvider())).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, existing := range containers.GetResults() {
+		if !MatchesRegion(container, &existing) {
+			continue
+		}
+
+		if slices.Contains(inUse, existing.GetId()) {
+			return nil, fmt.Errorf("the network container %s is already in use by the network peers of the project or by another AtlasNetworkContainer, it can't be adopted", existing.GetId())
+		}
+
+		return &existing, nil
+	}
+
+	return nil, nil
+}
+
+func deleteNetworkContainer(ctx *workflow.Context, projectID, containerID string) error {
+	_, resp, err := ctx.SdkClient.NetworkPeeringApi.DeletePeeringContainer(ctx.Context, projectID, containerID).Execute()
+	if err != nil && !isNotFound(resp) {
+		return err
+	}
+
+	return nil
+}
+
+func isNotFound(resp *http.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,237 @@
+package atlasnetworkcontainer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	"go.uber.org/zap/zaptest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func newNetworkContainer(spec mdbv1.AtlasNetworkContainerSpec) *mdbv1.AtlasNetworkContainer {
+	spec.Project = common.ResourceRefNamespaced{Name: "project"}
+
+	return &mdbv1.AtlasNetworkContainer{
+		ObjectMeta: metav1.ObjectMeta{Name: "container", Namespace: "default"},
+		Spec:       spec,
+	}
+}
+
+func newContext(t *testing.T, api admin.NetworkPeeringApi) *workflow.Context {
+	ctx := workflow.NewContext(zaptest.NewLogger(t).Sugar(), []status.Condition{}, context.Background())
+	ctx.SdkClient = &admin.APIClient{NetworkPeeringApi: api}
+
+	return ctx
+}
+
+func reconciledStatus(ctx *workflow.Context, container *mdbv1.AtlasNetworkContainer) status.AtlasNetworkContainerStatus {
+	container.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+
+	return container.Status
+}
+
+func TestValidateNetworkContainer(t *testing.T) {
+	t.Run("should require the region of an AWS container", func(t *testing.T) {
+		err := validateNetworkContainer(newNetworkContainer(mdbv1.AtlasNetworkContainerSpec{AtlasCIDRBlock: "10.8.0.0/21"}))
+
+		require.EqualError(t, err, "the region is required for an AWS container")
+	})
+
+	t.Run("should reject the region of a GCP container", func(t *testing.T) {
+		err := validateNetworkContainer(newNetworkContainer(mdbv1.AtlasNetworkContainerSpec{
+			Provider:       provider.ProviderGCP,
+			Region:         "US_EAST_4",
+			AtlasCIDRBlock: "10.8.0.0/18",
+		}))
+
+		require.EqualError(t, err, "the region can't be set for a GCP container, set the regions instead")
+	})
+
+	t.Run("should reject the regions of an Azure container", func(t *testing.T) {
+		err := validateNetworkContainer(newNetworkContainer(mdbv1.AtlasNetworkContainerSpec{
+			Provider:       provider.ProviderAzure,
+			Region:         "US_EAST_2",
+			Regions:        []string{"US_EAST_2"},
+			AtlasCIDRBlock: "10.8.0.0/21",
+		}))
+
+		require.EqualError(t, err, "the regions can only be set for a GCP container")
+	})
+}
+
+func TestEnsureNetworkContainer(t *testing.T) {
+	awsContainer := mdbv1.AtlasNetworkContainerSpec{Region: "US_EAST_1", AtlasCIDRBlock: "10.8.0.0/21"}
+
+	t.Run("should create the container", func(t *testing.T) {
+		api := atlasmock.NewNetworkPeeringApiMock(t)
+		api.EXPECT().ListPeeringContainerByCloudProvider(mock.Anything, "project-id").
+			Return(admin.ListPeeringContainerByCloudProviderApiRequest{ApiService: api})
+		api.EXPECT().ListPeeringContainerByCloudProviderExecute(mock.Anything).Return(
+			&admin.PaginatedCloudProviderContainer{Results: &[]admin.CloudProviderContainer{
+				{Id: admin.PtrString("other-id"), ProviderName: admin.PtrString("AWS"), RegionName: admin.PtrString("EU_WEST_1")},
+			}}, nil, nil,
+		)
+		api.EXPECT().CreatePeeringContainer(mock.Anything, "project-id", &admin.CloudProviderContainer{
+			ProviderName:   admin.PtrString("AWS"),
+			AtlasCidrBlock: admin.PtrString("10.8.0.0/21"),
+			RegionName:     admin.PtrString("US_EAST_1"),
+		}).Return(admin.CreatePeeringContainerApiRequest{ApiService: api})
+		api.EXPECT().CreatePeeringContainerExecute(mock.Anything).Return(
+			&admin.CloudProviderContainer{Id: admin.PtrString("container-id"), VpcId: admin.PtrString("vpc-1")}, nil, nil,
+		)
+		ctx := newContext(t, api)
+		container := newNetworkContainer(awsContainer)
+
+		result := ensureNetworkContainer(ctx, container, "project-id", nil)
+
+		require.True(t, result.IsOk())
+		containerStatus := reconciledStatus(ctx, container)
+		assert.Equal(t, "container-id", containerStatus.ID)
+		assert.Equal(t, "vpc-1", containerStatus.VpcID)
+	})
+
+	t.Run("should adopt the container of the region and update its CIDR block", func(t *testing.T) {
+		api := atlasmock.NewNetworkPeeringApiMock(t)
+		api.EXPECT().ListPeeringContainerByCloudProvider(mock.Anything, "project-id").
+			Return(admin.ListPeeringContainerByCloudProviderApiRequest{ApiService: api})
+		api.EXPECT().ListPeeringContainerByCloudProviderExecute(mock.Anything).Return(
+			&admin.PaginatedCloudProviderContainer{Results: &[]admin.CloudProviderContainer{
+				{Id: admin.PtrString("container-id"), ProviderName: admin.PtrString("AWS"), RegionName: admin.PtrString("US_EAST_1"), AtlasCidrBlock: admin.PtrString("10.9.0.0/21")},
+			}}, nil, nil,
+		)
+		api.EXPECT().UpdatePeeringContainer(mock.Anything, "project-id", "container-id", mock.Anything).
+			Return(admin.UpdatePeeringContainerApiRequest{ApiService: api})
+		api.EXPECT().UpdatePeeringContainerExecute(mock.Anything).Return(
+			&admin.CloudProviderContainer{Id: admin.PtrString("container-id"), AtlasCidrBlock: admin.PtrString("10.8.0.0/21")}, nil, nil,
+		)
+		ctx := newContext(t, api)
+		container := newNetworkContainer(awsContainer)
+
+		result := ensureNetworkContainer(ctx, container, "project-id", nil)
+
+		require.True(t, result.IsOk())
+		assert.Equal(t, "container-id", reconciledStatus(ctx, container).ID)
+	})
+
+	t.Run("should not adopt a container in use", func(t *testing.T) {
+		api := atlasmock.NewNetworkPeeringApiMock(t)
+		api.EXPECT().ListPeeringContainerByCloudProvider(mock.Anything, "project-id").
+			Return(admin.ListPeeringContainerByCloudProviderApiRequest{ApiService: api})
+		api.EXPECT().ListPeeringContainerByCloudProviderExecute(mock.Anything).Return(
+			&admin.PaginatedCloudProviderContainer{Results: &[]admin.CloudProviderContainer{
+				{Id: admin.PtrString("container-id"), ProviderName: admin.PtrString("AWS"), RegionName: admin.PtrString("US_EAST_1"), AtlasCidrBlock: admin.PtrString("10.9.0.0/21")},
+			}}, nil, nil,
+		)
+		ctx := newContext(t, api)
+
+		result := ensureNetworkContainer(ctx, newNetworkContainer(awsContainer), "project-id", []string{"container-id"})
+
+		assert.Equal(t, workflow.Terminate(
+			workflow.NetworkContainerNotUpdatedInAtlas,
+			"the network container container-id is already in use by the network peers of the project or by another AtlasNetworkContainer, it can't be adopted",
+		), result)
+	})
+
+	t.Run("should find the container again when it was removed from Atlas", func(t *testing.T) {
+		api := atlasmock.NewNetworkPeeringApiMock(t)
+		api.EXPECT().GetPeeringContainer(mock.Anything, "project-id", "removed-id").
+			Return(admin.GetPeeringContainerApiRequest{ApiService: api})
+		api.EXPECT().GetPeeringContainerExecute(mock.Anything).Return(
+			nil, &http.Response{StatusCode: http.StatusNotFound}, &admin.GenericOpenAPIError{},
+		)
+		api.EXPECT().ListPeeringContainerByCloudProvider(mock.Anything, "project-id").
+			Return(admin.ListPeeringContainerByCloudProviderApiRequest{ApiService: api})
+		api.EXPECT().ListPeeringContainerByCloudProviderExecute(mock.Anything).Return(
+			&admin.PaginatedCloudProviderContainer{Results: &[]admin.CloudProviderContainer{
+				{Id: admin.PtrString("container-id"), ProviderName: admin.PtrString("AWS"), RegionName: admin.PtrString("US_EAST_1"), AtlasCidrBlock: admin.PtrString("10.8.0.0/21")},
+			}}, nil, nil,
+		)
+		ctx := newContext(t, api)
+		container := newNetworkContainer(awsContainer)
+		container.Status.ID = "removed-id"
+
+		result := ensureNetworkContainer(ctx, container, "project-id", nil)
+
+		require.True(t, result.IsOk())
+		assert.Equal(t, "container-id", reconciledStatus(ctx, container).ID)
+	})
+
+	t.Run("should not update a GCP container with the same regions", func(t *testing.T) {
+		api := atlasmock.NewNetworkPeeringApiMock(t)
+		api.EXPECT().GetPeeringContainer(mock.Anything, "project-id", "container-id").
+			Return(admin.GetPeeringContainerApiRequest{ApiService: api})
+		api.EXPECT().GetPeeringContainerExecute(mock.Anything).Return(
+			&admin.CloudProviderContainer{
+				Id:             admin.PtrString("container-id"),
+				ProviderName:   admin.PtrString("GCP"),
+				AtlasCidrBlock: admin.PtrString("10.8.0.0/18"),
+				Regions:        &[]string{"US_EAST_4", "EUROPE_WEST_1"},
+				NetworkName:    admin.PtrString("nt-1"),
+			}, nil, nil,
+		)
+		ctx := newContext(t, api)
+		container := newNetworkContainer(mdbv1.AtlasNetworkContainerSpec{
+			Provider:       provider.ProviderGCP,
+			Regions:        []string{"EUROPE_WEST_1", "US_EAST_4"},
+			AtlasCIDRBlock: "10.8.0.0/18",
+		})
+		container.Status.ID = "container-id"
+
+		result := ensureNetworkContainer(ctx, container, "project-id", nil)
+
+		require.True(t, result.IsOk())
+		assert.Equal(t, "nt-1", reconciledStatus(ctx, container).NetworkName)
+	})
+
+	t.Run("should not retry an invalid spec", func(t *testing.T) {
+		ctx := newContext(t, atlasmock.NewNetworkPeeringApiMock(t))
+
+		result := ensureNetworkContainer(ctx, newNetworkContainer(mdbv1.AtlasNetworkContainerSpec{AtlasCIDRBlock: "10.8.0.0/21"}), "project-id", nil)
+
+		assert.Equal(t, workflow.Terminate(workflow.NetworkContainerInvalidSpec, "the region is required for an AWS container").WithoutRetry(), result)
+	})
+}
+
+func TestContainersInUse(t *testing.T) {
+	t.Run("should return the containers of the peers and of the other resources of the project", func(t *testing.T) {
+		sch := runtime.NewScheme()
+		require.NoError(t, mdbv1.AddToScheme(sch))
+		withID := func(container *mdbv1.AtlasNetworkContainer, name, id string) *mdbv1.AtlasNetworkContainer {
+			container.Name = name
+			container.Status.ID = id
+			return container
+		}
+		container := withID(newNetworkContainer(mdbv1.AtlasNetworkContainerSpec{}), "container", "container-1")
+		otherProject := newNetworkContainer(mdbv1.AtlasNetworkContainerSpec{})
+		otherProject.Spec.Project.Name = "other"
+		r := &AtlasNetworkContainerReconciler{
+			Client: fake.NewClientBuilder().WithScheme(sch).WithObjects(
+				container,
+				withID(newNetworkContainer(mdbv1.AtlasNetworkContainerSpec{}), "other", "container-2"),
+				withID(newNetworkContainer(mdbv1.AtlasNetworkContainerSpec{}), "not-created", ""),
+				withID(otherProject, "other-project", "container-3"),
+			).Build(),
+		}
+		project := mdbv1.NewProject("default", "project", "project")
+		project.Status.NetworkPeers = []status.AtlasNetworkPeer{{ContainerID: "container-4"}, {}}
+
+		ids, err := r.containersInUse(context.Background(), container, project)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"container-4", "container-2"}, ids)
+	})
+}
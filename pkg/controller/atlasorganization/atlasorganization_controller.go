@@ -0,0 +1,192 @@
+package atlasorganization
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/notifier"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/statushandler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/watch"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// AtlasOrganizationReconciler reconciles an AtlasOrganization object
+type AtlasOrganizationReconciler struct {
+	watch.ResourceWatcher
+	Client                   client.Client
+	Log                      *zap.SugaredLogger
+	Scheme                   *runtime.Scheme
+	GlobalPredicates         []predicate.Predicate
+	EventRecorder            record.EventRecorder
+	FailureNotifier          *notifier.Notifier
+	AtlasProvider            atlas.Provider
+	ObjectDeletionProtection bool
+}
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasorganizations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasorganizations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasorganizations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasorganizations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",namespace=default,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+func (r *AtlasOrganizationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, _ error) {
+	log := r.Log.With("atlasorganization", req.NamespacedName)
+
+	org := &mdbv1.AtlasOrganization{}
+	result := customresource.PrepareResource(ctx, r.Client, req, org, log)
+	if !result.IsOk() {
+		return result.ReconcileResult(), nil
+	}
+
+	if customresource.ReconciliationShouldBeSkipped(org) {
+		log.Infow(fmt.Sprintf("-> Skipping AtlasOrganization reconciliation as annotation %s=%s", customresource.ReconciliationPolicyAnnotation, customresource.ReconciliationPolicySkip), "spec", org.Spec)
+		if !org.GetDeletionTimestamp().IsZero() {
+			if err := customresource.ManageFinalizer(ctx, r.Client, org, customresource.UnsetFinalizer); err != nil {
+				log.Errorw("failed to remove finalizer", "error", err)
+				return workflow.Terminate(workflow.Internal, err.Error()).ReconcileResult(), nil
+			}
+		}
+		return workflow.OK().ReconcileResult(), nil
+	}
+
+	if customresource.NamespaceReconciliationPaused(ctx, r.Client, org.Namespace, log) {
+		log.Infow(fmt.Sprintf("-> Pausing AtlasOrganization reconciliation as annotation %s=true is set on the namespace", customresource.ReconciliationPausedAnnotation))
+		return customresource.MarkReconciliationPaused(ctx, r.Client, r.EventRecorder, org, log).ReconcileResult(), nil
+	}
+
+	workflowCtx := customresource.MarkReconciliationStarted(r.Client, org, log, ctx)
+	log.Infow("-> Starting AtlasOrganization reconciliation", "spec", org.Spec, "status", org.Status)
+
+	if workflowCtx.Degraded(org) {
+		log.Infow("-> Skipping the reconciliation of the degraded AtlasOrganization, change its spec or set the reapply annotation to retry")
+		return ctrl.Result{}, nil
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			res = workflowCtx.RecoverPanic("AtlasOrganization", p).ReconcileResult()
+		}
+		res = workflowCtx.CapRetries("AtlasOrganization", org, res)
+		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, r.FailureNotifier, org)
+	}()
+
+	resourceVersionIsValid := customresource.ValidateResourceVersion(workflowCtx, org, r.Log)
+	if !resourceVersionIsValid.IsOk() {
+		r.Log.Debugf("AtlasOrganization validation result: %v", resourceVersionIsValid)
+		return resourceVersionIsValid.ReconcileResult(), nil
+	}
+
+	// the Secret of the organization is watched so that writing a new key into it recovers a missing one
+	secretKeys := []client.ObjectKey{org.SecretObjectKey()}
+	if key := org.ConnectionSecretObjectKey(); key != nil {
+		secretKeys = append(secretKeys, *key)
+	}
+	r.EnsureResourcesAreWatched(req.NamespacedName, "Secret", log, secretKeys...)
+	if !org.GetDeletionTimestamp().IsZero() {
+		return r.handleDeletion(workflowCtx, org).ReconcileResult(), nil
+	}
+
+	if !customresource.HaveFinalizer(org, customresource.FinalizerLabel) {
+		if err := customresource.ManageFinalizer(ctx, r.Client, org, customresource.SetFinalizer); err != nil {
+			result = workflow.Terminate(workflow.Internal, err.Error())
+			log.Errorw("failed to add finalizer", "error", err)
+			return result.ReconcileResult(), nil
+		}
+	}
+
+	// the organization is created with the keys of the paying organization and managed with its own keys afterwards
+	if org.Status.ID == "" {
+		atlasClient, _, err := r.AtlasProvider.SdkClient(workflowCtx.Context, org.ConnectionSecretObjectKey(), log)
+		if err != nil {
+			result = workflow.Terminate(workflow.AtlasAPIAccessNotConfigured, err.Error())
+			workflowCtx.SetConditionFromResult(status.OrganizationReadyType, result)
+			return result.ReconcileResult(), nil
+		}
+		workflowCtx.SdkClient = atlasClient
+
+		result = r.createOrganization(workflowCtx, org)
+	} else {
+		if result = r.useOrganizationKeys(workflowCtx, org); !result.IsOk() {
+			workflowCtx.SetConditionFromResult(status.OrganizationReadyType, result)
+			return result.ReconcileResult(), nil
+		}
+
+		result = ensureOrganization(workflowCtx, org)
+	}
+	if !result.IsOk() {
+		workflowCtx.SetConditionFromResult(status.OrganizationReadyType, result)
+	}
+	workflowCtx.SetConditionFromResult(status.ReadyType, result)
+
+	return result.ReconcileResult(), nil
+}
+
+// useOrganizationKeys sets up the Atlas client with the API key the organization was created with
+func (r *AtlasOrganizationReconciler) useOrganizationKeys(ctx *workflow.Context, org *mdbv1.AtlasOrganization) workflow.Result {
+	secretKey := org.SecretObjectKey()
+	atlasClient, orgID, err := r.AtlasProvider.SdkClient(ctx.Context, &secretKey, ctx.Log)
+	if err != nil {
+		return workflow.Terminate(
+			workflow.OrganizationSecretMissing,
+			fmt.Sprintf("failed to read the API key of the organization %s from the Secret %s, a key of the organization must be written into it: %s", org.Status.ID, secretKey, err),
+		)
+	}
+	ctx.SdkClient = atlasClient
+	ctx.OrgID = orgID
+
+	return workflow.OK()
+}
+
+func (r *AtlasOrganizationReconciler) handleDeletion(ctx *workflow.Context, org *mdbv1.AtlasOrganization) workflow.Result {
+	if !customresource.HaveFinalizer(org, customresource.FinalizerLabel) {
+		return workflow.OK()
+	}
+
+	if customresource.IsResourcePolicyKeepOrDefault(org, r.ObjectDeletionProtection) {
+		ctx.Log.Info("Not removing the organization from Atlas as per configuration")
+	} else if org.Status.ID != "" {
+		result := r.useOrganizationKeys(ctx, org)
+		if result.IsOk() {
+			result = deleteOrganization(ctx, org)
+		}
+		if !result.IsOk() {
+			ctx.SetConditionFromResult(status.OrganizationReadyType, result)
+			return result
+		}
+
+		if err := r.deleteSecret(ctx.Context, org); err != nil {
+			ctx.Log.Errorw("failed to delete the Secret with the API key of the removed organization", "error", err)
+			return workflow.Terminate(workflow.Internal, err.Error())
+		}
+	}
+
+	if err := customresource.ManageFinalizer(ctx.Context, r.Client, org, customresource.UnsetFinalizer); err != nil {
+		ctx.Log.Errorw("failed to remove finalizer", "error", err)
+		return workflow.Terminate(workflow.AtlasFinalizerNotRemoved, err.Error())
+	}
+
+	return workflow.OK()
+}
+
+func (r *AtlasOrganizationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("AtlasOrganization").
+		For(&mdbv1.AtlasOrganization{}, builder.WithPredicates(r.GlobalPredicates...)).
+		Watches(&corev1.Secret{}, watch.NewSecretHandler(r.WatchedResources), builder.OnlyMetadata).
+		Complete(r)
+}
@@ -0,0 +1,173 @@
+package atlasorganization
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/connectionsecret"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// organizationAnnotation is set on the Secret written with the API key of a created organization to the resource
+// that created it, so that an organization whose ID couldn't be recorded in the status is recovered from the Secret
+// rather than created again
+const organizationAnnotation = "mongodb.com/atlas-organization"
+
+// createOrganization creates the organization billed to the paying organization and writes the API key created with
+// it into the Secret. Atlas returns the private part of the key only once, so the organization can't be managed by
+// the operator anymore if the Secret can't be written: the Secret is written with retries and the ID of the
+// organization is recorded in the status right away, so that it's never created twice
+func (r *AtlasOrganizationReconciler) createOrganization(ctx *workflow.Context, org *mdbv1.AtlasOrganization) workflow.Result {
+	orgID, err := r.recoverOrganizationID(ctx.Context, org)
+	if err != nil {
+		return workflow.Terminate(workflow.OrganizationNotCreatedInAtlas, fmt.Sprintf("failed to read the Secret %s: %s", org.SecretObjectKey(), err))
+	}
+	if orgID != "" {
+		ctx.Log.Infow("Recovered the ID of the organization created before from its Secret", "orgID", orgID)
+		ctx.EnsureStatusOption(status.AtlasOrganizationIDOption(orgID, org.Status.APIKeyID))
+		if err = r.recordOrganizationID(ctx.Context, org, orgID, org.Status.APIKeyID); err != nil {
+			ctx.Log.Errorw("failed to record the ID of the organization in the status", "error", err)
+		}
+		ctx.SetConditionTrue(status.OrganizationReadyType)
+
+		return workflow.OK()
+	}
+
+	request := &admin.CreateOrganizationRequest{
+		Name:       org.Spec.Name,
+		OrgOwnerId: &org.Spec.OwnerID,
+		ApiKey: &admin.CreateAtlasOrganizationApiKey{
+			Desc:  fmt.Sprintf("%s/%s", org.Namespace, org.Name),
+			Roles: org.Spec.APIKeyRoleNames(),
+		},
+	}
+	if org.Spec.FederationSettingsID != "" {
+		request.SetFederationSettingsId(org.Spec.FederationSettingsID)
+	}
+
+	response, _, err := ctx.SdkClient.OrganizationsApi.CreateOrganization(ctx.Context, request).Execute()
+	if err != nil {
+		return workflow.Terminate(workflow.OrganizationNotCreatedInAtlas, fmt.Sprintf("failed to create the organization: %s", err))
+	}
+
+	atlasOrg := response.GetOrganization()
+	orgID = atlasOrg.GetId()
+	apiKey := response.GetApiKey()
+	ctx.EnsureStatusOption(status.AtlasOrganizationIDOption(orgID, apiKey.GetId()))
+
+	secretErr := retry.OnError(retry.DefaultBackoff, func(error) bool { return true }, func() error {
+		return r.writeSecret(ctx.Context, org, orgID, apiKey.GetPublicKey(), apiKey.GetPrivateKey())
+	})
+	// the Secret also holds the ID, so the organization is recovered from it when the status can't be written
+	if err = r.recordOrganizationID(ctx.Context, org, orgID, apiKey.GetId()); err != nil {
+		ctx.Log.Errorw("failed to record the ID of the created organization in the status", "orgID", orgID, "error", err)
+	}
+	if secretErr != nil {
+		return workflow.Terminate(
+			workflow.OrganizationSecretNotWritten,
+			fmt.Sprintf("the organization %s was created but its API key couldn't be written into the Secret %s, a new key of the organization must be created and written into it: %s", orgID, org.SecretObjectKey(), secretErr),
+		)
+	}
+	ctx.SetConditionTrue(status.OrganizationReadyType)
+
+	return workflow.OK()
+}
+
+// recoverOrganizationID returns the ID of the organization held by the Secret written when the resource created it, or
+// an empty ID when there is no such Secret
+func (r *AtlasOrganizationReconciler) recoverOrganizationID(ctx context.Context, org *mdbv1.AtlasOrganization) (string, error) {
+	secret := &corev1.Secret{}
+	if err := r.Client.Get(ctx, org.SecretObjectKey(), secret); err != nil {
+		return "", client.IgnoreNotFound(err)
+	}
+	if secret.Annotations[organizationAnnotation] != client.ObjectKeyFromObject(org).String() {
+		return "", nil
+	}
+
+	return atlas.SecretOrgID(secret), nil
+}
+
+// recordOrganizationID patches the ID of the created organization into the status immediately, rather than waiting
+// for the status update at the end of the reconciliation
+func (r *AtlasOrganizationReconciler) recordOrganizationID(ctx context.Context, org *mdbv1.AtlasOrganization, orgID, apiKeyID string) error {
+	patch := client.MergeFrom(org.DeepCopy())
+	org.Status.ID = orgID
+	org.Status.APIKeyID = apiKeyID
+
+	return r.Client.Status().Patch(ctx, org, patch)
+}
+
+// ensureOrganization keeps the name of the organization in sync
+func ensureOrganization(ctx *workflow.Context, org *mdbv1.AtlasOrganization) workflow.Result {
+	atlasOrg, _, err := ctx.SdkClient.OrganizationsApi.GetOrganization(ctx.Context, org.Status.ID).Execute()
+	if err != nil {
+		return workflow.Terminate(workflow.OrganizationNotUpdatedInAtlas, fmt.Sprintf("failed to retrieve the organization: %s", err))
+	}
+
+	if atlasOrg.GetName() != org.Spec.Name {
+		_, _, err = ctx.SdkClient.OrganizationsApi.
+			RenameOrganization(ctx.Context, org.Status.ID, &admin.AtlasOrganization{Name: org.Spec.Name}).
+			Execute()
+		if err != nil {
+			return workflow.Terminate(workflow.OrganizationNotUpdatedInAtlas, fmt.Sprintf("failed to rename the organization: %s", err))
+		}
+	}
+	ctx.SetConditionTrue(status.OrganizationReadyType)
+
+	return workflow.OK()
+}
+
+// deleteOrganization removes the organization, which Atlas only allows once all its projects are removed
+func deleteOrganization(ctx *workflow.Context, org *mdbv1.AtlasOrganization) workflow.Result {
+	_, resp, err := ctx.SdkClient.OrganizationsApi.DeleteOrganization(ctx.Context, org.Status.ID).Execute()
+	if err != nil && !isNotFound(resp) {
+		return workflow.Terminate(workflow.OrganizationNotDeletedInAtlas, fmt.Sprintf("failed to delete the organization: %s", err))
+	}
+
+	return workflow.OK()
+}
+
+// writeSecret writes the API key into the Secret, with the layout of the Atlas credentials Secrets so that it can be
+// referenced by the AtlasProjects of the organization. The Secret isn't owned by the resource, the key remains
+// available when the organization is kept in Atlas after the resource is deleted
+func (r *AtlasOrganizationReconciler) writeSecret(ctx context.Context, org *mdbv1.AtlasOrganization, orgID, publicKey, privateKey string) error {
+	secretKey := org.SecretObjectKey()
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: secretKey.Namespace, Name: secretKey.Name}}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if secret.Labels == nil {
+			secret.Labels = map[string]string{}
+		}
+		secret.Labels[connectionsecret.TypeLabelKey] = connectionsecret.CredLabelVal
+		if secret.Annotations == nil {
+			secret.Annotations = map[string]string{}
+		}
+		secret.Annotations[organizationAnnotation] = client.ObjectKeyFromObject(org).String()
+		atlas.SetSecretCredentials(secret, orgID, publicKey, privateKey)
+
+		return nil
+	})
+
+	return err
+}
+
+func (r *AtlasOrganizationReconciler) deleteSecret(ctx context.Context, org *mdbv1.AtlasOrganization) error {
+	secretKey := org.SecretObjectKey()
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: secretKey.Namespace, Name: secretKey.Name}}
+
+	return client.IgnoreNotFound(r.Client.Delete(ctx, secret))
+}
+
+func isNotFound(resp *http.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,199 @@
+package atlasorganization
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func newOrganization() *mdbv1.AtlasOrganization {
+	return &mdbv1.AtlasOrganization{
+		ObjectMeta: metav1.ObjectMeta{Name: "org", Namespace: "default"},
+		Spec: mdbv1.AtlasOrganizationSpec{
+			Name:        "Tenant",
+			OwnerID:     "owner-id",
+			APIKeyRoles: []mdbv1.OrgRole{"ORG_OWNER"},
+		},
+	}
+}
+
+func newContext(t *testing.T, orgAPI admin.OrganizationsApi) *workflow.Context {
+	ctx := workflow.NewContext(zaptest.NewLogger(t).Sugar(), []status.Condition{}, context.Background())
+	ctx.SdkClient = &admin.APIClient{OrganizationsApi: orgAPI}
+
+	return ctx
+}
+
+func TestCreateOrganization(t *testing.T) {
+	t.Run("should create the organization and write its API key into the Secret", func(t *testing.T) {
+		orgAPI := atlasmock.NewOrganizationsApiMock(t)
+		orgAPI.EXPECT().CreateOrganization(mock.Anything, &admin.CreateOrganizationRequest{
+			Name:       "Tenant",
+			OrgOwnerId: admin.PtrString("owner-id"),
+			ApiKey:     &admin.CreateAtlasOrganizationApiKey{Desc: "default/org", Roles: []string{"ORG_OWNER"}},
+		}).Return(admin.CreateOrganizationApiRequest{ApiService: orgAPI})
+		orgAPI.EXPECT().CreateOrganizationExecute(mock.Anything).Return(&admin.CreateOrganizationResponse{
+			Organization: &admin.AtlasOrganization{Id: admin.PtrString("org-id"), Name: "Tenant"},
+			ApiKey: &admin.ApiKeyUserDetails{
+				Id:         admin.PtrString("key-id"),
+				PublicKey:  admin.PtrString("public"),
+				PrivateKey: admin.PtrString("private"),
+			},
+		}, nil, nil)
+		scheme := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(scheme))
+		require.NoError(t, mdbv1.AddToScheme(scheme))
+		org := newOrganization()
+		r := &AtlasOrganizationReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(org).WithStatusSubresource(org).Build(),
+			Scheme: scheme,
+		}
+		ctx := newContext(t, orgAPI)
+
+		result := r.createOrganization(ctx, org)
+
+		require.True(t, result.IsOk())
+		secret := &corev1.Secret{}
+		require.NoError(t, r.Client.Get(context.Background(), org.SecretObjectKey(), secret))
+		assert.Equal(t, "org-id", string(secret.Data["orgId"]))
+		assert.Equal(t, "public", string(secret.Data["publicApiKey"]))
+		assert.Equal(t, "private", string(secret.Data["privateApiKey"]))
+		assert.Equal(t, "credentials", secret.Labels["atlas.mongodb.com/type"])
+		assert.Equal(t, "default/org", secret.Annotations[organizationAnnotation])
+		stored := &mdbv1.AtlasOrganization{}
+		require.NoError(t, r.Client.Get(context.Background(), client.ObjectKeyFromObject(org), stored))
+		assert.Equal(t, "org-id", stored.Status.ID)
+		assert.Equal(t, "key-id", stored.Status.APIKeyID)
+		org.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+		assert.Equal(t, "org-id", org.Status.ID)
+		assert.Equal(t, "key-id", org.Status.APIKeyID)
+	})
+
+	t.Run("should recover the organization created before from its Secret rather than create it again", func(t *testing.T) {
+		scheme := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(scheme))
+		require.NoError(t, mdbv1.AddToScheme(scheme))
+		org := newOrganization()
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        org.SecretObjectKey().Name,
+				Namespace:   org.SecretObjectKey().Namespace,
+				Annotations: map[string]string{organizationAnnotation: "default/org"},
+			},
+			Data: map[string][]byte{"orgId": []byte("org-id")},
+		}
+		r := &AtlasOrganizationReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(org, secret).WithStatusSubresource(org).Build(),
+			Scheme: scheme,
+		}
+		ctx := newContext(t, atlasmock.NewOrganizationsApiMock(t))
+
+		result := r.createOrganization(ctx, org)
+
+		require.True(t, result.IsOk())
+		stored := &mdbv1.AtlasOrganization{}
+		require.NoError(t, r.Client.Get(context.Background(), client.ObjectKeyFromObject(org), stored))
+		assert.Equal(t, "org-id", stored.Status.ID)
+	})
+
+	t.Run("should record the created organization when its Secret can't be written", func(t *testing.T) {
+		orgAPI := atlasmock.NewOrganizationsApiMock(t)
+		orgAPI.EXPECT().CreateOrganization(mock.Anything, mock.Anything).
+			Return(admin.CreateOrganizationApiRequest{ApiService: orgAPI})
+		orgAPI.EXPECT().CreateOrganizationExecute(mock.Anything).Return(&admin.CreateOrganizationResponse{
+			Organization: &admin.AtlasOrganization{Id: admin.PtrString("org-id"), Name: "Tenant"},
+			ApiKey:       &admin.ApiKeyUserDetails{Id: admin.PtrString("key-id")},
+		}, nil, nil)
+		scheme := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(scheme))
+		require.NoError(t, mdbv1.AddToScheme(scheme))
+		org := newOrganization()
+		r := &AtlasOrganizationReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(org).WithStatusSubresource(org).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Create: func(ctx context.Context, client client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+						return assert.AnError
+					},
+				}).Build(),
+			Scheme: scheme,
+		}
+
+		result := r.createOrganization(newContext(t, orgAPI), org)
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.OrganizationSecretNotWritten, result.GetReason())
+		stored := &mdbv1.AtlasOrganization{}
+		require.NoError(t, r.Client.Get(context.Background(), client.ObjectKeyFromObject(org), stored))
+		assert.Equal(t, "org-id", stored.Status.ID)
+	})
+
+	t.Run("should fail when the paying organization can't create organizations", func(t *testing.T) {
+		orgAPI := atlasmock.NewOrganizationsApiMock(t)
+		orgAPI.EXPECT().CreateOrganization(mock.Anything, mock.Anything).
+			Return(admin.CreateOrganizationApiRequest{ApiService: orgAPI})
+		orgAPI.EXPECT().CreateOrganizationExecute(mock.Anything).Return(nil, nil, assert.AnError)
+
+		scheme := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(scheme))
+		r := &AtlasOrganizationReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).Build(), Scheme: scheme}
+
+		result := r.createOrganization(newContext(t, orgAPI), newOrganization())
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.OrganizationNotCreatedInAtlas, result.GetReason())
+	})
+}
+
+func TestEnsureOrganization(t *testing.T) {
+	t.Run("should rename the organization", func(t *testing.T) {
+		orgAPI := atlasmock.NewOrganizationsApiMock(t)
+		orgAPI.EXPECT().GetOrganization(mock.Anything, "org-id").Return(admin.GetOrganizationApiRequest{ApiService: orgAPI})
+		orgAPI.EXPECT().GetOrganizationExecute(mock.Anything).Return(&admin.AtlasOrganization{Name: "Old"}, nil, nil)
+		orgAPI.EXPECT().RenameOrganization(mock.Anything, "org-id", &admin.AtlasOrganization{Name: "Tenant"}).
+			Return(admin.RenameOrganizationApiRequest{ApiService: orgAPI})
+		orgAPI.EXPECT().RenameOrganizationExecute(mock.Anything).Return(nil, nil, nil)
+		org := newOrganization()
+		org.Status.ID = "org-id"
+
+		assert.True(t, ensureOrganization(newContext(t, orgAPI), org).IsOk())
+	})
+
+	t.Run("should leave an organization in sync untouched", func(t *testing.T) {
+		orgAPI := atlasmock.NewOrganizationsApiMock(t)
+		orgAPI.EXPECT().GetOrganization(mock.Anything, "org-id").Return(admin.GetOrganizationApiRequest{ApiService: orgAPI})
+		orgAPI.EXPECT().GetOrganizationExecute(mock.Anything).Return(&admin.AtlasOrganization{Name: "Tenant"}, nil, nil)
+		org := newOrganization()
+		org.Status.ID = "org-id"
+
+		assert.True(t, ensureOrganization(newContext(t, orgAPI), org).IsOk())
+	})
+}
+
+func TestDeleteOrganization(t *testing.T) {
+	t.Run("should consider an organization already gone as deleted", func(t *testing.T) {
+		orgAPI := atlasmock.NewOrganizationsApiMock(t)
+		orgAPI.EXPECT().DeleteOrganization(mock.Anything, "org-id").Return(admin.DeleteOrganizationApiRequest{ApiService: orgAPI})
+		orgAPI.EXPECT().DeleteOrganizationExecute(mock.Anything).Return(nil, &http.Response{StatusCode: http.StatusNotFound}, assert.AnError)
+		org := newOrganization()
+		org.Status.ID = "org-id"
+
+		assert.True(t, deleteOrganization(newContext(t, orgAPI), org).IsOk())
+	})
+}
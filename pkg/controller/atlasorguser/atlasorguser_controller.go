@@ -0,0 +1,188 @@
+package atlasorguser
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/notifier"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/statushandler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/watch"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// AtlasOrgUserReconciler reconciles an AtlasOrgUser object
+type AtlasOrgUserReconciler struct {
+	watch.ResourceWatcher
+	Client                   client.Client
+	Log                      *zap.SugaredLogger
+	Scheme                   *runtime.Scheme
+	GlobalPredicates         []predicate.Predicate
+	EventRecorder            record.EventRecorder
+	FailureNotifier          *notifier.Notifier
+	AtlasProvider            atlas.Provider
+	ObjectDeletionProtection bool
+}
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasorgusers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasorgusers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasorgusers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasorgusers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasteams,verbs=get;list;watch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasteams,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+func (r *AtlasOrgUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, _ error) {
+	log := r.Log.With("atlasorguser", req.NamespacedName)
+
+	orgUser := &mdbv1.AtlasOrgUser{}
+	result := customresource.PrepareResource(ctx, r.Client, req, orgUser, log)
+	if !result.IsOk() {
+		return result.ReconcileResult(), nil
+	}
+
+	if customresource.ReconciliationShouldBeSkipped(orgUser) {
+		log.Infow(fmt.Sprintf("-> Skipping AtlasOrgUser reconciliation as annotation %s=%s", customresource.ReconciliationPolicyAnnotation, customresource.ReconciliationPolicySkip), "spec", orgUser.Spec)
+		if !orgUser.GetDeletionTimestamp().IsZero() {
+			if err := customresource.ManageFinalizer(ctx, r.Client, orgUser, customresource.UnsetFinalizer); err != nil {
+				log.Errorw("failed to remove finalizer", "error", err)
+				return workflow.Terminate(workflow.Internal, err.Error()).ReconcileResult(), nil
+			}
+		}
+		return workflow.OK().ReconcileResult(), nil
+	}
+
+	if customresource.NamespaceReconciliationPaused(ctx, r.Client, orgUser.Namespace, log) {
+		log.Infow(fmt.Sprintf("-> Pausing AtlasOrgUser reconciliation as annotation %s=true is set on the namespace", customresource.ReconciliationPausedAnnotation))
+		return customresource.MarkReconciliationPaused(ctx, r.Client, r.EventRecorder, orgUser, log).ReconcileResult(), nil
+	}
+
+	workflowCtx := customresource.MarkReconciliationStarted(r.Client, orgUser, log, ctx)
+	log.Infow("-> Starting AtlasOrgUser reconciliation", "spec", orgUser.Spec, "status", orgUser.Status)
+
+	if workflowCtx.Degraded(orgUser) {
+		log.Infow("-> Skipping the reconciliation of the degraded AtlasOrgUser, change its spec or set the reapply annotation to retry")
+		return ctrl.Result{}, nil
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			res = workflowCtx.RecoverPanic("AtlasOrgUser", p).ReconcileResult()
+		}
+		res = workflowCtx.CapRetries("AtlasOrgUser", orgUser, res)
+		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, r.FailureNotifier, orgUser)
+	}()
+
+	resourceVersionIsValid := customresource.ValidateResourceVersion(workflowCtx, orgUser, r.Log)
+	if !resourceVersionIsValid.IsOk() {
+		r.Log.Debugf("AtlasOrgUser validation result: %v", resourceVersionIsValid)
+		return resourceVersionIsValid.ReconcileResult(), nil
+	}
+
+	if key := orgUser.ConnectionSecretObjectKey(); key != nil {
+		r.EnsureResourcesAreWatched(req.NamespacedName, "Secret", log, *key)
+	}
+
+	atlasClient, orgID, err := r.AtlasProvider.SdkClient(workflowCtx.Context, orgUser.ConnectionSecretObjectKey(), log)
+	if err != nil {
+		result = workflow.Terminate(workflow.AtlasAPIAccessNotConfigured, err.Error())
+		workflowCtx.SetConditionFromResult(status.OrgUserReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+	workflowCtx.SdkClient = atlasClient
+	workflowCtx.OrgID = orgID
+
+	if !orgUser.GetDeletionTimestamp().IsZero() {
+		return r.handleDeletion(workflowCtx, orgUser).ReconcileResult(), nil
+	}
+
+	if !customresource.HaveFinalizer(orgUser, customresource.FinalizerLabel) {
+		if err = customresource.ManageFinalizer(ctx, r.Client, orgUser, customresource.SetFinalizer); err != nil {
+			result = workflow.Terminate(workflow.Internal, err.Error())
+			log.Errorw("failed to add finalizer", "error", err)
+			return result.ReconcileResult(), nil
+		}
+	}
+
+	teamIDs, result := r.teamIDs(workflowCtx, orgUser)
+	if !result.IsOk() {
+		workflowCtx.SetConditionFromResult(status.OrgUserTeamsReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+
+	result = ensureOrgUser(workflowCtx, orgUser, teamIDs)
+	if !result.IsOk() {
+		workflowCtx.SetConditionFromResult(status.OrgUserReadyType, result)
+	}
+	workflowCtx.SetConditionFromResult(status.ReadyType, result)
+
+	return result.ReconcileResult(), nil
+}
+
+// teamIDs returns the Atlas IDs of the teams referenced by the user, waiting for the teams to be created in Atlas
+func (r *AtlasOrgUserReconciler) teamIDs(ctx *workflow.Context, orgUser *mdbv1.AtlasOrgUser) ([]string, workflow.Result) {
+	teamIDs := make([]string, 0, len(orgUser.Spec.TeamRefs))
+	for i := range orgUser.Spec.TeamRefs {
+		key := orgUser.Spec.TeamRefs[i].GetObject(orgUser.Namespace)
+		team := &mdbv1.AtlasTeam{}
+		if err := r.Client.Get(ctx.Context, *key, team); err != nil {
+			if apiErrors.IsNotFound(err) {
+				return nil, workflow.Terminate(workflow.OrgUserInvalidSpec, fmt.Sprintf("the AtlasTeam %s doesn't exist", key))
+			}
+
+			return nil, workflow.Terminate(workflow.Internal, err.Error())
+		}
+
+		if team.Status.ID == "" {
+			return nil, workflow.InProgress(
+				workflow.OrgUserTeamsNotReady,
+				fmt.Sprintf("waiting for the AtlasTeam %s to be created in Atlas, teams are created once assigned to a project", key),
+			)
+		}
+		teamIDs = append(teamIDs, team.Status.ID)
+	}
+
+	return teamIDs, workflow.OK()
+}
+
+func (r *AtlasOrgUserReconciler) handleDeletion(ctx *workflow.Context, orgUser *mdbv1.AtlasOrgUser) workflow.Result {
+	if !customresource.HaveFinalizer(orgUser, customresource.FinalizerLabel) {
+		return workflow.OK()
+	}
+
+	if customresource.IsResourcePolicyKeepOrDefault(orgUser, r.ObjectDeletionProtection) {
+		ctx.Log.Info("Not removing the user from the Atlas organization as per configuration")
+	} else if result := deleteOrgUser(ctx, orgUser); !result.IsOk() {
+		ctx.SetConditionFromResult(status.OrgUserReadyType, result)
+		return result
+	}
+
+	if err := customresource.ManageFinalizer(ctx.Context, r.Client, orgUser, customresource.UnsetFinalizer); err != nil {
+		ctx.Log.Errorw("failed to remove finalizer", "error", err)
+		return workflow.Terminate(workflow.AtlasFinalizerNotRemoved, err.Error())
+	}
+
+	return workflow.OK()
+}
+
+func (r *AtlasOrgUserReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("AtlasOrgUser").
+		For(&mdbv1.AtlasOrgUser{}, builder.WithPredicates(r.GlobalPredicates...)).
+		Watches(&corev1.Secret{}, watch.NewSecretHandler(r.WatchedResources), builder.OnlyMetadata).
+		Complete(r)
+}
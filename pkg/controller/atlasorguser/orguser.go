@@ -0,0 +1,279 @@
+package atlasorguser
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/timeutil"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	listItemsPerPage = 500
+
+	orgOwnerRole = "ORG_OWNER"
+)
+
+// ensureOrgUser invites the user to the organization until they accept the invitation, keeping the roles and teams of
+// the pending invitation in sync and sending again an expired one. Once the user is a member of the organization their
+// organization roles and teams are kept in sync instead
+func ensureOrgUser(ctx *workflow.Context, orgUser *mdbv1.AtlasOrgUser, teamIDs []string) workflow.Result {
+	member, err := findOrgMember(ctx, orgUser.Spec.Username)
+	if err != nil {
+		return workflow.Terminate(workflow.OrgUserNotUpdatedInAtlas, fmt.Sprintf("failed to retrieve the users of the organization: %s", err))
+	}
+
+	if member != nil {
+		return ensureOrgMember(ctx, orgUser, member, teamIDs)
+	}
+
+	return ensureOrgInvitation(ctx, orgUser, teamIDs)
+}
+
+// ensureOrgMember keeps the organization roles and teams of the member in sync. The members the operator didn't invite
+// are only managed once explicitly adopted, and owners are never demoted
+func ensureOrgMember(ctx *workflow.Context, orgUser *mdbv1.AtlasOrgUser, member *admin.CloudAppUser, teamIDs []string) workflow.Result {
+	if !isManagedMember(orgUser, member) && !orgUser.Spec.AdoptExisting {
+		return workflow.Terminate(
+			workflow.OrgUserNotAdopted,
+			fmt.Sprintf("the user %s already is a member of the organization, set adoptExisting to manage their roles and teams", orgUser.Spec.Username),
+		).WithoutRetry()
+	}
+
+	roles := orgUser.Spec.RoleNames()
+	currentRoles := orgRoles(member, ctx.OrgID)
+	if slices.Contains(currentRoles, orgOwnerRole) && !slices.Contains(roles, orgOwnerRole) {
+		return workflow.Terminate(
+			workflow.OrgUserNotUpdatedInAtlas,
+			fmt.Sprintf("the user %s is an owner of the organization, add %s to the roles or demote them in Atlas", orgUser.Spec.Username, orgOwnerRole),
+		)
+	}
+
+	if !sameElements(currentRoles, roles) {
+		_, _, err := ctx.SdkClient.OrganizationsApi.
+			UpdateOrganizationRoles(ctx.Context, ctx.OrgID, member.GetId(), &admin.UpdateOrgRolesForUser{OrgRoles: &roles}).
+			Execute()
+		if err != nil {
+			return workflow.Terminate(workflow.OrgUserNotUpdatedInAtlas, fmt.Sprintf("failed to update the roles of the user: %s", err))
+		}
+	}
+
+	appliedTeamIDs, err := syncTeams(ctx, member, teamIDs, orgUser.Status.TeamIDs)
+	ctx.EnsureStatusOption(status.AtlasOrgUserMemberOption(member.GetId(), appliedTeamIDs))
+	if err != nil {
+		result := workflow.Terminate(workflow.OrgUserTeamsNotReady, err.Error())
+		ctx.SetConditionFromResult(status.OrgUserTeamsReadyType, result)
+
+		return result
+	}
+
+	if len(teamIDs) > 0 {
+		ctx.SetConditionTrue(status.OrgUserTeamsReadyType)
+	} else {
+		ctx.UnsetCondition(status.OrgUserTeamsReadyType)
+	}
+	ctx.SetConditionTrue(status.OrgUserReadyType)
+
+	return workflow.OK()
+}
+
+// syncTeams adds the member to the teams they don't belong to yet and removes them from the teams the operator
+// previously added them to and which are no longer referenced. The teams the user joined by other means are left
+// untouched. It returns the teams the operator added the user to, even on failure
+func syncTeams(ctx *workflow.Context, member *admin.CloudAppUser, teamIDs, previousTeamIDs []string) ([]string, error) {
+	var errs []error
+	applied := make([]string, 0, len(teamIDs))
+	for _, teamID := range teamIDs {
+		if !slices.Contains(member.GetTeamIds(), teamID) {
+			_, _, err := ctx.SdkClient.TeamsApi.
+				AddTeamUser(ctx.Context, ctx.OrgID, teamID, &[]admin.AddUserToTeam{{Id: member.GetId()}}).
+				Execute()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to add the user to the team %s: %w", teamID, err))
+				continue
+			}
+		}
+		applied = append(applied, teamID)
+	}
+
+	for _, teamID := range previousTeamIDs {
+		if slices.Contains(teamIDs, teamID) || !slices.Contains(member.GetTeamIds(), teamID) {
+			continue
+		}
+
+		resp, err := ctx.SdkClient.TeamsApi.RemoveTeamUser(ctx.Context, ctx.OrgID, teamID, member.GetId()).Execute()
+		if err != nil && !isNotFound(resp) {
+			errs = append(errs, fmt.Errorf("failed to remove the user from the team %s: %w", teamID, err))
+			applied = append(applied, teamID)
+		}
+	}
+
+	return applied, errors.Join(errs...)
+}
+
+func ensureOrgInvitation(ctx *workflow.Context, orgUser *mdbv1.AtlasOrgUser, teamIDs []string) workflow.Result {
+	invitation, err := findOrgInvitation(ctx, orgUser.Spec.Username)
+	if err != nil {
+		return workflow.Terminate(workflow.OrgUserNotInvitedInAtlas, fmt.Sprintf("failed to retrieve the invitations of the organization: %s", err))
+	}
+
+	roles := orgUser.Spec.RoleNames()
+	switch {
+	case invitation != nil && isExpired(invitation):
+		ctx.Log.Debugw("Sending again the expired organization invitation", "username", orgUser.Spec.Username)
+		if _, _, err = ctx.SdkClient.OrganizationsApi.DeleteOrganizationInvitation(ctx.Context, ctx.OrgID, invitation.GetId()).Execute(); err == nil {
+			invitation, err = createOrgInvitation(ctx, orgUser.Spec.Username, roles, teamIDs)
+		}
+	case invitation != nil:
+		if !sameElements(invitation.GetRoles(), roles) || !sameElements(invitation.GetTeamIds(), teamIDs) {
+			invitation, _, err = ctx.SdkClient.OrganizationsApi.
+				UpdateOrganizationInvitationById(ctx.Context, ctx.OrgID, invitation.GetId(), &admin.OrganizationInvitationUpdateRequest{Roles: &roles, TeamIds: &teamIDs}).
+				Execute()
+		}
+	default:
+		invitation, err = createOrgInvitation(ctx, orgUser.Spec.Username, roles, teamIDs)
+	}
+
+	if err != nil {
+		return workflow.Terminate(workflow.OrgUserNotInvitedInAtlas, fmt.Sprintf("failed to invite the user: %s", err))
+	}
+
+	expiresAt := ""
+	if value, ok := invitation.GetExpiresAtOk(); ok {
+		expiresAt = timeutil.FormatISO8601(*value)
+	}
+	ctx.EnsureStatusOption(status.AtlasOrgUserInvitedOption(invitation.GetId(), expiresAt))
+	ctx.UnsetCondition(status.OrgUserTeamsReadyType)
+	ctx.SetConditionTrueMsg(status.OrgUserReadyType, "the invitation is pending acceptance by the user")
+
+	return workflow.OK()
+}
+
+func createOrgInvitation(ctx *workflow.Context, username string, roles, teamIDs []string) (*admin.OrganizationInvitation, error) {
+	invitation, _, err := ctx.SdkClient.OrganizationsApi.
+		CreateOrganizationInvitation(ctx.Context, ctx.OrgID, &admin.OrganizationInvitationRequest{Username: &username, Roles: &roles, TeamIds: &teamIDs}).
+		Execute()
+
+	return invitation, err
+}
+
+// deleteOrgUser removes the member from the organization, or revokes their pending invitation
+func deleteOrgUser(ctx *workflow.Context, orgUser *mdbv1.AtlasOrgUser) workflow.Result {
+	member, err := findOrgMember(ctx, orgUser.Spec.Username)
+	if err != nil {
+		return workflow.Terminate(workflow.OrgUserNotRemovedFromAtlas, fmt.Sprintf("failed to retrieve the users of the organization: %s", err))
+	}
+
+	if member != nil {
+		if !isManagedMember(orgUser, member) {
+			ctx.Log.Infow("Not removing the user the operator didn't invite or adopt from the organization", "username", orgUser.Spec.Username)
+			return workflow.OK()
+		}
+
+		_, resp, err := ctx.SdkClient.OrganizationsApi.RemoveOrganizationUser(ctx.Context, ctx.OrgID, member.GetId()).Execute()
+		if err != nil && !isNotFound(resp) {
+			return workflow.Terminate(workflow.OrgUserNotRemovedFromAtlas, fmt.Sprintf("failed to remove the user from the organization: %s", err))
+		}
+
+		return workflow.OK()
+	}
+
+	invitation, err := findOrgInvitation(ctx, orgUser.Spec.Username)
+	if err != nil {
+		return workflow.Terminate(workflow.OrgUserNotRemovedFromAtlas, fmt.Sprintf("failed to retrieve the invitations of the organization: %s", err))
+	}
+
+	if invitation != nil {
+		_, resp, err := ctx.SdkClient.OrganizationsApi.DeleteOrganizationInvitation(ctx.Context, ctx.OrgID, invitation.GetId()).Execute()
+		if err != nil && !isNotFound(resp) {
+			return workflow.Terminate(workflow.OrgUserNotRemovedFromAtlas, fmt.Sprintf("failed to revoke the invitation of the user: %s", err))
+		}
+	}
+
+	return workflow.OK()
+}
+
+// findOrgMember returns the user of the organization with the given username, nil when they aren't a member
+func findOrgMember(ctx *workflow.Context, username string) (*admin.CloudAppUser, error) {
+	for page := 1; ; page++ {
+		users, _, err := ctx.SdkClient.OrganizationsApi.ListOrganizationUsers(ctx.Context, ctx.OrgID).
+			PageNum(page).
+			ItemsPerPage(listItemsPerPage).
+			Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range users.GetResults() {
+			if strings.EqualFold(users.GetResults()[i].Username, username) {
+				return &users.GetResults()[i], nil
+			}
+		}
+
+		if len(users.GetResults()) < listItemsPerPage {
+			return nil, nil
+		}
+	}
+}
+
+// findOrgInvitation returns the pending invitation of the user to the organization, nil when there is none
+func findOrgInvitation(ctx *workflow.Context, username string) (*admin.OrganizationInvitation, error) {
+	invitations, _, err := ctx.SdkClient.OrganizationsApi.ListOrganizationInvitations(ctx.Context, ctx.OrgID).
+		Username(username).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range invitations {
+		if strings.EqualFold(invitations[i].GetUsername(), username) {
+			return &invitations[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// isManagedMember tells whether the member was invited by the operator or was already managed by it
+func isManagedMember(orgUser *mdbv1.AtlasOrgUser, member *admin.CloudAppUser) bool {
+	return orgUser.Status.InvitationID != "" || (orgUser.Status.UserID != "" && orgUser.Status.UserID == member.GetId())
+}
+
+// orgRoles returns the roles of the user over the organization, leaving out the ones over its projects
+func orgRoles(member *admin.CloudAppUser, orgID string) []string {
+	var roles []string
+	for _, role := range member.GetRoles() {
+		if role.GetOrgId() == orgID {
+			roles = append(roles, role.GetRoleName())
+		}
+	}
+
+	return roles
+}
+
+func isExpired(invitation *admin.OrganizationInvitation) bool {
+	expiresAt, ok := invitation.GetExpiresAtOk()
+
+	return ok && expiresAt.Before(time.Now())
+}
+
+func isNotFound(resp *http.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusNotFound
+}
+
+func sameElements(current, desired []string) bool {
+	current = slices.Clone(current)
+	desired = slices.Clone(desired)
+	slices.Sort(current)
+	slices.Sort(desired)
+
+	return slices.Equal(current, desired)
+}
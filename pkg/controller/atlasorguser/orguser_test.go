@@ -0,0 +1,238 @@
+package atlasorguser
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	"go.uber.org/zap/zaptest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func newOrgUser(roles ...mdbv1.OrgRole) *mdbv1.AtlasOrgUser {
+	return &mdbv1.AtlasOrgUser{
+		ObjectMeta: metav1.ObjectMeta{Name: "org-user", Namespace: "default"},
+		Spec:       mdbv1.AtlasOrgUserSpec{Username: "jane@example.com", Roles: roles},
+	}
+}
+
+func newContext(t *testing.T, orgAPI admin.OrganizationsApi, teamsAPI admin.TeamsApi) *workflow.Context {
+	ctx := workflow.NewContext(zaptest.NewLogger(t).Sugar(), []status.Condition{}, context.Background())
+	ctx.SdkClient = &admin.APIClient{OrganizationsApi: orgAPI, TeamsApi: teamsAPI}
+	ctx.OrgID = "org-id"
+
+	return ctx
+}
+
+func reconciledStatus(ctx *workflow.Context, orgUser *mdbv1.AtlasOrgUser) status.AtlasOrgUserStatus {
+	orgUser.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+
+	return orgUser.Status
+}
+
+func expectOrgMembers(orgAPI *atlasmock.OrganizationsApiMock, users ...admin.CloudAppUser) {
+	orgAPI.EXPECT().ListOrganizationUsers(mock.Anything, "org-id").
+		Return(admin.ListOrganizationUsersApiRequest{ApiService: orgAPI})
+	orgAPI.EXPECT().ListOrganizationUsersExecute(mock.Anything).Return(&admin.PaginatedAppUser{Results: &users}, nil, nil)
+}
+
+func expectOrgInvitations(orgAPI *atlasmock.OrganizationsApiMock, invitations ...admin.OrganizationInvitation) {
+	orgAPI.EXPECT().ListOrganizationInvitations(mock.Anything, "org-id").
+		Return(admin.ListOrganizationInvitationsApiRequest{ApiService: orgAPI})
+	orgAPI.EXPECT().ListOrganizationInvitationsExecute(mock.Anything).Return(invitations, nil, nil)
+}
+
+func TestEnsureOrgUser(t *testing.T) {
+	t.Run("should invite a user who isn't a member of the organization", func(t *testing.T) {
+		orgAPI := atlasmock.NewOrganizationsApiMock(t)
+		expectOrgMembers(orgAPI)
+		expectOrgInvitations(orgAPI)
+		orgAPI.EXPECT().CreateOrganizationInvitation(mock.Anything, "org-id", &admin.OrganizationInvitationRequest{
+			Username: admin.PtrString("jane@example.com"),
+			Roles:    &[]string{"ORG_MEMBER"},
+			TeamIds:  &[]string{"team-id"},
+		}).Return(admin.CreateOrganizationInvitationApiRequest{ApiService: orgAPI})
+		orgAPI.EXPECT().CreateOrganizationInvitationExecute(mock.Anything).Return(
+			&admin.OrganizationInvitation{Id: admin.PtrString("invitation-id")}, nil, nil,
+		)
+		ctx := newContext(t, orgAPI, nil)
+		orgUser := newOrgUser("ORG_MEMBER")
+
+		result := ensureOrgUser(ctx, orgUser, []string{"team-id"})
+
+		require.True(t, result.IsOk())
+		orgUserStatus := reconciledStatus(ctx, orgUser)
+		assert.Equal(t, status.OrgUserInvited, orgUserStatus.State)
+		assert.Equal(t, "invitation-id", orgUserStatus.InvitationID)
+	})
+
+	t.Run("should update the roles of a pending invitation", func(t *testing.T) {
+		orgAPI := atlasmock.NewOrganizationsApiMock(t)
+		expectOrgMembers(orgAPI)
+		expectOrgInvitations(orgAPI, admin.OrganizationInvitation{
+			Id:       admin.PtrString("invitation-id"),
+			Username: admin.PtrString("Jane@example.com"),
+			Roles:    &[]string{"ORG_MEMBER"},
+		})
+		orgAPI.EXPECT().UpdateOrganizationInvitationById(mock.Anything, "org-id", "invitation-id", &admin.OrganizationInvitationUpdateRequest{
+			Roles:   &[]string{"ORG_READ_ONLY"},
+			TeamIds: &[]string{},
+		}).Return(admin.UpdateOrganizationInvitationByIdApiRequest{ApiService: orgAPI})
+		orgAPI.EXPECT().UpdateOrganizationInvitationByIdExecute(mock.Anything).Return(
+			&admin.OrganizationInvitation{Id: admin.PtrString("invitation-id")}, nil, nil,
+		)
+
+		result := ensureOrgUser(newContext(t, orgAPI, nil), newOrgUser("ORG_READ_ONLY"), []string{})
+
+		require.True(t, result.IsOk())
+	})
+
+	t.Run("should send again an expired invitation", func(t *testing.T) {
+		orgAPI := atlasmock.NewOrganizationsApiMock(t)
+		expectOrgMembers(orgAPI)
+		expectOrgInvitations(orgAPI, admin.OrganizationInvitation{
+			Id:        admin.PtrString("expired-id"),
+			Username:  admin.PtrString("jane@example.com"),
+			Roles:     &[]string{"ORG_MEMBER"},
+			ExpiresAt: admin.PtrTime(time.Now().Add(-time.Hour)),
+		})
+		orgAPI.EXPECT().DeleteOrganizationInvitation(mock.Anything, "org-id", "expired-id").
+			Return(admin.DeleteOrganizationInvitationApiRequest{ApiService: orgAPI})
+		orgAPI.EXPECT().DeleteOrganizationInvitationExecute(mock.Anything).Return(nil, nil, nil)
+		orgAPI.EXPECT().CreateOrganizationInvitation(mock.Anything, "org-id", mock.Anything).
+			Return(admin.CreateOrganizationInvitationApiRequest{ApiService: orgAPI})
+		orgAPI.EXPECT().CreateOrganizationInvitationExecute(mock.Anything).Return(
+			&admin.OrganizationInvitation{Id: admin.PtrString("invitation-id")}, nil, nil,
+		)
+		ctx := newContext(t, orgAPI, nil)
+		orgUser := newOrgUser("ORG_MEMBER")
+
+		result := ensureOrgUser(ctx, orgUser, nil)
+
+		require.True(t, result.IsOk())
+		assert.Equal(t, "invitation-id", reconciledStatus(ctx, orgUser).InvitationID)
+	})
+
+	t.Run("should sync the roles and teams of a member of the organization", func(t *testing.T) {
+		orgAPI := atlasmock.NewOrganizationsApiMock(t)
+		teamsAPI := atlasmock.NewTeamsApiMock(t)
+		expectOrgMembers(orgAPI, admin.CloudAppUser{
+			Id:       admin.PtrString("user-id"),
+			Username: "jane@example.com",
+			Roles: &[]admin.CloudAccessRoleAssignment{
+				{OrgId: admin.PtrString("org-id"), RoleName: admin.PtrString("ORG_MEMBER")},
+				{GroupId: admin.PtrString("project-id"), RoleName: admin.PtrString("GROUP_READ_ONLY")},
+			},
+			TeamIds: &[]string{"old-team-id", "other-team-id"},
+		})
+		orgAPI.EXPECT().UpdateOrganizationRoles(mock.Anything, "org-id", "user-id", &admin.UpdateOrgRolesForUser{OrgRoles: &[]string{"ORG_OWNER"}}).
+			Return(admin.UpdateOrganizationRolesApiRequest{ApiService: orgAPI})
+		orgAPI.EXPECT().UpdateOrganizationRolesExecute(mock.Anything).Return(nil, nil, nil)
+		teamsAPI.EXPECT().AddTeamUser(mock.Anything, "org-id", "team-id", &[]admin.AddUserToTeam{{Id: "user-id"}}).
+			Return(admin.AddTeamUserApiRequest{ApiService: teamsAPI})
+		teamsAPI.EXPECT().AddTeamUserExecute(mock.Anything).Return(nil, nil, nil)
+		teamsAPI.EXPECT().RemoveTeamUser(mock.Anything, "org-id", "old-team-id", "user-id").
+			Return(admin.RemoveTeamUserApiRequest{ApiService: teamsAPI})
+		teamsAPI.EXPECT().RemoveTeamUserExecute(mock.Anything).Return(nil, nil)
+		ctx := newContext(t, orgAPI, teamsAPI)
+		orgUser := newOrgUser("ORG_OWNER")
+		orgUser.Status.UserID = "user-id"
+		orgUser.Status.TeamIDs = []string{"old-team-id"}
+
+		result := ensureOrgUser(ctx, orgUser, []string{"team-id"})
+
+		require.True(t, result.IsOk())
+		orgUserStatus := reconciledStatus(ctx, orgUser)
+		assert.Equal(t, status.OrgUserMember, orgUserStatus.State)
+		assert.Equal(t, "user-id", orgUserStatus.UserID)
+		assert.Equal(t, []string{"team-id"}, orgUserStatus.TeamIDs)
+	})
+
+	t.Run("should refuse a member the operator didn't invite", func(t *testing.T) {
+		orgAPI := atlasmock.NewOrganizationsApiMock(t)
+		expectOrgMembers(orgAPI, admin.CloudAppUser{Id: admin.PtrString("user-id"), Username: "jane@example.com"})
+
+		result := ensureOrgUser(newContext(t, orgAPI, nil), newOrgUser("ORG_MEMBER"), nil)
+
+		assert.Equal(t, workflow.Terminate(
+			workflow.OrgUserNotAdopted,
+			"the user jane@example.com already is a member of the organization, set adoptExisting to manage their roles and teams",
+		).WithoutRetry(), result)
+	})
+
+	t.Run("should adopt a member explicitly", func(t *testing.T) {
+		orgAPI := atlasmock.NewOrganizationsApiMock(t)
+		expectOrgMembers(orgAPI, admin.CloudAppUser{
+			Id:       admin.PtrString("user-id"),
+			Username: "jane@example.com",
+			Roles:    &[]admin.CloudAccessRoleAssignment{{OrgId: admin.PtrString("org-id"), RoleName: admin.PtrString("ORG_MEMBER")}},
+		})
+		ctx := newContext(t, orgAPI, nil)
+		orgUser := newOrgUser("ORG_MEMBER")
+		orgUser.Spec.AdoptExisting = true
+
+		result := ensureOrgUser(ctx, orgUser, nil)
+
+		require.True(t, result.IsOk())
+		assert.Equal(t, "user-id", reconciledStatus(ctx, orgUser).UserID)
+	})
+
+	t.Run("should never demote an owner of the organization", func(t *testing.T) {
+		orgAPI := atlasmock.NewOrganizationsApiMock(t)
+		expectOrgMembers(orgAPI, admin.CloudAppUser{
+			Id:       admin.PtrString("user-id"),
+			Username: "jane@example.com",
+			Roles:    &[]admin.CloudAccessRoleAssignment{{OrgId: admin.PtrString("org-id"), RoleName: admin.PtrString("ORG_OWNER")}},
+		})
+		orgUser := newOrgUser("ORG_MEMBER")
+		orgUser.Spec.AdoptExisting = true
+
+		result := ensureOrgUser(newContext(t, orgAPI, nil), orgUser, nil)
+
+		assert.Equal(t, workflow.Terminate(
+			workflow.OrgUserNotUpdatedInAtlas,
+			"the user jane@example.com is an owner of the organization, add ORG_OWNER to the roles or demote them in Atlas",
+		), result)
+	})
+}
+
+func TestDeleteOrgUser(t *testing.T) {
+	t.Run("should remove a member from the organization", func(t *testing.T) {
+		orgAPI := atlasmock.NewOrganizationsApiMock(t)
+		expectOrgMembers(orgAPI, admin.CloudAppUser{Id: admin.PtrString("user-id"), Username: "jane@example.com"})
+		orgAPI.EXPECT().RemoveOrganizationUser(mock.Anything, "org-id", "user-id").
+			Return(admin.RemoveOrganizationUserApiRequest{ApiService: orgAPI})
+		orgAPI.EXPECT().RemoveOrganizationUserExecute(mock.Anything).Return(nil, nil, nil)
+		orgUser := newOrgUser("ORG_MEMBER")
+		orgUser.Status.UserID = "user-id"
+
+		assert.True(t, deleteOrgUser(newContext(t, orgAPI, nil), orgUser).IsOk())
+	})
+
+	t.Run("should keep a member the operator didn't invite or adopt", func(t *testing.T) {
+		orgAPI := atlasmock.NewOrganizationsApiMock(t)
+		expectOrgMembers(orgAPI, admin.CloudAppUser{Id: admin.PtrString("user-id"), Username: "jane@example.com"})
+
+		assert.True(t, deleteOrgUser(newContext(t, orgAPI, nil), newOrgUser("ORG_MEMBER")).IsOk())
+	})
+
+	t.Run("should revoke a pending invitation", func(t *testing.T) {
+		orgAPI := atlasmock.NewOrganizationsApiMock(t)
+		expectOrgMembers(orgAPI)
+		expectOrgInvitations(orgAPI, admin.OrganizationInvitation{Id: admin.PtrString("invitation-id"), Username: admin.PtrString("jane@example.com")})
+		orgAPI.EXPECT().DeleteOrganizationInvitation(mock.Anything, "org-id", "invitation-id").
+			Return(admin.DeleteOrganizationInvitationApiRequest{ApiService: orgAPI})
+		orgAPI.EXPECT().DeleteOrganizationInvitationExecute(mock.Anything).Return(nil, nil, nil)
+
+		assert.True(t, deleteOrgUser(newContext(t, orgAPI, nil), newOrgUser("ORG_MEMBER")).IsOk())
+	})
+}
@@ -0,0 +1,170 @@
+package atlasprivateendpoint
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/notifier"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/statushandler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/watch"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// AtlasPrivateEndpointReconciler reconciles an AtlasPrivateEndpoint object
+type AtlasPrivateEndpointReconciler struct {
+	Client                   client.Client
+	Log                      *zap.SugaredLogger
+	Scheme                   *runtime.Scheme
+	GlobalPredicates         []predicate.Predicate
+	EventRecorder            record.EventRecorder
+	FailureNotifier          *notifier.Notifier
+	AtlasProvider            atlas.Provider
+	ObjectDeletionProtection bool
+}
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasprivateendpoints,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasprivateendpoints/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasprivateendpoints,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasprivateendpoints/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *AtlasPrivateEndpointReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, _ error) {
+	log := r.Log.With("atlasprivateendpoint", req.NamespacedName)
+
+	privateEndpoint := &mdbv1.AtlasPrivateEndpoint{}
+	result := customresource.PrepareResource(ctx, r.Client, req, privateEndpoint, log)
+	if !result.IsOk() {
+		return result.ReconcileResult(), nil
+	}
+
+	if customresource.ReconciliationShouldBeSkipped(privateEndpoint) {
+		log.Infow(fmt.Sprintf("-> Skipping AtlasPrivateEndpoint reconciliation as annotation %s=%s", customresource.ReconciliationPolicyAnnotation, customresource.ReconciliationPolicySkip), "spec", privateEndpoint.Spec)
+		if !privateEndpoint.GetDeletionTimestamp().IsZero() {
+			if err := customresource.ManageFinalizer(ctx, r.Client, privateEndpoint, customresource.UnsetFinalizer); err != nil {
+				log.Errorw("failed to remove finalizer", "error", err)
+				return workflow.Terminate(workflow.Internal, err.Error()).ReconcileResult(), nil
+			}
+		}
+		return workflow.OK().ReconcileResult(), nil
+	}
+
+	if customresource.NamespaceReconciliationPaused(ctx, r.Client, privateEndpoint.Namespace, log) {
+		log.Infow(fmt.Sprintf("-> Pausing AtlasPrivateEndpoint reconciliation as annotation %s=true is set on the namespace", customresource.ReconciliationPausedAnnotation))
+		return customresource.MarkReconciliationPaused(ctx, r.Client, r.EventRecorder, privateEndpoint, log).ReconcileResult(), nil
+	}
+
+	workflowCtx := customresource.MarkReconciliationStarted(r.Client, privateEndpoint, log, ctx)
+	log.Infow("-> Starting AtlasPrivateEndpoint reconciliation", "spec", privateEndpoint.Spec, "status", privateEndpoint.Status)
+
+	if workflowCtx.Degraded(privateEndpoint) {
+		log.Infow("-> Skipping the reconciliation of the degraded AtlasPrivateEndpoint, change its spec or set the reapply annotation to retry")
+		return ctrl.Result{}, nil
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			res = workflowCtx.RecoverPanic("AtlasPrivateEndpoint", p).ReconcileResult()
+		}
+		res = workflowCtx.CapRetries("AtlasPrivateEndpoint", privateEndpoint, res)
+		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, r.FailureNotifier, privateEndpoint)
+	}()
+
+	resourceVersionIsValid := customresource.ValidateResourceVersion(workflowCtx, privateEndpoint, r.Log)
+	if !resourceVersionIsValid.IsOk() {
+		r.Log.Debugf("AtlasPrivateEndpoint validation result: %v", resourceVersionIsValid)
+		return resourceVersionIsValid.ReconcileResult(), nil
+	}
+
+	project := &mdbv1.AtlasProject{}
+	err := r.Client.Get(ctx, privateEndpoint.AtlasProjectObjectKey(), project)
+	if apiErrors.IsNotFound(err) && !privateEndpoint.GetDeletionTimestamp().IsZero() {
+		// the private endpoint service was removed from Atlas along with its project
+		return r.removeFinalizer(workflowCtx, privateEndpoint).ReconcileResult(), nil
+	}
+	if err != nil {
+		result = workflow.Terminate(workflow.Internal, err.Error())
+		workflowCtx.SetConditionFromResult(status.PrivateEndpointServiceReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+
+	atlasClient, orgID, err := r.AtlasProvider.SdkClient(workflowCtx.Context, project.ConnectionSecretObjectKey(), log)
+	if err != nil {
+		result = workflow.Terminate(workflow.AtlasAPIAccessNotConfigured, err.Error())
+		workflowCtx.SetConditionFromResult(status.PrivateEndpointServiceReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+	workflowCtx.SdkClient = atlasClient
+	workflowCtx.OrgID = orgID
+
+	if !privateEndpoint.GetDeletionTimestamp().IsZero() {
+		return r.handleDeletion(workflowCtx, privateEndpoint, project.ID()).ReconcileResult(), nil
+	}
+
+	if !customresource.HaveFinalizer(privateEndpoint, customresource.FinalizerLabel) {
+		if err = customresource.ManageFinalizer(ctx, r.Client, privateEndpoint, customresource.SetFinalizer); err != nil {
+			result = workflow.Terminate(workflow.Internal, err.Error())
+			log.Errorw("failed to add finalizer", "error", err)
+			return result.ReconcileResult(), nil
+		}
+	}
+
+	if project.ID() == "" {
+		result = workflow.InProgress(workflow.PrivateEndpointProjectNotReady, fmt.Sprintf("waiting for the project %s to be created in Atlas", project.Spec.Name))
+		workflowCtx.SetConditionFromResult(status.PrivateEndpointServiceReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+
+	result = ensurePrivateEndpoint(workflowCtx, privateEndpoint, project, time.Now())
+	workflowCtx.SetConditionFromResult(status.ReadyType, result)
+
+	return result.ReconcileResult(), nil
+}
+
+func (r *AtlasPrivateEndpointReconciler) handleDeletion(ctx *workflow.Context, privateEndpoint *mdbv1.AtlasPrivateEndpoint, projectID string) workflow.Result {
+	if !customresource.HaveFinalizer(privateEndpoint, customresource.FinalizerLabel) {
+		return workflow.OK()
+	}
+
+	if customresource.IsResourcePolicyKeepOrDefault(privateEndpoint, r.ObjectDeletionProtection) {
+		ctx.Log.Info("Not removing the private endpoint service from Atlas as per configuration")
+	} else if privateEndpoint.Status.ServiceID != "" && projectID != "" {
+		// the interfaces must be removed before the service, the deletion is retried until they are gone
+		if result := deletePrivateEndpoint(ctx, privateEndpoint, projectID); !result.IsOk() {
+			ctx.SetConditionFromResult(status.PrivateEndpointServiceReadyType, result)
+			return result
+		}
+	}
+
+	return r.removeFinalizer(ctx, privateEndpoint)
+}
+
+func (r *AtlasPrivateEndpointReconciler) removeFinalizer(ctx *workflow.Context, privateEndpoint *mdbv1.AtlasPrivateEndpoint) workflow.Result {
+	if err := customresource.ManageFinalizer(ctx.Context, r.Client, privateEndpoint, customresource.UnsetFinalizer); err != nil {
+		ctx.Log.Errorw("failed to remove finalizer", "error", err)
+		return workflow.Terminate(workflow.AtlasFinalizerNotRemoved, err.Error())
+	}
+
+	return workflow.OK()
+}
+
+func (r *AtlasPrivateEndpointReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("AtlasPrivateEndpoint").
+		For(&mdbv1.AtlasPrivateEndpoint{}, builder.WithPredicates(r.GlobalPredicates...)).
+		Watches(&mdbv1.AtlasProject{}, watch.NewProjectDependentsHandler(r.Client, &mdbv1.AtlasPrivateEndpointList{}), builder.WithPredicates(watch.ProjectOrganizationChanged())).
+		Complete(r)
+}
@@ -0,0 +1,443 @@
+package atlasprivateendpoint
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// maxGCPEndpoints is the maximum number of forwarding rules, and so of addresses, of a GCP endpoint group
+const maxGCPEndpoints = 50
+
+const (
+	statusAvailable = "AVAILABLE"
+	statusFailed    = "FAILED"
+	statusRejected  = "REJECTED"
+)
+
+const (
+	defaultRecreationBackoff     = 15 * time.Minute
+	defaultRecreationMaxAttempts = 3
+)
+
+// validatePrivateEndpoint checks the interfaces of the spec, and that the private endpoint service isn't managed by the
+// spec of the project as well, as both would remove the interfaces of the other
+func validatePrivateEndpoint(privateEndpoint *mdbv1.AtlasPrivateEndpoint, project *mdbv1.AtlasProject) error {
+	for _, projectPE := range project.Spec.PrivateEndpoints {
+		if projectPE.Provider == privateEndpoint.Spec.Provider &&
+			status.TransformRegionToID(projectPE.Region) == status.TransformRegionToID(privateEndpoint.Spec.Region) {
+			return fmt.Errorf("the %s private endpoint service in %s is managed by the privateEndpoints of the project %s", privateEndpoint.Spec.Provider, privateEndpoint.Spec.Region, project.Name)
+		}
+	}
+
+	seen := map[string]struct{}{}
+	for i, iface := range privateEndpoint.Spec.Interfaces {
+		switch privateEndpoint.Spec.Provider {
+		case provider.ProviderAWS:
+			if iface.ID == "" {
+				return fmt.Errorf("the id of the interface %d is required for an AWS private endpoint", i)
+			}
+		case provider.ProviderAzure:
+			if iface.ID == "" || iface.IP == "" {
+				return fmt.Errorf("the id and ip of the interface %d are required for an Azure private endpoint", i)
+			}
+		case provider.ProviderGCP:
+			if iface.GCPProjectID == "" || iface.EndpointGroupName == "" || len(iface.Endpoints) == 0 {
+				return fmt.Errorf("the gcpProjectId, endpointGroupName and endpoints of the interface %d are required for a GCP private endpoint", i)
+			}
+			if len(iface.Endpoints) > maxGCPEndpoints {
+				return fmt.Errorf("the endpoint group %s can't have more than %d endpoints", iface.EndpointGroupName, maxGCPEndpoints)
+			}
+		}
+
+		if _, ok := seen[iface.InterfaceID()]; ok {
+			return fmt.Errorf("the interface %s is listed more than once", iface.InterfaceID())
+		}
+		seen[iface.InterfaceID()] = struct{}{}
+	}
+
+	return nil
+}
+
+// ensurePrivateEndpoint creates the private endpoint service in Atlas and connects the interfaces of the spec to it
+// once it is available. The service is found by the id recorded in the status only, the services created otherwise are
+// never adopted
+func ensurePrivateEndpoint(ctx *workflow.Context, privateEndpoint *mdbv1.AtlasPrivateEndpoint, project *mdbv1.AtlasProject, now time.Time) workflow.Result {
+	projectID := project.ID()
+	if err := validatePrivateEndpoint(privateEndpoint, project); err != nil {
+		result := workflow.Terminate(workflow.PrivateEndpointInvalidSpec, err.Error()).WithoutRetry()
+		ctx.SetConditionFromResult(status.PrivateEndpointServiceReadyType, result)
+		return result
+	}
+
+	service, err := readService(ctx, privateEndpoint, projectID)
+	if err != nil {
+		result := workflow.Terminate(workflow.PrivateEndpointServiceNotCreatedInAtlas, err.Error())
+		ctx.SetConditionFromResult(status.PrivateEndpointServiceReadyType, result)
+		return result
+	}
+
+	if service == nil {
+		ctx.Log.Infow("Creating the private endpoint service", "provider", privateEndpoint.Spec.Provider, "region", privateEndpoint.Spec.Region)
+		service, _, err = ctx.SdkClient.PrivateEndpointServicesApi.CreatePrivateEndpointService(ctx.Context, projectID, &admin.CloudProviderEndpointServiceRequest{
+			ProviderName: string(privateEndpoint.Spec.Provider),
+			Region:       privateEndpoint.Spec.Region,
+		}).Execute()
+		if err != nil {
+			result := workflow.Terminate(workflow.PrivateEndpointServiceNotCreatedInAtlas, err.Error())
+			ctx.SetConditionFromResult(status.PrivateEndpointServiceReadyType, result)
+			return result
+		}
+	}
+
+	ctx.EnsureStatusOption(status.AtlasPrivateEndpointServiceOption(
+		service.GetId(),
+		serviceName(service),
+		service.GetPrivateLinkServiceResourceId(),
+		service.GetServiceAttachmentNames(),
+		service.GetStatus(),
+		service.GetErrorMessage(),
+	))
+
+	switch service.GetStatus() {
+	case statusAvailable:
+		ctx.SetConditionTrue(status.PrivateEndpointServiceReadyType)
+	case statusFailed:
+		result := workflow.Terminate(workflow.PrivateEndpointServiceFailed, fmt.Sprintf("the private endpoint service failed: %s", service.GetErrorMessage()))
+		ctx.SetConditionFromResult(status.PrivateEndpointServiceReadyType, result)
+		ctx.UnsetCondition(status.PrivateEndpointReadyType)
+		return result
+	default:
+		result := workflow.InProgress(workflow.PrivateEndpointServiceInitiating, "waiting for the private endpoint service to be available")
+		ctx.SetConditionFromResult(status.PrivateEndpointServiceReadyType, result)
+		ctx.UnsetCondition(status.PrivateEndpointReadyType)
+		return result
+	}
+
+	if len(privateEndpoint.Spec.Interfaces) == 0 && len(serviceInterfaceIDs(service)) == 0 {
+		ctx.UnsetCondition(status.PrivateEndpointReadyType)
+		ctx.EnsureStatusOption(status.AtlasPrivateEndpointInterfacesOption(nil))
+		return workflow.OK()
+	}
+
+	result := ensureInterfaces(ctx, privateEndpoint, projectID, service, now)
+	ctx.SetConditionFromResult(status.PrivateEndpointReadyType, result)
+
+	return result
+}
+
+// ensureInterfaces connects the interfaces of the spec to the service, and removes the other ones. Every interface
+// reports its own state and conditions in the status. The failed interfaces are recreated when the spec enables it
+func ensureInterfaces(ctx *workflow.Context, privateEndpoint *mdbv1.AtlasPrivateEndpoint, projectID string, service *admin.EndpointService, now time.Time) workflow.Result {
+	api := ctx.SdkClient.PrivateEndpointServicesApi
+	cloudProvider := string(privateEndpoint.Spec.Provider)
+
+	desired := map[string]struct{}{}
+	for _, iface := range privateEndpoint.Spec.Interfaces {
+		desired[iface.InterfaceID()] = struct{}{}
+	}
+
+	existing := map[string]struct{}{}
+	for _, id := range serviceInterfaceIDs(service) {
+		existing[id] = struct{}{}
+		if _, ok := desired[id]; ok {
+			continue
+		}
+
+		ctx.Log.Infow("Removing the interface from the private endpoint service", "interfaceID", id, "serviceID", service.GetId())
+		_, resp, err := api.DeletePrivateEndpoint(ctx.Context, projectID, cloudProvider, id, service.GetId()).Execute()
+		if err != nil && !isNotFound(resp) {
+			return workflow.Terminate(workflow.PrivateEndpointNotDeletedInAtlas, err.Error())
+		}
+	}
+
+	statuses := make([]status.PrivateEndpointInterfaceStatus, 0, len(privateEndpoint.Spec.Interfaces))
+	var failed, pending []string
+	for _, iface := range privateEndpoint.Spec.Interfaces {
+		id := iface.InterfaceID()
+
+		var endpoint *admin.PrivateLinkEndpoint
+		var err error
+		if _, ok := existing[id]; ok {
+			endpoint, _, err = api.GetPrivateEndpoint(ctx.Context, projectID, cloudProvider, id, service.GetId()).Execute()
+		} else {
+			ctx.Log.Infow("Connecting the interface to the private endpoint service", "interfaceID", id, "serviceID", service.GetId())
+			endpoint, _, err = api.CreatePrivateEndpoint(ctx.Context, projectID, cloudProvider, service.GetId(), toAtlasInterface(iface)).Execute()
+		}
+
+		ifaceStatus := interfaceStatus(privateEndpoint, id, endpoint, err, now)
+		recreate, gaveUp := recreation(privateEndpoint.Spec.Recreation, ifaceStatus, now)
+		if recreate {
+			ctx.Log.Infow("Recreating the failed interface", "interfaceID", id, "serviceID", service.GetId(), "error", ifaceStatus.Error)
+			_, resp, err := api.DeletePrivateEndpoint(ctx.Context, projectID, cloudProvider, id, service.GetId()).Execute()
+			if err != nil && !isNotFound(resp) {
+				return workflow.Terminate(workflow.PrivateEndpointNotDeletedInAtlas, err.Error())
+			}
+			ifaceStatus = recreatingStatus(ifaceStatus)
+		}
+		statuses = append(statuses, ifaceStatus)
+
+		switch {
+		case recreate:
+			pending = append(pending, id)
+		case gaveUp:
+			failed = append(failed, fmt.Sprintf("%s: %s (gave up after %d recreations)", id, ifaceStatus.Error, ifaceStatus.Recreations))
+		case ifaceStatus.Error != "":
+			failed = append(failed, fmt.Sprintf("%s: %s", id, ifaceStatus.Error))
+		case ifaceStatus.ConnectionStatus != statusAvailable:
+			pending = append(pending, id)
+		}
+	}
+	ctx.EnsureStatusOption(status.AtlasPrivateEndpointInterfacesOption(statuses))
+
+	if len(failed) > 0 {
+		return workflow.Terminate(workflow.PrivateEndpointFailed, fmt.Sprintf("the private endpoints failed: %v", failed))
+	}
+	if len(pending) > 0 {
+		return workflow.InProgress(workflow.PrivateEndpointPending, fmt.Sprintf("waiting for the private endpoints to be available: %v", pending))
+	}
+
+	return workflow.OK()
+}
+
+// interfaceStatus returns the state of the interface, carrying over the transition times of its previous conditions,
+// the time it failed at and its number of recreations
+func interfaceStatus(privateEndpoint *mdbv1.AtlasPrivateEndpoint, id string, endpoint *admin.PrivateLinkEndpoint, err error, now time.Time) status.PrivateEndpointInterfaceStatus {
+	var previous status.PrivateEndpointInterfaceStatus
+	for _, ifaceStatus := range privateEndpoint.Status.Interfaces {
+		if ifaceStatus.ID == id {
+			previous = ifaceStatus
+		}
+	}
+
+	result := status.PrivateEndpointInterfaceStatus{ID: id, Recreations: previous.Recreations}
+	condition := status.Condition{Type: status.ReadyType, Status: corev1.ConditionFalse}
+	switch {
+	case err != nil:
+		result.Error = err.Error()
+		condition.Reason = string(workflow.PrivateEndpointNotCreatedInAtlas)
+		condition.Message = err.Error()
+	default:
+		result.ConnectionStatus = connectionStatus(endpoint)
+		result.Endpoints = forwardingRules(endpoint)
+		failedRules := failedForwardingRules(result.Endpoints)
+		switch {
+		case len(failedRules) > 0:
+			result.Error = fmt.Sprintf("the forwarding rules %s failed", strings.Join(failedRules, ", "))
+			if endpoint.GetErrorMessage() != "" {
+				result.Error = fmt.Sprintf("%s: %s", result.Error, endpoint.GetErrorMessage())
+			}
+			condition.Reason = string(workflow.PrivateEndpointFailed)
+			condition.Message = result.Error
+		case result.ConnectionStatus == statusAvailable:
+			condition.Status = corev1.ConditionTrue
+			result.Recreations = 0
+		case result.ConnectionStatus == statusFailed, result.ConnectionStatus == statusRejected:
+			result.Error = endpoint.GetErrorMessage()
+			if result.Error == "" {
+				result.Error = fmt.Sprintf("the connection is %s", result.ConnectionStatus)
+			}
+			result.FailedSince = previous.FailedSince
+			if result.FailedSince == nil {
+				result.FailedSince = &metav1.Time{Time: now}
+			}
+			condition.Reason = string(workflow.PrivateEndpointFailed)
+			condition.Message = result.Error
+		default:
+			condition.Reason = string(workflow.PrivateEndpointPending)
+			condition.Message = "waiting for the private endpoint to be available"
+		}
+	}
+	result.Conditions = status.EnsureConditionExists(condition, previous.Conditions)
+
+	return result
+}
+
+// recreation tells whether the failed interface is due to be recreated, once it stayed failed for the backoff of the
+// policy doubled after every recreation, or whether it reached the maximum number of recreations
+func recreation(policy *mdbv1.PrivateEndpointRecreationSpec, ifaceStatus status.PrivateEndpointInterfaceStatus, now time.Time) (recreate bool, gaveUp bool) {
+	if policy == nil || ifaceStatus.FailedSince == nil {
+		return false, false
+	}
+
+	maxAttempts := policy.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultRecreationMaxAttempts
+	}
+	if ifaceStatus.Recreations >= maxAttempts {
+		return false, true
+	}
+
+	backoff := defaultRecreationBackoff
+	if policy.Backoff != nil {
+		backoff = policy.Backoff.Duration
+	}
+	backoff <<= ifaceStatus.Recreations
+
+	return !now.Before(ifaceStatus.FailedSince.Add(backoff)), false
+}
+
+// recreatingStatus returns the state of a failed interface being removed to be connected again. The failure reason
+// is kept until the new interface reports its own state
+func recreatingStatus(ifaceStatus status.PrivateEndpointInterfaceStatus) status.PrivateEndpointInterfaceStatus {
+	ifaceStatus.Recreations++
+	ifaceStatus.FailedSince = nil
+	ifaceStatus.Conditions = status.EnsureConditionExists(status.Condition{
+		Type:    status.ReadyType,
+		Status:  corev1.ConditionFalse,
+		Reason:  string(workflow.PrivateEndpointRecreating),
+		Message: fmt.Sprintf("recreating the private endpoint after it failed: %s", ifaceStatus.Error),
+	}, ifaceStatus.Conditions)
+
+	return ifaceStatus
+}
+
+// deletePrivateEndpoint removes the interfaces of the service, then the service once they are gone
+func deletePrivateEndpoint(ctx *workflow.Context, privateEndpoint *mdbv1.AtlasPrivateEndpoint, projectID string) workflow.Result {
+	api := ctx.SdkClient.PrivateEndpointServicesApi
+	cloudProvider := string(privateEndpoint.Spec.Provider)
+	serviceID := privateEndpoint.Status.ServiceID
+
+	service, resp, err := api.GetPrivateEndpointService(ctx.Context, projectID, cloudProvider, serviceID).Execute()
+	if err != nil {
+		if isNotFound(resp) {
+			return workflow.OK()
+		}
+		return workflow.Terminate(workflow.PrivateEndpointNotDeletedInAtlas, err.Error())
+	}
+
+	interfaceIDs := serviceInterfaceIDs(service)
+	for _, id := range interfaceIDs {
+		_, resp, err = api.DeletePrivateEndpoint(ctx.Context, projectID, cloudProvider, id, serviceID).Execute()
+		if err != nil && !isNotFound(resp) {
+			return workflow.Terminate(workflow.PrivateEndpointNotDeletedInAtlas, err.Error())
+		}
+	}
+	if len(interfaceIDs) > 0 {
+		return workflow.InProgress(workflow.PrivateEndpointNotDeletedInAtlas, "waiting for the private endpoints to be removed")
+	}
+
+	_, resp, err = api.DeletePrivateEndpointService(ctx.Context, projectID, cloudProvider, serviceID).Execute()
+	if err != nil && !isNotFound(resp) {
+		return workflow.Terminate(workflow.PrivateEndpointNotDeletedInAtlas, err.Error())
+	}
+
+	return workflow.OK()
+}
+
+// readService returns the service recorded in the status, nil when there is none or it doesn't exist anymore
+func readService(ctx *workflow.Context, privateEndpoint *mdbv1.AtlasPrivateEndpoint, projectID string) (*admin.EndpointService, error) {
+	if privateEndpoint.Status.ServiceID == "" {
+		return nil, nil
+	}
+
+	service, resp, err := ctx.SdkClient.PrivateEndpointServicesApi.
+		GetPrivateEndpointService(ctx.Context, projectID, string(privateEndpoint.Spec.Provider), privateEndpoint.Status.ServiceID).Execute()
+	if err != nil {
+		if isNotFound(resp) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return service, nil
+}
+
+func toAtlasInterface(iface mdbv1.PrivateEndpointInterface) *admin.CreateEndpointRequest {
+	request := &admin.CreateEndpointRequest{}
+	if iface.EndpointGroupName != "" {
+		endpoints := make([]admin.CreateGCPForwardingRuleRequest, 0, len(iface.Endpoints))
+		for _, endpoint := range iface.Endpoints {
+			endpoints = append(endpoints, admin.CreateGCPForwardingRuleRequest{
+				EndpointName: pointer.MakePtr(endpoint.EndpointName),
+				IpAddress:    pointer.MakePtr(endpoint.IPAddress),
+			})
+		}
+		request.EndpointGroupName = pointer.MakePtr(iface.EndpointGroupName)
+		request.GcpProjectId = pointer.MakePtr(iface.GCPProjectID)
+		request.Endpoints = &endpoints
+
+		return request
+	}
+
+	request.Id = pointer.MakePtr(iface.ID)
+	if iface.IP != "" {
+		request.PrivateEndpointIPAddress = pointer.MakePtr(iface.IP)
+	}
+
+	return request
+}
+
+// serviceName returns the name of the service the AWS interface endpoints or the Azure private endpoints connect to
+func serviceName(service *admin.EndpointService) string {
+	if service.GetEndpointServiceName() != "" {
+		return service.GetEndpointServiceName()
+	}
+
+	return service.GetPrivateLinkServiceName()
+}
+
+// serviceInterfaceIDs returns the ids of the interfaces connected to the service, whatever its provider
+func serviceInterfaceIDs(service *admin.EndpointService) []string {
+	switch {
+	case len(service.GetInterfaceEndpoints()) > 0:
+		return service.GetInterfaceEndpoints()
+	case len(service.GetPrivateEndpoints()) > 0:
+		return service.GetPrivateEndpoints()
+	default:
+		return service.GetEndpointGroupNames()
+	}
+}
+
+// connectionStatus returns the state of the interface, reported as the connection status for AWS
+func connectionStatus(endpoint *admin.PrivateLinkEndpoint) string {
+	if endpoint.GetConnectionStatus() != "" {
+		return endpoint.GetConnectionStatus()
+	}
+
+	return endpoint.GetStatus()
+}
+
+// forwardingRules returns the state of the forwarding rules of a GCP endpoint group
+func forwardingRules(endpoint *admin.PrivateLinkEndpoint) []status.GCPEndpoint {
+	if endpoint == nil || len(endpoint.GetEndpoints()) == 0 {
+		return nil
+	}
+
+	rules := make([]status.GCPEndpoint, 0, len(endpoint.GetEndpoints()))
+	for _, rule := range endpoint.GetEndpoints() {
+		rules = append(rules, status.GCPEndpoint{
+			Status:       rule.GetStatus(),
+			EndpointName: rule.GetEndpointName(),
+			IPAddress:    rule.GetIpAddress(),
+		})
+	}
+
+	return rules
+}
+
+func failedForwardingRules(rules []status.GCPEndpoint) []string {
+	var failed []string
+	for _, rule := range rules {
+		if rule.Status == statusFailed {
+			failed = append(failed, rule.EndpointName)
+		}
+	}
+
+	return failed
+}
+
+func isNotFound(resp *http.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusNotFound
+}
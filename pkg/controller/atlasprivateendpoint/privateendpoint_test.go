@@ -0,0 +1,411 @@
+package atlasprivateendpoint
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func newPrivateEndpoint(spec mdbv1.AtlasPrivateEndpointSpec) *mdbv1.AtlasPrivateEndpoint {
+	spec.Project = common.ResourceRefNamespaced{Name: "project"}
+
+	return &mdbv1.AtlasPrivateEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "private-endpoint", Namespace: "default"},
+		Spec:       spec,
+	}
+}
+
+func newProject(privateEndpoints ...mdbv1.PrivateEndpoint) *mdbv1.AtlasProject {
+	return &mdbv1.AtlasProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "project", Namespace: "default"},
+		Spec:       mdbv1.AtlasProjectSpec{PrivateEndpoints: privateEndpoints},
+		Status:     status.AtlasProjectStatus{ID: "project-id"},
+	}
+}
+
+func newContext(t *testing.T, api admin.PrivateEndpointServicesApi) *workflow.Context {
+	ctx := workflow.NewContext(zaptest.NewLogger(t).Sugar(), []status.Condition{}, context.Background())
+	ctx.SdkClient = &admin.APIClient{PrivateEndpointServicesApi: api}
+
+	return ctx
+}
+
+func reconciledStatus(ctx *workflow.Context, privateEndpoint *mdbv1.AtlasPrivateEndpoint) status.AtlasPrivateEndpointStatus {
+	privateEndpoint.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+
+	return privateEndpoint.Status
+}
+
+func conditionStatus(conditions []status.Condition, conditionType status.ConditionType) corev1.ConditionStatus {
+	for _, condition := range conditions {
+		if condition.Type == conditionType {
+			return condition.Status
+		}
+	}
+
+	return ""
+}
+
+func TestValidatePrivateEndpoint(t *testing.T) {
+	t.Run("should require the ip of an Azure interface", func(t *testing.T) {
+		err := validatePrivateEndpoint(newPrivateEndpoint(mdbv1.AtlasPrivateEndpointSpec{
+			Provider:   provider.ProviderAzure,
+			Region:     "eastus2",
+			Interfaces: []mdbv1.PrivateEndpointInterface{{ID: "/subscriptions/1/privateEndpoints/pe-1"}},
+		}), newProject())
+
+		require.EqualError(t, err, "the id and ip of the interface 0 are required for an Azure private endpoint")
+	})
+
+	t.Run("should require the endpoints of a GCP interface", func(t *testing.T) {
+		err := validatePrivateEndpoint(newPrivateEndpoint(mdbv1.AtlasPrivateEndpointSpec{
+			Provider:   provider.ProviderGCP,
+			Region:     "us-east4",
+			Interfaces: []mdbv1.PrivateEndpointInterface{{GCPProjectID: "gcp-project", EndpointGroupName: "group"}},
+		}), newProject())
+
+		require.EqualError(t, err, "the gcpProjectId, endpointGroupName and endpoints of the interface 0 are required for a GCP private endpoint")
+	})
+
+	t.Run("should reject a service managed by the spec of the project", func(t *testing.T) {
+		err := validatePrivateEndpoint(
+			newPrivateEndpoint(mdbv1.AtlasPrivateEndpointSpec{Provider: provider.ProviderAWS, Region: "us-east-1"}),
+			newProject(mdbv1.PrivateEndpoint{Provider: provider.ProviderAWS, Region: "US_EAST_1"}),
+		)
+
+		require.EqualError(t, err, "the AWS private endpoint service in us-east-1 is managed by the privateEndpoints of the project project")
+	})
+
+	t.Run("should reject the duplicated interfaces", func(t *testing.T) {
+		err := validatePrivateEndpoint(newPrivateEndpoint(mdbv1.AtlasPrivateEndpointSpec{
+			Provider:   provider.ProviderAWS,
+			Region:     "us-east-1",
+			Interfaces: []mdbv1.PrivateEndpointInterface{{ID: "vpce-1"}, {ID: "vpce-1"}},
+		}), newProject())
+
+		require.EqualError(t, err, "the interface vpce-1 is listed more than once")
+	})
+}
+
+func TestEnsurePrivateEndpoint(t *testing.T) {
+	awsEndpoint := mdbv1.AtlasPrivateEndpointSpec{
+		Provider:   provider.ProviderAWS,
+		Region:     "us-east-1",
+		Interfaces: []mdbv1.PrivateEndpointInterface{{ID: "vpce-1"}},
+	}
+
+	t.Run("should create the service and wait for it to be available", func(t *testing.T) {
+		api := atlasmock.NewPrivateEndpointServicesApiMock(t)
+		api.EXPECT().CreatePrivateEndpointService(mock.Anything, "project-id", &admin.CloudProviderEndpointServiceRequest{ProviderName: "AWS", Region: "us-east-1"}).
+			Return(admin.CreatePrivateEndpointServiceApiRequest{ApiService: api})
+		api.EXPECT().CreatePrivateEndpointServiceExecute(mock.Anything).Return(
+			&admin.EndpointService{Id: admin.PtrString("service-id"), Status: admin.PtrString("INITIATING")}, nil, nil,
+		)
+		ctx := newContext(t, api)
+		privateEndpoint := newPrivateEndpoint(awsEndpoint)
+
+		result := ensurePrivateEndpoint(ctx, privateEndpoint, newProject(), time.Now())
+
+		assert.Equal(t, workflow.InProgress(workflow.PrivateEndpointServiceInitiating, "waiting for the private endpoint service to be available"), result)
+		endpointStatus := reconciledStatus(ctx, privateEndpoint)
+		assert.Equal(t, "service-id", endpointStatus.ServiceID)
+		assert.Equal(t, "INITIATING", endpointStatus.ServiceStatus)
+		assert.Equal(t, corev1.ConditionFalse, conditionStatus(endpointStatus.Conditions, status.PrivateEndpointServiceReadyType))
+	})
+
+	t.Run("should connect the interfaces and remove the ones not in the spec", func(t *testing.T) {
+		api := atlasmock.NewPrivateEndpointServicesApiMock(t)
+		api.EXPECT().GetPrivateEndpointService(mock.Anything, "project-id", "AWS", "service-id").
+			Return(admin.GetPrivateEndpointServiceApiRequest{ApiService: api})
+		api.EXPECT().GetPrivateEndpointServiceExecute(mock.Anything).Return(
+			&admin.EndpointService{
+				Id:                  admin.PtrString("service-id"),
+				Status:              admin.PtrString("AVAILABLE"),
+				EndpointServiceName: admin.PtrString("com.amazonaws.vpce.us-east-1.vpce-svc-1"),
+				InterfaceEndpoints:  &[]string{"vpce-old"},
+			}, nil, nil,
+		)
+		api.EXPECT().DeletePrivateEndpoint(mock.Anything, "project-id", "AWS", "vpce-old", "service-id").
+			Return(admin.DeletePrivateEndpointApiRequest{ApiService: api})
+		api.EXPECT().DeletePrivateEndpointExecute(mock.Anything).Return(nil, nil, nil)
+		api.EXPECT().CreatePrivateEndpoint(mock.Anything, "project-id", "AWS", "service-id", &admin.CreateEndpointRequest{Id: admin.PtrString("vpce-1")}).
+			Return(admin.CreatePrivateEndpointApiRequest{ApiService: api})
+		api.EXPECT().CreatePrivateEndpointExecute(mock.Anything).Return(
+			&admin.PrivateLinkEndpoint{InterfaceEndpointId: admin.PtrString("vpce-1"), ConnectionStatus: admin.PtrString("PENDING_ACCEPTANCE")}, nil, nil,
+		)
+		ctx := newContext(t, api)
+		privateEndpoint := newPrivateEndpoint(awsEndpoint)
+		privateEndpoint.Status.ServiceID = "service-id"
+
+		result := ensurePrivateEndpoint(ctx, privateEndpoint, newProject(), time.Now())
+
+		assert.Equal(t, workflow.InProgress(workflow.PrivateEndpointPending, "waiting for the private endpoints to be available: [vpce-1]"), result)
+		endpointStatus := reconciledStatus(ctx, privateEndpoint)
+		assert.Equal(t, "com.amazonaws.vpce.us-east-1.vpce-svc-1", endpointStatus.ServiceName)
+		assert.Equal(t, corev1.ConditionTrue, conditionStatus(endpointStatus.Conditions, status.PrivateEndpointServiceReadyType))
+		require.Len(t, endpointStatus.Interfaces, 1)
+		assert.Equal(t, "PENDING_ACCEPTANCE", endpointStatus.Interfaces[0].ConnectionStatus)
+		assert.Equal(t, corev1.ConditionFalse, conditionStatus(endpointStatus.Interfaces[0].Conditions, status.ReadyType))
+	})
+
+	t.Run("should report the state of every interface", func(t *testing.T) {
+		api := atlasmock.NewPrivateEndpointServicesApiMock(t)
+		api.EXPECT().GetPrivateEndpointService(mock.Anything, "project-id", "AWS", "service-id").
+			Return(admin.GetPrivateEndpointServiceApiRequest{ApiService: api})
+		api.EXPECT().GetPrivateEndpointServiceExecute(mock.Anything).Return(
+			&admin.EndpointService{
+				Id:                 admin.PtrString("service-id"),
+				Status:             admin.PtrString("AVAILABLE"),
+				InterfaceEndpoints: &[]string{"vpce-1", "vpce-2"},
+			}, nil, nil,
+		)
+		api.EXPECT().GetPrivateEndpoint(mock.Anything, "project-id", "AWS", "vpce-1", "service-id").
+			Return(admin.GetPrivateEndpointApiRequest{ApiService: api}).Once()
+		api.EXPECT().GetPrivateEndpoint(mock.Anything, "project-id", "AWS", "vpce-2", "service-id").
+			Return(admin.GetPrivateEndpointApiRequest{ApiService: api}).Once()
+		api.EXPECT().GetPrivateEndpointExecute(mock.Anything).Return(
+			&admin.PrivateLinkEndpoint{ConnectionStatus: admin.PtrString("AVAILABLE")}, nil, nil,
+		).Once()
+		api.EXPECT().GetPrivateEndpointExecute(mock.Anything).Return(
+			&admin.PrivateLinkEndpoint{ConnectionStatus: admin.PtrString("REJECTED"), ErrorMessage: admin.PtrString("the endpoint was rejected")}, nil, nil,
+		).Once()
+		ctx := newContext(t, api)
+		privateEndpoint := newPrivateEndpoint(mdbv1.AtlasPrivateEndpointSpec{
+			Provider:   provider.ProviderAWS,
+			Region:     "us-east-1",
+			Interfaces: []mdbv1.PrivateEndpointInterface{{ID: "vpce-1"}, {ID: "vpce-2"}},
+		})
+		privateEndpoint.Status.ServiceID = "service-id"
+
+		result := ensurePrivateEndpoint(ctx, privateEndpoint, newProject(), time.Now())
+
+		assert.Equal(t, workflow.Terminate(workflow.PrivateEndpointFailed, "the private endpoints failed: [vpce-2: the endpoint was rejected]"), result)
+		endpointStatus := reconciledStatus(ctx, privateEndpoint)
+		require.Len(t, endpointStatus.Interfaces, 2)
+		assert.Equal(t, corev1.ConditionTrue, conditionStatus(endpointStatus.Interfaces[0].Conditions, status.ReadyType))
+		assert.Equal(t, "the endpoint was rejected", endpointStatus.Interfaces[1].Error)
+		assert.Equal(t, corev1.ConditionFalse, conditionStatus(endpointStatus.Interfaces[1].Conditions, status.ReadyType))
+		assert.Equal(t, corev1.ConditionFalse, conditionStatus(endpointStatus.Conditions, status.PrivateEndpointReadyType))
+	})
+
+	t.Run("should report the failure to connect an interface", func(t *testing.T) {
+		api := atlasmock.NewPrivateEndpointServicesApiMock(t)
+		api.EXPECT().GetPrivateEndpointService(mock.Anything, "project-id", "AWS", "service-id").
+			Return(admin.GetPrivateEndpointServiceApiRequest{ApiService: api})
+		api.EXPECT().GetPrivateEndpointServiceExecute(mock.Anything).Return(
+			&admin.EndpointService{Id: admin.PtrString("service-id"), Status: admin.PtrString("AVAILABLE")}, nil, nil,
+		)
+		api.EXPECT().CreatePrivateEndpoint(mock.Anything, "project-id", "AWS", "service-id", mock.Anything).
+			Return(admin.CreatePrivateEndpointApiRequest{ApiService: api})
+		api.EXPECT().CreatePrivateEndpointExecute(mock.Anything).Return(nil, nil, errors.New("endpoint not found in the VPC"))
+		ctx := newContext(t, api)
+		privateEndpoint := newPrivateEndpoint(awsEndpoint)
+		privateEndpoint.Status.ServiceID = "service-id"
+
+		result := ensurePrivateEndpoint(ctx, privateEndpoint, newProject(), time.Now())
+
+		assert.Equal(t, workflow.Terminate(workflow.PrivateEndpointFailed, "the private endpoints failed: [vpce-1: endpoint not found in the VPC]"), result)
+		assert.Equal(t, "endpoint not found in the VPC", reconciledStatus(ctx, privateEndpoint).Interfaces[0].Error)
+	})
+
+	t.Run("should create a new service when the recorded one doesn't exist anymore", func(t *testing.T) {
+		api := atlasmock.NewPrivateEndpointServicesApiMock(t)
+		api.EXPECT().GetPrivateEndpointService(mock.Anything, "project-id", "AWS", "service-id").
+			Return(admin.GetPrivateEndpointServiceApiRequest{ApiService: api})
+		api.EXPECT().GetPrivateEndpointServiceExecute(mock.Anything).Return(nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found"))
+		api.EXPECT().CreatePrivateEndpointService(mock.Anything, "project-id", mock.Anything).
+			Return(admin.CreatePrivateEndpointServiceApiRequest{ApiService: api})
+		api.EXPECT().CreatePrivateEndpointServiceExecute(mock.Anything).Return(
+			&admin.EndpointService{Id: admin.PtrString("new-service-id"), Status: admin.PtrString("INITIATING")}, nil, nil,
+		)
+		ctx := newContext(t, api)
+		privateEndpoint := newPrivateEndpoint(awsEndpoint)
+		privateEndpoint.Status.ServiceID = "service-id"
+
+		ensurePrivateEndpoint(ctx, privateEndpoint, newProject(), time.Now())
+
+		assert.Equal(t, "new-service-id", reconciledStatus(ctx, privateEndpoint).ServiceID)
+	})
+
+	t.Run("should not retry an invalid spec", func(t *testing.T) {
+		ctx := newContext(t, atlasmock.NewPrivateEndpointServicesApiMock(t))
+		privateEndpoint := newPrivateEndpoint(mdbv1.AtlasPrivateEndpointSpec{
+			Provider:   provider.ProviderAWS,
+			Region:     "us-east-1",
+			Interfaces: []mdbv1.PrivateEndpointInterface{{}},
+		})
+
+		result := ensurePrivateEndpoint(ctx, privateEndpoint, newProject(), time.Now())
+
+		assert.Equal(t, workflow.Terminate(workflow.PrivateEndpointInvalidSpec, "the id of the interface 0 is required for an AWS private endpoint").WithoutRetry(), result)
+	})
+}
+
+func TestDeletePrivateEndpoint(t *testing.T) {
+	newDeletedEndpoint := func() *mdbv1.AtlasPrivateEndpoint {
+		privateEndpoint := newPrivateEndpoint(mdbv1.AtlasPrivateEndpointSpec{Provider: provider.ProviderAWS, Region: "us-east-1"})
+		privateEndpoint.Status.ServiceID = "service-id"
+
+		return privateEndpoint
+	}
+
+	t.Run("should remove the interfaces before the service", func(t *testing.T) {
+		api := atlasmock.NewPrivateEndpointServicesApiMock(t)
+		api.EXPECT().GetPrivateEndpointService(mock.Anything, "project-id", "AWS", "service-id").
+			Return(admin.GetPrivateEndpointServiceApiRequest{ApiService: api})
+		api.EXPECT().GetPrivateEndpointServiceExecute(mock.Anything).Return(
+			&admin.EndpointService{Id: admin.PtrString("service-id"), InterfaceEndpoints: &[]string{"vpce-1"}}, nil, nil,
+		)
+		api.EXPECT().DeletePrivateEndpoint(mock.Anything, "project-id", "AWS", "vpce-1", "service-id").
+			Return(admin.DeletePrivateEndpointApiRequest{ApiService: api})
+		api.EXPECT().DeletePrivateEndpointExecute(mock.Anything).Return(nil, nil, nil)
+
+		result := deletePrivateEndpoint(newContext(t, api), newDeletedEndpoint(), "project-id")
+
+		assert.Equal(t, workflow.InProgress(workflow.PrivateEndpointNotDeletedInAtlas, "waiting for the private endpoints to be removed"), result)
+	})
+
+	t.Run("should remove the service without interfaces", func(t *testing.T) {
+		api := atlasmock.NewPrivateEndpointServicesApiMock(t)
+		api.EXPECT().GetPrivateEndpointService(mock.Anything, "project-id", "AWS", "service-id").
+			Return(admin.GetPrivateEndpointServiceApiRequest{ApiService: api})
+		api.EXPECT().GetPrivateEndpointServiceExecute(mock.Anything).Return(&admin.EndpointService{Id: admin.PtrString("service-id")}, nil, nil)
+		api.EXPECT().DeletePrivateEndpointService(mock.Anything, "project-id", "AWS", "service-id").
+			Return(admin.DeletePrivateEndpointServiceApiRequest{ApiService: api})
+		api.EXPECT().DeletePrivateEndpointServiceExecute(mock.Anything).Return(nil, nil, nil)
+
+		assert.True(t, deletePrivateEndpoint(newContext(t, api), newDeletedEndpoint(), "project-id").IsOk())
+	})
+
+	t.Run("should ignore the services already removed", func(t *testing.T) {
+		api := atlasmock.NewPrivateEndpointServicesApiMock(t)
+		api.EXPECT().GetPrivateEndpointService(mock.Anything, "project-id", "AWS", "service-id").
+			Return(admin.GetPrivateEndpointServiceApiRequest{ApiService: api})
+		api.EXPECT().GetPrivateEndpointServiceExecute(mock.Anything).Return(
+			nil, &http.Response{StatusCode: http.StatusNotFound}, &admin.GenericOpenAPIError{},
+		)
+
+		assert.True(t, deletePrivateEndpoint(newContext(t, api), newDeletedEndpoint(), "project-id").IsOk())
+	})
+}
+
+func TestInterfaceStatus(t *testing.T) {
+	t.Run("should report the state of the forwarding rules of a GCP endpoint group", func(t *testing.T) {
+		privateEndpoint := newPrivateEndpoint(mdbv1.AtlasPrivateEndpointSpec{Provider: provider.ProviderGCP, Region: "us-east4"})
+
+		ifaceStatus := interfaceStatus(privateEndpoint, "group-1", &admin.PrivateLinkEndpoint{
+			Status: admin.PtrString("INITIATING"),
+			Endpoints: &[]admin.GCPConsumerForwardingRule{
+				{EndpointName: admin.PtrString("rule-1"), IpAddress: admin.PtrString("10.0.0.1"), Status: admin.PtrString("AVAILABLE")},
+				{EndpointName: admin.PtrString("rule-2"), IpAddress: admin.PtrString("10.0.0.2"), Status: admin.PtrString("FAILED")},
+			},
+		}, nil, time.Now())
+
+		assert.Equal(t, []status.GCPEndpoint{
+			{Status: "AVAILABLE", EndpointName: "rule-1", IPAddress: "10.0.0.1"},
+			{Status: "FAILED", EndpointName: "rule-2", IPAddress: "10.0.0.2"},
+		}, ifaceStatus.Endpoints)
+		assert.Equal(t, "the forwarding rules rule-2 failed", ifaceStatus.Error)
+		assert.Equal(t, string(workflow.PrivateEndpointFailed), ifaceStatus.Conditions[0].Reason)
+	})
+}
+
+func TestEnsureInterfacesRecreation(t *testing.T) {
+	now := time.Date(2024, 1, 10, 10, 0, 0, 0, time.UTC)
+	service := &admin.EndpointService{Id: admin.PtrString("service-id"), InterfaceEndpoints: &[]string{"vpce-1"}}
+	rejectedEndpoint := func(api *atlasmock.PrivateEndpointServicesApiMock) {
+		api.EXPECT().GetPrivateEndpoint(mock.Anything, "project-id", "AWS", "vpce-1", "service-id").
+			Return(admin.GetPrivateEndpointApiRequest{ApiService: api})
+		api.EXPECT().GetPrivateEndpointExecute(mock.Anything).Return(
+			&admin.PrivateLinkEndpoint{ConnectionStatus: admin.PtrString("REJECTED"), ErrorMessage: admin.PtrString("the connection was rejected in AWS")}, nil, nil,
+		)
+	}
+	failedPrivateEndpoint := func(failedSince time.Time, recreations int) *mdbv1.AtlasPrivateEndpoint {
+		privateEndpoint := newPrivateEndpoint(mdbv1.AtlasPrivateEndpointSpec{
+			Provider:   provider.ProviderAWS,
+			Region:     "us-east-1",
+			Interfaces: []mdbv1.PrivateEndpointInterface{{ID: "vpce-1"}},
+			Recreation: &mdbv1.PrivateEndpointRecreationSpec{Backoff: &metav1.Duration{Duration: 15 * time.Minute}, MaxAttempts: 2},
+		})
+		privateEndpoint.Status.Interfaces = []status.PrivateEndpointInterfaceStatus{
+			{ID: "vpce-1", ConnectionStatus: "REJECTED", FailedSince: &metav1.Time{Time: failedSince}, Recreations: recreations},
+		}
+
+		return privateEndpoint
+	}
+
+	t.Run("should recreate a failed interface after the backoff", func(t *testing.T) {
+		api := atlasmock.NewPrivateEndpointServicesApiMock(t)
+		rejectedEndpoint(api)
+		api.EXPECT().DeletePrivateEndpoint(mock.Anything, "project-id", "AWS", "vpce-1", "service-id").
+			Return(admin.DeletePrivateEndpointApiRequest{ApiService: api})
+		api.EXPECT().DeletePrivateEndpointExecute(mock.Anything).Return(nil, nil, nil)
+		ctx := newContext(t, api)
+		privateEndpoint := failedPrivateEndpoint(now.Add(-20*time.Minute), 0)
+
+		result := ensureInterfaces(ctx, privateEndpoint, "project-id", service, now)
+
+		assert.Equal(t, workflow.InProgress(workflow.PrivateEndpointPending, "waiting for the private endpoints to be available: [vpce-1]"), result)
+		ifaceStatus := reconciledStatus(ctx, privateEndpoint).Interfaces[0]
+		assert.Equal(t, 1, ifaceStatus.Recreations)
+		assert.Nil(t, ifaceStatus.FailedSince)
+		assert.Equal(t, "the connection was rejected in AWS", ifaceStatus.Error)
+		assert.Equal(t, string(workflow.PrivateEndpointRecreating), ifaceStatus.Conditions[0].Reason)
+	})
+
+	t.Run("should double the backoff after every recreation", func(t *testing.T) {
+		api := atlasmock.NewPrivateEndpointServicesApiMock(t)
+		rejectedEndpoint(api)
+		ctx := newContext(t, api)
+		privateEndpoint := failedPrivateEndpoint(now.Add(-20*time.Minute), 1)
+
+		result := ensureInterfaces(ctx, privateEndpoint, "project-id", service, now)
+
+		assert.Equal(t, workflow.Terminate(workflow.PrivateEndpointFailed, "the private endpoints failed: [vpce-1: the connection was rejected in AWS]"), result)
+		ifaceStatus := reconciledStatus(ctx, privateEndpoint).Interfaces[0]
+		assert.Equal(t, now.Add(-20*time.Minute), ifaceStatus.FailedSince.Time)
+		assert.Equal(t, 1, ifaceStatus.Recreations)
+	})
+
+	t.Run("should give up once the interface was recreated too many times", func(t *testing.T) {
+		api := atlasmock.NewPrivateEndpointServicesApiMock(t)
+		rejectedEndpoint(api)
+		ctx := newContext(t, api)
+		privateEndpoint := failedPrivateEndpoint(now.Add(-24*time.Hour), 2)
+
+		result := ensureInterfaces(ctx, privateEndpoint, "project-id", service, now)
+
+		assert.Equal(t, workflow.Terminate(workflow.PrivateEndpointFailed, "the private endpoints failed: [vpce-1: the connection was rejected in AWS (gave up after 2 recreations)]"), result)
+	})
+
+	t.Run("should only track the failure without a recreation policy", func(t *testing.T) {
+		api := atlasmock.NewPrivateEndpointServicesApiMock(t)
+		rejectedEndpoint(api)
+		ctx := newContext(t, api)
+		privateEndpoint := failedPrivateEndpoint(now.Add(-24*time.Hour), 0)
+		privateEndpoint.Spec.Recreation = nil
+		privateEndpoint.Status.Interfaces = nil
+
+		result := ensureInterfaces(ctx, privateEndpoint, "project-id", service, now)
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, now, reconciledStatus(ctx, privateEndpoint).Interfaces[0].FailedSince.Time)
+	})
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
 
+const alertConfigurationReconciler = "alertConfigurations"
+
 func (r *AtlasProjectReconciler) ensureAlertConfigurations(service *workflow.Context, project *mdbv1.AtlasProject) workflow.Result {
 	service.Log.Debug("starting alert configurations processing")
 	defer service.Log.Debug("finished alert configurations processing")
@@ -27,6 +29,7 @@ func (r *AtlasProjectReconciler) ensureAlertConfigurations(service *workflow.Con
 		alertConfigurationCondition := status.AlertConfigurationReadyType
 		if len(specToSync) == 0 {
 			service.UnsetCondition(alertConfigurationCondition)
+			syncCompleted(service, alertConfigurationsResource)
 			return workflow.OK()
 		}
 		err := r.readAlertConfigurationsSecretsData(project, service, specToSync)
@@ -43,6 +46,7 @@ func (r *AtlasProjectReconciler) ensureAlertConfigurations(service *workflow.Con
 		return result
 	}
 	service.UnsetCondition(status.AlertConfigurationReadyType)
+	syncCompleted(service, alertConfigurationsResource)
 	service.Log.Debugf("Alert configuration sync is disabled for project %s", project.Name)
 	return workflow.OK()
 }
@@ -109,6 +113,26 @@ func (r *AtlasProjectReconciler) readAlertConfigurationsSecretsData(project *mdb
 					return err
 				}
 				nf.SetVictorOpsRoutingKey(token)
+			case nf.WebhookSecretRef.Name != "":
+				data, res, err := readNotificationSecretData(service.Context, r.Client, nf.WebhookSecretRef, projectNs)
+				resourcesToWatch = append(resourcesToWatch, *res)
+				if err != nil {
+					return err
+				}
+				url, err := notificationSecretValue(data, nf.WebhookSecretRef, projectNs, "WebhookURL")
+				if err != nil {
+					return err
+				}
+				nf.SetWebhookURL(url)
+				// the webhook secret is optional, the requests aren't signed without it
+				nf.SetWebhookSecret(string(data["WebhookSecret"]))
+			case nf.MicrosoftTeamsWebhookURLRef.Name != "":
+				token, res, err := readNotificationSecret(service.Context, r.Client, nf.MicrosoftTeamsWebhookURLRef, projectNs, "MicrosoftTeamsWebhookURL")
+				resourcesToWatch = append(resourcesToWatch, *res)
+				if err != nil {
+					return err
+				}
+				nf.SetMicrosoftTeamsWebhookURL(token)
 			}
 		}
 	}
@@ -116,42 +140,67 @@ func (r *AtlasProjectReconciler) readAlertConfigurationsSecretsData(project *mdb
 }
 
 func readNotificationSecret(ctx context.Context, kubeClient client.Client, res common.ResourceRefNamespaced, parentNamespace string, fieldName string) (string, *watch.WatchedObject, error) {
-	secret := &v1.Secret{}
-	var ns string
-	if res.Namespace == "" {
-		ns = parentNamespace
-	} else {
-		ns = res.Namespace
+	data, obj, err := readNotificationSecretData(ctx, kubeClient, res, parentNamespace)
+	if err != nil {
+		return "", obj, err
 	}
 
-	secretObj := client.ObjectKey{Name: res.Name, Namespace: ns}
+	val, err := notificationSecretValue(data, res, parentNamespace, fieldName)
+	return val, obj, err
+}
+
+func readNotificationSecretData(ctx context.Context, kubeClient client.Client, res common.ResourceRefNamespaced, parentNamespace string) (map[string][]byte, *watch.WatchedObject, error) {
+	secret := &v1.Secret{}
+	secretObj := client.ObjectKey{Name: res.Name, Namespace: notificationSecretNamespace(res, parentNamespace)}
 	obj := &watch.WatchedObject{ResourceKind: "Secret", Resource: secretObj}
 
 	if err := kubeClient.Get(ctx, secretObj, secret); err != nil {
-		return "", obj, err
+		return nil, obj, err
 	}
-	val, exists := secret.Data[fieldName]
+
+	return secret.Data, obj, nil
+}
+
+func notificationSecretValue(data map[string][]byte, res common.ResourceRefNamespaced, parentNamespace string, fieldName string) (string, error) {
+	ns := notificationSecretNamespace(res, parentNamespace)
+	val, exists := data[fieldName]
 	switch {
 	case !exists:
-		return "", obj, fmt.Errorf("secret '%s/%s' doesn't contain '%s' parameter", ns, res.Name, fieldName)
+		return "", fmt.Errorf("secret '%s/%s' doesn't contain '%s' parameter", ns, res.Name, fieldName)
 	case len(val) == 0:
-		return "", obj, fmt.Errorf("secret '%s/%s' contains an empty value for '%s' parameter", ns, res.Name, fieldName)
+		return "", fmt.Errorf("secret '%s/%s' contains an empty value for '%s' parameter", ns, res.Name, fieldName)
 	}
-	return string(val), obj, nil
+
+	return string(val), nil
+}
+
+func notificationSecretNamespace(res common.ResourceRefNamespaced, parentNamespace string) string {
+	if res.Namespace == "" {
+		return parentNamespace
+	}
+
+	return res.Namespace
 }
 
+const alertConfigurationsResource = "alertConfigurations"
+
 func syncAlertConfigurations(service *workflow.Context, groupID string, alertSpec []mdbv1.AlertConfiguration) workflow.Result {
 	logger := service.Log
-	existedAlertConfigs, _, err := service.Client.AlertConfigurations.List(service.Context, groupID, nil)
+	existedAlertConfigs, err := listAlertConfigurations(service, groupID)
 	if err != nil {
 		logger.Errorf("failed to list alert configurations: %v", err)
-		return workflow.Terminate(workflow.ProjectAlertConfigurationIsNotReadyInAtlas, fmt.Sprintf("failed to list alert configurations: %v", err))
+		if isRateLimited(err) {
+			return syncInProgress(service, alertConfigurationsProgress(alertSpec, nil), true)
+		}
+		return workflow.Terminate(workflow.ProjectAlertConfigurationIsNotReadyInAtlas, fmt.Sprintf("failed to list alert configurations: %v", err)).
+			WithCause(alertConfigurationReconciler, "listAlertConfigurations")
 	}
 
 	diff := sortAlertConfigs(logger, alertSpec, existedAlertConfigs)
 	logger.Debugf("to create %v, to create statuses %v, to delete %v", len(diff.Create), len(diff.CreateStatus), len(diff.Delete))
 
-	newStatuses := createAlertConfigs(service, groupID, diff.Create)
+	toCreate, budget := syncChunk(diff.Create, syncChunkSize)
+	newStatuses, created, err := createAlertConfigs(service, groupID, toCreate)
 
 	for _, existedAlertConfig := range diff.CreateStatus {
 		newStatuses = append(newStatuses, status.ParseAlertConfiguration(existedAlertConfig))
@@ -159,41 +208,99 @@ func syncAlertConfigurations(service *workflow.Context, groupID string, alertSpe
 
 	service.EnsureStatusOption(status.AtlasProjectSetAlertConfigOption(&newStatuses))
 
-	err = deleteAlertConfigs(service, groupID, diff.Delete)
+	diff.Create = diff.Create[created:]
 	if err != nil {
-		return workflow.Terminate(workflow.ProjectAlertConfigurationIsNotReadyInAtlas, fmt.Sprintf("failed to delete alert configurations: %v", err))
+		return syncInProgress(service, alertConfigurationsProgress(alertSpec, &diff), true)
+	}
+
+	if result := checkAlertConfigurationStatuses(newStatuses); !result.IsOk() {
+		return result
 	}
 
-	return checkAlertConfigurationStatuses(newStatuses)
+	toDelete, _ := syncChunk(diff.Delete, budget)
+	deleted, err := deleteAlertConfigs(service, groupID, toDelete)
+	diff.Delete = diff.Delete[deleted:]
+	if err != nil {
+		if isRateLimited(err) {
+			return syncInProgress(service, alertConfigurationsProgress(alertSpec, &diff), true)
+		}
+		return workflow.Terminate(workflow.ProjectAlertConfigurationIsNotReadyInAtlas, fmt.Sprintf("failed to delete alert configurations: %v", err)).
+			WithCause(alertConfigurationReconciler, "deleteAlertConfiguration")
+	}
+
+	if len(diff.Create) > 0 || len(diff.Delete) > 0 {
+		return syncInProgress(service, alertConfigurationsProgress(alertSpec, &diff), false)
+	}
+
+	syncCompleted(service, alertConfigurationsResource)
+
+	return workflow.OK()
+}
+
+// listAlertConfigurations returns all the alert configurations of the project, reading every page of the list
+func listAlertConfigurations(workflowCtx *workflow.Context, groupID string) ([]mongodbatlas.AlertConfiguration, error) {
+	var alertConfigs []mongodbatlas.AlertConfiguration
+	for pageNum := 1; ; pageNum++ {
+		page, _, err := workflowCtx.Client.AlertConfigurations.List(workflowCtx.Context, groupID, &mongodbatlas.ListOptions{PageNum: pageNum, ItemsPerPage: listItemsPerPage})
+		if err != nil {
+			return nil, err
+		}
+
+		alertConfigs = append(alertConfigs, page...)
+		if len(page) < listItemsPerPage {
+			return alertConfigs, nil
+		}
+	}
+}
+
+// alertConfigurationsProgress returns the progress of the synchronization from the changes still to apply in Atlas.
+// All the alert configurations are pending when the changes are unknown
+func alertConfigurationsProgress(alertSpec []mdbv1.AlertConfiguration, pending *alertConfigurationDiff) status.ResourceSyncProgress {
+	progress := status.ResourceSyncProgress{
+		Resource: alertConfigurationsResource,
+		Total:    len(alertSpec),
+	}
+
+	if pending != nil {
+		progress.Synced = len(alertSpec) - len(pending.Create)
+		progress.PendingDeletions = len(pending.Delete)
+	}
+
+	return progress
 }
 
 func checkAlertConfigurationStatuses(statuses []status.AlertConfiguration) workflow.Result {
 	for _, alertConfigurationStatus := range statuses {
 		if alertConfigurationStatus.ErrorMessage != "" {
 			return workflow.Terminate(workflow.ProjectAlertConfigurationIsNotReadyInAtlas,
-				fmt.Sprintf("failed to create alert configuration: %s", alertConfigurationStatus.ErrorMessage))
+				fmt.Sprintf("failed to create alert configuration: %s", alertConfigurationStatus.ErrorMessage)).
+				WithCause(alertConfigurationReconciler, "createAlertConfiguration")
 		}
 	}
 	return workflow.OK()
 }
 
-func deleteAlertConfigs(workflowCtx *workflow.Context, groupID string, alertConfigIDs []string) error {
+// deleteAlertConfigs deletes the alert configurations and returns how many were deleted before any failure
+func deleteAlertConfigs(workflowCtx *workflow.Context, groupID string, alertConfigIDs []string) (int, error) {
 	logger := workflowCtx.Log
-	for _, alertConfigID := range alertConfigIDs {
+	for i, alertConfigID := range alertConfigIDs {
 		_, err := workflowCtx.Client.AlertConfigurations.Delete(workflowCtx.Context, groupID, alertConfigID)
 		if err != nil {
 			logger.Errorf("failed to delete alert configuration: %v", err)
-			return err
+			return i, err
 		}
 		logger.Infof("Alert configuration %s deleted.", alertConfigID)
 	}
-	return nil
+	return len(alertConfigIDs), nil
 }
 
-func createAlertConfigs(workflowCtx *workflow.Context, groupID string, alertSpec []mdbv1.AlertConfiguration) []status.AlertConfiguration {
+// createAlertConfigs creates the alert configurations and returns their statuses along with the number of alert
+// configurations processed. The creation stops with an error when Atlas rate limits the requests, other failures are
+// reported in the statuses
+func createAlertConfigs(workflowCtx *workflow.Context, groupID string, alertSpec []mdbv1.AlertConfiguration) ([]status.AlertConfiguration, int, error) {
 	logger := workflowCtx.Log
 	var result []status.AlertConfiguration
-	for _, alert := range alertSpec {
+	for i, alert := range alertSpec {
 		atlasAlert, err := alert.ToAtlas()
 		if err != nil {
 			logger.Errorf("failed to convert spec to atlas alert configuration: %v", err)
@@ -209,6 +316,9 @@ func createAlertConfigs(workflowCtx *workflow.Context, groupID string, alertSpec
 		alertConfiguration, _, err := workflowCtx.Client.AlertConfigurations.Create(workflowCtx.Context, groupID, atlasAlert)
 		if err != nil {
 			logger.Errorf("failed to create alert configuration: %v", err)
+			if isRateLimited(err) {
+				return result, i, err
+			}
 			result = append(result, status.NewIncorrectAlertConfigStatus(fmt.Sprintf("failed to create atlas alert configuration: %v", err), atlasAlert))
 		} else {
 			if alertConfiguration == nil {
@@ -219,7 +329,7 @@ func createAlertConfigs(workflowCtx *workflow.Context, groupID string, alertSpec
 			}
 		}
 	}
-	return result
+	return result, len(alertSpec), nil
 }
 
 func sortAlertConfigs(logger *zap.SugaredLogger, alertConfigSpecs []mdbv1.AlertConfiguration, atlasAlertConfigs []mongodbatlas.AlertConfiguration) alertConfigurationDiff {
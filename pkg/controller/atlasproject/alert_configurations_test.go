@@ -0,0 +1,222 @@
+package atlasproject
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/watch"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func alertConfigurationSpecs(count int) []mdbv1.AlertConfiguration {
+	alertConfigs := make([]mdbv1.AlertConfiguration, 0, count)
+	for i := 0; i < count; i++ {
+		alertConfigs = append(alertConfigs, mdbv1.AlertConfiguration{Enabled: true, EventTypeName: fmt.Sprintf("EVENT_%d", i)})
+	}
+
+	return alertConfigs
+}
+
+func syncedProject(workflowCtx *workflow.Context) *mdbv1.AtlasProject {
+	project := &mdbv1.AtlasProject{}
+	project.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+
+	return project
+}
+
+func TestSyncAlertConfigurations(t *testing.T) {
+	t.Run("should create the alert configurations over several reconciliations", func(t *testing.T) {
+		created := 0
+		alertConfigsMock := &atlas.AlertConfigurationsMock{
+			ListFunc: func(projectID string) ([]mongodbatlas.AlertConfiguration, *mongodbatlas.Response, error) {
+				return nil, nil, nil
+			},
+			CreateFunc: func(projectID string, alertConfig *mongodbatlas.AlertConfiguration) (*mongodbatlas.AlertConfiguration, *mongodbatlas.Response, error) {
+				created++
+				alertConfig.ID = fmt.Sprintf("alert-%d", created)
+				return alertConfig, nil, nil
+			},
+		}
+		workflowCtx := &workflow.Context{
+			Client:  &mongodbatlas.Client{AlertConfigurations: alertConfigsMock},
+			Context: context.Background(),
+			Log:     zaptest.NewLogger(t).Sugar(),
+		}
+
+		result := syncAlertConfigurations(workflowCtx, "project-id", alertConfigurationSpecs(syncChunkSize+10))
+
+		assert.True(t, result.IsInProgress())
+		assert.Equal(t, "synchronized 50 of 60 alertConfigurations", result.GetMessage())
+		assert.Equal(t, syncChunkSize, created)
+		assert.Equal(
+			t,
+			[]status.ResourceSyncProgress{{Resource: alertConfigurationsResource, Synced: 50, Total: 60}},
+			syncedProject(workflowCtx).Status.SyncProgress,
+		)
+	})
+
+	t.Run("should pause the synchronization when rate limited by Atlas", func(t *testing.T) {
+		created := 0
+		alertConfigsMock := &atlas.AlertConfigurationsMock{
+			ListFunc: func(projectID string) ([]mongodbatlas.AlertConfiguration, *mongodbatlas.Response, error) {
+				return nil, nil, nil
+			},
+			CreateFunc: func(projectID string, alertConfig *mongodbatlas.AlertConfiguration) (*mongodbatlas.AlertConfiguration, *mongodbatlas.Response, error) {
+				if created == 3 {
+					return nil, nil, &mongodbatlas.ErrorResponse{HTTPCode: http.StatusTooManyRequests, ErrorCode: "RATE_LIMITED"}
+				}
+				created++
+				return alertConfig, nil, nil
+			},
+		}
+		workflowCtx := &workflow.Context{
+			Client:  &mongodbatlas.Client{AlertConfigurations: alertConfigsMock},
+			Context: context.Background(),
+			Log:     zaptest.NewLogger(t).Sugar(),
+		}
+
+		result := syncAlertConfigurations(workflowCtx, "project-id", alertConfigurationSpecs(10))
+
+		assert.True(t, result.IsInProgress())
+		assert.Equal(t, "synchronized 3 of 10 alertConfigurations, paused by the Atlas rate limits", result.GetMessage())
+		assert.Equal(t, rateLimitedRetry, result.ReconcileResult().RequeueAfter)
+		assert.Len(t, syncedProject(workflowCtx).Status.AlertConfigurations, 3)
+	})
+
+	t.Run("should delete the remaining alert configurations and clear the progress", func(t *testing.T) {
+		alertConfigsMock := &atlas.AlertConfigurationsMock{
+			ListFunc: func(projectID string) ([]mongodbatlas.AlertConfiguration, *mongodbatlas.Response, error) {
+				return []mongodbatlas.AlertConfiguration{
+					{ID: "kept", Enabled: pointer.MakePtr(true), EventTypeName: "EVENT_0"},
+					{ID: "removed", Enabled: pointer.MakePtr(true), EventTypeName: "REMOVED"},
+				}, nil, nil
+			},
+			DeleteFunc: func(projectID string, alertConfigID string) (*mongodbatlas.Response, error) {
+				return nil, nil
+			},
+		}
+		workflowCtx := &workflow.Context{
+			Client:  &mongodbatlas.Client{AlertConfigurations: alertConfigsMock},
+			Context: context.Background(),
+			Log:     zaptest.NewLogger(t).Sugar(),
+		}
+		workflowCtx.EnsureStatusOption(status.AtlasProjectSyncProgressOption(alertConfigurationsResource, &status.ResourceSyncProgress{Resource: alertConfigurationsResource}))
+
+		result := syncAlertConfigurations(workflowCtx, "project-id", alertConfigurationSpecs(1))
+
+		assert.True(t, result.IsOk())
+		assert.Contains(t, alertConfigsMock.DeleteRequests, "project-id.removed")
+		assert.Empty(t, syncedProject(workflowCtx).Status.SyncProgress)
+	})
+}
+
+func TestSyncChunk(t *testing.T) {
+	items := []int{1, 2, 3}
+
+	chunk, budget := syncChunk(items, 5)
+	assert.Equal(t, items, chunk)
+	assert.Equal(t, 2, budget)
+
+	chunk, budget = syncChunk(items, 2)
+	assert.Equal(t, []int{1, 2}, chunk)
+	assert.Equal(t, 0, budget)
+
+	chunk, budget = syncChunk(items, 0)
+	assert.Empty(t, chunk)
+	assert.Equal(t, 0, budget)
+}
+
+func TestReadAlertConfigurationsSecretsData(t *testing.T) {
+	newReconciler := func(t *testing.T, secrets ...*corev1.Secret) *AtlasProjectReconciler {
+		sch := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(sch))
+		objects := make([]client.Object, 0, len(secrets))
+		for _, secret := range secrets {
+			objects = append(objects, secret)
+		}
+
+		return &AtlasProjectReconciler{
+			Client: fake.NewClientBuilder().WithScheme(sch).WithObjects(objects...).Build(),
+			Log:    zaptest.NewLogger(t).Sugar(),
+		}
+	}
+	newSecret := func(name string, data map[string]string) *corev1.Secret {
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"}, Data: map[string][]byte{}}
+		for key, value := range data {
+			secret.Data[key] = []byte(value)
+		}
+
+		return secret
+	}
+	project := mdbv1.NewProject("ns", "project", "project")
+
+	t.Run("should resolve and watch the webhook secrets", func(t *testing.T) {
+		r := newReconciler(t,
+			newSecret("webhook", map[string]string{"WebhookURL": "https://hooks.example.com/atlas", "WebhookSecret": "signing-secret"}),
+			newSecret("teams", map[string]string{"MicrosoftTeamsWebhookURL": "https://teams.example.com/hook"}),
+		)
+		workflowCtx := newContext(t)
+		alertConfigs := []mdbv1.AlertConfiguration{{Notifications: []mdbv1.Notification{
+			{TypeName: "WEBHOOK", WebhookSecretRef: common.ResourceRefNamespaced{Name: "webhook"}},
+			{TypeName: "MICROSOFT_TEAMS", MicrosoftTeamsWebhookURLRef: common.ResourceRefNamespaced{Name: "teams"}},
+		}}}
+
+		require.NoError(t, r.readAlertConfigurationsSecretsData(project, workflowCtx, alertConfigs))
+
+		webhook, err := alertConfigs[0].Notifications[0].ToAtlas()
+		require.NoError(t, err)
+		assert.Equal(t, "https://hooks.example.com/atlas", webhook.WebhookURL)
+		assert.Equal(t, "signing-secret", webhook.WebhookSecret)
+		teams, err := alertConfigs[0].Notifications[1].ToAtlas()
+		require.NoError(t, err)
+		assert.Equal(t, "https://teams.example.com/hook", teams.MicrosoftTeamsWebhookURL)
+		assert.ElementsMatch(t, []watch.WatchedObject{
+			{ResourceKind: "Secret", Resource: client.ObjectKey{Namespace: "ns", Name: "webhook"}},
+			{ResourceKind: "Secret", Resource: client.ObjectKey{Namespace: "ns", Name: "teams"}},
+		}, workflowCtx.ListResourcesToWatch())
+	})
+
+	t.Run("should accept a webhook without a signing secret", func(t *testing.T) {
+		r := newReconciler(t, newSecret("webhook", map[string]string{"WebhookURL": "https://hooks.example.com/atlas"}))
+		workflowCtx := newContext(t)
+		alertConfigs := []mdbv1.AlertConfiguration{{Notifications: []mdbv1.Notification{
+			{TypeName: "WEBHOOK", WebhookSecretRef: common.ResourceRefNamespaced{Name: "webhook"}},
+		}}}
+
+		require.NoError(t, r.readAlertConfigurationsSecretsData(project, workflowCtx, alertConfigs))
+
+		webhook, err := alertConfigs[0].Notifications[0].ToAtlas()
+		require.NoError(t, err)
+		assert.Empty(t, webhook.WebhookSecret)
+	})
+
+	t.Run("should fail when the webhook URL is missing", func(t *testing.T) {
+		r := newReconciler(t, newSecret("webhook", map[string]string{"WebhookSecret": "signing-secret"}))
+		workflowCtx := newContext(t)
+		alertConfigs := []mdbv1.AlertConfiguration{{Notifications: []mdbv1.Notification{
+			{TypeName: "WEBHOOK", WebhookSecretRef: common.ResourceRefNamespaced{Name: "webhook"}},
+		}}}
+
+		err := r.readAlertConfigurationsSecretsData(project, workflowCtx, alertConfigs)
+
+		assert.EqualError(t, err, "secret 'ns/webhook' doesn't contain 'WebhookURL' parameter")
+		assert.Len(t, workflowCtx.ListResourcesToWatch(), 1, "the secret is watched until it's fixed")
+	})
+}
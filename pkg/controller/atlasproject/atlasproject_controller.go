@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.mongodb.org/atlas/mongodbatlas"
 	"go.uber.org/zap"
@@ -38,12 +39,18 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/deletion"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/notifier"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/statushandler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/validate"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/watch"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
 
+// projectStepTimeout is the deadline of each of the project sub-reconcilers, so that a slow or stuck call to Atlas
+// doesn't hold the reconciliation of the other project resources
+const projectStepTimeout = 2 * time.Minute
+
 // AtlasProjectReconciler reconciles a AtlasProject object
 type AtlasProjectReconciler struct {
 	Client client.Client
@@ -52,9 +59,16 @@ type AtlasProjectReconciler struct {
 	Scheme                      *runtime.Scheme
 	GlobalPredicates            []predicate.Predicate
 	EventRecorder               record.EventRecorder
+	FailureNotifier             *notifier.Notifier
 	AtlasProvider               atlas.Provider
 	ObjectDeletionProtection    bool
 	SubObjectDeletionProtection bool
+	// EgressIPProvider discovers the egress IPs added to the IP Access List of the projects enabling it
+	EgressIPProvider EgressIPProvider
+	// AWSPeeringAccepter accepts the AWS peering connections of the network peers enabling it
+	AWSPeeringAccepter AWSPeeringAccepter
+	// DeletionScheduler throttles the deletions of the projects in Atlas, they aren't throttled when it's nil
+	DeletionScheduler *deletion.Scheduler
 }
 
 // Dev note: duplicate the permissions in both sections below to generate both Role and ClusterRoles
@@ -62,19 +76,28 @@ type AtlasProjectReconciler struct {
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasprojects,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasprojects/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasprojects,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasprojects/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",namespace=default,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",namespace=default,resources=secrets,verbs=create;update;patch;delete
 // +kubebuilder:rbac:groups="",namespace=default,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",namespace=default,resources=configmaps,verbs=get;list;watch;create;update;patch
+
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasdeployments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasdeployments,verbs=get;list;watch
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasteams,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasteams/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasteams,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasteams/status,verbs=get;update;patch
 
-func (r *AtlasProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *AtlasProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, _ error) {
 	log := r.Log.With("atlasproject", req.NamespacedName)
 
 	project := &mdbv1.AtlasProject{}
@@ -96,6 +119,11 @@ func (r *AtlasProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		return workflow.OK().ReconcileResult(), nil
 	}
 
+	if customresource.NamespaceReconciliationPaused(ctx, r.Client, project.Namespace, log) {
+		log.Infow(fmt.Sprintf("-> Pausing AtlasProject reconciliation as annotation %s=true is set on the namespace", customresource.ReconciliationPausedAnnotation))
+		return customresource.MarkReconciliationPaused(ctx, r.Client, r.EventRecorder, project, log).ReconcileResult(), nil
+	}
+
 	workflowCtx := customresource.MarkReconciliationStarted(r.Client, project, log, ctx)
 	log.Infow("-> Starting AtlasProject reconciliation", "spec", project.Spec)
 
@@ -105,10 +133,20 @@ func (r *AtlasProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		workflowCtx.AddResourcesToWatch(watch.WatchedObject{ResourceKind: "Secret", Resource: *project.ConnectionSecretObjectKey()})
 	}
 
+	if workflowCtx.Degraded(project) {
+		log.Infow("-> Skipping the reconciliation of the degraded AtlasProject, change its spec or set the reapply annotation to retry")
+		return ctrl.Result{}, nil
+	}
+
 	// This update will make sure the status is always updated in case of any errors or successful result
 	defer func() {
-		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, project)
+		if p := recover(); p != nil {
+			res = workflowCtx.RecoverPanic("AtlasProject", p).ReconcileResult()
+		}
+		res = workflowCtx.CapRetries("AtlasProject", project, res)
+		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, r.FailureNotifier, project)
 		r.EnsureMultiplesResourcesAreWatched(req.NamespacedName, log, workflowCtx.ListResourcesToWatch()...)
+		workflowCtx.LogStepTimings()
 	}()
 
 	resourceVersionIsValid := customresource.ValidateResourceVersion(workflowCtx, project, r.Log)
@@ -148,6 +186,24 @@ func (r *AtlasProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	workflowCtx.OrgID = orgID
 	workflowCtx.Client = atlasClient
 
+	if result = ensureOrganization(orgID, project); !result.IsOk() {
+		setCondition(workflowCtx, status.ProjectReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+
+	// only the project creation is blocked by the missing roles, the sub-reconcilers needing them are skipped
+	missingRoles := customresource.ReportAtlasPermissions(workflowCtx, requiredAtlasRoles(project)...)
+	if project.ID() == "" && len(missingRoles) > 0 {
+		result = workflow.Terminate(workflow.InsufficientAtlasPermissions, customresource.MissingRolesMessage(missingRoles...))
+		setCondition(workflowCtx, status.ProjectReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+
+	if result = r.ensureProjectMigration(workflowCtx, project); !result.IsOk() {
+		setCondition(workflowCtx, status.ProjectReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+
 	owner, err := customresource.IsOwner(project, r.ObjectDeletionProtection, customresource.IsResourceManagedByOperator, managedByAtlas(workflowCtx))
 	if err != nil {
 		result = workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err))
@@ -175,6 +231,7 @@ func (r *AtlasProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	}
 
 	workflowCtx.EnsureStatusOption(status.AtlasProjectIDOption(projectID))
+	workflowCtx.EnsureStatusOption(status.AtlasProjectOrgIDOption(orgID))
 
 	if result = r.ensureDeletionFinalizer(workflowCtx, atlasClient, project); !result.IsOk() {
 		setCondition(workflowCtx, status.ProjectReadyType, result)
@@ -206,7 +263,9 @@ func (r *AtlasProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	workflowCtx.SetConditionTrue(status.ProjectReadyType)
 	r.EventRecorder.Event(project, "Normal", string(status.ProjectReadyType), "")
 
-	results := r.ensureProjectResources(workflowCtx, project)
+	// the steps rejected by Atlas during a maintenance flag the project again
+	workflowCtx.UnsetCondition(status.MaintenanceInProgressType)
+	results := r.ensureProjectResources(workflowCtx, project, missingRoles)
 	for i := range results {
 		if !results[i].IsOk() {
 			logIfWarning(workflowCtx, result)
@@ -224,6 +283,13 @@ func (r *AtlasProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	}
 
 	workflowCtx.SetConditionTrue(status.ReadyType)
+	if project.Spec.EgressIPDiscovery.IsEnabled() {
+		return workflow.OK().WithRetry(egressIPRefreshInterval).ReconcileResult(), nil
+	}
+	if project.Spec.ReportUnmanagedDeployments {
+		return workflow.OK().WithRetry(unmanagedDeploymentsRefreshInterval).ReconcileResult(), nil
+	}
+
 	return workflow.OK().ReconcileResult(), nil
 }
 
@@ -245,16 +311,27 @@ func (r *AtlasProjectReconciler) ensureDeletionFinalizer(workflowCtx *workflow.C
 				log.Info("Not removing Project from Atlas as per configuration")
 				result = workflow.OK()
 			} else {
-				if result = DeleteAllPrivateEndpoints(workflowCtx, project.ID()); !result.IsOk() {
+				release, acquired := r.DeletionScheduler.Acquire(workflowCtx.Context, r.Client, project)
+				if !acquired.IsOk() {
+					return acquired
+				}
+				var err error
+				result, err = DeleteAllPrivateEndpoints(workflowCtx, project.ID())
+				if !result.IsOk() {
+					release(err)
 					setCondition(workflowCtx, status.PrivateEndpointReadyType, result)
 					return result
 				}
-				if result = DeleteAllNetworkPeers(workflowCtx.Context, project.ID(), workflowCtx.SdkClient.NetworkPeeringApi, workflowCtx.Log); !result.IsOk() {
+				result, err = DeleteAllNetworkPeers(workflowCtx.Context, project.ID(), workflowCtx.SdkClient.NetworkPeeringApi, workflowCtx.Log)
+				if !result.IsOk() {
+					release(err)
 					setCondition(workflowCtx, status.NetworkPeerReadyType, result)
 					return result
 				}
 
-				if err := r.deleteAtlasProject(workflowCtx.Context, atlasClient, project); err != nil {
+				err = r.deleteAtlasProject(workflowCtx.Context, atlasClient, project)
+				release(err)
+				if err != nil {
 					result = workflow.Terminate(workflow.Internal, err.Error())
 					setCondition(workflowCtx, status.DeploymentReadyType, result)
 					return result
@@ -271,74 +348,124 @@ func (r *AtlasProjectReconciler) ensureDeletionFinalizer(workflowCtx *workflow.C
 	return workflow.OK()
 }
 
-// ensureProjectResources ensures IP Access List, Private Endpoints, Integrations, Maintenance Window and Encryption at Rest
-func (r *AtlasProjectReconciler) ensureProjectResources(workflowCtx *workflow.Context, project *mdbv1.AtlasProject) (results []workflow.Result) {
+// ensureProjectResources ensures IP Access List, Private Endpoints, Integrations, Maintenance Window and Encryption at Rest.
+// The sub-reconcilers needing the roles missing from the Atlas API key are skipped
+func (r *AtlasProjectReconciler) ensureProjectResources(workflowCtx *workflow.Context, project *mdbv1.AtlasProject, missingRoles []atlas.RequiredRole) (results []workflow.Result) {
 	for k, v := range project.Annotations {
 		workflowCtx.Log.Debugf(k)
 		workflowCtx.Log.Debugf(v)
 	}
 
 	var result workflow.Result
-	if result = ensureIPAccessList(workflowCtx, atlas.CustomIPAccessListStatus(workflowCtx.SdkClient), project, r.SubObjectDeletionProtection); result.IsOk() {
+	if result = workflowCtx.RunStep("ipAccessList", projectStepTimeout, func() workflow.Result {
+		return r.reconcileIPAccessList(workflowCtx, project)
+	}); result.IsOk() {
 		r.EventRecorder.Event(project, "Normal", string(status.IPAccessListReadyType), "")
 	}
 	results = append(results, result)
 
-	if result = ensurePrivateEndpoint(workflowCtx, project, r.SubObjectDeletionProtection); result.IsOk() {
+	if result = workflowCtx.RunStep("regionalizedPrivateEndpoint", projectStepTimeout, func() workflow.Result {
+		return ensureRegionalizedPrivateEndpoint(workflowCtx, project, r.SubObjectDeletionProtection)
+	}); result.IsOk() {
+		r.EventRecorder.Event(project, "Normal", string(status.RegionalizedPrivateEndpointReadyType), "")
+	}
+	results = append(results, result)
+
+	if result = workflowCtx.RunStep("privateEndpoint", projectStepTimeout, withAtlasPermissions(workflowCtx, missingRoles, privateEndpointsOperation, status.PrivateEndpointReadyType, func() workflow.Result {
+		return r.reconcilePrivateEndpoints(workflowCtx, project)
+	})); result.IsOk() {
 		r.EventRecorder.Event(project, "Normal", string(status.PrivateEndpointReadyType), "")
 	}
 	results = append(results, result)
 
-	if result = ensureCloudProviderIntegration(workflowCtx, project, r.SubObjectDeletionProtection); result.IsOk() {
+	if result = workflowCtx.RunStep("cloudProviderIntegration", projectStepTimeout, withAtlasPermissions(workflowCtx, missingRoles, cloudProviderAccessOperation, status.CloudProviderIntegrationReadyType, func() workflow.Result {
+		return ensureCloudProviderIntegration(workflowCtx, project, r.SubObjectDeletionProtection)
+	})); result.IsOk() {
 		r.EventRecorder.Event(project, "Normal", string(status.CloudProviderIntegrationReadyType), "")
 	}
 	results = append(results, result)
 
-	if result = ensureNetworkPeers(workflowCtx, project, r.SubObjectDeletionProtection); result.IsOk() {
+	if result = workflowCtx.RunStep("networkPeers", projectStepTimeout, withAtlasPermissions(workflowCtx, missingRoles, networkPeeringOperation, status.NetworkPeerReadyType, func() workflow.Result {
+		return r.reconcileNetworkPeers(workflowCtx, project)
+	})); result.IsOk() {
 		r.EventRecorder.Event(project, "Normal", string(status.NetworkPeerReadyType), "")
 	}
 	results = append(results, result)
 
-	if result = r.ensureAlertConfigurations(workflowCtx, project); result.IsOk() {
+	if result = workflowCtx.RunStep("alertConfigurations", projectStepTimeout, func() workflow.Result {
+		return r.ensureAlertConfigurations(workflowCtx, project)
+	}); result.IsOk() {
 		r.EventRecorder.Event(project, "Normal", string(status.AlertConfigurationReadyType), "")
 	}
 	results = append(results, result)
 
-	if result = r.ensureIntegration(workflowCtx, project, r.SubObjectDeletionProtection); result.IsOk() {
+	if result = workflowCtx.RunStep("integration", projectStepTimeout, withAtlasPermissions(workflowCtx, missingRoles, integrationsOperation, status.IntegrationReadyType, func() workflow.Result {
+		return r.ensureIntegration(workflowCtx, project, r.SubObjectDeletionProtection)
+	})); result.IsOk() {
 		r.EventRecorder.Event(project, "Normal", string(status.IntegrationReadyType), "")
 	}
 	results = append(results, result)
 
-	if result = ensureMaintenanceWindow(workflowCtx, project, r.SubObjectDeletionProtection); result.IsOk() {
+	if result = workflowCtx.RunStep("maintenanceWindow", projectStepTimeout, func() workflow.Result {
+		return ensureMaintenanceWindow(workflowCtx, project, r.SubObjectDeletionProtection)
+	}); result.IsOk() {
 		r.EventRecorder.Event(project, "Normal", string(status.MaintenanceWindowReadyType), "")
 	}
 	results = append(results, result)
 
-	if result = r.ensureEncryptionAtRest(workflowCtx, project, r.SubObjectDeletionProtection); result.IsOk() {
+	if result = workflowCtx.RunStep("encryptionAtRest", projectStepTimeout, withAtlasPermissions(workflowCtx, missingRoles, encryptionAtRestOperation, status.EncryptionAtRestReadyType, func() workflow.Result {
+		return r.ensureEncryptionAtRest(workflowCtx, project, r.SubObjectDeletionProtection)
+	})); result.IsOk() {
 		r.EventRecorder.Event(project, "Normal", string(status.EncryptionAtRestReadyType), "")
 	}
 	results = append(results, result)
 
-	if result = ensureAuditing(workflowCtx, project, r.SubObjectDeletionProtection); result.IsOk() {
+	if result = workflowCtx.RunStep("auditing", projectStepTimeout, func() workflow.Result {
+		return ensureAuditing(workflowCtx, project, r.SubObjectDeletionProtection)
+	}); result.IsOk() {
 		r.EventRecorder.Event(project, "Normal", string(status.AuditingReadyType), "")
 	}
 	results = append(results, result)
 
-	if result = ensureProjectSettings(workflowCtx, project, r.SubObjectDeletionProtection); result.IsOk() {
+	if result = workflowCtx.RunStep("projectSettings", projectStepTimeout, func() workflow.Result {
+		return ensureProjectSettings(workflowCtx, project, r.SubObjectDeletionProtection)
+	}); result.IsOk() {
 		r.EventRecorder.Event(project, "Normal", string(status.ProjectSettingsReadyType), "")
 	}
 	results = append(results, result)
 
-	if result = ensureCustomRoles(workflowCtx, project, r.SubObjectDeletionProtection); result.IsOk() {
+	if result = workflowCtx.RunStep("customRoles", projectStepTimeout, func() workflow.Result {
+		return r.ensureCustomRoles(workflowCtx, project, r.SubObjectDeletionProtection)
+	}); result.IsOk() {
 		r.EventRecorder.Event(project, "Normal", string(status.ProjectCustomRolesReadyType), "")
 	}
 	results = append(results, result)
 
-	if result = r.ensureAssignedTeams(workflowCtx, project, r.SubObjectDeletionProtection); result.IsOk() {
+	if result = workflowCtx.RunStep("assignedTeams", projectStepTimeout, func() workflow.Result {
+		return r.ensureAssignedTeams(workflowCtx, project, r.SubObjectDeletionProtection)
+	}); result.IsOk() {
 		r.EventRecorder.Event(project, "Normal", string(status.ProjectTeamsReadyType), "")
 	}
 	results = append(results, result)
 
+	if result = workflowCtx.RunStep("projectInvitations", projectStepTimeout, withAtlasPermissions(workflowCtx, missingRoles, projectInvitationsOperation, status.ProjectInvitationsReadyType, func() workflow.Result {
+		return ensureProjectInvitations(workflowCtx, project)
+	})); result.IsOk() {
+		r.EventRecorder.Event(project, "Normal", string(status.ProjectInvitationsReadyType), "")
+	}
+	results = append(results, result)
+
+	if result = workflowCtx.RunStep("defaultDatabaseUser", projectStepTimeout, func() workflow.Result {
+		return r.ensureDefaultDatabaseUser(workflowCtx, project)
+	}); result.IsOk() {
+		r.EventRecorder.Event(project, "Normal", string(status.DefaultDatabaseUserReadyType), "")
+	}
+	results = append(results, result)
+
+	results = append(results, workflowCtx.RunStep("unmanagedDeployments", projectStepTimeout, func() workflow.Result {
+		return r.ensureUnmanagedDeploymentsReport(workflowCtx, project)
+	}))
+
 	return results
 }
 
@@ -360,7 +487,8 @@ func (r *AtlasProjectReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("AtlasProject").
 		For(&mdbv1.AtlasProject{}, builder.WithPredicates(r.GlobalPredicates...)).
-		Watches(&corev1.Secret{}, watch.NewSecretHandler(r.WatchedResources)).
+		Watches(&corev1.Secret{}, watch.NewSecretHandler(r.WatchedResources), builder.OnlyMetadata).
+		Watches(&corev1.ConfigMap{}, watch.NewConfigMapHandler(r.WatchedResources), builder.OnlyMetadata).
 		Watches(&mdbv1.AtlasTeam{}, watch.NewAtlasTeamHandler(r.WatchedResources)).
 		Complete(r)
 }
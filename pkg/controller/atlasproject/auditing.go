@@ -14,14 +14,18 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
 
+const auditingReconciler = "auditing"
+
 func ensureAuditing(workflowCtx *workflow.Context, project *v1.AtlasProject, protected bool) workflow.Result {
-	canReconcile, err := canAuditingReconcile(workflowCtx, protected, project)
+	canReconcile, differences, err := canAuditingReconcile(workflowCtx, protected, project)
 	if err != nil {
-		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err))
+		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err)).
+			WithCause(auditingReconciler, workflow.AtlasOperation(err))
 		workflowCtx.SetConditionFromResult(status.AuditingReadyType, result)
 
 		return result
 	}
+	reportDeletionProtection(workflowCtx, "auditing", protected, canReconcile, differences)
 
 	if !canReconcile {
 		result := workflow.Terminate(
@@ -51,13 +55,16 @@ func ensureAuditing(workflowCtx *workflow.Context, project *v1.AtlasProject, pro
 func createOrDeleteAuditing(ctx *workflow.Context, projectID string, project *v1.AtlasProject) workflow.Result {
 	atlas, err := fetchAuditing(ctx, projectID)
 	if err != nil {
-		return workflow.Terminate(workflow.ProjectAuditingReady, err.Error())
+		return workflow.Terminate(workflow.ProjectAuditingReady, err.Error()).WithCause(auditingReconciler, "getAuditingConfiguration")
 	}
 
 	if !auditingInSync(atlas, project.Spec.Auditing) {
 		err := patchAuditing(ctx, projectID, prepareAuditingSpec(project.Spec.Auditing))
+		if result, rejected := rejectedDuringMaintenance(ctx, err); rejected {
+			return result
+		}
 		if err != nil {
-			return workflow.Terminate(workflow.ProjectAuditingReady, err.Error())
+			return workflow.Terminate(workflow.ProjectAuditingReady, err.Error()).WithCause(auditingReconciler, "updateAuditingConfiguration")
 		}
 	}
 
@@ -75,8 +82,13 @@ func prepareAuditingSpec(spec *v1.Auditing) *mongodbatlas.Auditing {
 }
 
 func auditingInSync(atlas *mongodbatlas.Auditing, spec *v1.Auditing) bool {
+	return len(auditingDifferences(atlas, spec)) == 0
+}
+
+// auditingDifferences lists the auditing settings of Atlas differing from the spec
+func auditingDifferences(atlas *mongodbatlas.Auditing, spec *v1.Auditing) []string {
 	if isAuditingEmpty(atlas) && isAuditingEmpty(spec) {
-		return true
+		return nil
 	}
 
 	specAsAtlas := &mongodbatlas.Auditing{
@@ -97,7 +109,18 @@ func auditingInSync(atlas *mongodbatlas.Auditing, spec *v1.Auditing) bool {
 
 	removeConfigurationType(atlas)
 
-	return reflect.DeepEqual(atlas, specAsAtlas)
+	var differences []string
+	if !reflect.DeepEqual(atlas.Enabled, specAsAtlas.Enabled) {
+		differences = append(differences, "enabled")
+	}
+	if !reflect.DeepEqual(atlas.AuditAuthorizationSuccess, specAsAtlas.AuditAuthorizationSuccess) {
+		differences = append(differences, "auditAuthorizationSuccess")
+	}
+	if atlas.AuditFilter != specAsAtlas.AuditFilter {
+		differences = append(differences, "auditFilter")
+	}
+
+	return differences
 }
 
 func isAuditingEmpty[Auditing mongodbatlas.Auditing | v1.Auditing](auditing *Auditing) bool {
@@ -118,28 +141,31 @@ func patchAuditing(ctx *workflow.Context, projectID string, auditing *mongodbatl
 	return err
 }
 
-func canAuditingReconcile(workflowCtx *workflow.Context, protected bool, akoProject *v1.AtlasProject) (bool, error) {
+func canAuditingReconcile(workflowCtx *workflow.Context, protected bool, akoProject *v1.AtlasProject) (bool, []string, error) {
 	if !protected {
-		return true, nil
+		return true, nil, nil
 	}
 
 	latestConfig := &v1.AtlasProjectSpec{}
 	latestConfigString, ok := akoProject.Annotations[customresource.AnnotationLastAppliedConfiguration]
 	if ok {
 		if err := json.Unmarshal([]byte(latestConfigString), latestConfig); err != nil {
-			return false, err
+			return false, nil, err
 		}
 	}
 
 	auditing, _, err := workflowCtx.Client.Auditing.Get(workflowCtx.Context, akoProject.ID())
 	if err != nil {
-		return false, err
+		return false, nil, workflow.NewAtlasOperationError("getAuditingConfiguration", err)
 	}
 
 	if isAuditingEmpty(auditing) {
-		return true, nil
+		return true, nil, nil
+	}
+
+	if auditingInSync(auditing, latestConfig.Auditing) || auditingInSync(auditing, akoProject.Spec.Auditing) {
+		return true, nil, nil
 	}
 
-	return auditingInSync(auditing, latestConfig.Auditing) ||
-		auditingInSync(auditing, akoProject.Spec.Auditing), nil
+	return false, auditingDifferences(auditing, akoProject.Spec.Auditing), nil
 }
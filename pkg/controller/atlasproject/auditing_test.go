@@ -18,7 +18,7 @@ import (
 
 func TestCanAuditingReconcile(t *testing.T) {
 	t.Run("should return true when subResourceDeletionProtection is disabled", func(t *testing.T) {
-		result, err := canAuditingReconcile(testWorkFlowContext(mongodbatlas.Client{}), false, &mdbv1.AtlasProject{})
+		result, _, err := canAuditingReconcile(testWorkFlowContext(mongodbatlas.Client{}), false, &mdbv1.AtlasProject{})
 		require.NoError(t, err)
 		require.True(t, result)
 	})
@@ -26,7 +26,7 @@ func TestCanAuditingReconcile(t *testing.T) {
 	t.Run("should return error when unable to deserialize last applied configuration", func(t *testing.T) {
 		akoProject := &mdbv1.AtlasProject{}
 		akoProject.WithAnnotations(map[string]string{customresource.AnnotationLastAppliedConfiguration: "{wrong}"})
-		result, err := canAuditingReconcile(testWorkFlowContext(mongodbatlas.Client{}), true, akoProject)
+		result, _, err := canAuditingReconcile(testWorkFlowContext(mongodbatlas.Client{}), true, akoProject)
 		require.EqualError(t, err, "invalid character 'w' looking for beginning of object key string")
 		require.False(t, result)
 	})
@@ -41,7 +41,7 @@ func TestCanAuditingReconcile(t *testing.T) {
 		}
 		akoProject := &mdbv1.AtlasProject{}
 		akoProject.WithAnnotations(map[string]string{customresource.AnnotationLastAppliedConfiguration: "{}"})
-		result, err := canAuditingReconcile(testWorkFlowContext(atlasClient), true, akoProject)
+		result, _, err := canAuditingReconcile(testWorkFlowContext(atlasClient), true, akoProject)
 
 		require.EqualError(t, err, "failed to retrieve data")
 		require.False(t, result)
@@ -57,7 +57,7 @@ func TestCanAuditingReconcile(t *testing.T) {
 		}
 		akoProject := &mdbv1.AtlasProject{}
 		akoProject.WithAnnotations(map[string]string{customresource.AnnotationLastAppliedConfiguration: "{}"})
-		result, err := canAuditingReconcile(testWorkFlowContext(atlasClient), true, akoProject)
+		result, _, err := canAuditingReconcile(testWorkFlowContext(atlasClient), true, akoProject)
 
 		require.NoError(t, err)
 		require.True(t, result)
@@ -89,7 +89,7 @@ func TestCanAuditingReconcile(t *testing.T) {
 				customresource.AnnotationLastAppliedConfiguration: `{"auditing":{"auditFilter":"{\"atype\":\"authenticate\",\"param\":{\"user\":\"auditReadOnly\",\"db\":\"admin\",\"mechanism\":\"SCRAM-SHA-1\"}}","enabled":true,"auditAuthorizationSuccess":false}}`,
 			},
 		)
-		result, err := canAuditingReconcile(testWorkFlowContext(atlasClient), true, akoProject)
+		result, _, err := canAuditingReconcile(testWorkFlowContext(atlasClient), true, akoProject)
 
 		require.NoError(t, err)
 		require.True(t, result)
@@ -121,7 +121,7 @@ func TestCanAuditingReconcile(t *testing.T) {
 				customresource.AnnotationLastAppliedConfiguration: `{"auditing":{"auditFilter":"{\"atype\":\"authenticate\",\"param\":{\"user\":\"auditReadOnly\",\"db\":\"admin\",\"mechanism\":\"SCRAM-SHA-1\"}}","enabled":true,"auditAuthorizationSuccess":true}}`,
 			},
 		)
-		result, err := canAuditingReconcile(testWorkFlowContext(atlasClient), true, akoProject)
+		result, _, err := canAuditingReconcile(testWorkFlowContext(atlasClient), true, akoProject)
 
 		require.NoError(t, err)
 		require.True(t, result)
@@ -153,10 +153,11 @@ func TestCanAuditingReconcile(t *testing.T) {
 				customresource.AnnotationLastAppliedConfiguration: `{"auditing":{"auditFilter":"{\"atype\":\"authenticate\",\"param\":{\"db\":\"admin\",\"mechanism\":\"SCRAM-SHA-1\"}}","enabled":true,"auditAuthorizationSuccess":true}}`,
 			},
 		)
-		result, err := canAuditingReconcile(testWorkFlowContext(atlasClient), true, akoProject)
+		result, differences, err := canAuditingReconcile(testWorkFlowContext(atlasClient), true, akoProject)
 
 		require.NoError(t, err)
 		require.False(t, result)
+		assert.Equal(t, []string{"auditAuthorizationSuccess"}, differences)
 	})
 }
 
@@ -173,7 +174,8 @@ func TestEnsureAuditing(t *testing.T) {
 		akoProject.WithAnnotations(map[string]string{customresource.AnnotationLastAppliedConfiguration: "{}"})
 		result := ensureAuditing(testWorkFlowContext(atlasClient), akoProject, true)
 
-		require.Equal(t, workflow.Terminate(workflow.Internal, "unable to resolve ownership for deletion protection: failed to retrieve data"), result)
+		require.Equal(t, workflow.Terminate(workflow.Internal, "unable to resolve ownership for deletion protection: failed to retrieve data").
+			WithCause(auditingReconciler, "getAuditingConfiguration"), result)
 	})
 
 	t.Run("should failed to reconcile when unable to synchronize with Atlas", func(t *testing.T) {
@@ -15,14 +15,18 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
 
+const cloudProviderIntegrationReconciler = "cloudProviderIntegration"
+
 func ensureCloudProviderIntegration(workflowCtx *workflow.Context, project *mdbv1.AtlasProject, protected bool) workflow.Result {
-	canReconcile, err := canCloudProviderIntegrationReconcile(workflowCtx, protected, project)
+	canReconcile, differences, err := canCloudProviderIntegrationReconcile(workflowCtx, protected, project)
 	if err != nil {
-		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err))
+		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err)).
+			WithCause(cloudProviderIntegrationReconciler, workflow.AtlasOperation(err))
 		workflowCtx.SetConditionFromResult(status.CloudProviderIntegrationReadyType, result)
 
 		return result
 	}
+	reportDeletionProtection(workflowCtx, "cloudProviderIntegrations", protected, canReconcile, differences)
 
 	if !canReconcile {
 		result := workflow.Terminate(
@@ -44,7 +48,8 @@ func ensureCloudProviderIntegration(workflowCtx *workflow.Context, project *mdbv
 
 	allAuthorized, err := syncCloudProviderIntegration(workflowCtx, project.ID(), roleSpecs)
 	if err != nil {
-		result := workflow.Terminate(workflow.ProjectCloudIntegrationsIsNotReadyInAtlas, err.Error())
+		result := workflow.Terminate(workflow.ProjectCloudIntegrationsIsNotReadyInAtlas, err.Error()).
+			WithCause(cloudProviderIntegrationReconciler, workflow.AtlasOperation(err))
 		workflowCtx.SetConditionFromResult(status.CloudProviderIntegrationReadyType, result)
 
 		return result
@@ -69,7 +74,7 @@ func ensureCloudProviderIntegration(workflowCtx *workflow.Context, project *mdbv
 func syncCloudProviderIntegration(workflowCtx *workflow.Context, projectID string, cpaSpecs []mdbv1.CloudProviderIntegration) (bool, error) {
 	atlasCPAs, _, err := workflowCtx.Client.CloudProviderAccess.ListRoles(workflowCtx.Context, projectID)
 	if err != nil {
-		return false, fmt.Errorf("unable to fetch cloud provider access from Atlas: %w", err)
+		return false, workflow.NewAtlasOperationError("listCloudProviderAccessRoles", fmt.Errorf("unable to fetch cloud provider access from Atlas: %w", err))
 	}
 
 	AWSRoles := sortAtlasCPAsByRoleID(atlasCPAs.AWSIAMRoles)
@@ -321,22 +326,22 @@ func deleteCloudProviderAccess(workflowCtx *workflow.Context, projectID string,
 	}
 }
 
-func canCloudProviderIntegrationReconcile(workflowCtx *workflow.Context, protected bool, akoProject *mdbv1.AtlasProject) (bool, error) {
+func canCloudProviderIntegrationReconcile(workflowCtx *workflow.Context, protected bool, akoProject *mdbv1.AtlasProject) (bool, []string, error) {
 	if !protected {
-		return true, nil
+		return true, nil, nil
 	}
 
 	latestConfig := &mdbv1.AtlasProjectSpec{}
 	latestConfigString, ok := akoProject.Annotations[customresource.AnnotationLastAppliedConfiguration]
 	if ok {
 		if err := json.Unmarshal([]byte(latestConfigString), latestConfig); err != nil {
-			return false, err
+			return false, nil, err
 		}
 	}
 
 	list, _, err := workflowCtx.Client.CloudProviderAccess.ListRoles(workflowCtx.Context, akoProject.ID())
 	if err != nil {
-		return false, err
+		return false, nil, workflow.NewAtlasOperationError("listCloudProviderAccessRoles", err)
 	}
 
 	atlasList := make([]CloudProviderIntegrationIdentifiable, 0, len(list.AWSIAMRoles))
@@ -352,7 +357,7 @@ func canCloudProviderIntegrationReconcile(workflowCtx *workflow.Context, protect
 	}
 
 	if len(atlasList) == 0 {
-		return true, nil
+		return true, nil, nil
 	}
 
 	akoLastCPIs := getCloudProviderIntegrations(*latestConfig)
@@ -364,7 +369,7 @@ func canCloudProviderIntegrationReconcile(workflowCtx *workflow.Context, protect
 	diff := set.Difference(atlasList, akoLastList)
 
 	if len(diff) == 0 {
-		return true, nil
+		return true, nil, nil
 	}
 
 	akoCurrentCPIs := getCloudProviderIntegrations(akoProject.Spec)
@@ -374,8 +379,11 @@ func canCloudProviderIntegrationReconcile(workflowCtx *workflow.Context, protect
 	}
 
 	diff = set.Difference(akoCurrentList, atlasList)
+	if len(diff) == 0 {
+		return true, nil, nil
+	}
 
-	return len(diff) == 0, nil
+	return false, identifiableDifferences(atlasList, akoCurrentList), nil
 }
 
 type CloudProviderIntegrationIdentifiable mdbv1.CloudProviderIntegration
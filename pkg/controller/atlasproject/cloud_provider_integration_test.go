@@ -955,7 +955,7 @@ func TestCanCloudProviderIntegrationReconcile(t *testing.T) {
 			Client:  &mongodbatlas.Client{},
 			Context: context.Background(),
 		}
-		result, err := canCloudProviderIntegrationReconcile(workflowCtx, false, &mdbv1.AtlasProject{})
+		result, _, err := canCloudProviderIntegrationReconcile(workflowCtx, false, &mdbv1.AtlasProject{})
 		assert.NoError(t, err)
 		assert.True(t, result)
 	})
@@ -967,7 +967,7 @@ func TestCanCloudProviderIntegrationReconcile(t *testing.T) {
 			Context: context.Background(),
 		}
 		akoProject.WithAnnotations(map[string]string{customresource.AnnotationLastAppliedConfiguration: "{wrong}"})
-		result, err := canCloudProviderIntegrationReconcile(workflowCtx, true, akoProject)
+		result, _, err := canCloudProviderIntegrationReconcile(workflowCtx, true, akoProject)
 		assert.EqualError(t, err, "invalid character 'w' looking for beginning of object key string")
 		assert.False(t, result)
 	})
@@ -986,7 +986,7 @@ func TestCanCloudProviderIntegrationReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canCloudProviderIntegrationReconcile(workflowCtx, true, akoProject)
+		result, _, err := canCloudProviderIntegrationReconcile(workflowCtx, true, akoProject)
 
 		assert.EqualError(t, err, "failed to retrieve data")
 		assert.False(t, result)
@@ -1008,7 +1008,7 @@ func TestCanCloudProviderIntegrationReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canCloudProviderIntegrationReconcile(workflowCtx, true, akoProject)
+		result, _, err := canCloudProviderIntegrationReconcile(workflowCtx, true, akoProject)
 
 		assert.NoError(t, err)
 		assert.True(t, result)
@@ -1044,7 +1044,7 @@ func TestCanCloudProviderIntegrationReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canCloudProviderIntegrationReconcile(workflowCtx, true, akoProject)
+		result, _, err := canCloudProviderIntegrationReconcile(workflowCtx, true, akoProject)
 
 		assert.NoError(t, err)
 		assert.True(t, result)
@@ -1088,7 +1088,7 @@ func TestCanCloudProviderIntegrationReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canCloudProviderIntegrationReconcile(workflowCtx, true, akoProject)
+		result, _, err := canCloudProviderIntegrationReconcile(workflowCtx, true, akoProject)
 
 		assert.NoError(t, err)
 		assert.True(t, result)
@@ -1123,7 +1123,7 @@ func TestCanCloudProviderIntegrationReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canCloudProviderIntegrationReconcile(workflowCtx, true, akoProject)
+		result, _, err := canCloudProviderIntegrationReconcile(workflowCtx, true, akoProject)
 
 		assert.NoError(t, err)
 		assert.True(t, result)
@@ -1167,7 +1167,7 @@ func TestCanCloudProviderIntegrationReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canCloudProviderIntegrationReconcile(workflowCtx, true, akoProject)
+		result, _, err := canCloudProviderIntegrationReconcile(workflowCtx, true, akoProject)
 
 		assert.NoError(t, err)
 		assert.False(t, result)
@@ -1211,7 +1211,7 @@ func TestCanCloudProviderIntegrationReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canCloudProviderIntegrationReconcile(workflowCtx, true, akoProject)
+		result, _, err := canCloudProviderIntegrationReconcile(workflowCtx, true, akoProject)
 
 		assert.NoError(t, err)
 		assert.True(t, result)
@@ -1235,7 +1235,8 @@ func TestEnsureCloudProviderIntegration(t *testing.T) {
 		}
 		result := ensureCloudProviderIntegration(workflowCtx, akoProject, true)
 
-		assert.Equal(t, workflow.Terminate(workflow.Internal, "unable to resolve ownership for deletion protection: failed to retrieve data"), result)
+		assert.Equal(t, workflow.Terminate(workflow.Internal, "unable to resolve ownership for deletion protection: failed to retrieve data").
+			WithCause(cloudProviderIntegrationReconciler, "listCloudProviderAccessRoles"), result)
 	})
 
 	t.Run("should failed to reconcile when unable to synchronize with Atlas", func(t *testing.T) {
@@ -1328,7 +1329,8 @@ func TestEnsureCloudProviderIntegration(t *testing.T) {
 		result := ensureCloudProviderIntegration(workflowCtx, akoProject, false)
 		assert.Equal(
 			t,
-			workflow.Terminate(workflow.ProjectCloudIntegrationsIsNotReadyInAtlas, "unable to fetch cloud provider access from Atlas: failed to retrieve data"),
+			workflow.Terminate(workflow.ProjectCloudIntegrationsIsNotReadyInAtlas, "unable to fetch cloud provider access from Atlas: failed to retrieve data").
+				WithCause(cloudProviderIntegrationReconciler, "listCloudProviderAccessRoles"),
 			result,
 		)
 	})
@@ -16,14 +16,18 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
 
-func ensureCustomRoles(workflowCtx *workflow.Context, project *v1.AtlasProject, protected bool) workflow.Result {
-	canReconcile, err := canCustomRolesReconcile(workflowCtx, protected, project)
+const customRolesReconciler = "customRoles"
+
+func (r *AtlasProjectReconciler) ensureCustomRoles(workflowCtx *workflow.Context, project *v1.AtlasProject, protected bool) workflow.Result {
+	canReconcile, differences, err := canCustomRolesReconcile(workflowCtx, protected, project)
 	if err != nil {
-		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err))
+		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err)).
+			WithCause(customRolesReconciler, workflow.AtlasOperation(err))
 		workflowCtx.SetConditionFromResult(status.ProjectCustomRolesReadyType, result)
 
 		return result
 	}
+	reportDeletionProtection(workflowCtx, "customRoles", protected, canReconcile, differences)
 
 	if !canReconcile {
 		result := workflow.Terminate(
@@ -37,16 +41,22 @@ func ensureCustomRoles(workflowCtx *workflow.Context, project *v1.AtlasProject,
 
 	currentCustomRoles, err := fetchCustomRoles(workflowCtx, project.ID())
 	if err != nil {
-		return workflow.Terminate(workflow.ProjectCustomRolesReady, err.Error())
+		return workflow.Terminate(workflow.ProjectCustomRolesReady, err.Error()).WithCause(customRolesReconciler, "listCustomDatabaseRoles")
+	}
+
+	lastApplied, err := lastAppliedCustomRoles(project)
+	if err != nil {
+		return workflow.Terminate(workflow.ProjectCustomRolesReady, err.Error()).WithCause(customRolesReconciler, "")
 	}
 
 	ops := calculateChanges(currentCustomRoles, project.Spec.CustomRoles)
+	imported := importCustomRoles(project, lastApplied, &ops)
 
 	deleteStatus := deleteCustomRoles(workflowCtx, project.ID(), ops.Delete)
 	updateStatus := updateCustomRoles(workflowCtx, project.ID(), ops.Update)
 	createStatus := createCustomRoles(workflowCtx, project.ID(), ops.Create)
 
-	result := syncCustomRolesStatus(workflowCtx, project.Spec.CustomRoles, createStatus, updateStatus, deleteStatus)
+	result := syncCustomRolesStatus(workflowCtx, project.Spec.CustomRoles, createStatus, updateStatus, deleteStatus, imported)
 
 	if !result.IsOk() {
 		workflowCtx.SetConditionFromResult(status.ProjectCustomRolesReadyType, result)
@@ -54,9 +64,15 @@ func ensureCustomRoles(workflowCtx *workflow.Context, project *v1.AtlasProject,
 		return result
 	}
 
+	if result = r.exportImportedCustomRoles(workflowCtx, project, imported); !result.IsOk() {
+		workflowCtx.SetConditionFromResult(status.ProjectCustomRolesReadyType, result)
+
+		return result
+	}
+
 	workflowCtx.SetConditionTrue(status.ProjectCustomRolesReadyType)
 
-	if len(project.Spec.CustomRoles) == 0 {
+	if len(project.Spec.CustomRoles) == 0 && len(imported) == 0 {
 		workflowCtx.UnsetCondition(status.ProjectCustomRolesReadyType)
 	}
 
@@ -253,7 +269,7 @@ func evaluateOperation(err error) (status.CustomRoleStatus, string) {
 	return status.CustomRoleStatusOK, ""
 }
 
-func syncCustomRolesStatus(ctx *workflow.Context, desiredCustomRoles []v1.CustomRole, created, updated, deleted map[string]status.CustomRole) workflow.Result {
+func syncCustomRolesStatus(ctx *workflow.Context, desiredCustomRoles []v1.CustomRole, created, updated, deleted map[string]status.CustomRole, imported []v1.CustomRole) workflow.Result {
 	statuses := make([]status.CustomRole, 0, len(desiredCustomRoles))
 	var err error
 
@@ -295,42 +311,60 @@ func syncCustomRolesStatus(ctx *workflow.Context, desiredCustomRoles []v1.Custom
 		})
 	}
 
+	for _, customRole := range imported {
+		statuses = append(statuses, status.CustomRole{
+			Name:   customRole.Name,
+			Status: status.CustomRoleStatusImported,
+		})
+	}
+
 	ctx.EnsureStatusOption(status.AtlasProjectSetCustomRolesOption(&statuses))
 
 	if err != nil {
-		return workflow.Terminate(workflow.ProjectCustomRolesReady, fmt.Sprintf("failed to apply changes to custom roles: %s", err.Error()))
+		return workflow.Terminate(workflow.ProjectCustomRolesReady, fmt.Sprintf("failed to apply changes to custom roles: %s", err.Error())).
+			WithCause(customRolesReconciler, "")
 	}
 
 	return workflow.OK()
 }
 
-func canCustomRolesReconcile(workflowCtx *workflow.Context, protected bool, akoProject *v1.AtlasProject) (bool, error) {
+func canCustomRolesReconcile(workflowCtx *workflow.Context, protected bool, akoProject *v1.AtlasProject) (bool, []string, error) {
 	if !protected {
-		return true, nil
+		return true, nil, nil
 	}
 
 	latestConfig := &v1.AtlasProjectSpec{}
 	latestConfigString, ok := akoProject.Annotations[customresource.AnnotationLastAppliedConfiguration]
 	if ok {
 		if err := json.Unmarshal([]byte(latestConfigString), latestConfig); err != nil {
-			return false, err
+			return false, nil, err
 		}
 	}
 
 	atlasData, _, err := workflowCtx.Client.CustomDBRoles.List(workflowCtx.Context, akoProject.ID(), nil)
 	if err != nil {
-		return false, err
+		return false, nil, workflow.NewAtlasOperationError("listCustomDatabaseRoles", err)
 	}
 
 	if atlasData == nil || len(*atlasData) == 0 {
-		return true, nil
+		return true, nil, nil
 	}
 
 	atlasCustomRoles := mapToOperator(atlasData)
+	if isCustomRolesImportEnabled(akoProject) {
+		// the roles defined in Atlas only are imported, not overwritten
+		atlasCustomRoles = managedCustomRoles(atlasCustomRoles, akoProject.Spec.CustomRoles, latestConfig.CustomRoles)
+	}
 
 	if cmp.Diff(latestConfig.CustomRoles, atlasCustomRoles, cmpopts.EquateEmpty()) == "" {
-		return true, nil
+		return true, nil, nil
+	}
+
+	if cmp.Diff(akoProject.Spec.CustomRoles, atlasCustomRoles, cmpopts.EquateEmpty()) == "" {
+		return true, nil, nil
 	}
 
-	return cmp.Diff(akoProject.Spec.CustomRoles, atlasCustomRoles, cmpopts.EquateEmpty()) == "", nil
+	return false, listDifferences(atlasCustomRoles, akoProject.Spec.CustomRoles, func(role v1.CustomRole) string {
+		return role.Name
+	}), nil
 }
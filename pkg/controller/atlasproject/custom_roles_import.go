@@ -0,0 +1,140 @@
+package atlasproject
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/compare"
+	v1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	// CustomRolesImportedEvent is the reason of the event emitted when the set of imported custom roles changes
+	CustomRolesImportedEvent = "CustomRolesImported"
+	// importedCustomRolesKey is the key of the ConfigMap holding the imported custom roles as a spec snippet
+	importedCustomRolesKey = "customRoles.yaml"
+)
+
+func isCustomRolesImportEnabled(project *v1.AtlasProject) bool {
+	return project.Spec.CustomRolesImport != nil && project.Spec.CustomRolesImport.Enabled
+}
+
+// importCustomRoles removes the roles which were never in the last applied spec from the deletions when the import is
+// enabled and returns them sorted by name. The roles removed from the spec are still deleted
+func importCustomRoles(project *v1.AtlasProject, lastApplied []v1.CustomRole, ops *CustomRolesOperations) []v1.CustomRole {
+	if !isCustomRolesImportEnabled(project) {
+		return nil
+	}
+
+	applied := make(map[string]bool, len(lastApplied))
+	for _, customRole := range lastApplied {
+		applied[customRole.Name] = true
+	}
+
+	imported := make([]v1.CustomRole, 0, len(ops.Delete))
+	for name, customRole := range ops.Delete {
+		if applied[name] {
+			continue
+		}
+		imported = append(imported, customRole)
+		delete(ops.Delete, name)
+	}
+	sort.Slice(imported, func(i, j int) bool {
+		return imported[i].Name < imported[j].Name
+	})
+
+	return imported
+}
+
+// lastAppliedCustomRoles returns the custom roles of the spec last applied to Atlas
+func lastAppliedCustomRoles(project *v1.AtlasProject) ([]v1.CustomRole, error) {
+	lastApplied, ok := project.Annotations[customresource.AnnotationLastAppliedConfiguration]
+	if !ok {
+		return nil, nil
+	}
+
+	spec := &v1.AtlasProjectSpec{}
+	if err := json.Unmarshal([]byte(lastApplied), spec); err != nil {
+		return nil, fmt.Errorf("failed to read the last applied configuration: %w", err)
+	}
+
+	return spec.CustomRoles, nil
+}
+
+// managedCustomRoles filters the roles in Atlas down to the ones defined in any of the given specs
+func managedCustomRoles(atlasCustomRoles []v1.CustomRole, specs ...[]v1.CustomRole) []v1.CustomRole {
+	names := map[string]bool{}
+	for _, spec := range specs {
+		for _, customRole := range spec {
+			names[customRole.Name] = true
+		}
+	}
+
+	managed := make([]v1.CustomRole, 0, len(atlasCustomRoles))
+	for _, customRole := range atlasCustomRoles {
+		if names[customRole.Name] {
+			managed = append(managed, customRole)
+		}
+	}
+
+	return managed
+}
+
+// exportImportedCustomRoles emits an event when the set of imported roles changes and, if configured, writes them to
+// a ConfigMap owned by the project as a customRoles spec snippet
+func (r *AtlasProjectReconciler) exportImportedCustomRoles(workflowCtx *workflow.Context, project *v1.AtlasProject, imported []v1.CustomRole) workflow.Result {
+	if !isCustomRolesImportEnabled(project) {
+		return workflow.OK()
+	}
+
+	names := make([]string, 0, len(imported))
+	for _, customRole := range imported {
+		names = append(names, customRole.Name)
+	}
+	if len(names) > 0 && !compare.IsEqualWithoutOrder(names, previouslyImportedCustomRoles(project)) {
+		r.EventRecorder.Eventf(project, "Normal", CustomRolesImportedEvent, "Custom roles imported from Atlas: %s", strings.Join(names, ", "))
+	}
+
+	configMapName := project.Spec.CustomRolesImport.ConfigMapName
+	if configMapName == "" {
+		return workflow.OK()
+	}
+
+	snippet, err := yaml.Marshal(map[string][]v1.CustomRole{"customRoles": imported})
+	if err != nil {
+		return workflow.Terminate(workflow.ProjectCustomRolesReady, err.Error()).WithCause(customRolesReconciler, "")
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: configMapName, Namespace: project.Namespace}}
+	_, err = controllerutil.CreateOrUpdate(workflowCtx.Context, r.Client, configMap, func() error {
+		configMap.Data = map[string]string{importedCustomRolesKey: string(snippet)}
+
+		return controllerutil.SetControllerReference(project, configMap, r.Scheme)
+	})
+	if err != nil {
+		return workflow.Terminate(workflow.ProjectCustomRolesReady, err.Error()).WithCause(customRolesReconciler, "")
+	}
+
+	return workflow.OK()
+}
+
+func previouslyImportedCustomRoles(project *v1.AtlasProject) []string {
+	names := make([]string, 0)
+	for _, customRole := range project.Status.CustomRoles {
+		if customRole.Status == status.CustomRoleStatusImported {
+			names = append(names, customRole.Name)
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,150 @@
+package atlasproject
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestImportCustomRoles(t *testing.T) {
+	newProject := func(enabled bool) *mdbv1.AtlasProject {
+		project := mdbv1.NewProject("ns", "project", "project")
+		project.Spec.CustomRolesImport = &mdbv1.CustomRolesImport{Enabled: enabled}
+
+		return project
+	}
+
+	t.Run("should import the roles missing from the spec instead of deleting them", func(t *testing.T) {
+		ops := calculateChanges([]mdbv1.CustomRole{{Name: "b"}, {Name: "a"}, {Name: "managed"}}, []mdbv1.CustomRole{{Name: "managed"}})
+
+		imported := importCustomRoles(newProject(true), []mdbv1.CustomRole{{Name: "managed"}}, &ops)
+
+		assert.Equal(t, []mdbv1.CustomRole{{Name: "a"}, {Name: "b"}}, imported)
+		assert.Empty(t, ops.Delete)
+	})
+
+	t.Run("should delete the roles removed from the spec", func(t *testing.T) {
+		ops := calculateChanges([]mdbv1.CustomRole{{Name: "a"}, {Name: "removed"}}, nil)
+
+		imported := importCustomRoles(newProject(true), []mdbv1.CustomRole{{Name: "removed"}}, &ops)
+
+		assert.Equal(t, []mdbv1.CustomRole{{Name: "a"}}, imported)
+		assert.Len(t, ops.Delete, 1)
+		assert.Contains(t, ops.Delete, "removed")
+	})
+
+	t.Run("should delete the roles missing from the spec when the import is disabled", func(t *testing.T) {
+		ops := calculateChanges([]mdbv1.CustomRole{{Name: "a"}}, nil)
+
+		assert.Nil(t, importCustomRoles(newProject(false), nil, &ops))
+		assert.Len(t, ops.Delete, 1)
+	})
+
+	t.Run("should read the roles of the last applied spec", func(t *testing.T) {
+		project := newProject(true)
+		project.Annotations = map[string]string{customresource.AnnotationLastAppliedConfiguration: `{"name":"project","customRoles":[{"name":"removed"}]}`}
+
+		lastApplied, err := lastAppliedCustomRoles(project)
+
+		require.NoError(t, err)
+		assert.Equal(t, []mdbv1.CustomRole{{Name: "removed"}}, lastApplied)
+	})
+
+	t.Run("should report the imported roles in the status", func(t *testing.T) {
+		workflowCtx := newContext(t)
+
+		result := syncCustomRolesStatus(workflowCtx, []mdbv1.CustomRole{{Name: "managed"}}, nil, nil, nil, []mdbv1.CustomRole{{Name: "a"}})
+
+		require.True(t, result.IsOk())
+		project := newProject(true)
+		project.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+		assert.Equal(t, []status.CustomRole{
+			{Name: "managed", Status: status.CustomRoleStatusOK},
+			{Name: "a", Status: status.CustomRoleStatusImported},
+		}, project.Status.CustomRoles)
+	})
+
+	t.Run("should not consider the imported roles as changed by someone else", func(t *testing.T) {
+		project := newProject(true)
+		project.WithAnnotations(map[string]string{customresource.AnnotationLastAppliedConfiguration: "{}"})
+		workflowCtx := &workflow.Context{
+			Client: &mongodbatlas.Client{
+				CustomDBRoles: &atlas.CustomRolesClientMock{
+					ListFunc: func(projectID string) (*[]mongodbatlas.CustomDBRole, *mongodbatlas.Response, error) {
+						return &[]mongodbatlas.CustomDBRole{{RoleName: "console-role"}}, nil, nil
+					},
+				},
+			},
+			Context: context.Background(),
+		}
+
+		canReconcile, _, err := canCustomRolesReconcile(workflowCtx, true, project)
+
+		require.NoError(t, err)
+		assert.True(t, canReconcile)
+	})
+}
+
+func TestExportImportedCustomRoles(t *testing.T) {
+	newReconciler := func(t *testing.T, recorder record.EventRecorder) *AtlasProjectReconciler {
+		sch := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(sch))
+		require.NoError(t, mdbv1.AddToScheme(sch))
+
+		return &AtlasProjectReconciler{
+			Client:        fake.NewClientBuilder().WithScheme(sch).Build(),
+			Scheme:        sch,
+			EventRecorder: recorder,
+		}
+	}
+	newProject := func() *mdbv1.AtlasProject {
+		project := mdbv1.NewProject("ns", "project", "project")
+		project.UID = "project-uid"
+		project.Spec.CustomRolesImport = &mdbv1.CustomRolesImport{Enabled: true, ConfigMapName: "imported-roles"}
+
+		return project
+	}
+	imported := []mdbv1.CustomRole{{Name: "console-role", InheritedRoles: []mdbv1.Role{{Name: "read", Database: "orders"}}}}
+
+	t.Run("should write the imported roles as a spec snippet", func(t *testing.T) {
+		recorder := record.NewFakeRecorder(10)
+		r := newReconciler(t, recorder)
+		project := newProject()
+		workflowCtx := newContext(t)
+
+		require.True(t, r.exportImportedCustomRoles(workflowCtx, project, imported).IsOk())
+
+		configMap := &corev1.ConfigMap{}
+		require.NoError(t, r.Client.Get(context.Background(), client.ObjectKey{Namespace: "ns", Name: "imported-roles"}, configMap))
+		assert.Equal(t, "customRoles:\n- inheritedRoles:\n  - database: orders\n    name: read\n  name: console-role\n", configMap.Data[importedCustomRolesKey])
+		assert.True(t, metav1.IsControlledBy(configMap, project))
+		require.Len(t, recorder.Events, 1)
+		assert.Equal(t, "Normal CustomRolesImported Custom roles imported from Atlas: console-role", <-recorder.Events)
+	})
+
+	t.Run("should not emit an event for the roles already imported", func(t *testing.T) {
+		recorder := record.NewFakeRecorder(10)
+		r := newReconciler(t, recorder)
+		project := newProject()
+		project.Status.CustomRoles = []status.CustomRole{{Name: "console-role", Status: status.CustomRoleStatusImported}}
+		workflowCtx := newContext(t)
+
+		require.True(t, r.exportImportedCustomRoles(workflowCtx, project, imported).IsOk())
+		assert.Empty(t, recorder.Events)
+	})
+}
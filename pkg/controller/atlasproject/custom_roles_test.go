@@ -119,7 +119,7 @@ func TestSyncCustomRolesStatus(t *testing.T) {
 		assert.Equal(
 			t,
 			workflow.OK(),
-			syncCustomRolesStatus(ctx, desired, created, updated, deleted),
+			syncCustomRolesStatus(ctx, desired, created, updated, deleted, nil),
 		)
 
 		option := ctx.StatusOptions()[0].(status.AtlasProjectStatusOption)
@@ -186,8 +186,9 @@ func TestSyncCustomRolesStatus(t *testing.T) {
 
 		assert.Equal(
 			t,
-			workflow.Terminate(workflow.ProjectCustomRolesReady, "failed to apply changes to custom roles: server failed"),
-			syncCustomRolesStatus(ctx, desired, created, updated, deleted),
+			workflow.Terminate(workflow.ProjectCustomRolesReady, "failed to apply changes to custom roles: server failed").
+				WithCause(customRolesReconciler, ""),
+			syncCustomRolesStatus(ctx, desired, created, updated, deleted, nil),
 		)
 
 		option := ctx.StatusOptions()[0].(status.AtlasProjectStatusOption)
@@ -221,7 +222,7 @@ func TestCanCustomRolesReconcile(t *testing.T) {
 			Client:  &mongodbatlas.Client{},
 			Context: context.Background(),
 		}
-		result, err := canCustomRolesReconcile(&workflowCtx, false, &mdbv1.AtlasProject{})
+		result, _, err := canCustomRolesReconcile(&workflowCtx, false, &mdbv1.AtlasProject{})
 		assert.NoError(t, err)
 		assert.True(t, result)
 	})
@@ -233,7 +234,7 @@ func TestCanCustomRolesReconcile(t *testing.T) {
 			Client:  &mongodbatlas.Client{},
 			Context: context.Background(),
 		}
-		result, err := canCustomRolesReconcile(workflowCtx, true, akoProject)
+		result, _, err := canCustomRolesReconcile(workflowCtx, true, akoProject)
 		assert.EqualError(t, err, "invalid character 'w' looking for beginning of object key string")
 		assert.False(t, result)
 	})
@@ -252,7 +253,7 @@ func TestCanCustomRolesReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canCustomRolesReconcile(workflowCtx, true, akoProject)
+		result, _, err := canCustomRolesReconcile(workflowCtx, true, akoProject)
 
 		assert.EqualError(t, err, "failed to retrieve data")
 		assert.False(t, result)
@@ -272,7 +273,7 @@ func TestCanCustomRolesReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canCustomRolesReconcile(workflowCtx, true, akoProject)
+		result, _, err := canCustomRolesReconcile(workflowCtx, true, akoProject)
 
 		assert.NoError(t, err)
 		assert.True(t, result)
@@ -292,7 +293,7 @@ func TestCanCustomRolesReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canCustomRolesReconcile(workflowCtx, true, akoProject)
+		result, _, err := canCustomRolesReconcile(workflowCtx, true, akoProject)
 
 		assert.NoError(t, err)
 		assert.True(t, result)
@@ -348,7 +349,7 @@ func TestCanCustomRolesReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canCustomRolesReconcile(workflowCtx, true, akoProject)
+		result, _, err := canCustomRolesReconcile(workflowCtx, true, akoProject)
 
 		assert.NoError(t, err)
 		assert.True(t, result)
@@ -404,7 +405,7 @@ func TestCanCustomRolesReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canCustomRolesReconcile(workflowCtx, true, akoProject)
+		result, _, err := canCustomRolesReconcile(workflowCtx, true, akoProject)
 
 		assert.NoError(t, err)
 		assert.True(t, result)
@@ -462,7 +463,7 @@ func TestCanCustomRolesReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canCustomRolesReconcile(workflowCtx, true, akoProject)
+		result, _, err := canCustomRolesReconcile(workflowCtx, true, akoProject)
 
 		assert.NoError(t, err)
 		assert.False(t, result)
@@ -484,9 +485,10 @@ func TestEnsureCustomRoles(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result := ensureCustomRoles(workflowCtx, akoProject, true)
+		result := (&AtlasProjectReconciler{}).ensureCustomRoles(workflowCtx, akoProject, true)
 
-		require.Equal(t, workflow.Terminate(workflow.Internal, "unable to resolve ownership for deletion protection: failed to retrieve data"), result)
+		require.Equal(t, workflow.Terminate(workflow.Internal, "unable to resolve ownership for deletion protection: failed to retrieve data").
+			WithCause(customRolesReconciler, "listCustomDatabaseRoles"), result)
 	})
 
 	t.Run("should failed to reconcile when unable to synchronize with Atlas", func(t *testing.T) {
@@ -541,7 +543,7 @@ func TestEnsureCustomRoles(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result := ensureCustomRoles(workflowCtx, akoProject, true)
+		result := (&AtlasProjectReconciler{}).ensureCustomRoles(workflowCtx, akoProject, true)
 
 		require.Equal(
 			t,
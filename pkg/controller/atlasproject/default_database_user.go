@@ -0,0 +1,145 @@
+package atlasproject
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/stringutil"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/connectionsecret"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	defaultDatabaseUserPasswordKey    = "password"
+	defaultDatabaseUserPasswordLength = 24
+)
+
+// defaultDatabaseUserName is the name of the AtlasDatabaseUser created in the project namespace for the default
+// database user, its password Secret is named after it
+func defaultDatabaseUserName(akoProject *mdbv1.AtlasProject) string {
+	return fmt.Sprintf("%s-default-user", akoProject.Name)
+}
+
+func defaultDatabaseUserPasswordName(akoProject *mdbv1.AtlasProject) string {
+	return fmt.Sprintf("%s-password", defaultDatabaseUserName(akoProject))
+}
+
+// ensureDefaultDatabaseUser creates the AtlasDatabaseUser of the default database user and the Secret with its
+// generated password, both owned by the project. The password is generated once and kept as is afterwards. The
+// connection secrets are then created by the AtlasDatabaseUser as for any other user
+func (r *AtlasProjectReconciler) ensureDefaultDatabaseUser(ctx *workflow.Context, akoProject *mdbv1.AtlasProject) workflow.Result {
+	user := &mdbv1.AtlasDatabaseUser{ObjectMeta: metav1.ObjectMeta{Name: defaultDatabaseUserName(akoProject), Namespace: akoProject.Namespace}}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: defaultDatabaseUserPasswordName(akoProject), Namespace: akoProject.Namespace}}
+
+	if akoProject.Spec.DefaultDatabaseUser == nil {
+		for _, obj := range []client.Object{user, secret} {
+			if err := r.deleteOwnedObject(ctx, akoProject, obj); err != nil {
+				result := workflow.Terminate(workflow.ProjectDefaultDatabaseUserNotReady, fmt.Sprintf("failed to remove the default database user: %s", err))
+				ctx.SetConditionFromResult(status.DefaultDatabaseUserReadyType, result)
+
+				return result
+			}
+		}
+		ctx.UnsetCondition(status.DefaultDatabaseUserReadyType)
+
+		return workflow.OK()
+	}
+
+	if err := r.ensureDefaultDatabaseUserPassword(ctx, akoProject, secret); err != nil {
+		result := workflow.Terminate(workflow.ProjectDefaultDatabaseUserNotReady, fmt.Sprintf("failed to write the password of the default database user: %s", err))
+		ctx.SetConditionFromResult(status.DefaultDatabaseUserReadyType, result)
+
+		return result
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx.Context, r.Client, user, func() error {
+		if err := requireOwnership(akoProject, user); err != nil {
+			return err
+		}
+
+		user.Spec.Project = common.ResourceRefNamespaced{Name: akoProject.Name, Namespace: akoProject.Namespace}
+		user.Spec.DatabaseName = "admin"
+		user.Spec.Username = akoProject.Spec.DefaultDatabaseUser.Username
+		if user.Spec.Username == "" {
+			user.Spec.Username = "admin"
+		}
+		user.Spec.Roles = akoProject.Spec.DefaultDatabaseUser.Roles
+		if len(user.Spec.Roles) == 0 {
+			user.Spec.Roles = []mdbv1.RoleSpec{{RoleName: "atlasAdmin", DatabaseName: "admin"}}
+		}
+		user.Spec.PasswordSecret = &common.ResourceRef{Name: secret.Name}
+
+		return controllerutil.SetControllerReference(akoProject, user, r.Scheme)
+	})
+	if err != nil {
+		result := workflow.Terminate(workflow.ProjectDefaultDatabaseUserNotReady, fmt.Sprintf("failed to write the default database user: %s", err))
+		ctx.SetConditionFromResult(status.DefaultDatabaseUserReadyType, result)
+
+		return result
+	}
+
+	ctx.SetConditionTrue(status.DefaultDatabaseUserReadyType)
+	return workflow.OK()
+}
+
+func (r *AtlasProjectReconciler) ensureDefaultDatabaseUserPassword(ctx *workflow.Context, akoProject *mdbv1.AtlasProject, secret *corev1.Secret) error {
+	_, err := controllerutil.CreateOrUpdate(ctx.Context, r.Client, secret, func() error {
+		if err := requireOwnership(akoProject, secret); err != nil {
+			return err
+		}
+
+		if secret.Labels == nil {
+			secret.Labels = map[string]string{}
+		}
+		// the label lets the AtlasDatabaseUser be reconciled on changes of the password
+		secret.Labels[connectionsecret.TypeLabelKey] = connectionsecret.CredLabelVal
+
+		if len(secret.Data[defaultDatabaseUserPasswordKey]) == 0 {
+			password, err := stringutil.GeneratePassword(defaultDatabaseUserPasswordLength)
+			if err != nil {
+				return err
+			}
+			secret.Data = map[string][]byte{defaultDatabaseUserPasswordKey: []byte(password)}
+		}
+
+		return controllerutil.SetControllerReference(akoProject, secret, r.Scheme)
+	})
+
+	return err
+}
+
+// requireOwnership refuses to mutate an existing object the project doesn't control, so that an object of the same
+// name created by the users is never taken over
+func requireOwnership(akoProject *mdbv1.AtlasProject, obj client.Object) error {
+	if obj.GetResourceVersion() == "" || metav1.IsControlledBy(obj, akoProject) {
+		return nil
+	}
+
+	return fmt.Errorf("%s already exists and isn't owned by the project, rename or remove it", obj.GetName())
+}
+
+// deleteOwnedObject deletes the object if it exists and is controlled by the project, leaving alone the objects of the
+// same name created by the users
+func (r *AtlasProjectReconciler) deleteOwnedObject(ctx *workflow.Context, akoProject *mdbv1.AtlasProject, obj client.Object) error {
+	if err := r.Client.Get(ctx.Context, client.ObjectKeyFromObject(obj), obj); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if !metav1.IsControlledBy(obj, akoProject) {
+		return nil
+	}
+
+	if err := r.Client.Delete(ctx.Context, obj); err != nil && !apiErrors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
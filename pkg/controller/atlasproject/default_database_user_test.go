@@ -0,0 +1,129 @@
+package atlasproject
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/connectionsecret"
+)
+
+func TestEnsureDefaultDatabaseUser(t *testing.T) {
+	newProject := func(defaultUser *mdbv1.DefaultDatabaseUser) *mdbv1.AtlasProject {
+		akoProject := mdbv1.NewProject("ns", "my-project", "my-project")
+		akoProject.UID = "project-uid"
+		akoProject.Spec.DefaultDatabaseUser = defaultUser
+
+		return akoProject
+	}
+	newReconciler := func(t *testing.T, objects ...client.Object) *AtlasProjectReconciler {
+		sch := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(sch))
+		require.NoError(t, mdbv1.AddToScheme(sch))
+
+		return &AtlasProjectReconciler{
+			Client: fake.NewClientBuilder().WithScheme(sch).WithObjects(objects...).Build(),
+			Scheme: sch,
+			Log:    zaptest.NewLogger(t).Sugar(),
+		}
+	}
+	userKey := client.ObjectKey{Name: "my-project-default-user", Namespace: "ns"}
+	passwordKey := client.ObjectKey{Name: "my-project-default-user-password", Namespace: "ns"}
+
+	t.Run("should create an atlasAdmin user with a generated password", func(t *testing.T) {
+		r := newReconciler(t)
+		akoProject := newProject(&mdbv1.DefaultDatabaseUser{})
+
+		result := r.ensureDefaultDatabaseUser(newContext(t), akoProject)
+		require.True(t, result.IsOk())
+
+		secret := &corev1.Secret{}
+		require.NoError(t, r.Client.Get(context.Background(), passwordKey, secret))
+		assert.Len(t, secret.Data["password"], 24)
+		assert.Equal(t, connectionsecret.CredLabelVal, secret.Labels[connectionsecret.TypeLabelKey])
+		assert.True(t, metav1.IsControlledBy(secret, akoProject))
+
+		user := &mdbv1.AtlasDatabaseUser{}
+		require.NoError(t, r.Client.Get(context.Background(), userKey, user))
+		assert.Equal(t, "admin", user.Spec.Username)
+		assert.Equal(t, "my-project", user.Spec.Project.Name)
+		assert.Equal(t, []mdbv1.RoleSpec{{RoleName: "atlasAdmin", DatabaseName: "admin"}}, user.Spec.Roles)
+		assert.Equal(t, passwordKey.Name, user.Spec.PasswordSecret.Name)
+		assert.True(t, metav1.IsControlledBy(user, akoProject))
+	})
+
+	t.Run("should keep the generated password", func(t *testing.T) {
+		akoProject := newProject(&mdbv1.DefaultDatabaseUser{Username: "app"})
+		r := newReconciler(t)
+		require.True(t, r.ensureDefaultDatabaseUser(newContext(t), akoProject).IsOk())
+
+		secret := &corev1.Secret{}
+		require.NoError(t, r.Client.Get(context.Background(), passwordKey, secret))
+		password := string(secret.Data["password"])
+
+		require.True(t, r.ensureDefaultDatabaseUser(newContext(t), akoProject).IsOk())
+		require.NoError(t, r.Client.Get(context.Background(), passwordKey, secret))
+		assert.Equal(t, password, string(secret.Data["password"]))
+	})
+
+	t.Run("should remove the user it created once unset", func(t *testing.T) {
+		akoProject := newProject(&mdbv1.DefaultDatabaseUser{})
+		r := newReconciler(t)
+		require.True(t, r.ensureDefaultDatabaseUser(newContext(t), akoProject).IsOk())
+
+		akoProject.Spec.DefaultDatabaseUser = nil
+		require.True(t, r.ensureDefaultDatabaseUser(newContext(t), akoProject).IsOk())
+
+		err := r.Client.Get(context.Background(), userKey, &mdbv1.AtlasDatabaseUser{})
+		assert.True(t, apiErrors.IsNotFound(err))
+		err = r.Client.Get(context.Background(), passwordKey, &corev1.Secret{})
+		assert.True(t, apiErrors.IsNotFound(err))
+	})
+
+	t.Run("should leave alone a user of the same name not owned by the project", func(t *testing.T) {
+		r := newReconciler(t, &mdbv1.AtlasDatabaseUser{ObjectMeta: metav1.ObjectMeta{Name: userKey.Name, Namespace: userKey.Namespace}})
+
+		require.True(t, r.ensureDefaultDatabaseUser(newContext(t), newProject(nil)).IsOk())
+
+		assert.NoError(t, r.Client.Get(context.Background(), userKey, &mdbv1.AtlasDatabaseUser{}))
+	})
+	t.Run("should refuse to take over a user of the same name not owned by the project", func(t *testing.T) {
+		existing := &mdbv1.AtlasDatabaseUser{
+			ObjectMeta: metav1.ObjectMeta{Name: userKey.Name, Namespace: userKey.Namespace},
+			Spec:       mdbv1.AtlasDatabaseUserSpec{Username: "app", Roles: []mdbv1.RoleSpec{{RoleName: "read", DatabaseName: "app"}}},
+		}
+		r := newReconciler(t, existing)
+
+		result := r.ensureDefaultDatabaseUser(newContext(t), newProject(&mdbv1.DefaultDatabaseUser{}))
+
+		require.False(t, result.IsOk())
+		assert.Contains(t, result.GetMessage(), "isn't owned by the project")
+		user := &mdbv1.AtlasDatabaseUser{}
+		require.NoError(t, r.Client.Get(context.Background(), userKey, user))
+		assert.Equal(t, existing.Spec.Roles, user.Spec.Roles)
+		assert.Empty(t, user.OwnerReferences)
+	})
+
+	t.Run("should refuse to take over a password Secret of the same name not owned by the project", func(t *testing.T) {
+		r := newReconciler(t, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: passwordKey.Name, Namespace: passwordKey.Namespace},
+			Data:       map[string][]byte{"password": []byte("secret")},
+		})
+
+		result := r.ensureDefaultDatabaseUser(newContext(t), newProject(&mdbv1.DefaultDatabaseUser{}))
+
+		require.False(t, result.IsOk())
+		err := r.Client.Get(context.Background(), userKey, &mdbv1.AtlasDatabaseUser{})
+		assert.True(t, apiErrors.IsNotFound(err))
+	})
+}
@@ -0,0 +1,80 @@
+package atlasproject
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/set"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// reportDeletionProtection records in the status the conclusion of the deletion protection check of a sub-resource, so
+// that users can tell which configuration changed in Atlas without reading the operator logs. The decision is removed
+// when the protection is disabled, the check being skipped
+func reportDeletionProtection(workflowCtx *workflow.Context, subresource string, protected, canReconcile bool, differences []string) {
+	if !protected {
+		workflowCtx.EnsureStatusOption(status.AtlasProjectDeletionProtectionOption(subresource, nil))
+
+		return
+	}
+
+	decision := status.DeletionProtectionDecision{
+		Subresource: subresource,
+		Reconciled:  canReconcile,
+	}
+	if !canReconcile {
+		decision.Differences = differences
+	}
+
+	workflowCtx.EnsureStatusOption(status.AtlasProjectDeletionProtectionOption(subresource, &decision))
+}
+
+// listDifferences describes the entries of a list only found in Atlas, only found in the spec, or found in both with
+// different configurations. Entries are matched by key
+func listDifferences[T any](atlas, spec []T, key func(T) string) []string {
+	atlasEntries := make(map[string]T, len(atlas))
+	for _, entry := range atlas {
+		atlasEntries[key(entry)] = entry
+	}
+
+	differences := make([]string, 0)
+	for _, entry := range spec {
+		atlasEntry, ok := atlasEntries[key(entry)]
+		switch {
+		case !ok:
+			differences = append(differences, fmt.Sprintf("%s: only in the spec", key(entry)))
+		case !cmp.Equal(atlasEntry, entry, cmpopts.EquateEmpty()):
+			differences = append(differences, fmt.Sprintf("%s: differs in Atlas", key(entry)))
+		}
+		delete(atlasEntries, key(entry))
+	}
+
+	for entryKey := range atlasEntries {
+		differences = append(differences, fmt.Sprintf("%s: only in Atlas", entryKey))
+	}
+
+	sort.Strings(differences)
+
+	return differences
+}
+
+// identifiableDifferences describes the entries of a list only found in Atlas or only found in the spec, the items
+// of both lists being set.Identifiable
+func identifiableDifferences(atlas, spec interface{}) []string {
+	differences := make([]string, 0)
+	for _, entry := range set.Difference(atlas, spec) {
+		differences = append(differences, fmt.Sprintf("%v: only in Atlas", entry.Identifier()))
+	}
+
+	for _, entry := range set.Difference(spec, atlas) {
+		differences = append(differences, fmt.Sprintf("%v: only in the spec", entry.Identifier()))
+	}
+
+	sort.Strings(differences)
+
+	return differences
+}
@@ -0,0 +1,98 @@
+package atlasproject
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestReportDeletionProtection(t *testing.T) {
+	t.Run("should report the differences of a sub-resource which isn't reconciled", func(t *testing.T) {
+		workflowCtx := &workflow.Context{Context: context.Background()}
+
+		reportDeletionProtection(workflowCtx, "auditing", true, false, []string{"enabled"})
+		reportDeletionProtection(workflowCtx, "settings", true, true, nil)
+
+		assert.Equal(
+			t,
+			[]status.DeletionProtectionDecision{
+				{Subresource: "auditing", Reconciled: false, Differences: []string{"enabled"}},
+				{Subresource: "settings", Reconciled: true},
+			},
+			syncedProject(workflowCtx).Status.DeletionProtection,
+		)
+	})
+
+	t.Run("should replace the previous decision of a sub-resource", func(t *testing.T) {
+		workflowCtx := &workflow.Context{Context: context.Background()}
+
+		reportDeletionProtection(workflowCtx, "auditing", true, false, []string{"enabled"})
+		reportDeletionProtection(workflowCtx, "auditing", true, true, []string{"enabled"})
+
+		assert.Equal(
+			t,
+			[]status.DeletionProtectionDecision{{Subresource: "auditing", Reconciled: true}},
+			syncedProject(workflowCtx).Status.DeletionProtection,
+		)
+	})
+
+	t.Run("should remove the decision when the protection is disabled", func(t *testing.T) {
+		workflowCtx := &workflow.Context{Context: context.Background()}
+
+		reportDeletionProtection(workflowCtx, "auditing", true, false, []string{"enabled"})
+		reportDeletionProtection(workflowCtx, "auditing", false, true, nil)
+
+		assert.Empty(t, syncedProject(workflowCtx).Status.DeletionProtection)
+	})
+}
+
+func TestListDifferences(t *testing.T) {
+	key := func(entry project.IPAccessList) string {
+		return entry.CIDRBlock
+	}
+
+	t.Run("should describe the entries only in Atlas, only in the spec and changed in Atlas", func(t *testing.T) {
+		atlas := []project.IPAccessList{
+			{CIDRBlock: "10.0.0.0/24"},
+			{CIDRBlock: "10.1.0.0/24", Comment: "changed"},
+		}
+		spec := []project.IPAccessList{
+			{CIDRBlock: "10.1.0.0/24"},
+			{CIDRBlock: "10.2.0.0/24"},
+		}
+
+		assert.Equal(
+			t,
+			[]string{"10.0.0.0/24: only in Atlas", "10.1.0.0/24: differs in Atlas", "10.2.0.0/24: only in the spec"},
+			listDifferences(atlas, spec, key),
+		)
+	})
+
+	t.Run("should describe no differences for equal lists", func(t *testing.T) {
+		entries := []project.IPAccessList{{CIDRBlock: "10.0.0.0/24"}}
+
+		assert.Empty(t, listDifferences(entries, entries, key))
+	})
+}
+
+func TestIdentifiableDifferences(t *testing.T) {
+	atlas := []CloudProviderIntegrationIdentifiable{
+		{ProviderName: "AWS", IamAssumedRoleArn: "arn-1"},
+		{ProviderName: "AWS", IamAssumedRoleArn: "arn-2"},
+	}
+	spec := []CloudProviderIntegrationIdentifiable{
+		{ProviderName: "AWS", IamAssumedRoleArn: "arn-2"},
+		{ProviderName: "AWS", IamAssumedRoleArn: "arn-3"},
+	}
+
+	assert.Equal(
+		t,
+		[]string{"AWS.arn-1: only in Atlas", "AWS.arn-3: only in the spec"},
+		identifiableDifferences(atlas, spec),
+	)
+}
@@ -23,6 +23,8 @@ import (
 
 const (
 	ObjectIDRegex = "^([a-f0-9]{24})$"
+
+	encryptionAtRestReconciler = "encryptionAtRest"
 )
 
 func (r *AtlasProjectReconciler) ensureEncryptionAtRest(workflowCtx *workflow.Context, project *mdbv1.AtlasProject, protected bool) workflow.Result {
@@ -31,13 +33,15 @@ func (r *AtlasProjectReconciler) ensureEncryptionAtRest(workflowCtx *workflow.Co
 		return workflow.Terminate(workflow.ProjectEncryptionAtRestReady, err.Error())
 	}
 
-	canReconcile, err := canEncryptionAtRestReconcile(workflowCtx, protected, project)
+	canReconcile, differences, err := canEncryptionAtRestReconcile(workflowCtx, protected, project)
 	if err != nil {
-		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err))
+		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err)).
+			WithCause(encryptionAtRestReconciler, workflow.AtlasOperation(err))
 		workflowCtx.SetConditionFromResult(status.EncryptionAtRestReadyType, result)
 
 		return result
 	}
+	reportDeletionProtection(workflowCtx, "encryptionAtRest", protected, canReconcile, differences)
 
 	if !canReconcile {
 		result := workflow.Terminate(
@@ -167,20 +171,24 @@ func readSecretData(ctx context.Context, kubeClient client.Client, res common.Re
 func createOrDeleteEncryptionAtRests(ctx *workflow.Context, projectID string, project *mdbv1.AtlasProject) workflow.Result {
 	encryptionAtRestsInAtlas, err := fetchEncryptionAtRests(ctx, projectID)
 	if err != nil {
-		return workflow.Terminate(workflow.Internal, err.Error())
+		return workflow.Terminate(workflow.Internal, err.Error()).WithCause(encryptionAtRestReconciler, "getEncryptionAtRest")
 	}
 
 	inSync, err := AtlasInSync(encryptionAtRestsInAtlas, project.Spec.EncryptionAtRest)
 	if err != nil {
-		return workflow.Terminate(workflow.Internal, err.Error())
+		return workflow.Terminate(workflow.Internal, err.Error()).WithCause(encryptionAtRestReconciler, "")
 	}
 
 	if inSync {
 		return workflow.OK()
 	}
 
-	if err := syncEncryptionAtRestsInAtlas(ctx, projectID, project); err != nil {
-		return workflow.Terminate(workflow.Internal, err.Error())
+	err = syncEncryptionAtRestsInAtlas(ctx, projectID, project)
+	if result, rejected := rejectedDuringMaintenance(ctx, err); rejected {
+		return result
+	}
+	if err != nil {
+		return workflow.Terminate(workflow.Internal, err.Error()).WithCause(encryptionAtRestReconciler, workflow.AtlasOperation(err))
 	}
 
 	return workflow.OK()
@@ -208,7 +216,7 @@ func syncEncryptionAtRestsInAtlas(ctx *workflow.Context, projectID string, proje
 	}
 
 	if _, _, err := ctx.Client.EncryptionsAtRest.Create(ctx.Context, &requestBody); err != nil { // Create() sends PATCH request
-		return err
+		return workflow.NewAtlasOperationError("updateEncryptionAtRest", err)
 	}
 
 	return nil
@@ -232,7 +240,7 @@ func normalizeAwsKms(ctx *workflow.Context, projectID string, awsKms *mongodbatl
 	// assume that role ID is set as AWS ARN
 	resp, _, err := ctx.Client.CloudProviderAccess.ListRoles(ctx.Context, projectID)
 	if err != nil {
-		return err
+		return workflow.NewAtlasOperationError("listCloudProviderAccessRoles", err)
 	}
 
 	for _, role := range resp.AWSIAMRoles {
@@ -388,30 +396,50 @@ func selectRole(accessRoles []status.CloudProviderIntegration, providerName stri
 	return
 }
 
-func canEncryptionAtRestReconcile(workflowCtx *workflow.Context, protected bool, akoProject *mdbv1.AtlasProject) (bool, error) {
+func canEncryptionAtRestReconcile(workflowCtx *workflow.Context, protected bool, akoProject *mdbv1.AtlasProject) (bool, []string, error) {
 	if !protected {
-		return true, nil
+		return true, nil, nil
 	}
 
 	latestConfig := &mdbv1.AtlasProjectSpec{}
 	latestConfigString, ok := akoProject.Annotations[customresource.AnnotationLastAppliedConfiguration]
 	if ok {
 		if err := json.Unmarshal([]byte(latestConfigString), latestConfig); err != nil {
-			return false, err
+			return false, nil, err
 		}
 	}
 
 	ear, _, err := workflowCtx.Client.EncryptionsAtRest.Get(workflowCtx.Context, akoProject.ID())
 	if err != nil {
-		return false, err
+		return false, nil, workflow.NewAtlasOperationError("getEncryptionAtRest", err)
 	}
 
 	if IsEncryptionAtlasEmpty(ear) {
-		return true, nil
+		return true, nil, nil
+	}
+
+	if areEaRConfigEqual(*latestConfig.EncryptionAtRest, ear, true) ||
+		areEaRConfigEqual(*akoProject.Spec.EncryptionAtRest, ear, false) {
+		return true, nil, nil
+	}
+
+	return false, encryptionAtRestDifferences(*akoProject.Spec.EncryptionAtRest, ear), nil
+}
+
+// encryptionAtRestDifferences lists the key management services configured in Atlas differently from the spec
+func encryptionAtRestDifferences(operator mdbv1.EncryptionAtRest, atlas *mongodbatlas.EncryptionAtRest) []string {
+	var differences []string
+	if !areAWSConfigEqual(operator.AwsKms, atlas.AwsKms, false) {
+		differences = append(differences, "awsKms")
+	}
+	if !areGCPConfigEqual(operator.GoogleCloudKms, atlas.GoogleCloudKms, false) {
+		differences = append(differences, "googleCloudKms")
+	}
+	if !areAzureConfigEqual(operator.AzureKeyVault, atlas.AzureKeyVault, false) {
+		differences = append(differences, "azureKeyVault")
 	}
 
-	return areEaRConfigEqual(*latestConfig.EncryptionAtRest, ear, true) ||
-		areEaRConfigEqual(*akoProject.Spec.EncryptionAtRest, ear, false), nil
+	return differences
 }
 
 func areEaRConfigEqual(operator mdbv1.EncryptionAtRest, atlas *mongodbatlas.EncryptionAtRest, lastApplied bool) bool {
@@ -27,7 +27,7 @@ func TestCanEncryptionAtRestReconcile(t *testing.T) {
 			Client:  &mongodbatlas.Client{},
 			Context: context.Background(),
 		}
-		result, err := canEncryptionAtRestReconcile(workflowCtx, false, &mdbv1.AtlasProject{})
+		result, _, err := canEncryptionAtRestReconcile(workflowCtx, false, &mdbv1.AtlasProject{})
 		require.NoError(t, err)
 		require.True(t, result)
 	})
@@ -39,7 +39,7 @@ func TestCanEncryptionAtRestReconcile(t *testing.T) {
 			Client:  &mongodbatlas.Client{},
 			Context: context.Background(),
 		}
-		result, err := canEncryptionAtRestReconcile(workflowCtx, true, akoProject)
+		result, _, err := canEncryptionAtRestReconcile(workflowCtx, true, akoProject)
 		require.EqualError(t, err, "invalid character 'w' looking for beginning of object key string")
 		require.False(t, result)
 	})
@@ -58,7 +58,7 @@ func TestCanEncryptionAtRestReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canEncryptionAtRestReconcile(workflowCtx, true, akoProject)
+		result, _, err := canEncryptionAtRestReconcile(workflowCtx, true, akoProject)
 
 		require.EqualError(t, err, "failed to retrieve data")
 		require.False(t, result)
@@ -88,7 +88,7 @@ func TestCanEncryptionAtRestReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canEncryptionAtRestReconcile(workflowCtx, true, akoProject)
+		result, _, err := canEncryptionAtRestReconcile(workflowCtx, true, akoProject)
 
 		require.NoError(t, err)
 		require.True(t, result)
@@ -140,7 +140,7 @@ func TestCanEncryptionAtRestReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canEncryptionAtRestReconcile(workflowCtx, true, akoProject)
+		result, _, err := canEncryptionAtRestReconcile(workflowCtx, true, akoProject)
 
 		require.NoError(t, err)
 		require.True(t, result)
@@ -193,7 +193,7 @@ func TestCanEncryptionAtRestReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canEncryptionAtRestReconcile(workflowCtx, true, akoProject)
+		result, _, err := canEncryptionAtRestReconcile(workflowCtx, true, akoProject)
 
 		require.NoError(t, err)
 		require.True(t, result)
@@ -246,7 +246,7 @@ func TestCanEncryptionAtRestReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canEncryptionAtRestReconcile(workflowCtx, true, akoProject)
+		result, _, err := canEncryptionAtRestReconcile(workflowCtx, true, akoProject)
 
 		require.NoError(t, err)
 		require.False(t, result)
@@ -273,7 +273,8 @@ func TestEnsureEncryptionAtRest(t *testing.T) {
 		}
 		result := reconciler.ensureEncryptionAtRest(workflowCtx, akoProject, true)
 
-		require.Equal(t, workflow.Terminate(workflow.Internal, "unable to resolve ownership for deletion protection: failed to retrieve data"), result)
+		require.Equal(t, workflow.Terminate(workflow.Internal, "unable to resolve ownership for deletion protection: failed to retrieve data").
+			WithCause(encryptionAtRestReconciler, "getEncryptionAtRest"), result)
 	})
 
 	t.Run("should failed to reconcile when unable to synchronize with Atlas", func(t *testing.T) {
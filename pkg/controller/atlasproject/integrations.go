@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/url"
 	"reflect"
+	"time"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
 
@@ -19,14 +20,18 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
 
+const integrationReconciler = "integration"
+
 func (r *AtlasProjectReconciler) ensureIntegration(workflowCtx *workflow.Context, akoProject *mdbv1.AtlasProject, protected bool) workflow.Result {
-	canReconcile, err := canIntegrationsReconcile(workflowCtx, protected, akoProject)
+	canReconcile, differences, err := canIntegrationsReconcile(workflowCtx, protected, akoProject)
 	if err != nil {
-		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err))
+		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err)).
+			WithCause(integrationReconciler, workflow.AtlasOperation(err))
 		workflowCtx.SetConditionFromResult(status.IntegrationReadyType, result)
 
 		return result
 	}
+	reportDeletionProtection(workflowCtx, "integrations", protected, canReconcile, differences)
 
 	if !canReconcile {
 		result := workflow.Terminate(
@@ -56,14 +61,14 @@ func (r *AtlasProjectReconciler) ensureIntegration(workflowCtx *workflow.Context
 func (r *AtlasProjectReconciler) createOrDeleteIntegrations(ctx *workflow.Context, projectID string, project *mdbv1.AtlasProject) workflow.Result {
 	integrationsInAtlas, err := fetchIntegrations(ctx, projectID)
 	if err != nil {
-		return workflow.Terminate(workflow.ProjectIntegrationInternal, err.Error())
+		return workflow.Terminate(workflow.ProjectIntegrationInternal, err.Error()).WithCause(integrationReconciler, "listThirdPartyIntegrations")
 	}
 	integrationsInAtlasAlias := toAliasThirdPartyIntegration(integrationsInAtlas.Results)
 
 	identifiersForDelete := set.Difference(integrationsInAtlasAlias, project.Spec.Integrations)
 	ctx.Log.Debugf("identifiersForDelete: %v", identifiersForDelete)
 	if err := deleteIntegrationsFromAtlas(ctx, projectID, identifiersForDelete); err != nil {
-		return workflow.Terminate(workflow.ProjectIntegrationInternal, err.Error())
+		return workflow.Terminate(workflow.ProjectIntegrationInternal, err.Error()).WithCause(integrationReconciler, "deleteThirdPartyIntegration")
 	}
 
 	integrationsToUpdate := set.Intersection(integrationsInAtlasAlias, project.Spec.Integrations)
@@ -79,6 +84,12 @@ func (r *AtlasProjectReconciler) createOrDeleteIntegrations(ctx *workflow.Contex
 	}
 
 	syncPrometheusStatus(ctx, project, integrationsToUpdate)
+	if result := r.ensurePrometheusScrapeSecret(ctx, project); !result.IsOk() {
+		return result
+	}
+	if result := r.ensureWebhookIntegration(ctx, project, time.Now()); !result.IsOk() {
+		return result
+	}
 	if ready := r.checkIntegrationsReady(ctx, project.Namespace, integrationsToUpdate, project.Spec.Integrations); !ready {
 		return workflow.InProgress(workflow.ProjectIntegrationReady, "in progress")
 	}
@@ -101,13 +112,15 @@ func (r *AtlasProjectReconciler) updateIntegrationsAtlas(ctx *workflow.Context,
 		kubeIntegration, err := item[1].(project.Integration).ToAtlas(ctx.Context, r.Client, namespace)
 		if kubeIntegration == nil {
 			ctx.Log.Warnw("Update Integrations", "Can not convert kube integration", err)
-			return workflow.Terminate(workflow.ProjectIntegrationInternal, "Update Integrations: Can not convert kube integration")
+			return workflow.Terminate(workflow.ProjectIntegrationInternal, "Update Integrations: Can not convert kube integration").
+				WithCause(integrationReconciler, "")
 		}
 		t := mongodbatlas.ThirdPartyIntegration(atlasIntegration)
 		if &t != kubeIntegration {
 			ctx.Log.Debugf("Try to update integration: %s", kubeIntegration.Type)
 			if _, _, err := ctx.Client.Integrations.Replace(ctx.Context, projectID, kubeIntegration.Type, kubeIntegration); err != nil {
-				return workflow.Terminate(workflow.ProjectIntegrationRequest, "Can not convert integration")
+				return workflow.Terminate(workflow.ProjectIntegrationRequest, "Can not convert integration").
+					WithCause(integrationReconciler, "updateThirdPartyIntegration")
 			}
 		}
 	}
@@ -128,7 +141,8 @@ func (r *AtlasProjectReconciler) createIntegrationsInAtlas(ctx *workflow.Context
 	for _, item := range integrations {
 		integration, err := item.(project.Integration).ToAtlas(ctx.Context, r.Client, namespace)
 		if err != nil || integration == nil {
-			return workflow.Terminate(workflow.ProjectIntegrationInternal, fmt.Sprintf("cannot convert integration: %s", err.Error()))
+			return workflow.Terminate(workflow.ProjectIntegrationInternal, fmt.Sprintf("cannot convert integration: %s", err.Error())).
+				WithCause(integrationReconciler, "")
 		}
 
 		_, resp, err := ctx.Client.Integrations.Create(ctx.Context, projectID, integration.Type, integration)
@@ -136,7 +150,7 @@ func (r *AtlasProjectReconciler) createIntegrationsInAtlas(ctx *workflow.Context
 			ctx.Log.Debugw("Create request failed", "Status", resp.Status, "Integration", integration)
 		}
 		if err != nil {
-			return workflow.Terminate(workflow.ProjectIntegrationRequest, err.Error())
+			return workflow.Terminate(workflow.ProjectIntegrationRequest, err.Error()).WithCause(integrationReconciler, "createThirdPartyIntegration")
 		}
 	}
 	return workflow.OK()
@@ -260,36 +274,39 @@ func buildPrometheusDiscoveryURL(baseURL *url.URL, projectID string) string {
 	return fmt.Sprintf("%s/groups/%s/discovery", api, projectID)
 }
 
-func canIntegrationsReconcile(workflowCtx *workflow.Context, protected bool, akoProject *mdbv1.AtlasProject) (bool, error) {
+func canIntegrationsReconcile(workflowCtx *workflow.Context, protected bool, akoProject *mdbv1.AtlasProject) (bool, []string, error) {
 	if !protected {
-		return true, nil
+		return true, nil, nil
 	}
 
 	latestConfig := &mdbv1.AtlasProjectSpec{}
 	latestConfigString, ok := akoProject.Annotations[customresource.AnnotationLastAppliedConfiguration]
 	if ok {
 		if err := json.Unmarshal([]byte(latestConfigString), latestConfig); err != nil {
-			return false, err
+			return false, nil, err
 		}
 	}
 
 	list, _, err := workflowCtx.Client.Integrations.List(workflowCtx.Context, akoProject.ID())
 	if err != nil {
-		return false, err
+		return false, nil, workflow.NewAtlasOperationError("listThirdPartyIntegrations", err)
 	}
 
 	if list.TotalCount == 0 {
-		return true, nil
+		return true, nil, nil
 	}
 
 	atlasIntegrations := toAliasThirdPartyIntegration(list.Results)
 	diff := set.Difference(atlasIntegrations, latestConfig.Integrations)
 
 	if len(diff) == 0 {
-		return true, nil
+		return true, nil, nil
 	}
 
 	diff = set.Difference(akoProject.Spec.Integrations, atlasIntegrations)
+	if len(diff) == 0 {
+		return true, nil, nil
+	}
 
-	return len(diff) == 0, nil
+	return false, identifiableDifferences(atlasIntegrations, akoProject.Spec.Integrations), nil
 }
@@ -55,7 +55,7 @@ func TestCanIntegrationsReconcile(t *testing.T) {
 			Client:  &mongodbatlas.Client{},
 			Context: context.Background(),
 		}
-		result, err := canIntegrationsReconcile(workflowCtx, false, &mdbv1.AtlasProject{})
+		result, _, err := canIntegrationsReconcile(workflowCtx, false, &mdbv1.AtlasProject{})
 		require.NoError(t, err)
 		require.True(t, result)
 	})
@@ -67,7 +67,7 @@ func TestCanIntegrationsReconcile(t *testing.T) {
 			Client:  &mongodbatlas.Client{},
 			Context: context.Background(),
 		}
-		result, err := canIntegrationsReconcile(workflowCtx, true, akoProject)
+		result, _, err := canIntegrationsReconcile(workflowCtx, true, akoProject)
 		require.EqualError(t, err, "invalid character 'w' looking for beginning of object key string")
 		require.False(t, result)
 	})
@@ -86,7 +86,7 @@ func TestCanIntegrationsReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canIntegrationsReconcile(workflowCtx, true, akoProject)
+		result, _, err := canIntegrationsReconcile(workflowCtx, true, akoProject)
 
 		require.EqualError(t, err, "failed to retrieve data")
 		require.False(t, result)
@@ -106,7 +106,7 @@ func TestCanIntegrationsReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canIntegrationsReconcile(workflowCtx, true, akoProject)
+		result, _, err := canIntegrationsReconcile(workflowCtx, true, akoProject)
 
 		require.NoError(t, err)
 		require.True(t, result)
@@ -152,7 +152,7 @@ func TestCanIntegrationsReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canIntegrationsReconcile(workflowCtx, true, akoProject)
+		result, _, err := canIntegrationsReconcile(workflowCtx, true, akoProject)
 
 		require.NoError(t, err)
 		require.True(t, result)
@@ -198,7 +198,7 @@ func TestCanIntegrationsReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canIntegrationsReconcile(workflowCtx, true, akoProject)
+		result, _, err := canIntegrationsReconcile(workflowCtx, true, akoProject)
 
 		require.NoError(t, err)
 		require.True(t, result)
@@ -244,7 +244,7 @@ func TestCanIntegrationsReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canIntegrationsReconcile(workflowCtx, true, akoProject)
+		result, _, err := canIntegrationsReconcile(workflowCtx, true, akoProject)
 
 		require.NoError(t, err)
 		require.False(t, result)
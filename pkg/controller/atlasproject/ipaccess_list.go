@@ -15,6 +15,7 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/timeutil"
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
@@ -23,18 +24,20 @@ import (
 
 const ipAccessStatusPending = "PENDING"
 const ipAccessStatusFailed = "FAILED"
+const ipAccessListReconciler = "ipAccessList"
 
 // ensureIPAccessList ensures that the state of the Atlas IP Access List matches the
-// state of the IP Access list specified in the project CR. Any Access Lists which exist
-// in Atlas but are not specified in the CR are deleted.
-func ensureIPAccessList(service *workflow.Context, statusFunc atlas.IPAccessListStatus, akoProject *mdbv1.AtlasProject, subobjectProtect bool) workflow.Result {
-	canReconcile, err := canIPAccessListReconcile(service.Context, service.SdkClient, subobjectProtect, akoProject)
+// state of the IP Access list specified in the project CR, plus the entries of its ConfigMap. Any Access Lists which
+// exist in Atlas but are not specified are deleted.
+func ensureIPAccessList(service *workflow.Context, statusFunc atlas.IPAccessListStatus, akoProject *mdbv1.AtlasProject, configMapEntries []project.IPAccessList, subobjectProtect bool) workflow.Result {
+	canReconcile, differences, err := canIPAccessListReconcile(service.Context, service.SdkClient, subobjectProtect, akoProject)
 	if err != nil {
 		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err))
 		service.SetConditionFromResult(status.IPAccessListReadyType, result)
 
 		return result
 	}
+	reportDeletionProtection(service, "projectIpAccessList", subobjectProtect, canReconcile, differences)
 
 	if !canReconcile {
 		result := workflow.Terminate(
@@ -46,22 +49,31 @@ func ensureIPAccessList(service *workflow.Context, statusFunc atlas.IPAccessList
 		return result
 	}
 
-	desiredList, expiredList := filterActiveIPAccessLists(akoProject.Spec.ProjectIPAccessList)
+	ipAccessLists := mergeIPAccessLists(akoProject.Spec.ProjectIPAccessList, configMapEntries)
+	desiredList, expiredList := filterActiveIPAccessLists(ipAccessLists)
 	service.EnsureStatusOption(status.AtlasProjectExpiredIPAccessOption(expiredList))
 
+	if result := checkAWSSecurityGroupPeering(service, akoProject, desiredList); !result.IsOk() {
+		service.SetConditionFromResult(status.IPAccessListReadyType, result)
+
+		return result
+	}
+
 	list, _, err := service.SdkClient.ProjectIPAccessListApi.ListProjectIpAccessLists(service.Context, akoProject.ID()).Execute()
 	if err != nil {
-		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("failed to retrieve IP Access list: %s", err))
+		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("failed to retrieve IP Access list: %s", err)).
+			WithCause(ipAccessListReconciler, "listProjectIpAccessLists")
 		service.SetConditionFromResult(status.IPAccessListReadyType, result)
 
 		return result
 	}
 
 	currentList := mapToOperatorSpec(list.GetResults())
-	if cmp.Diff(currentList, akoProject.Spec.ProjectIPAccessList, cmpopts.EquateEmpty()) != "" {
+	if cmp.Diff(currentList, ipAccessLists, cmpopts.EquateEmpty()) != "" {
 		err = syncIPAccessList(service, akoProject.ID(), currentList, desiredList)
 		if err != nil {
-			result := workflow.Terminate(workflow.ProjectIPNotCreatedInAtlas, fmt.Sprintf("failed to sync desired state with Atlas: %s", err))
+			result := workflow.Terminate(workflow.ProjectIPNotCreatedInAtlas, fmt.Sprintf("failed to sync desired state with Atlas: %s", err)).
+				WithCause(ipAccessListReconciler, workflow.AtlasOperation(err))
 			service.SetConditionFromResult(status.IPAccessListReadyType, result)
 
 			return result
@@ -71,14 +83,16 @@ func ensureIPAccessList(service *workflow.Context, statusFunc atlas.IPAccessList
 	for _, ipAccessList := range desiredList {
 		ipAccessStatus, err := statusFunc(service.Context, akoProject.ID(), mapToEntryValue(ipAccessList))
 		if err != nil {
-			result := workflow.Terminate(workflow.ProjectIPNotCreatedInAtlas, fmt.Sprintf("failed to check status in Atlas: %s", err))
+			result := workflow.Terminate(workflow.ProjectIPNotCreatedInAtlas, fmt.Sprintf("failed to check status in Atlas: %s", err)).
+				WithCause(ipAccessListReconciler, "getProjectIpAccessListStatus")
 			service.SetConditionFromResult(status.IPAccessListReadyType, result)
 
 			return result
 		}
 
 		if ipAccessStatus == ipAccessStatusFailed {
-			result := workflow.Terminate(workflow.ProjectIPNotCreatedInAtlas, fmt.Sprintf("configuration of %s failed in Atlas", mapToEntryValue(ipAccessList)))
+			result := workflow.Terminate(workflow.ProjectIPNotCreatedInAtlas, fmt.Sprintf("configuration of %s failed in Atlas", mapToEntryValue(ipAccessList))).
+				WithCause(ipAccessListReconciler, "getProjectIpAccessListStatus")
 			service.SetConditionFromResult(status.IPAccessListReadyType, result)
 
 			return result
@@ -94,13 +108,58 @@ func ensureIPAccessList(service *workflow.Context, statusFunc atlas.IPAccessList
 
 	service.SetConditionTrue(status.IPAccessListReadyType)
 
-	if len(akoProject.Spec.ProjectIPAccessList) == 0 {
+	if len(ipAccessLists) == 0 {
 		service.UnsetCondition(status.IPAccessListReadyType)
 	}
 
 	return workflow.OK()
 }
 
+// checkAWSSecurityGroupPeering verifies that an AWS network peering connection exists when the access list
+// references AWS security groups, as Atlas can only resolve security groups from peered VPCs
+func checkAWSSecurityGroupPeering(service *workflow.Context, akoProject *mdbv1.AtlasProject, ipAccessLists []project.IPAccessList) workflow.Result {
+	if !hasAWSSecurityGroup(ipAccessLists) {
+		return workflow.OK()
+	}
+
+	peers, _, err := service.SdkClient.NetworkPeeringApi.ListPeeringConnectionsWithParams(service.Context, &admin.ListPeeringConnectionsApiParams{
+		GroupId:      akoProject.ID(),
+		ProviderName: admin.PtrString(string(provider.ProviderAWS)),
+	}).Execute()
+	if err != nil {
+		return workflow.Terminate(workflow.Internal, fmt.Sprintf("failed to retrieve AWS network peering connections: %s", err)).
+			WithCause(ipAccessListReconciler, "listPeeringConnections")
+	}
+
+	if len(peers.GetResults()) > 0 {
+		return workflow.OK()
+	}
+
+	for _, peer := range akoProject.Spec.NetworkPeers {
+		if peer.ProviderName == provider.ProviderAWS {
+			return workflow.InProgress(
+				workflow.ProjectIPAccessListAWSPeeringMissing,
+				"waiting for the AWS network peering connection to be created before configuring awsSecurityGroup entries",
+			)
+		}
+	}
+
+	return workflow.Terminate(
+		workflow.ProjectIPAccessListAWSPeeringMissing,
+		"awsSecurityGroup entries require an AWS network peering connection in the project",
+	)
+}
+
+func hasAWSSecurityGroup(ipAccessLists []project.IPAccessList) bool {
+	for _, ipAccessList := range ipAccessLists {
+		if ipAccessList.AwsSecurityGroup != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
 func mapToOperatorSpec(projectIPAccessList []admin.NetworkPermissionEntry) []project.IPAccessList {
 	ipAccessList := make([]project.IPAccessList, 0, len(projectIPAccessList))
 
@@ -143,7 +202,7 @@ func syncIPAccessList(service *workflow.Context, projectID string, current, desi
 
 		_, _, err := service.SdkClient.ProjectIPAccessListApi.DeleteProjectIpAccessList(service.Context, projectID, mapToEntryValue(ipAccessList)).Execute()
 		if err != nil {
-			return err
+			return workflow.NewAtlasOperationError("deleteProjectIpAccessList", err)
 		}
 	}
 
@@ -174,7 +233,7 @@ func syncIPAccessList(service *workflow.Context, projectID string, current, desi
 
 	_, _, err := service.SdkClient.ProjectIPAccessListApi.CreateProjectIpAccessList(service.Context, projectID, &toCreate).Execute()
 
-	return err
+	return workflow.NewAtlasOperationError("createProjectIpAccessList", err)
 }
 
 func mapToEntryValue(ipAccessList project.IPAccessList) string {
@@ -226,32 +285,39 @@ func filterActiveIPAccessLists(accessLists []project.IPAccessList) ([]project.IP
 	return active, expired
 }
 
-func canIPAccessListReconcile(ctx context.Context, atlasClient *admin.APIClient, protected bool, akoProject *mdbv1.AtlasProject) (bool, error) {
+func canIPAccessListReconcile(ctx context.Context, atlasClient *admin.APIClient, protected bool, akoProject *mdbv1.AtlasProject) (bool, []string, error) {
 	if !protected {
-		return true, nil
+		return true, nil, nil
 	}
 
 	latestConfig := &mdbv1.AtlasProjectSpec{}
 	latestConfigString, ok := akoProject.Annotations[customresource.AnnotationLastAppliedConfiguration]
 	if ok {
 		if err := json.Unmarshal([]byte(latestConfigString), latestConfig); err != nil {
-			return false, err
+			return false, nil, err
 		}
 	}
 
 	list, _, err := atlasClient.ProjectIPAccessListApi.ListProjectIpAccessLists(ctx, akoProject.ID()).Execute()
 	if err != nil {
-		return false, err
+		return false, nil, err
 	}
 
 	if list.GetTotalCount() == 0 {
-		return true, nil
+		return true, nil, nil
 	}
 
-	atlasAccessLists := mapToOperatorSpec(list.GetResults())
+	// the entries of the ConfigMap and the egress IPs are managed by the operator and change outside the project spec
+	atlasAccessLists := withoutGeneratedEntries(mapToOperatorSpec(list.GetResults()))
 	if cmp.Equal(atlasAccessLists, latestConfig.ProjectIPAccessList, cmpopts.EquateEmpty()) {
-		return true, nil
+		return true, nil, nil
+	}
+
+	if cmp.Equal(akoProject.Spec.ProjectIPAccessList, atlasAccessLists, cmpopts.EquateEmpty()) {
+		return true, nil, nil
 	}
 
-	return cmp.Equal(akoProject.Spec.ProjectIPAccessList, atlasAccessLists, cmpopts.EquateEmpty()), nil
+	return false, listDifferences(atlasAccessLists, akoProject.Spec.ProjectIPAccessList, func(entry project.IPAccessList) string {
+		return fmt.Sprint(entry.Identifier())
+	}), nil
 }
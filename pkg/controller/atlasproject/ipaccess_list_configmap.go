@@ -0,0 +1,162 @@
+package atlasproject
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"unicode"
+
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/watch"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	// configMapEntryCommentPrefix starts the comment of the IP Access List entries read from a ConfigMap. It tells them
+	// apart from the entries of the spec in Atlas
+	configMapEntryCommentPrefix  = "configmap:"
+	maxIPAccessListCommentLength = 80
+)
+
+// reconcileIPAccessList reads the entries of the IP Access List ConfigMap and the egress IPs of the cluster, if any,
+// and ensures the IP Access List
+func (r *AtlasProjectReconciler) reconcileIPAccessList(workflowCtx *workflow.Context, akoProject *mdbv1.AtlasProject) workflow.Result {
+	configMapEntries, err := r.ipAccessListFromConfigMap(workflowCtx, akoProject)
+	if err != nil {
+		result := workflow.Terminate(workflow.ProjectIPAccessInvalid, err.Error())
+		workflowCtx.SetConditionFromResult(status.IPAccessListReadyType, result)
+
+		return result
+	}
+
+	egressEntries, err := r.ipAccessListFromEgressIPs(workflowCtx, akoProject, mergeIPAccessLists(akoProject.Spec.ProjectIPAccessList, configMapEntries))
+	if err != nil {
+		result := workflow.Terminate(workflow.ProjectIPAccessListEgressDiscoveryFailed, err.Error())
+		workflowCtx.SetConditionFromResult(status.IPAccessListReadyType, result)
+
+		return result
+	}
+
+	return ensureIPAccessList(workflowCtx, atlas.CustomIPAccessListStatus(workflowCtx.SdkClient), akoProject, mergeIPAccessLists(configMapEntries, egressEntries), r.SubObjectDeletionProtection)
+}
+
+// ipAccessListFromConfigMap returns the IP Access List entries of the ConfigMap referenced by the project. The
+// ConfigMap is watched so that its changes are synced to Atlas
+func (r *AtlasProjectReconciler) ipAccessListFromConfigMap(workflowCtx *workflow.Context, akoProject *mdbv1.AtlasProject) ([]project.IPAccessList, error) {
+	key := akoProject.Spec.ProjectIPAccessListConfigMapRef.GetObject(akoProject.Namespace)
+	if key == nil {
+		return nil, nil
+	}
+
+	workflowCtx.AddResourcesToWatch(watch.WatchedObject{ResourceKind: "ConfigMap", Resource: *key})
+
+	configMap := &corev1.ConfigMap{}
+	if err := r.Client.Get(workflowCtx.Context, *key, configMap); err != nil {
+		if apiErrors.IsNotFound(err) {
+			return nil, fmt.Errorf("the IP Access List ConfigMap %s doesn't exist", key)
+		}
+
+		return nil, fmt.Errorf("failed to read the IP Access List ConfigMap %s: %w", key, err)
+	}
+
+	return parseIPAccessListConfigMap(configMap, akoProject.Spec.ProjectIPAccessList)
+}
+
+// parseIPAccessListConfigMap reads the IP addresses and CIDR blocks of every key of the ConfigMap. The entries already
+// in the spec are skipped, so that their settings take precedence
+func parseIPAccessListConfigMap(configMap *corev1.ConfigMap, specEntries []project.IPAccessList) ([]project.IPAccessList, error) {
+	known := map[string]struct{}{}
+	for _, entry := range specEntries {
+		known[genIPAccessListKey(entry)] = struct{}{}
+	}
+
+	keys := make([]string, 0, len(configMap.Data))
+	for key := range configMap.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var entries []project.IPAccessList
+	for _, key := range keys {
+		for _, value := range splitIPAccessListValues(configMap.Data[key]) {
+			entry, err := parseIPAccessListEntry(value)
+			if err != nil {
+				return nil, fmt.Errorf("%w in the key %s of the IP Access List ConfigMap %s", err, key, configMap.Name)
+			}
+			entry = entry.WithComment(configMapEntryComment(configMap.Name, key))
+
+			if _, ok := known[genIPAccessListKey(entry)]; ok {
+				continue
+			}
+			known[genIPAccessListKey(entry)] = struct{}{}
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
+
+// splitIPAccessListValues splits a list of IP addresses and CIDR blocks separated by commas, spaces or new lines
+func splitIPAccessListValues(values string) []string {
+	return strings.FieldsFunc(values, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+}
+
+// parseIPAccessListEntry returns the access list entry of a CIDR block, or of an IP address
+func parseIPAccessListEntry(value string) (project.IPAccessList, error) {
+	if strings.Contains(value, "/") {
+		if _, _, err := net.ParseCIDR(value); err != nil {
+			return project.IPAccessList{}, fmt.Errorf("invalid CIDR block %q", value)
+		}
+
+		return project.NewIPAccessList().WithCIDR(value), nil
+	}
+
+	if net.ParseIP(value) == nil {
+		return project.IPAccessList{}, fmt.Errorf("invalid IP address %q", value)
+	}
+
+	return project.NewIPAccessList().WithIP(value), nil
+}
+
+func configMapEntryComment(name, key string) string {
+	comment := fmt.Sprintf("%s%s/%s", configMapEntryCommentPrefix, name, key)
+	if len(comment) > maxIPAccessListCommentLength {
+		comment = comment[:maxIPAccessListCommentLength]
+	}
+
+	return comment
+}
+
+// mergeIPAccessLists returns the entries of the spec followed by the ones of the ConfigMap
+func mergeIPAccessLists(specEntries, configMapEntries []project.IPAccessList) []project.IPAccessList {
+	if len(configMapEntries) == 0 {
+		return specEntries
+	}
+
+	merged := make([]project.IPAccessList, 0, len(specEntries)+len(configMapEntries))
+	merged = append(merged, specEntries...)
+
+	return append(merged, configMapEntries...)
+}
+
+// withoutGeneratedEntries removes the entries the operator adds on top of the spec, i.e. the ones read from a ConfigMap
+// or discovered from the egress IPs of the cluster, out of the IP Access List found in Atlas
+func withoutGeneratedEntries(entries []project.IPAccessList) []project.IPAccessList {
+	result := make([]project.IPAccessList, 0, len(entries))
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Comment, configMapEntryCommentPrefix) && !strings.HasPrefix(entry.Comment, egressIPEntryCommentPrefix) {
+			result = append(result, entry)
+		}
+	}
+
+	return result
+}
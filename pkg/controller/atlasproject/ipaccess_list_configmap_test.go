@@ -0,0 +1,128 @@
+package atlasproject
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/watch"
+)
+
+func TestParseIPAccessListConfigMap(t *testing.T) {
+	newConfigMap := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "egress", Namespace: "ns"}, Data: data}
+	}
+
+	t.Run("should read the IP addresses and CIDR blocks of every key", func(t *testing.T) {
+		entries, err := parseIPAccessListConfigMap(newConfigMap(map[string]string{
+			"zone-b": "10.0.1.0/24",
+			"zone-a": "192.168.0.1, 192.168.0.2\n10.0.0.0/24",
+		}), nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, []project.IPAccessList{
+			project.NewIPAccessList().WithIP("192.168.0.1").WithComment("configmap:egress/zone-a"),
+			project.NewIPAccessList().WithIP("192.168.0.2").WithComment("configmap:egress/zone-a"),
+			project.NewIPAccessList().WithCIDR("10.0.0.0/24").WithComment("configmap:egress/zone-a"),
+			project.NewIPAccessList().WithCIDR("10.0.1.0/24").WithComment("configmap:egress/zone-b"),
+		}, entries)
+	})
+
+	t.Run("should skip the entries already in the spec or repeated", func(t *testing.T) {
+		entries, err := parseIPAccessListConfigMap(newConfigMap(map[string]string{
+			"nat": "192.168.0.1 192.168.0.2 192.168.0.2",
+		}), []project.IPAccessList{project.NewIPAccessList().WithIP("192.168.0.1")})
+
+		require.NoError(t, err)
+		assert.Equal(t, []project.IPAccessList{
+			project.NewIPAccessList().WithIP("192.168.0.2").WithComment("configmap:egress/nat"),
+		}, entries)
+	})
+
+	t.Run("should reject an invalid IP address", func(t *testing.T) {
+		_, err := parseIPAccessListConfigMap(newConfigMap(map[string]string{"nat": "192.168.0.300"}), nil)
+
+		require.EqualError(t, err, `invalid IP address "192.168.0.300" in the key nat of the IP Access List ConfigMap egress`)
+	})
+
+	t.Run("should reject an invalid CIDR block", func(t *testing.T) {
+		_, err := parseIPAccessListConfigMap(newConfigMap(map[string]string{"nat": "10.0.0.0/33"}), nil)
+
+		require.EqualError(t, err, `invalid CIDR block "10.0.0.0/33" in the key nat of the IP Access List ConfigMap egress`)
+	})
+
+	t.Run("should truncate long comments", func(t *testing.T) {
+		comment := configMapEntryComment("egress", strings.Repeat("a", 100))
+
+		assert.Len(t, comment, maxIPAccessListCommentLength)
+		assert.True(t, strings.HasPrefix(comment, configMapEntryCommentPrefix))
+	})
+}
+
+func TestIPAccessListFromConfigMap(t *testing.T) {
+	newReconciler := func(t *testing.T, objects ...client.Object) *AtlasProjectReconciler {
+		sch := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(sch))
+
+		return &AtlasProjectReconciler{
+			Client: fake.NewClientBuilder().WithScheme(sch).WithObjects(objects...).Build(),
+			Log:    zaptest.NewLogger(t).Sugar(),
+		}
+	}
+	newProject := func() *mdbv1.AtlasProject {
+		akoProject := mdbv1.NewProject("ns", "project", "project")
+		akoProject.Spec.ProjectIPAccessListConfigMapRef = &common.ResourceRefNamespaced{Name: "egress"}
+
+		return akoProject
+	}
+
+	t.Run("should return no entries without a ConfigMap reference", func(t *testing.T) {
+		r := newReconciler(t)
+		workflowCtx := newContext(t)
+
+		entries, err := r.ipAccessListFromConfigMap(workflowCtx, mdbv1.NewProject("ns", "project", "project"))
+
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+		assert.Empty(t, workflowCtx.ListResourcesToWatch())
+	})
+
+	t.Run("should read and watch the ConfigMap", func(t *testing.T) {
+		r := newReconciler(t, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "egress", Namespace: "ns"},
+			Data:       map[string]string{"nat": "192.168.0.1"},
+		})
+		workflowCtx := newContext(t)
+
+		entries, err := r.ipAccessListFromConfigMap(workflowCtx, newProject())
+
+		require.NoError(t, err)
+		assert.Equal(t, []project.IPAccessList{
+			project.NewIPAccessList().WithIP("192.168.0.1").WithComment("configmap:egress/nat"),
+		}, entries)
+		assert.Equal(t, []watch.WatchedObject{
+			{ResourceKind: "ConfigMap", Resource: client.ObjectKey{Namespace: "ns", Name: "egress"}},
+		}, workflowCtx.ListResourcesToWatch())
+	})
+
+	t.Run("should watch a missing ConfigMap and fail", func(t *testing.T) {
+		r := newReconciler(t)
+		workflowCtx := newContext(t)
+
+		_, err := r.ipAccessListFromConfigMap(workflowCtx, newProject())
+
+		require.EqualError(t, err, "the IP Access List ConfigMap ns/egress doesn't exist")
+		assert.Len(t, workflowCtx.ListResourcesToWatch(), 1)
+	})
+}
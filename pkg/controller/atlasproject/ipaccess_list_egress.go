@@ -0,0 +1,142 @@
+package atlasproject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	// egressIPEntryCommentPrefix starts the comment of the IP Access List entries discovered from the egress IPs of
+	// the cluster. It tells them apart from the entries of the spec in Atlas
+	egressIPEntryCommentPrefix = "egress:"
+	egressIPEntryComment       = egressIPEntryCommentPrefix + "auto-discovered"
+
+	// egressIPRefreshInterval is how often the projects discovering the egress IPs are reconciled, so that the
+	// entries follow the changes of the nodes
+	egressIPRefreshInterval = 5 * time.Minute
+
+	maxEgressIPResponseSize = 64 * 1024
+)
+
+// EgressIPProvider discovers the IPs the traffic of the Kubernetes cluster reaches Atlas from
+type EgressIPProvider interface {
+	EgressIPs(ctx context.Context) ([]string, error)
+}
+
+// NodeEgressIPProvider returns the external IPs of the nodes of the cluster
+type NodeEgressIPProvider struct {
+	Reader client.Reader
+}
+
+func (p *NodeEgressIPProvider) EgressIPs(ctx context.Context) ([]string, error) {
+	nodes := &corev1.NodeList{}
+	if err := p.Reader.List(ctx, nodes); err != nil {
+		return nil, fmt.Errorf("failed to list the nodes: %w", err)
+	}
+
+	unique := map[string]struct{}{}
+	for _, node := range nodes.Items {
+		for _, address := range node.Status.Addresses {
+			if address.Type == corev1.NodeExternalIP && address.Address != "" {
+				unique[address.Address] = struct{}{}
+			}
+		}
+	}
+
+	ips := make([]string, 0, len(unique))
+	for ip := range unique {
+		ips = append(ips, ip)
+	}
+	sort.Strings(ips)
+
+	return ips, nil
+}
+
+// URLEgressIPProvider reads the egress IPs from an HTTP endpoint, e.g. one published by the NAT gateway of the cluster.
+// The response holds IP addresses or CIDR blocks separated by commas, spaces or new lines
+type URLEgressIPProvider struct {
+	URL        string
+	HTTPClient *http.Client
+}
+
+func (p *URLEgressIPProvider) EgressIPs(ctx context.Context) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the egress IPs from %s: %w", p.URL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to read the egress IPs from %s: unexpected status %s", p.URL, resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxEgressIPResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the egress IPs from %s: %w", p.URL, err)
+	}
+
+	return splitIPAccessListValues(string(body)), nil
+}
+
+// ipAccessListFromEgressIPs returns the IP Access List entries of the egress IPs of the cluster when the project
+// enables their discovery. The entries already specified are skipped
+func (r *AtlasProjectReconciler) ipAccessListFromEgressIPs(workflowCtx *workflow.Context, akoProject *mdbv1.AtlasProject, specified []project.IPAccessList) ([]project.IPAccessList, error) {
+	if !akoProject.Spec.EgressIPDiscovery.IsEnabled() {
+		return nil, nil
+	}
+
+	if r.EgressIPProvider == nil {
+		return nil, errors.New("the discovery of the egress IPs is not configured in the operator")
+	}
+
+	ips, err := r.EgressIPProvider.EgressIPs(workflowCtx.Context)
+	if err != nil {
+		return nil, err
+	}
+	// an empty list is more likely a failed discovery than a cluster without egress, keep the current entries instead
+	// of removing them all
+	if len(ips) == 0 {
+		return nil, errors.New("no egress IP was discovered")
+	}
+
+	known := map[string]struct{}{}
+	for _, entry := range specified {
+		known[genIPAccessListKey(entry)] = struct{}{}
+	}
+
+	var entries []project.IPAccessList
+	for _, ip := range ips {
+		entry, err := parseIPAccessListEntry(ip)
+		if err != nil {
+			return nil, fmt.Errorf("%w in the discovered egress IPs", err)
+		}
+		entry = entry.WithComment(egressIPEntryComment)
+
+		if _, ok := known[genIPAccessListKey(entry)]; ok {
+			continue
+		}
+		known[genIPAccessListKey(entry)] = struct{}{}
+		entries = append(entries, entry)
+	}
+
+	workflowCtx.Log.Debugw("Discovered the egress IPs of the cluster", "ips", ips)
+
+	return entries, nil
+}
@@ -0,0 +1,147 @@
+package atlasproject
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/project"
+)
+
+type staticEgressIPProvider struct {
+	ips []string
+	err error
+}
+
+func (p *staticEgressIPProvider) EgressIPs(_ context.Context) ([]string, error) {
+	return p.ips, p.err
+}
+
+func TestNodeEgressIPProvider(t *testing.T) {
+	t.Run("should return the unique external IPs of the nodes", func(t *testing.T) {
+		sch := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(sch))
+		newNode := func(name string, addresses ...corev1.NodeAddress) *corev1.Node {
+			return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}, Status: corev1.NodeStatus{Addresses: addresses}}
+		}
+		reader := fake.NewClientBuilder().WithScheme(sch).WithObjects(
+			newNode("node-1",
+				corev1.NodeAddress{Type: corev1.NodeInternalIP, Address: "10.0.0.1"},
+				corev1.NodeAddress{Type: corev1.NodeExternalIP, Address: "34.1.1.2"},
+			),
+			newNode("node-2",
+				corev1.NodeAddress{Type: corev1.NodeExternalIP, Address: "34.1.1.1"},
+				corev1.NodeAddress{Type: corev1.NodeExternalIP, Address: "34.1.1.2"},
+			),
+			newNode("node-3", corev1.NodeAddress{Type: corev1.NodeInternalIP, Address: "10.0.0.3"}),
+		).Build()
+
+		ips, err := (&NodeEgressIPProvider{Reader: reader}).EgressIPs(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"34.1.1.1", "34.1.1.2"}, ips)
+	})
+}
+
+func TestURLEgressIPProvider(t *testing.T) {
+	t.Run("should read the egress IPs from the endpoint", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("34.1.1.1, 34.1.1.2\n35.0.0.0/28\n"))
+		}))
+		defer server.Close()
+
+		ips, err := (&URLEgressIPProvider{URL: server.URL, HTTPClient: server.Client()}).EgressIPs(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"34.1.1.1", "34.1.1.2", "35.0.0.0/28"}, ips)
+	})
+
+	t.Run("should fail when the endpoint returns an error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		_, err := (&URLEgressIPProvider{URL: server.URL, HTTPClient: server.Client()}).EgressIPs(context.Background())
+
+		require.ErrorContains(t, err, "unexpected status 503 Service Unavailable")
+	})
+}
+
+func TestIPAccessListFromEgressIPs(t *testing.T) {
+	newProject := func(enabled bool) *mdbv1.AtlasProject {
+		akoProject := mdbv1.NewProject("ns", "project", "project")
+		akoProject.Spec.EgressIPDiscovery = &project.EgressIPDiscovery{Enabled: enabled}
+
+		return akoProject
+	}
+
+	t.Run("should not discover the egress IPs unless enabled", func(t *testing.T) {
+		r := &AtlasProjectReconciler{EgressIPProvider: &staticEgressIPProvider{err: errors.New("should not be called")}}
+
+		entries, err := r.ipAccessListFromEgressIPs(newContext(t), newProject(false), nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("should add the egress IPs not already specified", func(t *testing.T) {
+		r := &AtlasProjectReconciler{EgressIPProvider: &staticEgressIPProvider{ips: []string{"34.1.1.1", "34.1.1.2", "35.0.0.0/28"}}}
+
+		entries, err := r.ipAccessListFromEgressIPs(newContext(t), newProject(true), []project.IPAccessList{
+			project.NewIPAccessList().WithIP("34.1.1.1"),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []project.IPAccessList{
+			project.NewIPAccessList().WithIP("34.1.1.2").WithComment("egress:auto-discovered"),
+			project.NewIPAccessList().WithCIDR("35.0.0.0/28").WithComment("egress:auto-discovered"),
+		}, entries)
+	})
+
+	t.Run("should fail without a provider", func(t *testing.T) {
+		r := &AtlasProjectReconciler{}
+
+		_, err := r.ipAccessListFromEgressIPs(newContext(t), newProject(true), nil)
+
+		require.EqualError(t, err, "the discovery of the egress IPs is not configured in the operator")
+	})
+
+	t.Run("should fail when no egress IP is discovered", func(t *testing.T) {
+		r := &AtlasProjectReconciler{EgressIPProvider: &staticEgressIPProvider{}}
+
+		_, err := r.ipAccessListFromEgressIPs(newContext(t), newProject(true), nil)
+
+		require.EqualError(t, err, "no egress IP was discovered")
+	})
+
+	t.Run("should fail on an invalid egress IP", func(t *testing.T) {
+		r := &AtlasProjectReconciler{EgressIPProvider: &staticEgressIPProvider{ips: []string{"not-an-ip"}}}
+
+		_, err := r.ipAccessListFromEgressIPs(newContext(t), newProject(true), nil)
+
+		require.EqualError(t, err, `invalid IP address "not-an-ip" in the discovered egress IPs`)
+	})
+}
+
+func TestWithoutGeneratedEntries(t *testing.T) {
+	t.Run("should remove the entries of the ConfigMaps and the egress IPs", func(t *testing.T) {
+		specified := project.NewIPAccessList().WithIP("192.168.0.1").WithComment("office")
+
+		assert.Equal(t, []project.IPAccessList{specified}, withoutGeneratedEntries([]project.IPAccessList{
+			specified,
+			project.NewIPAccessList().WithIP("34.1.1.1").WithComment("egress:auto-discovered"),
+			project.NewIPAccessList().WithIP("10.0.0.1").WithComment("configmap:egress/nat"),
+		}))
+	})
+}
@@ -14,6 +14,7 @@ import (
 	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
@@ -42,7 +43,7 @@ func TestFilterActiveIPAccessLists(t *testing.T) {
 
 func TestCanIPAccessListReconcile(t *testing.T) {
 	t.Run("should return true when subResourceDeletionProtection is disabled", func(t *testing.T) {
-		result, err := canIPAccessListReconcile(context.Background(), &admin.APIClient{}, false, &mdbv1.AtlasProject{})
+		result, _, err := canIPAccessListReconcile(context.Background(), &admin.APIClient{}, false, &mdbv1.AtlasProject{})
 		require.NoError(t, err)
 		require.True(t, result)
 	})
@@ -50,7 +51,7 @@ func TestCanIPAccessListReconcile(t *testing.T) {
 	t.Run("should return error when unable to deserialize last applied configuration", func(t *testing.T) {
 		akoProject := &mdbv1.AtlasProject{}
 		akoProject.WithAnnotations(map[string]string{customresource.AnnotationLastAppliedConfiguration: "{wrong}"})
-		result, err := canIPAccessListReconcile(context.Background(), nil, true, akoProject)
+		result, _, err := canIPAccessListReconcile(context.Background(), nil, true, akoProject)
 		require.EqualError(t, err, "invalid character 'w' looking for beginning of object key string")
 		require.False(t, result)
 	})
@@ -63,7 +64,7 @@ func TestCanIPAccessListReconcile(t *testing.T) {
 		)
 		akoProject := &mdbv1.AtlasProject{}
 		akoProject.WithAnnotations(map[string]string{customresource.AnnotationLastAppliedConfiguration: "{}"})
-		result, err := canIPAccessListReconcile(context.Background(), &admin.APIClient{ProjectIPAccessListApi: m}, true, akoProject)
+		result, _, err := canIPAccessListReconcile(context.Background(), &admin.APIClient{ProjectIPAccessListApi: m}, true, akoProject)
 
 		require.EqualError(t, err, "failed to retrieve data")
 		require.False(t, result)
@@ -77,7 +78,7 @@ func TestCanIPAccessListReconcile(t *testing.T) {
 		)
 		akoProject := &mdbv1.AtlasProject{}
 		akoProject.WithAnnotations(map[string]string{customresource.AnnotationLastAppliedConfiguration: "{}"})
-		result, err := canIPAccessListReconcile(context.Background(), &admin.APIClient{ProjectIPAccessListApi: m}, true, akoProject)
+		result, _, err := canIPAccessListReconcile(context.Background(), &admin.APIClient{ProjectIPAccessListApi: m}, true, akoProject)
 
 		require.NoError(t, err)
 		require.True(t, result)
@@ -110,7 +111,7 @@ func TestCanIPAccessListReconcile(t *testing.T) {
 			},
 		}
 		akoProject.WithAnnotations(map[string]string{customresource.AnnotationLastAppliedConfiguration: "{\"projectIpAccessList\":[{\"cidrBlock\":\"192.168.0.0/24\"}]}"})
-		result, err := canIPAccessListReconcile(context.Background(), &admin.APIClient{ProjectIPAccessListApi: m}, true, akoProject)
+		result, _, err := canIPAccessListReconcile(context.Background(), &admin.APIClient{ProjectIPAccessListApi: m}, true, akoProject)
 
 		require.NoError(t, err)
 		require.True(t, result)
@@ -147,7 +148,7 @@ func TestCanIPAccessListReconcile(t *testing.T) {
 			},
 		}
 		akoProject.WithAnnotations(map[string]string{customresource.AnnotationLastAppliedConfiguration: "{\"projectIpAccessList\":[{\"cidrBlock\":\"192.168.0.0/24\"}]}"})
-		result, err := canIPAccessListReconcile(context.Background(), &admin.APIClient{ProjectIPAccessListApi: m}, true, akoProject)
+		result, _, err := canIPAccessListReconcile(context.Background(), &admin.APIClient{ProjectIPAccessListApi: m}, true, akoProject)
 
 		require.NoError(t, err)
 		require.True(t, result)
@@ -184,10 +185,46 @@ func TestCanIPAccessListReconcile(t *testing.T) {
 			},
 		}
 		akoProject.WithAnnotations(map[string]string{customresource.AnnotationLastAppliedConfiguration: "{\"projectIpAccessList\":[{\"cidrBlock\":\"192.168.0.0/24\"}]}"})
-		result, err := canIPAccessListReconcile(context.Background(), &admin.APIClient{ProjectIPAccessListApi: m}, true, akoProject)
+		result, differences, err := canIPAccessListReconcile(context.Background(), &admin.APIClient{ProjectIPAccessListApi: m}, true, akoProject)
 
 		require.NoError(t, err)
 		require.False(t, result)
+		assert.Equal(t, []string{"10.0.0.0/24: only in Atlas", "10.1.0.0/24: only in the spec"}, differences)
+	})
+
+	t.Run("should ignore the entries read from a ConfigMap", func(t *testing.T) {
+		m := atlasmock.NewProjectIPAccessListApiMock(t)
+		m.EXPECT().ListProjectIpAccessLists(mock.Anything, mock.Anything).Return(admin.ListProjectIpAccessListsApiRequest{ApiService: m})
+		m.EXPECT().ListProjectIpAccessListsExecute(mock.Anything).Return(
+			&admin.PaginatedNetworkAccess{
+				Results: &[]admin.NetworkPermissionEntry{
+					{
+						GroupId:   admin.PtrString("123456"),
+						CidrBlock: admin.PtrString("192.168.0.0/24"),
+					},
+					{
+						GroupId:   admin.PtrString("123456"),
+						CidrBlock: admin.PtrString("10.0.0.0/24"),
+						Comment:   admin.PtrString("configmap:egress/nat"),
+					},
+				},
+				TotalCount: admin.PtrInt(2),
+			}, nil, nil,
+		)
+		akoProject := &mdbv1.AtlasProject{
+			Spec: mdbv1.AtlasProjectSpec{
+				ProjectIPAccessList: []project.IPAccessList{
+					{
+						CIDRBlock: "192.168.0.0/24",
+					},
+				},
+			},
+		}
+		akoProject.WithAnnotations(map[string]string{customresource.AnnotationLastAppliedConfiguration: "{\"projectIpAccessList\":[{\"cidrBlock\":\"192.168.0.0/24\"}]}"})
+		result, _, err := canIPAccessListReconcile(context.Background(), &admin.APIClient{ProjectIPAccessListApi: m}, true, akoProject)
+
+		require.NoError(t, err)
+		require.True(t, result)
 	})
 }
 
@@ -205,7 +242,7 @@ func TestEnsureIPAccessList(t *testing.T) {
 			SdkClient: atlasClient,
 			Context:   context.Background(),
 		}
-		result := ensureIPAccessList(workflowCtx, atlas.CustomIPAccessListStatus(atlasClient), akoProject, true)
+		result := ensureIPAccessList(workflowCtx, atlas.CustomIPAccessListStatus(atlasClient), akoProject, nil, true)
 
 		require.Equal(t, workflow.Terminate(workflow.Internal, "unable to resolve ownership for deletion protection: failed to retrieve data"), result)
 	})
@@ -246,7 +283,7 @@ func TestEnsureIPAccessList(t *testing.T) {
 			SdkClient: atlasClient,
 			Context:   context.Background(),
 		}
-		result := ensureIPAccessList(workflowCtx, atlas.CustomIPAccessListStatus(atlasClient), akoProject, true)
+		result := ensureIPAccessList(workflowCtx, atlas.CustomIPAccessListStatus(atlasClient), akoProject, nil, true)
 
 		require.Equal(
 			t,
@@ -311,6 +348,7 @@ func TestEnsureIPAccessList(t *testing.T) {
 				return "ACTIVE", nil
 			},
 			akoProject,
+			nil,
 			false,
 		)
 
@@ -318,6 +356,83 @@ func TestEnsureIPAccessList(t *testing.T) {
 	})
 }
 
+func TestCheckAWSSecurityGroupPeering(t *testing.T) {
+	sgAccessList := []project.IPAccessList{project.NewIPAccessList().WithAWSGroup("sg-0129d834cbf03bc6d")}
+
+	t.Run("should not check peering when there are no security groups", func(t *testing.T) {
+		workflowCtx := &workflow.Context{
+			SdkClient: &admin.APIClient{NetworkPeeringApi: atlasmock.NewNetworkPeeringApiMock(t)},
+			Context:   context.Background(),
+		}
+		result := checkAWSSecurityGroupPeering(workflowCtx, &mdbv1.AtlasProject{}, []project.IPAccessList{project.NewIPAccessList().WithIP("10.0.0.1")})
+
+		require.Equal(t, workflow.OK(), result)
+	})
+
+	t.Run("should fail when unable to list peering connections", func(t *testing.T) {
+		m := atlasmock.NewNetworkPeeringApiMock(t)
+		m.EXPECT().ListPeeringConnectionsWithParams(mock.Anything, mock.Anything).Return(admin.ListPeeringConnectionsApiRequest{ApiService: m})
+		m.EXPECT().ListPeeringConnectionsExecute(mock.Anything).Return(nil, nil, errors.New("failed to retrieve data"))
+		workflowCtx := &workflow.Context{
+			SdkClient: &admin.APIClient{NetworkPeeringApi: m},
+			Context:   context.Background(),
+		}
+		result := checkAWSSecurityGroupPeering(workflowCtx, &mdbv1.AtlasProject{}, sgAccessList)
+
+		require.Equal(t, workflow.Terminate(workflow.Internal, "failed to retrieve AWS network peering connections: failed to retrieve data").WithCause(ipAccessListReconciler, "listPeeringConnections"), result)
+	})
+
+	t.Run("should succeed when an AWS peering connection exists", func(t *testing.T) {
+		m := atlasmock.NewNetworkPeeringApiMock(t)
+		m.EXPECT().ListPeeringConnectionsWithParams(mock.Anything, mock.Anything).Return(admin.ListPeeringConnectionsApiRequest{ApiService: m})
+		m.EXPECT().ListPeeringConnectionsExecute(mock.Anything).Return(
+			&admin.PaginatedContainerPeer{Results: &[]admin.BaseNetworkPeeringConnectionSettings{{VpcId: admin.PtrString("vpc-123")}}}, nil, nil,
+		)
+		workflowCtx := &workflow.Context{
+			SdkClient: &admin.APIClient{NetworkPeeringApi: m},
+			Context:   context.Background(),
+		}
+		result := checkAWSSecurityGroupPeering(workflowCtx, &mdbv1.AtlasProject{}, sgAccessList)
+
+		require.Equal(t, workflow.OK(), result)
+	})
+
+	t.Run("should wait when the AWS peering connection is yet to be created", func(t *testing.T) {
+		m := atlasmock.NewNetworkPeeringApiMock(t)
+		m.EXPECT().ListPeeringConnectionsWithParams(mock.Anything, mock.Anything).Return(admin.ListPeeringConnectionsApiRequest{ApiService: m})
+		m.EXPECT().ListPeeringConnectionsExecute(mock.Anything).Return(&admin.PaginatedContainerPeer{}, nil, nil)
+		workflowCtx := &workflow.Context{
+			SdkClient: &admin.APIClient{NetworkPeeringApi: m},
+			Context:   context.Background(),
+		}
+		akoProject := &mdbv1.AtlasProject{
+			Spec: mdbv1.AtlasProjectSpec{
+				NetworkPeers: []mdbv1.NetworkPeer{{ProviderName: provider.ProviderAWS}},
+			},
+		}
+		result := checkAWSSecurityGroupPeering(workflowCtx, akoProject, sgAccessList)
+
+		require.True(t, result.IsInProgress())
+	})
+
+	t.Run("should fail when there is no AWS peering connection", func(t *testing.T) {
+		m := atlasmock.NewNetworkPeeringApiMock(t)
+		m.EXPECT().ListPeeringConnectionsWithParams(mock.Anything, mock.Anything).Return(admin.ListPeeringConnectionsApiRequest{ApiService: m})
+		m.EXPECT().ListPeeringConnectionsExecute(mock.Anything).Return(&admin.PaginatedContainerPeer{}, nil, nil)
+		workflowCtx := &workflow.Context{
+			SdkClient: &admin.APIClient{NetworkPeeringApi: m},
+			Context:   context.Background(),
+		}
+		result := checkAWSSecurityGroupPeering(workflowCtx, &mdbv1.AtlasProject{}, sgAccessList)
+
+		require.Equal(
+			t,
+			workflow.Terminate(workflow.ProjectIPAccessListAWSPeeringMissing, "awsSecurityGroup entries require an AWS network peering connection in the project"),
+			result,
+		)
+	})
+}
+
 func TestSyncIPAccessList(t *testing.T) {
 	t.Run("should fail to perform deletion", func(t *testing.T) {
 		current := []project.IPAccessList{
@@ -0,0 +1,32 @@
+package atlasproject
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// maintenanceRetry is the delay between two attempts of a change Atlas rejected during a maintenance
+const maintenanceRetry = time.Minute
+
+// rejectedDuringMaintenance tells whether Atlas rejected a change to the project because of a maintenance running on
+// one of its deployments. When it did, the project is flagged with the MaintenanceInProgress condition and the
+// returned result backs off the change until the maintenance is over
+func rejectedDuringMaintenance(workflowCtx *workflow.Context, err error) (workflow.Result, bool) {
+	if !atlas.IsMaintenanceInProgress(err) {
+		return workflow.OK(), false
+	}
+
+	result := workflow.InProgress(
+		workflow.AtlasMaintenanceInProgress,
+		fmt.Sprintf("Atlas rejected the change during a maintenance: %s", err),
+	)
+	workflowCtx.EnsureCondition(status.TrueCondition(status.MaintenanceInProgressType).
+		WithReason(string(result.GetReason())).
+		WithMessageRegexp(result.GetMessage()))
+
+	return result.WithRetry(maintenanceRetry), true
+}
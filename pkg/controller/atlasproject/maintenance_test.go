@@ -0,0 +1,49 @@
+package atlasproject
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestRejectedDuringMaintenance(t *testing.T) {
+	hasCondition := func(workflowCtx *workflow.Context) bool {
+		for _, condition := range workflowCtx.Conditions() {
+			if condition.Type == status.MaintenanceInProgressType {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	t.Run("should back off a change rejected during a maintenance", func(t *testing.T) {
+		workflowCtx := newContext(t)
+		err := fmt.Errorf("failed to patch: %w", &mongodbatlas.ErrorResponse{HTTPCode: 409, ErrorCode: atlas.ClusterMaintenanceInProgress})
+
+		result, rejected := rejectedDuringMaintenance(workflowCtx, err)
+
+		require.True(t, rejected)
+		assert.Equal(t, workflow.AtlasMaintenanceInProgress, result.GetReason())
+		assert.Equal(t, maintenanceRetry, result.ReconcileResult().RequeueAfter)
+		assert.True(t, hasCondition(workflowCtx))
+	})
+
+	t.Run("should leave the other errors to the caller", func(t *testing.T) {
+		workflowCtx := newContext(t)
+
+		for _, err := range []error{nil, errors.New("failed"), &mongodbatlas.ErrorResponse{HTTPCode: 409, ErrorCode: "DUPLICATE_CLUSTER_NAME"}} {
+			_, rejected := rejectedDuringMaintenance(workflowCtx, err)
+			assert.False(t, rejected)
+		}
+		assert.False(t, hasCondition(workflowCtx))
+	})
+}
@@ -5,8 +5,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"reflect"
+	"time"
 
 	"go.mongodb.org/atlas/mongodbatlas"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
@@ -16,17 +20,35 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
 
+const (
+	maintenanceWindowReconciler = "maintenanceWindow"
+	maintenanceWindowPath       = "api/atlas/v1.0/groups/%s/maintenanceWindow"
+)
+
+// maintenanceWindowSettings holds the maintenance window fields of a project
+// TODO: Replace with the atlas-go-client MaintenanceWindow fields when they are available
+type maintenanceWindowSettings struct {
+	ProtectedHours               *project.ProtectedHours `json:"protectedHours,omitempty"`
+	NextMaintenanceStartDateTime string                  `json:"nextMaintenanceStartDateTime,omitempty"`
+}
+
+// protectedHoursUpdate is the request updating the protected hours, which are removed when nil
+type protectedHoursUpdate struct {
+	ProtectedHours *project.ProtectedHours `json:"protectedHours"`
+}
+
 // ensureMaintenanceWindow ensures that the state of the Atlas Maintenance Window matches the
 // state of the Maintenance Window specified in the project CR. If a Maintenance Window exists
 // in Atlas but is not specified in the CR, it is deleted.
 func ensureMaintenanceWindow(workflowCtx *workflow.Context, atlasProject *mdbv1.AtlasProject, protected bool) workflow.Result {
-	canReconcile, err := canMaintenanceWindowReconcile(workflowCtx, protected, atlasProject)
+	canReconcile, differences, err := canMaintenanceWindowReconcile(workflowCtx, protected, atlasProject)
 	if err != nil {
 		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err))
 		workflowCtx.SetConditionFromResult(status.IPAccessListReadyType, result)
 
 		return result
 	}
+	reportDeletionProtection(workflowCtx, "maintenanceWindow", protected, canReconcile, differences)
 
 	if !canReconcile {
 		result := workflow.Terminate(
@@ -47,6 +69,7 @@ func ensureMaintenanceWindow(workflowCtx *workflow.Context, atlasProject *mdbv1.
 			}
 			workflowCtx.UnsetCondition(condition.Type)
 		}
+		workflowCtx.EnsureStatusOption(status.AtlasProjectNextMaintenanceOption(nil))
 
 		return workflow.OK()
 	}
@@ -56,6 +79,8 @@ func ensureMaintenanceWindow(workflowCtx *workflow.Context, atlasProject *mdbv1.
 		return result
 	}
 
+	reportNextMaintenance(workflowCtx, atlasProject.ID())
+
 	workflowCtx.SetConditionTrue(status.MaintenanceWindowReadyType)
 	return workflow.OK()
 }
@@ -79,7 +104,7 @@ func syncAtlasWithSpec(ctx *workflow.Context, projectID string, windowSpec proje
 		if result := createOrUpdateInAtlas(ctx.Context, ctx.Client, projectID, windowSpec.WithStartASAP(false)); !result.IsOk() {
 			return result
 		}
-	} else if *windowInAtlas.AutoDeferOnceEnabled != windowSpec.AutoDefer {
+	} else if pointer.GetOrDefault(windowInAtlas.AutoDeferOnceEnabled, false) != windowSpec.AutoDefer {
 		// If autoDefer flag is different in Atlas, and we haven't updated the window previously, we toggle the flag
 		ctx.Log.Debugw("Toggling autoDefer")
 		if result := toggleAutoDeferInAtlas(ctx.Context, ctx.Client, projectID); !result.IsOk() {
@@ -87,6 +112,10 @@ func syncAtlasWithSpec(ctx *workflow.Context, projectID string, windowSpec proje
 		}
 	}
 
+	if result := syncProtectedHours(ctx, projectID, windowSpec.ProtectedHours); !result.IsOk() {
+		return result
+	}
+
 	if windowSpec.StartASAP {
 		ctx.Log.Debugw("Starting maintenance ASAP")
 		// To avoid any unexpected behavior, we send a request to the API containing only the StartASAP flag,
@@ -110,12 +139,82 @@ func syncAtlasWithSpec(ctx *workflow.Context, projectID string, windowSpec proje
 	return workflow.OK()
 }
 
+// syncProtectedHours updates the protected hours in Atlas when they differ from the spec
+func syncProtectedHours(ctx *workflow.Context, projectID string, protectedHours *project.ProtectedHours) workflow.Result {
+	settings, err := getMaintenanceWindowSettings(ctx.Context, ctx.Client, projectID)
+	if err != nil {
+		return workflow.Terminate(workflow.ProjectWindowNotObtainedFromAtlas, err.Error()).WithCause(maintenanceWindowReconciler, "getMaintenanceWindow")
+	}
+
+	if reflect.DeepEqual(settings.ProtectedHours, protectedHours) {
+		return workflow.OK()
+	}
+
+	ctx.Log.Debugw("Updating protected hours", "protectedHours", protectedHours)
+	if err = updateProtectedHours(ctx.Context, ctx.Client, projectID, protectedHours); err != nil {
+		return workflow.Terminate(workflow.ProjectWindowNotCreatedInAtlas, err.Error()).WithCause(maintenanceWindowReconciler, "updateProtectedHours")
+	}
+
+	return workflow.OK()
+}
+
+// reportNextMaintenance records the time of the next maintenance scheduled by Atlas in the status. Failing to read it
+// doesn't prevent the maintenance window from being ready
+func reportNextMaintenance(ctx *workflow.Context, projectID string) {
+	settings, err := getMaintenanceWindowSettings(ctx.Context, ctx.Client, projectID)
+	if err != nil {
+		ctx.Log.Warnw("failed to get the next scheduled maintenance", "error", err)
+		return
+	}
+
+	ctx.EnsureStatusOption(status.AtlasProjectNextMaintenanceOption(nextMaintenance(ctx, settings)))
+}
+
+func nextMaintenance(ctx *workflow.Context, settings *maintenanceWindowSettings) *metav1.Time {
+	if settings.NextMaintenanceStartDateTime == "" {
+		return nil
+	}
+
+	startTime, err := time.Parse(time.RFC3339, settings.NextMaintenanceStartDateTime)
+	if err != nil {
+		ctx.Log.Warnw("failed to parse the next scheduled maintenance", "error", err)
+		return nil
+	}
+
+	return &metav1.Time{Time: startTime}
+}
+
+func getMaintenanceWindowSettings(ctx context.Context, client *mongodbatlas.Client, projectID string) (*maintenanceWindowSettings, error) {
+	req, err := client.NewRequest(ctx, http.MethodGet, fmt.Sprintf(maintenanceWindowPath, projectID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := &maintenanceWindowSettings{}
+	if _, err = client.Do(ctx, req, settings); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func updateProtectedHours(ctx context.Context, client *mongodbatlas.Client, projectID string, protectedHours *project.ProtectedHours) error {
+	req, err := client.NewRequest(ctx, http.MethodPatch, fmt.Sprintf(maintenanceWindowPath, projectID), &protectedHoursUpdate{ProtectedHours: protectedHours})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(ctx, req, nil)
+
+	return err
+}
+
 func isEmpty(i int) bool {
 	return i == 0
 }
 
 func isEmptyWindow(window project.MaintenanceWindow) bool {
-	return isEmpty(window.DayOfWeek) && isEmpty(window.HourOfDay) && !window.StartASAP && !window.Defer && !window.AutoDefer
+	return isEmpty(window.DayOfWeek) && isEmpty(window.HourOfDay) && !window.StartASAP && !window.Defer && !window.AutoDefer && window.ProtectedHours == nil
 }
 
 func windowSpecified(window project.MaintenanceWindow) bool {
@@ -153,7 +252,7 @@ func operatorToAtlasMaintenanceWindow(maintenanceWindow project.MaintenanceWindo
 func getInAtlas(ctx context.Context, client *mongodbatlas.Client, projectID string) (*mongodbatlas.MaintenanceWindow, workflow.Result) {
 	window, _, err := client.MaintenanceWindows.Get(ctx, projectID)
 	if err != nil {
-		return nil, workflow.Terminate(workflow.ProjectWindowNotObtainedFromAtlas, err.Error())
+		return nil, workflow.Terminate(workflow.ProjectWindowNotObtainedFromAtlas, err.Error()).WithCause(maintenanceWindowReconciler, "getMaintenanceWindow")
 	}
 	return window, workflow.OK()
 }
@@ -165,21 +264,21 @@ func createOrUpdateInAtlas(ctx context.Context, client *mongodbatlas.Client, pro
 	}
 
 	if _, err := client.MaintenanceWindows.Update(ctx, projectID, operatorWindow); err != nil {
-		return workflow.Terminate(workflow.ProjectWindowNotCreatedInAtlas, err.Error())
+		return workflow.Terminate(workflow.ProjectWindowNotCreatedInAtlas, err.Error()).WithCause(maintenanceWindowReconciler, "updateMaintenanceWindow")
 	}
 	return workflow.OK()
 }
 
 func deleteInAtlas(ctx context.Context, client *mongodbatlas.Client, projectID string) workflow.Result {
 	if _, err := client.MaintenanceWindows.Reset(ctx, projectID); err != nil {
-		return workflow.Terminate(workflow.ProjectWindowNotDeletedInAtlas, err.Error())
+		return workflow.Terminate(workflow.ProjectWindowNotDeletedInAtlas, err.Error()).WithCause(maintenanceWindowReconciler, "resetMaintenanceWindow")
 	}
 	return workflow.OK()
 }
 
 func deferInAtlas(ctx context.Context, client *mongodbatlas.Client, projectID string) workflow.Result {
 	if _, err := client.MaintenanceWindows.Defer(ctx, projectID); err != nil {
-		return workflow.Terminate(workflow.ProjectWindowNotDeferredInAtlas, err.Error())
+		return workflow.Terminate(workflow.ProjectWindowNotDeferredInAtlas, err.Error()).WithCause(maintenanceWindowReconciler, "deferMaintenanceWindow")
 	}
 	return workflow.OK()
 }
@@ -187,38 +286,47 @@ func deferInAtlas(ctx context.Context, client *mongodbatlas.Client, projectID st
 // toggleAutoDeferInAtlas toggles the field "autoDeferOnceEnabled" by sending a POST /autoDefer request to the API
 func toggleAutoDeferInAtlas(ctx context.Context, client *mongodbatlas.Client, projectID string) workflow.Result {
 	if _, err := client.MaintenanceWindows.AutoDefer(ctx, projectID); err != nil {
-		return workflow.Terminate(workflow.ProjectWindowNotAutoDeferredInAtlas, err.Error())
+		return workflow.Terminate(workflow.ProjectWindowNotAutoDeferredInAtlas, err.Error()).WithCause(maintenanceWindowReconciler, "toggleMaintenanceAutoDefer")
 	}
 	return workflow.OK()
 }
 
-func canMaintenanceWindowReconcile(workflowCtx *workflow.Context, protected bool, akoProject *mdbv1.AtlasProject) (bool, error) {
+func canMaintenanceWindowReconcile(workflowCtx *workflow.Context, protected bool, akoProject *mdbv1.AtlasProject) (bool, []string, error) {
 	if !protected {
-		return true, nil
+		return true, nil, nil
 	}
 
 	latestConfig := &mdbv1.AtlasProjectSpec{}
 	latestConfigString, ok := akoProject.Annotations[customresource.AnnotationLastAppliedConfiguration]
 	if ok {
 		if err := json.Unmarshal([]byte(latestConfigString), latestConfig); err != nil {
-			return false, err
+			return false, nil, err
 		}
 	}
 
 	mWindow, _, err := workflowCtx.Client.MaintenanceWindows.Get(workflowCtx.Context, akoProject.ID())
 	if err != nil {
-		return false, err
+		return false, nil, err
 	}
 
 	if isAtlasMaintenanceWindowEmpty(mWindow) {
-		return true, nil
+		return true, nil, nil
+	}
+
+	if isMaintenanceWindowConfigEqual(latestConfig.MaintenanceWindow, *mWindow) ||
+		isMaintenanceWindowConfigEqual(akoProject.Spec.MaintenanceWindow, *mWindow) {
+		return true, nil, nil
 	}
 
-	return isMaintenanceWindowConfigEqual(latestConfig.MaintenanceWindow, *mWindow) ||
-		isMaintenanceWindowConfigEqual(akoProject.Spec.MaintenanceWindow, *mWindow), nil
+	return false, maintenanceWindowDifferences(akoProject.Spec.MaintenanceWindow, *mWindow), nil
 }
 
 func isMaintenanceWindowConfigEqual(akoMWindow project.MaintenanceWindow, atlasMWindow mongodbatlas.MaintenanceWindow) bool {
+	return len(maintenanceWindowDifferences(akoMWindow, atlasMWindow)) == 0
+}
+
+// maintenanceWindowDifferences lists the fields of the maintenance window of Atlas differing from the spec
+func maintenanceWindowDifferences(akoMWindow project.MaintenanceWindow, atlasMWindow mongodbatlas.MaintenanceWindow) []string {
 	if atlasMWindow.HourOfDay == nil {
 		atlasMWindow.HourOfDay = pointer.MakePtr(0)
 	}
@@ -231,10 +339,21 @@ func isMaintenanceWindowConfigEqual(akoMWindow project.MaintenanceWindow, atlasM
 		atlasMWindow.AutoDeferOnceEnabled = pointer.MakePtr(false)
 	}
 
-	return akoMWindow.DayOfWeek == atlasMWindow.DayOfWeek &&
-		akoMWindow.HourOfDay == *atlasMWindow.HourOfDay &&
-		akoMWindow.StartASAP == *atlasMWindow.StartASAP &&
-		akoMWindow.AutoDefer == *atlasMWindow.AutoDeferOnceEnabled
+	var differences []string
+	if akoMWindow.DayOfWeek != atlasMWindow.DayOfWeek {
+		differences = append(differences, "dayOfWeek")
+	}
+	if akoMWindow.HourOfDay != *atlasMWindow.HourOfDay {
+		differences = append(differences, "hourOfDay")
+	}
+	if akoMWindow.StartASAP != *atlasMWindow.StartASAP {
+		differences = append(differences, "startASAP")
+	}
+	if akoMWindow.AutoDefer != *atlasMWindow.AutoDeferOnceEnabled {
+		differences = append(differences, "autoDefer")
+	}
+
+	return differences
 }
 
 func isAtlasMaintenanceWindowEmpty(mWindow *mongodbatlas.MaintenanceWindow) bool {
@@ -2,8 +2,13 @@ package atlasproject
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -13,6 +18,7 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
@@ -137,7 +143,7 @@ func TestCanMaintenanceWindowReconcile(t *testing.T) {
 			Client:  &mongodbatlas.Client{},
 			Context: context.Background(),
 		}
-		result, err := canMaintenanceWindowReconcile(workflowCtx, false, &mdbv1.AtlasProject{})
+		result, _, err := canMaintenanceWindowReconcile(workflowCtx, false, &mdbv1.AtlasProject{})
 		require.NoError(t, err)
 		require.True(t, result)
 	})
@@ -149,7 +155,7 @@ func TestCanMaintenanceWindowReconcile(t *testing.T) {
 			Client:  &mongodbatlas.Client{},
 			Context: context.Background(),
 		}
-		result, err := canMaintenanceWindowReconcile(workflowCtx, true, akoProject)
+		result, _, err := canMaintenanceWindowReconcile(workflowCtx, true, akoProject)
 		require.EqualError(t, err, "invalid character 'w' looking for beginning of object key string")
 		require.False(t, result)
 	})
@@ -168,7 +174,7 @@ func TestCanMaintenanceWindowReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canMaintenanceWindowReconcile(workflowCtx, true, akoProject)
+		result, _, err := canMaintenanceWindowReconcile(workflowCtx, true, akoProject)
 
 		require.EqualError(t, err, "failed to retrieve data")
 		require.False(t, result)
@@ -188,7 +194,7 @@ func TestCanMaintenanceWindowReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canMaintenanceWindowReconcile(workflowCtx, true, akoProject)
+		result, _, err := canMaintenanceWindowReconcile(workflowCtx, true, akoProject)
 
 		require.NoError(t, err)
 		require.True(t, result)
@@ -218,7 +224,7 @@ func TestCanMaintenanceWindowReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canMaintenanceWindowReconcile(workflowCtx, true, akoProject)
+		result, _, err := canMaintenanceWindowReconcile(workflowCtx, true, akoProject)
 
 		require.NoError(t, err)
 		require.True(t, result)
@@ -248,7 +254,7 @@ func TestCanMaintenanceWindowReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canMaintenanceWindowReconcile(workflowCtx, true, akoProject)
+		result, _, err := canMaintenanceWindowReconcile(workflowCtx, true, akoProject)
 
 		require.NoError(t, err)
 		require.True(t, result)
@@ -279,10 +285,11 @@ func TestCanMaintenanceWindowReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canMaintenanceWindowReconcile(workflowCtx, true, akoProject)
+		result, differences, err := canMaintenanceWindowReconcile(workflowCtx, true, akoProject)
 
 		require.NoError(t, err)
 		require.False(t, result)
+		assert.Equal(t, []string{"dayOfWeek", "hourOfDay", "startASAP"}, differences)
 	})
 }
 
@@ -345,3 +352,110 @@ func TestEnsureMaintenanceWindow(t *testing.T) {
 		)
 	})
 }
+
+func testMaintenanceWindowContext(t *testing.T, handler http.Handler) *workflow.Context {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := mongodbatlas.New(server.Client(), mongodbatlas.SetBaseURL(server.URL+"/"))
+	require.NoError(t, err)
+
+	workflowCtx := newContext(t)
+	workflowCtx.Client = client
+
+	return workflowCtx
+}
+
+func TestSyncProtectedHours(t *testing.T) {
+	t.Run("should not update protected hours matching the spec", func(t *testing.T) {
+		workflowCtx := testMaintenanceWindowContext(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/atlas/v1.0/groups/project-id/maintenanceWindow", r.URL.Path)
+			fmt.Fprint(w, `{"dayOfWeek":1,"protectedHours":{"startHourOfDay":9,"endHourOfDay":17}}`)
+		}))
+
+		result := syncProtectedHours(workflowCtx, "project-id", &project.ProtectedHours{StartHourOfDay: 9, EndHourOfDay: 17})
+
+		assert.True(t, result.IsOk())
+	})
+
+	t.Run("should update drifted protected hours", func(t *testing.T) {
+		var patched map[string]interface{}
+		workflowCtx := testMaintenanceWindowContext(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPatch {
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&patched))
+				fmt.Fprint(w, `{}`)
+				return
+			}
+			fmt.Fprint(w, `{"dayOfWeek":1,"protectedHours":{"startHourOfDay":9,"endHourOfDay":17}}`)
+		}))
+
+		result := syncProtectedHours(workflowCtx, "project-id", &project.ProtectedHours{StartHourOfDay: 8, EndHourOfDay: 18})
+
+		assert.True(t, result.IsOk())
+		assert.Equal(t, map[string]interface{}{"protectedHours": map[string]interface{}{"startHourOfDay": 8.0, "endHourOfDay": 18.0}}, patched)
+	})
+
+	t.Run("should remove protected hours missing from the spec", func(t *testing.T) {
+		var patched map[string]interface{}
+		workflowCtx := testMaintenanceWindowContext(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPatch {
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&patched))
+				fmt.Fprint(w, `{}`)
+				return
+			}
+			fmt.Fprint(w, `{"dayOfWeek":1,"protectedHours":{"startHourOfDay":9,"endHourOfDay":17}}`)
+		}))
+
+		result := syncProtectedHours(workflowCtx, "project-id", nil)
+
+		assert.True(t, result.IsOk())
+		assert.Equal(t, map[string]interface{}{"protectedHours": nil}, patched)
+	})
+
+	t.Run("should fail when protected hours can't be obtained from Atlas", func(t *testing.T) {
+		workflowCtx := testMaintenanceWindowContext(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+
+		result := syncProtectedHours(workflowCtx, "project-id", nil)
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.ProjectWindowNotObtainedFromAtlas, result.GetReason())
+	})
+}
+
+func TestReportNextMaintenance(t *testing.T) {
+	projectStatus := func(workflowCtx *workflow.Context) status.AtlasProjectStatus {
+		projectStatus := status.AtlasProjectStatus{}
+		for _, option := range workflowCtx.StatusOptions() {
+			option.(status.AtlasProjectStatusOption)(&projectStatus)
+		}
+
+		return projectStatus
+	}
+
+	t.Run("should report the next scheduled maintenance", func(t *testing.T) {
+		workflowCtx := testMaintenanceWindowContext(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"dayOfWeek":1,"nextMaintenanceStartDateTime":"2023-11-20T10:00:00Z"}`)
+		}))
+
+		reportNextMaintenance(workflowCtx, "project-id")
+
+		nextMaintenance := projectStatus(workflowCtx).NextMaintenance
+		require.NotNil(t, nextMaintenance)
+		assert.True(t, time.Date(2023, 11, 20, 10, 0, 0, 0, time.UTC).Equal(nextMaintenance.Time))
+	})
+
+	t.Run("should remove the next maintenance when none is scheduled", func(t *testing.T) {
+		workflowCtx := testMaintenanceWindowContext(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"dayOfWeek":1}`)
+		}))
+
+		reportNextMaintenance(workflowCtx, "project-id")
+
+		require.Len(t, workflowCtx.StatusOptions(), 1)
+		assert.Nil(t, projectStatus(workflowCtx).NextMaintenance)
+	})
+}
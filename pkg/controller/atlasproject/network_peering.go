@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 
 	"go.mongodb.org/atlas-sdk/v20231115004/admin"
@@ -12,14 +13,18 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/compare"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlasnetworkcontainer"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
 
+const networkPeeringReconciler = "networkPeers"
+
 const (
 	StatusFailed      = "FAILED"
 	StatusReady       = "AVAILABLE"
@@ -33,14 +38,74 @@ type networkPeerDiff struct {
 	PeersToUpdate []admin.BaseNetworkPeeringConnectionSettings
 }
 
-func ensureNetworkPeers(workflowCtx *workflow.Context, akoProject *mdbv1.AtlasProject, subobjectProtect bool) workflow.Result {
-	canReconcile, err := canNetworkPeeringReconcile(workflowCtx, subobjectProtect, akoProject)
+// reconcileNetworkPeers ensures the network peers, keeping the containers of the project pinned by
+// AtlasNetworkContainer resources
+func (r *AtlasProjectReconciler) reconcileNetworkPeers(workflowCtx *workflow.Context, akoProject *mdbv1.AtlasProject) workflow.Result {
+	pinnedContainers, err := r.pinnedNetworkContainerIDs(workflowCtx, akoProject)
+	if err != nil {
+		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("failed to list the network containers: %s", err)).
+			WithCause(networkPeeringReconciler, "")
+		workflowCtx.SetConditionFromResult(status.NetworkPeerReadyType, result)
+
+		return result
+	}
+
+	return ensureNetworkPeers(workflowCtx, akoProject, r.SubObjectDeletionProtection, r.AWSPeeringAccepter, pinnedContainers)
+}
+
+// pinnedNetworkContainerIDs returns the ids of the containers of the project managed by AtlasNetworkContainer
+// resources. The resources without a container id yet pin the Atlas container of their provider and region, which they
+// create or adopt
+func (r *AtlasProjectReconciler) pinnedNetworkContainerIDs(workflowCtx *workflow.Context, akoProject *mdbv1.AtlasProject) ([]string, error) {
+	containers := &mdbv1.AtlasNetworkContainerList{}
+	if err := r.Client.List(workflowCtx.Context, containers); err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	var unresolved []*mdbv1.AtlasNetworkContainer
+	for i := range containers.Items {
+		container := &containers.Items[i]
+		if container.AtlasProjectObjectKey() != kube.ObjectKeyFromObject(akoProject) {
+			continue
+		}
+		if container.Status.ID == "" {
+			unresolved = append(unresolved, container)
+			continue
+		}
+		ids = append(ids, container.Status.ID)
+	}
+
+	if len(unresolved) == 0 || akoProject.ID() == "" {
+		return ids, nil
+	}
+
+	atlasContainers, _, err := workflowCtx.SdkClient.NetworkPeeringApi.ListPeeringContainers(workflowCtx.Context, akoProject.ID()).Execute()
+	if err != nil {
+		return nil, err
+	}
+	for _, atlasContainer := range atlasContainers.GetResults() {
+		for _, container := range unresolved {
+			if atlasnetworkcontainer.MatchesRegion(container, &atlasContainer) {
+				ids = append(ids, atlasContainer.GetId())
+				break
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+func ensureNetworkPeers(workflowCtx *workflow.Context, akoProject *mdbv1.AtlasProject, subobjectProtect bool, accepter AWSPeeringAccepter, pinnedContainers []string) workflow.Result {
+	canReconcile, differences, err := canNetworkPeeringReconcile(workflowCtx, subobjectProtect, akoProject, pinnedContainers)
 	if err != nil {
-		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err))
+		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err)).
+			WithCause(networkPeeringReconciler, workflow.AtlasOperation(err))
 		workflowCtx.SetConditionFromResult(status.NetworkPeerReadyType, result)
 
 		return result
 	}
+	reportDeletionProtection(workflowCtx, "networkPeers", subobjectProtect, canReconcile, differences)
 
 	if !canReconcile {
 		result := workflow.Terminate(
@@ -55,7 +120,7 @@ func ensureNetworkPeers(workflowCtx *workflow.Context, akoProject *mdbv1.AtlasPr
 	networkPeerStatus := akoProject.Status.DeepCopy().NetworkPeers
 	networkPeerSpec := akoProject.Spec.DeepCopy().NetworkPeers
 
-	result, condition := SyncNetworkPeer(workflowCtx, akoProject.ID(), networkPeerStatus, networkPeerSpec)
+	result, condition := SyncNetworkPeer(workflowCtx, akoProject.ID(), networkPeerStatus, networkPeerSpec, accepter, pinnedContainers)
 	if !result.IsOk() {
 		workflowCtx.SetConditionFromResult(condition, result)
 		return result
@@ -86,7 +151,9 @@ func failedPeerStatus(errMessage string, peer mdbv1.NetworkPeer) status.AtlasNet
 	}
 }
 
-func SyncNetworkPeer(workflowCtx *workflow.Context, groupID string, peerStatuses []status.AtlasNetworkPeer, peerSpecs []mdbv1.NetworkPeer) (workflow.Result, status.ConditionType) {
+const networkPeersResource = "networkPeers"
+
+func SyncNetworkPeer(workflowCtx *workflow.Context, groupID string, peerStatuses []status.AtlasNetworkPeer, peerSpecs []mdbv1.NetworkPeer, accepter AWSPeeringAccepter, pinnedContainers []string) (workflow.Result, status.ConditionType) {
 	defer workflowCtx.EnsureStatusOption(status.AtlasProjectSetNetworkPeerOption(&peerStatuses))
 	logger := workflowCtx.Log
 	mongoClient := workflowCtx.SdkClient
@@ -94,35 +161,59 @@ func SyncNetworkPeer(workflowCtx *workflow.Context, groupID string, peerStatuses
 	list, err := GetAllExistedNetworkPeer(workflowCtx.Context, mongoClient.NetworkPeeringApi, groupID)
 	if err != nil {
 		logger.Errorf("failed to get all network peers: %v", err)
-		return workflow.Terminate(workflow.ProjectNetworkPeerIsNotReadyInAtlas, "failed to get all network peers"),
-			status.NetworkPeerReadyType
+		if isRateLimited(err) {
+			return syncInProgress(workflowCtx, networkPeersProgress(peerSpecs, nil), true), status.NetworkPeerReadyType
+		}
+		result := workflow.Terminate(workflow.ProjectNetworkPeerIsNotReadyInAtlas, "failed to get all network peers").
+			WithCause(networkPeeringReconciler, "listPeeringConnections")
+		return result, status.NetworkPeerReadyType
 	}
 
 	diff := sortPeers(workflowCtx.Context, list, peerSpecs, logger, mongoClient.NetworkPeeringApi, groupID)
 	logger.Debugf("peers to create %d, peers to update %d, peers to delete %d",
 		len(diff.PeersToCreate), len(diff.PeersToUpdate), len(diff.PeersToDelete))
 
-	for _, peerToDelete := range diff.PeersToDelete {
+	toDelete, budget := syncChunk(diff.PeersToDelete, syncChunkSize)
+	for i, peerToDelete := range toDelete {
 		errDelete := deletePeerByID(workflowCtx.Context, mongoClient.NetworkPeeringApi, groupID, peerToDelete, logger)
 		if errDelete != nil {
 			logger.Errorf("failed to delete network peer %s: %v", peerToDelete, errDelete)
-			return workflow.Terminate(workflow.ProjectNetworkPeerIsNotReadyInAtlas, "failed to delete network peer"),
+			if isRateLimited(errDelete) {
+				diff.PeersToDelete = diff.PeersToDelete[i:]
+				return syncInProgress(workflowCtx, networkPeersProgress(peerSpecs, diff), true), status.NetworkPeerReadyType
+			}
+			return workflow.Terminate(workflow.ProjectNetworkPeerIsNotReadyInAtlas, "failed to delete network peer").
+					WithCause(networkPeeringReconciler, "deletePeeringConnection"),
 				status.NetworkPeerReadyType
 		}
 	}
+	diff.PeersToDelete = diff.PeersToDelete[len(toDelete):]
 
-	peerStatuses = createNetworkPeers(workflowCtx.Context, mongoClient, groupID, diff.PeersToCreate, logger)
+	toCreate, _ := syncChunk(diff.PeersToCreate, budget)
+	peerStatuses, created, errCreate := createNetworkPeers(workflowCtx.Context, mongoClient, groupID, toCreate, logger)
+	diff.PeersToCreate = diff.PeersToCreate[created:]
 	peerStatuses, err = UpdateStatuses(workflowCtx.Context, mongoClient.NetworkPeeringApi, peerStatuses, diff.PeersToUpdate, groupID, logger)
 	if err != nil {
 		logger.Errorf("failed to update network peer statuses: %v", err)
 		return workflow.Terminate(workflow.ProjectNetworkPeerIsNotReadyInAtlas,
-			"failed to update network peer statuses"), status.NetworkPeerReadyType
+			"failed to update network peer statuses").WithCause(networkPeeringReconciler, "getPeeringContainer"), status.NetworkPeerReadyType
+	}
+	// the containers of the peers still to create may be unused yet, they are only deleted once all the peers exist
+	if len(diff.PeersToCreate) > 0 || len(diff.PeersToDelete) > 0 {
+		return syncInProgress(workflowCtx, networkPeersProgress(peerSpecs, diff), errCreate != nil), status.NetworkPeerReadyType
 	}
-	err = deleteUnusedContainers(workflowCtx.Context, mongoClient.NetworkPeeringApi, groupID, getPeerIDs(peerStatuses))
+	syncCompleted(workflowCtx, networkPeersResource)
+
+	err = deleteUnusedContainers(workflowCtx.Context, mongoClient.NetworkPeeringApi, groupID, append(getPeerIDs(peerStatuses), pinnedContainers...))
 	if err != nil {
 		logger.Errorf("failed to delete unused containers: %v", err)
 		return workflow.Terminate(workflow.ProjectNetworkPeerIsNotReadyInAtlas,
-			fmt.Sprintf("failed to delete unused containers: %s", err)), status.NetworkPeerReadyType
+			fmt.Sprintf("failed to delete unused containers: %s", err)).WithCause(networkPeeringReconciler, workflow.AtlasOperation(err)), status.NetworkPeerReadyType
+	}
+	err = acceptAWSPeers(workflowCtx, groupID, accepter, peerStatuses, peerSpecs)
+	if err != nil {
+		logger.Errorf("failed to accept the AWS network peers: %v", err)
+		return workflow.Terminate(workflow.ProjectNetworkPeerAutoAcceptFailed, err.Error()).WithCause(networkPeeringReconciler, ""), status.NetworkPeerReadyType
 	}
 	return ensurePeerStatus(peerStatuses, len(peerSpecs), logger)
 }
@@ -158,13 +249,13 @@ func getPeerIDs(statuses []status.AtlasNetworkPeer) []string {
 func deleteUnusedContainers(context context.Context, containerService admin.NetworkPeeringApi, groupID string, doNotDelete []string) error {
 	containers, _, err := containerService.ListPeeringContainers(context, groupID).Execute()
 	if err != nil {
-		return err
+		return workflow.NewAtlasOperationError("listPeeringContainers", err)
 	}
 	for _, container := range containers.GetResults() {
 		if !compare.Contains(doNotDelete, container.GetId()) {
 			_, response, errDelete := containerService.DeletePeeringContainer(context, groupID, container.GetId()).Execute()
 			if errDelete != nil && response.StatusCode != http.StatusConflict { // AWS peer does not contain container id
-				return errDelete
+				return workflow.NewAtlasOperationError("deletePeeringContainer", errDelete)
 			}
 		}
 	}
@@ -236,9 +327,11 @@ func ensurePeerStatus(peerStatuses []status.AtlasNetworkPeer, lenOfSpec int, log
 	return workflow.OK(), status.NetworkPeerReadyType
 }
 
-func createNetworkPeers(context context.Context, mongoClient *admin.APIClient, groupID string, peers []mdbv1.NetworkPeer, logger *zap.SugaredLogger) []status.AtlasNetworkPeer {
+// createNetworkPeers creates the network peers and returns their statuses along with the number of peers processed.
+// The creation stops with an error when Atlas rate limits the requests, other failures are reported in the statuses
+func createNetworkPeers(context context.Context, mongoClient *admin.APIClient, groupID string, peers []mdbv1.NetworkPeer, logger *zap.SugaredLogger) ([]status.AtlasNetworkPeer, int, error) {
 	var newPeerStatuses []status.AtlasNetworkPeer
-	for _, peer := range peers {
+	for i, peer := range peers {
 		err := validateInitNetworkPeer(peer)
 		if err != nil {
 			newPeerStatuses = append(newPeerStatuses,
@@ -249,6 +342,9 @@ func createNetworkPeers(context context.Context, mongoClient *admin.APIClient, g
 		if peer.ContainerID == "" {
 			containerID, errCreate := createContainer(context, mongoClient.NetworkPeeringApi, groupID, peer, logger)
 			if errCreate != nil {
+				if isRateLimited(errCreate) {
+					return newPeerStatuses, i, errCreate
+				}
 				newPeerStatuses = append(newPeerStatuses,
 					failedPeerStatus(fmt.Errorf("failed to create container for network peer %w", errCreate).Error(), peer))
 				logger.Errorf("failed to create container for network peer: %s", errCreate)
@@ -260,6 +356,9 @@ func createNetworkPeers(context context.Context, mongoClient *admin.APIClient, g
 		atlasPeer, err := createNetworkPeer(context, groupID, mongoClient.NetworkPeeringApi, peer, logger)
 		if err != nil {
 			logger.Errorf("failed to create network peer: %v", err)
+			if isRateLimited(err) {
+				return newPeerStatuses, i, err
+			}
 			newPeerStatuses = append(newPeerStatuses,
 				failedPeerStatus(fmt.Errorf("failed to create network peer: %w", err).Error(), peer))
 			continue
@@ -290,7 +389,23 @@ func createNetworkPeers(context context.Context, mongoClient *admin.APIClient, g
 			}
 		}
 	}
-	return newPeerStatuses
+	return newPeerStatuses, len(peers), nil
+}
+
+// networkPeersProgress returns the progress of the synchronization from the changes still to apply in Atlas.
+// All the network peers are pending when the changes are unknown
+func networkPeersProgress(peerSpecs []mdbv1.NetworkPeer, pending *networkPeerDiff) status.ResourceSyncProgress {
+	progress := status.ResourceSyncProgress{
+		Resource: networkPeersResource,
+		Total:    len(peerSpecs),
+	}
+
+	if pending != nil {
+		progress.Synced = len(peerSpecs) - len(pending.PeersToCreate)
+		progress.PendingDeletions = len(pending.PeersToDelete)
+	}
+
+	return progress
 }
 
 func GetAllExistedNetworkPeer(ctx context.Context, peerService admin.NetworkPeeringApi, groupID string) ([]admin.BaseNetworkPeeringConnectionSettings, error) {
@@ -565,74 +680,108 @@ func validateInitNetworkPeer(peer mdbv1.NetworkPeer) error {
 	return fmt.Errorf("unsupported provider: %s", peer.ProviderName)
 }
 
-func DeleteAllNetworkPeers(ctx context.Context, groupID string, service admin.NetworkPeeringApi, logger *zap.SugaredLogger) workflow.Result {
+func DeleteAllNetworkPeers(ctx context.Context, groupID string, service admin.NetworkPeeringApi, logger *zap.SugaredLogger) (workflow.Result, error) {
 	result := workflow.OK()
 	err := deleteAllNetworkPeers(ctx, groupID, service, logger)
 	if err != nil {
-		result = workflow.Terminate(workflow.ProjectNetworkPeerIsNotReadyInAtlas, "failed to delete NetworkPeers")
+		result = workflow.Terminate(workflow.ProjectNetworkPeerIsNotReadyInAtlas, "failed to delete NetworkPeers").
+			WithCause(networkPeeringReconciler, workflow.AtlasOperation(err))
 	}
-	return result
+	return result, err
 }
 
 func deleteAllNetworkPeers(ctx context.Context, groupID string, service admin.NetworkPeeringApi, logger *zap.SugaredLogger) error {
 	peers, err := GetAllExistedNetworkPeer(ctx, service, groupID)
 	if err != nil {
 		logger.Errorf("failed to list network peers for project %s: %v", groupID, err)
-		return err
+		return workflow.NewAtlasOperationError("listPeeringConnections", err)
 	}
 	for _, peer := range peers {
 		errDelete := deletePeerByID(ctx, service, groupID, peer.GetId(), logger)
 		if errDelete != nil {
 			logger.Errorf("failed to delete network peer %s: %v", peer.GetId(), errDelete)
-			return errDelete
+			return workflow.NewAtlasOperationError("deletePeeringConnection", errDelete)
 		}
 	}
 	return nil
 }
 
-func canNetworkPeeringReconcile(workflowCtx *workflow.Context, protected bool, akoProject *mdbv1.AtlasProject) (bool, error) {
+func canNetworkPeeringReconcile(workflowCtx *workflow.Context, protected bool, akoProject *mdbv1.AtlasProject, pinnedContainers []string) (bool, []string, error) {
 	if !protected {
-		return true, nil
+		return true, nil, nil
 	}
 
 	latestConfig := &mdbv1.AtlasProjectSpec{}
 	latestConfigString, ok := akoProject.Annotations[customresource.AnnotationLastAppliedConfiguration]
 	if ok {
 		if err := json.Unmarshal([]byte(latestConfigString), latestConfig); err != nil {
-			return false, err
+			return false, nil, err
 		}
 	}
 
 	containers, _, err := workflowCtx.Client.Containers.List(workflowCtx.Context, akoProject.ID(), &mongodbatlas.ContainersListOptions{})
 	if err != nil {
-		return false, err
+		return false, nil, workflow.NewAtlasOperationError("listPeeringContainers", err)
 	}
+	containers = withoutPinnedContainers(containers, pinnedContainers)
 
 	if len(containers) > 0 && !areContainersEqual(latestConfig.NetworkPeers, containers) && !areContainersEqual(akoProject.Spec.NetworkPeers, containers) {
-		return false, nil
+		return false, networkPeeringDifferences("container", len(akoProject.Spec.NetworkPeers), len(containers), unmatchedContainers(akoProject.Spec.NetworkPeers, containers)), nil
 	}
 
 	peers, _, err := workflowCtx.Client.Peers.List(workflowCtx.Context, akoProject.ID(), &mongodbatlas.ContainersListOptions{})
 	if err != nil {
-		return false, err
+		return false, nil, workflow.NewAtlasOperationError("listPeeringConnections", err)
 	}
 
 	if len(peers) == 0 {
-		return true, nil
+		return true, nil, nil
 	}
 
 	if !arePeersEqual(latestConfig.NetworkPeers, peers) && !arePeersEqual(akoProject.Spec.NetworkPeers, peers) {
-		return false, nil
+		return false, networkPeeringDifferences("peer", len(akoProject.Spec.NetworkPeers), len(peers), unmatchedPeers(akoProject.Spec.NetworkPeers, peers)), nil
 	}
 
-	return true, err
+	return true, nil, nil
 }
 
-func areContainersEqual(operatorContainers []mdbv1.NetworkPeer, atlasContainers []mongodbatlas.Container) bool {
-	if len(operatorContainers) != len(atlasContainers) {
-		return false
+// networkPeeringDifferences describes the containers or peers of Atlas missing from the spec
+func networkPeeringDifferences(kind string, specCount, atlasCount int, unmatched []string) []string {
+	differences := make([]string, 0, len(unmatched)+1)
+	for _, id := range unmatched {
+		differences = append(differences, fmt.Sprintf("%s %s: only in Atlas", kind, id))
 	}
 
+	if specCount != atlasCount {
+		differences = append(differences, fmt.Sprintf("%s count: %d in Atlas, %d in the spec", kind, atlasCount, specCount))
+	}
+
+	return differences
+}
+
+// withoutPinnedContainers removes the containers managed by AtlasNetworkContainer resources, which don't belong to
+// the network peers of the project
+func withoutPinnedContainers(containers []mongodbatlas.Container, pinnedContainers []string) []mongodbatlas.Container {
+	if len(pinnedContainers) == 0 {
+		return containers
+	}
+
+	result := make([]mongodbatlas.Container, 0, len(containers))
+	for _, container := range containers {
+		if !compare.Contains(pinnedContainers, container.ID) {
+			result = append(result, container)
+		}
+	}
+
+	return result
+}
+
+func areContainersEqual(operatorContainers []mdbv1.NetworkPeer, atlasContainers []mongodbatlas.Container) bool {
+	return len(operatorContainers) == len(atlasContainers) && len(unmatchedContainers(operatorContainers, atlasContainers)) == 0
+}
+
+// unmatchedContainers returns the identifiers of the containers of Atlas missing from the spec
+func unmatchedContainers(operatorContainers []mdbv1.NetworkPeer, atlasContainers []mongodbatlas.Container) []string {
 	atlasContainersIDs := map[string]struct{}{}
 	for _, container := range atlasContainers {
 		switch container.ProviderName {
@@ -656,14 +805,15 @@ func areContainersEqual(operatorContainers []mdbv1.NetworkPeer, atlasContainers
 		}
 	}
 
-	return len(atlasContainersIDs) == 0
+	return sortedKeys(atlasContainersIDs)
 }
 
 func arePeersEqual(operatorPeers []mdbv1.NetworkPeer, atlasPeers []mongodbatlas.Peer) bool {
-	if len(operatorPeers) != len(atlasPeers) {
-		return false
-	}
+	return len(operatorPeers) == len(atlasPeers) && len(unmatchedPeers(operatorPeers, atlasPeers)) == 0
+}
 
+// unmatchedPeers returns the identifiers of the peers of Atlas missing from the spec
+func unmatchedPeers(operatorPeers []mdbv1.NetworkPeer, atlasPeers []mongodbatlas.Peer) []string {
 	atlasPeersIDs := map[string]struct{}{}
 	for _, peer := range atlasPeers {
 		switch peer.ProviderName {
@@ -687,5 +837,16 @@ func arePeersEqual(operatorPeers []mdbv1.NetworkPeer, atlasPeers []mongodbatlas.
 		}
 	}
 
-	return len(atlasPeersIDs) == 0
+	return sortedKeys(atlasPeersIDs)
+}
+
+// sortedKeys returns the identifiers of a set in order
+func sortedKeys(ids map[string]struct{}) []string {
+	keys := make([]string, 0, len(ids))
+	for key := range ids {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
 }
@@ -0,0 +1,191 @@
+package atlasproject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// StatusPendingAcceptance is the status of an AWS peering connection waiting for the user to accept it
+const StatusPendingAcceptance = "PENDING_ACCEPTANCE"
+
+// AWSPeeringAccepter completes, in the user's AWS account, the peering connections initiated by Atlas
+type AWSPeeringAccepter interface {
+	// AcceptPeering accepts the peering connection
+	AcceptPeering(ctx context.Context, region, connectionID string) error
+	// EnsureRoutes routes the destination CIDR block through the peering connection in the route tables of the VPC,
+	// or in the given ones
+	EnsureRoutes(ctx context.Context, region, vpcID, connectionID, destinationCIDR string, routeTableIDs []string) error
+}
+
+// EC2PeeringAccepter accepts the peering connections with the EC2 API
+type EC2PeeringAccepter struct {
+	// NewClient returns the EC2 client of a region
+	NewClient func(region string) ec2iface.EC2API
+}
+
+// NewEC2PeeringAccepter returns an accepter using the AWS credentials of the environment, e.g. the web identity token of
+// IAM Roles for Service Accounts
+func NewEC2PeeringAccepter() (*EC2PeeringAccepter, error) {
+	sess, err := session.NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the AWS session: %w", err)
+	}
+
+	return &EC2PeeringAccepter{
+		NewClient: func(region string) ec2iface.EC2API {
+			return ec2.New(sess, aws.NewConfig().WithRegion(region))
+		},
+	}, nil
+}
+
+func (a *EC2PeeringAccepter) AcceptPeering(ctx context.Context, region, connectionID string) error {
+	_, err := a.NewClient(region).AcceptVpcPeeringConnectionWithContext(ctx, &ec2.AcceptVpcPeeringConnectionInput{
+		VpcPeeringConnectionId: aws.String(connectionID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to accept the peering connection %s: %w", connectionID, err)
+	}
+
+	return nil
+}
+
+func (a *EC2PeeringAccepter) EnsureRoutes(ctx context.Context, region, vpcID, connectionID, destinationCIDR string, routeTableIDs []string) error {
+	client := a.NewClient(region)
+
+	input := &ec2.DescribeRouteTablesInput{}
+	if len(routeTableIDs) > 0 {
+		input.RouteTableIds = aws.StringSlice(routeTableIDs)
+	} else {
+		input.Filters = []*ec2.Filter{{Name: aws.String("vpc-id"), Values: aws.StringSlice([]string{vpcID})}}
+	}
+
+	var tables []*ec2.RouteTable
+	err := client.DescribeRouteTablesPagesWithContext(ctx, input, func(page *ec2.DescribeRouteTablesOutput, _ bool) bool {
+		tables = append(tables, page.RouteTables...)
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list the route tables of the VPC %s: %w", vpcID, err)
+	}
+	if len(tables) == 0 {
+		return fmt.Errorf("no route table found for the VPC %s", vpcID)
+	}
+
+	var errs []error
+	for _, table := range tables {
+		errs = append(errs, ensureRoute(ctx, client, table, connectionID, destinationCIDR))
+	}
+
+	return errors.Join(errs...)
+}
+
+// ensureRoute creates the route to the destination CIDR block through the peering connection. A route to the same CIDR
+// block going through another target (e.g. a transit gateway) is left alone, as replacing it would cut the traffic
+// relying on it
+func ensureRoute(ctx context.Context, client ec2iface.EC2API, table *ec2.RouteTable, connectionID, destinationCIDR string) error {
+	for _, route := range table.Routes {
+		if aws.StringValue(route.DestinationCidrBlock) != destinationCIDR {
+			continue
+		}
+
+		if aws.StringValue(route.VpcPeeringConnectionId) == connectionID {
+			return nil
+		}
+
+		return fmt.Errorf("the route table %s already routes %s through another target, remove that route or exclude the route table", aws.StringValue(table.RouteTableId), destinationCIDR)
+	}
+
+	_, err := client.CreateRouteWithContext(ctx, &ec2.CreateRouteInput{
+		RouteTableId:           table.RouteTableId,
+		DestinationCidrBlock:   aws.String(destinationCIDR),
+		VpcPeeringConnectionId: aws.String(connectionID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create the route to %s in the route table %s: %w", destinationCIDR, aws.StringValue(table.RouteTableId), err)
+	}
+
+	return nil
+}
+
+// acceptAWSPeers accepts the AWS peering connections of the peers enabling it once Atlas initiated them, and updates
+// the route tables of their VPC once they are available
+func acceptAWSPeers(workflowCtx *workflow.Context, groupID string, accepter AWSPeeringAccepter, peerStatuses []status.AtlasNetworkPeer, peerSpecs []mdbv1.NetworkPeer) error {
+	for _, peerStatus := range peerStatuses {
+		if peerStatus.ProviderName != provider.ProviderAWS || peerStatus.ConnectionID == "" {
+			continue
+		}
+
+		peerSpec, ok := findAWSPeerSpec(peerSpecs, peerStatus)
+		if !ok || !peerSpec.AWSAutoAccept.IsEnabled() {
+			continue
+		}
+
+		if accepter == nil {
+			return errors.New("the auto-accept of the AWS peering connections is not configured in the operator")
+		}
+
+		switch peerStatus.StatusName {
+		case StatusPendingAcceptance:
+			workflowCtx.Log.Infow("Accepting the AWS peering connection", "connectionID", peerStatus.ConnectionID, "vpc", peerStatus.VPC)
+			if err := accepter.AcceptPeering(workflowCtx.Context, peerStatus.Region, peerStatus.ConnectionID); err != nil {
+				return err
+			}
+		case StatusReady:
+			if !peerSpec.AWSAutoAccept.UpdateRouteTables {
+				continue
+			}
+
+			cidr, err := atlasCIDRBlock(workflowCtx, groupID, peerSpec)
+			if err != nil {
+				return err
+			}
+
+			err = accepter.EnsureRoutes(workflowCtx.Context, peerStatus.Region, peerStatus.VPC, peerStatus.ConnectionID, cidr, peerSpec.AWSAutoAccept.RouteTableIDs)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func findAWSPeerSpec(peerSpecs []mdbv1.NetworkPeer, peerStatus status.AtlasNetworkPeer) (mdbv1.NetworkPeer, bool) {
+	for _, peerSpec := range peerSpecs {
+		if peerSpec.ProviderName != provider.ProviderAWS && peerSpec.ProviderName != "" {
+			continue
+		}
+
+		if peerSpec.VpcID == peerStatus.VPC && peerSpec.AccepterRegionName == peerStatus.Region {
+			return peerSpec, true
+		}
+	}
+
+	return mdbv1.NetworkPeer{}, false
+}
+
+// atlasCIDRBlock returns the CIDR block of the Atlas container of the peer, read from Atlas when the peer references an
+// existing container
+func atlasCIDRBlock(workflowCtx *workflow.Context, groupID string, peerSpec mdbv1.NetworkPeer) (string, error) {
+	if peerSpec.AtlasCIDRBlock != "" {
+		return peerSpec.AtlasCIDRBlock, nil
+	}
+
+	container, _, err := workflowCtx.SdkClient.NetworkPeeringApi.GetPeeringContainer(workflowCtx.Context, groupID, peerSpec.ContainerID).Execute()
+	if err != nil {
+		return "", fmt.Errorf("failed to get the network peer container %s: %w", peerSpec.ContainerID, err)
+	}
+
+	return container.GetAtlasCidrBlock(), nil
+}
@@ -0,0 +1,180 @@
+package atlasproject
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+type fakeEC2 struct {
+	ec2iface.EC2API
+
+	routeTables []*ec2.RouteTable
+	accepted    []string
+	created     []string
+}
+
+func (f *fakeEC2) AcceptVpcPeeringConnectionWithContext(_ aws.Context, input *ec2.AcceptVpcPeeringConnectionInput, _ ...request.Option) (*ec2.AcceptVpcPeeringConnectionOutput, error) {
+	f.accepted = append(f.accepted, aws.StringValue(input.VpcPeeringConnectionId))
+	return &ec2.AcceptVpcPeeringConnectionOutput{}, nil
+}
+
+func (f *fakeEC2) DescribeRouteTablesPagesWithContext(_ aws.Context, _ *ec2.DescribeRouteTablesInput, fn func(*ec2.DescribeRouteTablesOutput, bool) bool, _ ...request.Option) error {
+	fn(&ec2.DescribeRouteTablesOutput{RouteTables: f.routeTables}, true)
+	return nil
+}
+
+func (f *fakeEC2) CreateRouteWithContext(_ aws.Context, input *ec2.CreateRouteInput, _ ...request.Option) (*ec2.CreateRouteOutput, error) {
+	f.created = append(f.created, aws.StringValue(input.RouteTableId))
+	return &ec2.CreateRouteOutput{}, nil
+}
+
+type fakePeeringAccepter struct {
+	accepted []string
+	routed   []string
+	err      error
+}
+
+func (f *fakePeeringAccepter) AcceptPeering(_ context.Context, _, connectionID string) error {
+	f.accepted = append(f.accepted, connectionID)
+	return f.err
+}
+
+func (f *fakePeeringAccepter) EnsureRoutes(_ context.Context, _, _, connectionID, destinationCIDR string, _ []string) error {
+	f.routed = append(f.routed, connectionID+":"+destinationCIDR)
+	return f.err
+}
+
+func TestEC2PeeringAccepter(t *testing.T) {
+	t.Run("should accept the peering connection", func(t *testing.T) {
+		client := &fakeEC2{}
+		accepter := &EC2PeeringAccepter{NewClient: func(string) ec2iface.EC2API { return client }}
+
+		require.NoError(t, accepter.AcceptPeering(context.Background(), "eu-west-1", "pcx-1"))
+		assert.Equal(t, []string{"pcx-1"}, client.accepted)
+	})
+
+	t.Run("should create the missing routes", func(t *testing.T) {
+		client := &fakeEC2{routeTables: []*ec2.RouteTable{
+			{RouteTableId: aws.String("rtb-1")},
+			{RouteTableId: aws.String("rtb-2"), Routes: []*ec2.Route{
+				{DestinationCidrBlock: aws.String("192.168.0.0/24"), VpcPeeringConnectionId: aws.String("pcx-1")},
+			}},
+		}}
+		accepter := &EC2PeeringAccepter{NewClient: func(string) ec2iface.EC2API { return client }}
+
+		require.NoError(t, accepter.EnsureRoutes(context.Background(), "eu-west-1", "vpc-1", "pcx-1", "192.168.0.0/24", nil))
+		assert.Equal(t, []string{"rtb-1"}, client.created)
+	})
+
+	t.Run("should leave the routes through another target alone", func(t *testing.T) {
+		client := &fakeEC2{routeTables: []*ec2.RouteTable{
+			{RouteTableId: aws.String("rtb-1"), Routes: []*ec2.Route{
+				{DestinationCidrBlock: aws.String("192.168.0.0/24"), TransitGatewayId: aws.String("tgw-1")},
+			}},
+			{RouteTableId: aws.String("rtb-2")},
+		}}
+		accepter := &EC2PeeringAccepter{NewClient: func(string) ec2iface.EC2API { return client }}
+
+		err := accepter.EnsureRoutes(context.Background(), "eu-west-1", "vpc-1", "pcx-1", "192.168.0.0/24", nil)
+
+		require.EqualError(t, err, "the route table rtb-1 already routes 192.168.0.0/24 through another target, remove that route or exclude the route table")
+		assert.Equal(t, []string{"rtb-2"}, client.created)
+	})
+
+	t.Run("should fail when the VPC has no route table", func(t *testing.T) {
+		accepter := &EC2PeeringAccepter{NewClient: func(string) ec2iface.EC2API { return &fakeEC2{} }}
+
+		err := accepter.EnsureRoutes(context.Background(), "eu-west-1", "vpc-1", "pcx-1", "192.168.0.0/24", nil)
+
+		require.EqualError(t, err, "no route table found for the VPC vpc-1")
+	})
+}
+
+func TestAcceptAWSPeers(t *testing.T) {
+	newPeer := func(vpcID string, autoAccept *mdbv1.AWSPeeringAutoAccept) mdbv1.NetworkPeer {
+		return mdbv1.NetworkPeer{
+			ProviderName:       provider.ProviderAWS,
+			AccepterRegionName: "eu-west-1",
+			VpcID:              vpcID,
+			AtlasCIDRBlock:     "192.168.0.0/24",
+			AWSAutoAccept:      autoAccept,
+		}
+	}
+	newStatus := func(vpcID, connectionID, statusName string) status.AtlasNetworkPeer {
+		return status.AtlasNetworkPeer{
+			ProviderName: provider.ProviderAWS,
+			Region:       "eu-west-1",
+			VPC:          vpcID,
+			ConnectionID: connectionID,
+			StatusName:   statusName,
+		}
+	}
+
+	t.Run("should accept the pending peers enabling it", func(t *testing.T) {
+		accepter := &fakePeeringAccepter{}
+
+		err := acceptAWSPeers(newContext(t), "project-id", accepter,
+			[]status.AtlasNetworkPeer{
+				newStatus("vpc-1", "pcx-1", StatusPendingAcceptance),
+				newStatus("vpc-2", "pcx-2", StatusPendingAcceptance),
+			},
+			[]mdbv1.NetworkPeer{
+				newPeer("vpc-1", &mdbv1.AWSPeeringAutoAccept{Enabled: true}),
+				newPeer("vpc-2", nil),
+			},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"pcx-1"}, accepter.accepted)
+		assert.Empty(t, accepter.routed)
+	})
+
+	t.Run("should update the route tables of the available peers", func(t *testing.T) {
+		accepter := &fakePeeringAccepter{}
+
+		err := acceptAWSPeers(newContext(t), "project-id", accepter,
+			[]status.AtlasNetworkPeer{
+				newStatus("vpc-1", "pcx-1", StatusReady),
+				newStatus("vpc-2", "pcx-2", StatusReady),
+			},
+			[]mdbv1.NetworkPeer{
+				newPeer("vpc-1", &mdbv1.AWSPeeringAutoAccept{Enabled: true, UpdateRouteTables: true}),
+				newPeer("vpc-2", &mdbv1.AWSPeeringAutoAccept{Enabled: true}),
+			},
+		)
+
+		require.NoError(t, err)
+		assert.Empty(t, accepter.accepted)
+		assert.Equal(t, []string{"pcx-1:192.168.0.0/24"}, accepter.routed)
+	})
+
+	t.Run("should fail without an accepter", func(t *testing.T) {
+		err := acceptAWSPeers(newContext(t), "project-id", nil,
+			[]status.AtlasNetworkPeer{newStatus("vpc-1", "pcx-1", StatusPendingAcceptance)},
+			[]mdbv1.NetworkPeer{newPeer("vpc-1", &mdbv1.AWSPeeringAutoAccept{Enabled: true})},
+		)
+
+		require.EqualError(t, err, "the auto-accept of the AWS peering connections is not configured in the operator")
+	})
+
+	t.Run("should return the errors of the accepter", func(t *testing.T) {
+		err := acceptAWSPeers(newContext(t), "project-id", &fakePeeringAccepter{err: errors.New("access denied")},
+			[]status.AtlasNetworkPeer{newStatus("vpc-1", "pcx-1", StatusPendingAcceptance)},
+			[]mdbv1.NetworkPeer{newPeer("vpc-1", &mdbv1.AWSPeeringAutoAccept{Enabled: true})},
+		)
+
+		require.EqualError(t, err, "access denied")
+	})
+}
@@ -3,14 +3,25 @@ package atlasproject
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
 	"go.mongodb.org/atlas/mongodbatlas"
+	"go.uber.org/zap/zaptest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
@@ -21,7 +32,7 @@ func TestCanNetworkPeeringReconcile(t *testing.T) {
 			Client:  &mongodbatlas.Client{},
 			Context: context.Background(),
 		}
-		result, err := canNetworkPeeringReconcile(workflowCtx, false, &mdbv1.AtlasProject{})
+		result, _, err := canNetworkPeeringReconcile(workflowCtx, false, &mdbv1.AtlasProject{}, nil)
 		require.NoError(t, err)
 		require.True(t, result)
 	})
@@ -33,7 +44,7 @@ func TestCanNetworkPeeringReconcile(t *testing.T) {
 			Client:  &mongodbatlas.Client{},
 			Context: context.Background(),
 		}
-		result, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject)
+		result, _, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject, nil)
 		require.EqualError(t, err, "invalid character 'w' looking for beginning of object key string")
 		require.False(t, result)
 	})
@@ -52,7 +63,7 @@ func TestCanNetworkPeeringReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject)
+		result, _, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject, nil)
 
 		require.EqualError(t, err, "failed to retrieve data")
 		require.False(t, result)
@@ -77,7 +88,7 @@ func TestCanNetworkPeeringReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject)
+		result, _, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject, nil)
 
 		require.EqualError(t, err, "failed to retrieve data")
 		require.False(t, result)
@@ -102,7 +113,7 @@ func TestCanNetworkPeeringReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject)
+		result, _, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject, nil)
 
 		require.NoError(t, err)
 		require.True(t, result)
@@ -159,7 +170,7 @@ func TestCanNetworkPeeringReconcile(t *testing.T) {
 				Client:  &atlasClient,
 				Context: context.Background(),
 			}
-			result, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject)
+			result, _, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject, nil)
 
 			require.NoError(t, err)
 			require.True(t, result)
@@ -212,7 +223,7 @@ func TestCanNetworkPeeringReconcile(t *testing.T) {
 				Client:  &atlasClient,
 				Context: context.Background(),
 			}
-			result, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject)
+			result, _, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject, nil)
 
 			require.NoError(t, err)
 			require.True(t, result)
@@ -270,7 +281,7 @@ func TestCanNetworkPeeringReconcile(t *testing.T) {
 				Client:  &atlasClient,
 				Context: context.Background(),
 			}
-			result, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject)
+			result, _, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject, nil)
 
 			require.NoError(t, err)
 			require.True(t, result)
@@ -328,7 +339,7 @@ func TestCanNetworkPeeringReconcile(t *testing.T) {
 				Client:  &atlasClient,
 				Context: context.Background(),
 			}
-			result, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject)
+			result, _, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject, nil)
 
 			require.NoError(t, err)
 			require.False(t, result)
@@ -381,7 +392,7 @@ func TestCanNetworkPeeringReconcile(t *testing.T) {
 				Client:  &atlasClient,
 				Context: context.Background(),
 			}
-			result, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject)
+			result, _, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject, nil)
 
 			require.NoError(t, err)
 			require.False(t, result)
@@ -439,7 +450,7 @@ func TestCanNetworkPeeringReconcile(t *testing.T) {
 				Client:  &atlasClient,
 				Context: context.Background(),
 			}
-			result, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject)
+			result, _, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject, nil)
 
 			require.NoError(t, err)
 			require.False(t, result)
@@ -497,7 +508,7 @@ func TestCanNetworkPeeringReconcile(t *testing.T) {
 				Client:  &atlasClient,
 				Context: context.Background(),
 			}
-			result, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject)
+			result, _, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject, nil)
 
 			require.NoError(t, err)
 			require.False(t, result)
@@ -549,7 +560,7 @@ func TestCanNetworkPeeringReconcile(t *testing.T) {
 				Client:  &atlasClient,
 				Context: context.Background(),
 			}
-			result, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject)
+			result, _, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject, nil)
 
 			require.NoError(t, err)
 			require.False(t, result)
@@ -607,7 +618,7 @@ func TestCanNetworkPeeringReconcile(t *testing.T) {
 				Client:  &atlasClient,
 				Context: context.Background(),
 			}
-			result, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject)
+			result, _, err := canNetworkPeeringReconcile(workflowCtx, true, akoProject, nil)
 
 			require.NoError(t, err)
 			require.False(t, result)
@@ -630,9 +641,10 @@ func TestEnsureNetworkPeers(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result := ensureNetworkPeers(workflowCtx, akoProject, true)
+		result := ensureNetworkPeers(workflowCtx, akoProject, true, nil, nil)
 
-		require.Equal(t, workflow.Terminate(workflow.Internal, "unable to resolve ownership for deletion protection: failed to retrieve data"), result)
+		require.Equal(t, workflow.Terminate(workflow.Internal, "unable to resolve ownership for deletion protection: failed to retrieve data").
+			WithCause(networkPeeringReconciler, "listPeeringContainers"), result)
 	})
 
 	t.Run("should failed to reconcile when unable to synchronize with Atlas", func(t *testing.T) {
@@ -685,7 +697,7 @@ func TestEnsureNetworkPeers(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result := ensureNetworkPeers(workflowCtx, akoProject, true)
+		result := ensureNetworkPeers(workflowCtx, akoProject, true, nil, nil)
 
 		require.Equal(
 			t,
@@ -697,3 +709,127 @@ func TestEnsureNetworkPeers(t *testing.T) {
 		)
 	})
 }
+
+func TestPinnedNetworkContainerIDs(t *testing.T) {
+	sch := runtime.NewScheme()
+	require.NoError(t, mdbv1.AddToScheme(sch))
+	newContainer := func(namespace, name, projectName, id string) *mdbv1.AtlasNetworkContainer {
+		return &mdbv1.AtlasNetworkContainer{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       mdbv1.AtlasNetworkContainerSpec{Project: common.ResourceRefNamespaced{Name: projectName}, Region: "US_EAST_1"},
+			Status:     status.AtlasNetworkContainerStatus{ID: id},
+		}
+	}
+
+	t.Run("should return the containers of the project managed by AtlasNetworkContainer resources", func(t *testing.T) {
+		r := &AtlasProjectReconciler{
+			Client: fake.NewClientBuilder().WithScheme(sch).WithObjects(
+				newContainer("ns", "pinned", "project", "container-1"),
+				newContainer("ns", "other-project", "other", "container-2"),
+				newContainer("other-ns", "other-namespace", "project", "container-3"),
+			).Build(),
+		}
+		workflowCtx := &workflow.Context{Context: context.Background()}
+
+		ids, err := r.pinnedNetworkContainerIDs(workflowCtx, mdbv1.NewProject("ns", "project", "project"))
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"container-1"}, ids)
+	})
+
+	t.Run("should pin the container of the region of a resource without a container id yet", func(t *testing.T) {
+		r := &AtlasProjectReconciler{
+			Client: fake.NewClientBuilder().WithScheme(sch).WithObjects(
+				newContainer("ns", "pinned", "project", "container-1"),
+				newContainer("ns", "not-recorded", "project", ""),
+			).Build(),
+		}
+		api := atlas.NewNetworkPeeringApiMock(t)
+		api.EXPECT().ListPeeringContainers(mock.Anything, "project-id").
+			Return(admin.ListPeeringContainersApiRequest{ApiService: api})
+		api.EXPECT().ListPeeringContainersExecute(mock.Anything).Return(
+			&admin.PaginatedCloudProviderContainer{Results: &[]admin.CloudProviderContainer{
+				{Id: admin.PtrString("container-1"), ProviderName: admin.PtrString("AWS"), RegionName: admin.PtrString("EU_WEST_1")},
+				{Id: admin.PtrString("container-2"), ProviderName: admin.PtrString("AWS"), RegionName: admin.PtrString("US_EAST_1")},
+				{Id: admin.PtrString("container-3"), ProviderName: admin.PtrString("AZURE"), Region: admin.PtrString("US_EAST_2")},
+			}}, nil, nil,
+		)
+		workflowCtx := &workflow.Context{Context: context.Background(), SdkClient: &admin.APIClient{NetworkPeeringApi: api}}
+		project := mdbv1.NewProject("ns", "project", "project")
+		project.Status.ID = "project-id"
+
+		ids, err := r.pinnedNetworkContainerIDs(workflowCtx, project)
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"container-1", "container-2"}, ids)
+	})
+}
+
+func TestWithoutPinnedContainers(t *testing.T) {
+	t.Run("should remove the pinned containers", func(t *testing.T) {
+		containers := []mongodbatlas.Container{{ID: "container-1"}, {ID: "container-2"}}
+
+		require.Equal(t, []mongodbatlas.Container{{ID: "container-2"}}, withoutPinnedContainers(containers, []string{"container-1"}))
+	})
+}
+
+func TestSyncNetworkPeerChunks(t *testing.T) {
+	listPeers := func(api *atlas.NetworkPeeringApiMock, count int) {
+		peers := make([]admin.BaseNetworkPeeringConnectionSettings, 0, count)
+		for i := 0; i < count; i++ {
+			peers = append(peers, admin.BaseNetworkPeeringConnectionSettings{
+				Id:           admin.PtrString(fmt.Sprintf("peer-%d", i)),
+				ProviderName: admin.PtrString(string(provider.ProviderAWS)),
+				VpcId:        admin.PtrString(fmt.Sprintf("vpc-%d", i)),
+				StatusName:   admin.PtrString(StatusReady),
+			})
+		}
+		api.EXPECT().ListPeeringConnectionsWithParams(mock.Anything, mock.Anything).Return(admin.ListPeeringConnectionsApiRequest{ApiService: api})
+		api.EXPECT().ListPeeringConnectionsExecute(mock.Anything).Return(&admin.PaginatedContainerPeer{Results: &peers}, nil, nil).Once()
+		api.EXPECT().ListPeeringConnectionsExecute(mock.Anything).Return(&admin.PaginatedContainerPeer{}, nil, nil).Twice()
+	}
+
+	t.Run("should delete the network peers over several reconciliations", func(t *testing.T) {
+		api := atlas.NewNetworkPeeringApiMock(t)
+		listPeers(api, syncChunkSize+5)
+		api.EXPECT().DeletePeeringConnection(mock.Anything, "project-id", mock.Anything).Return(admin.DeletePeeringConnectionApiRequest{ApiService: api})
+		api.EXPECT().DeletePeeringConnectionExecute(mock.Anything).Return(nil, nil, nil).Times(syncChunkSize)
+		workflowCtx := &workflow.Context{
+			SdkClient: &admin.APIClient{NetworkPeeringApi: api},
+			Context:   context.Background(),
+			Log:       zaptest.NewLogger(t).Sugar(),
+		}
+
+		result, condition := SyncNetworkPeer(workflowCtx, "project-id", nil, nil, nil, nil)
+
+		assert.Equal(t, status.NetworkPeerReadyType, condition)
+		assert.True(t, result.IsInProgress())
+		assert.Equal(t, "synchronized 0 of 0 networkPeers, 5 left to delete", result.GetMessage())
+		assert.Equal(
+			t,
+			[]status.ResourceSyncProgress{{Resource: networkPeersResource, PendingDeletions: 5}},
+			syncedProject(workflowCtx).Status.SyncProgress,
+		)
+	})
+
+	t.Run("should pause the synchronization when rate limited by Atlas", func(t *testing.T) {
+		rateLimited := &admin.GenericOpenAPIError{}
+		rateLimited.SetModel(admin.ApiError{Error: admin.PtrInt(http.StatusTooManyRequests)})
+		api := atlas.NewNetworkPeeringApiMock(t)
+		listPeers(api, 3)
+		api.EXPECT().DeletePeeringConnection(mock.Anything, "project-id", mock.Anything).Return(admin.DeletePeeringConnectionApiRequest{ApiService: api})
+		api.EXPECT().DeletePeeringConnectionExecute(mock.Anything).Return(nil, nil, nil).Once()
+		api.EXPECT().DeletePeeringConnectionExecute(mock.Anything).Return(nil, nil, rateLimited).Once()
+		workflowCtx := &workflow.Context{
+			SdkClient: &admin.APIClient{NetworkPeeringApi: api},
+			Context:   context.Background(),
+			Log:       zaptest.NewLogger(t).Sugar(),
+		}
+
+		result, _ := SyncNetworkPeer(workflowCtx, "project-id", nil, nil, nil, nil)
+
+		assert.True(t, result.IsInProgress())
+		assert.Equal(t, "synchronized 0 of 0 networkPeers, 2 left to delete, paused by the Atlas rate limits", result.GetMessage())
+		assert.Equal(t, rateLimitedRetry, result.ReconcileResult().RequeueAfter)
+	})
+}
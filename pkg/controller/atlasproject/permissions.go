@@ -0,0 +1,72 @@
+package atlasproject
+
+import (
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// Operations of the project requiring specific roles of the Atlas API key
+const (
+	projectCreationOperation     = "project creation"
+	networkPeeringOperation      = "network peering"
+	privateEndpointsOperation    = "private endpoints"
+	cloudProviderAccessOperation = "cloud provider access"
+	encryptionAtRestOperation    = "encryption at rest"
+	integrationsOperation        = "third party integrations"
+	projectInvitationsOperation  = "project invitations"
+)
+
+// requiredAtlasRoles returns the roles the Atlas API key needs for the operations requested by the project spec
+func requiredAtlasRoles(project *mdbv1.AtlasProject) []atlas.RequiredRole {
+	if project.ID() == "" {
+		return []atlas.RequiredRole{
+			{Operation: projectCreationOperation, Roles: []string{atlas.OrgOwnerRole, atlas.OrgGroupCreatorRole}},
+		}
+	}
+
+	projectRoles := func(operation string, roles ...string) atlas.RequiredRole {
+		return atlas.RequiredRole{Operation: operation, ProjectID: project.ID(), Roles: roles}
+	}
+
+	var required []atlas.RequiredRole
+	if len(project.Spec.NetworkPeers) > 0 {
+		required = append(required, projectRoles(networkPeeringOperation, atlas.ProjectOwnerRole))
+	}
+	if len(project.Spec.PrivateEndpoints) > 0 {
+		required = append(required, projectRoles(privateEndpointsOperation, atlas.ProjectOwnerRole))
+	}
+	if len(project.Spec.CloudProviderAccessRoles) > 0 || len(project.Spec.CloudProviderIntegrations) > 0 {
+		required = append(required, projectRoles(cloudProviderAccessOperation, atlas.ProjectOwnerRole))
+	}
+	if project.Spec.EncryptionAtRest != nil {
+		required = append(required, projectRoles(encryptionAtRestOperation, atlas.ProjectOwnerRole))
+	}
+	if len(project.Spec.Integrations) > 0 {
+		required = append(required, projectRoles(integrationsOperation, atlas.ProjectOwnerRole))
+	}
+	if len(project.Spec.ProjectInvitations) > 0 {
+		required = append(required, projectRoles(projectInvitationsOperation, atlas.ProjectOwnerRole, atlas.ProjectUserAdminRole))
+	}
+
+	return required
+}
+
+// withAtlasPermissions runs the sub-reconciler unless the Atlas API key is missing the roles of its operation. Its
+// condition then reports the missing roles, and the sub-reconcilers which don't need them are still run
+func withAtlasPermissions(ctx *workflow.Context, missing []atlas.RequiredRole, operation string, conditionType status.ConditionType, step func() workflow.Result) func() workflow.Result {
+	return func() workflow.Result {
+		for _, requirement := range missing {
+			if requirement.Operation == operation {
+				result := workflow.Terminate(workflow.InsufficientAtlasPermissions, customresource.MissingRolesMessage(requirement))
+				ctx.SetConditionFromResult(conditionType, result)
+
+				return result
+			}
+		}
+
+		return step()
+	}
+}
@@ -0,0 +1,79 @@
+package atlasproject
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestRequiredAtlasRoles(t *testing.T) {
+	t.Run("should require the creation roles for a new project", func(t *testing.T) {
+		project := mdbv1.NewProject("ns", "project", "project")
+		project.Spec.NetworkPeers = []mdbv1.NetworkPeer{{}}
+
+		assert.Equal(
+			t,
+			[]atlas.RequiredRole{{Operation: projectCreationOperation, Roles: []string{atlas.OrgOwnerRole, atlas.OrgGroupCreatorRole}}},
+			requiredAtlasRoles(project),
+		)
+	})
+
+	t.Run("should accept the project user admin for the invitations", func(t *testing.T) {
+		project := mdbv1.NewProject("ns", "project", "project")
+		project.Status.ID = "project-id"
+		project.Spec.ProjectInvitations = []mdbv1.ProjectInvitation{{Username: "user@mongodb.com"}}
+
+		assert.Equal(
+			t,
+			[]atlas.RequiredRole{{
+				Operation: projectInvitationsOperation,
+				ProjectID: "project-id",
+				Roles:     []string{atlas.ProjectOwnerRole, atlas.ProjectUserAdminRole},
+			}},
+			requiredAtlasRoles(project),
+		)
+	})
+}
+
+func TestWithAtlasPermissions(t *testing.T) {
+	missing := []atlas.RequiredRole{{Operation: networkPeeringOperation, ProjectID: "project-id", Roles: []string{atlas.ProjectOwnerRole}}}
+
+	t.Run("should skip the sub-reconciler missing the roles of its operation", func(t *testing.T) {
+		workflowCtx := newContext(t)
+		ran := false
+
+		result := withAtlasPermissions(workflowCtx, missing, networkPeeringOperation, status.NetworkPeerReadyType, func() workflow.Result {
+			ran = true
+			return workflow.OK()
+		})()
+
+		assert.False(t, ran)
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.InsufficientAtlasPermissions, result.GetReason())
+		require.Len(t, workflowCtx.Conditions(), 1)
+		assert.Equal(t, status.NetworkPeerReadyType, workflowCtx.Conditions()[0].Type)
+		assert.Equal(t, corev1.ConditionFalse, workflowCtx.Conditions()[0].Status)
+		assert.Equal(t, "the Atlas API key is missing the roles: GROUP_OWNER on project project-id for network peering", workflowCtx.Conditions()[0].Message)
+	})
+
+	t.Run("should run the sub-reconcilers which don't need the missing roles", func(t *testing.T) {
+		workflowCtx := newContext(t)
+		ran := false
+
+		result := withAtlasPermissions(workflowCtx, missing, encryptionAtRestOperation, status.EncryptionAtRestReadyType, func() workflow.Result {
+			ran = true
+			return workflow.OK()
+		})()
+
+		assert.True(t, ran)
+		assert.True(t, result.IsOk())
+		assert.Empty(t, workflowCtx.Conditions())
+	})
+}
@@ -13,6 +13,8 @@ import (
 
 	"go.mongodb.org/atlas/mongodbatlas"
 
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nametemplate"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/set"
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
@@ -20,14 +22,52 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
 
-func ensurePrivateEndpoint(workflowCtx *workflow.Context, project *mdbv1.AtlasProject, protected bool) workflow.Result {
-	canReconcile, err := canPrivateEndpointReconcile(workflowCtx.Context, workflowCtx.Client, protected, project)
+const privateEndpointReconciler = "privateEndpoint"
+
+// reconcilePrivateEndpoints ensures the private endpoints, leaving alone the private endpoint services of the project
+// managed by AtlasPrivateEndpoint resources
+func (r *AtlasProjectReconciler) reconcilePrivateEndpoints(workflowCtx *workflow.Context, project *mdbv1.AtlasProject) workflow.Result {
+	pinnedServices, err := r.pinnedPrivateEndpointServiceIDs(workflowCtx.Context, project)
+	if err != nil {
+		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("failed to list the private endpoints: %s", err)).
+			WithCause(privateEndpointReconciler, "")
+		workflowCtx.SetConditionFromResult(status.PrivateEndpointReadyType, result)
+
+		return result
+	}
+
+	return ensurePrivateEndpoint(workflowCtx, project, r.SubObjectDeletionProtection, pinnedServices)
+}
+
+// pinnedPrivateEndpointServiceIDs returns the ids of the private endpoint services of the project managed by
+// AtlasPrivateEndpoint resources
+func (r *AtlasProjectReconciler) pinnedPrivateEndpointServiceIDs(ctx context.Context, project *mdbv1.AtlasProject) ([]string, error) {
+	privateEndpoints := &mdbv1.AtlasPrivateEndpointList{}
+	if err := r.Client.List(ctx, privateEndpoints); err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for i := range privateEndpoints.Items {
+		privateEndpoint := &privateEndpoints.Items[i]
+		if privateEndpoint.Status.ServiceID != "" && privateEndpoint.AtlasProjectObjectKey() == kube.ObjectKeyFromObject(project) {
+			ids = append(ids, privateEndpoint.Status.ServiceID)
+		}
+	}
+
+	return ids, nil
+}
+
+func ensurePrivateEndpoint(workflowCtx *workflow.Context, project *mdbv1.AtlasProject, protected bool, pinnedServices []string) workflow.Result {
+	canReconcile, differences, err := canPrivateEndpointReconcile(workflowCtx.Context, workflowCtx.Client, protected, project, pinnedServices)
 	if err != nil {
-		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err))
+		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err)).
+			WithCause(privateEndpointReconciler, workflow.AtlasOperation(err))
 		workflowCtx.SetConditionFromResult(status.PrivateEndpointReadyType, result)
 
 		return result
 	}
+	reportDeletionProtection(workflowCtx, "privateEndpoints", protected, canReconcile, differences)
 
 	if !canReconcile {
 		result := workflow.Terminate(
@@ -40,13 +80,30 @@ func ensurePrivateEndpoint(workflowCtx *workflow.Context, project *mdbv1.AtlasPr
 	}
 
 	specPEs := project.Spec.DeepCopy().PrivateEndpoints
+	if err = validateGCPEndpointGroups(specPEs); err != nil {
+		result := workflow.Terminate(workflow.ProjectPEInvalidSpec, err.Error()).WithoutRetry()
+		workflowCtx.SetConditionFromResult(status.PrivateEndpointReadyType, result)
+
+		return result
+	}
+
+	names, err := renderPrivateEndpointNames(project)
+	if err != nil {
+		result := workflow.Terminate(workflow.ProjectPENameTemplateInvalid, err.Error())
+		workflowCtx.SetConditionFromResult(status.PrivateEndpointReadyType, result)
+
+		return result
+	}
 
 	atlasPEs, err := getAllPrivateEndpoints(workflowCtx.Context, workflowCtx.Client, project.ID())
 	if err != nil {
-		return workflow.Terminate(workflow.Internal, err.Error())
+		return workflow.Terminate(workflow.Internal, err.Error()).WithCause(privateEndpointReconciler, workflow.AtlasOperation(err))
 	}
+	atlasPEs = withoutPinnedServices(atlasPEs, pinnedServices)
 
 	result, conditionType := syncPrivateEndpointsWithAtlas(workflowCtx, project.ID(), specPEs, atlasPEs)
+	// the names are applied once the private endpoints of the status are set
+	workflowCtx.EnsureStatusOption(status.AtlasProjectPrivateEndpointNamesOption(names))
 	if !result.IsOk() {
 		if conditionType == status.PrivateEndpointServiceReadyType {
 			workflowCtx.UnsetCondition(status.PrivateEndpointReadyType)
@@ -76,7 +133,8 @@ func ensurePrivateEndpoint(workflowCtx *workflow.Context, project *mdbv1.AtlasPr
 			workflowCtx.UnsetCondition(status.PrivateEndpointReadyType)
 			return serviceStatus
 		} else {
-			return workflow.Terminate(workflow.ProjectPEInterfaceIsNotReadyInAtlas, "Not All Interface Private Endpoint are fully configured")
+			return workflow.Terminate(workflow.ProjectPEInterfaceIsNotReadyInAtlas, "Not All Interface Private Endpoint are fully configured").
+				WithCause(privateEndpointReconciler, "")
 		}
 	}
 
@@ -86,19 +144,63 @@ func ensurePrivateEndpoint(workflowCtx *workflow.Context, project *mdbv1.AtlasPr
 	return interfaceStatus
 }
 
+// renderPrivateEndpointNames expands the name templates of the private endpoints, by the identifier of the private
+// endpoints
+func renderPrivateEndpointNames(project *mdbv1.AtlasProject) (map[string]string, error) {
+	names := map[string]string{}
+	rendered := map[string]int{}
+	for i, pe := range project.Spec.PrivateEndpoints {
+		if pe.Name == "" {
+			continue
+		}
+
+		values := nametemplate.NewValues()
+		values.Project = project.Spec.Name
+		values.Namespace = project.Namespace
+		values.Name = project.Name
+		values.Provider = string(pe.Provider)
+		values.Region = pe.Region
+		values.Index = i
+		values.Group = pe.EndpointGroupName
+
+		name, err := nametemplate.Generate(pe.Name, values, nametemplate.MaxLength)
+		if err != nil {
+			return nil, err
+		}
+		if j, ok := rendered[name]; ok {
+			return nil, fmt.Errorf("the private endpoints %d and %d are both named %q, use .Group or .Index to tell them apart", j, i, name)
+		}
+		rendered[name] = i
+		// the private endpoints sharing a service, e.g. the GCP endpoint groups of a region, report the first name
+		if _, ok := names[pe.Identifier().(string)]; !ok {
+			names[pe.Identifier().(string)] = name
+		}
+	}
+
+	return names, nil
+}
+
 func syncPrivateEndpointsWithAtlas(ctx *workflow.Context, projectID string, specPEs []mdbv1.PrivateEndpoint, atlasPEs []atlasPE) (workflow.Result, status.ConditionType) {
 	log := ctx.Log
 
 	log.Debugw("PE Connections", "atlasPEs", atlasPEs, "specPEs", specPEs)
 	endpointsToDelete := getEndpointsNotInSpec(specPEs, atlasPEs)
 	log.Debugf("Number of Private Endpoints to delete: %d", len(endpointsToDelete))
-	if result := deletePrivateEndpointsFromAtlas(ctx, projectID, endpointsToDelete); !result.IsOk() {
-		return result, status.PrivateEndpointServiceReadyType
+	if result, err := deletePrivateEndpointsFromAtlas(ctx, projectID, endpointsToDelete); !result.IsOk() {
+		return result.WithAtlasError(err), status.PrivateEndpointServiceReadyType
+	}
+
+	removingGroups, err := deleteGCPEndpointGroupsNotInSpec(ctx, projectID, specPEs, atlasPEs)
+	if err != nil {
+		return terminateWithError(ctx, status.PrivateEndpointReadyType, "Failed to delete GCP endpoint groups in Atlas", err)
 	}
 
 	endpointsToCreate, endpointCounts := getEndpointsNotInAtlas(specPEs, atlasPEs)
 	log.Debugf("Number of Private Endpoints to create: %d", len(endpointsToCreate))
 	newConnections, err := createPeServiceInAtlas(ctx, projectID, endpointsToCreate, endpointCounts)
+	if result, rejected := rejectedDuringMaintenance(ctx, err); rejected {
+		return result, status.PrivateEndpointServiceReadyType
+	}
 	if err != nil {
 		return terminateWithError(ctx, status.PrivateEndpointServiceReadyType, "Failed to create PE Service in Atlas", err)
 	}
@@ -106,6 +208,9 @@ func syncPrivateEndpointsWithAtlas(ctx *workflow.Context, projectID string, spec
 	endpointsToSync := getEndpointsIntersection(specPEs, atlasPEs)
 	log.Debugf("Number of Private Endpoints to sync: %d", len(endpointsToSync))
 	syncedConnections, err := syncPeInterfaceInAtlas(ctx, projectID, endpointsToSync)
+	if result, rejected := rejectedDuringMaintenance(ctx, err); rejected {
+		return result, status.PrivateEndpointReadyType
+	}
 	if err != nil {
 		return terminateWithError(ctx, status.PrivateEndpointReadyType, "Failed to sync PE Interface in Atlas", err)
 	}
@@ -117,6 +222,10 @@ func syncPrivateEndpointsWithAtlas(ctx *workflow.Context, projectID string, spec
 		return notReadyServiceResult, status.PrivateEndpointServiceReadyType
 	}
 
+	if removingGroups {
+		return workflow.InProgress(workflow.ProjectPEInterfaceIsNotReadyInAtlas, "GCP endpoint groups are being removed"), status.PrivateEndpointReadyType
+	}
+
 	return workflow.OK(), status.PrivateEndpointReadyType
 }
 
@@ -124,7 +233,8 @@ func getStatusForServices(ctx *workflow.Context, atlasPEs []atlasPE) workflow.Re
 	allAvailable, failureMessage := areServicesAvailableOrFailed(atlasPEs)
 	ctx.Log.Debugw("Get Status for Services", "allAvailable", allAvailable, "failureMessage", failureMessage)
 	if failureMessage != "" {
-		return workflow.Terminate(workflow.ProjectPEServiceIsNotReadyInAtlas, failureMessage)
+		return workflow.Terminate(workflow.ProjectPEServiceIsNotReadyInAtlas, failureMessage).
+			WithCause(privateEndpointReconciler, "getPrivateEndpointService")
 	}
 	if !allAvailable {
 		return notReadyServiceResult
@@ -147,12 +257,13 @@ func getStatusForInterfaces(ctx *workflow.Context, projectID string, specPEs []m
 
 			interfaceEndpoint, _, err := ctx.Client.PrivateEndpoints.GetOnePrivateEndpoint(ctx.Context, projectID, atlasPeService.ProviderName, atlasPeService.ID, interfaceEndpointID)
 			if err != nil {
-				return workflow.Terminate(workflow.Internal, err.Error())
+				return workflow.Terminate(workflow.Internal, err.Error()).WithCause(privateEndpointReconciler, "getPrivateEndpoint")
 			}
 
 			interfaceIsAvailable, interfaceFailureMessage := checkIfInterfaceIsAvailable(interfaceEndpoint)
 			if interfaceFailureMessage != "" {
-				return workflow.Terminate(workflow.ProjectPEInterfaceIsNotReadyInAtlas, interfaceFailureMessage)
+				return workflow.Terminate(workflow.ProjectPEInterfaceIsNotReadyInAtlas, interfaceFailureMessage).
+					WithCause(privateEndpointReconciler, "getPrivateEndpoint")
 			}
 			if !interfaceIsAvailable {
 				return notReadyInterfaceResult
@@ -225,7 +336,7 @@ func getAllPrivateEndpoints(ctx context.Context, client *mongodbatlas.Client, pr
 	for _, p := range providers {
 		atlasPeConnections, _, err := client.PrivateEndpoints.List(ctx, projectID, p, &mongodbatlas.ListOptions{})
 		if err != nil {
-			return nil, err
+			return nil, workflow.NewAtlasOperationError("listPrivateEndpointServices", err)
 		}
 
 		for connIdx := range atlasPeConnections {
@@ -248,7 +359,7 @@ func createPeServiceInAtlas(ctx *workflow.Context, projectID string, endpointsTo
 			Region:       pe.Region,
 		})
 		if err != nil {
-			return newConnections, err
+			return newConnections, workflow.NewAtlasOperationError("createPrivateEndpointService", err)
 		}
 
 		conn.ProviderName = string(pe.Provider)
@@ -286,7 +397,7 @@ func syncPeInterfaceInAtlas(ctx *workflow.Context, projectID string, endpointsTo
 			if err != nil {
 				ctx.Log.Debugw("failed to create PE Interface", "error", err)
 				if response.StatusCode == http.StatusBadRequest || response.StatusCode == http.StatusConflict {
-					return syncedEndpoints, err
+					return syncedEndpoints, workflow.NewAtlasOperationError("createPrivateEndpoint", err)
 				}
 			}
 		}
@@ -305,7 +416,9 @@ func endpointNeedsUpdating(specPeService mdbv1.PrivateEndpoint, atlasPeService a
 		case provider.ProviderAWS, provider.ProviderAzure:
 			return !slices.Contains(atlasPeService.InterfaceEndpointIDs(), specPeService.ID)
 		case provider.ProviderGCP:
-			return !slices.Contains(atlasPeService.InterfaceEndpointIDs(), specPeService.EndpointGroupName) || len(atlasPeService.ServiceAttachmentNames) != len(specPeService.Endpoints)
+			// a region may have several endpoint groups, so the number of service attachments doesn't match the
+			// endpoints of each of them
+			return !slices.Contains(atlasPeService.InterfaceEndpointIDs(), specPeService.EndpointGroupName)
 		}
 	}
 
@@ -326,19 +439,19 @@ func endpointDefinedInSpec(specEndpoint mdbv1.PrivateEndpoint) bool {
 	return specEndpoint.ID != "" || specEndpoint.EndpointGroupName != ""
 }
 
-func DeleteAllPrivateEndpoints(ctx *workflow.Context, projectID string) workflow.Result {
+func DeleteAllPrivateEndpoints(ctx *workflow.Context, projectID string) (workflow.Result, error) {
 	atlasPEs, err := getAllPrivateEndpoints(ctx.Context, ctx.Client, projectID)
 	if err != nil {
-		return workflow.Terminate(workflow.Internal, err.Error())
+		return workflow.Terminate(workflow.Internal, err.Error()).WithCause(privateEndpointReconciler, workflow.AtlasOperation(err)), err
 	}
 
 	endpointsToDelete := getEndpointsNotInSpec([]mdbv1.PrivateEndpoint{}, atlasPEs)
 	return deletePrivateEndpointsFromAtlas(ctx, projectID, endpointsToDelete)
 }
 
-func deletePrivateEndpointsFromAtlas(ctx *workflow.Context, projectID string, listsToRemove []atlasPE) workflow.Result {
+func deletePrivateEndpointsFromAtlas(ctx *workflow.Context, projectID string, listsToRemove []atlasPE) (workflow.Result, error) {
 	if len(listsToRemove) == 0 {
-		return workflow.OK()
+		return workflow.OK(), nil
 	}
 
 	for _, peService := range listsToRemove {
@@ -351,7 +464,8 @@ func deletePrivateEndpointsFromAtlas(ctx *workflow.Context, projectID string, li
 		if len(interfaceEndpointIDs) != 0 {
 			for _, interfaceEndpointID := range interfaceEndpointIDs {
 				if _, err := ctx.Client.PrivateEndpoints.DeleteOnePrivateEndpoint(ctx.Context, projectID, peService.ProviderName, peService.ID, interfaceEndpointID); err != nil {
-					return workflow.Terminate(workflow.ProjectPEInterfaceIsNotReadyInAtlas, "failed to delete Private Endpoint")
+					return workflow.Terminate(workflow.ProjectPEInterfaceIsNotReadyInAtlas, "failed to delete Private Endpoint").
+						WithCause(privateEndpointReconciler, "deletePrivateEndpoint"), err
 				}
 			}
 
@@ -359,13 +473,14 @@ func deletePrivateEndpointsFromAtlas(ctx *workflow.Context, projectID string, li
 		}
 
 		if _, err := ctx.Client.PrivateEndpoints.Delete(ctx.Context, projectID, peService.ProviderName, peService.ID); err != nil {
-			return workflow.Terminate(workflow.ProjectPEServiceIsNotReadyInAtlas, "failed to delete Private Endpoint Service")
+			return workflow.Terminate(workflow.ProjectPEServiceIsNotReadyInAtlas, "failed to delete Private Endpoint Service").
+				WithCause(privateEndpointReconciler, "deletePrivateEndpointService"), err
 		}
 
 		ctx.Log.Debugw("Removed Private Endpoint Service from Atlas as it's not specified in current AtlasProject", "provider", peService.ProviderName, "regionName", peService.RegionName)
 	}
 
-	return workflow.InProgress(workflow.ProjectPEServiceIsNotReadyInAtlas, "Private Endpoint is deleting")
+	return workflow.InProgress(workflow.ProjectPEServiceIsNotReadyInAtlas, "Private Endpoint is deleting"), nil
 }
 
 func convertAllToStatus(ctx *workflow.Context, projectID string, peList []atlasPE) (result []status.ProjectPrivateEndpoint) {
@@ -461,10 +576,11 @@ func checkIfInterfaceIsAvailable(interfaceEndpointConn *mongodbatlas.InterfaceEn
 		allAvailable = false
 	}
 
+	if message := failedForwardingRules(interfaceEndpointConn); message != "" {
+		return false, message
+	}
+
 	for _, endpoint := range interfaceEndpointConn.Endpoints {
-		if isFailed(endpoint.Status) {
-			return false, interfaceEndpointConn.ErrorMessage
-		}
 		if !isAvailable(endpoint.Status) {
 			allAvailable = false
 		}
@@ -487,7 +603,8 @@ func isFailed(status string) bool {
 
 func terminateWithError(ctx *workflow.Context, conditionType status.ConditionType, message string, err error) (workflow.Result, status.ConditionType) {
 	ctx.Log.Debugw(message, "error", err)
-	result := workflow.Terminate(workflow.ProjectPEServiceIsNotReadyInAtlas, err.Error()).WithoutRetry()
+	result := workflow.Terminate(workflow.ProjectPEServiceIsNotReadyInAtlas, err.Error()).WithoutRetry().
+		WithCause(privateEndpointReconciler, workflow.AtlasOperation(err))
 	return result, conditionType
 }
 
@@ -550,35 +667,56 @@ type intersectionPair struct {
 	atlas atlasPE
 }
 
-func canPrivateEndpointReconcile(ctx context.Context, atlasClient *mongodbatlas.Client, protected bool, akoProject *mdbv1.AtlasProject) (bool, error) {
+func canPrivateEndpointReconcile(ctx context.Context, atlasClient *mongodbatlas.Client, protected bool, akoProject *mdbv1.AtlasProject, pinnedServices []string) (bool, []string, error) {
 	if !protected {
-		return true, nil
+		return true, nil, nil
 	}
 
 	latestConfig := &mdbv1.AtlasProjectSpec{}
 	latestConfigString, ok := akoProject.Annotations[customresource.AnnotationLastAppliedConfiguration]
 	if ok {
 		if err := json.Unmarshal([]byte(latestConfigString), latestConfig); err != nil {
-			return false, err
+			return false, nil, err
 		}
 	}
 
 	list, err := getAllPrivateEndpoints(ctx, atlasClient, akoProject.ID())
 	if err != nil {
-		return false, err
+		return false, nil, err
 	}
+	list = withoutPinnedServices(list, pinnedServices)
 
 	if len(list) == 0 {
-		return true, nil
+		return true, nil, nil
 	}
 
 	diff, _ := getUniqueDifference(list, latestConfig.PrivateEndpoints)
 
 	if len(diff) == 0 {
-		return true, nil
+		return true, nil, nil
 	}
 
 	diff, _ = getUniqueDifference(list, akoProject.Spec.PrivateEndpoints)
+	if len(diff) == 0 {
+		return true, nil, nil
+	}
 
-	return len(diff) == 0, nil
+	return false, identifiableDifferences(list, akoProject.Spec.PrivateEndpoints), nil
+}
+
+// withoutPinnedServices removes the private endpoint services managed by AtlasPrivateEndpoint resources, which don't
+// belong to the spec of the project
+func withoutPinnedServices(atlasPEs []atlasPE, pinnedServices []string) []atlasPE {
+	if len(pinnedServices) == 0 {
+		return atlasPEs
+	}
+
+	result := make([]atlasPE, 0, len(atlasPEs))
+	for _, pe := range atlasPEs {
+		if !slices.Contains(pinnedServices, pe.ID) {
+			result = append(result, pe)
+		}
+	}
+
+	return result
 }
@@ -0,0 +1,125 @@
+package atlasproject
+
+import (
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+	"golang.org/x/exp/slices"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// maxGCPEndpoints is the maximum number of forwarding rules, and so of addresses, of a GCP endpoint group
+const maxGCPEndpoints = 50
+
+// validateGCPEndpointGroups checks the GCP endpoint groups of the spec. A region may list several endpoint groups,
+// all connected to the Private Service Connect service of the region, each with up to 50 forwarding rules
+func validateGCPEndpointGroups(specPEs []mdbv1.PrivateEndpoint) error {
+	groups := map[string]struct{}{}
+	for _, pe := range specPEs {
+		if pe.Provider != provider.ProviderGCP || pe.EndpointGroupName == "" {
+			continue
+		}
+
+		key := fmt.Sprintf("%s/%s", pe.Identifier(), pe.EndpointGroupName)
+		if _, ok := groups[key]; ok {
+			return fmt.Errorf("the endpoint group %s is listed more than once for the region %s", pe.EndpointGroupName, pe.Region)
+		}
+		groups[key] = struct{}{}
+
+		if pe.GCPProjectID == "" {
+			return fmt.Errorf("the gcpProjectId of the endpoint group %s is required", pe.EndpointGroupName)
+		}
+		if len(pe.Endpoints) == 0 || len(pe.Endpoints) > maxGCPEndpoints {
+			return fmt.Errorf("the endpoint group %s must have between 1 and %d endpoints, it has %d", pe.EndpointGroupName, maxGCPEndpoints, len(pe.Endpoints))
+		}
+
+		names := map[string]struct{}{}
+		addresses := map[string]struct{}{}
+		for _, endpoint := range pe.Endpoints {
+			if _, ok := names[endpoint.EndpointName]; ok {
+				return fmt.Errorf("the endpoint %s is listed more than once in the endpoint group %s", endpoint.EndpointName, pe.EndpointGroupName)
+			}
+			if _, ok := addresses[endpoint.IPAddress]; ok {
+				return fmt.Errorf("the address %s is listed more than once in the endpoint group %s", endpoint.IPAddress, pe.EndpointGroupName)
+			}
+			names[endpoint.EndpointName] = struct{}{}
+			addresses[endpoint.IPAddress] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// deleteGCPEndpointGroupsNotInSpec removes the endpoint groups of the GCP services of the spec which are no longer
+// listed in it. It returns true if any endpoint group is being removed
+func deleteGCPEndpointGroupsNotInSpec(ctx *workflow.Context, projectID string, specPEs []mdbv1.PrivateEndpoint, atlasPEs []atlasPE) (bool, error) {
+	removing := false
+	for _, pair := range getEndpointsIntersection(specPEs, atlasPEs) {
+		if pair.spec.Provider != provider.ProviderGCP {
+			continue
+		}
+
+		for _, group := range endpointGroupsNotInSpec(specPEs, pair.atlas) {
+			groupConn, _, err := ctx.Client.PrivateEndpoints.GetOnePrivateEndpoint(ctx.Context, projectID, string(provider.ProviderGCP), pair.atlas.ID, group)
+			if err != nil {
+				return removing, workflow.NewAtlasOperationError("getPrivateEndpoint", err)
+			}
+
+			removing = true
+			if isDeleting(groupConn.Status) {
+				continue
+			}
+
+			if _, err = ctx.Client.PrivateEndpoints.DeleteOnePrivateEndpoint(ctx.Context, projectID, string(provider.ProviderGCP), pair.atlas.ID, group); err != nil {
+				return removing, workflow.NewAtlasOperationError("deletePrivateEndpoint", err)
+			}
+			ctx.Log.Debugw("Removed the GCP endpoint group from Atlas as it's not specified in current AtlasProject", "endpointGroupName", group, "regionName", pair.atlas.RegionName)
+		}
+	}
+
+	return removing, nil
+}
+
+// endpointGroupsNotInSpec returns the endpoint groups of the GCP service which aren't listed for its region in the spec
+func endpointGroupsNotInSpec(specPEs []mdbv1.PrivateEndpoint, service atlasPE) []string {
+	var specGroups []string
+	for _, pe := range specPEs {
+		if pe.Identifier() == service.Identifier() {
+			specGroups = append(specGroups, pe.EndpointGroupName)
+		}
+	}
+
+	var groups []string
+	for _, group := range service.EndpointGroupNames {
+		if !slices.Contains(specGroups, group) && !slices.Contains(groups, group) {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups
+}
+
+// failedForwardingRules returns the message of the failed forwarding rules of a GCP endpoint group, or an empty string
+func failedForwardingRules(groupConn *mongodbatlas.InterfaceEndpointConnection) string {
+	var failed []string
+	for _, endpoint := range groupConn.Endpoints {
+		if isFailed(endpoint.Status) {
+			failed = append(failed, endpoint.EndpointName)
+		}
+	}
+
+	if len(failed) == 0 {
+		return ""
+	}
+
+	message := fmt.Sprintf("the forwarding rules %s of the endpoint group %s failed", strings.Join(failed, ", "), groupConn.EndpointGroupName)
+	if groupConn.ErrorMessage != "" {
+		message = fmt.Sprintf("%s: %s", message, groupConn.ErrorMessage)
+	}
+
+	return message
+}
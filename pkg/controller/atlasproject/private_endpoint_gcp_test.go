@@ -0,0 +1,136 @@
+package atlasproject
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func gcpEndpointGroup(group string, endpoints int) mdbv1.PrivateEndpoint {
+	pe := mdbv1.PrivateEndpoint{
+		Provider:          provider.ProviderGCP,
+		Region:            "europe-west1",
+		GCPProjectID:      "gcp-project",
+		EndpointGroupName: group,
+	}
+	for i := 0; i < endpoints; i++ {
+		pe.Endpoints = append(pe.Endpoints, mdbv1.GCPEndpoint{
+			EndpointName: fmt.Sprintf("%s-%d", group, i),
+			IPAddress:    fmt.Sprintf("10.0.0.%d", i),
+		})
+	}
+
+	return pe
+}
+
+func TestValidateGCPEndpointGroups(t *testing.T) {
+	t.Run("should accept several endpoint groups in a region", func(t *testing.T) {
+		assert.NoError(t, validateGCPEndpointGroups([]mdbv1.PrivateEndpoint{gcpEndpointGroup("group-1", 50), gcpEndpointGroup("group-2", 1)}))
+	})
+
+	t.Run("should reject an endpoint group listed twice in a region", func(t *testing.T) {
+		err := validateGCPEndpointGroups([]mdbv1.PrivateEndpoint{gcpEndpointGroup("group-1", 1), gcpEndpointGroup("group-1", 2)})
+
+		require.EqualError(t, err, "the endpoint group group-1 is listed more than once for the region europe-west1")
+	})
+
+	t.Run("should reject more than 50 endpoints", func(t *testing.T) {
+		err := validateGCPEndpointGroups([]mdbv1.PrivateEndpoint{gcpEndpointGroup("group-1", 51)})
+
+		require.EqualError(t, err, "the endpoint group group-1 must have between 1 and 50 endpoints, it has 51")
+	})
+
+	t.Run("should reject an address listed twice", func(t *testing.T) {
+		pe := gcpEndpointGroup("group-1", 2)
+		pe.Endpoints[1].IPAddress = pe.Endpoints[0].IPAddress
+
+		err := validateGCPEndpointGroups([]mdbv1.PrivateEndpoint{pe})
+
+		require.EqualError(t, err, "the address 10.0.0.0 is listed more than once in the endpoint group group-1")
+	})
+}
+
+func TestEndpointNeedsUpdatingGCP(t *testing.T) {
+	service := atlasPE{
+		ProviderName:           "GCP",
+		RegionName:             "EUROPE_WEST_1",
+		Status:                 "AVAILABLE",
+		ServiceAttachmentNames: make([]string, 50),
+		EndpointGroupNames:     []string{"group-1"},
+	}
+
+	t.Run("should not add again an endpoint group smaller than the service", func(t *testing.T) {
+		assert.False(t, endpointNeedsUpdating(gcpEndpointGroup("group-1", 1), service))
+	})
+
+	t.Run("should add a new endpoint group to the service of the region", func(t *testing.T) {
+		assert.True(t, endpointNeedsUpdating(gcpEndpointGroup("group-2", 1), service))
+	})
+}
+
+func TestDeleteGCPEndpointGroupsNotInSpec(t *testing.T) {
+	service := atlasPE{
+		ID:                 "service-id",
+		ProviderName:       "GCP",
+		RegionName:         "EUROPE_WEST_1",
+		EndpointGroupNames: []string{"group-1", "group-2", "group-3"},
+	}
+	var deleted []string
+	ctx := &workflow.Context{
+		Context: context.Background(),
+		Log:     zaptest.NewLogger(t).Sugar(),
+		Client: &mongodbatlas.Client{
+			PrivateEndpoints: &atlas.PrivateEndpointsClientMock{
+				GetOnePrivateEndpointFunc: func(projectID string, cloudProvider string, endpointServiceID string, privateEndpointID string) (*mongodbatlas.InterfaceEndpointConnection, *mongodbatlas.Response, error) {
+					if privateEndpointID == "group-3" {
+						return &mongodbatlas.InterfaceEndpointConnection{Status: "DELETING"}, nil, nil
+					}
+
+					return &mongodbatlas.InterfaceEndpointConnection{Status: "AVAILABLE"}, nil, nil
+				},
+				DeleteOnePrivateEndpointFunc: func(projectID string, cloudProvider string, endpointServiceID string, privateEndpointID string) (*mongodbatlas.Response, error) {
+					deleted = append(deleted, privateEndpointID)
+					return nil, nil
+				},
+			},
+		},
+	}
+
+	removing, err := deleteGCPEndpointGroupsNotInSpec(ctx, "project-id", []mdbv1.PrivateEndpoint{gcpEndpointGroup("group-1", 1)}, []atlasPE{service})
+
+	require.NoError(t, err)
+	assert.True(t, removing)
+	assert.Equal(t, []string{"group-2"}, deleted)
+}
+
+func TestFailedForwardingRules(t *testing.T) {
+	t.Run("should name the failed forwarding rules", func(t *testing.T) {
+		message := failedForwardingRules(&mongodbatlas.InterfaceEndpointConnection{
+			EndpointGroupName: "group-1",
+			ErrorMessage:      "quota exceeded",
+			Endpoints: []*mongodbatlas.GCPEndpoint{
+				{EndpointName: "rule-1", Status: "AVAILABLE"},
+				{EndpointName: "rule-2", Status: "FAILED"},
+				{EndpointName: "rule-3", Status: "FAILED"},
+			},
+		})
+
+		assert.Equal(t, "the forwarding rules rule-2, rule-3 of the endpoint group group-1 failed: quota exceeded", message)
+	})
+
+	t.Run("should be empty when no forwarding rule failed", func(t *testing.T) {
+		assert.Empty(t, failedForwardingRules(&mongodbatlas.InterfaceEndpointConnection{
+			Endpoints: []*mongodbatlas.GCPEndpoint{{EndpointName: "rule-1", Status: "INITIATING"}},
+		}))
+	})
+}
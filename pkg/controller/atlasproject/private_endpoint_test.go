@@ -12,6 +12,7 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
@@ -86,7 +87,7 @@ func TestGetEndpointsNotInSpec(t *testing.T) {
 
 func TestCanPrivateEndpointReconcile(t *testing.T) {
 	t.Run("should return true when subResourceDeletionProtection is disabled", func(t *testing.T) {
-		result, err := canPrivateEndpointReconcile(context.Background(), &mongodbatlas.Client{}, false, &mdbv1.AtlasProject{})
+		result, _, err := canPrivateEndpointReconcile(context.Background(), &mongodbatlas.Client{}, false, &mdbv1.AtlasProject{}, nil)
 		require.NoError(t, err)
 		require.True(t, result)
 	})
@@ -94,7 +95,7 @@ func TestCanPrivateEndpointReconcile(t *testing.T) {
 	t.Run("should return error when unable to deserialize last applied configuration", func(t *testing.T) {
 		akoProject := &mdbv1.AtlasProject{}
 		akoProject.WithAnnotations(map[string]string{customresource.AnnotationLastAppliedConfiguration: "{wrong}"})
-		result, err := canPrivateEndpointReconcile(context.Background(), &mongodbatlas.Client{}, true, akoProject)
+		result, _, err := canPrivateEndpointReconcile(context.Background(), &mongodbatlas.Client{}, true, akoProject, nil)
 		require.EqualError(t, err, "invalid character 'w' looking for beginning of object key string")
 		require.False(t, result)
 	})
@@ -109,7 +110,7 @@ func TestCanPrivateEndpointReconcile(t *testing.T) {
 		}
 		akoProject := &mdbv1.AtlasProject{}
 		akoProject.WithAnnotations(map[string]string{customresource.AnnotationLastAppliedConfiguration: "{}"})
-		result, err := canPrivateEndpointReconcile(context.Background(), &atlasClient, true, akoProject)
+		result, _, err := canPrivateEndpointReconcile(context.Background(), &atlasClient, true, akoProject, nil)
 
 		require.EqualError(t, err, "failed to retrieve data")
 		require.False(t, result)
@@ -125,7 +126,7 @@ func TestCanPrivateEndpointReconcile(t *testing.T) {
 		}
 		akoProject := &mdbv1.AtlasProject{}
 		akoProject.WithAnnotations(map[string]string{customresource.AnnotationLastAppliedConfiguration: "{}"})
-		result, err := canPrivateEndpointReconcile(context.Background(), &atlasClient, true, akoProject)
+		result, _, err := canPrivateEndpointReconcile(context.Background(), &atlasClient, true, akoProject, nil)
 
 		require.NoError(t, err)
 		require.True(t, result)
@@ -165,7 +166,7 @@ func TestCanPrivateEndpointReconcile(t *testing.T) {
 			},
 		}
 		akoProject.WithAnnotations(map[string]string{customresource.AnnotationLastAppliedConfiguration: "{\"privateEndpoints\":[{\"provider\":\"AWS\",\"region\":\"eu-west-2\"}]}"})
-		result, err := canPrivateEndpointReconcile(context.Background(), &atlasClient, true, akoProject)
+		result, _, err := canPrivateEndpointReconcile(context.Background(), &atlasClient, true, akoProject, nil)
 
 		require.NoError(t, err)
 		require.True(t, result)
@@ -210,7 +211,7 @@ func TestCanPrivateEndpointReconcile(t *testing.T) {
 			},
 		}
 		akoProject.WithAnnotations(map[string]string{customresource.AnnotationLastAppliedConfiguration: "{\"privateEndpoints\":[{\"provider\":\"AWS\",\"region\":\"eu-west-2\"}]}"})
-		result, err := canPrivateEndpointReconcile(context.Background(), &atlasClient, true, akoProject)
+		result, _, err := canPrivateEndpointReconcile(context.Background(), &atlasClient, true, akoProject, nil)
 
 		require.NoError(t, err)
 		require.True(t, result)
@@ -255,7 +256,7 @@ func TestCanPrivateEndpointReconcile(t *testing.T) {
 			},
 		}
 		akoProject.WithAnnotations(map[string]string{customresource.AnnotationLastAppliedConfiguration: "{\"privateEndpoints\":[{\"provider\":\"AWS\",\"region\":\"eu-west-2\"}]}"})
-		result, err := canPrivateEndpointReconcile(context.Background(), &atlasClient, true, akoProject)
+		result, _, err := canPrivateEndpointReconcile(context.Background(), &atlasClient, true, akoProject, nil)
 
 		require.NoError(t, err)
 		require.False(t, result)
@@ -276,9 +277,10 @@ func TestEnsurePrivateEndpoint(t *testing.T) {
 		workflowCtx := &workflow.Context{
 			Client: &atlasClient,
 		}
-		result := ensurePrivateEndpoint(workflowCtx, akoProject, true)
+		result := ensurePrivateEndpoint(workflowCtx, akoProject, true, nil)
 
-		require.Equal(t, workflow.Terminate(workflow.Internal, "unable to resolve ownership for deletion protection: failed to retrieve data"), result)
+		require.Equal(t, workflow.Terminate(workflow.Internal, "unable to resolve ownership for deletion protection: failed to retrieve data").
+			WithCause(privateEndpointReconciler, "listPrivateEndpointServices"), result)
 	})
 
 	t.Run("should failed to reconcile when unable to synchronize with Atlas", func(t *testing.T) {
@@ -323,7 +325,7 @@ func TestEnsurePrivateEndpoint(t *testing.T) {
 		workflowCtx := &workflow.Context{
 			Client: &atlasClient,
 		}
-		result := ensurePrivateEndpoint(workflowCtx, akoProject, true)
+		result := ensurePrivateEndpoint(workflowCtx, akoProject, true, nil)
 
 		require.Equal(
 			t,
@@ -335,3 +337,82 @@ func TestEnsurePrivateEndpoint(t *testing.T) {
 		)
 	})
 }
+
+func TestRenderPrivateEndpointNames(t *testing.T) {
+	project := mdbv1.NewProject("ns", "project", "atlas-project")
+
+	t.Run("should render the names by private endpoint", func(t *testing.T) {
+		project := project.DeepCopy()
+		project.Spec.PrivateEndpoints = []mdbv1.PrivateEndpoint{
+			{Provider: provider.ProviderAWS, Region: "US_EAST_1", Name: "{{ .Project }}-{{ .Region }}"},
+			{Provider: provider.ProviderAzure, Region: "eastus2"},
+		}
+
+		names, err := renderPrivateEndpointNames(project)
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{project.Spec.PrivateEndpoints[0].Identifier().(string): "atlas-project-US_EAST_1"}, names)
+	})
+
+	t.Run("should tell apart the endpoint groups of a region", func(t *testing.T) {
+		project := project.DeepCopy()
+		project.Spec.PrivateEndpoints = []mdbv1.PrivateEndpoint{
+			{Provider: provider.ProviderGCP, Region: "europe-west1", EndpointGroupName: "group-a", Name: "{{ .Project }}-{{ .Group }}"},
+			{Provider: provider.ProviderGCP, Region: "europe-west1", EndpointGroupName: "group-b", Name: "{{ .Project }}-{{ .Group }}"},
+		}
+
+		names, err := renderPrivateEndpointNames(project)
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{project.Spec.PrivateEndpoints[0].Identifier().(string): "atlas-project-group-a"}, names)
+	})
+
+	t.Run("should reject names rendered for several endpoints", func(t *testing.T) {
+		project := project.DeepCopy()
+		project.Spec.PrivateEndpoints = []mdbv1.PrivateEndpoint{
+			{Provider: provider.ProviderGCP, Region: "europe-west1", EndpointGroupName: "group-a", Name: "{{ .Project }}-{{ .Region }}"},
+			{Provider: provider.ProviderGCP, Region: "europe-west1", EndpointGroupName: "group-b", Name: "{{ .Project }}-{{ .Region }}"},
+		}
+
+		_, err := renderPrivateEndpointNames(project)
+
+		require.ErrorContains(t, err, `the private endpoints 0 and 1 are both named "atlas-project-europe-west1"`)
+	})
+
+	t.Run("should reject invalid templates", func(t *testing.T) {
+		project := project.DeepCopy()
+		project.Spec.PrivateEndpoints = []mdbv1.PrivateEndpoint{{Provider: provider.ProviderAWS, Region: "US_EAST_1", Name: "{{ .Deployment"}}
+
+		_, err := renderPrivateEndpointNames(project)
+
+		require.ErrorContains(t, err, "invalid name template")
+	})
+
+	t.Run("should set the names in the status", func(t *testing.T) {
+		projectStatus := &status.AtlasProjectStatus{PrivateEndpoints: []status.ProjectPrivateEndpoint{
+			{Provider: provider.ProviderAWS, Region: "us-east-1"},
+			{Provider: provider.ProviderAzure, Region: "eastus2"},
+		}}
+
+		names := map[string]string{projectStatus.PrivateEndpoints[0].Identifier().(string): "atlas-project-US_EAST_1"}
+		status.AtlasProjectPrivateEndpointNamesOption(names)(projectStatus)
+
+		assert.Equal(t, "atlas-project-US_EAST_1", projectStatus.PrivateEndpoints[0].Name)
+		assert.Empty(t, projectStatus.PrivateEndpoints[1].Name)
+	})
+}
+
+func TestWithoutPinnedServices(t *testing.T) {
+	atlasPEs := []atlasPE{
+		{ID: "service-1", ProviderName: "AWS"},
+		{ID: "service-2", ProviderName: "AZURE"},
+	}
+
+	t.Run("should keep every service when none is pinned", func(t *testing.T) {
+		assert.Equal(t, atlasPEs, withoutPinnedServices(atlasPEs, nil))
+	})
+
+	t.Run("should leave out the services managed by a private endpoint resource", func(t *testing.T) {
+		assert.Equal(t, []atlasPE{{ID: "service-2", ProviderName: "AZURE"}}, withoutPinnedServices(atlasPEs, []string{"service-1"}))
+	})
+}
@@ -2,6 +2,7 @@ package atlasproject
 
 import (
 	"errors"
+	"fmt"
 
 	"go.mongodb.org/atlas/mongodbatlas"
 
@@ -10,6 +11,8 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
 
+const projectReconciler = "project"
+
 // ensureProjectExists creates the project if it doesn't exist yet. Returns the project ID
 func (r *AtlasProjectReconciler) ensureProjectExists(ctx *workflow.Context, project *mdbv1.AtlasProject) (string, workflow.Result) {
 	// Try to find the project
@@ -18,6 +21,12 @@ func (r *AtlasProjectReconciler) ensureProjectExists(ctx *workflow.Context, proj
 		ctx.Log.Infow("Error", "err", err.Error())
 		var apiError *mongodbatlas.ErrorResponse
 		if errors.As(err, &apiError) && (apiError.ErrorCode == atlas.NotInGroup || apiError.ErrorCode == atlas.ResourceNotFound) {
+			if !project.Spec.CreationPolicy.AllowsCreation() {
+				return "", workflow.Terminate(
+					workflow.ProjectCreationPolicyNotMet,
+					fmt.Sprintf("the project %s doesn't exist in Atlas and the creation policy is %s", project.Spec.Name, project.Spec.CreationPolicy),
+				)
+			}
 			// Project doesn't exist? Try to create it
 			p = &mongodbatlas.Project{
 				OrgID:                     ctx.OrgID,
@@ -26,12 +35,28 @@ func (r *AtlasProjectReconciler) ensureProjectExists(ctx *workflow.Context, proj
 				RegionUsageRestrictions:   project.Spec.RegionUsageRestrictions,
 			}
 			if p, _, err = ctx.Client.Projects.Create(ctx.Context, p, &mongodbatlas.CreateProjectOptions{}); err != nil {
-				return "", workflow.Terminate(workflow.ProjectNotCreatedInAtlas, err.Error())
+				return "", workflow.Terminate(workflow.ProjectNotCreatedInAtlas, err.Error()).WithAtlasError(err).
+					WithCause(projectReconciler, "createProject")
 			}
 			ctx.Log.Infow("Created Atlas Project", "name", project.Spec.Name, "id", p.ID)
 		} else {
-			return "", workflow.Terminate(workflow.ProjectNotCreatedInAtlas, err.Error())
+			return "", workflow.Terminate(workflow.ProjectNotCreatedInAtlas, err.Error()).WithAtlasError(err).
+				WithCause(projectReconciler, "getProjectByName")
 		}
+	} else if p != nil && p.ID != project.ID() && !project.Spec.CreationPolicy.AllowsAcquisition() {
+		// the project was found in Atlas but this resource never reconciled it
+		return "", workflow.Terminate(
+			workflow.ProjectCreationPolicyNotMet,
+			fmt.Sprintf("the project %s already exists in Atlas and the creation policy is %s", project.Spec.Name, project.Spec.CreationPolicy),
+		)
+	}
+
+	// a project being deleted isn't checked so that it can always lose its finalizer
+	if p != nil && project.Spec.OrgID != "" && p.OrgID != project.Spec.OrgID && project.GetDeletionTimestamp().IsZero() {
+		return "", workflow.Terminate(
+			workflow.ProjectOrganizationMismatch,
+			fmt.Sprintf("the project %s belongs to the organization %s, not to %s", project.Spec.Name, p.OrgID, project.Spec.OrgID),
+		)
 	}
 
 	if p == nil || p.ID == "" {
@@ -41,3 +66,22 @@ func (r *AtlasProjectReconciler) ensureProjectExists(ctx *workflow.Context, proj
 
 	return p.ID, workflow.OK()
 }
+
+// ensureOrganization checks that the organization requested in the spec, if any, is the one of the API keys used to
+// reconcile the project. Without it, the organization of the API keys is used. A project being deleted isn't checked
+// so that it can always lose its finalizer
+func ensureOrganization(credentialsOrgID string, project *mdbv1.AtlasProject) workflow.Result {
+	if project.Spec.OrgID == "" || project.Spec.OrgID == credentialsOrgID || !project.GetDeletionTimestamp().IsZero() {
+		return workflow.OK()
+	}
+
+	credentials := "the default operator connection secret"
+	if key := project.ConnectionSecretObjectKey(); key != nil {
+		credentials = fmt.Sprintf("the connection secret %s", key)
+	}
+
+	return workflow.Terminate(
+		workflow.ProjectOrganizationMismatch,
+		fmt.Sprintf("spec.orgId is %s but %s holds the API keys of the organization %s", project.Spec.OrgID, credentials, credentialsOrgID),
+	)
+}
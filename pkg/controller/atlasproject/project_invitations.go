@@ -0,0 +1,263 @@
+package atlasproject
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/timeutil"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const projectInvitationsReconciler = "projectInvitations"
+
+// ensureProjectInvitations invites the users of the spec to the project and tracks the invitations until they are
+// accepted. Expired invitations are sent again and the invitations removed from the spec are revoked. Only the
+// invitations previously applied by the operator are revoked, the ones sent by other means are left untouched
+func ensureProjectInvitations(workflowCtx *workflow.Context, project *mdbv1.AtlasProject) workflow.Result {
+	previous, err := previousProjectInvitations(project)
+	if err != nil {
+		result := workflow.Terminate(workflow.Internal, err.Error())
+		workflowCtx.SetConditionFromResult(status.ProjectInvitationsReadyType, result)
+
+		return result
+	}
+
+	if len(project.Spec.ProjectInvitations) == 0 && len(previous) == 0 {
+		workflowCtx.EnsureStatusOption(status.AtlasProjectInvitationsOption(nil))
+		workflowCtx.UnsetCondition(status.ProjectInvitationsReadyType)
+
+		return workflow.OK()
+	}
+
+	atlasInvitations, _, err := workflowCtx.SdkClient.ProjectsApi.ListProjectInvitations(workflowCtx.Context, project.ID()).Execute()
+	if err != nil {
+		result := workflow.Terminate(workflow.ProjectInvitationsNotReady, fmt.Sprintf("failed to retrieve project invitations: %s", err)).
+			WithCause(projectInvitationsReconciler, "listProjectInvitations")
+		workflowCtx.SetConditionFromResult(status.ProjectInvitationsReadyType, result)
+
+		return result
+	}
+
+	members, err := listProjectMembers(workflowCtx, project.ID())
+	if err != nil {
+		result := workflow.Terminate(workflow.ProjectInvitationsNotReady, fmt.Sprintf("failed to retrieve project users: %s", err)).
+			WithCause(projectInvitationsReconciler, "listProjectUsers")
+		workflowCtx.SetConditionFromResult(status.ProjectInvitationsReadyType, result)
+
+		return result
+	}
+
+	pending := make(map[string]admin.GroupInvitation, len(atlasInvitations))
+	for _, invitation := range atlasInvitations {
+		pending[strings.ToLower(invitation.GetUsername())] = invitation
+	}
+
+	desired := make(map[string]struct{}, len(project.Spec.ProjectInvitations))
+	for _, invitation := range project.Spec.ProjectInvitations {
+		desired[strings.ToLower(invitation.Username)] = struct{}{}
+	}
+
+	var errs []error
+	for _, invitation := range previous {
+		username := strings.ToLower(invitation.Username)
+		if _, ok := desired[username]; ok {
+			continue
+		}
+
+		if atlasInvitation, ok := pending[username]; ok {
+			_, _, err = workflowCtx.SdkClient.ProjectsApi.DeleteProjectInvitation(workflowCtx.Context, project.ID(), atlasInvitation.GetId()).Execute()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to revoke the invitation of %s: %w", invitation.Username, err))
+			}
+		}
+	}
+
+	previousStatuses := make(map[string]status.ProjectInvitationStatus, len(project.Status.ProjectInvitations))
+	for _, invitationStatus := range project.Status.ProjectInvitations {
+		previousStatuses[strings.ToLower(invitationStatus.Username)] = invitationStatus
+	}
+
+	statuses := make([]status.ProjectInvitationStatus, 0, len(project.Spec.ProjectInvitations))
+	pendingCount := 0
+	for i := range project.Spec.ProjectInvitations {
+		invitation := &project.Spec.ProjectInvitations[i]
+		username := strings.ToLower(invitation.Username)
+		invitationStatus := syncProjectInvitation(workflowCtx, project.ID(), invitation, members[username], pending[username], previousStatuses[username])
+
+		switch invitationStatus.State {
+		case status.ProjectInvitationFailed:
+			errs = append(errs, fmt.Errorf("failed to invite %s: %s", invitation.Username, invitationStatus.Error))
+		case status.ProjectInvitationPending:
+			pendingCount++
+		}
+		statuses = append(statuses, invitationStatus)
+	}
+	workflowCtx.EnsureStatusOption(status.AtlasProjectInvitationsOption(statuses))
+
+	if len(errs) > 0 {
+		result := workflow.Terminate(workflow.ProjectInvitationsNotReady, errors.Join(errs...).Error()).
+			WithCause(projectInvitationsReconciler, "")
+		workflowCtx.SetConditionFromResult(status.ProjectInvitationsReadyType, result)
+
+		return result
+	}
+
+	if len(project.Spec.ProjectInvitations) == 0 {
+		workflowCtx.UnsetCondition(status.ProjectInvitationsReadyType)
+
+		return workflow.OK()
+	}
+
+	if pendingCount > 0 {
+		workflowCtx.SetConditionTrueMsg(status.ProjectInvitationsReadyType, fmt.Sprintf("%d invitation(s) pending acceptance", pendingCount))
+	} else {
+		workflowCtx.SetConditionTrue(status.ProjectInvitationsReadyType)
+	}
+
+	return workflow.OK()
+}
+
+// syncProjectInvitation sends the invitation when the user is neither a member of the project nor invited, updates the
+// roles of a pending invitation and sends again an expired one
+func syncProjectInvitation(
+	workflowCtx *workflow.Context,
+	projectID string,
+	invitation *mdbv1.ProjectInvitation,
+	isMember bool,
+	atlasInvitation admin.GroupInvitation,
+	previousStatus status.ProjectInvitationStatus,
+) status.ProjectInvitationStatus {
+	invitationStatus := status.ProjectInvitationStatus{
+		Username: invitation.Username,
+		Resent:   previousStatus.Resent,
+	}
+
+	if isMember {
+		invitationStatus.State = status.ProjectInvitationAccepted
+
+		return invitationStatus
+	}
+
+	var err error
+	roles := invitation.RoleNames()
+	switch {
+	case atlasInvitation.GetId() != "" && isExpired(atlasInvitation):
+		workflowCtx.Log.Debugw("Sending again the expired project invitation", "username", invitation.Username)
+		if _, _, err = workflowCtx.SdkClient.ProjectsApi.DeleteProjectInvitation(workflowCtx.Context, projectID, atlasInvitation.GetId()).Execute(); err == nil {
+			atlasInvitation, err = createProjectInvitation(workflowCtx, projectID, invitation.Username, roles)
+			invitationStatus.Resent++
+		}
+	case atlasInvitation.GetId() != "":
+		if !sameRoles(atlasInvitation.GetRoles(), roles) {
+			var updated *admin.GroupInvitation
+			updated, _, err = workflowCtx.SdkClient.ProjectsApi.
+				UpdateProjectInvitationById(workflowCtx.Context, projectID, atlasInvitation.GetId(), &admin.GroupInvitationUpdateRequest{Roles: &roles}).
+				Execute()
+			if err == nil {
+				atlasInvitation = *updated
+			}
+		}
+	default:
+		// a previously pending invitation missing from Atlas expired and was removed
+		if previousStatus.State == status.ProjectInvitationPending {
+			invitationStatus.Resent++
+		}
+		atlasInvitation, err = createProjectInvitation(workflowCtx, projectID, invitation.Username, roles)
+	}
+
+	if err != nil {
+		invitationStatus.State = status.ProjectInvitationFailed
+		invitationStatus.Error = err.Error()
+
+		return invitationStatus
+	}
+
+	invitationStatus.State = status.ProjectInvitationPending
+	invitationStatus.InvitationID = atlasInvitation.GetId()
+	if expiresAt, ok := atlasInvitation.GetExpiresAtOk(); ok {
+		invitationStatus.ExpiresAt = timeutil.FormatISO8601(*expiresAt)
+	}
+
+	return invitationStatus
+}
+
+func createProjectInvitation(workflowCtx *workflow.Context, projectID, username string, roles []string) (admin.GroupInvitation, error) {
+	created, _, err := workflowCtx.SdkClient.ProjectsApi.
+		CreateProjectInvitation(workflowCtx.Context, projectID, &admin.GroupInvitationRequest{Username: &username, Roles: &roles}).
+		Execute()
+	if err != nil {
+		return admin.GroupInvitation{}, err
+	}
+
+	return *created, nil
+}
+
+// listProjectMembers returns the lower case usernames of the users of the project
+func listProjectMembers(workflowCtx *workflow.Context, projectID string) (map[string]bool, error) {
+	members := map[string]bool{}
+	for page := 1; ; page++ {
+		users, _, err := workflowCtx.SdkClient.ProjectsApi.ListProjectUsers(workflowCtx.Context, projectID).
+			PageNum(page).
+			ItemsPerPage(listItemsPerPage).
+			Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, user := range users.GetResults() {
+			members[strings.ToLower(user.GetUsername())] = true
+		}
+
+		if len(users.GetResults()) < listItemsPerPage {
+			return members, nil
+		}
+	}
+}
+
+// previousProjectInvitations returns the invitations of the last configuration applied by the operator
+func previousProjectInvitations(project *mdbv1.AtlasProject) ([]mdbv1.ProjectInvitation, error) {
+	lastApplied, ok := project.Annotations[customresource.AnnotationLastAppliedConfiguration]
+	if !ok {
+		return nil, nil
+	}
+
+	lastSpec := mdbv1.AtlasProjectSpec{}
+	if err := json.Unmarshal([]byte(lastApplied), &lastSpec); err != nil {
+		return nil, fmt.Errorf("failed to parse the last applied configuration: %w", err)
+	}
+
+	return lastSpec.ProjectInvitations, nil
+}
+
+func isExpired(invitation admin.GroupInvitation) bool {
+	expiresAt, ok := invitation.GetExpiresAtOk()
+
+	return ok && expiresAt.Before(time.Now())
+}
+
+func sameRoles(current, desired []string) bool {
+	if len(current) != len(desired) {
+		return false
+	}
+
+	current = append([]string(nil), current...)
+	desired = append([]string(nil), desired...)
+	sort.Strings(current)
+	sort.Strings(desired)
+	for i := range current {
+		if current[i] != desired[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,178 @@
+package atlasproject
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func invitationsProject(invitations ...mdbv1.ProjectInvitation) *mdbv1.AtlasProject {
+	return &mdbv1.AtlasProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "project", Namespace: "default"},
+		Spec:       mdbv1.AtlasProjectSpec{Name: "project", ProjectInvitations: invitations},
+		Status:     status.AtlasProjectStatus{ID: "project-id"},
+	}
+}
+
+func projectsAPIWithInvitations(t *testing.T, invitations []admin.GroupInvitation, usernames ...string) *atlas.ProjectsApiMock {
+	projectsAPI := atlas.NewProjectsApiMock(t)
+	projectsAPI.EXPECT().ListProjectInvitations(context.Background(), "project-id").
+		Return(admin.ListProjectInvitationsApiRequest{ApiService: projectsAPI})
+	projectsAPI.EXPECT().ListProjectInvitationsExecute(mock.Anything).
+		Return(invitations, nil, nil)
+
+	users := make([]admin.CloudAppUser, 0, len(usernames))
+	for _, username := range usernames {
+		users = append(users, admin.CloudAppUser{Username: username})
+	}
+	projectsAPI.EXPECT().ListProjectUsers(context.Background(), "project-id").
+		Return(admin.ListProjectUsersApiRequest{ApiService: projectsAPI})
+	projectsAPI.EXPECT().ListProjectUsersExecute(mock.Anything).
+		Return(&admin.PaginatedAppUser{Results: &users}, nil, nil)
+
+	return projectsAPI
+}
+
+func invitationsContext(t *testing.T, projectsAPI admin.ProjectsApi) *workflow.Context {
+	return &workflow.Context{
+		Context:   context.Background(),
+		Log:       zaptest.NewLogger(t).Sugar(),
+		SdkClient: &admin.APIClient{ProjectsApi: projectsAPI},
+	}
+}
+
+func TestEnsureProjectInvitations(t *testing.T) {
+	readOnly := mdbv1.ProjectInvitation{Username: "reader@example.com", Roles: []mdbv1.TeamRole{mdbv1.TeamRoleReadOnly}}
+
+	t.Run("should not call Atlas without invitations", func(t *testing.T) {
+		workflowCtx := invitationsContext(t, atlas.NewProjectsApiMock(t))
+
+		result := ensureProjectInvitations(workflowCtx, invitationsProject())
+
+		assert.True(t, result.IsOk())
+	})
+
+	t.Run("should invite a user and report the pending invitation", func(t *testing.T) {
+		expiresAt := time.Now().Add(24 * time.Hour)
+		projectsAPI := projectsAPIWithInvitations(t, nil)
+		projectsAPI.EXPECT().CreateProjectInvitation(
+			context.Background(),
+			"project-id",
+			&admin.GroupInvitationRequest{Username: pointer.MakePtr("reader@example.com"), Roles: &[]string{"GROUP_READ_ONLY"}},
+		).Return(admin.CreateProjectInvitationApiRequest{ApiService: projectsAPI})
+		projectsAPI.EXPECT().CreateProjectInvitationExecute(mock.Anything).
+			Return(&admin.GroupInvitation{Id: pointer.MakePtr("invitation-id"), ExpiresAt: &expiresAt}, nil, nil)
+		workflowCtx := invitationsContext(t, projectsAPI)
+		project := invitationsProject(readOnly)
+
+		result := ensureProjectInvitations(workflowCtx, project)
+
+		assert.True(t, result.IsOk())
+		project.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+		assert.Equal(t, status.ProjectInvitationPending, project.Status.ProjectInvitations[0].State)
+		assert.Equal(t, "invitation-id", project.Status.ProjectInvitations[0].InvitationID)
+		assert.NotEmpty(t, project.Status.ProjectInvitations[0].ExpiresAt)
+		assert.Equal(t, "1 invitation(s) pending acceptance", project.Status.Conditions[0].Message)
+	})
+
+	t.Run("should report the accepted invitation of a project member", func(t *testing.T) {
+		workflowCtx := invitationsContext(t, projectsAPIWithInvitations(t, nil, "Reader@example.com"))
+		project := invitationsProject(readOnly)
+
+		result := ensureProjectInvitations(workflowCtx, project)
+
+		assert.True(t, result.IsOk())
+		project.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+		assert.Equal(
+			t,
+			[]status.ProjectInvitationStatus{{Username: "reader@example.com", State: status.ProjectInvitationAccepted}},
+			project.Status.ProjectInvitations,
+		)
+		assert.Equal(t, corev1.ConditionTrue, project.Status.Conditions[0].Status)
+	})
+
+	t.Run("should send again an expired invitation", func(t *testing.T) {
+		expiredAt := time.Now().Add(-time.Hour)
+		projectsAPI := projectsAPIWithInvitations(
+			t,
+			[]admin.GroupInvitation{{Id: pointer.MakePtr("expired-id"), Username: pointer.MakePtr("reader@example.com"), Roles: &[]string{"GROUP_READ_ONLY"}, ExpiresAt: &expiredAt}},
+		)
+		projectsAPI.EXPECT().DeleteProjectInvitation(context.Background(), "project-id", "expired-id").
+			Return(admin.DeleteProjectInvitationApiRequest{ApiService: projectsAPI})
+		projectsAPI.EXPECT().DeleteProjectInvitationExecute(mock.Anything).
+			Return(nil, nil, nil)
+		projectsAPI.EXPECT().CreateProjectInvitation(context.Background(), "project-id", mock.Anything).
+			Return(admin.CreateProjectInvitationApiRequest{ApiService: projectsAPI})
+		projectsAPI.EXPECT().CreateProjectInvitationExecute(mock.Anything).
+			Return(&admin.GroupInvitation{Id: pointer.MakePtr("invitation-id")}, nil, nil)
+		workflowCtx := invitationsContext(t, projectsAPI)
+		project := invitationsProject(readOnly)
+
+		result := ensureProjectInvitations(workflowCtx, project)
+
+		assert.True(t, result.IsOk())
+		project.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+		assert.Equal(t, 1, project.Status.ProjectInvitations[0].Resent)
+		assert.Equal(t, "invitation-id", project.Status.ProjectInvitations[0].InvitationID)
+	})
+
+	t.Run("should update the roles of a pending invitation", func(t *testing.T) {
+		projectsAPI := projectsAPIWithInvitations(
+			t,
+			[]admin.GroupInvitation{{Id: pointer.MakePtr("invitation-id"), Username: pointer.MakePtr("reader@example.com"), Roles: &[]string{"GROUP_OWNER"}}},
+		)
+		projectsAPI.EXPECT().UpdateProjectInvitationById(
+			context.Background(),
+			"project-id",
+			"invitation-id",
+			&admin.GroupInvitationUpdateRequest{Roles: &[]string{"GROUP_READ_ONLY"}},
+		).Return(admin.UpdateProjectInvitationByIdApiRequest{ApiService: projectsAPI})
+		projectsAPI.EXPECT().UpdateProjectInvitationByIdExecute(mock.Anything).
+			Return(&admin.GroupInvitation{Id: pointer.MakePtr("invitation-id"), Roles: &[]string{"GROUP_READ_ONLY"}}, nil, nil)
+		workflowCtx := invitationsContext(t, projectsAPI)
+
+		result := ensureProjectInvitations(workflowCtx, invitationsProject(readOnly))
+
+		assert.True(t, result.IsOk())
+	})
+
+	t.Run("should revoke the invitations removed from the spec only", func(t *testing.T) {
+		projectsAPI := projectsAPIWithInvitations(
+			t,
+			[]admin.GroupInvitation{
+				{Id: pointer.MakePtr("removed-id"), Username: pointer.MakePtr("reader@example.com")},
+				{Id: pointer.MakePtr("other-id"), Username: pointer.MakePtr("other@example.com")},
+			},
+		)
+		projectsAPI.EXPECT().DeleteProjectInvitation(context.Background(), "project-id", "removed-id").
+			Return(admin.DeleteProjectInvitationApiRequest{ApiService: projectsAPI})
+		projectsAPI.EXPECT().DeleteProjectInvitationExecute(mock.Anything).
+			Return(nil, nil, nil)
+		workflowCtx := invitationsContext(t, projectsAPI)
+		project := invitationsProject()
+		project.Annotations = map[string]string{
+			customresource.AnnotationLastAppliedConfiguration: `{"name":"project","projectInvitations":[{"username":"reader@example.com","roles":["GROUP_READ_ONLY"]}]}`,
+		}
+
+		result := ensureProjectInvitations(workflowCtx, project)
+
+		assert.True(t, result.IsOk())
+		project.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+		assert.Empty(t, project.Status.ProjectInvitations)
+		assert.Empty(t, project.Status.Conditions)
+	})
+}
@@ -0,0 +1,64 @@
+package atlasproject
+
+import (
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const projectMigrationReconciler = "projectMigration"
+
+// ensureProjectMigration re-points the project to the organization of its connection secret once the project was
+// migrated to that organization in Atlas. The move must be confirmed by setting spec.orgId to the new organization,
+// and is refused unless the project is found in it: otherwise the operator would create a new project with the same
+// name. The teams recorded in the status belong to the previous organization, they are forgotten so that the teams are
+// found or created again in the new one. A project being deleted isn't migrated so that it can always lose its finalizer
+func (r *AtlasProjectReconciler) ensureProjectMigration(workflowCtx *workflow.Context, project *mdbv1.AtlasProject) workflow.Result {
+	previousOrgID := project.Status.OrgID
+	if project.ID() == "" || previousOrgID == "" || previousOrgID == workflowCtx.OrgID || !project.GetDeletionTimestamp().IsZero() {
+		return workflow.OK()
+	}
+
+	if project.Spec.OrgID != workflowCtx.OrgID {
+		return workflow.Terminate(
+			workflow.ProjectOrganizationMismatch,
+			fmt.Sprintf(
+				"the project was reconciled in the organization %s but the connection secret holds the API keys of the organization %s, set spec.orgId to %s once the project is migrated in Atlas",
+				previousOrgID,
+				workflowCtx.OrgID,
+				workflowCtx.OrgID,
+			),
+		)
+	}
+
+	atlasProject, _, err := workflowCtx.Client.Projects.GetOneProject(workflowCtx.Context, project.ID())
+	if err != nil {
+		var apiError *mongodbatlas.ErrorResponse
+		if !errors.As(err, &apiError) || (apiError.ErrorCode != atlas.NotInGroup && apiError.ErrorCode != atlas.ResourceNotFound) {
+			return workflow.Terminate(workflow.ProjectMigrationFailed, err.Error()).WithAtlasError(err).
+				WithCause(projectMigrationReconciler, "getProject")
+		}
+
+		atlasProject = nil
+	}
+
+	if atlasProject == nil || atlasProject.OrgID != workflowCtx.OrgID {
+		return workflow.Terminate(
+			workflow.ProjectMigrationFailed,
+			fmt.Sprintf("the project %s isn't part of the organization %s, migrate it in Atlas first", project.ID(), workflowCtx.OrgID),
+		)
+	}
+
+	workflowCtx.EnsureStatusOption(status.AtlasProjectSetTeamsOption(nil))
+
+	workflowCtx.Log.Infow("Atlas Project migrated to another organization", "from", previousOrgID, "to", workflowCtx.OrgID)
+	r.EventRecorder.Eventf(project, "Normal", "ProjectMigrated", "The project moved from the organization %s to %s", previousOrgID, workflowCtx.OrgID)
+
+	return workflow.OK()
+}
@@ -0,0 +1,129 @@
+package atlasproject
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	"go.uber.org/zap/zaptest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	atlasapi "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestEnsureProjectMigration(t *testing.T) {
+	newReconciler := func(t *testing.T, objects ...client.Object) *AtlasProjectReconciler {
+		sch := runtime.NewScheme()
+		require.NoError(t, mdbv1.AddToScheme(sch))
+
+		return &AtlasProjectReconciler{
+			Client:        fake.NewClientBuilder().WithScheme(sch).WithObjects(objects...).WithStatusSubresource(objects...).Build(),
+			Log:           zaptest.NewLogger(t).Sugar(),
+			EventRecorder: record.NewFakeRecorder(10),
+		}
+	}
+	newContext := func(t *testing.T, projects *atlas.ProjectsClientMock) *workflow.Context {
+		return &workflow.Context{
+			Client:  &mongodbatlas.Client{Projects: projects},
+			Context: context.Background(),
+			Log:     zaptest.NewLogger(t).Sugar(),
+			OrgID:   "org-b",
+		}
+	}
+	newProject := func(statusOrgID string) *mdbv1.AtlasProject {
+		project := mdbv1.NewProject("ns", "project", "project")
+		project.Status.ID = "project-id"
+		project.Status.OrgID = statusOrgID
+
+		return project
+	}
+	migratedProjects := func() *atlas.ProjectsClientMock {
+		return &atlas.ProjectsClientMock{
+			GetOneProjectFunc: func(projectID string) (*mongodbatlas.Project, *mongodbatlas.Response, error) {
+				return &mongodbatlas.Project{ID: projectID, Name: "project", OrgID: "org-b"}, nil, nil
+			},
+		}
+	}
+
+	t.Run("should do nothing when the organization didn't change", func(t *testing.T) {
+		result := newReconciler(t).ensureProjectMigration(newContext(t, &atlas.ProjectsClientMock{}), newProject("org-b"))
+
+		assert.True(t, result.IsOk())
+	})
+
+	t.Run("should do nothing when the organization of the project isn't known yet", func(t *testing.T) {
+		result := newReconciler(t).ensureProjectMigration(newContext(t, &atlas.ProjectsClientMock{}), newProject(""))
+
+		assert.True(t, result.IsOk())
+	})
+
+	t.Run("should require the new organization to be confirmed in the spec", func(t *testing.T) {
+		result := newReconciler(t).ensureProjectMigration(newContext(t, &atlas.ProjectsClientMock{}), newProject("org-a"))
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.ProjectOrganizationMismatch, result.GetReason())
+		assert.Equal(
+			t,
+			"the project was reconciled in the organization org-a but the connection secret holds the API keys of the organization org-b, set spec.orgId to org-b once the project is migrated in Atlas",
+			result.GetMessage(),
+		)
+	})
+
+	t.Run("should not migrate a project being deleted", func(t *testing.T) {
+		project := newProject("org-a")
+		project.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+		result := newReconciler(t).ensureProjectMigration(newContext(t, &atlas.ProjectsClientMock{}), project)
+
+		assert.True(t, result.IsOk())
+	})
+
+	t.Run("should fail when the project isn't part of the new organization", func(t *testing.T) {
+		projects := &atlas.ProjectsClientMock{
+			GetOneProjectFunc: func(projectID string) (*mongodbatlas.Project, *mongodbatlas.Response, error) {
+				return nil, nil, &mongodbatlas.ErrorResponse{HTTPCode: http.StatusNotFound, ErrorCode: atlasapi.ResourceNotFound}
+			},
+		}
+		project := newProject("org-a").WithOrgID("org-b")
+
+		result := newReconciler(t).ensureProjectMigration(newContext(t, projects), project)
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.ProjectMigrationFailed, result.GetReason())
+		assert.Equal(t, "the project project-id isn't part of the organization org-b, migrate it in Atlas first", result.GetMessage())
+	})
+
+	t.Run("should forget the teams of the previous organization without touching the shared teams", func(t *testing.T) {
+		team := &mdbv1.AtlasTeam{}
+		team.Name = "team"
+		team.Namespace = "ns"
+		team.Status.ID = "team-id"
+		project := newProject("org-a").WithOrgID("org-b")
+		project.Spec.Teams = []mdbv1.Team{{TeamRef: common.ResourceRefNamespaced{Name: "team"}}}
+		r := newReconciler(t, team)
+		workflowCtx := newContext(t, migratedProjects())
+		workflowCtx.EnsureStatusOption(status.AtlasProjectSetTeamsOption(&[]status.ProjectTeamStatus{{ID: "team-id"}}))
+
+		result := r.ensureProjectMigration(workflowCtx, project)
+
+		assert.True(t, result.IsOk())
+		sharedTeam := &mdbv1.AtlasTeam{}
+		require.NoError(t, r.Client.Get(context.Background(), client.ObjectKeyFromObject(team), sharedTeam))
+		assert.Equal(t, "team-id", sharedTeam.Status.ID)
+		assert.Empty(t, syncedProject(workflowCtx).Status.Teams)
+		assert.Contains(t, <-r.EventRecorder.(*record.FakeRecorder).Events, "The project moved from the organization org-a to org-b")
+	})
+}
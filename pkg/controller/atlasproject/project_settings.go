@@ -14,14 +14,18 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
 
+const projectSettingsReconciler = "projectSettings"
+
 func ensureProjectSettings(workflowCtx *workflow.Context, project *v1.AtlasProject, protected bool) (result workflow.Result) {
-	canReconcile, err := canProjectSettingsReconcile(workflowCtx, protected, project)
+	canReconcile, differences, err := canProjectSettingsReconcile(workflowCtx, protected, project)
 	if err != nil {
-		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err))
+		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err)).
+			WithCause(projectSettingsReconciler, workflow.AtlasOperation(err))
 		workflowCtx.SetConditionFromResult(status.ProjectSettingsReadyType, result)
 
 		return result
 	}
+	reportDeletionProtection(workflowCtx, "settings", protected, canReconcile, differences)
 
 	if !canReconcile {
 		result := workflow.Terminate(
@@ -52,12 +56,12 @@ func syncProjectSettings(ctx *workflow.Context, projectID string, project *v1.At
 
 	atlas, err := fetchSettings(ctx, projectID)
 	if err != nil {
-		return workflow.Terminate(workflow.ProjectSettingsReady, err.Error())
+		return workflow.Terminate(workflow.ProjectSettingsReady, err.Error()).WithCause(projectSettingsReconciler, "getProjectSettings")
 	}
 
 	if !areSettingsInSync(atlas, spec) {
 		if err := patchSettings(ctx, projectID, spec); err != nil {
-			return workflow.Terminate(workflow.ProjectSettingsReady, err.Error())
+			return workflow.Terminate(workflow.ProjectSettingsReady, err.Error()).WithCause(projectSettingsReconciler, "updateProjectSettings")
 		}
 	}
 
@@ -117,35 +121,43 @@ func isOneContainedInOther(one, other *v1.ProjectSettings) bool {
 	return true
 }
 
-func canProjectSettingsReconcile(workflowCtx *workflow.Context, protected bool, akoProject *v1.AtlasProject) (bool, error) {
+func canProjectSettingsReconcile(workflowCtx *workflow.Context, protected bool, akoProject *v1.AtlasProject) (bool, []string, error) {
 	if !protected {
-		return true, nil
+		return true, nil, nil
 	}
 
 	latestConfig := &v1.AtlasProjectSpec{}
 	latestConfigString, ok := akoProject.Annotations[customresource.AnnotationLastAppliedConfiguration]
 	if ok {
 		if err := json.Unmarshal([]byte(latestConfigString), latestConfig); err != nil {
-			return false, err
+			return false, nil, err
 		}
 	}
 
 	settings, _, err := workflowCtx.Client.Projects.GetProjectSettings(workflowCtx.Context, akoProject.ID())
 	if err != nil {
-		return false, err
+		return false, nil, workflow.NewAtlasOperationError("getProjectSettings", err)
 	}
 
 	if settings == nil {
-		return true, nil
+		return true, nil, nil
+	}
+
+	if areSettingsEqual(latestConfig.Settings, settings) || areSettingsEqual(akoProject.Spec.Settings, settings) {
+		return true, nil, nil
 	}
 
-	return areSettingsEqual(latestConfig.Settings, settings) ||
-		areSettingsEqual(akoProject.Spec.Settings, settings), nil
+	return false, settingsDifferences(akoProject.Spec.Settings, settings), nil
 }
 
 func areSettingsEqual(operator *v1.ProjectSettings, atlas *mongodbatlas.ProjectSettings) bool {
+	return len(settingsDifferences(operator, atlas)) == 0
+}
+
+// settingsDifferences lists the project settings of Atlas differing from the spec
+func settingsDifferences(operator *v1.ProjectSettings, atlas *mongodbatlas.ProjectSettings) []string {
 	if operator == nil && atlas == nil {
-		return true
+		return nil
 	}
 
 	if operator == nil {
@@ -176,10 +188,25 @@ func areSettingsEqual(operator *v1.ProjectSettings, atlas *mongodbatlas.ProjectS
 		operator.IsSchemaAdvisorEnabled = pointer.MakePtr(true)
 	}
 
-	return *operator.IsCollectDatabaseSpecificsStatisticsEnabled == *atlas.IsCollectDatabaseSpecificsStatisticsEnabled &&
-		*operator.IsDataExplorerEnabled == *atlas.IsDataExplorerEnabled &&
-		*operator.IsExtendedStorageSizesEnabled == *atlas.IsExtendedStorageSizesEnabled &&
-		*operator.IsPerformanceAdvisorEnabled == *atlas.IsPerformanceAdvisorEnabled &&
-		*operator.IsRealtimePerformancePanelEnabled == *atlas.IsRealtimePerformancePanelEnabled &&
-		*operator.IsSchemaAdvisorEnabled == *atlas.IsSchemaAdvisorEnabled
+	var differences []string
+	if *operator.IsCollectDatabaseSpecificsStatisticsEnabled != *atlas.IsCollectDatabaseSpecificsStatisticsEnabled {
+		differences = append(differences, "isCollectDatabaseSpecificsStatisticsEnabled")
+	}
+	if *operator.IsDataExplorerEnabled != *atlas.IsDataExplorerEnabled {
+		differences = append(differences, "isDataExplorerEnabled")
+	}
+	if *operator.IsExtendedStorageSizesEnabled != *atlas.IsExtendedStorageSizesEnabled {
+		differences = append(differences, "isExtendedStorageSizesEnabled")
+	}
+	if *operator.IsPerformanceAdvisorEnabled != *atlas.IsPerformanceAdvisorEnabled {
+		differences = append(differences, "isPerformanceAdvisorEnabled")
+	}
+	if *operator.IsRealtimePerformancePanelEnabled != *atlas.IsRealtimePerformancePanelEnabled {
+		differences = append(differences, "isRealtimePerformancePanelEnabled")
+	}
+	if *operator.IsSchemaAdvisorEnabled != *atlas.IsSchemaAdvisorEnabled {
+		differences = append(differences, "isSchemaAdvisorEnabled")
+	}
+
+	return differences
 }
@@ -22,7 +22,7 @@ func TestProjectSettingsReconcile(t *testing.T) {
 			Client:  &mongodbatlas.Client{},
 			Context: context.Background(),
 		}
-		result, err := canProjectSettingsReconcile(workflowCtx, false, &mdbv1.AtlasProject{})
+		result, _, err := canProjectSettingsReconcile(workflowCtx, false, &mdbv1.AtlasProject{})
 		require.NoError(t, err)
 		require.True(t, result)
 	})
@@ -34,7 +34,7 @@ func TestProjectSettingsReconcile(t *testing.T) {
 			Client:  &mongodbatlas.Client{},
 			Context: context.Background(),
 		}
-		result, err := canProjectSettingsReconcile(workflowCtx, true, akoProject)
+		result, _, err := canProjectSettingsReconcile(workflowCtx, true, akoProject)
 		require.EqualError(t, err, "invalid character 'w' looking for beginning of object key string")
 		require.False(t, result)
 	})
@@ -53,7 +53,7 @@ func TestProjectSettingsReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canProjectSettingsReconcile(workflowCtx, true, akoProject)
+		result, _, err := canProjectSettingsReconcile(workflowCtx, true, akoProject)
 
 		require.EqualError(t, err, "failed to retrieve data")
 		require.False(t, result)
@@ -73,7 +73,7 @@ func TestProjectSettingsReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canProjectSettingsReconcile(workflowCtx, true, akoProject)
+		result, _, err := canProjectSettingsReconcile(workflowCtx, true, akoProject)
 
 		require.NoError(t, err)
 		require.True(t, result)
@@ -122,7 +122,7 @@ func TestProjectSettingsReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canProjectSettingsReconcile(workflowCtx, true, akoProject)
+		result, _, err := canProjectSettingsReconcile(workflowCtx, true, akoProject)
 
 		require.NoError(t, err)
 		require.True(t, result)
@@ -172,7 +172,7 @@ func TestProjectSettingsReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canProjectSettingsReconcile(workflowCtx, true, akoProject)
+		result, _, err := canProjectSettingsReconcile(workflowCtx, true, akoProject)
 
 		require.NoError(t, err)
 		require.True(t, result)
@@ -222,7 +222,7 @@ func TestProjectSettingsReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canProjectSettingsReconcile(workflowCtx, true, akoProject)
+		result, _, err := canProjectSettingsReconcile(workflowCtx, true, akoProject)
 
 		require.NoError(t, err)
 		require.False(t, result)
@@ -246,7 +246,8 @@ func TestEnsureProjectSettings(t *testing.T) {
 		}
 		result := ensureProjectSettings(workflowCtx, akoProject, true)
 
-		require.Equal(t, workflow.Terminate(workflow.Internal, "unable to resolve ownership for deletion protection: failed to retrieve data"), result)
+		require.Equal(t, workflow.Terminate(workflow.Internal, "unable to resolve ownership for deletion protection: failed to retrieve data").
+			WithCause(projectSettingsReconciler, "getProjectSettings"), result)
 	})
 
 	t.Run("should failed to reconcile when unable to synchronize with Atlas", func(t *testing.T) {
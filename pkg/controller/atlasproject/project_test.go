@@ -0,0 +1,192 @@
+package atlasproject
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/atlas/mongodbatlas"
+	"go.uber.org/zap/zaptest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	atlasapi "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestEnsureOrganization(t *testing.T) {
+	t.Run("should use the organization of the credentials by default", func(t *testing.T) {
+		assert.True(t, ensureOrganization("org-a", mdbv1.NewProject("ns", "project", "project")).IsOk())
+	})
+
+	t.Run("should accept the organization of the credentials", func(t *testing.T) {
+		assert.True(t, ensureOrganization("org-a", mdbv1.NewProject("ns", "project", "project").WithOrgID("org-a")).IsOk())
+	})
+
+	t.Run("should reject the credentials of another organization", func(t *testing.T) {
+		project := mdbv1.NewProject("ns", "project", "project").WithOrgID("org-a").WithConnectionSecret("org-b-keys")
+
+		result := ensureOrganization("org-b", project)
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.ProjectOrganizationMismatch, result.GetReason())
+		assert.Equal(t, "spec.orgId is org-a but the connection secret ns/org-b-keys holds the API keys of the organization org-b", result.GetMessage())
+	})
+
+	t.Run("should name the default connection secret", func(t *testing.T) {
+		result := ensureOrganization("org-b", mdbv1.NewProject("ns", "project", "project").WithOrgID("org-a"))
+
+		assert.Contains(t, result.GetMessage(), "the default operator connection secret")
+	})
+
+	t.Run("should not check the organization of a project being deleted", func(t *testing.T) {
+		project := mdbv1.NewProject("ns", "project", "project").WithOrgID("org-a")
+		project.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+		assert.True(t, ensureOrganization("org-b", project).IsOk())
+	})
+}
+
+func TestEnsureProjectExists(t *testing.T) {
+	newContext := func(t *testing.T, projects *atlas.ProjectsClientMock) *workflow.Context {
+		return &workflow.Context{
+			Client:  &mongodbatlas.Client{Projects: projects},
+			Context: context.Background(),
+			Log:     zaptest.NewLogger(t).Sugar(),
+			OrgID:   "org-a",
+		}
+	}
+
+	t.Run("should create the project in the organization of the credentials", func(t *testing.T) {
+		projects := &atlas.ProjectsClientMock{
+			GetOneProjectByNameFunc: func(projectName string) (*mongodbatlas.Project, *mongodbatlas.Response, error) {
+				return nil, nil, &mongodbatlas.ErrorResponse{
+					Response:  &http.Response{StatusCode: http.StatusNotFound, Request: httptest.NewRequest(http.MethodGet, "/groups/byName/project", nil)},
+					HTTPCode:  http.StatusNotFound,
+					ErrorCode: atlasapi.ResourceNotFound,
+				}
+			},
+			CreateFunc: func(project *mongodbatlas.Project) (*mongodbatlas.Project, *mongodbatlas.Response, error) {
+				project.ID = "project-id"
+				return project, nil, nil
+			},
+		}
+		r := &AtlasProjectReconciler{}
+
+		projectID, result := r.ensureProjectExists(newContext(t, projects), mdbv1.NewProject("ns", "project", "project").WithOrgID("org-a"))
+
+		assert.True(t, result.IsOk())
+		assert.Equal(t, "project-id", projectID)
+		assert.Equal(t, "org-a", projects.CreateRequests[0].OrgID)
+	})
+
+	t.Run("should reject an existing project of another organization", func(t *testing.T) {
+		projects := &atlas.ProjectsClientMock{
+			GetOneProjectByNameFunc: func(projectName string) (*mongodbatlas.Project, *mongodbatlas.Response, error) {
+				return &mongodbatlas.Project{ID: "project-id", Name: projectName, OrgID: "org-b"}, nil, nil
+			},
+		}
+		r := &AtlasProjectReconciler{}
+
+		_, result := r.ensureProjectExists(newContext(t, projects), mdbv1.NewProject("ns", "project", "project").WithOrgID("org-a"))
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.ProjectOrganizationMismatch, result.GetReason())
+		assert.Equal(t, "the project project belongs to the organization org-b, not to org-a", result.GetMessage())
+	})
+
+	t.Run("should not check the organization of an existing project being deleted", func(t *testing.T) {
+		projects := &atlas.ProjectsClientMock{
+			GetOneProjectByNameFunc: func(projectName string) (*mongodbatlas.Project, *mongodbatlas.Response, error) {
+				return &mongodbatlas.Project{ID: "project-id", Name: projectName, OrgID: "org-b"}, nil, nil
+			},
+		}
+		project := mdbv1.NewProject("ns", "project", "project").WithOrgID("org-a")
+		project.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		r := &AtlasProjectReconciler{}
+
+		projectID, result := r.ensureProjectExists(newContext(t, projects), project)
+
+		assert.True(t, result.IsOk())
+		assert.Equal(t, "project-id", projectID)
+	})
+
+	t.Run("should accept an existing project of another organization when the organization isn't set", func(t *testing.T) {
+		projects := &atlas.ProjectsClientMock{
+			GetOneProjectByNameFunc: func(projectName string) (*mongodbatlas.Project, *mongodbatlas.Response, error) {
+				return &mongodbatlas.Project{ID: "project-id", Name: projectName, OrgID: "org-b"}, nil, nil
+			},
+		}
+		r := &AtlasProjectReconciler{}
+
+		projectID, result := r.ensureProjectExists(newContext(t, projects), mdbv1.NewProject("ns", "project", "project"))
+
+		assert.True(t, result.IsOk())
+		assert.Equal(t, "project-id", projectID)
+	})
+	t.Run("should not create the project when it must exist", func(t *testing.T) {
+		projects := &atlas.ProjectsClientMock{
+			GetOneProjectByNameFunc: func(projectName string) (*mongodbatlas.Project, *mongodbatlas.Response, error) {
+				return nil, nil, &mongodbatlas.ErrorResponse{
+					Response:  &http.Response{StatusCode: http.StatusNotFound, Request: httptest.NewRequest(http.MethodGet, "/groups/byName/project", nil)},
+					HTTPCode:  http.StatusNotFound,
+					ErrorCode: atlasapi.ResourceNotFound,
+				}
+			},
+		}
+		project := mdbv1.NewProject("ns", "project", "project")
+		project.Spec.CreationPolicy = common.CreationPolicyMustExist
+		r := &AtlasProjectReconciler{}
+
+		_, result := r.ensureProjectExists(newContext(t, projects), project)
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.ProjectCreationPolicyNotMet, result.GetReason())
+		assert.Equal(t, "the project project doesn't exist in Atlas and the creation policy is MustExist", result.GetMessage())
+		assert.Empty(t, projects.CreateRequests)
+	})
+
+	t.Run("should not bind to an existing project when it must not exist", func(t *testing.T) {
+		projects := &atlas.ProjectsClientMock{
+			GetOneProjectByNameFunc: func(projectName string) (*mongodbatlas.Project, *mongodbatlas.Response, error) {
+				return &mongodbatlas.Project{ID: "project-id", Name: projectName, OrgID: "org-a"}, nil, nil
+			},
+		}
+		project := mdbv1.NewProject("ns", "project", "project")
+		project.Spec.CreationPolicy = common.CreationPolicyMustNotExist
+		r := &AtlasProjectReconciler{}
+
+		_, result := r.ensureProjectExists(newContext(t, projects), project)
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.ProjectCreationPolicyNotMet, result.GetReason())
+		assert.Equal(t, "the project project already exists in Atlas and the creation policy is MustNotExist", result.GetMessage())
+	})
+
+	t.Run("should keep reconciling the project it created when it must not exist", func(t *testing.T) {
+		projects := &atlas.ProjectsClientMock{
+			GetOneProjectByNameFunc: func(projectName string) (*mongodbatlas.Project, *mongodbatlas.Response, error) {
+				return &mongodbatlas.Project{ID: "project-id", Name: projectName, OrgID: "org-a"}, nil, nil
+			},
+		}
+		project := mdbv1.NewProject("ns", "project", "project")
+		project.Spec.CreationPolicy = common.CreationPolicyMustNotExist
+		project.Status.ID = "project-id"
+		r := &AtlasProjectReconciler{}
+
+		projectID, result := r.ensureProjectExists(newContext(t, projects), project)
+
+		assert.True(t, result.IsOk())
+		assert.Equal(t, "project-id", projectID)
+	})
+}
+
+func newContext(t *testing.T) *workflow.Context {
+	return workflow.NewContext(zaptest.NewLogger(t).Sugar(), []status.Condition{}, context.Background())
+}
@@ -0,0 +1,85 @@
+package atlasproject
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/connectionsecret"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	// PrometheusScrapeSecretTypeLabelVal is the type of the Secret holding the Prometheus scraping configuration
+	PrometheusScrapeSecretTypeLabelVal = "prometheus-scrape"
+
+	prometheusDiscoveryURLKey = "discoveryURL"
+	prometheusSchemeKey       = "scheme"
+	prometheusUsernameKey     = "username"
+	prometheusPasswordKey     = "password"
+)
+
+// prometheusScrapeSecretName is the name of the Secret created in the project namespace with the Prometheus scraping
+// configuration
+func prometheusScrapeSecretName(akoProject *mdbv1.AtlasProject) string {
+	return fmt.Sprintf("%s-prometheus-scrape", akoProject.Name)
+}
+
+// ensurePrometheusScrapeSecret writes the discovery endpoint and the credentials of the enabled Prometheus integration
+// to a Secret owned by the project, so that Prometheus can be configured to scrape Atlas without copying them. The
+// Secret is removed once the integration is disabled or removed from the spec
+func (r *AtlasProjectReconciler) ensurePrometheusScrapeSecret(ctx *workflow.Context, akoProject *mdbv1.AtlasProject) workflow.Result {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: prometheusScrapeSecretName(akoProject), Namespace: akoProject.Namespace}}
+
+	integration, found := searchSpecIntegration(akoProject.Spec.Integrations, isPrometheusType)
+	if !found || !integration.Enabled {
+		if err := r.deleteOwnedObject(ctx, akoProject, secret); err != nil {
+			return workflow.Terminate(workflow.ProjectIntegrationInternal, fmt.Sprintf("failed to delete the Prometheus scrape secret: %s", err))
+		}
+		ctx.EnsureStatusOption(status.AtlasProjectPrometheusScrapeSecretOption(""))
+
+		return workflow.OK()
+	}
+
+	password, err := integration.PasswordRef.ReadPassword(ctx.Context, r.Client, akoProject.Namespace)
+	if err != nil {
+		return workflow.Terminate(workflow.ProjectIntegrationInternal, fmt.Sprintf("failed to read the Prometheus password: %s", err))
+	}
+
+	_, err = controllerutil.CreateOrUpdate(ctx.Context, r.Client, secret, func() error {
+		if secret.Labels == nil {
+			secret.Labels = map[string]string{}
+		}
+		secret.Labels[connectionsecret.TypeLabelKey] = PrometheusScrapeSecretTypeLabelVal
+		secret.Labels[connectionsecret.ProjectLabelKey] = akoProject.ID()
+		secret.Data = map[string][]byte{
+			prometheusDiscoveryURLKey: []byte(buildPrometheusDiscoveryURL(ctx.Client.BaseURL, akoProject.ID())),
+			prometheusSchemeKey:       []byte(integration.Scheme),
+			prometheusUsernameKey:     []byte(integration.UserName),
+			prometheusPasswordKey:     []byte(password),
+		}
+
+		return controllerutil.SetControllerReference(akoProject, secret, r.Scheme)
+	})
+	if err != nil {
+		return workflow.Terminate(workflow.ProjectIntegrationInternal, fmt.Sprintf("failed to write the Prometheus scrape secret: %s", err))
+	}
+	ctx.EnsureStatusOption(status.AtlasProjectPrometheusScrapeSecretOption(secret.Name))
+
+	return workflow.OK()
+}
+
+func searchSpecIntegration(integrations []project.Integration, filterFunc func(typeName string) bool) (project.Integration, bool) {
+	for _, integration := range integrations {
+		if filterFunc(integration.Type) {
+			return integration, true
+		}
+	}
+
+	return project.Integration{}, false
+}
@@ -0,0 +1,123 @@
+package atlasproject
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/connectionsecret"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestEnsurePrometheusScrapeSecret(t *testing.T) {
+	akoProject := mdbv1.NewProject("ns", "my-project", "my-project")
+	akoProject.Status.ID = "project-id"
+	withPrometheus := func(enabled bool) *mdbv1.AtlasProject {
+		withIntegration := akoProject.DeepCopy()
+		withIntegration.Spec.Integrations = []project.Integration{{
+			Type:        "PROMETHEUS",
+			UserName:    "prometheus",
+			PasswordRef: common.ResourceRefNamespaced{Name: "prometheus-password"},
+			Scheme:      "https",
+			Enabled:     enabled,
+		}}
+
+		return withIntegration
+	}
+	newReconciler := func(t *testing.T, objects ...client.Object) *AtlasProjectReconciler {
+		sch := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(sch))
+		require.NoError(t, mdbv1.AddToScheme(sch))
+		objects = append(objects, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "prometheus-password", Namespace: "ns"},
+			Data:       map[string][]byte{"password": []byte("secret")},
+		})
+
+		return &AtlasProjectReconciler{
+			Client: fake.NewClientBuilder().WithScheme(sch).WithObjects(objects...).Build(),
+			Scheme: sch,
+			Log:    zaptest.NewLogger(t).Sugar(),
+		}
+	}
+	newPrometheusContext := func(t *testing.T) *workflow.Context {
+		workflowCtx := newContext(t)
+		workflowCtx.Client = mongodbatlas.NewClient(nil)
+		workflowCtx.EnsureStatusOption(status.AtlasProjectPrometheusOption(&status.Prometheus{Scheme: "https"}))
+
+		return workflowCtx
+	}
+	scrapeSecretKey := client.ObjectKey{Name: "my-project-prometheus-scrape", Namespace: "ns"}
+
+	t.Run("should write the scrape secret when the integration is enabled", func(t *testing.T) {
+		r := newReconciler(t)
+		workflowCtx := newPrometheusContext(t)
+
+		assert.True(t, r.ensurePrometheusScrapeSecret(workflowCtx, withPrometheus(true)).IsOk())
+
+		secret := &corev1.Secret{}
+		require.NoError(t, r.Client.Get(context.Background(), scrapeSecretKey, secret))
+		assert.Equal(t, map[string][]byte{
+			"discoveryURL": []byte("https://cloud.mongodb.com/prometheus/v1.0/groups/project-id/discovery"),
+			"scheme":       []byte("https"),
+			"username":     []byte("prometheus"),
+			"password":     []byte("secret"),
+		}, secret.Data)
+		assert.Equal(t, PrometheusScrapeSecretTypeLabelVal, secret.Labels[connectionsecret.TypeLabelKey])
+		assert.Equal(t, "project-id", secret.Labels[connectionsecret.ProjectLabelKey])
+		require.Len(t, secret.OwnerReferences, 1)
+		assert.Equal(t, "my-project", secret.OwnerReferences[0].Name)
+
+		project := &mdbv1.AtlasProject{}
+		project.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+		assert.Equal(t, "my-project-prometheus-scrape", project.Status.Prometheus.ScrapeSecret)
+	})
+
+	t.Run("should fail when the password can't be read", func(t *testing.T) {
+		r := newReconciler(t)
+		withIntegration := withPrometheus(true)
+		withIntegration.Spec.Integrations[0].PasswordRef.Name = "missing"
+
+		result := r.ensurePrometheusScrapeSecret(newPrometheusContext(t), withIntegration)
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.ProjectIntegrationInternal, result.GetReason())
+	})
+
+	t.Run("should delete the scrape secret when the integration is disabled", func(t *testing.T) {
+		r := newReconciler(t)
+		require.True(t, r.ensurePrometheusScrapeSecret(newPrometheusContext(t), withPrometheus(true)).IsOk())
+
+		assert.True(t, r.ensurePrometheusScrapeSecret(newPrometheusContext(t), withPrometheus(false)).IsOk())
+
+		err := r.Client.Get(context.Background(), scrapeSecretKey, &corev1.Secret{})
+		assert.True(t, apiErrors.IsNotFound(err))
+	})
+
+	t.Run("should do nothing without the integration", func(t *testing.T) {
+		r := newReconciler(t)
+
+		assert.True(t, r.ensurePrometheusScrapeSecret(newPrometheusContext(t), akoProject).IsOk())
+	})
+
+	t.Run("should leave alone a Secret of the same name not owned by the project", func(t *testing.T) {
+		r := newReconciler(t, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: scrapeSecretKey.Name, Namespace: "ns"}})
+
+		assert.True(t, r.ensurePrometheusScrapeSecret(newPrometheusContext(t), akoProject).IsOk())
+
+		assert.NoError(t, r.Client.Get(context.Background(), scrapeSecretKey, &corev1.Secret{}))
+	})
+}
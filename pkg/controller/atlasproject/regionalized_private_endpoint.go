@@ -0,0 +1,154 @@
+package atlasproject
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const regionalizedPrivateEndpointReconciler = "regionalizedPrivateEndpoint"
+
+// ensureRegionalizedPrivateEndpoint enables or disables the regionalized private endpoint mode of the project. It runs
+// before the private endpoints are reconciled, as the mode must be enabled to create private endpoints in several
+// regions of a cloud provider
+func ensureRegionalizedPrivateEndpoint(workflowCtx *workflow.Context, project *mdbv1.AtlasProject, protected bool) workflow.Result {
+	if project.Spec.RegionalizedPrivateEndpoints == nil {
+		workflowCtx.UnsetCondition(status.RegionalizedPrivateEndpointReadyType)
+		return workflow.OK()
+	}
+
+	enabled := *project.Spec.RegionalizedPrivateEndpoints
+	if providers := multiRegionProviders(project.Spec.PrivateEndpoints, nil); !enabled && len(providers) != 0 {
+		result := workflow.Terminate(
+			workflow.ProjectRegionalizedPEInvalidSpec,
+			fmt.Sprintf("the regionalized private endpoint mode can't be disabled as the private endpoints of %s are in several regions", strings.Join(providers, ", ")),
+		).WithoutRetry()
+		workflowCtx.SetConditionFromResult(status.RegionalizedPrivateEndpointReadyType, result)
+
+		return result
+	}
+
+	setting, _, err := workflowCtx.Client.PrivateEndpoints.GetRegionalizedPrivateEndpointSetting(workflowCtx.Context, project.ID())
+	if err != nil {
+		result := workflow.Terminate(workflow.ProjectRegionalizedPENotReady, fmt.Sprintf("failed to get the regionalized private endpoint mode: %s", err)).
+			WithCause(regionalizedPrivateEndpointReconciler, "getRegionalizedPrivateEndpointSetting")
+		workflowCtx.SetConditionFromResult(status.RegionalizedPrivateEndpointReadyType, result)
+
+		return result
+	}
+
+	if setting.Enabled == enabled {
+		workflowCtx.SetConditionTrue(status.RegionalizedPrivateEndpointReadyType)
+		return workflow.OK()
+	}
+
+	canReconcile, differences, err := canRegionalizedPrivateEndpointReconcile(protected, project, setting.Enabled)
+	if err != nil {
+		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err)).
+			WithCause(regionalizedPrivateEndpointReconciler, "")
+		workflowCtx.SetConditionFromResult(status.RegionalizedPrivateEndpointReadyType, result)
+
+		return result
+	}
+	reportDeletionProtection(workflowCtx, "regionalizedPrivateEndpoints", protected, canReconcile, differences)
+
+	if !canReconcile {
+		result := workflow.Terminate(
+			workflow.AtlasDeletionProtection,
+			"unable to reconcile the regionalized private endpoint mode due to deletion protection being enabled. see https://dochub.mongodb.org/core/ako-deletion-protection for further information",
+		)
+		workflowCtx.SetConditionFromResult(status.RegionalizedPrivateEndpointReadyType, result)
+
+		return result
+	}
+
+	if !enabled {
+		atlasPEs, err := getAllPrivateEndpoints(workflowCtx.Context, workflowCtx.Client, project.ID())
+		if err != nil {
+			result := workflow.Terminate(workflow.ProjectRegionalizedPENotReady, fmt.Sprintf("failed to list the private endpoints: %s", err)).
+				WithCause(regionalizedPrivateEndpointReconciler, workflow.AtlasOperation(err))
+			workflowCtx.SetConditionFromResult(status.RegionalizedPrivateEndpointReadyType, result)
+
+			return result
+		}
+
+		// the private endpoints removed from the spec are deleted by the next step, the mode is disabled once they're gone
+		if providers := multiRegionProviders(nil, atlasPEs); len(providers) != 0 {
+			result := workflow.Terminate(
+				workflow.ProjectRegionalizedPENotReady,
+				fmt.Sprintf("the regionalized private endpoint mode can't be disabled while the private endpoints of %s in Atlas are in several regions", strings.Join(providers, ", ")),
+			)
+			workflowCtx.SetConditionFromResult(status.RegionalizedPrivateEndpointReadyType, result)
+
+			return result
+		}
+	}
+
+	if _, _, err = workflowCtx.Client.PrivateEndpoints.UpdateRegionalizedPrivateEndpointSetting(workflowCtx.Context, project.ID(), enabled); err != nil {
+		result := workflow.Terminate(workflow.ProjectRegionalizedPENotReady, fmt.Sprintf("failed to update the regionalized private endpoint mode: %s", err)).
+			WithCause(regionalizedPrivateEndpointReconciler, "toggleRegionalizedPrivateEndpointSetting")
+		workflowCtx.SetConditionFromResult(status.RegionalizedPrivateEndpointReadyType, result)
+
+		return result
+	}
+	workflowCtx.Log.Infow("Updated the regionalized private endpoint mode", "enabled", enabled)
+
+	workflowCtx.SetConditionTrue(status.RegionalizedPrivateEndpointReadyType)
+	return workflow.OK()
+}
+
+// multiRegionProviders returns the cloud providers, sorted, with private endpoints in more than one region, either in
+// the spec or in Atlas
+func multiRegionProviders(specPEs []mdbv1.PrivateEndpoint, atlasPEs []atlasPE) []string {
+	regions := map[string]map[string]struct{}{}
+	add := func(providerName, region string) {
+		if regions[providerName] == nil {
+			regions[providerName] = map[string]struct{}{}
+		}
+		regions[providerName][status.TransformRegionToID(region)] = struct{}{}
+	}
+
+	for _, pe := range specPEs {
+		add(string(pe.Provider), pe.Region)
+	}
+	for _, pe := range atlasPEs {
+		add(pe.ProviderName, pe.RegionName)
+	}
+
+	var providers []string
+	for providerName, providerRegions := range regions {
+		if len(providerRegions) > 1 {
+			providers = append(providers, providerName)
+		}
+	}
+	sort.Strings(providers)
+
+	return providers
+}
+
+func canRegionalizedPrivateEndpointReconcile(protected bool, akoProject *mdbv1.AtlasProject, atlasEnabled bool) (bool, []string, error) {
+	if !protected || !atlasEnabled {
+		return true, nil, nil
+	}
+
+	latestConfig := &mdbv1.AtlasProjectSpec{}
+	latestConfigString, ok := akoProject.Annotations[customresource.AnnotationLastAppliedConfiguration]
+	if ok {
+		if err := json.Unmarshal([]byte(latestConfigString), latestConfig); err != nil {
+			return false, nil, err
+		}
+	}
+
+	// the mode enabled in Atlas is only disabled by the operator if it was the one enabling it
+	if latestConfig.RegionalizedPrivateEndpoints != nil && *latestConfig.RegionalizedPrivateEndpoints {
+		return true, nil, nil
+	}
+
+	return false, []string{"regionalized mode: enabled in Atlas"}, nil
+}
@@ -0,0 +1,137 @@
+package atlasproject
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func regionalizedPrivateEndpointsClient(enabled bool, atlasPEs map[string][]mongodbatlas.PrivateEndpointConnection) *atlas.PrivateEndpointsClientMock {
+	return &atlas.PrivateEndpointsClientMock{
+		GetRegionalizedPrivateEndpointSettingFunc: func(projectID string) (*mongodbatlas.RegionalizedPrivateEndpointSetting, *mongodbatlas.Response, error) {
+			return &mongodbatlas.RegionalizedPrivateEndpointSetting{Enabled: enabled}, nil, nil
+		},
+		UpdateRegionalizedPrivateEndpointSettingFunc: func(projectID string, enabled bool) (*mongodbatlas.RegionalizedPrivateEndpointSetting, *mongodbatlas.Response, error) {
+			return &mongodbatlas.RegionalizedPrivateEndpointSetting{Enabled: enabled}, nil, nil
+		},
+		ListFunc: func(projectID, providerName string) ([]mongodbatlas.PrivateEndpointConnection, *mongodbatlas.Response, error) {
+			return atlasPEs[providerName], nil, nil
+		},
+	}
+}
+
+func regionalizedTestContext(t *testing.T, peClient *atlas.PrivateEndpointsClientMock) *workflow.Context {
+	return &workflow.Context{
+		Context: context.Background(),
+		Log:     zaptest.NewLogger(t).Sugar(),
+		Client:  &mongodbatlas.Client{PrivateEndpoints: peClient},
+	}
+}
+
+func TestEnsureRegionalizedPrivateEndpoint(t *testing.T) {
+	t.Run("should leave the mode alone when it isn't set in the spec", func(t *testing.T) {
+		peClient := regionalizedPrivateEndpointsClient(true, nil)
+		akoProject := &mdbv1.AtlasProject{}
+
+		result := ensureRegionalizedPrivateEndpoint(regionalizedTestContext(t, peClient), akoProject, false)
+
+		require.Equal(t, workflow.OK(), result)
+		assert.Empty(t, peClient.GetRegionalizedPrivateEndpointSettingRequests)
+	})
+
+	t.Run("should enable the mode", func(t *testing.T) {
+		peClient := regionalizedPrivateEndpointsClient(false, nil)
+		akoProject := &mdbv1.AtlasProject{
+			Spec: mdbv1.AtlasProjectSpec{RegionalizedPrivateEndpoints: pointer.MakePtr(true)},
+		}
+
+		result := ensureRegionalizedPrivateEndpoint(regionalizedTestContext(t, peClient), akoProject, false)
+
+		require.Equal(t, workflow.OK(), result)
+		assert.Equal(t, map[string]bool{"": true}, peClient.UpdateRegionalizedPrivateEndpointSettingRequests)
+	})
+
+	t.Run("should reject disabling the mode with private endpoints in several regions in the spec", func(t *testing.T) {
+		peClient := regionalizedPrivateEndpointsClient(true, nil)
+		akoProject := &mdbv1.AtlasProject{
+			Spec: mdbv1.AtlasProjectSpec{
+				RegionalizedPrivateEndpoints: pointer.MakePtr(false),
+				PrivateEndpoints: []mdbv1.PrivateEndpoint{
+					{Provider: provider.ProviderAWS, Region: "eu-west-1"},
+					{Provider: provider.ProviderAWS, Region: "us-east-1"},
+				},
+			},
+		}
+
+		result := ensureRegionalizedPrivateEndpoint(regionalizedTestContext(t, peClient), akoProject, false)
+
+		require.Equal(
+			t,
+			workflow.Terminate(workflow.ProjectRegionalizedPEInvalidSpec, "the regionalized private endpoint mode can't be disabled as the private endpoints of AWS are in several regions").WithoutRetry(),
+			result,
+		)
+		assert.Empty(t, peClient.UpdateRegionalizedPrivateEndpointSettingRequests)
+	})
+
+	t.Run("should wait for the private endpoints in several regions in Atlas to be removed before disabling the mode", func(t *testing.T) {
+		peClient := regionalizedPrivateEndpointsClient(true, map[string][]mongodbatlas.PrivateEndpointConnection{
+			"AZURE": {{RegionName: "EUROPE_NORTH"}, {RegionName: "US_EAST_2"}},
+		})
+		akoProject := &mdbv1.AtlasProject{
+			Spec: mdbv1.AtlasProjectSpec{RegionalizedPrivateEndpoints: pointer.MakePtr(false)},
+		}
+
+		result := ensureRegionalizedPrivateEndpoint(regionalizedTestContext(t, peClient), akoProject, false)
+
+		require.Equal(
+			t,
+			workflow.Terminate(workflow.ProjectRegionalizedPENotReady, "the regionalized private endpoint mode can't be disabled while the private endpoints of AZURE in Atlas are in several regions"),
+			result,
+		)
+		assert.Empty(t, peClient.UpdateRegionalizedPrivateEndpointSettingRequests)
+	})
+
+	t.Run("should not disable the mode it didn't enable when deletion protection is enabled", func(t *testing.T) {
+		peClient := regionalizedPrivateEndpointsClient(true, nil)
+		akoProject := &mdbv1.AtlasProject{
+			Spec: mdbv1.AtlasProjectSpec{RegionalizedPrivateEndpoints: pointer.MakePtr(false)},
+		}
+		akoProject.WithAnnotations(map[string]string{customresource.AnnotationLastAppliedConfiguration: "{}"})
+
+		result := ensureRegionalizedPrivateEndpoint(regionalizedTestContext(t, peClient), akoProject, true)
+
+		require.Equal(
+			t,
+			workflow.Terminate(
+				workflow.AtlasDeletionProtection,
+				"unable to reconcile the regionalized private endpoint mode due to deletion protection being enabled. see https://dochub.mongodb.org/core/ako-deletion-protection for further information",
+			),
+			result,
+		)
+	})
+}
+
+func TestMultiRegionProviders(t *testing.T) {
+	specPEs := []mdbv1.PrivateEndpoint{
+		{Provider: provider.ProviderGCP, Region: "europe-west1"},
+		{Provider: provider.ProviderAWS, Region: "eu-west-1"},
+	}
+	atlasPEs := []atlasPE{
+		{ProviderName: "GCP", RegionName: "EUROPE_WEST_1"},
+		{ProviderName: "AWS", RegionName: "US_EAST_1"},
+	}
+
+	assert.Empty(t, multiRegionProviders(specPEs, nil))
+	assert.Equal(t, []string{"AWS"}, multiRegionProviders(specPEs, atlasPEs))
+}
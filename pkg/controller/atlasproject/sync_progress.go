@@ -0,0 +1,77 @@
+package atlasproject
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	"go.mongodb.org/atlas/mongodbatlas"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	// syncChunkSize is the maximum number of Atlas write operations a single reconciliation performs for a kind of
+	// project resource. Larger changes, e.g. the adoption of a project holding hundreds of existing resources, are
+	// split over several reconciliations so that none of them times out or exceeds the Atlas rate limits
+	syncChunkSize = 50
+	// rateLimitedRetry is the delay before resuming a synchronization interrupted by the Atlas rate limits
+	rateLimitedRetry = time.Minute
+	// listItemsPerPage is the page size used to list all the resources of a kind, it is the maximum allowed by Atlas
+	listItemsPerPage = 500
+)
+
+// isRateLimited returns true if Atlas rejected the request because of its rate limits, the error coming from either the
+// legacy client or the Atlas SDK
+func isRateLimited(err error) bool {
+	var apiError *mongodbatlas.ErrorResponse
+	if errors.As(err, &apiError) {
+		return apiError.HTTPCode == http.StatusTooManyRequests ||
+			(apiError.Response != nil && apiError.Response.StatusCode == http.StatusTooManyRequests)
+	}
+
+	sdkError, ok := admin.AsError(err)
+
+	return ok && sdkError.GetError() == http.StatusTooManyRequests
+}
+
+// syncChunk returns the items to process in the current reconciliation given the number of Atlas write operations
+// still allowed, and the budget left once they are processed
+func syncChunk[T any](items []T, budget int) ([]T, int) {
+	if budget <= 0 {
+		return nil, 0
+	}
+
+	if len(items) > budget {
+		return items[:budget], 0
+	}
+
+	return items, budget - len(items)
+}
+
+// syncInProgress records the progress of the synchronization in the status and returns the result requeuing the
+// reconciliation. The synchronization resumes from the state of Atlas, the progress only informs about the
+// remaining work
+func syncInProgress(workflowCtx *workflow.Context, progress status.ResourceSyncProgress, rateLimited bool) workflow.Result {
+	workflowCtx.EnsureStatusOption(status.AtlasProjectSyncProgressOption(progress.Resource, &progress))
+
+	msg := fmt.Sprintf("synchronized %d of %d %s", progress.Synced, progress.Total, progress.Resource)
+	if progress.PendingDeletions > 0 {
+		msg = fmt.Sprintf("%s, %d left to delete", msg, progress.PendingDeletions)
+	}
+
+	if rateLimited {
+		return workflow.InProgress(workflow.AtlasRateLimited, fmt.Sprintf("%s, paused by the Atlas rate limits", msg)).
+			WithRetry(rateLimitedRetry)
+	}
+
+	return workflow.InProgress(workflow.ProjectSyncInProgress, msg)
+}
+
+// syncCompleted clears the progress of the synchronization from the status
+func syncCompleted(workflowCtx *workflow.Context, resource string) {
+	workflowCtx.EnsureStatusOption(status.AtlasProjectSyncProgressOption(resource, nil))
+}
@@ -23,11 +23,16 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
 
+const atlasTeamReconciler = "team"
+
+// teamReconcile reconciles the team in the organization of the connection secret. The teamID holds the ID of the team
+// the project knows in that organization, if any, and receives the ID of the team once reconciled
 func (r *AtlasProjectReconciler) teamReconcile(
 	team *v1.AtlasTeam,
 	connectionSecretKey *client.ObjectKey,
+	teamID *string,
 ) reconcile.Func {
-	return func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	return func(ctx context.Context, req reconcile.Request) (res reconcile.Result, _ error) {
 		log := r.Log.With("atlasteam", req.NamespacedName)
 
 		result := customresource.PrepareResource(ctx, r.Client, req, team, log)
@@ -40,9 +45,25 @@ func (r *AtlasProjectReconciler) teamReconcile(
 			return workflow.OK().ReconcileResult(), nil
 		}
 
+		if customresource.NamespaceReconciliationPaused(ctx, r.Client, team.Namespace, log) {
+			log.Infow(fmt.Sprintf("-> Pausing AtlasTeam reconciliation as annotation %s=true is set on the namespace", customresource.ReconciliationPausedAnnotation))
+			return customresource.MarkReconciliationPaused(ctx, r.Client, r.EventRecorder, team, log).ReconcileResult(), nil
+		}
+
 		teamCtx := customresource.MarkReconciliationStarted(r.Client, team, log, ctx)
 		log.Infow("-> Starting AtlasTeam reconciliation", "spec", team.Spec)
-		defer statushandler.Update(teamCtx, r.Client, r.EventRecorder, team)
+		if teamCtx.Degraded(team) {
+			log.Infow("-> Skipping the reconciliation of the degraded AtlasTeam, change its spec or set the reapply annotation to retry")
+			return reconcile.Result{}, nil
+		}
+
+		defer func() {
+			if p := recover(); p != nil {
+				res = teamCtx.RecoverPanic("AtlasTeam", p).ReconcileResult()
+			}
+			res = teamCtx.CapRetries("AtlasTeam", team, res)
+			statushandler.Update(teamCtx, r.Client, r.EventRecorder, r.FailureNotifier, team)
+		}()
 
 		resourceVersionIsValid := customresource.ValidateResourceVersion(teamCtx, team, r.Log)
 		if !resourceVersionIsValid.IsOk() {
@@ -68,7 +89,8 @@ func (r *AtlasProjectReconciler) teamReconcile(
 
 		owner, err := customresource.IsOwner(team, r.ObjectDeletionProtection, customresource.IsResourceManagedByOperator, teamsManagedByAtlas(teamCtx))
 		if err != nil {
-			result = workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err))
+			result = workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err)).
+				WithCause(atlasTeamReconciler, "getTeamById")
 			teamCtx.SetConditionFromResult(status.ReadyType, result)
 			log.Error(result.GetMessage())
 
@@ -86,7 +108,19 @@ func (r *AtlasProjectReconciler) teamReconcile(
 			return result.ReconcileResult(), nil
 		}
 
-		teamID, result := ensureTeamState(teamCtx, team)
+		usernames, err := r.teamUsernames(teamCtx.Context, team)
+		if err != nil {
+			result = workflow.Terminate(workflow.TeamInvalidSpec, err.Error())
+			teamCtx.SetConditionFromResult(status.ReadyType, result)
+
+			return result.ReconcileResult(), nil
+		}
+
+		knownTeamID := team.Status.ID
+		if *teamID != "" {
+			knownTeamID = *teamID
+		}
+		*teamID, result = ensureTeamState(teamCtx, team, knownTeamID, usernames)
 		if !result.IsOk() {
 			teamCtx.SetConditionFromResult(status.ReadyType, result)
 			if result.IsWarning() {
@@ -96,9 +130,13 @@ func (r *AtlasProjectReconciler) teamReconcile(
 			return result.ReconcileResult(), nil
 		}
 
-		teamCtx.EnsureStatusOption(status.AtlasTeamSetID(teamID))
+		// the team may be shared with projects of other organizations (e.g. while they are migrated): the ID of the
+		// team in the organization of the project is kept in the status of the project, not in the one of the team
+		if team.Status.ID == "" {
+			teamCtx.EnsureStatusOption(status.AtlasTeamSetID(*teamID))
+		}
 
-		result = ensureTeamUsersAreInSync(teamCtx, teamID, team)
+		result = ensureTeamUsersAreInSync(teamCtx, *teamID, usernames)
 		if !result.IsOk() {
 			teamCtx.SetConditionFromResult(status.ReadyType, result)
 			return result.ReconcileResult(), nil
@@ -151,27 +189,32 @@ func (r *AtlasProjectReconciler) teamReconcile(
 	}
 }
 
-func ensureTeamState(workflowCtx *workflow.Context, team *v1.AtlasTeam) (string, workflow.Result) {
+// ensureTeamState finds the team by its known ID, or by name when the ID isn't part of the organization, and creates
+// it when it doesn't exist
+func ensureTeamState(workflowCtx *workflow.Context, team *v1.AtlasTeam, teamID string, usernames []v1.TeamUser) (string, workflow.Result) {
 	var atlasTeam *mongodbatlas.Team
 	var err error
 
-	if team.Status.ID != "" {
-		atlasTeam, err = fetchTeamByID(workflowCtx, team.Status.ID)
-		if err != nil {
-			return "", workflow.Terminate(workflow.TeamNotCreatedInAtlas, err.Error())
-		}
+	if teamID != "" {
+		atlasTeam, err = fetchTeamByID(workflowCtx, teamID)
+		switch {
+		case isTeamNotFound(err):
+			workflowCtx.Log.Debugf("team %s not found in the organization %s, looking it up by name", teamID, workflowCtx.OrgID)
+		case err != nil:
+			return "", workflow.Terminate(workflow.TeamNotCreatedInAtlas, err.Error()).WithCause(atlasTeamReconciler, "getTeamById")
+		default:
+			atlasTeam, err = renameTeam(workflowCtx, atlasTeam, team.Spec.Name)
+			if err != nil {
+				return "", workflow.Terminate(workflow.TeamNotUpdatedInAtlas, err.Error()).WithCause(atlasTeamReconciler, "renameTeam")
+			}
 
-		atlasTeam, err = renameTeam(workflowCtx, atlasTeam, team.Spec.Name)
-		if err != nil {
-			return "", workflow.Terminate(workflow.TeamNotUpdatedInAtlas, err.Error())
+			return atlasTeam.ID, workflow.OK()
 		}
-
-		return atlasTeam.ID, workflow.OK()
 	}
 
 	atlasTeam, err = fetchTeamByName(workflowCtx, team.Spec.Name)
 	if err != nil {
-		return "", workflow.Terminate(workflow.TeamNotCreatedInAtlas, err.Error())
+		return "", workflow.Terminate(workflow.TeamNotCreatedInAtlas, err.Error()).WithCause(atlasTeamReconciler, "getTeamByName")
 	}
 
 	if atlasTeam == nil {
@@ -179,29 +222,33 @@ func ensureTeamState(workflowCtx *workflow.Context, team *v1.AtlasTeam) (string,
 		if err != nil {
 			return "", workflow.Terminate(workflow.TeamInvalidSpec, err.Error())
 		}
+		atlasTeam.Usernames = make([]string, 0, len(usernames))
+		for _, username := range usernames {
+			atlasTeam.Usernames = append(atlasTeam.Usernames, string(username))
+		}
 
 		atlasTeam, err = createTeam(workflowCtx, atlasTeam)
 		if err != nil {
-			return "", workflow.Terminate(workflow.TeamNotCreatedInAtlas, err.Error())
+			return "", workflow.Terminate(workflow.TeamNotCreatedInAtlas, err.Error()).WithCause(atlasTeamReconciler, "createTeam")
 		}
 	}
 
 	atlasTeam, err = renameTeam(workflowCtx, atlasTeam, team.Spec.Name)
 	if err != nil {
-		return "", workflow.Terminate(workflow.TeamNotUpdatedInAtlas, err.Error())
+		return "", workflow.Terminate(workflow.TeamNotUpdatedInAtlas, err.Error()).WithCause(atlasTeamReconciler, "renameTeam")
 	}
 
 	return atlasTeam.ID, workflow.OK()
 }
 
-func ensureTeamUsersAreInSync(workflowCtx *workflow.Context, teamID string, team *v1.AtlasTeam) workflow.Result {
+func ensureTeamUsersAreInSync(workflowCtx *workflow.Context, teamID string, usernames []v1.TeamUser) workflow.Result {
 	atlasUsers, _, err := workflowCtx.Client.Teams.GetTeamUsersAssigned(workflowCtx.Context, workflowCtx.OrgID, teamID)
 	if err != nil {
-		return workflow.Terminate(workflow.TeamUsersNotReady, err.Error())
+		return workflow.Terminate(workflow.TeamUsersNotReady, err.Error()).WithCause(atlasTeamReconciler, "listTeamUsers")
 	}
 
 	usernamesMap := map[string]struct{}{}
-	for _, username := range team.Spec.Usernames {
+	for _, username := range usernames {
 		usernamesMap[string(username)] = struct{}{}
 	}
 
@@ -227,14 +274,14 @@ func ensureTeamUsersAreInSync(workflowCtx *workflow.Context, teamID string, team
 	if err = g.Wait(); err != nil {
 		workflowCtx.Log.Warnf("failed to remove user(s) from team %s", teamID)
 
-		return workflow.Terminate(workflow.TeamUsersNotReady, err.Error())
+		return workflow.Terminate(workflow.TeamUsersNotReady, err.Error()).WithCause(atlasTeamReconciler, "removeTeamUser")
 	}
 
 	g, taskContext = errgroup.WithContext(workflowCtx.Context)
-	toAdd := make([]string, 0, len(team.Spec.Usernames))
+	toAdd := make([]string, 0, len(usernames))
 	lock := sync.Mutex{}
-	for i := range team.Spec.Usernames {
-		username := team.Spec.Usernames[i]
+	for i := range usernames {
+		username := usernames[i]
 		if _, ok := atlasUsernamesMap[string(username)]; !ok {
 			g.Go(func() error {
 				user, _, err := workflowCtx.Client.AtlasUsers.GetByName(taskContext, string(username))
@@ -255,7 +302,7 @@ func ensureTeamUsersAreInSync(workflowCtx *workflow.Context, teamID string, team
 	if err = g.Wait(); err != nil {
 		workflowCtx.Log.Warnf("failed to retrieve users to add to the team %s", teamID)
 
-		return workflow.Terminate(workflow.TeamUsersNotReady, err.Error())
+		return workflow.Terminate(workflow.TeamUsersNotReady, err.Error()).WithCause(atlasTeamReconciler, "getUserByUsername")
 	}
 
 	if len(toAdd) == 0 {
@@ -265,7 +312,7 @@ func ensureTeamUsersAreInSync(workflowCtx *workflow.Context, teamID string, team
 	workflowCtx.Log.Debugf("Adding users to team %s", teamID)
 	_, _, err = workflowCtx.Client.Teams.AddUsersToTeam(workflowCtx.Context, workflowCtx.OrgID, teamID, toAdd)
 	if err != nil {
-		return workflow.Terminate(workflow.TeamUsersNotReady, err.Error())
+		return workflow.Terminate(workflow.TeamUsersNotReady, err.Error()).WithCause(atlasTeamReconciler, "addTeamUser")
 	}
 
 	return workflow.OK()
@@ -281,6 +328,11 @@ func fetchTeamByID(workflowCtx *workflow.Context, teamID string) (*mongodbatlas.
 	return atlasTeam, nil
 }
 
+func isTeamNotFound(err error) bool {
+	var apiError *mongodbatlas.ErrorResponse
+	return errors.As(err, &apiError) && (apiError.ErrorCode == atlas.NotInGroup || apiError.ErrorCode == atlas.ResourceNotFound)
+}
+
 func fetchTeamByName(workflowCtx *workflow.Context, teamName string) (*mongodbatlas.Team, error) {
 	workflowCtx.Log.Debugf("fetching team named %s from atlas", teamName)
 	atlasTeam, resp, err := workflowCtx.Client.Teams.GetOneTeamByName(workflowCtx.Context, workflowCtx.OrgID, teamName)
@@ -344,6 +396,11 @@ func teamsManagedByAtlas(workflowCtx *workflow.Context) customresource.AtlasChec
 			return false, err
 		}
 
+		// the users listed in a ConfigMap are maintained outside of the AtlasTeam
+		if team.Spec.UsernamesConfigMapRef != nil {
+			return false, nil
+		}
+
 		usernames := make([]string, 0, len(team.Spec.Usernames))
 		for _, username := range team.Spec.Usernames {
 			usernames = append(usernames, string(username))
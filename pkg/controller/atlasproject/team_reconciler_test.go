@@ -6,12 +6,22 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.mongodb.org/atlas/mongodbatlas"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
 	v1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
 
@@ -152,3 +162,79 @@ func TestTeamManagedByAtlas(t *testing.T) {
 		assert.True(t, result)
 	})
 }
+
+func TestEnsureTeamState(t *testing.T) {
+	t.Run("should use the team known by the project", func(t *testing.T) {
+		workflowCtx := &workflow.Context{
+			OrgID:   "org-b",
+			Context: context.Background(),
+			Log:     zaptest.NewLogger(t).Sugar(),
+			Client: &mongodbatlas.Client{Teams: &atlasmock.TeamsClientMock{
+				GetFunc: func(orgID string, teamID string) (*mongodbatlas.Team, *mongodbatlas.Response, error) {
+					return &mongodbatlas.Team{ID: teamID, Name: "team"}, nil, nil
+				},
+			}},
+		}
+		team := &v1.AtlasTeam{Spec: v1.TeamSpec{Name: "team"}, Status: status.TeamStatus{ID: "team-org-a"}}
+
+		teamID, result := ensureTeamState(workflowCtx, team, "team-org-b", nil)
+
+		assert.True(t, result.IsOk())
+		assert.Equal(t, "team-org-b", teamID)
+	})
+
+	t.Run("should look the team up by name when its ID isn't part of the organization", func(t *testing.T) {
+		workflowCtx := &workflow.Context{
+			OrgID:   "org-b",
+			Context: context.Background(),
+			Log:     zaptest.NewLogger(t).Sugar(),
+			Client: &mongodbatlas.Client{Teams: &atlasmock.TeamsClientMock{
+				GetFunc: func(orgID string, teamID string) (*mongodbatlas.Team, *mongodbatlas.Response, error) {
+					return nil, &mongodbatlas.Response{}, &mongodbatlas.ErrorResponse{ErrorCode: atlas.ResourceNotFound}
+				},
+				GetOneTeamByNameFunc: func(orgID string, name string) (*mongodbatlas.Team, *mongodbatlas.Response, error) {
+					return &mongodbatlas.Team{ID: "team-org-b", Name: name}, nil, nil
+				},
+			}},
+		}
+		team := &v1.AtlasTeam{Spec: v1.TeamSpec{Name: "team"}, Status: status.TeamStatus{ID: "team-org-a"}}
+
+		teamID, result := ensureTeamState(workflowCtx, team, team.Status.ID, nil)
+
+		assert.True(t, result.IsOk())
+		assert.Equal(t, "team-org-b", teamID)
+	})
+}
+
+func TestTeamReconcilePausedNamespace(t *testing.T) {
+	team := &v1.AtlasTeam{
+		ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: "paused"},
+		Spec:       v1.TeamSpec{Name: "team", Usernames: []v1.TeamUser{"user@mongodb.com"}},
+	}
+	sch := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(sch))
+	require.NoError(t, v1.AddToScheme(sch))
+	k8sClient := fake.NewClientBuilder().WithScheme(sch).WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "paused", Annotations: map[string]string{customresource.ReconciliationPausedAnnotation: "true"}}},
+		team,
+	).WithStatusSubresource(team).Build()
+	reconciler := &AtlasProjectReconciler{
+		Client:        k8sClient,
+		Log:           zaptest.NewLogger(t).Sugar(),
+		EventRecorder: record.NewFakeRecorder(10),
+	}
+
+	teamID := ""
+	result, err := reconciler.teamReconcile(team, nil, &teamID)(context.Background(), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(team)})
+	require.NoError(t, err)
+	assert.Equal(t, reconcile.Result{RequeueAfter: customresource.PausedRetry}, result)
+	assert.Empty(t, teamID)
+
+	updated := &v1.AtlasTeam{}
+	require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(team), updated))
+	require.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, status.PausedByOperatorType, updated.Status.Conditions[0].Type)
+	assert.Equal(t, corev1.ConditionTrue, updated.Status.Conditions[0].Status)
+	assert.Equal(t, string(workflow.NamespaceReconciliationPaused), updated.Status.Conditions[0].Reason)
+	assert.Empty(t, updated.Status.ID)
+}
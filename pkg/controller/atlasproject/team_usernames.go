@@ -0,0 +1,91 @@
+package atlasproject
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+// teamUsernames returns the usernames of the spec of the team followed by the ones listed in its ConfigMap, if any, and
+// by the members of the organization whose AtlasOrgUser references the team, without duplicates
+func (r *AtlasProjectReconciler) teamUsernames(ctx context.Context, team *v1.AtlasTeam) ([]v1.TeamUser, error) {
+	usernames := make([]v1.TeamUser, 0, len(team.Spec.Usernames))
+	known := map[v1.TeamUser]struct{}{}
+	add := func(username v1.TeamUser) {
+		if _, ok := known[username]; !ok {
+			known[username] = struct{}{}
+			usernames = append(usernames, username)
+		}
+	}
+
+	for _, username := range team.Spec.Usernames {
+		add(username)
+	}
+
+	key := team.Spec.UsernamesConfigMapRef.GetObject(team.Namespace)
+	if key != nil {
+		configMap := &corev1.ConfigMap{}
+		if err := r.Client.Get(ctx, *key, configMap); err != nil {
+			if apiErrors.IsNotFound(err) {
+				return nil, fmt.Errorf("the usernames ConfigMap %s doesn't exist", key)
+			}
+
+			return nil, fmt.Errorf("failed to read the usernames ConfigMap %s: %w", key, err)
+		}
+
+		for _, username := range parseTeamUsernamesConfigMap(configMap) {
+			add(username)
+		}
+	}
+
+	orgUsers := &v1.AtlasOrgUserList{}
+	if err := r.Client.List(ctx, orgUsers); err != nil {
+		return nil, fmt.Errorf("failed to list the organization users: %w", err)
+	}
+
+	teamKey := client.ObjectKeyFromObject(team)
+	for i := range orgUsers.Items {
+		orgUser := &orgUsers.Items[i]
+		// invited users can't be added to a team before they accept the invitation
+		if orgUser.Status.State == status.OrgUserMember && orgUser.ReferencesTeam(teamKey) {
+			add(v1.TeamUser(orgUser.Spec.Username))
+		}
+	}
+
+	if len(usernames) == 0 {
+		return nil, fmt.Errorf("the team %s has no users", team.Spec.Name)
+	}
+
+	return usernames, nil
+}
+
+// parseTeamUsernamesConfigMap reads the usernames of every key of the ConfigMap, separated by commas, spaces or new
+// lines
+func parseTeamUsernamesConfigMap(configMap *corev1.ConfigMap) []v1.TeamUser {
+	keys := make([]string, 0, len(configMap.Data))
+	for key := range configMap.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var usernames []v1.TeamUser
+	for _, key := range keys {
+		values := strings.FieldsFunc(configMap.Data[key], func(r rune) bool {
+			return r == ',' || unicode.IsSpace(r)
+		})
+		for _, value := range values {
+			usernames = append(usernames, v1.TeamUser(value))
+		}
+	}
+
+	return usernames
+}
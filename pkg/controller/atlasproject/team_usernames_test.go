@@ -0,0 +1,82 @@
+package atlasproject
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+func TestTeamUsernames(t *testing.T) {
+	newReconciler := func(t *testing.T, objects ...client.Object) *AtlasProjectReconciler {
+		sch := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(sch))
+		require.NoError(t, v1.AddToScheme(sch))
+
+		return &AtlasProjectReconciler{Client: fake.NewClientBuilder().WithScheme(sch).WithObjects(objects...).Build()}
+	}
+	newTeam := func(usernames []v1.TeamUser, configMapRef *common.ResourceRefNamespaced) *v1.AtlasTeam {
+		return &v1.AtlasTeam{
+			ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: "ns"},
+			Spec:       v1.TeamSpec{Name: "Team", Usernames: usernames, UsernamesConfigMapRef: configMapRef},
+		}
+	}
+
+	t.Run("should add the users of the ConfigMap to the ones of the spec", func(t *testing.T) {
+		r := newReconciler(t, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "roster", Namespace: "ns"},
+			Data: map[string]string{
+				"developers": "dev1@example.com, dev2@example.com\nlead@example.com",
+				"admins":     "lead@example.com",
+			},
+		})
+		team := newTeam([]v1.TeamUser{"lead@example.com"}, &common.ResourceRefNamespaced{Name: "roster"})
+
+		usernames, err := r.teamUsernames(context.Background(), team)
+
+		require.NoError(t, err)
+		assert.Equal(t, []v1.TeamUser{"lead@example.com", "dev1@example.com", "dev2@example.com"}, usernames)
+	})
+
+	t.Run("should add the members referencing the team in their AtlasOrgUser", func(t *testing.T) {
+		newOrgUser := func(name, username string, state status.OrgUserState) *v1.AtlasOrgUser {
+			return &v1.AtlasOrgUser{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "users"},
+				Spec:       v1.AtlasOrgUserSpec{Username: username, TeamRefs: []common.ResourceRefNamespaced{{Name: "team", Namespace: "ns"}}},
+				Status:     status.AtlasOrgUserStatus{State: state},
+			}
+		}
+		r := newReconciler(
+			t,
+			newOrgUser("member", "member@example.com", status.OrgUserMember),
+			newOrgUser("invited", "invited@example.com", status.OrgUserInvited),
+		)
+
+		usernames, err := r.teamUsernames(context.Background(), newTeam([]v1.TeamUser{"lead@example.com"}, nil))
+
+		require.NoError(t, err)
+		assert.Equal(t, []v1.TeamUser{"lead@example.com", "member@example.com"}, usernames)
+	})
+
+	t.Run("should fail when the ConfigMap doesn't exist", func(t *testing.T) {
+		_, err := newReconciler(t).teamUsernames(context.Background(), newTeam(nil, &common.ResourceRefNamespaced{Name: "roster"}))
+
+		require.EqualError(t, err, "the usernames ConfigMap ns/roster doesn't exist")
+	})
+
+	t.Run("should fail when the team has no users", func(t *testing.T) {
+		_, err := newReconciler(t).teamUsernames(context.Background(), newTeam(nil, nil))
+
+		require.EqualError(t, err, "the team Team has no users")
+	})
+}
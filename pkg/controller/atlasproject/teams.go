@@ -25,6 +25,8 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
 
+const assignedTeamsReconciler = "assignedTeams"
+
 type TeamDataContainer struct {
 	ProjectTeam *v1.Team
 	Team        *v1.AtlasTeam
@@ -53,7 +55,8 @@ func (r *AtlasProjectReconciler) ensureAssignedTeams(workflowCtx *workflow.Conte
 		}
 
 		team := &v1.AtlasTeam{}
-		teamReconciler := r.teamReconcile(team, project.ConnectionSecretObjectKey())
+		teamID := getTeamIDFromProjectStatus(project, assignedTeam.TeamRef)
+		teamReconciler := r.teamReconcile(team, project.ConnectionSecretObjectKey(), &teamID)
 		_, err := teamReconciler(
 			workflowCtx.Context,
 			controllerruntime.Request{NamespacedName: types.NamespacedName{Name: assignedTeam.TeamRef.Name, Namespace: assignedTeam.TeamRef.Namespace}},
@@ -67,17 +70,22 @@ func (r *AtlasProjectReconciler) ensureAssignedTeams(workflowCtx *workflow.Conte
 			resourcesToWatch,
 			watch.WatchedObject{ResourceKind: team.Kind, Resource: types.NamespacedName{Name: assignedTeam.TeamRef.Name, Namespace: assignedTeam.TeamRef.Namespace}},
 		)
+		if key := team.Spec.UsernamesConfigMapRef.GetObject(team.Namespace); key != nil {
+			resourcesToWatch = append(resourcesToWatch, watch.WatchedObject{ResourceKind: "ConfigMap", Resource: *key})
+		}
 
-		teamsToAssign[team.Status.ID] = &assignedTeam
+		teamsToAssign[teamID] = &assignedTeam
 	}
 
-	canReconcile, err := canAssignedTeamsReconcile(workflowCtx, r.Client, protected, project)
+	canReconcile, differences, err := canAssignedTeamsReconcile(workflowCtx, r.Client, protected, project)
 	if err != nil {
-		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err))
+		result := workflow.Terminate(workflow.Internal, fmt.Sprintf("unable to resolve ownership for deletion protection: %s", err)).
+			WithCause(assignedTeamsReconciler, workflow.AtlasOperation(err))
 		workflowCtx.SetConditionFromResult(status.ProjectTeamsReadyType, result)
 
 		return result
 	}
+	reportDeletionProtection(workflowCtx, "teams", protected, canReconcile, differences)
 
 	if !canReconcile {
 		result := workflow.Terminate(
@@ -91,8 +99,11 @@ func (r *AtlasProjectReconciler) ensureAssignedTeams(workflowCtx *workflow.Conte
 
 	err = r.syncAssignedTeams(workflowCtx, project.ID(), project, teamsToAssign)
 	if err != nil {
-		workflowCtx.SetConditionFalse(status.ProjectTeamsReadyType)
-		return workflow.Terminate(workflow.ProjectTeamUnavailable, err.Error())
+		result := workflow.Terminate(workflow.ProjectTeamUnavailable, err.Error()).
+			WithCause(assignedTeamsReconciler, workflow.AtlasOperation(err))
+		workflowCtx.SetConditionFromResult(status.ProjectTeamsReadyType, result)
+
+		return result
 	}
 
 	workflowCtx.SetConditionTrue(status.ProjectTeamsReadyType)
@@ -109,7 +120,7 @@ func (r *AtlasProjectReconciler) syncAssignedTeams(ctx *workflow.Context, projec
 	ctx.Log.Debug("fetching assigned teams from atlas")
 	atlasAssignedTeams, _, err := ctx.Client.Projects.GetProjectTeamsAssigned(ctx.Context, projectID)
 	if err != nil {
-		return err
+		return workflow.NewAtlasOperationError("listProjectTeams", err)
 	}
 
 	projectTeamStatus := make([]status.ProjectTeamStatus, 0, len(teamsToAssign))
@@ -118,7 +129,7 @@ func (r *AtlasProjectReconciler) syncAssignedTeams(ctx *workflow.Context, projec
 		currentProjectsStatus[projectTeam.ID] = projectTeam
 	}
 
-	defer statushandler.Update(ctx, r.Client, r.EventRecorder, project)
+	defer statushandler.Update(ctx, r.Client, r.EventRecorder, r.FailureNotifier, project)
 
 	toDelete := make([]*mongodbatlas.Result, 0, len(atlasAssignedTeams.Results))
 	for _, atlasAssignedTeam := range atlasAssignedTeams.Results {
@@ -183,7 +194,7 @@ func (r *AtlasProjectReconciler) syncAssignedTeams(ctx *workflow.Context, projec
 
 		_, _, err = ctx.Client.Projects.AddTeamsToProject(ctx.Context, projectID, projectTeams)
 		if err != nil {
-			return err
+			return workflow.NewAtlasOperationError("addAllTeamsToProject", err)
 		}
 	}
 
@@ -244,7 +255,7 @@ func (r *AtlasProjectReconciler) updateTeamState(ctx *workflow.Context, project
 	}
 
 	teamCtx.EnsureStatusOption(status.AtlasTeamSetProjects(assignedProjects))
-	statushandler.Update(teamCtx, r.Client, r.EventRecorder, team)
+	statushandler.Update(teamCtx, r.Client, r.EventRecorder, r.FailureNotifier, team)
 
 	return nil
 }
@@ -259,6 +270,18 @@ func getTeamRefFromProjectStatus(project *v1.AtlasProject, teamID string) *commo
 	return nil
 }
 
+// getTeamIDFromProjectStatus returns the ID of the team in the organization of the project, empty until the team was
+// assigned to the project
+func getTeamIDFromProjectStatus(project *v1.AtlasProject, teamRef common.ResourceRefNamespaced) string {
+	for _, stat := range project.Status.Teams {
+		if *stat.TeamRef.GetObject(project.Namespace) == *teamRef.GetObject(project.Namespace) {
+			return stat.ID
+		}
+	}
+
+	return ""
+}
+
 func hasTeamRolesChanged(current []string, desired []v1.TeamRole) bool {
 	desiredMap := map[string]struct{}{}
 	for _, desiredRole := range desired {
@@ -277,26 +300,26 @@ type assignedTeamInfo struct {
 	Roles []string
 }
 
-func canAssignedTeamsReconcile(workflowCtx *workflow.Context, k8sClient client.Client, protected bool, akoProject *v1.AtlasProject) (bool, error) {
+func canAssignedTeamsReconcile(workflowCtx *workflow.Context, k8sClient client.Client, protected bool, akoProject *v1.AtlasProject) (bool, []string, error) {
 	if !protected {
-		return true, nil
+		return true, nil, nil
 	}
 
 	latestConfig := &v1.AtlasProjectSpec{}
 	latestConfigString, ok := akoProject.Annotations[customresource.AnnotationLastAppliedConfiguration]
 	if ok {
 		if err := json.Unmarshal([]byte(latestConfigString), latestConfig); err != nil {
-			return false, err
+			return false, nil, err
 		}
 	}
 
 	atlasAssignedTeams, _, err := workflowCtx.Client.Projects.GetProjectTeamsAssigned(workflowCtx.Context, akoProject.ID())
 	if err != nil {
-		return false, err
+		return false, nil, workflow.NewAtlasOperationError("listProjectTeams", err)
 	}
 
 	if atlasAssignedTeams == nil || atlasAssignedTeams.TotalCount == 0 {
-		return true, nil
+		return true, nil, nil
 	}
 
 	atlasAssignedTeamsInfo := make([]assignedTeamInfo, 0, atlasAssignedTeams.TotalCount)
@@ -314,19 +337,25 @@ func canAssignedTeamsReconcile(workflowCtx *workflow.Context, k8sClient client.C
 
 	lastAssignedTeamsInfo, err := collectTeams(workflowCtx.Context, k8sClient, latestConfig, akoProject.Namespace)
 	if err != nil {
-		return false, err
+		return false, nil, err
 	}
 
 	if cmp.Diff(atlasAssignedTeamsInfo, lastAssignedTeamsInfo, cmpopts.EquateEmpty()) == "" {
-		return true, nil
+		return true, nil, nil
 	}
 
 	currentAssignedTeamsInfo, err := collectTeams(workflowCtx.Context, k8sClient, &akoProject.Spec, akoProject.Namespace)
 	if err != nil {
-		return false, err
+		return false, nil, err
+	}
+
+	if cmp.Diff(atlasAssignedTeamsInfo, currentAssignedTeamsInfo, cmpopts.EquateEmpty()) == "" {
+		return true, nil, nil
 	}
 
-	return cmp.Diff(atlasAssignedTeamsInfo, currentAssignedTeamsInfo, cmpopts.EquateEmpty()) == "", nil
+	return false, listDifferences(atlasAssignedTeamsInfo, currentAssignedTeamsInfo, func(team assignedTeamInfo) string {
+		return team.ID
+	}), nil
 }
 
 func collectTeams(ctx context.Context, k8sClient client.Client, projectSpec *v1.AtlasProjectSpec, projectNamespace string) ([]assignedTeamInfo, error) {
@@ -59,7 +59,7 @@ func TestCanAssignedTeamsReconcile(t *testing.T) {
 			Client:  &mongodbatlas.Client{},
 			Context: context.Background(),
 		}
-		result, err := canAssignedTeamsReconcile(workflowCtx, k8sClient, false, &mdbv1.AtlasProject{})
+		result, _, err := canAssignedTeamsReconcile(workflowCtx, k8sClient, false, &mdbv1.AtlasProject{})
 		assert.NoError(t, err)
 		assert.True(t, result)
 	})
@@ -71,7 +71,7 @@ func TestCanAssignedTeamsReconcile(t *testing.T) {
 			Client:  &mongodbatlas.Client{},
 			Context: context.Background(),
 		}
-		result, err := canAssignedTeamsReconcile(workflowCtx, k8sClient, true, akoProject)
+		result, _, err := canAssignedTeamsReconcile(workflowCtx, k8sClient, true, akoProject)
 		assert.EqualError(t, err, "invalid character 'w' looking for beginning of object key string")
 		assert.False(t, result)
 	})
@@ -90,7 +90,7 @@ func TestCanAssignedTeamsReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canAssignedTeamsReconcile(workflowCtx, k8sClient, true, akoProject)
+		result, _, err := canAssignedTeamsReconcile(workflowCtx, k8sClient, true, akoProject)
 
 		assert.EqualError(t, err, "failed to retrieve data")
 		assert.False(t, result)
@@ -110,7 +110,7 @@ func TestCanAssignedTeamsReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canAssignedTeamsReconcile(workflowCtx, k8sClient, true, akoProject)
+		result, _, err := canAssignedTeamsReconcile(workflowCtx, k8sClient, true, akoProject)
 
 		assert.NoError(t, err)
 		assert.True(t, result)
@@ -130,7 +130,7 @@ func TestCanAssignedTeamsReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canAssignedTeamsReconcile(workflowCtx, k8sClient, true, akoProject)
+		result, _, err := canAssignedTeamsReconcile(workflowCtx, k8sClient, true, akoProject)
 
 		assert.NoError(t, err)
 		assert.True(t, result)
@@ -170,7 +170,7 @@ func TestCanAssignedTeamsReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canAssignedTeamsReconcile(workflowCtx, k8sClient, true, akoProject)
+		result, _, err := canAssignedTeamsReconcile(workflowCtx, k8sClient, true, akoProject)
 
 		assert.NoError(t, err)
 		assert.True(t, result)
@@ -210,7 +210,7 @@ func TestCanAssignedTeamsReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canAssignedTeamsReconcile(workflowCtx, k8sClient, true, akoProject)
+		result, _, err := canAssignedTeamsReconcile(workflowCtx, k8sClient, true, akoProject)
 
 		assert.NoError(t, err)
 		assert.True(t, result)
@@ -250,7 +250,7 @@ func TestCanAssignedTeamsReconcile(t *testing.T) {
 			Client:  &atlasClient,
 			Context: context.Background(),
 		}
-		result, err := canAssignedTeamsReconcile(workflowCtx, k8sClient, true, akoProject)
+		result, _, err := canAssignedTeamsReconcile(workflowCtx, k8sClient, true, akoProject)
 
 		assert.NoError(t, err)
 		assert.False(t, result)
@@ -279,7 +279,8 @@ func TestEnsureAssignedTeams(t *testing.T) {
 		}
 		result := reconciler.ensureAssignedTeams(workflowCtx, akoProject, true)
 
-		require.Equal(t, workflow.Terminate(workflow.Internal, "unable to resolve ownership for deletion protection: failed to retrieve data"), result)
+		require.Equal(t, workflow.Terminate(workflow.Internal, "unable to resolve ownership for deletion protection: failed to retrieve data").
+			WithCause(assignedTeamsReconciler, "listProjectTeams"), result)
 	})
 
 	t.Run("should failed to reconcile when unable to synchronize with Atlas", func(t *testing.T) {
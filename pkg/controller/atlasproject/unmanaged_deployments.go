@@ -0,0 +1,142 @@
+package atlasproject
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// unmanagedDeploymentsRefreshInterval is how often the projects reporting their unmanaged deployments are reconciled,
+// as the deployments created outside the operator trigger no reconciliation
+const unmanagedDeploymentsRefreshInterval = 10 * time.Minute
+
+const (
+	serverlessInstanceSize = "SERVERLESS"
+
+	unmanagedDeploymentsReconciler = "unmanagedDeployments"
+)
+
+// ensureUnmanagedDeploymentsReport lists in the status the deployments of the project in Atlas which no
+// AtlasDeployment manages. They are only reported, the operator never changes them
+func (r *AtlasProjectReconciler) ensureUnmanagedDeploymentsReport(ctx *workflow.Context, akoProject *mdbv1.AtlasProject) workflow.Result {
+	if !akoProject.Spec.ReportUnmanagedDeployments {
+		ctx.EnsureStatusOption(status.AtlasProjectUnmanagedDeploymentsOption(nil))
+		ctx.UnsetCondition(status.UnmanagedDeploymentsReportedType)
+
+		return workflow.OK()
+	}
+
+	managed, err := r.managedDeploymentNames(ctx, akoProject)
+	if err != nil {
+		result := workflow.Terminate(workflow.ProjectUnmanagedDeploymentsNotListed, fmt.Sprintf("failed to list the AtlasDeployments: %s", err))
+		ctx.SetConditionFromResult(status.UnmanagedDeploymentsReportedType, result)
+
+		return result
+	}
+
+	deployments, err := listAtlasDeployments(ctx, akoProject.ID())
+	if err != nil {
+		result := workflow.Terminate(workflow.ProjectUnmanagedDeploymentsNotListed, err.Error()).WithAtlasError(err).
+			WithCause(unmanagedDeploymentsReconciler, workflow.AtlasOperation(err))
+		ctx.SetConditionFromResult(status.UnmanagedDeploymentsReportedType, result)
+
+		return result
+	}
+
+	var unmanaged []status.UnmanagedDeployment
+	for _, deployment := range deployments {
+		if _, ok := managed[deployment.Name]; !ok {
+			unmanaged = append(unmanaged, deployment)
+		}
+	}
+	sort.Slice(unmanaged, func(i, j int) bool {
+		return unmanaged[i].Name < unmanaged[j].Name
+	})
+
+	if len(unmanaged) > 0 {
+		ctx.Log.Debugw("Found deployments which no AtlasDeployment manages", "count", len(unmanaged))
+	}
+	ctx.EnsureStatusOption(status.AtlasProjectUnmanagedDeploymentsOption(unmanaged))
+	ctx.SetConditionTrue(status.UnmanagedDeploymentsReportedType)
+
+	return workflow.OK()
+}
+
+// managedDeploymentNames returns the names in Atlas of the deployments of the AtlasDeployments referencing the project
+func (r *AtlasProjectReconciler) managedDeploymentNames(ctx *workflow.Context, akoProject *mdbv1.AtlasProject) (map[string]struct{}, error) {
+	deployments := &mdbv1.AtlasDeploymentList{}
+	if err := r.Client.List(ctx.Context, deployments); err != nil {
+		return nil, err
+	}
+
+	names := map[string]struct{}{}
+	projectKey := kube.ObjectKeyFromObject(akoProject)
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		if deployment.AtlasProjectObjectKey() == projectKey {
+			names[deployment.GetDeploymentName()] = struct{}{}
+		}
+	}
+
+	return names, nil
+}
+
+// listAtlasDeployments returns the dedicated deployments and the serverless instances of the project in Atlas
+func listAtlasDeployments(ctx *workflow.Context, projectID string) ([]status.UnmanagedDeployment, error) {
+	var deployments []status.UnmanagedDeployment
+
+	clusters, _, err := ctx.Client.AdvancedClusters.List(ctx.Context, projectID, &mongodbatlas.ListOptions{})
+	if err != nil {
+		return nil, workflow.NewAtlasOperationError("listClusters", err)
+	}
+	if clusters == nil {
+		clusters = &mongodbatlas.AdvancedClustersResponse{}
+	}
+	for _, cluster := range clusters.Results {
+		deployments = append(deployments, status.UnmanagedDeployment{
+			Name:         cluster.Name,
+			InstanceSize: advancedClusterInstanceSize(cluster),
+			StateName:    cluster.StateName,
+		})
+	}
+
+	instances, _, err := ctx.Client.ServerlessInstances.List(ctx.Context, projectID, &mongodbatlas.ListOptions{})
+	if err != nil {
+		return nil, workflow.NewAtlasOperationError("listServerlessInstances", err)
+	}
+	if instances == nil {
+		instances = &mongodbatlas.ClustersResponse{}
+	}
+	for _, instance := range instances.Results {
+		deployments = append(deployments, status.UnmanagedDeployment{
+			Name:         instance.Name,
+			InstanceSize: serverlessInstanceSize,
+			StateName:    instance.StateName,
+		})
+	}
+
+	return deployments, nil
+}
+
+// advancedClusterInstanceSize returns the instance size of the electable nodes of the first region of the deployment
+func advancedClusterInstanceSize(cluster *mongodbatlas.AdvancedCluster) string {
+	for _, replicationSpec := range cluster.ReplicationSpecs {
+		if replicationSpec == nil {
+			continue
+		}
+		for _, regionConfig := range replicationSpec.RegionConfigs {
+			if regionConfig != nil && regionConfig.ElectableSpecs != nil {
+				return regionConfig.ElectableSpecs.InstanceSize
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,134 @@
+package atlasproject
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestEnsureUnmanagedDeploymentsReport(t *testing.T) {
+	newProject := func(report bool) *mdbv1.AtlasProject {
+		akoProject := mdbv1.NewProject("ns", "my-project", "my-project")
+		akoProject.Spec.ReportUnmanagedDeployments = report
+		akoProject.Status.ID = "project-id"
+
+		return akoProject
+	}
+	newReconciler := func(t *testing.T, objects ...client.Object) *AtlasProjectReconciler {
+		sch := runtime.NewScheme()
+		require.NoError(t, mdbv1.AddToScheme(sch))
+
+		return &AtlasProjectReconciler{Client: fake.NewClientBuilder().WithScheme(sch).WithObjects(objects...).Build()}
+	}
+	newClustersContext := func(t *testing.T, clusters *atlas.AdvancedClustersClientMock) *workflow.Context {
+		workflowCtx := newContext(t)
+		workflowCtx.Client = &mongodbatlas.Client{
+			AdvancedClusters: clusters,
+			ServerlessInstances: &atlas.ServerlessInstancesClientMock{
+				ListFunc: func(projectID string) (*mongodbatlas.ClustersResponse, *mongodbatlas.Response, error) {
+					return &mongodbatlas.ClustersResponse{Results: []*mongodbatlas.Cluster{{Name: "console-serverless", StateName: status.StateIDLE}}}, nil, nil
+				},
+			},
+		}
+
+		return workflowCtx
+	}
+	listedClusters := func() *atlas.AdvancedClustersClientMock {
+		return &atlas.AdvancedClustersClientMock{
+			ListFunc: func(projectID string) (*mongodbatlas.AdvancedClustersResponse, *mongodbatlas.Response, error) {
+				return &mongodbatlas.AdvancedClustersResponse{Results: []*mongodbatlas.AdvancedCluster{
+					{Name: "managed", StateName: status.StateIDLE},
+					{
+						Name:      "console-created",
+						StateName: status.StateCREATING,
+						ReplicationSpecs: []*mongodbatlas.AdvancedReplicationSpec{
+							{RegionConfigs: []*mongodbatlas.AdvancedRegionConfig{{ElectableSpecs: &mongodbatlas.Specs{InstanceSize: "M30"}}}},
+						},
+					},
+					{Name: "other-project-deployment", StateName: status.StateIDLE},
+				}}, nil, nil
+			},
+		}
+	}
+	managedDeployment := &mdbv1.AtlasDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "managed", Namespace: "ns"},
+		Spec: mdbv1.AtlasDeploymentSpec{
+			Project:        common.ResourceRefNamespaced{Name: "my-project"},
+			DeploymentSpec: &mdbv1.AdvancedDeploymentSpec{Name: "managed"},
+		},
+	}
+	otherProjectDeployment := &mdbv1.AtlasDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "ns"},
+		Spec: mdbv1.AtlasDeploymentSpec{
+			Project:        common.ResourceRefNamespaced{Name: "other-project"},
+			DeploymentSpec: &mdbv1.AdvancedDeploymentSpec{Name: "other-project-deployment"},
+		},
+	}
+
+	t.Run("should report the deployments which no AtlasDeployment of the project manages", func(t *testing.T) {
+		akoProject := newProject(true)
+		workflowCtx := newClustersContext(t, listedClusters())
+
+		result := newReconciler(t, managedDeployment, otherProjectDeployment).ensureUnmanagedDeploymentsReport(workflowCtx, akoProject)
+
+		assert.True(t, result.IsOk())
+		akoProject.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+		assert.Equal(
+			t,
+			[]status.UnmanagedDeployment{
+				{Name: "console-created", InstanceSize: "M30", StateName: status.StateCREATING},
+				{Name: "console-serverless", InstanceSize: "SERVERLESS", StateName: status.StateIDLE},
+				{Name: "other-project-deployment", StateName: status.StateIDLE},
+			},
+			akoProject.Status.UnmanagedDeployments,
+		)
+		assert.Equal(t, corev1.ConditionTrue, workflowCtx.Conditions()[0].Status)
+	})
+
+	t.Run("should report the failure to list the deployments in Atlas", func(t *testing.T) {
+		workflowCtx := newClustersContext(t, &atlas.AdvancedClustersClientMock{
+			ListFunc: func(projectID string) (*mongodbatlas.AdvancedClustersResponse, *mongodbatlas.Response, error) {
+				return nil, nil, &mongodbatlas.ErrorResponse{
+					Response:  &http.Response{StatusCode: http.StatusUnauthorized, Request: httptest.NewRequest(http.MethodGet, "/clusters", nil)},
+					ErrorCode: "UNAUTHORIZED",
+				}
+			},
+		})
+
+		result := newReconciler(t).ensureUnmanagedDeploymentsReport(workflowCtx, newProject(true))
+
+		assert.Equal(t, workflow.ProjectUnmanagedDeploymentsNotListed, result.GetReason())
+		condition := workflowCtx.Conditions()[0]
+		assert.Equal(t, status.UnmanagedDeploymentsReportedType, condition.Type)
+		assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	})
+
+	t.Run("should remove the report once disabled", func(t *testing.T) {
+		akoProject := newProject(false)
+		akoProject.Status.UnmanagedDeployments = []status.UnmanagedDeployment{{Name: "console-created"}}
+		workflowCtx := newClustersContext(t, listedClusters())
+		workflowCtx.SetConditionTrue(status.UnmanagedDeploymentsReportedType)
+
+		result := newReconciler(t).ensureUnmanagedDeploymentsReport(workflowCtx, akoProject)
+
+		assert.True(t, result.IsOk())
+		akoProject.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+		assert.Nil(t, akoProject.Status.UnmanagedDeployments)
+		assert.Empty(t, workflowCtx.Conditions())
+	})
+}
@@ -0,0 +1,189 @@
+package atlasproject
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // Atlas signs the webhook notifications with HMAC-SHA1
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/timeutil"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/watch"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	webhookIntegrationType = "WEBHOOK"
+
+	// webhookSignatureHeader is the header holding the signature of the notifications, as sent by Atlas
+	webhookSignatureHeader = "X-MMS-Signature"
+	// webhookValidationEventType is the event type of the test notifications sent by the operator
+	webhookValidationEventType = "AKO_WEBHOOK_VALIDATION"
+
+	webhookValidationTimeout = 10 * time.Second
+	// webhookValidationBackoff is the delay before sending again a failed test notification, doubled on each failure
+	// up to webhookValidationMaxBackoff
+	webhookValidationBackoff    = time.Minute
+	webhookValidationMaxBackoff = time.Hour
+	webhookCredentialsLength    = 16
+)
+
+// webhookValidationClient sends the test notifications to the webhooks
+var webhookValidationClient = &http.Client{Transport: http.DefaultTransport, Timeout: webhookValidationTimeout}
+
+// webhookValidation is the test notification sent to the webhook
+type webhookValidation struct {
+	EventTypeName string `json:"eventTypeName"`
+	GroupID       string `json:"groupId"`
+	Created       string `json:"created"`
+	HumanReadable string `json:"humanReadable"`
+}
+
+// ensureWebhookIntegration reports the rotations of the URL and the secret of the WEBHOOK integration, read from the
+// referenced Secrets which are watched so that a rotation is applied to Atlas right away. When requested, a test
+// notification is sent to the webhook after each rotation, and again with a growing delay until it's accepted
+func (r *AtlasProjectReconciler) ensureWebhookIntegration(ctx *workflow.Context, akoProject *mdbv1.AtlasProject, now time.Time) workflow.Result {
+	integration, found := findWebhookIntegration(akoProject.Spec.Integrations)
+	if !found {
+		ctx.EnsureStatusOption(status.AtlasProjectWebhookIntegrationOption(nil))
+		return workflow.OK()
+	}
+
+	for _, ref := range []common.ResourceRefNamespaced{integration.URLRef, integration.SecretRef} {
+		if ref.Name != "" {
+			ctx.AddResourcesToWatch(watch.WatchedObject{ResourceKind: "Secret", Resource: *ref.GetObject(akoProject.Namespace)})
+		}
+	}
+
+	atlasIntegration, err := integration.ToAtlas(ctx.Context, r.Client, akoProject.Namespace)
+	if err != nil {
+		return workflow.Terminate(workflow.ProjectIntegrationInternal, fmt.Sprintf("failed to read the webhook credentials: %s", err))
+	}
+
+	webhook := &status.WebhookIntegration{}
+	if akoProject.Status.WebhookIntegration != nil {
+		*webhook = *akoProject.Status.WebhookIntegration
+	}
+
+	if hash := webhookCredentialsHash(atlasIntegration.URL, atlasIntegration.Secret); webhook.CredentialsHash != hash {
+		if webhook.CredentialsHash != "" {
+			r.EventRecorder.Event(akoProject, "Normal", "WebhookIntegrationRotated", "The URL or the secret of the webhook integration changed")
+		}
+		webhook.CredentialsHash = hash
+		webhook.LastRotated = timeutil.FormatISO8601(now)
+		webhook.LastValidated = ""
+		webhook.ValidationError = ""
+		webhook.ValidationFailures = 0
+	}
+
+	result := workflow.OK()
+	switch {
+	case !integration.ValidateWebhook || (webhook.LastValidated != "" && webhook.ValidationError == ""):
+		// not requested, or already accepted since the last rotation
+	case webhook.ValidationError != "" && now.Before(nextWebhookValidation(webhook)):
+		result = workflow.Terminate(workflow.ProjectIntegrationWebhookValidationFailed, fmt.Sprintf("the test notification to the webhook failed: %s", webhook.ValidationError)).
+			WithRetry(nextWebhookValidation(webhook).Sub(now))
+	default:
+		webhook.LastValidated = timeutil.FormatISO8601(now)
+		webhook.ValidationError = ""
+
+		if err = sendWebhookValidation(ctx.Context, atlasIntegration.URL, atlasIntegration.Secret, akoProject.ID(), now); err != nil {
+			webhook.ValidationError = err.Error()
+			webhook.ValidationFailures++
+			r.EventRecorder.Eventf(akoProject, "Warning", "WebhookValidationFailed", "The test notification to the webhook failed: %s", err)
+			result = workflow.Terminate(workflow.ProjectIntegrationWebhookValidationFailed, fmt.Sprintf("the test notification to the webhook failed: %s", err)).
+				WithRetry(nextWebhookValidation(webhook).Sub(now))
+		} else {
+			webhook.LastSuccessfulProbe = webhook.LastValidated
+			webhook.ValidationFailures = 0
+		}
+	}
+
+	ctx.EnsureStatusOption(status.AtlasProjectWebhookIntegrationOption(webhook))
+
+	return result
+}
+
+// nextWebhookValidation returns when the failed test notification is sent again
+func nextWebhookValidation(webhook *status.WebhookIntegration) time.Time {
+	lastValidated, err := timeutil.ParseISO8601(webhook.LastValidated)
+	if err != nil {
+		return time.Time{}
+	}
+
+	backoff := webhookValidationBackoff
+	for i := 1; i < webhook.ValidationFailures && backoff < webhookValidationMaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return lastValidated.Add(min(backoff, webhookValidationMaxBackoff))
+}
+
+// sendWebhookValidation posts a test notification to the webhook, signed with the secret like Atlas does: the base64
+// encoded HMAC-SHA1 of the body in the X-MMS-Signature header
+func sendWebhookValidation(ctx context.Context, url, secret, projectID string, now time.Time) error {
+	body, err := json.Marshal(webhookValidation{
+		EventTypeName: webhookValidationEventType,
+		GroupID:       projectID,
+		Created:       timeutil.FormatISO8601(now),
+		HumanReadable: "Test notification sent by the Atlas Kubernetes Operator to validate the webhook integration",
+	})
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if secret != "" {
+		request.Header.Set(webhookSignatureHeader, webhookSignature(secret, body))
+	}
+
+	response, err := webhookValidationClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("the webhook answered with the status %s", response.Status)
+	}
+
+	return nil
+}
+
+func webhookSignature(secret string, body []byte) string {
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write(body)
+
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// webhookCredentialsHash returns a short hash of the URL and the secret, to detect their rotation without storing them
+func webhookCredentialsHash(url, secret string) string {
+	hash := sha256.Sum256([]byte(url + "\x00" + secret))
+
+	return hex.EncodeToString(hash[:])[:webhookCredentialsLength]
+}
+
+func findWebhookIntegration(integrations []project.Integration) (project.Integration, bool) {
+	for _, integration := range integrations {
+		if integration.Type == webhookIntegrationType {
+			return integration, true
+		}
+	}
+
+	return project.Integration{}, false
+}
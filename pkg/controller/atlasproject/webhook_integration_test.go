@@ -0,0 +1,196 @@
+package atlasproject
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestEnsureWebhookIntegration(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	deliveries := 0
+	accept := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deliveries++
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, webhookSignature("webhook-secret", body), r.Header.Get("X-MMS-Signature"))
+
+		notification := webhookValidation{}
+		require.NoError(t, json.Unmarshal(body, &notification))
+		assert.Equal(t, "project-id", notification.GroupID)
+
+		if !accept {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	newProject := func(validate bool) *mdbv1.AtlasProject {
+		akoProject := mdbv1.NewProject("ns", "my-project", "my-project")
+		akoProject.Status.ID = "project-id"
+		akoProject.Spec.Integrations = []project.Integration{{
+			Type:            "WEBHOOK",
+			URLRef:          common.ResourceRefNamespaced{Name: "webhook-url"},
+			SecretRef:       common.ResourceRefNamespaced{Name: "webhook-secret"},
+			ValidateWebhook: validate,
+		}}
+
+		return akoProject
+	}
+	newReconciler := func(t *testing.T, url string) *AtlasProjectReconciler {
+		sch := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(sch))
+		require.NoError(t, mdbv1.AddToScheme(sch))
+
+		return &AtlasProjectReconciler{
+			Client: fake.NewClientBuilder().WithScheme(sch).WithObjects(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "webhook-url", Namespace: "ns"},
+					Data:       map[string][]byte{"password": []byte(url)},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "webhook-secret", Namespace: "ns"},
+					Data:       map[string][]byte{"password": []byte("webhook-secret")},
+				},
+			).Build(),
+			Scheme:        sch,
+			Log:           zaptest.NewLogger(t).Sugar(),
+			EventRecorder: record.NewFakeRecorder(10),
+		}
+	}
+	webhookStatus := func(workflowCtx *workflow.Context) *status.WebhookIntegration {
+		projectStatus := status.AtlasProjectStatus{}
+		for _, option := range workflowCtx.StatusOptions() {
+			option.(status.AtlasProjectStatusOption)(&projectStatus)
+		}
+
+		return projectStatus.WebhookIntegration
+	}
+
+	t.Run("should validate the webhook once its credentials are applied", func(t *testing.T) {
+		deliveries, accept = 0, true
+		r := newReconciler(t, server.URL)
+		akoProject := newProject(true)
+		workflowCtx := newContext(t)
+
+		require.True(t, r.ensureWebhookIntegration(workflowCtx, akoProject, now).IsOk())
+
+		webhook := webhookStatus(workflowCtx)
+		require.NotNil(t, webhook)
+		assert.Equal(t, webhookCredentialsHash(server.URL, "webhook-secret"), webhook.CredentialsHash)
+		assert.Equal(t, "2024-03-01T12:00:00Z", webhook.LastRotated)
+		assert.Equal(t, "2024-03-01T12:00:00Z", webhook.LastSuccessfulProbe)
+		assert.Empty(t, webhook.ValidationError)
+		assert.Equal(t, 1, deliveries)
+		assert.Len(t, workflowCtx.ListResourcesToWatch(), 2)
+
+		akoProject.Status.WebhookIntegration = webhook
+		workflowCtx = newContext(t)
+		require.True(t, r.ensureWebhookIntegration(workflowCtx, akoProject, now.Add(time.Hour)).IsOk())
+		assert.Equal(t, webhook, webhookStatus(workflowCtx))
+		assert.Equal(t, 1, deliveries)
+	})
+
+	t.Run("should report the rotation of the credentials", func(t *testing.T) {
+		deliveries, accept = 0, true
+		r := newReconciler(t, server.URL+"/rotated")
+		akoProject := newProject(false)
+		akoProject.Status.WebhookIntegration = &status.WebhookIntegration{
+			CredentialsHash: webhookCredentialsHash(server.URL, "webhook-secret"),
+			LastRotated:     "2024-01-01T12:00:00Z",
+		}
+		workflowCtx := newContext(t)
+
+		require.True(t, r.ensureWebhookIntegration(workflowCtx, akoProject, now).IsOk())
+
+		webhook := webhookStatus(workflowCtx)
+		assert.Equal(t, webhookCredentialsHash(server.URL+"/rotated", "webhook-secret"), webhook.CredentialsHash)
+		assert.Equal(t, "2024-03-01T12:00:00Z", webhook.LastRotated)
+		assert.Zero(t, deliveries)
+		events := r.EventRecorder.(*record.FakeRecorder).Events
+		require.Len(t, events, 1)
+		assert.Contains(t, <-events, "WebhookIntegrationRotated")
+	})
+
+	t.Run("should fail until the webhook accepts the test notification", func(t *testing.T) {
+		deliveries, accept = 0, false
+		r := newReconciler(t, server.URL)
+		akoProject := newProject(true)
+		workflowCtx := newContext(t)
+
+		result := r.ensureWebhookIntegration(workflowCtx, akoProject, now)
+
+		require.False(t, result.IsOk())
+		webhook := webhookStatus(workflowCtx)
+		assert.Contains(t, webhook.ValidationError, "503 Service Unavailable")
+		assert.Empty(t, webhook.LastSuccessfulProbe)
+
+		assert.Equal(t, 1, webhook.ValidationFailures)
+		assert.Equal(t, time.Minute, result.ReconcileResult().RequeueAfter)
+
+		accept = true
+		akoProject.Status.WebhookIntegration = webhook
+		workflowCtx = newContext(t)
+		require.True(t, r.ensureWebhookIntegration(workflowCtx, akoProject, now.Add(time.Minute)).IsOk())
+		assert.Equal(t, "2024-03-01T12:01:00Z", webhookStatus(workflowCtx).LastSuccessfulProbe)
+		assert.Zero(t, webhookStatus(workflowCtx).ValidationFailures)
+		assert.Equal(t, 2, deliveries)
+	})
+
+	t.Run("should back off the failed test notifications", func(t *testing.T) {
+		deliveries, accept = 0, false
+		r := newReconciler(t, server.URL)
+		akoProject := newProject(true)
+		akoProject.Status.WebhookIntegration = &status.WebhookIntegration{
+			CredentialsHash:    webhookCredentialsHash(server.URL, "webhook-secret"),
+			LastValidated:      "2024-03-01T12:00:00Z",
+			ValidationError:    "the webhook answered with the status 503 Service Unavailable",
+			ValidationFailures: 3,
+		}
+		workflowCtx := newContext(t)
+
+		result := r.ensureWebhookIntegration(workflowCtx, akoProject, now.Add(time.Minute))
+
+		require.False(t, result.IsOk())
+		assert.Equal(t, 3*time.Minute, result.ReconcileResult().RequeueAfter)
+		assert.Zero(t, deliveries)
+
+		workflowCtx = newContext(t)
+		result = r.ensureWebhookIntegration(workflowCtx, akoProject, now.Add(4*time.Minute))
+
+		require.False(t, result.IsOk())
+		assert.Equal(t, 4, webhookStatus(workflowCtx).ValidationFailures)
+		assert.Equal(t, 8*time.Minute, result.ReconcileResult().RequeueAfter)
+		assert.Equal(t, 1, deliveries)
+	})
+
+	t.Run("should clear the status without a webhook integration", func(t *testing.T) {
+		r := newReconciler(t, server.URL)
+		akoProject := newProject(true)
+		akoProject.Spec.Integrations = nil
+		akoProject.Status.WebhookIntegration = &status.WebhookIntegration{CredentialsHash: "hash"}
+		workflowCtx := newContext(t)
+
+		require.True(t, r.ensureWebhookIntegration(workflowCtx, akoProject, now).IsOk())
+		assert.Nil(t, webhookStatus(workflowCtx))
+	})
+}
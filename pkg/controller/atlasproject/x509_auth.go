@@ -17,6 +17,8 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
 
+const x509Reconciler = "x509"
+
 func (r *AtlasProjectReconciler) ensureX509(ctx *workflow.Context, projectID string, project *mdbv1.AtlasProject) (authmode.AuthModes, workflow.Result) {
 	log := ctx.Log
 
@@ -34,7 +36,7 @@ func (r *AtlasProjectReconciler) ensureX509(ctx *workflow.Context, projectID str
 		log.Infow("Disable x509 auth", "projectID", projectID)
 		_, err := ctx.Client.X509AuthDBUsers.DisableCustomerX509(ctx.Context, projectID)
 		if err != nil {
-			return authModes, workflow.Terminate(workflow.Internal, err.Error())
+			return authModes, workflow.Terminate(workflow.Internal, err.Error()).WithCause(x509Reconciler, "disableCustomerManagedX509")
 		}
 		authModes.RemoveAuthMode(authmode.X509)
 		return authModes, workflow.OK()
@@ -42,7 +44,7 @@ func (r *AtlasProjectReconciler) ensureX509(ctx *workflow.Context, projectID str
 
 	customer, _, err := ctx.Client.X509AuthDBUsers.GetCurrentX509Conf(ctx.Context, projectID)
 	if err != nil {
-		return authModes, workflow.Terminate(workflow.Internal, err.Error())
+		return authModes, workflow.Terminate(workflow.Internal, err.Error()).WithCause(x509Reconciler, "getLdapConfiguration")
 	}
 
 	if specCert != customer.Cas {
@@ -54,7 +56,7 @@ func (r *AtlasProjectReconciler) ensureX509(ctx *workflow.Context, projectID str
 
 		_, _, err := ctx.Client.X509AuthDBUsers.SaveConfiguration(ctx.Context, projectID, &conf)
 		if err != nil {
-			return authModes, workflow.Terminate(workflow.Internal, err.Error())
+			return authModes, workflow.Terminate(workflow.Internal, err.Error()).WithCause(x509Reconciler, "saveLdapConfiguration")
 		}
 	}
 
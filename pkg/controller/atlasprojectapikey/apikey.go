@@ -0,0 +1,267 @@
+package atlasprojectapikey
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/timeutil"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/connectionsecret"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// ensureProjectAPIKey keeps the description and roles of the key in sync, creating a new key whenever the current one
+// can't be used anymore or is due for rotation. The private part of a key is only returned on creation, so a key whose
+// Secret was removed is replaced as well
+func (r *AtlasProjectAPIKeyReconciler) ensureProjectAPIKey(ctx *workflow.Context, apiKey *mdbv1.AtlasProjectAPIKey, projectID string) workflow.Result {
+	if apiKey.Status.PendingKeyID != "" {
+		if err := r.resumeRotation(ctx, apiKey); err != nil {
+			return workflow.Terminate(workflow.ProjectAPIKeyNotCreatedInAtlas, fmt.Sprintf("failed to complete the interrupted rotation: %s", err))
+		}
+	}
+
+	secretExists, err := r.secretExists(ctx.Context, apiKey.SecretObjectKey())
+	if err != nil {
+		return workflow.Terminate(workflow.Internal, err.Error())
+	}
+
+	var current *admin.ApiKeyUserDetails
+	if apiKey.Status.KeyID != "" {
+		key, resp, err := ctx.SdkClient.ProgrammaticAPIKeysApi.GetApiKey(ctx.Context, ctx.OrgID, apiKey.Status.KeyID).Execute()
+		if err != nil && !isNotFound(resp) {
+			return workflow.Terminate(workflow.ProjectAPIKeyNotUpdatedInAtlas, fmt.Sprintf("failed to retrieve the API key: %s", err))
+		}
+		if err == nil {
+			current = key
+		}
+	}
+
+	rotationRequest := apiKey.GetAnnotations()[customresource.RotateAPIKeyAnnotation]
+	if reason := rotationReason(apiKey, current != nil, secretExists, rotationRequest, time.Now()); reason != "" {
+		ctx.Log.Infow("Creating a new API key", "reason", reason)
+		return r.rotateProjectAPIKey(ctx, apiKey, projectID, current, rotationRequest)
+	}
+
+	description := apiKey.KeyDescription()
+	roles := apiKey.Spec.RoleNames()
+	if current.GetDesc() != description || !sameElements(projectRoles(current, projectID), roles) {
+		_, _, err = ctx.SdkClient.ProgrammaticAPIKeysApi.
+			UpdateApiKeyRoles(ctx.Context, projectID, current.GetId(), &admin.UpdateAtlasProjectApiKey{Desc: &description, Roles: &roles}).
+			Execute()
+		if err != nil {
+			return workflow.Terminate(workflow.ProjectAPIKeyNotUpdatedInAtlas, fmt.Sprintf("failed to update the API key: %s", err))
+		}
+	}
+
+	createdAt, err := timeutil.ParseISO8601(apiKey.Status.CreatedAt)
+	if err != nil {
+		createdAt = time.Now()
+	}
+	ctx.SetConditionTrue(status.ProjectAPIKeyReadyType)
+
+	return okUntilRotation(apiKey.Spec.TTL, createdAt)
+}
+
+// rotateProjectAPIKey creates a new key, writes it into the Secret and deletes the key it replaces. The new key is
+// recorded as pending in the status before being written into the Secret, so that it's never orphaned when the
+// reconciliation is interrupted. A new key which couldn't be written into the Secret is deleted right away
+func (r *AtlasProjectAPIKeyReconciler) rotateProjectAPIKey(ctx *workflow.Context, apiKey *mdbv1.AtlasProjectAPIKey, projectID string, current *admin.ApiKeyUserDetails, rotationRequest string) workflow.Result {
+	key, _, err := ctx.SdkClient.ProgrammaticAPIKeysApi.
+		CreateProjectApiKey(ctx.Context, projectID, &admin.CreateAtlasProjectApiKey{Desc: apiKey.KeyDescription(), Roles: apiKey.Spec.RoleNames()}).
+		Execute()
+	if err != nil {
+		return workflow.Terminate(workflow.ProjectAPIKeyNotCreatedInAtlas, fmt.Sprintf("failed to create the API key: %s", err))
+	}
+
+	if err = r.recordPendingKey(ctx.Context, apiKey, key.GetId()); err != nil {
+		r.discardKey(ctx, apiKey, key.GetId(), "failed to delete the API key which couldn't be recorded as pending")
+
+		return workflow.Terminate(workflow.Internal, fmt.Sprintf("failed to record the new API key in the status: %s", err))
+	}
+
+	if err = r.writeSecret(ctx.Context, apiKey, ctx.OrgID, key); err != nil {
+		r.discardKey(ctx, apiKey, key.GetId(), "failed to delete the API key which couldn't be written into the Secret")
+
+		return workflow.Terminate(workflow.ProjectAPIKeySecretNotWritten, fmt.Sprintf("failed to write the API key into the Secret: %s", err))
+	}
+
+	createdAt := time.Now()
+	ctx.EnsureStatusOption(status.AtlasProjectAPIKeyOption(key.GetId(), key.GetPublicKey(), timeutil.FormatISO8601(createdAt), rotationRequest))
+
+	if current != nil {
+		if err = deleteAPIKey(ctx, current.GetId()); err != nil {
+			ctx.Log.Errorw("failed to delete the replaced API key", "keyID", current.GetId(), "error", err)
+		}
+	}
+	ctx.SetConditionTrue(status.ProjectAPIKeyReadyType)
+
+	return okUntilRotation(apiKey.Spec.TTL, createdAt)
+}
+
+// resumeRotation completes a rotation interrupted after the new key was created: the new key is switched to when it was
+// written into the Secret, and deleted otherwise
+func (r *AtlasProjectAPIKeyReconciler) resumeRotation(ctx *workflow.Context, apiKey *mdbv1.AtlasProjectAPIKey) error {
+	pendingID := apiKey.Status.PendingKeyID
+	pending, resp, err := ctx.SdkClient.ProgrammaticAPIKeysApi.GetApiKey(ctx.Context, ctx.OrgID, pendingID).Execute()
+	if err != nil && !isNotFound(resp) {
+		return fmt.Errorf("failed to retrieve the pending API key %s: %w", pendingID, err)
+	}
+
+	secret := &corev1.Secret{}
+	if err == nil {
+		if err = r.Client.Get(ctx.Context, apiKey.SecretObjectKey(), secret); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	if pending != nil && atlas.SecretPublicKey(secret) == pending.GetPublicKey() {
+		ctx.Log.Infow("Switching to the API key written into the Secret by the interrupted rotation", "keyID", pendingID)
+		replacedID := apiKey.Status.KeyID
+		switched := status.AtlasProjectAPIKeyOption(pendingID, pending.GetPublicKey(), timeutil.FormatISO8601(time.Now()), apiKey.GetAnnotations()[customresource.RotateAPIKeyAnnotation])
+		// the rest of the reconciliation works on the key switched to
+		switched(&apiKey.Status)
+		ctx.EnsureStatusOption(switched)
+		if replacedID != "" && replacedID != pendingID {
+			if err = deleteAPIKey(ctx, replacedID); err != nil {
+				ctx.Log.Errorw("failed to delete the replaced API key", "keyID", replacedID, "error", err)
+			}
+		}
+
+		return nil
+	}
+
+	ctx.Log.Infow("Deleting the API key left over by the interrupted rotation", "keyID", pendingID)
+	if err = deleteAPIKey(ctx, pendingID); err != nil {
+		return err
+	}
+
+	return r.recordPendingKey(ctx.Context, apiKey, "")
+}
+
+// recordPendingKey patches the pending key into the status immediately, rather than waiting for the status update at
+// the end of the reconciliation
+func (r *AtlasProjectAPIKeyReconciler) recordPendingKey(ctx context.Context, apiKey *mdbv1.AtlasProjectAPIKey, keyID string) error {
+	patch := client.MergeFrom(apiKey.DeepCopy())
+	apiKey.Status.PendingKeyID = keyID
+
+	return r.Client.Status().Patch(ctx, apiKey, patch)
+}
+
+// discardKey deletes the new key of a failed rotation, which is left pending when it can't be deleted
+func (r *AtlasProjectAPIKeyReconciler) discardKey(ctx *workflow.Context, apiKey *mdbv1.AtlasProjectAPIKey, keyID, message string) {
+	if err := deleteAPIKey(ctx, keyID); err != nil {
+		ctx.Log.Errorw(message, "keyID", keyID, "error", err)
+		return
+	}
+
+	if apiKey.Status.PendingKeyID == keyID {
+		if err := r.recordPendingKey(ctx.Context, apiKey, ""); err != nil {
+			ctx.Log.Errorw("failed to clear the pending API key from the status", "keyID", keyID, "error", err)
+		}
+	}
+}
+
+// writeSecret writes the key pair into the Secret owned by the resource, so that it's removed along with it
+func (r *AtlasProjectAPIKeyReconciler) writeSecret(ctx context.Context, apiKey *mdbv1.AtlasProjectAPIKey, orgID string, key *admin.ApiKeyUserDetails) error {
+	secretKey := apiKey.SecretObjectKey()
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: secretKey.Namespace, Name: secretKey.Name}}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if secret.Labels == nil {
+			secret.Labels = map[string]string{}
+		}
+		secret.Labels[connectionsecret.TypeLabelKey] = connectionsecret.CredLabelVal
+		atlas.SetSecretCredentials(secret, orgID, key.GetPublicKey(), key.GetPrivateKey())
+
+		return controllerutil.SetControllerReference(apiKey, secret, r.Scheme)
+	})
+
+	return err
+}
+
+func (r *AtlasProjectAPIKeyReconciler) secretExists(ctx context.Context, key client.ObjectKey) (bool, error) {
+	err := r.Client.Get(ctx, key, &corev1.Secret{})
+	if apiErrors.IsNotFound(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// rotationReason returns why the key must be replaced by a new one, empty when the current key is still valid
+func rotationReason(apiKey *mdbv1.AtlasProjectAPIKey, keyExists, secretExists bool, rotationRequest string, now time.Time) string {
+	switch {
+	case apiKey.Status.KeyID == "":
+		return "the key doesn't exist yet"
+	case !keyExists:
+		return "the key was removed from Atlas"
+	case !secretExists:
+		return "the Secret was removed and the private key can't be retrieved again"
+	case rotationRequest != apiKey.Status.RotationRequest:
+		return "the rotation was requested by annotation"
+	}
+
+	if apiKey.Spec.TTL != nil {
+		createdAt, err := timeutil.ParseISO8601(apiKey.Status.CreatedAt)
+		if err != nil || !now.Before(createdAt.Add(apiKey.Spec.TTL.Duration)) {
+			return "the key reached its TTL"
+		}
+	}
+
+	return ""
+}
+
+// okUntilRotation requeues the resource for the rotation of a key with a TTL
+func okUntilRotation(ttl *metav1.Duration, createdAt time.Time) workflow.Result {
+	if ttl == nil {
+		return workflow.OK()
+	}
+
+	return workflow.OK().WithRetry(time.Until(createdAt.Add(ttl.Duration)))
+}
+
+func deleteAPIKey(ctx *workflow.Context, keyID string) error {
+	_, resp, err := ctx.SdkClient.ProgrammaticAPIKeysApi.DeleteApiKey(ctx.Context, ctx.OrgID, keyID).Execute()
+	if err != nil && !isNotFound(resp) {
+		return fmt.Errorf("failed to delete the API key %s: %w", keyID, err)
+	}
+
+	return nil
+}
+
+// projectRoles returns the roles of the key over the project, leaving out the ones over the organization
+func projectRoles(key *admin.ApiKeyUserDetails, projectID string) []string {
+	var roles []string
+	for _, role := range key.GetRoles() {
+		if role.GetGroupId() == projectID {
+			roles = append(roles, role.GetRoleName())
+		}
+	}
+
+	return roles
+}
+
+func isNotFound(resp *http.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusNotFound
+}
+
+func sameElements(current, desired []string) bool {
+	current = slices.Clone(current)
+	desired = slices.Clone(desired)
+	slices.Sort(current)
+	slices.Sort(desired)
+
+	return slices.Equal(current, desired)
+}
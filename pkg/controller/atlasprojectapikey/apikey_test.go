@@ -0,0 +1,265 @@
+package atlasprojectapikey
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/timeutil"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func newAPIKey() *mdbv1.AtlasProjectAPIKey {
+	return &mdbv1.AtlasProjectAPIKey{
+		ObjectMeta: metav1.ObjectMeta{Name: "api-key", Namespace: "default", UID: "uid"},
+		Spec: mdbv1.AtlasProjectAPIKeySpec{
+			Project: common.ResourceRefNamespaced{Name: "project"},
+			Roles:   []mdbv1.TeamRole{mdbv1.TeamRoleReadOnly},
+		},
+	}
+}
+
+func newReconciler(t *testing.T, objects ...client.Object) *AtlasProjectAPIKeyReconciler {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, mdbv1.AddToScheme(scheme))
+
+	return &AtlasProjectAPIKeyReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).WithStatusSubresource(&mdbv1.AtlasProjectAPIKey{}).Build(),
+		Scheme: scheme,
+	}
+}
+
+func newContext(t *testing.T, keysAPI admin.ProgrammaticAPIKeysApi) *workflow.Context {
+	ctx := workflow.NewContext(zaptest.NewLogger(t).Sugar(), []status.Condition{}, context.Background())
+	ctx.SdkClient = &admin.APIClient{ProgrammaticAPIKeysApi: keysAPI}
+	ctx.OrgID = "org-id"
+
+	return ctx
+}
+
+func newSecret() *corev1.Secret {
+	return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "api-key-api-key", Namespace: "default"}}
+}
+
+func expectCurrentKey(keysAPI *atlasmock.ProgrammaticAPIKeysApiMock, key *admin.ApiKeyUserDetails, resp *http.Response, err error) {
+	keysAPI.EXPECT().GetApiKey(mock.Anything, "org-id", "old-key-id").
+		Return(admin.GetApiKeyApiRequest{ApiService: keysAPI})
+	keysAPI.EXPECT().GetApiKeyExecute(mock.Anything).Return(key, resp, err)
+}
+
+func expectNewKey(keysAPI *atlasmock.ProgrammaticAPIKeysApiMock) {
+	keysAPI.EXPECT().CreateProjectApiKey(mock.Anything, "project-id", &admin.CreateAtlasProjectApiKey{
+		Desc:  "default/api-key",
+		Roles: []string{"GROUP_READ_ONLY"},
+	}).Return(admin.CreateProjectApiKeyApiRequest{ApiService: keysAPI})
+	keysAPI.EXPECT().CreateProjectApiKeyExecute(mock.Anything).Return(&admin.ApiKeyUserDetails{
+		Id:         admin.PtrString("new-key-id"),
+		PublicKey:  admin.PtrString("public"),
+		PrivateKey: admin.PtrString("private"),
+	}, nil, nil)
+}
+
+func currentKey(roles ...string) *admin.ApiKeyUserDetails {
+	assignments := []admin.CloudAccessRoleAssignment{{OrgId: admin.PtrString("org-id"), RoleName: admin.PtrString("ORG_MEMBER")}}
+	for _, role := range roles {
+		assignments = append(assignments, admin.CloudAccessRoleAssignment{GroupId: admin.PtrString("project-id"), RoleName: admin.PtrString(role)})
+	}
+
+	return &admin.ApiKeyUserDetails{Id: admin.PtrString("old-key-id"), Desc: admin.PtrString("default/api-key"), Roles: &assignments}
+}
+
+func TestEnsureProjectAPIKey(t *testing.T) {
+	t.Run("should create the key and write it into the Secret", func(t *testing.T) {
+		keysAPI := atlasmock.NewProgrammaticAPIKeysApiMock(t)
+		expectNewKey(keysAPI)
+		apiKey := newAPIKey()
+		r := newReconciler(t, apiKey)
+		ctx := newContext(t, keysAPI)
+
+		result := r.ensureProjectAPIKey(ctx, apiKey, "project-id")
+
+		require.True(t, result.IsOk())
+		secret := &corev1.Secret{}
+		require.NoError(t, r.Client.Get(context.Background(), apiKey.SecretObjectKey(), secret))
+		assert.Equal(t, "org-id", string(secret.Data["orgId"]))
+		assert.Equal(t, "public", string(secret.Data["publicApiKey"]))
+		assert.Equal(t, "private", string(secret.Data["privateApiKey"]))
+		assert.Equal(t, "uid", string(secret.OwnerReferences[0].UID))
+		apiKey.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+		assert.Equal(t, "new-key-id", apiKey.Status.KeyID)
+		assert.Equal(t, "public", apiKey.Status.PublicKey)
+		assert.Empty(t, apiKey.Status.PendingKeyID)
+	})
+
+	t.Run("should sync the roles of the current key", func(t *testing.T) {
+		keysAPI := atlasmock.NewProgrammaticAPIKeysApiMock(t)
+		expectCurrentKey(keysAPI, currentKey("GROUP_OWNER"), nil, nil)
+		keysAPI.EXPECT().UpdateApiKeyRoles(mock.Anything, "project-id", "old-key-id", &admin.UpdateAtlasProjectApiKey{
+			Desc:  admin.PtrString("default/api-key"),
+			Roles: &[]string{"GROUP_READ_ONLY"},
+		}).Return(admin.UpdateApiKeyRolesApiRequest{ApiService: keysAPI})
+		keysAPI.EXPECT().UpdateApiKeyRolesExecute(mock.Anything).Return(nil, nil, nil)
+		apiKey := newAPIKey()
+		apiKey.Status.KeyID = "old-key-id"
+
+		result := newReconciler(t, newSecret()).ensureProjectAPIKey(newContext(t, keysAPI), apiKey, "project-id")
+
+		require.True(t, result.IsOk())
+	})
+
+	t.Run("should leave the current key untouched when in sync", func(t *testing.T) {
+		keysAPI := atlasmock.NewProgrammaticAPIKeysApiMock(t)
+		expectCurrentKey(keysAPI, currentKey("GROUP_READ_ONLY"), nil, nil)
+		apiKey := newAPIKey()
+		apiKey.Status.KeyID = "old-key-id"
+
+		result := newReconciler(t, newSecret()).ensureProjectAPIKey(newContext(t, keysAPI), apiKey, "project-id")
+
+		require.True(t, result.IsOk())
+	})
+
+	t.Run("should replace a key removed from Atlas", func(t *testing.T) {
+		keysAPI := atlasmock.NewProgrammaticAPIKeysApiMock(t)
+		expectCurrentKey(keysAPI, nil, &http.Response{StatusCode: http.StatusNotFound}, assert.AnError)
+		expectNewKey(keysAPI)
+		apiKey := newAPIKey()
+		apiKey.Status.KeyID = "old-key-id"
+
+		result := newReconciler(t, newSecret(), apiKey).ensureProjectAPIKey(newContext(t, keysAPI), apiKey, "project-id")
+
+		require.True(t, result.IsOk())
+	})
+
+	t.Run("should rotate the key on request and delete the replaced one", func(t *testing.T) {
+		keysAPI := atlasmock.NewProgrammaticAPIKeysApiMock(t)
+		expectCurrentKey(keysAPI, currentKey("GROUP_READ_ONLY"), nil, nil)
+		expectNewKey(keysAPI)
+		keysAPI.EXPECT().DeleteApiKey(mock.Anything, "org-id", "old-key-id").
+			Return(admin.DeleteApiKeyApiRequest{ApiService: keysAPI})
+		keysAPI.EXPECT().DeleteApiKeyExecute(mock.Anything).Return(nil, nil, nil)
+		ctx := newContext(t, keysAPI)
+		apiKey := newAPIKey()
+		apiKey.Annotations = map[string]string{customresource.RotateAPIKeyAnnotation: "2"}
+		apiKey.Status.KeyID = "old-key-id"
+		apiKey.Status.RotationRequest = "1"
+
+		result := newReconciler(t, newSecret(), apiKey).ensureProjectAPIKey(ctx, apiKey, "project-id")
+
+		require.True(t, result.IsOk())
+		apiKey.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+		assert.Equal(t, "new-key-id", apiKey.Status.KeyID)
+		assert.Equal(t, "2", apiKey.Status.RotationRequest)
+	})
+
+	t.Run("should record the new key as pending before writing it into the Secret", func(t *testing.T) {
+		keysAPI := atlasmock.NewProgrammaticAPIKeysApiMock(t)
+		expectNewKey(keysAPI)
+		keysAPI.EXPECT().DeleteApiKey(mock.Anything, "org-id", "new-key-id").
+			Return(admin.DeleteApiKeyApiRequest{ApiService: keysAPI})
+		keysAPI.EXPECT().DeleteApiKeyExecute(mock.Anything).Return(nil, nil, assert.AnError)
+		apiKey := newAPIKey()
+		// the Secret of another resource can't be taken over
+		secret := newSecret()
+		secret.OwnerReferences = []metav1.OwnerReference{{APIVersion: "v1", Kind: "ConfigMap", Name: "other", UID: "other-uid", Controller: admin.PtrBool(true)}}
+		r := newReconciler(t, secret, apiKey)
+
+		result := r.ensureProjectAPIKey(newContext(t, keysAPI), apiKey, "project-id")
+
+		require.False(t, result.IsOk())
+		assert.Equal(t, workflow.ProjectAPIKeySecretNotWritten, result.GetReason())
+		stored := &mdbv1.AtlasProjectAPIKey{}
+		require.NoError(t, r.Client.Get(context.Background(), client.ObjectKeyFromObject(apiKey), stored))
+		assert.Equal(t, "new-key-id", stored.Status.PendingKeyID)
+	})
+
+	t.Run("should switch to the pending key written into the Secret", func(t *testing.T) {
+		keysAPI := atlasmock.NewProgrammaticAPIKeysApiMock(t)
+		keysAPI.EXPECT().GetApiKey(mock.Anything, "org-id", "new-key-id").
+			Return(admin.GetApiKeyApiRequest{ApiService: keysAPI})
+		keysAPI.EXPECT().GetApiKeyExecute(mock.Anything).Return(&admin.ApiKeyUserDetails{
+			Id:        admin.PtrString("new-key-id"),
+			PublicKey: admin.PtrString("public"),
+			Desc:      admin.PtrString("default/api-key"),
+			Roles:     &[]admin.CloudAccessRoleAssignment{{GroupId: admin.PtrString("project-id"), RoleName: admin.PtrString("GROUP_READ_ONLY")}},
+		}, nil, nil)
+		keysAPI.EXPECT().DeleteApiKey(mock.Anything, "org-id", "old-key-id").
+			Return(admin.DeleteApiKeyApiRequest{ApiService: keysAPI})
+		keysAPI.EXPECT().DeleteApiKeyExecute(mock.Anything).Return(nil, nil, nil)
+		ctx := newContext(t, keysAPI)
+		apiKey := newAPIKey()
+		apiKey.Status.KeyID = "old-key-id"
+		apiKey.Status.PendingKeyID = "new-key-id"
+		secret := newSecret()
+		secret.Data = map[string][]byte{"publicApiKey": []byte("public")}
+
+		result := newReconciler(t, secret, apiKey).ensureProjectAPIKey(ctx, apiKey, "project-id")
+
+		require.True(t, result.IsOk())
+		apiKey.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+		assert.Equal(t, "new-key-id", apiKey.Status.KeyID)
+		assert.Empty(t, apiKey.Status.PendingKeyID)
+	})
+
+	t.Run("should delete the pending key not written into the Secret", func(t *testing.T) {
+		keysAPI := atlasmock.NewProgrammaticAPIKeysApiMock(t)
+		keysAPI.EXPECT().GetApiKey(mock.Anything, "org-id", "new-key-id").
+			Return(admin.GetApiKeyApiRequest{ApiService: keysAPI})
+		keysAPI.EXPECT().GetApiKeyExecute(mock.Anything).Return(&admin.ApiKeyUserDetails{Id: admin.PtrString("new-key-id"), PublicKey: admin.PtrString("public")}, nil, nil).Once()
+		keysAPI.EXPECT().DeleteApiKey(mock.Anything, "org-id", "new-key-id").
+			Return(admin.DeleteApiKeyApiRequest{ApiService: keysAPI})
+		keysAPI.EXPECT().DeleteApiKeyExecute(mock.Anything).Return(nil, nil, nil)
+		expectCurrentKey(keysAPI, currentKey("GROUP_READ_ONLY"), nil, nil)
+		apiKey := newAPIKey()
+		apiKey.Status.KeyID = "old-key-id"
+		apiKey.Status.PendingKeyID = "new-key-id"
+		secret := newSecret()
+		secret.Data = map[string][]byte{"publicApiKey": []byte("old-public")}
+
+		result := newReconciler(t, secret, apiKey).ensureProjectAPIKey(newContext(t, keysAPI), apiKey, "project-id")
+
+		require.True(t, result.IsOk())
+		assert.Empty(t, apiKey.Status.PendingKeyID)
+	})
+}
+
+func TestRotationReason(t *testing.T) {
+	now := time.Now()
+	apiKey := newAPIKey()
+	apiKey.Status.KeyID = "key-id"
+	apiKey.Status.CreatedAt = timeutil.FormatISO8601(now.Add(-2 * time.Hour))
+
+	t.Run("should keep a valid key", func(t *testing.T) {
+		assert.Empty(t, rotationReason(apiKey, true, true, "", now))
+	})
+
+	t.Run("should replace a key whose Secret was removed", func(t *testing.T) {
+		assert.NotEmpty(t, rotationReason(apiKey, true, false, "", now))
+	})
+
+	t.Run("should rotate a key which reached its TTL", func(t *testing.T) {
+		withTTL := apiKey.DeepCopy()
+		withTTL.Spec.TTL = &metav1.Duration{Duration: time.Hour}
+		assert.NotEmpty(t, rotationReason(withTTL, true, true, "", now))
+
+		withTTL.Spec.TTL = &metav1.Duration{Duration: 3 * time.Hour}
+		assert.Empty(t, rotationReason(withTTL, true, true, "", now))
+	})
+}
@@ -0,0 +1,179 @@
+package atlasprojectapikey
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/notifier"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/statushandler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/watch"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// AtlasProjectAPIKeyReconciler reconciles an AtlasProjectAPIKey object
+type AtlasProjectAPIKeyReconciler struct {
+	Client                   client.Client
+	Log                      *zap.SugaredLogger
+	Scheme                   *runtime.Scheme
+	GlobalPredicates         []predicate.Predicate
+	EventRecorder            record.EventRecorder
+	FailureNotifier          *notifier.Notifier
+	AtlasProvider            atlas.Provider
+	ObjectDeletionProtection bool
+}
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasprojectapikeys,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasprojectapikeys/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasprojectapikeys,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasprojectapikeys/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",namespace=default,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+func (r *AtlasProjectAPIKeyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, _ error) {
+	log := r.Log.With("atlasprojectapikey", req.NamespacedName)
+
+	apiKey := &mdbv1.AtlasProjectAPIKey{}
+	result := customresource.PrepareResource(ctx, r.Client, req, apiKey, log)
+	if !result.IsOk() {
+		return result.ReconcileResult(), nil
+	}
+
+	if customresource.ReconciliationShouldBeSkipped(apiKey) {
+		log.Infow(fmt.Sprintf("-> Skipping AtlasProjectAPIKey reconciliation as annotation %s=%s", customresource.ReconciliationPolicyAnnotation, customresource.ReconciliationPolicySkip), "spec", apiKey.Spec)
+		if !apiKey.GetDeletionTimestamp().IsZero() {
+			if err := customresource.ManageFinalizer(ctx, r.Client, apiKey, customresource.UnsetFinalizer); err != nil {
+				log.Errorw("failed to remove finalizer", "error", err)
+				return workflow.Terminate(workflow.Internal, err.Error()).ReconcileResult(), nil
+			}
+		}
+		return workflow.OK().ReconcileResult(), nil
+	}
+
+	if customresource.NamespaceReconciliationPaused(ctx, r.Client, apiKey.Namespace, log) {
+		log.Infow(fmt.Sprintf("-> Pausing AtlasProjectAPIKey reconciliation as annotation %s=true is set on the namespace", customresource.ReconciliationPausedAnnotation))
+		return customresource.MarkReconciliationPaused(ctx, r.Client, r.EventRecorder, apiKey, log).ReconcileResult(), nil
+	}
+
+	workflowCtx := customresource.MarkReconciliationStarted(r.Client, apiKey, log, ctx)
+	log.Infow("-> Starting AtlasProjectAPIKey reconciliation", "spec", apiKey.Spec, "status", apiKey.Status)
+
+	if workflowCtx.Degraded(apiKey) {
+		log.Infow("-> Skipping the reconciliation of the degraded AtlasProjectAPIKey, change its spec or set the reapply annotation to retry")
+		return ctrl.Result{}, nil
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			res = workflowCtx.RecoverPanic("AtlasProjectAPIKey", p).ReconcileResult()
+		}
+		res = workflowCtx.CapRetries("AtlasProjectAPIKey", apiKey, res)
+		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, r.FailureNotifier, apiKey)
+	}()
+
+	resourceVersionIsValid := customresource.ValidateResourceVersion(workflowCtx, apiKey, r.Log)
+	if !resourceVersionIsValid.IsOk() {
+		r.Log.Debugf("AtlasProjectAPIKey validation result: %v", resourceVersionIsValid)
+		return resourceVersionIsValid.ReconcileResult(), nil
+	}
+
+	project := &mdbv1.AtlasProject{}
+	err := r.Client.Get(ctx, apiKey.AtlasProjectObjectKey(), project)
+	if err != nil && !(apiErrors.IsNotFound(err) && !apiKey.GetDeletionTimestamp().IsZero()) {
+		result = workflow.Terminate(workflow.Internal, err.Error())
+		workflowCtx.SetConditionFromResult(status.ProjectAPIKeyReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+
+	// keys belong to the organization and outlive their project, the global credentials are used to remove the key
+	// of a project already gone
+	var connectionSecretKey *client.ObjectKey
+	if err == nil {
+		connectionSecretKey = project.ConnectionSecretObjectKey()
+	}
+	atlasClient, orgID, err := r.AtlasProvider.SdkClient(workflowCtx.Context, connectionSecretKey, log)
+	if err != nil {
+		result = workflow.Terminate(workflow.AtlasAPIAccessNotConfigured, err.Error())
+		workflowCtx.SetConditionFromResult(status.ProjectAPIKeyReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+	workflowCtx.SdkClient = atlasClient
+	workflowCtx.OrgID = orgID
+
+	if !apiKey.GetDeletionTimestamp().IsZero() {
+		return r.handleDeletion(workflowCtx, apiKey).ReconcileResult(), nil
+	}
+
+	if !customresource.HaveFinalizer(apiKey, customresource.FinalizerLabel) {
+		if err = customresource.ManageFinalizer(ctx, r.Client, apiKey, customresource.SetFinalizer); err != nil {
+			result = workflow.Terminate(workflow.Internal, err.Error())
+			log.Errorw("failed to add finalizer", "error", err)
+			return result.ReconcileResult(), nil
+		}
+	}
+
+	if project.ID() == "" {
+		result = workflow.InProgress(workflow.ProjectAPIKeyProjectNotReady, fmt.Sprintf("waiting for the project %s to be created in Atlas", project.Spec.Name))
+		workflowCtx.SetConditionFromResult(status.ProjectAPIKeyReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+
+	result = r.ensureProjectAPIKey(workflowCtx, apiKey, project.ID())
+	if !result.IsOk() {
+		workflowCtx.SetConditionFromResult(status.ProjectAPIKeyReadyType, result)
+	}
+	workflowCtx.SetConditionFromResult(status.ReadyType, result)
+
+	return result.ReconcileResult(), nil
+}
+
+func (r *AtlasProjectAPIKeyReconciler) handleDeletion(ctx *workflow.Context, apiKey *mdbv1.AtlasProjectAPIKey) workflow.Result {
+	if !customresource.HaveFinalizer(apiKey, customresource.FinalizerLabel) {
+		return workflow.OK()
+	}
+
+	if customresource.IsResourcePolicyKeepOrDefault(apiKey, r.ObjectDeletionProtection) {
+		ctx.Log.Info("Not removing the API key from Atlas as per configuration")
+	} else {
+		for _, keyID := range []string{apiKey.Status.KeyID, apiKey.Status.PendingKeyID} {
+			if keyID == "" {
+				continue
+			}
+			if err := deleteAPIKey(ctx, keyID); err != nil {
+				result := workflow.Terminate(workflow.ProjectAPIKeyNotDeletedInAtlas, err.Error())
+				ctx.SetConditionFromResult(status.ProjectAPIKeyReadyType, result)
+				return result
+			}
+		}
+	}
+
+	if err := customresource.ManageFinalizer(ctx.Context, r.Client, apiKey, customresource.UnsetFinalizer); err != nil {
+		ctx.Log.Errorw("failed to remove finalizer", "error", err)
+		return workflow.Terminate(workflow.AtlasFinalizerNotRemoved, err.Error())
+	}
+
+	return workflow.OK()
+}
+
+func (r *AtlasProjectAPIKeyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("AtlasProjectAPIKey").
+		For(&mdbv1.AtlasProjectAPIKey{}, builder.WithPredicates(r.GlobalPredicates...)).
+		Owns(&corev1.Secret{}, builder.OnlyMetadata).
+		Watches(&mdbv1.AtlasProject{}, watch.NewProjectDependentsHandler(r.Client, &mdbv1.AtlasProjectAPIKeyList{}), builder.WithPredicates(watch.ProjectOrganizationChanged())).
+		Complete(r)
+}
@@ -0,0 +1,154 @@
+package atlasresourcepolicy
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/notifier"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/statushandler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/watch"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// AtlasResourcePolicyReconciler reconciles an AtlasResourcePolicy object
+type AtlasResourcePolicyReconciler struct {
+	watch.ResourceWatcher
+	Client                   client.Client
+	Log                      *zap.SugaredLogger
+	Scheme                   *runtime.Scheme
+	GlobalPredicates         []predicate.Predicate
+	EventRecorder            record.EventRecorder
+	FailureNotifier          *notifier.Notifier
+	AtlasProvider            atlas.Provider
+	ObjectDeletionProtection bool
+}
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasresourcepolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasresourcepolicies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasresourcepolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasresourcepolicies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *AtlasResourcePolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, _ error) {
+	log := r.Log.With("atlasresourcepolicy", req.NamespacedName)
+
+	policy := &mdbv1.AtlasResourcePolicy{}
+	result := customresource.PrepareResource(ctx, r.Client, req, policy, log)
+	if !result.IsOk() {
+		return result.ReconcileResult(), nil
+	}
+
+	if customresource.ReconciliationShouldBeSkipped(policy) {
+		log.Infow(fmt.Sprintf("-> Skipping AtlasResourcePolicy reconciliation as annotation %s=%s", customresource.ReconciliationPolicyAnnotation, customresource.ReconciliationPolicySkip), "spec", policy.Spec)
+		if !policy.GetDeletionTimestamp().IsZero() {
+			if err := customresource.ManageFinalizer(ctx, r.Client, policy, customresource.UnsetFinalizer); err != nil {
+				log.Errorw("failed to remove finalizer", "error", err)
+				return workflow.Terminate(workflow.Internal, err.Error()).ReconcileResult(), nil
+			}
+		}
+		return workflow.OK().ReconcileResult(), nil
+	}
+
+	if customresource.NamespaceReconciliationPaused(ctx, r.Client, policy.Namespace, log) {
+		log.Infow(fmt.Sprintf("-> Pausing AtlasResourcePolicy reconciliation as annotation %s=true is set on the namespace", customresource.ReconciliationPausedAnnotation))
+		return customresource.MarkReconciliationPaused(ctx, r.Client, r.EventRecorder, policy, log).ReconcileResult(), nil
+	}
+
+	workflowCtx := customresource.MarkReconciliationStarted(r.Client, policy, log, ctx)
+	log.Infow("-> Starting AtlasResourcePolicy reconciliation", "spec", policy.Spec, "status", policy.Status)
+
+	if workflowCtx.Degraded(policy) {
+		log.Infow("-> Skipping the reconciliation of the degraded AtlasResourcePolicy, change its spec or set the reapply annotation to retry")
+		return ctrl.Result{}, nil
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			res = workflowCtx.RecoverPanic("AtlasResourcePolicy", p).ReconcileResult()
+		}
+		res = workflowCtx.CapRetries("AtlasResourcePolicy", policy, res)
+		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, r.FailureNotifier, policy)
+	}()
+
+	resourceVersionIsValid := customresource.ValidateResourceVersion(workflowCtx, policy, r.Log)
+	if !resourceVersionIsValid.IsOk() {
+		r.Log.Debugf("AtlasResourcePolicy validation result: %v", resourceVersionIsValid)
+		return resourceVersionIsValid.ReconcileResult(), nil
+	}
+
+	if key := policy.ConnectionSecretObjectKey(); key != nil {
+		r.EnsureResourcesAreWatched(req.NamespacedName, "Secret", log, *key)
+	}
+
+	atlasClient, orgID, err := r.AtlasProvider.Client(workflowCtx.Context, policy.ConnectionSecretObjectKey(), log)
+	if err != nil {
+		result = workflow.Terminate(workflow.AtlasAPIAccessNotConfigured, err.Error())
+		workflowCtx.SetConditionFromResult(status.ReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+	workflowCtx.Client = atlasClient
+	workflowCtx.OrgID = orgID
+
+	if !policy.GetDeletionTimestamp().IsZero() {
+		return r.handleDeletion(workflowCtx, policy).ReconcileResult(), nil
+	}
+
+	if !customresource.HaveFinalizer(policy, customresource.FinalizerLabel) {
+		if err := customresource.ManageFinalizer(ctx, r.Client, policy, customresource.SetFinalizer); err != nil {
+			result = workflow.Terminate(workflow.Internal, err.Error())
+			log.Errorw("failed to add finalizer", "error", err)
+			return result.ReconcileResult(), nil
+		}
+	}
+
+	result = ensureResourcePolicy(workflowCtx, policy)
+	if !result.IsOk() {
+		workflowCtx.SetConditionFromResult(status.ResourcePolicyReadyType, result)
+	}
+	workflowCtx.SetConditionFromResult(status.ReadyType, result)
+
+	return result.ReconcileResult(), nil
+}
+
+func (r *AtlasResourcePolicyReconciler) handleDeletion(ctx *workflow.Context, policy *mdbv1.AtlasResourcePolicy) workflow.Result {
+	if !customresource.HaveFinalizer(policy, customresource.FinalizerLabel) {
+		return workflow.OK()
+	}
+
+	if customresource.IsResourcePolicyKeepOrDefault(policy, r.ObjectDeletionProtection) {
+		ctx.Log.Info("Not removing the resource policy from Atlas as per configuration")
+	} else if policy.Status.ID != "" {
+		if result := deleteResourcePolicy(ctx, policy); !result.IsOk() {
+			ctx.SetConditionFromResult(status.ResourcePolicyReadyType, result)
+			return result
+		}
+	}
+
+	if err := customresource.ManageFinalizer(ctx.Context, r.Client, policy, customresource.UnsetFinalizer); err != nil {
+		ctx.Log.Errorw("failed to remove finalizer", "error", err)
+		return workflow.Terminate(workflow.AtlasFinalizerNotRemoved, err.Error())
+	}
+
+	return workflow.OK()
+}
+
+func (r *AtlasResourcePolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("AtlasResourcePolicy").
+		For(&mdbv1.AtlasResourcePolicy{}, builder.WithPredicates(r.GlobalPredicates...)).
+		Watches(&corev1.Secret{}, watch.NewSecretHandler(r.WatchedResources), builder.OnlyMetadata).
+		Complete(r)
+}
@@ -0,0 +1,236 @@
+package atlasresourcepolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	resourcePoliciesPath = "api/atlas/v2/orgs/%s/resourcePolicies"
+	// resourcePoliciesMediaType is the version of the Atlas Admin API the resource policies were released with
+	resourcePoliciesMediaType = "application/vnd.atlas.2024-08-05+json"
+)
+
+// resourcePolicy holds the fields of an Atlas resource policy
+// TODO: Replace with the atlas-sdk ResourcePoliciesApi when the operator moves to a version providing it
+type resourcePolicy struct {
+	ID          string        `json:"id,omitempty"`
+	Name        string        `json:"name"`
+	Description string        `json:"description,omitempty"`
+	Policies    []cedarPolicy `json:"policies"`
+	Version     string        `json:"version,omitempty"`
+}
+
+type cedarPolicy struct {
+	Body string `json:"body"`
+}
+
+// ensureResourcePolicy creates the resource policy in the organization, or updates it when it differs from the spec.
+// The policy is looked up by name before being created, so that a policy created by a reconciliation which failed to
+// record its id is adopted rather than duplicated. A policy removed from Atlas is created again
+func ensureResourcePolicy(ctx *workflow.Context, policy *mdbv1.AtlasResourcePolicy) workflow.Result {
+	desired := &resourcePolicy{Name: policy.Spec.Name, Description: policy.Spec.Description}
+	for _, body := range policyBodies(&policy.Spec) {
+		desired.Policies = append(desired.Policies, cedarPolicy{Body: body})
+	}
+	if len(desired.Policies) == 0 {
+		return workflow.Terminate(workflow.ResourcePolicyInvalid, "the resource policy must have at least one rule")
+	}
+
+	var current *resourcePolicy
+	if policy.Status.ID != "" {
+		var err error
+		current, err = getResourcePolicy(ctx.Context, ctx.Client, ctx.OrgID, policy.Status.ID)
+		switch {
+		case isNotFound(err):
+			ctx.Log.Infow("the resource policy was removed from Atlas, creating it again", "id", policy.Status.ID)
+		case err != nil:
+			return workflow.Terminate(workflow.ResourcePolicyNotUpdatedInAtlas, fmt.Sprintf("failed to retrieve the resource policy: %s", err))
+		}
+	}
+
+	if current == nil {
+		var err error
+		current, err = findResourcePolicy(ctx.Context, ctx.Client, ctx.OrgID, desired.Name)
+		if err != nil {
+			return workflow.Terminate(workflow.ResourcePolicyNotCreatedInAtlas, fmt.Sprintf("failed to look the resource policy up: %s", err))
+		}
+	}
+
+	switch {
+	case current == nil:
+		created, err := sendResourcePolicy(ctx.Context, ctx.Client, http.MethodPost, fmt.Sprintf(resourcePoliciesPath, ctx.OrgID), desired)
+		if err != nil {
+			return workflow.Terminate(workflow.ResourcePolicyNotCreatedInAtlas, fmt.Sprintf("failed to create the resource policy: %s", err))
+		}
+
+		return resourcePolicyReady(ctx, created)
+	case sameResourcePolicy(current, desired):
+		return resourcePolicyReady(ctx, current)
+	default:
+		updated, err := sendResourcePolicy(ctx.Context, ctx.Client, http.MethodPatch, resourcePolicyPath(ctx.OrgID, current.ID), desired)
+		if err != nil {
+			return workflow.Terminate(workflow.ResourcePolicyNotUpdatedInAtlas, fmt.Sprintf("failed to update the resource policy: %s", err))
+		}
+
+		return resourcePolicyReady(ctx, updated)
+	}
+}
+
+func resourcePolicyReady(ctx *workflow.Context, atlasPolicy *resourcePolicy) workflow.Result {
+	ctx.EnsureStatusOption(status.AtlasResourcePolicyOption(atlasPolicy.ID, atlasPolicy.Version))
+	ctx.SetConditionTrue(status.ResourcePolicyReadyType)
+
+	return workflow.OK()
+}
+
+// deleteResourcePolicy removes the resource policy from the organization, a policy already gone is considered deleted
+func deleteResourcePolicy(ctx *workflow.Context, policy *mdbv1.AtlasResourcePolicy) workflow.Result {
+	req, err := ctx.Client.NewRequest(ctx.Context, http.MethodDelete, resourcePolicyPath(ctx.OrgID, policy.Status.ID), nil)
+	if err == nil {
+		setMediaType(req)
+		_, err = ctx.Client.Do(ctx.Context, req, nil)
+	}
+	if err != nil && !isNotFound(err) {
+		return workflow.Terminate(workflow.ResourcePolicyNotDeletedInAtlas, fmt.Sprintf("failed to delete the resource policy: %s", err))
+	}
+
+	return workflow.OK()
+}
+
+// policyBodies returns the Cedar policies of the spec, the ones of the dedicated fields followed by the custom ones
+func policyBodies(spec *mdbv1.AtlasResourcePolicySpec) []string {
+	var bodies []string
+	if len(spec.AllowedCloudProviders) > 0 {
+		providers := make([]string, 0, len(spec.AllowedCloudProviders))
+		for _, provider := range spec.AllowedCloudProviders {
+			providers = append(providers, fmt.Sprintf("cloud::cloudProvider::%q", strings.ToLower(string(provider))))
+		}
+		bodies = append(bodies, fmt.Sprintf(
+			"forbid (principal, action == cloud::Action::\"cluster.createEdit\", resource)\nunless { [%s].containsAll(context.cluster.cloudProviders) };",
+			strings.Join(providers, ", "),
+		))
+	}
+
+	if len(spec.ForbiddenRegions) > 0 {
+		regions := make([]string, 0, len(spec.ForbiddenRegions))
+		for _, region := range spec.ForbiddenRegions {
+			regions = append(regions, fmt.Sprintf("cloud::region::%q", region))
+		}
+		bodies = append(bodies, fmt.Sprintf(
+			"forbid (principal, action == cloud::Action::\"cluster.createEdit\", resource)\nwhen { context.cluster.regions.containsAny([%s]) };",
+			strings.Join(regions, ", "),
+		))
+	}
+
+	if spec.ForbidPublicIPAccess {
+		bodies = append(bodies,
+			"forbid (principal, action == cloud::Action::\"project.ipAccessList.modify\", resource)\nwhen { context.project.ipAccessList.contains(ip(\"0.0.0.0/0\")) };",
+		)
+	}
+
+	for _, body := range spec.Policies {
+		if body = strings.TrimSpace(body); body != "" {
+			bodies = append(bodies, body)
+		}
+	}
+
+	return bodies
+}
+
+func sameResourcePolicy(current, desired *resourcePolicy) bool {
+	if current.Name != desired.Name || current.Description != desired.Description || len(current.Policies) != len(desired.Policies) {
+		return false
+	}
+
+	currentBodies := make([]string, 0, len(current.Policies))
+	for _, p := range current.Policies {
+		currentBodies = append(currentBodies, strings.TrimSpace(p.Body))
+	}
+	for _, p := range desired.Policies {
+		if !slices.Contains(currentBodies, p.Body) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func resourcePolicyPath(orgID, policyID string) string {
+	return fmt.Sprintf(resourcePoliciesPath, orgID) + "/" + policyID
+}
+
+func getResourcePolicy(ctx context.Context, client *mongodbatlas.Client, orgID, policyID string) (*resourcePolicy, error) {
+	req, err := client.NewRequest(ctx, http.MethodGet, resourcePolicyPath(orgID, policyID), nil)
+	if err != nil {
+		return nil, err
+	}
+	setMediaType(req)
+
+	result := &resourcePolicy{}
+	if _, err = client.Do(ctx, req, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// findResourcePolicy returns the resource policy of the organization with the given name, nil when there is none
+func findResourcePolicy(ctx context.Context, client *mongodbatlas.Client, orgID, name string) (*resourcePolicy, error) {
+	req, err := client.NewRequest(ctx, http.MethodGet, fmt.Sprintf(resourcePoliciesPath, orgID), nil)
+	if err != nil {
+		return nil, err
+	}
+	setMediaType(req)
+
+	var policies []resourcePolicy
+	if _, err = client.Do(ctx, req, &policies); err != nil {
+		return nil, err
+	}
+
+	for i := range policies {
+		if policies[i].Name == name {
+			return &policies[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func sendResourcePolicy(ctx context.Context, client *mongodbatlas.Client, method, path string, body *resourcePolicy) (*resourcePolicy, error) {
+	req, err := client.NewRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	setMediaType(req)
+
+	result := &resourcePolicy{}
+	if _, err = client.Do(ctx, req, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// setMediaType selects the version of the Admin API the resource policies are available in
+func setMediaType(req *http.Request) {
+	req.Header.Set("Accept", resourcePoliciesMediaType)
+	if req.Header.Get("Content-Type") != "" {
+		req.Header.Set("Content-Type", resourcePoliciesMediaType)
+	}
+}
+
+func isNotFound(err error) bool {
+	var apiError *mongodbatlas.ErrorResponse
+	return errors.As(err, &apiError) && apiError.HTTPCode == http.StatusNotFound
+}
@@ -0,0 +1,227 @@
+package atlasresourcepolicy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	"go.uber.org/zap/zaptest"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const policiesPath = "/api/atlas/v2/orgs/org-id/resourcePolicies"
+
+func newContext(t *testing.T, handler http.HandlerFunc) *workflow.Context {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := mongodbatlas.New(server.Client(), mongodbatlas.SetBaseURL(server.URL+"/"))
+	require.NoError(t, err)
+
+	ctx := workflow.NewContext(zaptest.NewLogger(t).Sugar(), []status.Condition{}, context.Background())
+	ctx.Client = client
+	ctx.OrgID = "org-id"
+
+	return ctx
+}
+
+func newResourcePolicy() *mdbv1.AtlasResourcePolicy {
+	return &mdbv1.AtlasResourcePolicy{
+		Spec: mdbv1.AtlasResourcePolicySpec{
+			Name:                 "governance",
+			ForbidPublicIPAccess: true,
+		},
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(body))
+}
+
+func decodePolicy(t *testing.T, r *http.Request) *resourcePolicy {
+	t.Helper()
+	assert.Equal(t, resourcePoliciesMediaType, r.Header.Get("Accept"))
+	assert.Equal(t, resourcePoliciesMediaType, r.Header.Get("Content-Type"))
+	body := &resourcePolicy{}
+	require.NoError(t, json.NewDecoder(r.Body).Decode(body))
+
+	return body
+}
+
+func TestEnsureResourcePolicy(t *testing.T) {
+	t.Run("should create the resource policy", func(t *testing.T) {
+		ctx := newContext(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, policiesPath, r.URL.Path)
+			if r.Method == http.MethodGet {
+				writeJSON(w, http.StatusOK, `[{"id":"other-id","name":"other"}]`)
+				return
+			}
+			assert.Equal(t, http.MethodPost, r.Method)
+			body := decodePolicy(t, r)
+			assert.Equal(t, "governance", body.Name)
+			require.Len(t, body.Policies, 1)
+			assert.Contains(t, body.Policies[0].Body, `ip("0.0.0.0/0")`)
+			writeJSON(w, http.StatusOK, `{"id":"policy-id","name":"governance","version":"v1"}`)
+		})
+		policy := newResourcePolicy()
+
+		result := ensureResourcePolicy(ctx, policy)
+
+		require.True(t, result.IsOk())
+		policy.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+		assert.Equal(t, "policy-id", policy.Status.ID)
+		assert.Equal(t, "v1", policy.Status.Version)
+	})
+
+	t.Run("should update a resource policy which differs from the spec", func(t *testing.T) {
+		ctx := newContext(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, policiesPath+"/policy-id", r.URL.Path)
+			switch r.Method {
+			case http.MethodGet:
+				writeJSON(w, http.StatusOK, `{"id":"policy-id","name":"old","policies":[{"body":"forbid (principal, action, resource);"}]}`)
+			case http.MethodPatch:
+				assert.Equal(t, "governance", decodePolicy(t, r).Name)
+				writeJSON(w, http.StatusOK, `{"id":"policy-id","name":"governance","version":"v2"}`)
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		})
+		policy := newResourcePolicy()
+		policy.Status.ID = "policy-id"
+
+		result := ensureResourcePolicy(ctx, policy)
+
+		require.True(t, result.IsOk())
+		policy.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+		assert.Equal(t, "v2", policy.Status.Version)
+	})
+
+	t.Run("should leave a resource policy in sync untouched", func(t *testing.T) {
+		policy := newResourcePolicy()
+		policy.Status.ID = "policy-id"
+		current, err := json.Marshal(&resourcePolicy{
+			ID:       "policy-id",
+			Name:     "governance",
+			Policies: []cedarPolicy{{Body: policyBodies(&policy.Spec)[0] + "\n"}},
+			Version:  "v1",
+		})
+		require.NoError(t, err)
+		ctx := newContext(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			writeJSON(w, http.StatusOK, string(current))
+		})
+
+		assert.True(t, ensureResourcePolicy(ctx, policy).IsOk())
+	})
+
+	t.Run("should adopt the resource policy of the same name instead of creating a duplicate", func(t *testing.T) {
+		ctx := newContext(t, func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == policiesPath:
+				writeJSON(w, http.StatusOK, `[{"id":"policy-id","name":"governance","policies":[{"body":"forbid (principal, action, resource);"}]}]`)
+			case r.Method == http.MethodPatch && r.URL.Path == policiesPath+"/policy-id":
+				writeJSON(w, http.StatusOK, `{"id":"policy-id","name":"governance","version":"v2"}`)
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		})
+		policy := newResourcePolicy()
+
+		result := ensureResourcePolicy(ctx, policy)
+
+		require.True(t, result.IsOk())
+		policy.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+		assert.Equal(t, "policy-id", policy.Status.ID)
+	})
+
+	t.Run("should create again a resource policy removed from Atlas", func(t *testing.T) {
+		ctx := newContext(t, func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == policiesPath:
+				writeJSON(w, http.StatusOK, `[]`)
+			case r.Method == http.MethodGet:
+				writeJSON(w, http.StatusNotFound, `{"error":404,"errorCode":"RESOURCE_NOT_FOUND"}`)
+			case r.Method == http.MethodPost:
+				writeJSON(w, http.StatusOK, `{"id":"new-policy-id","name":"governance"}`)
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		})
+		policy := newResourcePolicy()
+		policy.Status.ID = "policy-id"
+
+		result := ensureResourcePolicy(ctx, policy)
+
+		require.True(t, result.IsOk())
+		policy.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+		assert.Equal(t, "new-policy-id", policy.Status.ID)
+	})
+
+	t.Run("should report the policies rejected by Atlas", func(t *testing.T) {
+		ctx := newContext(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				writeJSON(w, http.StatusOK, `[]`)
+				return
+			}
+			writeJSON(w, http.StatusBadRequest, `{"error":400,"errorCode":"INVALID_POLICY","detail":"invalid Cedar policy"}`)
+		})
+
+		result := ensureResourcePolicy(ctx, newResourcePolicy())
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.ResourcePolicyNotCreatedInAtlas, result.GetReason())
+	})
+
+	t.Run("should reject a resource policy without rules", func(t *testing.T) {
+		policy := newResourcePolicy()
+		policy.Spec.ForbidPublicIPAccess = false
+
+		result := ensureResourcePolicy(newContext(t, nil), policy)
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.ResourcePolicyInvalid, result.GetReason())
+	})
+}
+
+func TestPolicyBodies(t *testing.T) {
+	t.Run("should render the dedicated fields as Cedar policies followed by the custom ones", func(t *testing.T) {
+		spec := &mdbv1.AtlasResourcePolicySpec{
+			AllowedCloudProviders: []mdbv1.ResourcePolicyCloudProvider{"AWS", "GCP"},
+			ForbiddenRegions:      []mdbv1.ResourcePolicyRegion{"aws:us-east-1"},
+			Policies:              []string{"  forbid (principal, action, resource);\n", " "},
+		}
+
+		assert.Equal(t, []string{
+			"forbid (principal, action == cloud::Action::\"cluster.createEdit\", resource)\n" +
+				"unless { [cloud::cloudProvider::\"aws\", cloud::cloudProvider::\"gcp\"].containsAll(context.cluster.cloudProviders) };",
+			"forbid (principal, action == cloud::Action::\"cluster.createEdit\", resource)\n" +
+				"when { context.cluster.regions.containsAny([cloud::region::\"aws:us-east-1\"]) };",
+			"forbid (principal, action, resource);",
+		}, policyBodies(spec))
+	})
+}
+
+func TestDeleteResourcePolicy(t *testing.T) {
+	t.Run("should consider a resource policy already gone as deleted", func(t *testing.T) {
+		ctx := newContext(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+			assert.Equal(t, policiesPath+"/policy-id", r.URL.Path)
+			writeJSON(w, http.StatusNotFound, `{"error":404,"errorCode":"RESOURCE_NOT_FOUND"}`)
+		})
+		policy := newResourcePolicy()
+		policy.Status.ID = "policy-id"
+
+		assert.True(t, deleteResourcePolicy(ctx, policy).IsOk())
+	})
+}
@@ -0,0 +1,187 @@
+package atlassearchindex
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/notifier"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/statushandler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// AtlasSearchIndexReconciler reconciles an AtlasSearchIndex object
+type AtlasSearchIndexReconciler struct {
+	Client                   client.Client
+	Log                      *zap.SugaredLogger
+	Scheme                   *runtime.Scheme
+	GlobalPredicates         []predicate.Predicate
+	EventRecorder            record.EventRecorder
+	FailureNotifier          *notifier.Notifier
+	AtlasProvider            atlas.Provider
+	ObjectDeletionProtection bool
+}
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlassearchindices,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlassearchindices/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlassearchindices,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlassearchindices/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *AtlasSearchIndexReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, _ error) {
+	log := r.Log.With("atlassearchindex", req.NamespacedName)
+
+	index := &mdbv1.AtlasSearchIndex{}
+	result := customresource.PrepareResource(ctx, r.Client, req, index, log)
+	if !result.IsOk() {
+		return result.ReconcileResult(), nil
+	}
+
+	if customresource.ReconciliationShouldBeSkipped(index) {
+		log.Infow(fmt.Sprintf("-> Skipping AtlasSearchIndex reconciliation as annotation %s=%s", customresource.ReconciliationPolicyAnnotation, customresource.ReconciliationPolicySkip), "spec", index.Spec)
+		if !index.GetDeletionTimestamp().IsZero() {
+			if err := customresource.ManageFinalizer(ctx, r.Client, index, customresource.UnsetFinalizer); err != nil {
+				log.Errorw("failed to remove finalizer", "error", err)
+				return workflow.Terminate(workflow.Internal, err.Error()).ReconcileResult(), nil
+			}
+		}
+		return workflow.OK().ReconcileResult(), nil
+	}
+
+	if customresource.NamespaceReconciliationPaused(ctx, r.Client, index.Namespace, log) {
+		log.Infow(fmt.Sprintf("-> Pausing AtlasSearchIndex reconciliation as annotation %s=true is set on the namespace", customresource.ReconciliationPausedAnnotation))
+		return customresource.MarkReconciliationPaused(ctx, r.Client, r.EventRecorder, index, log).ReconcileResult(), nil
+	}
+
+	workflowCtx := customresource.MarkReconciliationStarted(r.Client, index, log, ctx)
+	log.Infow("-> Starting AtlasSearchIndex reconciliation", "spec", index.Spec, "status", index.Status)
+
+	if workflowCtx.Degraded(index) {
+		log.Infow("-> Skipping the reconciliation of the degraded AtlasSearchIndex, change its spec or set the reapply annotation to retry")
+		return ctrl.Result{}, nil
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			res = workflowCtx.RecoverPanic("AtlasSearchIndex", p).ReconcileResult()
+		}
+		res = workflowCtx.CapRetries("AtlasSearchIndex", index, res)
+		statushandler.Update(workflowCtx, r.Client, r.EventRecorder, r.FailureNotifier, index)
+	}()
+
+	resourceVersionIsValid := customresource.ValidateResourceVersion(workflowCtx, index, r.Log)
+	if !resourceVersionIsValid.IsOk() {
+		r.Log.Debugf("AtlasSearchIndex validation result: %v", resourceVersionIsValid)
+		return resourceVersionIsValid.ReconcileResult(), nil
+	}
+
+	deployment := &mdbv1.AtlasDeployment{}
+	err := r.Client.Get(ctx, index.DeploymentObjectKey(), deployment)
+	if apiErrors.IsNotFound(err) && !index.GetDeletionTimestamp().IsZero() {
+		// the index was removed from Atlas along with its deployment
+		return r.removeFinalizer(workflowCtx, index).ReconcileResult(), nil
+	}
+	if err != nil {
+		result = workflow.Terminate(workflow.Internal, err.Error())
+		workflowCtx.SetConditionFromResult(status.SearchIndexReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+
+	project := &mdbv1.AtlasProject{}
+	if err = r.Client.Get(ctx, deployment.AtlasProjectObjectKey(), project); err != nil {
+		result = workflow.Terminate(workflow.Internal, err.Error())
+		workflowCtx.SetConditionFromResult(status.SearchIndexReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+
+	atlasClient, orgID, err := r.AtlasProvider.Client(workflowCtx.Context, project.ConnectionSecretObjectKey(), log)
+	if err != nil {
+		result = workflow.Terminate(workflow.AtlasAPIAccessNotConfigured, err.Error())
+		workflowCtx.SetConditionFromResult(status.SearchIndexReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+	workflowCtx.Client = atlasClient
+	workflowCtx.OrgID = orgID
+
+	if !index.GetDeletionTimestamp().IsZero() {
+		return r.handleDeletion(workflowCtx, index, project.ID(), deployment.GetDeploymentName()).ReconcileResult(), nil
+	}
+
+	if !customresource.HaveFinalizer(index, customresource.FinalizerLabel) {
+		if err = customresource.ManageFinalizer(ctx, r.Client, index, customresource.SetFinalizer); err != nil {
+			result = workflow.Terminate(workflow.Internal, err.Error())
+			log.Errorw("failed to add finalizer", "error", err)
+			return result.ReconcileResult(), nil
+		}
+	}
+
+	if !isReady(deployment.Status.Conditions) {
+		result = workflow.InProgress(workflow.SearchIndexDeploymentNotReady, fmt.Sprintf("waiting for the deployment %s to be ready", deployment.GetDeploymentName()))
+		workflowCtx.SetConditionFromResult(status.SearchIndexReadyType, result)
+		return result.ReconcileResult(), nil
+	}
+
+	result = ensureSearchIndex(workflowCtx, index, project.ID(), deployment.GetDeploymentName())
+	workflowCtx.SetConditionFromResult(status.SearchIndexReadyType, result)
+	workflowCtx.SetConditionFromResult(status.ReadyType, result)
+
+	return result.ReconcileResult(), nil
+}
+
+func (r *AtlasSearchIndexReconciler) handleDeletion(ctx *workflow.Context, index *mdbv1.AtlasSearchIndex, projectID, clusterName string) workflow.Result {
+	if !customresource.HaveFinalizer(index, customresource.FinalizerLabel) {
+		return workflow.OK()
+	}
+
+	if customresource.IsResourcePolicyKeepOrDefault(index, r.ObjectDeletionProtection) {
+		ctx.Log.Info("Not removing the search index from Atlas as per configuration")
+	} else if index.Status.IndexID != "" {
+		err := deleteSearchIndex(ctx.Context, ctx.Client, projectID, clusterName, index.Status.IndexID)
+		if err != nil && !isNotFound(err) {
+			result := workflow.Terminate(workflow.SearchIndexNotDeletedInAtlas, err.Error()).WithCause(searchIndexReconciler, "deleteSearchIndex")
+			ctx.SetConditionFromResult(status.SearchIndexReadyType, result)
+			return result
+		}
+	}
+
+	return r.removeFinalizer(ctx, index)
+}
+
+func (r *AtlasSearchIndexReconciler) removeFinalizer(ctx *workflow.Context, index *mdbv1.AtlasSearchIndex) workflow.Result {
+	if err := customresource.ManageFinalizer(ctx.Context, r.Client, index, customresource.UnsetFinalizer); err != nil {
+		ctx.Log.Errorw("failed to remove finalizer", "error", err)
+		return workflow.Terminate(workflow.AtlasFinalizerNotRemoved, err.Error())
+	}
+
+	return workflow.OK()
+}
+
+func (r *AtlasSearchIndexReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("AtlasSearchIndex").
+		For(&mdbv1.AtlasSearchIndex{}, builder.WithPredicates(r.GlobalPredicates...)).
+		Complete(r)
+}
+
+func isReady(conditions []status.Condition) bool {
+	for _, c := range conditions {
+		if c.Type == status.ReadyType && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,336 @@
+package atlassearchindex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/validate"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	searchIndexesPath = "api/atlas/v1.0/groups/%s/clusters/%s/fts/indexes"
+
+	defaultAnalyzer = "lucene.standard"
+
+	atlasStatusSteady = "STEADY"
+	atlasStatusFailed = "FAILED"
+
+	searchIndexReconciler = "searchIndex"
+)
+
+// searchIndex is an Atlas Search index as exchanged with the Atlas API
+// TODO: Replace with the atlas-go-client search index when it supports the stored source
+type searchIndex struct {
+	IndexID        string        `json:"indexID,omitempty"`
+	Name           string        `json:"name"`
+	Database       string        `json:"database"`
+	CollectionName string        `json:"collectionName"`
+	Type           string        `json:"type,omitempty"`
+	Status         string        `json:"status,omitempty"`
+	Analyzer       string        `json:"analyzer,omitempty"`
+	SearchAnalyzer string        `json:"searchAnalyzer,omitempty"`
+	Mappings       interface{}   `json:"mappings,omitempty"`
+	Analyzers      []interface{} `json:"analyzers,omitempty"`
+	Synonyms       []interface{} `json:"synonyms,omitempty"`
+	StoredSource   interface{}   `json:"storedSource,omitempty"`
+	Fields         []interface{} `json:"fields,omitempty"`
+}
+
+// toAtlas converts the spec of the index to its Atlas representation. The free-form parts of the spec are decoded
+// to generic values so that they can be compared with the ones returned by Atlas
+func toAtlas(index *mdbv1.AtlasSearchIndex) (*searchIndex, error) {
+	spec := index.Spec
+	atlasIndex := &searchIndex{
+		Name:           spec.Name,
+		Database:       spec.Database,
+		CollectionName: spec.CollectionName,
+		Type:           spec.Type,
+		Analyzer:       spec.Analyzer,
+		SearchAnalyzer: spec.SearchAnalyzer,
+	}
+
+	if index.IsVectorSearch() {
+		if err := toGeneric(spec.Fields, &atlasIndex.Fields); err != nil {
+			return nil, fmt.Errorf("invalid fields: %w", err)
+		}
+		return atlasIndex, nil
+	}
+
+	if spec.Mappings != nil {
+		if err := toGeneric(spec.Mappings, &atlasIndex.Mappings); err != nil {
+			return nil, fmt.Errorf("invalid mappings: %w", err)
+		}
+	}
+	if len(spec.Analyzers) > 0 {
+		if err := toGeneric(spec.Analyzers, &atlasIndex.Analyzers); err != nil {
+			return nil, fmt.Errorf("invalid analyzers: %w", err)
+		}
+	}
+	if len(spec.Synonyms) > 0 {
+		if err := toGeneric(spec.Synonyms, &atlasIndex.Synonyms); err != nil {
+			return nil, fmt.Errorf("invalid synonyms: %w", err)
+		}
+	}
+	if spec.StoredSource != nil {
+		if err := json.Unmarshal(spec.StoredSource.Raw, &atlasIndex.StoredSource); err != nil {
+			return nil, fmt.Errorf("invalid stored source: %w", err)
+		}
+	}
+
+	return atlasIndex, nil
+}
+
+func toGeneric(value interface{}, generic interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, generic)
+}
+
+// definitionMatches compares the definitions of the indexes, taking the Atlas defaults into account
+func definitionMatches(desired, existing *searchIndex) bool {
+	if indexType(desired) == mdbv1.SearchIndexTypeVectorSearch {
+		return reflect.DeepEqual(normalizedFields(desired.Fields), normalizedFields(existing.Fields))
+	}
+
+	analyzer := func(index *searchIndex) string {
+		if index.Analyzer == "" {
+			return defaultAnalyzer
+		}
+		return index.Analyzer
+	}
+	searchAnalyzer := func(index *searchIndex) string {
+		if index.SearchAnalyzer == "" {
+			return analyzer(index)
+		}
+		return index.SearchAnalyzer
+	}
+	storedSource := func(index *searchIndex) interface{} {
+		if index.StoredSource == nil {
+			return false
+		}
+		return index.StoredSource
+	}
+
+	return analyzer(desired) == analyzer(existing) &&
+		searchAnalyzer(desired) == searchAnalyzer(existing) &&
+		reflect.DeepEqual(normalizedMappings(desired.Mappings), normalizedMappings(existing.Mappings)) &&
+		sameItems(desired.Analyzers, existing.Analyzers) &&
+		sameItems(desired.Synonyms, existing.Synonyms) &&
+		reflect.DeepEqual(storedSource(desired), storedSource(existing))
+}
+
+// normalizedMappings fills the mappings defaults: Atlas doesn't return the fields of a dynamic index without
+// field mappings
+func normalizedMappings(mappings interface{}) map[string]interface{} {
+	normalized := map[string]interface{}{"dynamic": false}
+	if m, ok := mappings.(map[string]interface{}); ok {
+		for k, v := range m {
+			normalized[k] = v
+		}
+	}
+	if fields, ok := normalized["fields"].(map[string]interface{}); !ok || len(fields) == 0 {
+		delete(normalized, "fields")
+	}
+
+	return normalized
+}
+
+// normalizedFields fills the defaults of the vector fields: Atlas doesn't return the quantization when there's none
+func normalizedFields(fields []interface{}) []interface{} {
+	normalized := make([]interface{}, 0, len(fields))
+	for _, field := range fields {
+		f, ok := field.(map[string]interface{})
+		if !ok {
+			normalized = append(normalized, field)
+			continue
+		}
+		n := map[string]interface{}{}
+		for k, v := range f {
+			n[k] = v
+		}
+		if _, ok = n["quantization"]; !ok && n["type"] == mdbv1.VectorSearchFieldTypeVector {
+			n["quantization"] = "none"
+		}
+		normalized = append(normalized, n)
+	}
+
+	return normalized
+}
+
+func indexType(index *searchIndex) string {
+	if index.Type == "" {
+		return mdbv1.SearchIndexTypeSearch
+	}
+
+	return index.Type
+}
+
+func sameItems(desired, existing []interface{}) bool {
+	if len(desired) == 0 && len(existing) == 0 {
+		return true
+	}
+
+	return reflect.DeepEqual(desired, existing)
+}
+
+func indexPath(projectID, clusterName, indexID string) string {
+	return fmt.Sprintf(searchIndexesPath, projectID, clusterName) + "/" + indexID
+}
+
+func getSearchIndex(ctx context.Context, client *mongodbatlas.Client, projectID, clusterName, indexID string) (*searchIndex, error) {
+	req, err := client.NewRequest(ctx, http.MethodGet, indexPath(projectID, clusterName, indexID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	index := &searchIndex{}
+	if _, err = client.Do(ctx, req, index); err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
+func findSearchIndex(ctx context.Context, client *mongodbatlas.Client, projectID, clusterName string, desired *searchIndex) (*searchIndex, error) {
+	path := fmt.Sprintf(searchIndexesPath, projectID, clusterName) + fmt.Sprintf("/%s/%s", desired.Database, desired.CollectionName)
+	req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var indexes []*searchIndex
+	if _, err = client.Do(ctx, req, &indexes); err != nil {
+		return nil, err
+	}
+
+	for _, index := range indexes {
+		if index.Name == desired.Name {
+			return index, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func createSearchIndex(ctx context.Context, client *mongodbatlas.Client, projectID, clusterName string, index *searchIndex) (*searchIndex, error) {
+	req, err := client.NewRequest(ctx, http.MethodPost, fmt.Sprintf(searchIndexesPath, projectID, clusterName), index)
+	if err != nil {
+		return nil, err
+	}
+
+	created := &searchIndex{}
+	if _, err = client.Do(ctx, req, created); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func updateSearchIndex(ctx context.Context, client *mongodbatlas.Client, projectID, clusterName, indexID string, index *searchIndex) (*searchIndex, error) {
+	req, err := client.NewRequest(ctx, http.MethodPatch, indexPath(projectID, clusterName, indexID), index)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := &searchIndex{}
+	if _, err = client.Do(ctx, req, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func deleteSearchIndex(ctx context.Context, client *mongodbatlas.Client, projectID, clusterName, indexID string) error {
+	req, err := client.NewRequest(ctx, http.MethodDelete, indexPath(projectID, clusterName, indexID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(ctx, req, nil)
+
+	return err
+}
+
+func isNotFound(err error) bool {
+	var apiError *mongodbatlas.ErrorResponse
+	return errors.As(err, &apiError) && apiError.HTTPCode == http.StatusNotFound
+}
+
+// ensureSearchIndex creates or updates the index in Atlas and waits for Atlas to build it. The index is found by
+// its id, or by its name in the collection when it isn't known yet. An index moved to another collection, renamed or
+// changing of type is recreated as Atlas can't update them
+func ensureSearchIndex(ctx *workflow.Context, index *mdbv1.AtlasSearchIndex, projectID, clusterName string) workflow.Result {
+	desired, err := toAtlas(index)
+	if err != nil {
+		return workflow.Terminate(workflow.SearchIndexInvalidSpec, err.Error()).WithoutRetry()
+	}
+
+	if err = validate.SearchIndex(index); err != nil {
+		return workflow.Terminate(workflow.SearchIndexInvalidSpec, err.Error()).WithoutRetry()
+	}
+
+	existing, err := readSearchIndex(ctx, index, projectID, clusterName, desired)
+	if err != nil {
+		return workflow.Terminate(workflow.SearchIndexNotUpdatedInAtlas, err.Error()).
+			WithCause(searchIndexReconciler, workflow.AtlasOperation(err))
+	}
+
+	if existing != nil && (existing.Name != desired.Name || existing.Database != desired.Database ||
+		existing.CollectionName != desired.CollectionName || indexType(existing) != indexType(desired)) {
+		ctx.Log.Infow("Recreating the search index moved, renamed or changing of type", "indexID", existing.IndexID)
+		if err = deleteSearchIndex(ctx.Context, ctx.Client, projectID, clusterName, existing.IndexID); err != nil && !isNotFound(err) {
+			return workflow.Terminate(workflow.SearchIndexNotDeletedInAtlas, err.Error()).WithCause(searchIndexReconciler, "deleteSearchIndex")
+		}
+		existing = nil
+	}
+
+	switch {
+	case existing == nil:
+		ctx.Log.Infow("Creating the search index", "name", desired.Name)
+		if existing, err = createSearchIndex(ctx.Context, ctx.Client, projectID, clusterName, desired); err != nil {
+			return workflow.Terminate(workflow.SearchIndexNotCreatedInAtlas, err.Error()).WithCause(searchIndexReconciler, "createSearchIndex")
+		}
+	case !definitionMatches(desired, existing):
+		ctx.Log.Infow("Updating the search index", "indexID", existing.IndexID)
+		if existing, err = updateSearchIndex(ctx.Context, ctx.Client, projectID, clusterName, existing.IndexID, desired); err != nil {
+			return workflow.Terminate(workflow.SearchIndexNotUpdatedInAtlas, err.Error()).WithCause(searchIndexReconciler, "updateSearchIndex")
+		}
+	}
+
+	ctx.EnsureStatusOption(status.AtlasSearchIndexIDOption(existing.IndexID))
+	ctx.EnsureStatusOption(status.AtlasSearchIndexAtlasStatusOption(existing.Status))
+
+	switch existing.Status {
+	case atlasStatusSteady:
+		return workflow.OK()
+	case atlasStatusFailed:
+		return workflow.Terminate(workflow.SearchIndexFailed, "Atlas failed to build the search index").WithCause(searchIndexReconciler, "")
+	default:
+		return workflow.InProgress(workflow.SearchIndexBuilding, fmt.Sprintf("the search index is %s in Atlas", existing.Status))
+	}
+}
+
+func readSearchIndex(ctx *workflow.Context, index *mdbv1.AtlasSearchIndex, projectID, clusterName string, desired *searchIndex) (*searchIndex, error) {
+	if index.Status.IndexID != "" {
+		existing, err := getSearchIndex(ctx.Context, ctx.Client, projectID, clusterName, index.Status.IndexID)
+		if !isNotFound(err) {
+			return existing, workflow.NewAtlasOperationError("getSearchIndex", err)
+		}
+	}
+
+	existing, err := findSearchIndex(ctx.Context, ctx.Client, projectID, clusterName, desired)
+
+	return existing, workflow.NewAtlasOperationError("listSearchIndexes", err)
+}
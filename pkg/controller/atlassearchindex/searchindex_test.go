@@ -0,0 +1,266 @@
+package atlassearchindex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	"go.uber.org/zap/zaptest"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const indexesPath = "/api/atlas/v1.0/groups/project-id/clusters/cluster/fts/indexes"
+
+func newSearchIndex(indexStatus status.AtlasSearchIndexStatus) *mdbv1.AtlasSearchIndex {
+	return &mdbv1.AtlasSearchIndex{
+		ObjectMeta: metav1.ObjectMeta{Name: "index", Namespace: "default"},
+		Spec: mdbv1.AtlasSearchIndexSpec{
+			DeploymentRef:  common.ResourceRefNamespaced{Name: "deployment"},
+			Name:           "products",
+			Database:       "shop",
+			CollectionName: "products",
+			Mappings: &mdbv1.SearchIndexMappings{
+				Fields: &apiextensionsv1.JSON{Raw: []byte(`{"title":{"type":"string"}}`)},
+			},
+			StoredSource: &apiextensionsv1.JSON{Raw: []byte(`{"include":["title"]}`)},
+		},
+		Status: indexStatus,
+	}
+}
+
+func newContext(t *testing.T, handler http.HandlerFunc) *workflow.Context {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := mongodbatlas.New(server.Client(), mongodbatlas.SetBaseURL(server.URL+"/"))
+	require.NoError(t, err)
+
+	ctx := workflow.NewContext(zaptest.NewLogger(t).Sugar(), []status.Condition{}, context.Background())
+	ctx.Client = client
+
+	return ctx
+}
+
+func writeJSON(w http.ResponseWriter, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(body))
+}
+
+func reconciledStatus(ctx *workflow.Context, index *mdbv1.AtlasSearchIndex) status.AtlasSearchIndexStatus {
+	index.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+
+	return index.Status
+}
+
+const steadyIndex = `{"indexID":"index-id","name":"products","database":"shop","collectionName":"products","status":"STEADY",
+"analyzer":"lucene.standard","searchAnalyzer":"lucene.standard","mappings":{"dynamic":false,"fields":{"title":{"type":"string"}}},
+"storedSource":{"include":["title"]}}`
+
+func TestEnsureSearchIndex(t *testing.T) {
+	t.Run("should create a new index", func(t *testing.T) {
+		var created map[string]interface{}
+		ctx := newContext(t, func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == indexesPath+"/shop/products":
+				writeJSON(w, `[]`)
+			case r.Method == http.MethodPost && r.URL.Path == indexesPath:
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+				writeJSON(w, `{"indexID":"index-id","status":"IN_PROGRESS","name":"products","database":"shop","collectionName":"products"}`)
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		})
+		index := newSearchIndex(status.AtlasSearchIndexStatus{})
+
+		result := ensureSearchIndex(ctx, index, "project-id", "cluster")
+
+		assert.Equal(t, workflow.SearchIndexBuilding, result.GetReason())
+		assert.Equal(t, map[string]interface{}{"include": []interface{}{"title"}}, created["storedSource"])
+		assert.Equal(t, "products", created["name"])
+		indexStatus := reconciledStatus(ctx, index)
+		assert.Equal(t, "index-id", indexStatus.IndexID)
+		assert.Equal(t, "IN_PROGRESS", indexStatus.AtlasStatus)
+	})
+
+	t.Run("should be ready when the index is built and up to date", func(t *testing.T) {
+		ctx := newContext(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, indexesPath+"/index-id", r.URL.Path)
+			writeJSON(w, steadyIndex)
+		})
+
+		result := ensureSearchIndex(ctx, newSearchIndex(status.AtlasSearchIndexStatus{IndexID: "index-id"}), "project-id", "cluster")
+
+		assert.True(t, result.IsOk())
+	})
+
+	t.Run("should update a drifted index", func(t *testing.T) {
+		updated := false
+		ctx := newContext(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPatch {
+				assert.Equal(t, indexesPath+"/index-id", r.URL.Path)
+				updated = true
+				writeJSON(w, `{"indexID":"index-id","status":"IN_PROGRESS","name":"products","database":"shop","collectionName":"products"}`)
+				return
+			}
+			writeJSON(w, steadyIndex)
+		})
+		index := newSearchIndex(status.AtlasSearchIndexStatus{IndexID: "index-id"})
+		index.Spec.Analyzer = "lucene.english"
+
+		result := ensureSearchIndex(ctx, index, "project-id", "cluster")
+
+		assert.True(t, updated)
+		assert.Equal(t, workflow.SearchIndexBuilding, result.GetReason())
+	})
+
+	t.Run("should recreate a renamed index", func(t *testing.T) {
+		var requests []string
+		ctx := newContext(t, func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method)
+			switch r.Method {
+			case http.MethodGet:
+				writeJSON(w, steadyIndex)
+			case http.MethodDelete:
+				w.WriteHeader(http.StatusAccepted)
+			case http.MethodPost:
+				writeJSON(w, `{"indexID":"new-index-id","status":"IN_PROGRESS","name":"catalog","database":"shop","collectionName":"products"}`)
+			}
+		})
+		index := newSearchIndex(status.AtlasSearchIndexStatus{IndexID: "index-id"})
+		index.Spec.Name = "catalog"
+
+		ensureSearchIndex(ctx, index, "project-id", "cluster")
+
+		assert.Equal(t, []string{http.MethodGet, http.MethodDelete, http.MethodPost}, requests)
+		assert.Equal(t, "new-index-id", reconciledStatus(ctx, index).IndexID)
+	})
+
+	t.Run("should fail when Atlas can't build the index", func(t *testing.T) {
+		ctx := newContext(t, func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, `{"indexID":"index-id","name":"products","database":"shop","collectionName":"products","status":"FAILED",
+"mappings":{"fields":{"title":{"type":"string"}}},"storedSource":{"include":["title"]}}`)
+		})
+
+		result := ensureSearchIndex(ctx, newSearchIndex(status.AtlasSearchIndexStatus{IndexID: "index-id"}), "project-id", "cluster")
+
+		assert.Equal(t, workflow.SearchIndexFailed, result.GetReason())
+	})
+}
+
+func TestEnsureVectorSearchIndex(t *testing.T) {
+	newVectorSearchIndex := func(indexStatus status.AtlasSearchIndexStatus) *mdbv1.AtlasSearchIndex {
+		index := newSearchIndex(indexStatus)
+		index.Spec.Type = mdbv1.SearchIndexTypeVectorSearch
+		index.Spec.Mappings = nil
+		index.Spec.StoredSource = nil
+		index.Spec.Fields = []mdbv1.VectorSearchField{
+			{Type: mdbv1.VectorSearchFieldTypeVector, Path: "embedding", NumDimensions: 1536, Similarity: "cosine"},
+			{Type: mdbv1.VectorSearchFieldTypeFilter, Path: "category"},
+		}
+
+		return index
+	}
+
+	t.Run("should create a vectorSearch index", func(t *testing.T) {
+		var created map[string]interface{}
+		ctx := newContext(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				writeJSON(w, `[]`)
+				return
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			writeJSON(w, `{"indexID":"index-id","status":"IN_PROGRESS","type":"vectorSearch","name":"products","database":"shop","collectionName":"products"}`)
+		})
+
+		result := ensureSearchIndex(ctx, newVectorSearchIndex(status.AtlasSearchIndexStatus{}), "project-id", "cluster")
+
+		assert.Equal(t, workflow.SearchIndexBuilding, result.GetReason())
+		assert.Equal(t, "vectorSearch", created["type"])
+		assert.Nil(t, created["mappings"])
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"type": "vector", "path": "embedding", "numDimensions": float64(1536), "similarity": "cosine"},
+			map[string]interface{}{"type": "filter", "path": "category"},
+		}, created["fields"])
+	})
+
+	t.Run("should be ready when the vectorSearch index is built", func(t *testing.T) {
+		ctx := newContext(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			writeJSON(w, `{"indexID":"index-id","status":"STEADY","type":"vectorSearch","name":"products","database":"shop","collectionName":"products",
+"fields":[{"type":"vector","path":"embedding","numDimensions":1536,"similarity":"cosine","quantization":"none"},{"type":"filter","path":"category"}]}`)
+		})
+
+		result := ensureSearchIndex(ctx, newVectorSearchIndex(status.AtlasSearchIndexStatus{IndexID: "index-id"}), "project-id", "cluster")
+
+		assert.True(t, result.IsOk())
+	})
+
+	t.Run("should recreate an index changing of type", func(t *testing.T) {
+		var requests []string
+		ctx := newContext(t, func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method)
+			switch r.Method {
+			case http.MethodGet:
+				writeJSON(w, steadyIndex)
+			case http.MethodDelete:
+				w.WriteHeader(http.StatusAccepted)
+			case http.MethodPost:
+				writeJSON(w, `{"indexID":"new-index-id","status":"IN_PROGRESS","type":"vectorSearch","name":"products","database":"shop","collectionName":"products"}`)
+			}
+		})
+
+		ensureSearchIndex(ctx, newVectorSearchIndex(status.AtlasSearchIndexStatus{IndexID: "index-id"}), "project-id", "cluster")
+
+		assert.Equal(t, []string{http.MethodGet, http.MethodDelete, http.MethodPost}, requests)
+	})
+
+	t.Run("should reject a vectorSearch index without vector field", func(t *testing.T) {
+		index := newVectorSearchIndex(status.AtlasSearchIndexStatus{})
+		index.Spec.Fields = index.Spec.Fields[1:]
+
+		result := ensureSearchIndex(newContext(t, nil), index, "project-id", "cluster")
+
+		assert.Equal(t, workflow.SearchIndexInvalidSpec, result.GetReason())
+	})
+}
+
+func TestDefinitionMatches(t *testing.T) {
+	t.Run("should apply the Atlas defaults", func(t *testing.T) {
+		desired := &searchIndex{Mappings: map[string]interface{}{"dynamic": true}}
+		existing := &searchIndex{
+			Analyzer:       defaultAnalyzer,
+			SearchAnalyzer: defaultAnalyzer,
+			Mappings:       map[string]interface{}{"dynamic": true, "fields": map[string]interface{}{}},
+			StoredSource:   false,
+			Analyzers:      []interface{}{},
+		}
+
+		assert.True(t, definitionMatches(desired, existing))
+	})
+
+	t.Run("should detect removed field mappings", func(t *testing.T) {
+		desired := &searchIndex{Mappings: map[string]interface{}{"dynamic": true}}
+		existing := &searchIndex{Mappings: map[string]interface{}{"dynamic": true, "fields": map[string]interface{}{"title": map[string]interface{}{"type": "string"}}}}
+
+		assert.False(t, definitionMatches(desired, existing))
+	})
+
+	t.Run("should detect a different stored source", func(t *testing.T) {
+		desired := &searchIndex{StoredSource: true}
+
+		assert.False(t, definitionMatches(desired, &searchIndex{}))
+	})
+}
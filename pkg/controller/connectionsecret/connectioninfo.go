@@ -0,0 +1,46 @@
+package connectionsecret
+
+import (
+	"go.mongodb.org/atlas/mongodbatlas"
+	corev1 "k8s.io/api/core/v1"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+)
+
+// ConnectionInfo returns the connection strings of a deployment without credentials, under the keys of the connection
+// Secrets of the database users. It's empty until Atlas provides the connection strings of the deployment. The
+// connection string policy selects the primary connection string and leaves the public ones out, as for the
+// connection Secrets
+func ConnectionInfo(connectionStrings *mongodbatlas.ConnectionStrings, policy *mdbv1.ConnectionStringPolicy) map[string]string {
+	if connectionStrings == nil || connectionStrings.StandardSrv == "" {
+		return nil
+	}
+
+	data := ConnectionData{
+		ConnURL:    connectionStrings.Standard,
+		SrvConnURL: connectionStrings.StandardSrv,
+		Policy:     policy,
+	}
+	FillPrivateConnStrings(connectionStrings, &data)
+
+	secret := &corev1.Secret{Data: map[string][]byte{
+		standardKey:    []byte(data.ConnURL),
+		standardKeySrv: []byte(data.SrvConnURL),
+	}}
+	for idx, privateConn := range data.PrivateConnURLs {
+		suffix := getSuffix(idx)
+		secret.Data[privateKey+suffix] = []byte(privateConn.PvtConnURL)
+		secret.Data[privateKeySrv+suffix] = []byte(privateConn.PvtSrvConnURL)
+		if privateConn.PvtShardConnURL != "" {
+			secret.Data[privateShardKey+suffix] = []byte(privateConn.PvtShardConnURL)
+		}
+	}
+	fillPrimaryKey(secret, data)
+
+	info := make(map[string]string, len(secret.Data))
+	for key, value := range secret.Data {
+		info[key] = string(value)
+	}
+
+	return info
+}
@@ -0,0 +1,62 @@
+package connectionsecret
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/atlas/mongodbatlas"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+)
+
+func TestConnectionInfo(t *testing.T) {
+	t.Run("should be empty until the connection strings are available", func(t *testing.T) {
+		assert.Nil(t, ConnectionInfo(nil, nil))
+		assert.Nil(t, ConnectionInfo(&mongodbatlas.ConnectionStrings{}, nil))
+	})
+
+	t.Run("should list the public and private connection strings", func(t *testing.T) {
+		info := ConnectionInfo(&mongodbatlas.ConnectionStrings{
+			Standard:    "mongodb://cluster0-shard-00-00.abcde.mongodb.net:27017/?ssl=true",
+			StandardSrv: "mongodb+srv://cluster0.abcde.mongodb.net",
+			Private:     "mongodb://cluster0-shard-00-00-pri.abcde.mongodb.net:27017/?ssl=true",
+			PrivateSrv:  "mongodb+srv://cluster0-pri.abcde.mongodb.net",
+			PrivateEndpoint: []mongodbatlas.PrivateEndpoint{
+				{
+					ConnectionString:                  "mongodb://pl-0-us-east-1.abcde.mongodb.net:1024/?ssl=true",
+					SRVConnectionString:               "mongodb+srv://cluster0-pl-0.abcde.mongodb.net",
+					SRVShardOptimizedConnectionString: "mongodb+srv://cluster0-pl-0-lb.abcde.mongodb.net",
+				},
+			},
+		}, nil)
+
+		assert.Equal(t, map[string]string{
+			"connectionStringStandard":      "mongodb://cluster0-shard-00-00.abcde.mongodb.net:27017/?ssl=true",
+			"connectionStringStandardSrv":   "mongodb+srv://cluster0.abcde.mongodb.net",
+			"connectionStringPrivate":       "mongodb://cluster0-shard-00-00-pri.abcde.mongodb.net:27017/?ssl=true",
+			"connectionStringPrivateSrv":    "mongodb+srv://cluster0-pri.abcde.mongodb.net",
+			"connectionStringPrivate1":      "mongodb://pl-0-us-east-1.abcde.mongodb.net:1024/?ssl=true",
+			"connectionStringPrivateSrv1":   "mongodb+srv://cluster0-pl-0.abcde.mongodb.net",
+			"connectionStringPrivateShard1": "mongodb+srv://cluster0-pl-0-lb.abcde.mongodb.net",
+		}, info)
+	})
+
+	t.Run("should leave the public connection strings out with the PrivateEndpoint policy", func(t *testing.T) {
+		info := ConnectionInfo(&mongodbatlas.ConnectionStrings{
+			Standard:    "mongodb://cluster0-shard-00-00.abcde.mongodb.net:27017/?ssl=true",
+			StandardSrv: "mongodb+srv://cluster0.abcde.mongodb.net",
+			PrivateEndpoint: []mongodbatlas.PrivateEndpoint{
+				{
+					SRVConnectionString: "mongodb+srv://cluster0-pl-0.abcde.mongodb.net",
+					Endpoints:           []mongodbatlas.Endpoint{{EndpointID: "vpce-1"}},
+				},
+			},
+		}, &mdbv1.ConnectionStringPolicy{Type: mdbv1.ConnectionStringPolicyPrivateEndpoint})
+
+		assert.Equal(t, map[string]string{
+			"connectionString":           "mongodb+srv://cluster0-pl-0.abcde.mongodb.net",
+			"connectionStringPrivate":    "",
+			"connectionStringPrivateSrv": "mongodb+srv://cluster0-pl-0.abcde.mongodb.net",
+		}, info)
+	})
+}
@@ -11,24 +11,40 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/stringutil"
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/secretstore"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
 
 const ConnectionSecretsEnsuredEvent = "ConnectionSecretsEnsured"
 
-func CreateOrUpdateConnectionSecrets(ctx *workflow.Context, k8sClient client.Client, recorder record.EventRecorder, project mdbv1.AtlasProject, dbUser mdbv1.AtlasDatabaseUser) workflow.Result {
+func CreateOrUpdateConnectionSecrets(ctx *workflow.Context, k8sClient client.Client, recorder record.EventRecorder, project mdbv1.AtlasProject, dbUser mdbv1.AtlasDatabaseUser, password string, metadata Metadata, stores secretstore.Registry) workflow.Result {
 	advancedDeployments, _, err := ctx.Client.AdvancedClusters.List(ctx.Context, project.ID(), &mongodbatlas.ListOptions{})
 	if err != nil {
 		return workflow.Terminate(workflow.DatabaseUserConnectionSecretsNotCreated, err.Error())
 	}
 
+	deployments, err := projectDeployments(ctx.Context, k8sClient, project)
+	if err != nil {
+		return workflow.Terminate(workflow.DatabaseUserConnectionSecretsNotCreated, err.Error())
+	}
+
+	deploymentNames := make([]string, 0, len(advancedDeployments.Results))
+	for _, c := range advancedDeployments.Results {
+		deploymentNames = append(deploymentNames, c.Name)
+	}
+	onlineArchives := ListOnlineArchiveConnURLs(ctx, project.ID(), deploymentNames)
+
 	var deploymentSecrets []deploymentSecret
 	for _, c := range advancedDeployments.Results {
 		deploymentSecrets = append(deploymentSecrets, deploymentSecret{
 			name:              c.Name,
+			deployment:        deployments[c.Name],
 			connectionStrings: c.ConnectionStrings,
+			analyticsNodes:    HasAnalyticsNodes(c),
+			onlineArchive:     onlineArchives[c.Name],
+			zones:             GlobalClusterZones(c),
 		})
 	}
 
@@ -49,13 +65,14 @@ func CreateOrUpdateConnectionSecrets(ctx *workflow.Context, k8sClient client.Cli
 		if !found {
 			deploymentSecrets = append(deploymentSecrets, deploymentSecret{
 				name:              c.Name,
+				deployment:        deployments[c.Name],
 				connectionStrings: c.ConnectionStrings,
 			})
 		}
 	}
 
 	// ensure secrets for both deployments and advanced deployment.
-	if result := createOrUpdateConnectionSecretsFromDeploymentSecrets(ctx, k8sClient, recorder, project, dbUser, deploymentSecrets); !result.IsOk() {
+	if result := createOrUpdateConnectionSecretsFromDeploymentSecrets(ctx, k8sClient, recorder, project, dbUser, password, deploymentSecrets, metadata, stores); !result.IsOk() {
 		return result
 	}
 
@@ -64,17 +81,30 @@ func CreateOrUpdateConnectionSecrets(ctx *workflow.Context, k8sClient client.Cli
 
 // deploymentSecret holds the information required to ensure a secret for a user in a given deployment.
 type deploymentSecret struct {
-	name              string
+	name string
+	// deployment is the AtlasDeployment managing the deployment, nil when it's not managed by the Operator
+	deployment        *mdbv1.AtlasDeployment
 	connectionStrings *mongodbatlas.ConnectionStrings
+	analyticsNodes    bool
+	onlineArchive     OnlineArchiveConnURLs
+	zones             map[string][]string
 }
 
-func createOrUpdateConnectionSecretsFromDeploymentSecrets(ctx *workflow.Context, k8sClient client.Client, recorder record.EventRecorder, project mdbv1.AtlasProject, dbUser mdbv1.AtlasDatabaseUser, deploymentSecrets []deploymentSecret) workflow.Result {
+func createOrUpdateConnectionSecretsFromDeploymentSecrets(ctx *workflow.Context, k8sClient client.Client, recorder record.EventRecorder, project mdbv1.AtlasProject, dbUser mdbv1.AtlasDatabaseUser, password string, deploymentSecrets []deploymentSecret, metadata Metadata, stores secretstore.Registry) workflow.Result {
 	requeue := false
 	secrets := make([]string, 0)
 
+	policy, err := UserConnectionStringPolicy(ctx.Context, k8sClient, &dbUser)
+	if err != nil {
+		return workflow.Terminate(workflow.DatabaseUserConnectionSecretsNotCreated, err.Error())
+	}
+	store, err := UserStore(stores, &dbUser)
+	if err != nil {
+		return workflow.Terminate(workflow.DatabaseUserConnectionSecretsNotCreated, err.Error())
+	}
+
 	for _, ds := range deploymentSecrets {
-		scopes := dbUser.GetScopes(mdbv1.DeploymentScopeType)
-		if len(scopes) != 0 && !stringutil.Contains(scopes, ds.name) {
+		if !dbUser.HasConnectionSecret(ds.name) {
 			continue
 		}
 		// Deployment may be not ready yet, so no connection urls - skipping
@@ -84,20 +114,26 @@ func createOrUpdateConnectionSecretsFromDeploymentSecrets(ctx *workflow.Context,
 			requeue = true
 			continue
 		}
-		password, err := dbUser.ReadPassword(ctx.Context, k8sClient)
-		if err != nil {
-			return workflow.Terminate(workflow.DatabaseUserConnectionSecretsNotCreated, err.Error())
-		}
 		data := ConnectionData{
-			DBUserName: dbUser.Spec.Username,
-			Password:   password,
-			ConnURL:    ds.connectionStrings.Standard,
-			SrvConnURL: ds.connectionStrings.StandardSrv,
+			DBUserName:     dbUser.Spec.Username,
+			Password:       password,
+			ConnURL:        ds.connectionStrings.Standard,
+			SrvConnURL:     ds.connectionStrings.StandardSrv,
+			Metadata:       ResourceMetadata(metadata, ds.deployment, &dbUser),
+			AnalyticsNodes: ds.analyticsNodes,
+			AuthMechanism:  dbUser.ExternalAuthMechanism(),
+			OnlineArchive:  ds.onlineArchive,
+			Zones:          ds.zones,
+			Policy:         policy,
+			Store:          store,
 		}
 		FillPrivateConnStrings(ds.connectionStrings, &data)
 
-		var secretName string
-		if secretName, err = Ensure(ctx.Context, k8sClient, dbUser.Namespace, project.Spec.Name, project.ID(), ds.name, data); err != nil {
+		secretName, err := Ensure(ctx.Context, k8sClient, dbUser.Namespace, project.Spec.Name, project.ID(), ds.name, data)
+		if err != nil {
+			if store != nil {
+				ctx.EnsureStatusOption(status.AtlasDatabaseUserConnectionSecretStoreOption(trackedStoreEntries(dbUser.Status.ConnectionSecretStore, store)))
+			}
 			return workflow.Terminate(workflow.DatabaseUserConnectionSecretsNotCreated, err.Error())
 		}
 		secrets = append(secrets, secretName)
@@ -111,6 +147,19 @@ func createOrUpdateConnectionSecretsFromDeploymentSecrets(ctx *workflow.Context,
 	if err := cleanupStaleSecrets(ctx, k8sClient, project.ID(), dbUser); err != nil {
 		return workflow.Terminate(workflow.DatabaseUserStaleConnectionSecrets, err.Error())
 	}
+	if store != nil || dbUser.Status.ConnectionSecretStore != nil {
+		var wanted []string
+		for _, ds := range deploymentSecrets {
+			if store != nil && dbUser.HasConnectionSecret(ds.name) {
+				wanted = append(wanted, store.secretPath(FormatSecretName(project.Spec.Name, ds.name, dbUser.Spec.Username)))
+			}
+		}
+		entries, err := syncStoreEntries(ctx.Context, stores, &dbUser, store, wanted, ctx.Log)
+		ctx.EnsureStatusOption(status.AtlasDatabaseUserConnectionSecretStoreOption(entries))
+		if err != nil {
+			return workflow.Terminate(workflow.DatabaseUserStaleConnectionSecrets, err.Error())
+		}
+	}
 
 	if requeue {
 		return workflow.InProgress(workflow.DatabaseUserConnectionSecretsNotCreated, "Waiting for deployments to get created/updated")
@@ -130,10 +179,10 @@ func cleanupStaleSecrets(ctx *workflow.Context, k8sClient client.Client, project
 	return nil
 }
 
-// removeStaleByScope removes the secrets that are not relevant due to changes to 'scopes' field for the AtlasDatabaseUser.
+// removeStaleByScope removes the secrets that are not relevant due to changes to 'scopes' or 'skipConnectionSecrets'
+// fields for the AtlasDatabaseUser.
 func removeStaleByScope(ctx *workflow.Context, k8sClient client.Client, projectID string, user mdbv1.AtlasDatabaseUser) error {
-	scopes := user.GetScopes(mdbv1.DeploymentScopeType)
-	if len(scopes) == 0 {
+	if len(user.Spec.Scopes) == 0 && len(user.Spec.SkipConnectionSecrets) == 0 {
 		return nil
 	}
 	secrets, err := ListByUserName(ctx.Context, k8sClient, user.Namespace, projectID, user.Spec.Username)
@@ -145,7 +194,7 @@ func removeStaleByScope(ctx *workflow.Context, k8sClient client.Client, projectI
 		if !ok {
 			continue
 		}
-		if !stringutil.Contains(scopes, deployment) {
+		if !user.HasConnectionSecret(deployment) {
 			if err = k8sClient.Delete(ctx.Context, &secrets[i]); err != nil {
 				return err
 			}
@@ -178,6 +227,34 @@ func RemoveStaleSecretsByUserName(ctx context.Context, k8sClient client.Client,
 	return lastError
 }
 
+// ResourceMetadata adds the connection Secret labels and annotations of the deployment, if any, and of the database
+// user to the metadata of the Operator. The user takes precedence over the deployment
+func ResourceMetadata(metadata Metadata, deployment *mdbv1.AtlasDeployment, user *mdbv1.AtlasDatabaseUser) Metadata {
+	if deployment != nil {
+		metadata = metadata.With(deployment.Spec.ConnectionSecretLabels, deployment.Spec.ConnectionSecretAnnotations)
+	}
+
+	return metadata.With(user.Spec.ConnectionSecretLabels, user.Spec.ConnectionSecretAnnotations)
+}
+
+// projectDeployments returns the AtlasDeployments of the project, indexed by the name of their deployment in Atlas
+func projectDeployments(ctx context.Context, k8sClient client.Client, project mdbv1.AtlasProject) (map[string]*mdbv1.AtlasDeployment, error) {
+	deployments := &mdbv1.AtlasDeploymentList{}
+	if err := k8sClient.List(ctx, deployments); err != nil {
+		return nil, err
+	}
+
+	byName := map[string]*mdbv1.AtlasDeployment{}
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		if deployment.AtlasProjectObjectKey() == kube.ObjectKeyFromObject(&project) {
+			byName[deployment.GetDeploymentName()] = deployment
+		}
+	}
+
+	return byName, nil
+}
+
 func FillPrivateConnStrings(connStrings *mongodbatlas.ConnectionStrings, data *ConnectionData) {
 	if connStrings.Private != "" {
 		data.PrivateConnURLs = append(data.PrivateConnURLs, PrivateLinkConnURLs{
@@ -187,14 +264,67 @@ func FillPrivateConnStrings(connStrings *mongodbatlas.ConnectionStrings, data *C
 	}
 
 	for _, pe := range connStrings.PrivateEndpoint {
+		var regions, endpointIDs []string
+		for _, endpoint := range pe.Endpoints {
+			if endpoint.Region != "" {
+				regions = append(regions, regionKey(endpoint.ProviderName, endpoint.Region))
+			}
+			if endpoint.EndpointID != "" {
+				endpointIDs = append(endpointIDs, endpoint.EndpointID)
+			}
+		}
 		data.PrivateConnURLs = append(data.PrivateConnURLs, PrivateLinkConnURLs{
 			PvtConnURL:      pe.ConnectionString,
 			PvtSrvConnURL:   pe.SRVConnectionString,
 			PvtShardConnURL: pe.SRVShardOptimizedConnectionString,
+			Regions:         regions,
+			EndpointIDs:     endpointIDs,
 		})
 	}
 }
 
+// HasAnalyticsNodes returns true when the deployment has analytics nodes in any of its regions
+func HasAnalyticsNodes(deployment *mongodbatlas.AdvancedCluster) bool {
+	if deployment == nil {
+		return false
+	}
+
+	for _, replicationSpec := range deployment.ReplicationSpecs {
+		if replicationSpec == nil {
+			continue
+		}
+		for _, regionConfig := range replicationSpec.RegionConfigs {
+			if regionConfig != nil && regionConfig.AnalyticsSpecs != nil && regionConfig.AnalyticsSpecs.NodeCount != nil && *regionConfig.AnalyticsSpecs.NodeCount > 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// GlobalClusterZones returns the regions of every zone of a global cluster, formatted as <provider>.<region>. It's nil
+// for the other deployments
+func GlobalClusterZones(deployment *mongodbatlas.AdvancedCluster) map[string][]string {
+	if deployment == nil || deployment.ClusterType != "GEOSHARDED" {
+		return nil
+	}
+
+	zones := map[string][]string{}
+	for _, replicationSpec := range deployment.ReplicationSpecs {
+		if replicationSpec == nil || replicationSpec.ZoneName == "" {
+			continue
+		}
+		for _, regionConfig := range replicationSpec.RegionConfigs {
+			if regionConfig != nil {
+				zones[replicationSpec.ZoneName] = append(zones[replicationSpec.ZoneName], regionKey(regionConfig.ProviderName, regionConfig.RegionName))
+			}
+		}
+	}
+
+	return zones
+}
+
 func GetAllServerless(ctx *workflow.Context, projectID string) ([]*mongodbatlas.Cluster, error) {
 	serverless, _, err := ctx.Client.ServerlessInstances.List(ctx.Context, projectID, nil)
 	if err != nil {
@@ -0,0 +1,78 @@
+package connectionsecret
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestRemoveStaleByScope(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	for _, deployment := range []string{"c1", "c2", "c3"} {
+		data := dataForSecret()
+		data.DBUserName = "user1"
+		_, err := Ensure(context.Background(), fakeClient, "testNs", "p1", "603e7bf38a94956835659ae5", deployment, data)
+		require.NoError(t, err)
+	}
+	user := mdbv1.NewDBUser("testNs", "user1", "user1", "p1")
+	user.WithScope(mdbv1.DeploymentScopeType, "c1").WithScope(mdbv1.DeploymentScopeType, "c2")
+	user.Spec.SkipConnectionSecrets = []string{"c2"}
+	ctx := newContext(t)
+
+	err := removeStaleByScope(ctx, fakeClient, "603e7bf38a94956835659ae5", *user)
+
+	require.NoError(t, err)
+	secrets, err := ListByUserName(context.Background(), fakeClient, "testNs", "603e7bf38a94956835659ae5", "user1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"p1-c1-user1"}, getSecretsNames(secrets))
+}
+
+func TestResourceMetadata(t *testing.T) {
+	operatorMetadata := Metadata{
+		Labels:      map[string]string{"team": "platform", "env": "prod"},
+		Annotations: map[string]string{"replicator.v1.mittwald.de/replication-allowed": "false"},
+	}
+	deployment := mdbv1.NewDeployment("ns", "deployment", "cluster0")
+	deployment.Spec.ConnectionSecretLabels = map[string]string{"team": "orders", "tier": "gold"}
+	user := mdbv1.DefaultDBUser("ns", "app", "project")
+	user.Spec.ConnectionSecretLabels = map[string]string{"tier": "silver", TypeLabelKey: "overridden"}
+	user.Spec.ConnectionSecretAnnotations = map[string]string{"replicator.v1.mittwald.de/replication-allowed": "true"}
+
+	t.Run("should merge the metadata of the deployment and the user", func(t *testing.T) {
+		metadata := ResourceMetadata(operatorMetadata, deployment, user)
+
+		assert.Equal(t, map[string]string{"team": "orders", "env": "prod", "tier": "silver", TypeLabelKey: "overridden"}, metadata.Labels)
+		assert.Equal(t, map[string]string{"replicator.v1.mittwald.de/replication-allowed": "true"}, metadata.Annotations)
+		assert.Equal(t, map[string]string{"team": "platform", "env": "prod"}, operatorMetadata.Labels, "the Operator metadata is left unchanged")
+	})
+
+	t.Run("should keep the labels set by the Operator", func(t *testing.T) {
+		data := dataForSecret()
+		data.Metadata = ResourceMetadata(operatorMetadata, nil, user)
+		secret := &corev1.Secret{}
+
+		require.NoError(t, fillSecret(secret, "project-id", "cluster0", data))
+
+		assert.Equal(t, CredLabelVal, secret.Labels[TypeLabelKey])
+		assert.Equal(t, "silver", secret.Labels["tier"])
+		assert.Equal(t, "platform", secret.Labels["team"])
+		assert.Equal(t, "true", secret.Annotations["replicator.v1.mittwald.de/replication-allowed"])
+	})
+}
+
+func newContext(t *testing.T) *workflow.Context {
+	return workflow.NewContext(zaptest.NewLogger(t).Sugar(), []status.Condition{}, context.Background())
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"regexp"
+	"sort"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	apiErrors "k8s.io/apimachinery/pkg/api/errors"
@@ -11,6 +14,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
 )
 
 const (
@@ -19,13 +23,26 @@ const (
 	TypeLabelKey           = "atlas.mongodb.com/type"
 	CredLabelVal           = "credentials"
 
+	// PrivateEndpointRegionsAnnotation lists the regions of the private endpoints having their own connection strings
+	// in the Secret. It changes along with the region set, e.g. to trigger the rollout of the applications using them
+	PrivateEndpointRegionsAnnotation = "atlas.mongodb.com/private-endpoint-regions"
+	// ZonesAnnotation lists the zones of a global cluster having their own connection strings in the Secret
+	ZonesAnnotation = "atlas.mongodb.com/zones"
+
 	standardKey     string = "connectionStringStandard"
 	standardKeySrv  string = "connectionStringStandardSrv"
 	privateKey      string = "connectionStringPrivate"
 	privateKeySrv   string = "connectionStringPrivateSrv"
 	privateShardKey string = "connectionStringPrivateShard"
+	federatedKey    string = "connectionStringFederated"
+	archiveKey      string = "connectionStringArchive"
 	userNameKey     string = "username"
 	passwordKey     string = "password"
+	caKey           string = "ca.crt"
+
+	analyticsKeySuffix          string = "Analytics"
+	analyticsReadPreferenceKey  string = "readPreferenceTagsAnalytics"
+	analyticsReadPreferenceTags string = "nodeType:ANALYTICS"
 )
 
 type ConnectionData struct {
@@ -34,22 +51,92 @@ type ConnectionData struct {
 	ConnURL         string
 	SrvConnURL      string
 	PrivateConnURLs []PrivateLinkConnURLs
+	Metadata        Metadata
+	// AnalyticsNodes adds the variants of the connection strings reading from the analytics nodes of the deployment
+	AnalyticsNodes bool
+	// AuthMechanism is set for the users authenticated outside of MongoDB, e.g. MONGODB-AWS or MONGODB-X509. Their
+	// connection strings hold the authentication mechanism instead of the credentials, which are taken from the
+	// environment of the workload or its certificate
+	AuthMechanism string
+	// OnlineArchive adds the connection strings querying the online archive of the deployment
+	OnlineArchive OnlineArchiveConnURLs
+	// Store is the external secret store the connection credentials are written to instead of a Secret, nil when
+	// they're kept in a Secret
+	Store *Store
+	// Policy selects the connection string written as the primary one, nil when there's none
+	Policy *mdbv1.ConnectionStringPolicy
+	// Zones are the regions of every zone of a global cluster, formatted as <provider>.<region>
+	Zones map[string][]string
+}
+
+// Metadata holds the labels and annotations configured for the whole Operator which are added to all the connection
+// Secrets, e.g. to let secret syncing or policy tools select them. The labels set by the Operator take precedence
+type Metadata struct {
+	Labels      map[string]string
+	Annotations map[string]string
+	// RegionalKeys adds the private endpoint connection strings of every region under their own keys,
+	// e.g. connectionStringPrivateSrv.aws.us-east-1, so that regional applications pick the closest endpoint
+	RegionalKeys bool
+	// ZoneKeys adds the private endpoint connection strings of every zone of the global clusters under their own keys,
+	// e.g. connectionStringPrivateSrv.zone.europe, so that the applications of a zone mount only the keys of their zone
+	ZoneKeys bool
+	// CACertificate is the CA chain of the Atlas certificates added under the ca.crt key, for the drivers and sidecars
+	// which don't trust the system CAs
+	CACertificate []byte
+	// DriverOptions are the default options added to all the connection strings, e.g. appName, retryWrites or tls.
+	// The options set by Atlas are kept
+	DriverOptions url.Values
+}
+
+// With returns a copy of the metadata adding the labels and the annotations, which take precedence over the existing ones
+func (m Metadata) With(labels, annotations map[string]string) Metadata {
+	m.Labels = mergeMaps(m.Labels, labels)
+	m.Annotations = mergeMaps(m.Annotations, annotations)
+
+	return m
+}
+
+func mergeMaps(base, overrides map[string]string) map[string]string {
+	if len(overrides) == 0 {
+		return base
+	}
+
+	merged := make(map[string]string, len(base)+len(overrides))
+	for key, value := range base {
+		merged[key] = value
+	}
+	for key, value := range overrides {
+		merged[key] = value
+	}
+
+	return merged
 }
 
 type PrivateLinkConnURLs struct {
 	PvtConnURL      string
 	PvtSrvConnURL   string
 	PvtShardConnURL string
+	// Regions of the private endpoints the connection strings go through, formatted as <provider>.<region>
+	Regions []string
+	// EndpointIDs are the IDs of the private endpoints in the cloud provider, empty for the network peering
+	EndpointIDs []string
 }
 
 // Ensure creates or updates the connection Secret for the specific cluster and db user. Returns the name of the Secret
-// created.
+// created. The connection credentials are written to the external secret store of the data instead, if any, under the
+// name of the Secret.
 func Ensure(ctx context.Context, client client.Client, namespace, projectName, projectID, clusterName string, data ConnectionData) (string, error) {
 	var getError error
 	s := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
-		Name:      formatSecretName(projectName, clusterName, data.DBUserName),
+		Name:      FormatSecretName(projectName, clusterName, data.DBUserName),
 		Namespace: namespace,
 	}}
+	if data.Store != nil {
+		if err := fillSecret(s, projectID, clusterName, data); err != nil {
+			return "", err
+		}
+		return s.Name, ensureInStore(ctx, client, data.Store, s)
+	}
 	if getError = client.Get(ctx, kube.ObjectKeyFromObject(s), s); getError != nil && !apiErrors.IsNotFound(getError) {
 		return "", getError
 	}
@@ -65,29 +152,47 @@ func Ensure(ctx context.Context, client client.Client, namespace, projectName, p
 }
 
 func fillSecret(secret *corev1.Secret, projectID string, clusterName string, data ConnectionData) error {
+	addCredentials := func(connURL string) (string, error) {
+		return AddCredentialsToConnectionURL(connURL, data.DBUserName, data.Password)
+	}
+	if data.AuthMechanism != "" {
+		addCredentials = func(connURL string) (string, error) {
+			return AddAuthMechanismToConnectionURL(connURL, data.AuthMechanism)
+		}
+	}
+
 	var err error
-	if data.ConnURL, err = AddCredentialsToConnectionURL(data.ConnURL, data.DBUserName, data.Password); err != nil {
+	if data.ConnURL, err = addCredentials(data.ConnURL); err != nil {
 		return err
 	}
-	if data.SrvConnURL, err = AddCredentialsToConnectionURL(data.SrvConnURL, data.DBUserName, data.Password); err != nil {
+	if data.SrvConnURL, err = addCredentials(data.SrvConnURL); err != nil {
 		return err
 	}
 	for idx, privateConn := range data.PrivateConnURLs {
-		if data.PrivateConnURLs[idx].PvtConnURL, err = AddCredentialsToConnectionURL(privateConn.PvtConnURL, data.DBUserName, data.Password); err != nil {
+		if data.PrivateConnURLs[idx].PvtConnURL, err = addCredentials(privateConn.PvtConnURL); err != nil {
 			return err
 		}
-		if data.PrivateConnURLs[idx].PvtSrvConnURL, err = AddCredentialsToConnectionURL(privateConn.PvtSrvConnURL, data.DBUserName, data.Password); err != nil {
+		if data.PrivateConnURLs[idx].PvtSrvConnURL, err = addCredentials(privateConn.PvtSrvConnURL); err != nil {
 			return err
 		}
-		if data.PrivateConnURLs[idx].PvtShardConnURL, err = AddCredentialsToConnectionURL(privateConn.PvtShardConnURL, data.DBUserName, data.Password); err != nil {
+		if data.PrivateConnURLs[idx].PvtShardConnURL, err = addCredentials(privateConn.PvtShardConnURL); err != nil {
 			return err
 		}
 	}
 
-	secret.Labels = map[string]string{
-		TypeLabelKey:    CredLabelVal,
-		ProjectLabelKey: projectID,
-		ClusterLabelKey: kube.NormalizeLabelValue(clusterName),
+	secret.Labels = map[string]string{}
+	for key, value := range data.Metadata.Labels {
+		secret.Labels[key] = value
+	}
+	secret.Labels[TypeLabelKey] = CredLabelVal
+	secret.Labels[ProjectLabelKey] = projectID
+	secret.Labels[ClusterLabelKey] = kube.NormalizeLabelValue(clusterName)
+
+	if len(data.Metadata.Annotations) > 0 && secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	for key, value := range data.Metadata.Annotations {
+		secret.Annotations[key] = value
 	}
 
 	secret.Data = map[string][]byte{
@@ -106,9 +211,232 @@ func fillSecret(secret *corev1.Secret, projectID string, clusterName string, dat
 		secret.Data[privateShardKey+suffix] = []byte(privateConn.PvtShardConnURL)
 	}
 
+	if data.Metadata.RegionalKeys {
+		fillRegionalKeys(secret, data.PrivateConnURLs)
+	}
+	if data.Metadata.ZoneKeys {
+		fillZoneKeys(secret, data.Zones, data.PrivateConnURLs)
+	}
+
+	if data.AnalyticsNodes {
+		if err = fillAnalyticsKeys(secret); err != nil {
+			return err
+		}
+	}
+
+	// the online archive is queried through a federated database instance, which has no analytics nodes
+	if data.OnlineArchive.Federated != "" {
+		federatedURL, err := addCredentials(data.OnlineArchive.Federated)
+		if err != nil {
+			return err
+		}
+		archiveURL, err := addCredentials(data.OnlineArchive.Archive)
+		if err != nil {
+			return err
+		}
+		secret.Data[federatedKey] = []byte(federatedURL)
+		secret.Data[archiveKey] = []byte(archiveURL)
+	}
+
+	fillPrimaryKey(secret, data)
+
+	if len(data.Metadata.CACertificate) > 0 {
+		secret.Data[caKey] = data.Metadata.CACertificate
+	}
+
+	return fillDriverOptions(secret, data.Metadata.DriverOptions)
+}
+
+// fillDriverOptions adds the default driver options to every connection string of the Secret, unless it sets them
+// already. The options are appended to the query so that the ones set by Atlas are kept as they are
+func fillDriverOptions(secret *corev1.Secret, options url.Values) error {
+	if len(options) == 0 {
+		return nil
+	}
+
+	for key, value := range secret.Data {
+		if !strings.HasPrefix(key, "connectionString") {
+			continue
+		}
+
+		cs, err := url.Parse(string(value))
+		if err != nil {
+			return err
+		}
+		if cs.Host == "" {
+			continue
+		}
+
+		query := cs.Query()
+		missing := url.Values{}
+		for option, values := range options {
+			if !query.Has(option) {
+				missing[option] = values
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+
+		if cs.RawQuery != "" {
+			cs.RawQuery += "&"
+		}
+		cs.RawQuery += missing.Encode()
+		if cs.Path == "" {
+			cs.Path = "/"
+		}
+		secret.Data[key] = []byte(cs.String())
+	}
+
 	return nil
 }
 
+// fillAnalyticsKeys adds a variant of every connection string of the Secret routing the reads to the analytics nodes,
+// under the same key suffixed with Analytics, e.g. connectionStringStandardSrvAnalytics. The replica set tags of the
+// analytics nodes are added as well for the drivers configured with separate options
+func fillAnalyticsKeys(secret *corev1.Secret) error {
+	keys := make([]string, 0, len(secret.Data))
+	for key := range secret.Data {
+		if strings.HasPrefix(key, "connectionString") {
+			keys = append(keys, key)
+		}
+	}
+
+	for _, key := range keys {
+		analyticsURL, err := analyticsConnectionURL(string(secret.Data[key]))
+		if err != nil {
+			return err
+		}
+		if analyticsURL == "" {
+			continue
+		}
+		analyticsKey := key + analyticsKeySuffix
+		// the region of the regional keys stays at the end of the key
+		if prefix, region, ok := strings.Cut(key, "."); ok {
+			analyticsKey = prefix + analyticsKeySuffix + "." + region
+		}
+		secret.Data[analyticsKey] = []byte(analyticsURL)
+	}
+	secret.Data[analyticsReadPreferenceKey] = []byte(analyticsReadPreferenceTags)
+
+	return nil
+}
+
+// analyticsConnectionURL adds the read preference targeting the analytics nodes to the connection string, the
+// connection strings not provided by Atlas, having the credentials only, have no variant
+func analyticsConnectionURL(connURL string) (string, error) {
+	cs, err := url.Parse(connURL)
+	if err != nil || cs.Host == "" {
+		return "", err
+	}
+
+	query := cs.Query()
+	query.Del("readPreference")
+	query.Del("readPreferenceTags")
+	options := query.Encode()
+	if options != "" {
+		options += "&"
+	}
+	// the tags are kept unescaped as documented by Atlas
+	cs.RawQuery = options + "readPreference=secondary&readPreferenceTags=" + analyticsReadPreferenceTags
+
+	return cs.String(), nil
+}
+
+// fillRegionalKeys adds the private connection strings of every region under keys suffixed with the region. The keys
+// don't depend on the order Atlas lists the private endpoints in: when several connection strings go through the same
+// region, the first one in lexical order is used
+func fillRegionalKeys(secret *corev1.Secret, privateConnURLs []PrivateLinkConnURLs) {
+	byRegion := privateConnsByRegion(privateConnURLs)
+
+	regions := make([]string, 0, len(byRegion))
+	for region, privateConn := range byRegion {
+		regions = append(regions, region)
+		secret.Data[privateKey+"."+region] = []byte(privateConn.PvtConnURL)
+		secret.Data[privateKeySrv+"."+region] = []byte(privateConn.PvtSrvConnURL)
+		secret.Data[privateShardKey+"."+region] = []byte(privateConn.PvtShardConnURL)
+	}
+
+	if len(regions) == 0 {
+		delete(secret.Annotations, PrivateEndpointRegionsAnnotation)
+		return
+	}
+
+	sort.Strings(regions)
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[PrivateEndpointRegionsAnnotation] = strings.Join(regions, ",")
+}
+
+// fillZoneKeys adds the private connection strings of every zone of a global cluster under keys suffixed with the
+// zone. A zone uses the private endpoint of one of its regions, picked as for the regional keys
+func fillZoneKeys(secret *corev1.Secret, zones map[string][]string, privateConnURLs []PrivateLinkConnURLs) {
+	byRegion := privateConnsByRegion(privateConnURLs)
+
+	zoneNames := make([]string, 0, len(zones))
+	for zone, regions := range zones {
+		var selected *PrivateLinkConnURLs
+		for _, region := range regions {
+			if privateConn, ok := byRegion[region]; ok && (selected == nil || privateConn.PvtSrvConnURL < selected.PvtSrvConnURL) {
+				selected = &privateConn
+			}
+		}
+		if selected == nil {
+			continue
+		}
+
+		suffix := ".zone." + zoneKey(zone)
+		zoneNames = append(zoneNames, zone)
+		secret.Data[privateKey+suffix] = []byte(selected.PvtConnURL)
+		secret.Data[privateKeySrv+suffix] = []byte(selected.PvtSrvConnURL)
+		secret.Data[privateShardKey+suffix] = []byte(selected.PvtShardConnURL)
+	}
+
+	if len(zoneNames) == 0 {
+		delete(secret.Annotations, ZonesAnnotation)
+		return
+	}
+
+	sort.Strings(zoneNames)
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[ZonesAnnotation] = strings.Join(zoneNames, ",")
+}
+
+// privateConnsByRegion indexes the private connection strings by the regions of their endpoints. When several connection
+// strings go through the same region, the first one in lexical order is used
+func privateConnsByRegion(privateConnURLs []PrivateLinkConnURLs) map[string]PrivateLinkConnURLs {
+	byRegion := map[string]PrivateLinkConnURLs{}
+	for _, privateConn := range privateConnURLs {
+		for _, region := range privateConn.Regions {
+			if current, ok := byRegion[region]; !ok || privateConn.PvtSrvConnURL < current.PvtSrvConnURL {
+				byRegion[region] = privateConn
+			}
+		}
+	}
+
+	return byRegion
+}
+
+var invalidZoneKeyChars = regexp.MustCompile(`[^a-z0-9._-]+`)
+
+// zoneKey formats the name of a zone as a Secret key suffix, e.g. "Europe West" becomes europe-west
+func zoneKey(zone string) string {
+	return strings.Trim(invalidZoneKeyChars.ReplaceAllString(strings.ToLower(zone), "-"), "-.")
+}
+
+// regionKey formats the region of a private endpoint as a Secret key suffix, e.g. aws.us-east-1
+func regionKey(providerName, region string) string {
+	region = strings.ToLower(strings.ReplaceAll(region, "_", "-"))
+	if providerName == "" {
+		return region
+	}
+
+	return strings.ToLower(providerName) + "." + region
+}
+
 func getSuffix(idx int) string {
 	if idx == 0 {
 		return ""
@@ -117,7 +445,8 @@ func getSuffix(idx int) string {
 	return fmt.Sprint(idx)
 }
 
-func formatSecretName(projectName, clusterName, dbUserName string) string {
+// FormatSecretName returns the name of the connection Secret of a database user for a deployment
+func FormatSecretName(projectName, clusterName, dbUserName string) string {
 	name := fmt.Sprintf("%s-%s-%s",
 		kube.NormalizeIdentifier(projectName),
 		kube.NormalizeIdentifier(clusterName),
@@ -133,3 +462,22 @@ func AddCredentialsToConnectionURL(connURL, userName, password string) (string,
 	cs.User = url.UserPassword(userName, password)
 	return cs.String(), nil
 }
+
+// AddAuthMechanismToConnectionURL sets the external authentication mechanism in the connection string, e.g.
+// MONGODB-AWS, the credentials are not part of it. The connection strings not provided by Atlas are left empty
+func AddAuthMechanismToConnectionURL(connURL, authMechanism string) (string, error) {
+	cs, err := url.Parse(connURL)
+	if err != nil || cs.Host == "" {
+		return "", err
+	}
+
+	if cs.Path == "" {
+		cs.Path = "/"
+	}
+	query := cs.Query()
+	query.Set("authSource", mdbv1.ExternalDatabaseName)
+	query.Set("authMechanism", authMechanism)
+	cs.RawQuery = query.Encode()
+
+	return cs.String(), nil
+}
@@ -0,0 +1,267 @@
+package connectionsecret
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/atlas/mongodbatlas"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+)
+
+// OrphanedSecretsJanitor periodically removes the connection Secrets left behind by the operator: the ones of a
+// project which no longer exists, of a deployment or a Data Federation which no longer exists in Atlas, of a database
+// user which no longer exists, or of a database user which isn't granted the Secret anymore, e.g. after a change of
+// its scopes
+type OrphanedSecretsJanitor struct {
+	Client        client.Client
+	Log           *zap.SugaredLogger
+	AtlasProvider atlas.Provider
+	// Interval is the time between two searches
+	Interval time.Duration
+}
+
+// NeedLeaderElection makes sure a single replica of the operator deletes the orphaned Secrets
+func (j *OrphanedSecretsJanitor) NeedLeaderElection() bool {
+	return true
+}
+
+// Start runs the search every interval until the context is done
+func (j *OrphanedSecretsJanitor) Start(ctx context.Context) error {
+	ticker := time.NewTicker(j.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			j.collect(ctx)
+		}
+	}
+}
+
+// userKey identifies the database users a connection Secret may belong to
+type userKey struct {
+	namespace string
+	projectID string
+	username  string
+}
+
+func (j *OrphanedSecretsJanitor) collect(ctx context.Context) {
+	secrets := &corev1.SecretList{}
+	if err := j.Client.List(ctx, secrets, client.MatchingLabels{TypeLabelKey: CredLabelVal}); err != nil {
+		j.Log.Errorw("failed to list the connection secrets to look for orphaned ones", "error", err)
+		return
+	}
+	if len(secrets.Items) == 0 {
+		return
+	}
+
+	projects, err := j.projectsByID(ctx)
+	if err != nil {
+		j.Log.Errorw("failed to list the projects to look for orphaned connection secrets", "error", err)
+		return
+	}
+
+	users, err := j.usersByKey(ctx)
+	if err != nil {
+		j.Log.Errorw("failed to list the database users to look for orphaned connection secrets", "error", err)
+		return
+	}
+
+	byProject := map[string][]*corev1.Secret{}
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if !isConnectionSecret(secret) {
+			continue
+		}
+		byProject[secret.Labels[ProjectLabelKey]] = append(byProject[secret.Labels[ProjectLabelKey]], secret)
+	}
+
+	for projectID, projectSecrets := range byProject {
+		project, ok := projects[projectID]
+		if !ok {
+			j.deleteAll(ctx, projectSecrets, "the project no longer exists")
+			continue
+		}
+		if !project.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+
+		deployments, err := j.deploymentNames(ctx, project)
+		if err != nil {
+			j.Log.Errorw("failed to list the deployments to look for orphaned connection secrets", "project", kube.ObjectKeyFromObject(project), "error", err)
+			continue
+		}
+
+		for _, secret := range projectSecrets {
+			if reason := orphanReason(secret, deployments, users); reason != "" {
+				j.delete(ctx, secret, reason)
+			}
+		}
+	}
+}
+
+// isConnectionSecret tells the connection Secrets apart from the Atlas credentials Secrets, which share their type label
+func isConnectionSecret(secret *corev1.Secret) bool {
+	if secret.Labels[ProjectLabelKey] == "" || secret.Labels[ClusterLabelKey] == "" {
+		return false
+	}
+	_, ok := secret.Data[userNameKey]
+
+	return ok
+}
+
+// orphanReason returns why the connection Secret is orphaned, empty when it's still in use
+func orphanReason(secret *corev1.Secret, deployments map[string]string, users map[userKey][]mdbv1.AtlasDatabaseUser) string {
+	deploymentName, ok := deployments[secret.Labels[ClusterLabelKey]]
+	if !ok {
+		return "the deployment no longer exists"
+	}
+
+	key := userKey{namespace: secret.Namespace, projectID: secret.Labels[ProjectLabelKey], username: string(secret.Data[userNameKey])}
+	candidates := users[key]
+	if len(candidates) == 0 {
+		return "the database user no longer exists"
+	}
+
+	for _, user := range candidates {
+		if user.HasConnectionSecret(deploymentName) {
+			return ""
+		}
+	}
+
+	return fmt.Sprintf("the database user isn't granted a connection secret for %s anymore", deploymentName)
+}
+
+func (j *OrphanedSecretsJanitor) projectsByID(ctx context.Context) (map[string]*mdbv1.AtlasProject, error) {
+	projects := &mdbv1.AtlasProjectList{}
+	if err := j.Client.List(ctx, projects); err != nil {
+		return nil, err
+	}
+
+	byID := map[string]*mdbv1.AtlasProject{}
+	for i := range projects.Items {
+		if id := projects.Items[i].ID(); id != "" {
+			byID[id] = &projects.Items[i]
+		}
+	}
+
+	return byID, nil
+}
+
+// usersByKey indexes the database users by their namespace, the ID of their project and their username. The users
+// whose project isn't created yet are left out, they have no connection Secrets
+func (j *OrphanedSecretsJanitor) usersByKey(ctx context.Context) (map[userKey][]mdbv1.AtlasDatabaseUser, error) {
+	users := &mdbv1.AtlasDatabaseUserList{}
+	if err := j.Client.List(ctx, users); err != nil {
+		return nil, err
+	}
+
+	projectIDs := map[client.ObjectKey]string{}
+	byKey := map[userKey][]mdbv1.AtlasDatabaseUser{}
+	for _, user := range users.Items {
+		projectKey := user.AtlasProjectObjectKey()
+		projectID, ok := projectIDs[projectKey]
+		if !ok {
+			project := &mdbv1.AtlasProject{}
+			if err := j.Client.Get(ctx, projectKey, project); client.IgnoreNotFound(err) != nil {
+				return nil, err
+			}
+			projectID = project.ID()
+			projectIDs[projectKey] = projectID
+		}
+		if projectID == "" {
+			continue
+		}
+
+		// the secrets hold the username of the spec, which is a template for the users with a generated name
+		for _, username := range []string{user.Spec.Username, user.AtlasUsername()} {
+			key := userKey{namespace: user.Namespace, projectID: projectID, username: username}
+			byKey[key] = append(byKey[key], user)
+		}
+	}
+
+	return byKey, nil
+}
+
+// deploymentNames returns the names of the deployments and the Data Federations of the project in Atlas, indexed by
+// the value of the cluster label of their connection Secrets
+func (j *OrphanedSecretsJanitor) deploymentNames(ctx context.Context, project *mdbv1.AtlasProject) (map[string]string, error) {
+	atlasClient, _, err := j.AtlasProvider.Client(ctx, project.ConnectionSecretObjectKey(), j.Log)
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]string{}
+	add := func(name string) {
+		names[kube.NormalizeLabelValue(name)] = name
+	}
+
+	// all the pages are read, a deployment missing from the list would get its Secrets deleted
+	err = atlas.TraversePages(func(pageNum int) (atlas.Paginated, error) {
+		deployments, response, err := atlasClient.AdvancedClusters.List(ctx, project.ID(), atlas.DefaultListOptions(pageNum))
+		if err != nil {
+			return nil, err
+		}
+		return atlas.NewAtlasPaginated(response, deployments.Results), nil
+	}, func(entity interface{}) bool {
+		add(entity.(*mongodbatlas.AdvancedCluster).Name)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// serverless instances and Data Federations aren't available in Atlas for Government
+	if j.AtlasProvider.IsCloudGov() {
+		return names, nil
+	}
+
+	err = atlas.TraversePages(func(pageNum int) (atlas.Paginated, error) {
+		instances, response, err := atlasClient.ServerlessInstances.List(ctx, project.ID(), atlas.DefaultListOptions(pageNum))
+		if err != nil {
+			return nil, err
+		}
+		return atlas.NewAtlasPaginated(response, instances.Results), nil
+	}, func(entity interface{}) bool {
+		add(entity.(*mongodbatlas.Cluster).Name)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	federations, _, err := atlasClient.DataFederation.List(ctx, project.ID())
+	if err != nil {
+		return nil, err
+	}
+	for _, federation := range federations {
+		add(federation.Name)
+	}
+
+	return names, nil
+}
+
+func (j *OrphanedSecretsJanitor) deleteAll(ctx context.Context, secrets []*corev1.Secret, reason string) {
+	for _, secret := range secrets {
+		j.delete(ctx, secret, reason)
+	}
+}
+
+func (j *OrphanedSecretsJanitor) delete(ctx context.Context, secret *corev1.Secret, reason string) {
+	// the preconditions prevent the deletion of a Secret replaced since it was listed
+	if err := j.Client.Delete(ctx, secret, client.Preconditions{UID: &secret.UID, ResourceVersion: &secret.ResourceVersion}); client.IgnoreNotFound(err) != nil {
+		j.Log.Errorw("failed to delete the orphaned connection secret", "secret", kube.ObjectKeyFromObject(secret), "error", err)
+		return
+	}
+
+	j.Log.Infow("Deleted the orphaned connection secret", "secret", kube.ObjectKeyFromObject(secret), "reason", reason)
+}
@@ -0,0 +1,153 @@
+package connectionsecret
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas/mongodbatlas"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+)
+
+// dataFederationMock lists the Data Federations of a project, the other operations aren't used by the janitor
+type dataFederationMock struct {
+	mongodbatlas.DataFederationService
+	names []string
+}
+
+func (m *dataFederationMock) List(_ context.Context, _ string) ([]*mongodbatlas.DataFederationInstance, *mongodbatlas.Response, error) {
+	instances := make([]*mongodbatlas.DataFederationInstance, 0, len(m.names))
+	for _, name := range m.names {
+		instances = append(instances, &mongodbatlas.DataFederationInstance{Name: name})
+	}
+
+	return instances, &mongodbatlas.Response{}, nil
+}
+
+func TestOrphanedSecretsJanitor(t *testing.T) {
+	project := mdbv1.NewProject("ns", "project", "project")
+	project.Status.ID = "project-id"
+	connectionSecret := func(name, projectID, clusterName, username string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "ns",
+				Labels: map[string]string{
+					TypeLabelKey:    CredLabelVal,
+					ProjectLabelKey: projectID,
+					ClusterLabelKey: clusterName,
+				},
+			},
+			Data: map[string][]byte{userNameKey: []byte(username)},
+		}
+	}
+	newJanitor := func(t *testing.T, deployments []string, objects ...client.Object) *OrphanedSecretsJanitor {
+		sch := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(sch))
+		require.NoError(t, mdbv1.AddToScheme(sch))
+
+		clustersMock := &atlas.AdvancedClustersClientMock{
+			ListFunc: func(projectID string) (*mongodbatlas.AdvancedClustersResponse, *mongodbatlas.Response, error) {
+				response := &mongodbatlas.AdvancedClustersResponse{}
+				for _, name := range deployments {
+					response.Results = append(response.Results, &mongodbatlas.AdvancedCluster{Name: name})
+				}
+				return response, &mongodbatlas.Response{}, nil
+			},
+		}
+		serverlessMock := &atlas.ServerlessInstancesClientMock{
+			ListFunc: func(projectID string) (*mongodbatlas.ClustersResponse, *mongodbatlas.Response, error) {
+				return &mongodbatlas.ClustersResponse{Results: []*mongodbatlas.Cluster{{Name: "serverless0"}}}, &mongodbatlas.Response{}, nil
+			},
+		}
+
+		return &OrphanedSecretsJanitor{
+			Client: fake.NewClientBuilder().WithScheme(sch).WithObjects(append(objects, project.DeepCopy())...).Build(),
+			Log:    zaptest.NewLogger(t).Sugar(),
+			AtlasProvider: &atlas.TestProvider{
+				ClientFunc: func(secretRef *client.ObjectKey, log *zap.SugaredLogger) (*mongodbatlas.Client, string, error) {
+					return &mongodbatlas.Client{
+						AdvancedClusters:    clustersMock,
+						ServerlessInstances: serverlessMock,
+						DataFederation:      &dataFederationMock{names: []string{"federation0"}},
+					}, "org-id", nil
+				},
+				IsCloudGovFunc: func() bool { return false },
+			},
+		}
+	}
+	remaining := func(t *testing.T, janitor *OrphanedSecretsJanitor) []string {
+		secrets := &corev1.SecretList{}
+		require.NoError(t, janitor.Client.List(context.Background(), secrets))
+		names := make([]string, 0, len(secrets.Items))
+		for _, secret := range secrets.Items {
+			names = append(names, secret.Name)
+		}
+
+		return names
+	}
+
+	t.Run("should keep the secrets in use", func(t *testing.T) {
+		user := mdbv1.DefaultDBUser("ns", "app", "project")
+		janitor := newJanitor(t, []string{"Cluster 0"},
+			user,
+			connectionSecret("cluster", "project-id", "cluster-0", "app"),
+			connectionSecret("serverless", "project-id", "serverless0", "app"),
+			connectionSecret("federation", "project-id", "federation0", "app"),
+		)
+
+		janitor.collect(context.Background())
+
+		assert.ElementsMatch(t, []string{"cluster", "serverless", "federation"}, remaining(t, janitor))
+	})
+
+	t.Run("should delete the secrets of the deleted projects, deployments and users", func(t *testing.T) {
+		user := mdbv1.DefaultDBUser("ns", "app", "project")
+		janitor := newJanitor(t, []string{"cluster0"},
+			user,
+			connectionSecret("kept", "project-id", "cluster0", "app"),
+			connectionSecret("deleted-project", "deleted-project-id", "cluster0", "app"),
+			connectionSecret("deleted-deployment", "project-id", "cluster1", "app"),
+			connectionSecret("deleted-user", "project-id", "cluster0", "former-app"),
+		)
+
+		janitor.collect(context.Background())
+
+		assert.ElementsMatch(t, []string{"kept"}, remaining(t, janitor))
+	})
+
+	t.Run("should delete the secrets out of the scopes of the user", func(t *testing.T) {
+		user := mdbv1.DefaultDBUser("ns", "app", "project").WithScope(mdbv1.DeploymentScopeType, "cluster0")
+		janitor := newJanitor(t, []string{"cluster0", "cluster1"},
+			user,
+			connectionSecret("scoped", "project-id", "cluster0", "app"),
+			connectionSecret("out-of-scope", "project-id", "cluster1", "app"),
+		)
+
+		janitor.collect(context.Background())
+
+		assert.ElementsMatch(t, []string{"scoped"}, remaining(t, janitor))
+	})
+
+	t.Run("should leave the credentials secrets alone", func(t *testing.T) {
+		credentials := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "api-key", Namespace: "ns", Labels: map[string]string{TypeLabelKey: CredLabelVal}},
+			Data:       map[string][]byte{"orgId": []byte("org-id")},
+		}
+		janitor := newJanitor(t, nil, credentials)
+
+		janitor.collect(context.Background())
+
+		assert.ElementsMatch(t, []string{"api-key"}, remaining(t, janitor))
+	})
+}
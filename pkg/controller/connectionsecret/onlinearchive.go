@@ -0,0 +1,106 @@
+package connectionsecret
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	onlineArchiveFederationType = "ONLINE_ARCHIVE"
+	atlasStoreProvider          = "atlas"
+	// archiveHostPrefix prefixes the hostnames of the federated database instance of an online archive to query the
+	// archived data only
+	archiveHostPrefix = "archived-"
+)
+
+// OnlineArchiveConnURLs are the connection strings of the federated database instance Atlas creates for the online
+// archive of a deployment
+type OnlineArchiveConnURLs struct {
+	// Federated queries the data of the deployment and of its archive
+	Federated string
+	// Archive queries the archived data only
+	Archive string
+}
+
+// ListOnlineArchiveConnURLs returns the connection strings of the online archives of the deployments by deployment
+// name. The federated database instances are listed only when one of the deployments has an online archive. The
+// archives are optional in the connection secrets, the errors are logged and the deployments are returned without
+// archive then
+func ListOnlineArchiveConnURLs(ctx *workflow.Context, projectID string, deploymentNames []string) map[string]OnlineArchiveConnURLs {
+	// Atlas for Government doesn't support the online archives
+	if IsCloudGovDomain(ctx) {
+		return nil
+	}
+
+	var archived []string
+	for _, name := range deploymentNames {
+		archives, _, err := ctx.SdkClient.OnlineArchiveApi.ListOnlineArchives(ctx.Context, projectID, name).
+			ItemsPerPage(1).
+			IncludeCount(true).
+			Execute()
+		if err != nil {
+			ctx.Log.Warnf("failed to list the online archives of the deployment %s, its connection secrets are written without them: %s", name, err)
+			continue
+		}
+		if archives.GetTotalCount() > 0 {
+			archived = append(archived, name)
+		}
+	}
+	if len(archived) == 0 {
+		return nil
+	}
+
+	instances, _, err := ctx.SdkClient.DataFederationApi.ListFederatedDatabases(ctx.Context, projectID).Type_(onlineArchiveFederationType).Execute()
+	if err != nil {
+		ctx.Log.Warnf("failed to list the federated database instances of the online archives, the connection secrets are written without them: %s", err)
+		return nil
+	}
+
+	connURLs := onlineArchiveConnURLs(instances)
+	for name := range connURLs {
+		if !slices.Contains(archived, name) {
+			delete(connURLs, name)
+		}
+	}
+
+	return connURLs
+}
+
+// onlineArchiveConnURLs maps the federated database instances of the online archives to the deployments they archive,
+// found as the Atlas store of the instance
+func onlineArchiveConnURLs(instances []admin.DataLakeTenant) map[string]OnlineArchiveConnURLs {
+	connURLs := map[string]OnlineArchiveConnURLs{}
+	for _, instance := range instances {
+		hostnames := instance.GetHostnames()
+		if len(hostnames) == 0 {
+			continue
+		}
+
+		archiveHostnames := make([]string, 0, len(hostnames))
+		for _, hostname := range hostnames {
+			archiveHostnames = append(archiveHostnames, archiveHostPrefix+hostname)
+		}
+
+		storage := instance.GetStorage()
+		for _, store := range storage.GetStores() {
+			if store.Provider != atlasStoreProvider || store.GetClusterName() == "" {
+				continue
+			}
+			connURLs[store.GetClusterName()] = OnlineArchiveConnURLs{
+				Federated: federatedConnURL(hostnames),
+				Archive:   federatedConnURL(archiveHostnames),
+			}
+		}
+	}
+
+	return connURLs
+}
+
+func federatedConnURL(hostnames []string) string {
+	return fmt.Sprintf("mongodb://%s/?ssl=true&authSource=admin", strings.Join(hostnames, ","))
+}
@@ -0,0 +1,118 @@
+package connectionsecret
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	"go.mongodb.org/atlas/mongodbatlas"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestOnlineArchiveConnURLs(t *testing.T) {
+	t.Run("should map the archives to their deployment", func(t *testing.T) {
+		instances := []admin.DataLakeTenant{
+			{
+				Hostnames: &[]string{"atlas-online-archive-1.a.query.mongodb.net"},
+				Storage: &admin.DataLakeStorage{Stores: &[]admin.DataLakeStoreSettings{
+					{Provider: "dls:aws", Name: admin.PtrString("archive")},
+					{Provider: "atlas", ClusterName: admin.PtrString("cluster0")},
+				}},
+			},
+			{
+				Storage: &admin.DataLakeStorage{Stores: &[]admin.DataLakeStoreSettings{
+					{Provider: "atlas", ClusterName: admin.PtrString("cluster1")},
+				}},
+			},
+		}
+
+		assert.Equal(t, map[string]OnlineArchiveConnURLs{
+			"cluster0": {
+				Federated: "mongodb://atlas-online-archive-1.a.query.mongodb.net/?ssl=true&authSource=admin",
+				Archive:   "mongodb://archived-atlas-online-archive-1.a.query.mongodb.net/?ssl=true&authSource=admin",
+			},
+		}, onlineArchiveConnURLs(instances))
+	})
+
+	t.Run("should return no archives without instances", func(t *testing.T) {
+		assert.Empty(t, onlineArchiveConnURLs(nil))
+	})
+}
+
+func TestListOnlineArchiveConnURLs(t *testing.T) {
+	newArchiveContext := func(t *testing.T, handler http.HandlerFunc) *workflow.Context {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			handler(w, r)
+		}))
+		t.Cleanup(server.Close)
+
+		ctx := newContext(t)
+		atlasClient, err := mongodbatlas.New(server.Client(), mongodbatlas.SetBaseURL(server.URL+"/"))
+		require.NoError(t, err)
+		ctx.Client = atlasClient
+		ctx.SdkClient, err = admin.NewClient(admin.UseBaseURL(server.URL))
+		require.NoError(t, err)
+
+		return ctx
+	}
+	archives := func(w http.ResponseWriter, r *http.Request) bool {
+		switch r.URL.Path {
+		case "/api/atlas/v2/groups/project-id/clusters/archived/onlineArchives":
+			_, _ = w.Write([]byte(`{"totalCount":1}`))
+		case "/api/atlas/v2/groups/project-id/clusters/cluster0/onlineArchives":
+			_, _ = w.Write([]byte(`{"totalCount":0}`))
+		default:
+			return false
+		}
+
+		return true
+	}
+
+	t.Run("should list the federated databases only for the deployments with an online archive", func(t *testing.T) {
+		ctx := newArchiveContext(t, func(w http.ResponseWriter, r *http.Request) {
+			if archives(w, r) {
+				return
+			}
+			assert.Equal(t, "/api/atlas/v2/groups/project-id/dataFederation", r.URL.Path)
+			_, _ = w.Write([]byte(`[
+				{"hostnames":["archive.example.com"],"storage":{"stores":[{"provider":"atlas","clusterName":"archived"}]}},
+				{"hostnames":["other.example.com"],"storage":{"stores":[{"provider":"atlas","clusterName":"cluster0"}]}}
+			]`))
+		})
+
+		connURLs := ListOnlineArchiveConnURLs(ctx, "project-id", []string{"archived", "cluster0"})
+
+		assert.Equal(t, map[string]OnlineArchiveConnURLs{
+			"archived": {
+				Federated: "mongodb://archive.example.com/?ssl=true&authSource=admin",
+				Archive:   "mongodb://archived-archive.example.com/?ssl=true&authSource=admin",
+			},
+		}, connURLs)
+	})
+
+	t.Run("should not list the federated databases without online archive", func(t *testing.T) {
+		ctx := newArchiveContext(t, func(w http.ResponseWriter, r *http.Request) {
+			if !archives(w, r) {
+				assert.Fail(t, "unexpected request", r.URL.Path)
+			}
+		})
+
+		assert.Empty(t, ListOnlineArchiveConnURLs(ctx, "project-id", []string{"cluster0"}))
+	})
+
+	t.Run("should omit the online archives on error", func(t *testing.T) {
+		ctx := newArchiveContext(t, func(w http.ResponseWriter, r *http.Request) {
+			if archives(w, r) {
+				return
+			}
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+
+		assert.Empty(t, ListOnlineArchiveConnURLs(ctx, "project-id", []string{"archived"}))
+	})
+}
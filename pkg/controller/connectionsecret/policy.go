@@ -0,0 +1,99 @@
+package connectionsecret
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+)
+
+const (
+	// ConnectionStringPolicyAnnotation sets the connection string policy of the database users of a namespace which
+	// have none, either Public or PrivateEndpoint
+	ConnectionStringPolicyAnnotation = "mongodb.com/atlas-connection-string-policy"
+	// ConnectionStringPrivateEndpointAnnotation selects the private endpoint of the PrivateEndpoint policy of the namespace
+	ConnectionStringPrivateEndpointAnnotation = "mongodb.com/atlas-connection-string-private-endpoint"
+
+	primaryKey string = "connectionString"
+)
+
+// UserConnectionStringPolicy returns the connection string policy of the database user, defaulting to the one of its
+// namespace. It's nil when neither sets one
+func UserConnectionStringPolicy(ctx context.Context, reader client.Reader, user *mdbv1.AtlasDatabaseUser) (*mdbv1.ConnectionStringPolicy, error) {
+	if user.Spec.ConnectionStringPolicy != nil {
+		return user.Spec.ConnectionStringPolicy, nil
+	}
+
+	return NamespaceConnectionStringPolicy(ctx, reader, user.Namespace)
+}
+
+// NamespaceConnectionStringPolicy returns the connection string policy set by the annotations of the namespace, nil
+// when it sets none. The Namespaces aren't cached, a namespace which can't be read, e.g. when the Operator isn't
+// granted access to it, has no policy
+func NamespaceConnectionStringPolicy(ctx context.Context, reader client.Reader, name string) (*mdbv1.ConnectionStringPolicy, error) {
+	namespace := &corev1.Namespace{}
+	if err := reader.Get(ctx, client.ObjectKey{Name: name}, namespace); err != nil {
+		if apiErrors.IsNotFound(err) || apiErrors.IsForbidden(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	policyType, ok := namespace.GetAnnotations()[ConnectionStringPolicyAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	return &mdbv1.ConnectionStringPolicy{
+		Type:              policyType,
+		PrivateEndpointID: namespace.GetAnnotations()[ConnectionStringPrivateEndpointAnnotation],
+	}, nil
+}
+
+// fillPrimaryKey writes the connection string selected by the policy under the connectionString key. The
+// PrivateEndpoint policy, or any unknown one, removes the public connection strings from the Secret, so that the
+// workloads never receive them
+func fillPrimaryKey(secret *corev1.Secret, data ConnectionData) {
+	policy := data.Policy
+	if policy == nil {
+		return
+	}
+
+	if policy.Type == mdbv1.ConnectionStringPolicyPublic {
+		secret.Data[primaryKey] = secret.Data[standardKeySrv]
+		if len(secret.Data[primaryKey]) == 0 {
+			secret.Data[primaryKey] = secret.Data[standardKey]
+		}
+		return
+	}
+
+	for key := range secret.Data {
+		if strings.HasPrefix(key, standardKey) || strings.HasPrefix(key, federatedKey) || strings.HasPrefix(key, archiveKey) {
+			delete(secret.Data, key)
+		}
+	}
+
+	secret.Data[primaryKey] = []byte("")
+	if policy.Type != mdbv1.ConnectionStringPolicyPrivateEndpoint {
+		return
+	}
+	for _, privateConn := range data.PrivateConnURLs {
+		if len(privateConn.EndpointIDs) == 0 {
+			continue
+		}
+		if policy.PrivateEndpointID != "" && !slices.Contains(privateConn.EndpointIDs, policy.PrivateEndpointID) {
+			continue
+		}
+
+		secret.Data[primaryKey] = []byte(privateConn.PvtSrvConnURL)
+		if privateConn.PvtSrvConnURL == "" {
+			secret.Data[primaryKey] = []byte(privateConn.PvtConnURL)
+		}
+		return
+	}
+}
@@ -0,0 +1,195 @@
+package connectionsecret
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/secretstore"
+)
+
+// Store is the path of the external secret store the connection credentials are written under instead of Secrets
+type Store struct {
+	Provider string
+	Writer   secretstore.Writer
+	// Path is confined under the namespace of the user, see secretstore.NamespacedPath
+	Path string
+
+	// written are the paths of the secrets written during the reconciliation
+	written []string
+}
+
+// UserStore returns the external secret store the connection credentials of the database user are written to, nil
+// when they're kept in Secrets
+func UserStore(stores secretstore.Registry, user *mdbv1.AtlasDatabaseUser) (*Store, error) {
+	ref := user.Spec.ConnectionSecretStore
+	if ref == nil {
+		return nil, nil
+	}
+
+	writer, err := stores.Writer(ref.Provider)
+	if err != nil {
+		return nil, err
+	}
+	path, err := secretstore.NamespacedPath(user.Namespace, ref.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{Provider: ref.Provider, Writer: writer, Path: path}, nil
+}
+
+// RemoveFromStore deletes the connection credentials the Operator wrote to the external secret store of the database
+// user, as recorded in its status. Nothing else under the path of the store is deleted
+func RemoveFromStore(ctx context.Context, stores secretstore.Registry, user *mdbv1.AtlasDatabaseUser, log *zap.SugaredLogger) error {
+	return removeFromStore(ctx, stores, user.Namespace, user.Status.ConnectionSecretStore, nil, log)
+}
+
+func (s *Store) secretPath(name string) string {
+	return s.Path + "/" + name
+}
+
+// ensureInStore writes the connection credentials of the Secret to the store, unless it already holds them. The
+// connection Secret previously holding them, if any, is deleted so that they aren't kept in etcd anymore
+func ensureInStore(ctx context.Context, k8sClient client.Client, store *Store, secret *corev1.Secret) error {
+	values := make(map[string]string, len(secret.Data))
+	for key, value := range secret.Data {
+		values[key] = string(value)
+	}
+
+	path := store.secretPath(secret.Name)
+	current, err := store.Writer.ReadAll(ctx, path)
+	if err != nil {
+		return err
+	}
+	if !maps.Equal(current, values) {
+		if err = store.Writer.Write(ctx, path, values); err != nil {
+			return err
+		}
+	}
+	if !slices.Contains(store.written, path) {
+		store.written = append(store.written, path)
+	}
+
+	previous := &corev1.Secret{}
+	if err = k8sClient.Get(ctx, kube.ObjectKeyFromObject(secret), previous); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	// a Secret of the same name not created by the Operator is left alone
+	if previous.Labels[TypeLabelKey] != CredLabelVal || previous.Labels[ProjectLabelKey] == "" {
+		return nil
+	}
+
+	return client.IgnoreNotFound(k8sClient.Delete(ctx, previous))
+}
+
+// syncStoreEntries deletes the connection credentials previously written to the external secret store which aren't
+// wanted anymore, e.g. of the deleted deployments, the previous username or the previous path, and returns the entries
+// to record in the status of the user. The entries still wanted are kept even if they weren't written during this
+// reconciliation, e.g. when the deployment isn't ready
+func syncStoreEntries(ctx context.Context, stores secretstore.Registry, user *mdbv1.AtlasDatabaseUser, store *Store, wanted []string, log *zap.SugaredLogger) (*status.ConnectionSecretStoreStatus, error) {
+	previous := user.Status.ConnectionSecretStore
+
+	var current *status.ConnectionSecretStoreStatus
+	if store != nil {
+		current = &status.ConnectionSecretStoreStatus{Provider: store.Provider, Paths: slices.Clone(store.written)}
+		if previous != nil && previous.Provider == store.Provider {
+			for _, path := range previous.Paths {
+				if slices.Contains(wanted, path) && !slices.Contains(current.Paths, path) {
+					current.Paths = append(current.Paths, path)
+				}
+			}
+		}
+		slices.Sort(current.Paths)
+	}
+
+	if err := removeFromStore(ctx, stores, user.Namespace, previous, current, log); err != nil {
+		return trackedStoreEntries(previous, store), err
+	}
+
+	return current, nil
+}
+
+// trackedStoreEntries returns the entries previously recorded along with the ones written during the reconciliation,
+// so that nothing written is forgotten when the reconciliation fails
+func trackedStoreEntries(previous *status.ConnectionSecretStoreStatus, store *Store) *status.ConnectionSecretStoreStatus {
+	if store == nil || len(store.written) == 0 {
+		return previous
+	}
+	if previous == nil {
+		return &status.ConnectionSecretStoreStatus{Provider: store.Provider, Paths: slices.Clone(store.written)}
+	}
+	if previous.Provider != store.Provider {
+		// the entries of the previous provider are kept until they're deleted, the ones just written are rewritten
+		// and recorded by the next reconciliation
+		return previous
+	}
+
+	tracked := previous.DeepCopy()
+	for _, path := range store.written {
+		if !slices.Contains(tracked.Paths, path) {
+			tracked.Paths = append(tracked.Paths, path)
+		}
+	}
+	slices.Sort(tracked.Paths)
+
+	return tracked
+}
+
+// removeFromStore deletes the entries of previous which aren't in current. The status can be edited by hand, so an
+// entry outside the namespace of the user is never deleted
+func removeFromStore(ctx context.Context, stores secretstore.Registry, namespace string, previous, current *status.ConnectionSecretStoreStatus, log *zap.SugaredLogger) error {
+	if previous == nil || len(previous.Paths) == 0 {
+		return nil
+	}
+
+	var stale []string
+	for _, path := range previous.Paths {
+		if current == nil || current.Provider != previous.Provider || !slices.Contains(current.Paths, path) {
+			stale = append(stale, path)
+		}
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+
+	writer, err := stores.Writer(previous.Provider)
+	if err != nil {
+		return err
+	}
+	for _, path := range stale {
+		if !inNamespace(namespace, path) {
+			log.Warnw("Not removing the connection credentials outside of the namespace of the user from the secret store", "path", path, "namespace", namespace)
+			continue
+		}
+		if err = writer.Delete(ctx, path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// inNamespace returns whether the path of the store is confined under the namespace, as built by
+// secretstore.NamespacedPath
+func inNamespace(namespace, path string) bool {
+	relative, ok := strings.CutPrefix(path, namespace+"/")
+	if !ok || relative == "" {
+		return false
+	}
+	for _, segment := range strings.Split(relative, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return false
+		}
+	}
+
+	return true
+}
@@ -1,12 +1,21 @@
 package controller
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// UncachedObjects are read directly from the API server instead of being cached. The operator reads only a few of
+// them while clusters may hold tens of thousands, so the controllers watch them with metadata-only informers.
+// Namespaces are cluster-scoped: caching them would start a cluster-wide informer that never syncs when the Operator is
+// restricted to namespaced roles, reading them directly fails fast with Forbidden instead
+var UncachedObjects = []client.Object{&corev1.Secret{}, &corev1.ConfigMap{}, &corev1.Namespace{}}
+
 // MultiNamespacedCacheBuilder returns a manager cache builder for a list of namespaces
 func MultiNamespacedCacheBuilder(namespaces []string) cache.NewCacheFunc {
 	return func(config *rest.Config, opts cache.Options) (cache.Cache, error) {
@@ -25,3 +34,22 @@ func CustomLabelSelectorCacheBuilder(obj client.Object, labelsSelector labels.Se
 		return cache.New(config, opts)
 	}
 }
+
+// TransformingCacheBuilder returns a manager cache builder applying the transform to all the objects before they are
+// stored in the cache
+func TransformingCacheBuilder(newCache cache.NewCacheFunc, transform toolscache.TransformFunc) cache.NewCacheFunc {
+	return func(config *rest.Config, opts cache.Options) (cache.Cache, error) {
+		opts.DefaultTransform = transform
+		return newCache(config, opts)
+	}
+}
+
+// StripManagedFields is a cache transform removing the managed fields, which the operator never reads and which
+// account for a large share of the size of the objects. Updating an object without managed fields keeps them unchanged
+func StripManagedFields(in interface{}) (interface{}, error) {
+	if obj, err := meta.Accessor(in); err == nil && obj.GetManagedFields() != nil {
+		obj.SetManagedFields(nil)
+	}
+
+	return in, nil
+}
@@ -0,0 +1,34 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestStripManagedFields(t *testing.T) {
+	t.Run("should remove the managed fields", func(t *testing.T) {
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name:          "secret",
+			Labels:        map[string]string{"atlas.mongodb.com/type": "credentials"},
+			ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kubectl"}},
+		}}
+
+		out, err := StripManagedFields(secret)
+
+		require.NoError(t, err)
+		stripped := out.(*corev1.Secret)
+		assert.Nil(t, stripped.ManagedFields)
+		assert.Equal(t, "credentials", stripped.Labels["atlas.mongodb.com/type"])
+	})
+
+	t.Run("should leave the objects without metadata unchanged", func(t *testing.T) {
+		out, err := StripManagedFields("tombstone")
+
+		require.NoError(t, err)
+		assert.Equal(t, "tombstone", out)
+	})
+}
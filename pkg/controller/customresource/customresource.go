@@ -3,9 +3,12 @@ package customresource
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -13,7 +16,9 @@ import (
 
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/metrics"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/statushandler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/watch"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/version"
 )
@@ -23,10 +28,15 @@ const (
 	ReconciliationPolicyAnnotation = "mongodb.com/atlas-reconciliation-policy"
 	ResourceVersion                = "mongodb.com/atlas-resource-version"
 	ResourceVersionOverride        = "mongodb.com/atlas-resource-version-policy"
+	ReconciliationPausedAnnotation = "mongodb.com/atlas-reconciliation-paused"
+	RotateAPIKeyAnnotation         = "mongodb.com/atlas-rotate-api-key"
 	ResourcePolicyKeep             = "keep"
 	ResourcePolicyDelete           = "delete"
 	ReconciliationPolicySkip       = "skip"
 	ResourceVersionAllow           = "allow"
+
+	// PausedRetry is how often a paused resource checks whether its namespace was resumed
+	PausedRetry = time.Minute
 )
 
 // PrepareResource queries the Custom Resource 'request.NamespacedName' and populates the 'resource' pointer.
@@ -76,13 +86,76 @@ func ValidateResourceVersion(ctx *workflow.Context, resource mdbv1.AtlasCustomRe
 // Internally this will also update the 'observedGeneration' field that notify clients that the resource is being worked on
 func MarkReconciliationStarted(client client.Client, resource mdbv1.AtlasCustomResource, log *zap.SugaredLogger, context context.Context) *workflow.Context {
 	updatedConditions := status.EnsureConditionExists(status.FalseCondition(status.ReadyType), resource.GetStatus().GetConditions())
+	updatedConditions = status.RemoveConditionIfExists(status.PausedByOperatorType, updatedConditions)
 
+	// the Atlas API requests of the reconciliation are counted for the resource
+	context = metrics.WithResource(context, statushandler.ResourceKind(resource), resource.GetNamespace(), resource.GetName())
 	ctx := workflow.NewContext(log, updatedConditions, context)
-	statushandler.Update(ctx, client, nil, resource)
+	ctx.Reapply = consumeReapplyAnnotation(context, client, resource, log)
+	statushandler.Update(ctx, client, nil, nil, resource)
 
 	return ctx
 }
 
+// consumeReapplyAnnotation returns true if the resource has the reapply annotation and removes it, so that the full
+// resynchronization happens once. If the annotation can't be removed it is consumed by a later reconciliation
+func consumeReapplyAnnotation(context context.Context, k8sClient client.Client, resource mdbv1.AtlasCustomResource, log *zap.SugaredLogger) bool {
+	if _, ok := resource.GetAnnotations()[watch.ReapplyAnnotation]; !ok {
+		return false
+	}
+
+	log.Infow("full resynchronization requested", "annotation", watch.ReapplyAnnotation)
+
+	patch := client.MergeFrom(resource.DeepCopyObject().(client.Object))
+	annotations := resource.GetAnnotations()
+	delete(annotations, watch.ReapplyAnnotation)
+	resource.SetAnnotations(annotations)
+	if err := k8sClient.Patch(context, resource, patch); err != nil {
+		log.Errorf("failed to remove the %s annotation: %s", watch.ReapplyAnnotation, err)
+	}
+
+	return true
+}
+
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get
+
+// NamespaceReconciliationPaused returns 'true' if the reconciliation of all the Atlas resources of the namespace is paused.
+// The namespace may not be readable (e.g. the Operator is restricted to namespaced roles), it is then considered not paused.
+// The reader must not be backed by the cache (see controller.UncachedObjects), a cached read of the cluster-scoped
+// namespace blocks until a cluster-wide informer syncs instead of failing with Forbidden.
+func NamespaceReconciliationPaused(ctx context.Context, reader client.Reader, namespace string, log *zap.SugaredLogger) bool {
+	ns := &corev1.Namespace{}
+	if err := reader.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		if apiErrors.IsForbidden(err) || apiErrors.IsNotFound(err) {
+			log.Debugf("unable to read the namespace %s, considering its reconciliation not paused: %s", namespace, err)
+			return false
+		}
+		log.Warnf("failed to read the namespace %s, considering its reconciliation not paused: %s", namespace, err)
+		return false
+	}
+
+	return ns.GetAnnotations()[ReconciliationPausedAnnotation] == "true"
+}
+
+// MarkReconciliationPaused sets the PausedByOperator condition on the Atlas Resource, leaving the rest of its status untouched.
+// The resource is requeued to resume its reconciliation once the namespace is not paused anymore.
+func MarkReconciliationPaused(context context.Context, client client.Client, eventRecorder record.EventRecorder, resource mdbv1.AtlasCustomResource, log *zap.SugaredLogger) workflow.Result {
+	result := workflow.OK().WithRetry(PausedRetry)
+	for _, condition := range resource.GetStatus().GetConditions() {
+		if condition.Type == status.PausedByOperatorType {
+			return result
+		}
+	}
+
+	ctx := workflow.NewContext(log, resource.GetStatus().GetConditions(), context)
+	ctx.EnsureCondition(status.TrueCondition(status.PausedByOperatorType).
+		WithReason(string(workflow.NamespaceReconciliationPaused)).
+		WithMessageRegexp(fmt.Sprintf("reconciliation is paused by the annotation %s=true on the namespace %s", ReconciliationPausedAnnotation, resource.GetNamespace())))
+	statushandler.Update(ctx, client, eventRecorder, nil, resource)
+
+	return result
+}
+
 func IsResourcePolicyKeepOrDefault(resource mdbv1.AtlasCustomResource, protectionFlag bool) bool {
 	if policy, ok := resource.GetAnnotations()[ResourcePolicyAnnotation]; ok {
 		return policy == ResourcePolicyKeep
@@ -1,14 +1,27 @@
 package customresource
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/watch"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/version"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	v1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
 )
@@ -227,3 +240,88 @@ func TestResourceVersionIsValid(t *testing.T) {
 		})
 	}
 }
+
+func TestNamespaceReconciliationPaused(t *testing.T) {
+	sch := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(sch))
+	k8sClient := fake.NewClientBuilder().WithScheme(sch).WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "paused", Annotations: map[string]string{ReconciliationPausedAnnotation: "true"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "resumed", Annotations: map[string]string{ReconciliationPausedAnnotation: "false"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+	).Build()
+	log := zaptest.NewLogger(t).Sugar()
+
+	assert.True(t, NamespaceReconciliationPaused(context.Background(), k8sClient, "paused", log))
+	assert.False(t, NamespaceReconciliationPaused(context.Background(), k8sClient, "resumed", log))
+	assert.False(t, NamespaceReconciliationPaused(context.Background(), k8sClient, "default", log))
+	assert.False(t, NamespaceReconciliationPaused(context.Background(), k8sClient, "missing", log))
+
+	forbiddenClient := fake.NewClientBuilder().WithScheme(sch).WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(ctx context.Context, client client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			return apiErrors.NewForbidden(corev1.Resource("namespaces"), key.Name, fmt.Errorf("namespaced role"))
+		},
+	}).Build()
+	assert.False(t, NamespaceReconciliationPaused(context.Background(), forbiddenClient, "paused", log))
+}
+
+func TestMarkReconciliationPaused(t *testing.T) {
+	user := &v1.AtlasDatabaseUser{
+		ObjectMeta: metav1.ObjectMeta{Name: "user", Namespace: "paused"},
+		Status: status.AtlasDatabaseUserStatus{
+			Common: status.Common{Conditions: []status.Condition{status.TrueCondition(status.ReadyType)}},
+		},
+	}
+	sch := runtime.NewScheme()
+	require.NoError(t, v1.AddToScheme(sch))
+	k8sClient := fake.NewClientBuilder().WithScheme(sch).WithObjects(user).WithStatusSubresource(user).Build()
+	recorder := record.NewFakeRecorder(10)
+	log := zaptest.NewLogger(t).Sugar()
+
+	result := MarkReconciliationPaused(context.Background(), k8sClient, recorder, user, log)
+	assert.Equal(t, reconcile.Result{RequeueAfter: PausedRetry}, result.ReconcileResult())
+
+	updated := &v1.AtlasDatabaseUser{}
+	require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(user), updated))
+	require.Len(t, updated.Status.Conditions, 2)
+	assert.Equal(t, status.ReadyType, updated.Status.Conditions[0].Type)
+	assert.Equal(t, status.PausedByOperatorType, updated.Status.Conditions[1].Type)
+	assert.Equal(t, corev1.ConditionTrue, updated.Status.Conditions[1].Status)
+	assert.Equal(t, string(workflow.NamespaceReconciliationPaused), updated.Status.Conditions[1].Reason)
+	assert.Len(t, recorder.Events, 1)
+
+	MarkReconciliationPaused(context.Background(), k8sClient, recorder, updated, log)
+	assert.Len(t, recorder.Events, 1, "the pause is only reported once")
+}
+
+func TestMarkReconciliationStartedReapply(t *testing.T) {
+	newClient := func(user *v1.AtlasDatabaseUser) client.Client {
+		sch := runtime.NewScheme()
+		require.NoError(t, v1.AddToScheme(sch))
+		return fake.NewClientBuilder().WithScheme(sch).WithObjects(user).WithStatusSubresource(user).Build()
+	}
+
+	t.Run("should request a full resynchronization and remove the annotation", func(t *testing.T) {
+		user := &v1.AtlasDatabaseUser{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "user",
+				Namespace:   "ns",
+				Annotations: map[string]string{watch.ReapplyAnnotation: "true", "foo": "bar"},
+			},
+		}
+		k8sClient := newClient(user)
+
+		workflowCtx := MarkReconciliationStarted(k8sClient, user, zaptest.NewLogger(t).Sugar(), context.Background())
+		assert.True(t, workflowCtx.Reapply)
+
+		updated := &v1.AtlasDatabaseUser{}
+		require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(user), updated))
+		assert.Equal(t, map[string]string{"foo": "bar"}, updated.Annotations)
+	})
+
+	t.Run("should not request a resynchronization without the annotation", func(t *testing.T) {
+		user := &v1.AtlasDatabaseUser{ObjectMeta: metav1.ObjectMeta{Name: "user", Namespace: "ns"}}
+
+		workflowCtx := MarkReconciliationStarted(newClient(user), user, zaptest.NewLogger(t).Sugar(), context.Background())
+		assert.False(t, workflowCtx.Reapply)
+	})
+}
@@ -0,0 +1,56 @@
+package customresource
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// ValidateAtlasPermissions verifies the Atlas API key of the workflow context has the roles required by the operations
+// of the resource. The InsufficientAtlasPermissions condition lists the missing roles so that they can be granted
+// instead of reporting the forbidden errors returned by Atlas halfway through the reconciliation.
+// The validation is skipped when the roles of the API key can't be read
+func ValidateAtlasPermissions(ctx *workflow.Context, required ...atlas.RequiredRole) workflow.Result {
+	missing := ReportAtlasPermissions(ctx, required...)
+	if len(missing) == 0 {
+		return workflow.OK()
+	}
+
+	return workflow.Terminate(workflow.InsufficientAtlasPermissions, MissingRolesMessage(missing...))
+}
+
+// ReportAtlasPermissions is ValidateAtlasPermissions leaving the decision to the caller: the InsufficientAtlasPermissions
+// condition is set the same way and the missing roles are returned, so that only the operations requiring them are
+// stopped. No role is missing when the roles of the API key can't be read
+func ReportAtlasPermissions(ctx *workflow.Context, required ...atlas.RequiredRole) []atlas.RequiredRole {
+	missing, err := atlas.MissingRoles(ctx.Context, ctx.SdkClient.RootApi, ctx.OrgID, required)
+	if err != nil {
+		ctx.Log.Debugf("unable to verify the roles of the Atlas API key: %s", err)
+		return nil
+	}
+
+	if len(missing) == 0 {
+		ctx.UnsetCondition(status.InsufficientAtlasPermissionsType)
+		return nil
+	}
+
+	condition := status.TrueCondition(status.InsufficientAtlasPermissionsType).
+		WithReason(string(workflow.InsufficientAtlasPermissions))
+	condition.Message = MissingRolesMessage(missing...)
+	ctx.EnsureCondition(condition)
+
+	return missing
+}
+
+// MissingRolesMessage describes the roles the Atlas API key is missing
+func MissingRolesMessage(missing ...atlas.RequiredRole) string {
+	roles := make([]string, 0, len(missing))
+	for _, requirement := range missing {
+		roles = append(roles, requirement.String())
+	}
+
+	return fmt.Sprintf("the Atlas API key is missing the roles: %s", strings.Join(roles, "; "))
+}
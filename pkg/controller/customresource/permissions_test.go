@@ -0,0 +1,85 @@
+package customresource_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	"go.uber.org/zap/zaptest"
+
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func TestValidateAtlasPermissions(t *testing.T) {
+	peering := atlas.RequiredRole{Operation: "network peering", ProjectID: "project-id", Roles: []string{atlas.ProjectOwnerRole}}
+	permissionsContext := func(t *testing.T, roles []admin.CloudAccessRoleAssignment, err error) *workflow.Context {
+		rootAPI := atlasmock.NewRootApiMock(t)
+		rootAPI.EXPECT().GetSystemStatus(context.Background()).
+			Return(admin.GetSystemStatusApiRequest{ApiService: rootAPI})
+		if err != nil {
+			rootAPI.EXPECT().GetSystemStatusExecute(mock.Anything).Return(nil, nil, err)
+		} else {
+			rootAPI.EXPECT().GetSystemStatusExecute(mock.Anything).
+				Return(&admin.SystemStatus{ApiKey: admin.ApiKey{Roles: &roles}}, nil, nil)
+		}
+
+		workflowCtx := workflow.NewContext(
+			zaptest.NewLogger(t).Sugar(),
+			[]status.Condition{status.TrueCondition(status.InsufficientAtlasPermissionsType)},
+			context.Background(),
+		)
+		workflowCtx.SdkClient = &admin.APIClient{RootApi: rootAPI}
+		workflowCtx.OrgID = "org-id"
+
+		return workflowCtx
+	}
+
+	t.Run("should list the missing roles in the condition", func(t *testing.T) {
+		workflowCtx := permissionsContext(t, []admin.CloudAccessRoleAssignment{{OrgId: pointer.MakePtr("org-id"), RoleName: pointer.MakePtr("ORG_MEMBER")}}, nil)
+
+		result := customresource.ValidateAtlasPermissions(workflowCtx, peering)
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.InsufficientAtlasPermissions, result.GetReason())
+		condition := workflowCtx.Conditions()[0]
+		assert.Equal(t, status.InsufficientAtlasPermissionsType, condition.Type)
+		assert.Equal(t, "the Atlas API key is missing the roles: GROUP_OWNER on project project-id for network peering", condition.Message)
+	})
+
+	t.Run("should remove the condition once the roles are granted", func(t *testing.T) {
+		workflowCtx := permissionsContext(t, []admin.CloudAccessRoleAssignment{{GroupId: pointer.MakePtr("project-id"), RoleName: pointer.MakePtr(atlas.ProjectOwnerRole)}}, nil)
+
+		result := customresource.ValidateAtlasPermissions(workflowCtx, peering)
+
+		assert.True(t, result.IsOk())
+		assert.Empty(t, workflowCtx.Conditions())
+	})
+
+	t.Run("should report the missing roles without failing", func(t *testing.T) {
+		workflowCtx := permissionsContext(t, []admin.CloudAccessRoleAssignment{{OrgId: pointer.MakePtr("org-id"), RoleName: pointer.MakePtr("ORG_MEMBER")}}, nil)
+
+		missing := customresource.ReportAtlasPermissions(workflowCtx, peering)
+
+		assert.Equal(t, []atlas.RequiredRole{peering}, missing)
+		require.Len(t, workflowCtx.Conditions(), 1)
+		assert.Equal(t, "the Atlas API key is missing the roles: GROUP_OWNER on project project-id for network peering", workflowCtx.Conditions()[0].Message)
+	})
+
+	t.Run("should skip the validation when the roles can't be read", func(t *testing.T) {
+		workflowCtx := permissionsContext(t, nil, errors.New("unavailable"))
+
+		result := customresource.ValidateAtlasPermissions(workflowCtx, peering)
+
+		assert.True(t, result.IsOk())
+		require.Len(t, workflowCtx.Conditions(), 1)
+	})
+}
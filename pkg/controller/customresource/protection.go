@@ -17,6 +17,10 @@ const (
 type OperatorChecker func(resource mdbv1.AtlasCustomResource) (bool, error)
 type AtlasChecker func(resource mdbv1.AtlasCustomResource) (bool, error)
 
+// MarkerChecker reports whether the resource in Atlas carries the ownership marker of the operator, and whether the
+// marker names the custom resource
+type MarkerChecker func(resource mdbv1.AtlasCustomResource) (marked bool, owned bool, err error)
+
 func IsOwner(resource mdbv1.AtlasCustomResource, protectionFlag bool, operatorChecker OperatorChecker, atlasChecker AtlasChecker) (bool, error) {
 	if !protectionFlag {
 		return true, nil
@@ -39,6 +43,26 @@ func IsOwner(resource mdbv1.AtlasCustomResource, protectionFlag bool, operatorCh
 	return !existInAtlas, nil
 }
 
+// IsOwnerByMarker is IsOwner relying first on the ownership marker stamped on the resource in Atlas. The marker is
+// a stronger signal than the last applied configuration: the resources marked for another custom resource, or by an
+// operator in another cluster, are never owned, and the ones marked for this custom resource always are
+func IsOwnerByMarker(resource mdbv1.AtlasCustomResource, protectionFlag bool, markerChecker MarkerChecker, operatorChecker OperatorChecker, atlasChecker AtlasChecker) (bool, error) {
+	if !protectionFlag {
+		return true, nil
+	}
+
+	marked, owned, err := markerChecker(resource)
+	if err != nil {
+		return false, err
+	}
+
+	if marked {
+		return owned, nil
+	}
+
+	return IsOwner(resource, protectionFlag, operatorChecker, atlasChecker)
+}
+
 func ApplyLastConfigApplied(ctx context.Context, resource mdbv1.AtlasCustomResource, k8sClient client.Client) error {
 	uObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(resource)
 	if err != nil {
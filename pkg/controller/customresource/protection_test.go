@@ -179,3 +179,37 @@ func TestIsResourceManagedByOperator(t *testing.T) {
 		})
 	}
 }
+
+func testMarkerChecker(marked, owned bool) customresource.MarkerChecker {
+	return func(resource mdbv1.AtlasCustomResource) (bool, bool, error) {
+		return marked, owned, nil
+	}
+}
+
+func TestIsOwnerByMarker(t *testing.T) {
+	tests := []struct {
+		title         string
+		markerChecker customresource.MarkerChecker
+		opChecker     customresource.OperatorChecker
+		atlasChecker  customresource.AtlasChecker
+		expectedOwned bool
+	}{
+		{"marked for the resource is owned", testMarkerChecker(true, true), testOpChecker(false), testAtlasChecker(true), true},
+		{"marked for another resource is NOT owned", testMarkerChecker(true, false), testOpChecker(true), nil, false},
+		{"unmarked and managed is owned", testMarkerChecker(false, false), testOpChecker(true), nil, true},
+		{"unmarked, unmanaged and in Atlas is NOT owned", testMarkerChecker(false, false), testOpChecker(false), testAtlasChecker(true), false},
+	}
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("Protected and %s", tc.title), func(t *testing.T) {
+			owned, err := customresource.IsOwnerByMarker(sampleResource(), true, tc.markerChecker, tc.opChecker, tc.atlasChecker)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedOwned, owned)
+		})
+	}
+
+	t.Run("Without protection is owned", func(t *testing.T) {
+		owned, err := customresource.IsOwnerByMarker(sampleResource(), false, nil, nil, nil)
+		assert.NoError(t, err)
+		assert.True(t, owned)
+	})
+}
@@ -0,0 +1,159 @@
+package deletion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	"go.mongodb.org/atlas/mongodbatlas"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+const (
+	// busyRetry is the delay before retrying a deletion which found all the slots taken
+	busyRetry = 10 * time.Second
+	// dependentsRetry is the delay before retrying a deletion waiting for the resources to delete before it
+	dependentsRetry = 15 * time.Second
+	// rateLimitedPause is how long all the deletions are paused once Atlas rejected one for its rate limits
+	rateLimitedPause = time.Minute
+)
+
+// Scheduler throttles the deletions of the resources in Atlas, so that removing a namespace holding hundreds of
+// resources doesn't flood Atlas with delete calls. It bounds the number of concurrent deletions, pauses them all when
+// Atlas applies its rate limits, and deletes the database users, then the deployments and finally the project they
+// belong to. A nil Scheduler lets all the deletions through
+type Scheduler struct {
+	slots chan struct{}
+
+	lock        sync.Mutex
+	pausedUntil time.Time
+	now         func() time.Time
+}
+
+// NewScheduler returns a scheduler running at most concurrency deletions at a time
+func NewScheduler(concurrency int) *Scheduler {
+	return &Scheduler{
+		slots: make(chan struct{}, concurrency),
+		now:   time.Now,
+	}
+}
+
+// Acquire reserves a slot for the deletion of the resource in Atlas. The result isn't OK and holds the retry delay when
+// the deletion must wait, otherwise the release function must be called with the outcome of the deletion
+func (s *Scheduler) Acquire(ctx context.Context, kubeClient client.Client, resource client.Object) (func(error), workflow.Result) {
+	if s == nil {
+		return func(error) {}, workflow.OK()
+	}
+
+	if remaining := s.pauseRemaining(); remaining > 0 {
+		return nil, workflow.InProgress(workflow.DeletionThrottled, "the deletions are paused by the Atlas rate limits").
+			WithRetry(remaining)
+	}
+
+	pending, err := pendingDependents(ctx, kubeClient, resource)
+	if err != nil {
+		return nil, workflow.Terminate(workflow.Internal, fmt.Sprintf("failed to list the resources deleted before: %s", err))
+	}
+	if pending > 0 {
+		return nil, workflow.InProgress(
+			workflow.DeletionPendingDependents,
+			fmt.Sprintf("waiting for the deletion of %d resource(s) of the project to complete", pending),
+		).WithRetry(dependentsRetry)
+	}
+
+	select {
+	case s.slots <- struct{}{}:
+	default:
+		return nil, workflow.InProgress(workflow.DeletionThrottled, "waiting for a deletion slot").WithRetry(busyRetry)
+	}
+
+	return func(err error) {
+		if isRateLimited(err) {
+			s.pause()
+		}
+		<-s.slots
+	}, workflow.OK()
+}
+
+func (s *Scheduler) pauseRemaining() time.Duration {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.pausedUntil.Sub(s.now())
+}
+
+func (s *Scheduler) pause() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.pausedUntil = s.now().Add(rateLimitedPause)
+}
+
+// pendingDependents counts the resources of the project being deleted which must be removed from Atlas before the
+// resource: the database users come first, then the deployments and the project last
+func pendingDependents(ctx context.Context, kubeClient client.Client, resource client.Object) (int, error) {
+	var projectKey client.ObjectKey
+	var withDeployments bool
+	switch r := resource.(type) {
+	case *mdbv1.AtlasProject:
+		projectKey = client.ObjectKeyFromObject(r)
+		withDeployments = true
+	case *mdbv1.AtlasDeployment:
+		projectKey = r.AtlasProjectObjectKey()
+	default:
+		return 0, nil
+	}
+
+	pending := 0
+	users := &mdbv1.AtlasDatabaseUserList{}
+	if err := kubeClient.List(ctx, users); err != nil {
+		return 0, err
+	}
+	for i := range users.Items {
+		if users.Items[i].AtlasProjectObjectKey() == projectKey && beingDeleted(&users.Items[i]) {
+			pending++
+		}
+	}
+
+	if !withDeployments {
+		return pending, nil
+	}
+
+	deployments := &mdbv1.AtlasDeploymentList{}
+	if err := kubeClient.List(ctx, deployments); err != nil {
+		return 0, err
+	}
+	for i := range deployments.Items {
+		if deployments.Items[i].AtlasProjectObjectKey() == projectKey && beingDeleted(&deployments.Items[i]) {
+			pending++
+		}
+	}
+
+	return pending, nil
+}
+
+func beingDeleted(resource mdbv1.AtlasCustomResource) bool {
+	return !resource.GetDeletionTimestamp().IsZero() && customresource.HaveFinalizer(resource, customresource.FinalizerLabel)
+}
+
+// isRateLimited returns true if Atlas rejected the request because of its rate limits, the error coming from either the
+// legacy client or the Atlas SDK
+func isRateLimited(err error) bool {
+	var apiError *mongodbatlas.ErrorResponse
+	if errors.As(err, &apiError) {
+		return apiError.HTTPCode == http.StatusTooManyRequests ||
+			(apiError.Response != nil && apiError.Response.StatusCode == http.StatusTooManyRequests)
+	}
+
+	sdkError, ok := admin.AsError(err)
+
+	return ok && sdkError.GetError() == http.StatusTooManyRequests
+}
@@ -0,0 +1,150 @@
+package deletion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	"go.mongodb.org/atlas/mongodbatlas"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+func newClient(t *testing.T, objects ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mdbv1.AddToScheme(scheme))
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func deletedMeta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:              name,
+		Namespace:         "ns",
+		DeletionTimestamp: &metav1.Time{Time: time.Now()},
+		Finalizers:        []string{customresource.FinalizerLabel},
+	}
+}
+
+func newProject() *mdbv1.AtlasProject {
+	return &mdbv1.AtlasProject{ObjectMeta: metav1.ObjectMeta{Name: "project", Namespace: "ns"}}
+}
+
+func newDeployment() *mdbv1.AtlasDeployment {
+	return &mdbv1.AtlasDeployment{
+		ObjectMeta: deletedMeta("deployment"),
+		Spec:       mdbv1.AtlasDeploymentSpec{Project: common.ResourceRefNamespaced{Name: "project"}},
+	}
+}
+
+func newUser() *mdbv1.AtlasDatabaseUser {
+	return &mdbv1.AtlasDatabaseUser{
+		ObjectMeta: deletedMeta("user"),
+		Spec:       mdbv1.AtlasDatabaseUserSpec{Project: common.ResourceRefNamespaced{Name: "project"}},
+	}
+}
+
+func TestSchedulerAcquire(t *testing.T) {
+	t.Run("should let all the deletions through when disabled", func(t *testing.T) {
+		var scheduler *Scheduler
+
+		release, result := scheduler.Acquire(context.Background(), nil, newProject())
+
+		require.True(t, result.IsOk())
+		release(nil)
+	})
+
+	t.Run("should bound the concurrent deletions", func(t *testing.T) {
+		scheduler := NewScheduler(1)
+		kubeClient := newClient(t)
+
+		release, result := scheduler.Acquire(context.Background(), kubeClient, newUser())
+		require.True(t, result.IsOk())
+
+		_, result = scheduler.Acquire(context.Background(), kubeClient, newUser())
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.DeletionThrottled, result.GetReason())
+
+		release(nil)
+		_, result = scheduler.Acquire(context.Background(), kubeClient, newUser())
+		assert.True(t, result.IsOk())
+	})
+
+	t.Run("should pause the deletions when Atlas applies its rate limits", func(t *testing.T) {
+		now := time.Now()
+		scheduler := NewScheduler(2)
+		scheduler.now = func() time.Time { return now }
+		kubeClient := newClient(t)
+
+		release, result := scheduler.Acquire(context.Background(), kubeClient, newUser())
+		require.True(t, result.IsOk())
+		release(&mongodbatlas.ErrorResponse{HTTPCode: http.StatusTooManyRequests})
+
+		_, result = scheduler.Acquire(context.Background(), kubeClient, newUser())
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.DeletionThrottled, result.GetReason())
+		assert.Equal(t, rateLimitedPause, result.ReconcileResult().RequeueAfter)
+
+		now = now.Add(rateLimitedPause)
+		_, result = scheduler.Acquire(context.Background(), kubeClient, newUser())
+		assert.True(t, result.IsOk())
+	})
+
+	t.Run("should delete the database users before the deployments", func(t *testing.T) {
+		scheduler := NewScheduler(10)
+
+		_, result := scheduler.Acquire(context.Background(), newClient(t, newUser()), newDeployment())
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.DeletionPendingDependents, result.GetReason())
+	})
+
+	t.Run("should delete the deployments before the project", func(t *testing.T) {
+		scheduler := NewScheduler(10)
+
+		_, result := scheduler.Acquire(context.Background(), newClient(t, newDeployment()), newProject())
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, workflow.DeletionPendingDependents, result.GetReason())
+	})
+
+	t.Run("should ignore the resources of other projects and those not being deleted", func(t *testing.T) {
+		scheduler := NewScheduler(10)
+		otherProject := newDeployment()
+		otherProject.Spec.Project.Name = "other"
+		kept := newUser()
+		kept.DeletionTimestamp = nil
+
+		_, result := scheduler.Acquire(context.Background(), newClient(t, otherProject, kept), newProject())
+
+		assert.True(t, result.IsOk())
+	})
+}
+
+func TestIsRateLimited(t *testing.T) {
+	sdkError := func(code int) error {
+		err := &admin.GenericOpenAPIError{}
+		err.SetModel(admin.ApiError{Error: admin.PtrInt(code)})
+		return err
+	}
+
+	assert.True(t, isRateLimited(&mongodbatlas.ErrorResponse{HTTPCode: http.StatusTooManyRequests}))
+	assert.True(t, isRateLimited(fmt.Errorf("failed to delete: %w", sdkError(http.StatusTooManyRequests))))
+	assert.False(t, isRateLimited(sdkError(http.StatusConflict)))
+	assert.False(t, isRateLimited(&mongodbatlas.ErrorResponse{HTTPCode: http.StatusNotFound}))
+	assert.False(t, isRateLimited(errors.New("connection reset")))
+	assert.False(t, isRateLimited(nil))
+}
@@ -0,0 +1,171 @@
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespace = "atlas_operator"
+
+	controllerLabel = "controller"
+	namespaceLabel  = "namespace"
+	nameLabel       = "name"
+	resourceLabel   = "resource"
+	kindLabel       = "kind"
+)
+
+var (
+	// reconcilePanics counts the reconciliations that were interrupted by a panic and recovered by the operator
+	reconcilePanics = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reconcile_panics_total",
+			Help:      "Total number of reconciliations recovered from a panic per controller",
+		},
+		[]string{controllerLabel},
+	)
+
+	// deploymentUtilization reports the resource utilization of the deployments evaluated by the scaling advisor
+	deploymentUtilization = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "deployment_resource_utilization_ratio",
+			Help:      "Utilization ratio (0 to 1) of a deployment resource (cpu, disk or connections) as reported by Atlas",
+		},
+		[]string{namespaceLabel, nameLabel, resourceLabel},
+	)
+
+	// timeToReady measures how long the resources take to become ready after being created or changed
+	timeToReady = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "resource_time_to_ready_seconds",
+			Help:      "Time from the creation or the spec change of a resource to it becoming ready per kind",
+			Buckets:   []float64{5, 15, 30, 60, 120, 300, 600, 900, 1200, 1800, 2700, 3600, 7200},
+		},
+		[]string{kindLabel},
+	)
+
+	// degradedResources reports the resources that are no longer retried after failing too many times in a row
+	degradedResources = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "degraded_resources",
+			Help:      "Number of resources per controller marked as degraded after exhausting their reconciliation retries",
+		},
+		[]string{controllerLabel},
+	)
+
+	// atlasAPIRequests counts the Atlas API requests per resource they were made for, the requests made outside the
+	// reconciliation of a resource are counted with empty labels
+	atlasAPIRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "atlas_api_requests_total",
+			Help:      "Total number of Atlas API requests per resource initiating them",
+		},
+		[]string{kindLabel, namespaceLabel, nameLabel},
+	)
+
+	// pendingReadiness holds the resources whose time to ready is being measured. It's kept in memory, so the
+	// resources becoming ready across restarts of the operator aren't measured
+	pendingReadiness = map[types.UID]readinessStart{}
+	pendingLock      sync.Mutex
+)
+
+type resourceContextKey struct{}
+
+type resourceLabels struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+type readinessStart struct {
+	generation int64
+	since      time.Time
+}
+
+func init() {
+	// controller-runtime registry is the one exposed on the manager metrics endpoint
+	metrics.Registry.MustRegister(reconcilePanics, deploymentUtilization, timeToReady, degradedResources, atlasAPIRequests)
+}
+
+// IncReconcilePanics increments the recovered panics counter for the given controller
+func IncReconcilePanics(controller string) {
+	reconcilePanics.WithLabelValues(controller).Inc()
+}
+
+// IncDegradedResources increments the number of degraded resources of the given controller
+func IncDegradedResources(controller string) {
+	degradedResources.WithLabelValues(controller).Inc()
+}
+
+// DecDegradedResources decrements the number of degraded resources of the given controller
+func DecDegradedResources(controller string) {
+	degradedResources.WithLabelValues(controller).Dec()
+}
+
+// SetDeploymentUtilization records the utilization ratio of a resource of the given deployment
+func SetDeploymentUtilization(namespace, name, resource string, ratio float64) {
+	deploymentUtilization.WithLabelValues(namespace, name, resource).Set(ratio)
+}
+
+// DeleteDeploymentUtilization removes all the utilization series of the given deployment
+func DeleteDeploymentUtilization(namespace, name string) {
+	deploymentUtilization.DeletePartialMatch(prometheus.Labels{namespaceLabel: namespace, nameLabel: name})
+}
+
+// WithResource tags the context with the resource being reconciled, so that the Atlas API requests made with it are
+// counted for the resource
+func WithResource(ctx context.Context, kind, namespace, name string) context.Context {
+	return context.WithValue(ctx, resourceContextKey{}, resourceLabels{kind: kind, namespace: namespace, name: name})
+}
+
+// IncAtlasAPIRequests increments the Atlas API requests counter of the resource the context is tagged with
+func IncAtlasAPIRequests(ctx context.Context) {
+	resource, _ := ctx.Value(resourceContextKey{}).(resourceLabels)
+	atlasAPIRequests.WithLabelValues(resource.kind, resource.namespace, resource.name).Inc()
+}
+
+// DeleteAtlasAPIRequests removes the Atlas API requests series of the given resource
+func DeleteAtlasAPIRequests(kind, namespace, name string) {
+	atlasAPIRequests.DeletePartialMatch(prometheus.Labels{kindLabel: kind, namespaceLabel: namespace, nameLabel: name})
+}
+
+// StartTimeToReady starts measuring the time to ready of the given generation of a resource, replacing the measurement
+// of any previous generation
+func StartTimeToReady(uid types.UID, generation int64, since time.Time) {
+	pendingLock.Lock()
+	defer pendingLock.Unlock()
+
+	pendingReadiness[uid] = readinessStart{generation: generation, since: since}
+}
+
+// ObserveTimeToReady records the time to ready of the given generation of a resource, if it's being measured
+func ObserveTimeToReady(kind string, uid types.UID, generation int64, readyAt time.Time) {
+	pendingLock.Lock()
+	defer pendingLock.Unlock()
+
+	start, ok := pendingReadiness[uid]
+	if !ok || start.generation != generation {
+		return
+	}
+
+	delete(pendingReadiness, uid)
+	timeToReady.WithLabelValues(kind).Observe(readyAt.Sub(start.since).Seconds())
+}
+
+// ForgetTimeToReady stops measuring the time to ready of a resource, e.g. when it's deleted before becoming ready
+func ForgetTimeToReady(uid types.UID) {
+	pendingLock.Lock()
+	defer pendingLock.Unlock()
+
+	delete(pendingReadiness, uid)
+}
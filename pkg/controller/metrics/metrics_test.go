@@ -0,0 +1,94 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIncReconcilePanics(t *testing.T) {
+	before := testutil.ToFloat64(reconcilePanics.WithLabelValues("AtlasProject"))
+
+	IncReconcilePanics("AtlasProject")
+	IncReconcilePanics("AtlasProject")
+	IncReconcilePanics("AtlasDeployment")
+
+	assert.Equal(t, before+2, testutil.ToFloat64(reconcilePanics.WithLabelValues("AtlasProject")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(reconcilePanics.WithLabelValues("AtlasDeployment")))
+}
+
+func TestDeploymentUtilization(t *testing.T) {
+	SetDeploymentUtilization("ns", "cluster", "cpu", 0.5)
+	SetDeploymentUtilization("ns", "cluster", "disk", 0.75)
+	SetDeploymentUtilization("ns", "other", "cpu", 0.1)
+
+	assert.Equal(t, 0.5, testutil.ToFloat64(deploymentUtilization.WithLabelValues("ns", "cluster", "cpu")))
+	assert.Equal(t, 3, testutil.CollectAndCount(deploymentUtilization))
+
+	DeleteDeploymentUtilization("ns", "cluster")
+
+	assert.Equal(t, 1, testutil.CollectAndCount(deploymentUtilization))
+}
+
+func TestTimeToReady(t *testing.T) {
+	start := time.Date(2023, 11, 20, 10, 0, 0, 0, time.UTC)
+
+	StartTimeToReady("uid-1", 1, start)
+	ObserveTimeToReady("AtlasDatabaseUser", "uid-1", 2, start.Add(time.Minute))
+	assert.Equal(t, 0, testutil.CollectAndCount(timeToReady))
+
+	ObserveTimeToReady("AtlasDatabaseUser", "uid-1", 1, start.Add(100*time.Second))
+	ObserveTimeToReady("AtlasDatabaseUser", "uid-1", 1, start.Add(time.Hour))
+
+	StartTimeToReady("uid-2", 1, start)
+	ForgetTimeToReady("uid-2")
+	ObserveTimeToReady("AtlasDeployment", "uid-2", 1, start.Add(time.Minute))
+
+	expected := `
+# HELP atlas_operator_resource_time_to_ready_seconds Time from the creation or the spec change of a resource to it becoming ready per kind
+# TYPE atlas_operator_resource_time_to_ready_seconds histogram
+atlas_operator_resource_time_to_ready_seconds_bucket{kind="AtlasDatabaseUser",le="5"} 0
+atlas_operator_resource_time_to_ready_seconds_bucket{kind="AtlasDatabaseUser",le="15"} 0
+atlas_operator_resource_time_to_ready_seconds_bucket{kind="AtlasDatabaseUser",le="30"} 0
+atlas_operator_resource_time_to_ready_seconds_bucket{kind="AtlasDatabaseUser",le="60"} 0
+atlas_operator_resource_time_to_ready_seconds_bucket{kind="AtlasDatabaseUser",le="120"} 1
+atlas_operator_resource_time_to_ready_seconds_bucket{kind="AtlasDatabaseUser",le="300"} 1
+atlas_operator_resource_time_to_ready_seconds_bucket{kind="AtlasDatabaseUser",le="600"} 1
+atlas_operator_resource_time_to_ready_seconds_bucket{kind="AtlasDatabaseUser",le="900"} 1
+atlas_operator_resource_time_to_ready_seconds_bucket{kind="AtlasDatabaseUser",le="1200"} 1
+atlas_operator_resource_time_to_ready_seconds_bucket{kind="AtlasDatabaseUser",le="1800"} 1
+atlas_operator_resource_time_to_ready_seconds_bucket{kind="AtlasDatabaseUser",le="2700"} 1
+atlas_operator_resource_time_to_ready_seconds_bucket{kind="AtlasDatabaseUser",le="3600"} 1
+atlas_operator_resource_time_to_ready_seconds_bucket{kind="AtlasDatabaseUser",le="7200"} 1
+atlas_operator_resource_time_to_ready_seconds_bucket{kind="AtlasDatabaseUser",le="+Inf"} 1
+atlas_operator_resource_time_to_ready_seconds_sum{kind="AtlasDatabaseUser"} 100
+atlas_operator_resource_time_to_ready_seconds_count{kind="AtlasDatabaseUser"} 1
+`
+	assert.NoError(t, testutil.CollectAndCompare(timeToReady, strings.NewReader(expected)))
+}
+
+func TestAtlasAPIRequests(t *testing.T) {
+	ctx := WithResource(context.Background(), "AtlasProject", "ns", "project")
+
+	IncAtlasAPIRequests(ctx)
+	IncAtlasAPIRequests(ctx)
+	IncAtlasAPIRequests(context.Background())
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(atlasAPIRequests.WithLabelValues("AtlasProject", "ns", "project")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(atlasAPIRequests.WithLabelValues("", "", "")))
+}
+
+func TestDeleteAtlasAPIRequests(t *testing.T) {
+	IncAtlasAPIRequests(WithResource(context.Background(), "AtlasDeployment", "ns", "deleted"))
+	IncAtlasAPIRequests(WithResource(context.Background(), "AtlasDeployment", "ns", "kept"))
+	series := testutil.CollectAndCount(atlasAPIRequests)
+
+	DeleteAtlasAPIRequests("AtlasDeployment", "ns", "deleted")
+
+	assert.Equal(t, series-1, testutil.CollectAndCount(atlasAPIRequests))
+	assert.Equal(t, float64(1), testutil.ToFloat64(atlasAPIRequests.WithLabelValues("AtlasDeployment", "ns", "kept")))
+}
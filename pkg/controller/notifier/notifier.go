@@ -0,0 +1,289 @@
+// Package notifier sends a notification to a webhook whenever an Atlas Custom Resource transitions to a failure
+// condition, and once it recovers, for the teams which don't scrape the metrics of the Operator. The notifications are
+// either Alertmanager webhook payloads or plain events, both carrying a deduplication key per resource and reason.
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+// Formats of the notifications
+const (
+	FormatAlertmanager = "alertmanager"
+	FormatEvent        = "event"
+)
+
+// Status of the notifications
+const (
+	StatusFiring   = "firing"
+	StatusResolved = "resolved"
+)
+
+const (
+	alertName = "AtlasResourceFailed"
+	receiver  = "mongodb-atlas-operator"
+
+	// queueSize bounds the notifications waiting to be sent, the notifications are dropped once it's full so that the
+	// reconciliations never wait for the webhook
+	queueSize = 100
+
+	sendTimeout = time.Second * 10
+)
+
+// Resource identifies the Atlas Custom Resource a notification is about
+type Resource struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+func (r Resource) String() string {
+	return fmt.Sprintf("%s %s/%s", r.Kind, r.Namespace, r.Name)
+}
+
+// Notifier tracks the failures of the resources and sends a notification when a resource fails with a new reason, and
+// when it recovers or is deleted. The failures are tracked in memory, they are restored from the conditions of the
+// resources after a restart (see Restore)
+type Notifier struct {
+	url         string
+	format      string
+	environment string
+	httpClient  *http.Client
+	log         *zap.SugaredLogger
+
+	mu     sync.Mutex
+	failed map[Resource]status.Condition
+	queue  chan any
+}
+
+// New returns a Notifier sending the notifications to the URL in the given format. The environment, if any, is added
+// to the notifications to tell the Operators apart
+func New(webhookURL, format, environment string, log *zap.SugaredLogger) (*Notifier, error) {
+	if _, err := url.ParseRequestURI(webhookURL); err != nil {
+		return nil, fmt.Errorf("invalid webhook URL: %w", err)
+	}
+	if format != FormatAlertmanager && format != FormatEvent {
+		return nil, fmt.Errorf("unsupported format %q, must be one of %s, %s", format, FormatAlertmanager, FormatEvent)
+	}
+
+	return &Notifier{
+		url:         webhookURL,
+		format:      format,
+		environment: environment,
+		httpClient:  &http.Client{Transport: http.DefaultTransport, Timeout: sendTimeout},
+		log:         log,
+		failed:      map[Resource]status.Condition{},
+		queue:       make(chan any, queueSize),
+	}, nil
+}
+
+// Failed records the failure condition of the resource. A notification is sent when the resource wasn't failing or
+// failed with another reason, in which case the previous failure is resolved first
+func (n *Notifier) Failed(resource Resource, condition status.Condition, now time.Time) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	previous, failing := n.failed[resource]
+	if failing && previous.Type == condition.Type && previous.Reason == condition.Reason {
+		return
+	}
+	if failing {
+		n.enqueue(n.payload(StatusResolved, resource, previous, now))
+	}
+
+	n.failed[resource] = condition
+	n.enqueue(n.payload(StatusFiring, resource, condition, now))
+}
+
+// Restore records the failure the resource reported before the Operator started, without notifying it again. It does
+// nothing when the resource is already tracked
+func (n *Notifier) Restore(resource Resource, condition status.Condition) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if _, tracked := n.failed[resource]; tracked {
+		return
+	}
+	n.failed[resource] = condition
+}
+
+// Recovered resolves the failure of the resource, if any. It's also called once the resource is deleted
+func (n *Notifier) Recovered(resource Resource, now time.Time) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	previous, failing := n.failed[resource]
+	if !failing {
+		return
+	}
+
+	delete(n.failed, resource)
+	n.enqueue(n.payload(StatusResolved, resource, previous, now))
+}
+
+// Start sends the notifications until the context is done. It's run by the manager
+func (n *Notifier) Start(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case payload := <-n.queue:
+			if err := n.send(ctx, payload); err != nil {
+				n.log.Errorw("Failed to send the failure notification", "url", n.url, "error", err.Error())
+			}
+		}
+	}
+}
+
+func (n *Notifier) enqueue(payload any) {
+	select {
+	case n.queue <- payload:
+	default:
+		n.log.Warnw("Dropping the failure notification, too many notifications are waiting to be sent", "url", n.url)
+	}
+}
+
+func (n *Notifier) send(ctx context.Context, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := n.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("the webhook answered with the status %s", response.Status)
+	}
+
+	return nil
+}
+
+func (n *Notifier) payload(notificationStatus string, resource Resource, condition status.Condition, now time.Time) any {
+	key := DedupKey(resource, condition.Reason)
+	if n.format == FormatEvent {
+		return Event{
+			DedupKey:      key,
+			Status:        notificationStatus,
+			Kind:          resource.Kind,
+			Namespace:     resource.Namespace,
+			Name:          resource.Name,
+			ConditionType: string(condition.Type),
+			Reason:        condition.Reason,
+			Message:       condition.Message,
+			Environment:   n.environment,
+			Timestamp:     now.UTC().Format(time.RFC3339),
+		}
+	}
+
+	labels := map[string]string{
+		"alertname": alertName,
+		"kind":      resource.Kind,
+		"namespace": resource.Namespace,
+		"name":      resource.Name,
+		"condition": string(condition.Type),
+		"reason":    condition.Reason,
+	}
+	if n.environment != "" {
+		labels["environment"] = n.environment
+	}
+	annotations := map[string]string{
+		"summary":     fmt.Sprintf("%s failed: %s", resource, condition.Reason),
+		"description": condition.Message,
+	}
+
+	alert := Alert{
+		Status:      notificationStatus,
+		Labels:      labels,
+		Annotations: annotations,
+		StartsAt:    condition.LastTransitionTime.UTC().Format(time.RFC3339),
+		Fingerprint: fingerprint(key),
+	}
+	if condition.LastTransitionTime.IsZero() {
+		alert.StartsAt = now.UTC().Format(time.RFC3339)
+	}
+	if notificationStatus == StatusResolved {
+		alert.EndsAt = now.UTC().Format(time.RFC3339)
+	}
+
+	return AlertmanagerWebhook{
+		Version:           "4",
+		GroupKey:          key,
+		Status:            notificationStatus,
+		Receiver:          receiver,
+		GroupLabels:       map[string]string{"alertname": alertName},
+		CommonLabels:      labels,
+		CommonAnnotations: annotations,
+		Alerts:            []Alert{alert},
+	}
+}
+
+// DedupKey identifies the failure of a resource with a reason, the notifications of the same failure share it
+func DedupKey(resource Resource, reason string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", resource.Kind, resource.Namespace, resource.Name, reason)
+}
+
+func fingerprint(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(sum[:8])
+}
+
+// AlertmanagerWebhook is the payload sent by Alertmanager to its webhook receivers, version 4
+type AlertmanagerWebhook struct {
+	Version           string            `json:"version"`
+	GroupKey          string            `json:"groupKey"`
+	Status            string            `json:"status"`
+	Receiver          string            `json:"receiver"`
+	GroupLabels       map[string]string `json:"groupLabels"`
+	CommonLabels      map[string]string `json:"commonLabels"`
+	CommonAnnotations map[string]string `json:"commonAnnotations"`
+	ExternalURL       string            `json:"externalURL"`
+	Alerts            []Alert           `json:"alerts"`
+}
+
+type Alert struct {
+	Status       string            `json:"status"`
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     string            `json:"startsAt"`
+	EndsAt       string            `json:"endsAt,omitempty"`
+	GeneratorURL string            `json:"generatorURL"`
+	Fingerprint  string            `json:"fingerprint"`
+}
+
+// Event is the payload of the plain notifications
+type Event struct {
+	DedupKey      string `json:"dedupKey"`
+	Status        string `json:"status"`
+	Kind          string `json:"kind"`
+	Namespace     string `json:"namespace"`
+	Name          string `json:"name"`
+	ConditionType string `json:"conditionType"`
+	Reason        string `json:"reason"`
+	Message       string `json:"message,omitempty"`
+	Environment   string `json:"environment,omitempty"`
+	Timestamp     string `json:"timestamp"`
+}
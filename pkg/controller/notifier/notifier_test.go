@@ -0,0 +1,175 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+var (
+	deployment = Resource{Kind: "AtlasDeployment", Namespace: "team", Name: "orders"}
+	now        = time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+)
+
+func failure(reason string) status.Condition {
+	return status.Condition{
+		Type:    status.DeploymentReadyType,
+		Status:  corev1.ConditionFalse,
+		Reason:  reason,
+		Message: "the deployment failed",
+	}
+}
+
+// queued returns the notifications waiting to be sent
+func queued(n *Notifier) []any {
+	var payloads []any
+	for {
+		select {
+		case payload := <-n.queue:
+			payloads = append(payloads, payload)
+		default:
+			return payloads
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Run("should reject an invalid URL", func(t *testing.T) {
+		_, err := New("not a url", FormatAlertmanager, "", zaptest.NewLogger(t).Sugar())
+
+		assert.ErrorContains(t, err, "invalid webhook URL")
+	})
+
+	t.Run("should reject an unsupported format", func(t *testing.T) {
+		_, err := New("https://alertmanager.example.com/webhook", "slack", "", zaptest.NewLogger(t).Sugar())
+
+		assert.ErrorContains(t, err, "unsupported format")
+	})
+}
+
+func TestNotifier(t *testing.T) {
+	newNotifier := func(t *testing.T, format string) *Notifier {
+		n, err := New("https://alertmanager.example.com/webhook", format, "production", zaptest.NewLogger(t).Sugar())
+		require.NoError(t, err)
+
+		return n
+	}
+
+	t.Run("should notify a failure once per reason", func(t *testing.T) {
+		n := newNotifier(t, FormatAlertmanager)
+
+		n.Failed(deployment, failure("DeploymentNotCreatedInAtlas"), now)
+		n.Failed(deployment, failure("DeploymentNotCreatedInAtlas"), now.Add(time.Minute))
+
+		payloads := queued(n)
+		require.Len(t, payloads, 1)
+		webhook := payloads[0].(AlertmanagerWebhook)
+		assert.Equal(t, StatusFiring, webhook.Status)
+		assert.Equal(t, "AtlasDeployment/team/orders/DeploymentNotCreatedInAtlas", webhook.GroupKey)
+		require.Len(t, webhook.Alerts, 1)
+		assert.Equal(t, map[string]string{
+			"alertname":   alertName,
+			"kind":        "AtlasDeployment",
+			"namespace":   "team",
+			"name":        "orders",
+			"condition":   "DeploymentReady",
+			"reason":      "DeploymentNotCreatedInAtlas",
+			"environment": "production",
+		}, webhook.Alerts[0].Labels)
+		assert.Equal(t, "the deployment failed", webhook.Alerts[0].Annotations["description"])
+		assert.Equal(t, "2024-03-01T10:00:00Z", webhook.Alerts[0].StartsAt)
+		assert.Empty(t, webhook.Alerts[0].EndsAt)
+		assert.NotEmpty(t, webhook.Alerts[0].Fingerprint)
+	})
+
+	t.Run("should resolve the previous failure when the reason changes", func(t *testing.T) {
+		n := newNotifier(t, FormatEvent)
+
+		n.Failed(deployment, failure("DeploymentNotCreatedInAtlas"), now)
+		n.Failed(deployment, failure("DeploymentNotUpdatedInAtlas"), now.Add(time.Minute))
+
+		payloads := queued(n)
+		require.Len(t, payloads, 3)
+		assert.Equal(t, StatusFiring, payloads[0].(Event).Status)
+		resolved := payloads[1].(Event)
+		assert.Equal(t, StatusResolved, resolved.Status)
+		assert.Equal(t, "AtlasDeployment/team/orders/DeploymentNotCreatedInAtlas", resolved.DedupKey)
+		firing := payloads[2].(Event)
+		assert.Equal(t, StatusFiring, firing.Status)
+		assert.Equal(t, "AtlasDeployment/team/orders/DeploymentNotUpdatedInAtlas", firing.DedupKey)
+		assert.Equal(t, "production", firing.Environment)
+		assert.Equal(t, "2024-03-01T10:01:00Z", firing.Timestamp)
+	})
+
+	t.Run("should resolve the failure once the resource recovers", func(t *testing.T) {
+		n := newNotifier(t, FormatAlertmanager)
+
+		n.Recovered(deployment, now)
+		n.Failed(deployment, failure("DeploymentNotCreatedInAtlas"), now)
+		n.Recovered(deployment, now.Add(time.Hour))
+		n.Recovered(deployment, now.Add(time.Hour))
+
+		payloads := queued(n)
+		require.Len(t, payloads, 2)
+		resolved := payloads[1].(AlertmanagerWebhook)
+		assert.Equal(t, StatusResolved, resolved.Status)
+		assert.Equal(t, StatusResolved, resolved.Alerts[0].Status)
+		assert.Equal(t, "2024-03-01T11:00:00Z", resolved.Alerts[0].EndsAt)
+		assert.Equal(t, payloads[0].(AlertmanagerWebhook).Alerts[0].Fingerprint, resolved.Alerts[0].Fingerprint)
+	})
+
+	t.Run("should resolve a restored failure without notifying it again", func(t *testing.T) {
+		n := newNotifier(t, FormatEvent)
+
+		n.Restore(deployment, failure("DeploymentNotCreatedInAtlas"))
+		n.Restore(deployment, failure("DeploymentNotUpdatedInAtlas"))
+		n.Failed(deployment, failure("DeploymentNotCreatedInAtlas"), now)
+		n.Recovered(deployment, now.Add(time.Hour))
+
+		payloads := queued(n)
+		require.Len(t, payloads, 1)
+		resolved := payloads[0].(Event)
+		assert.Equal(t, StatusResolved, resolved.Status)
+		assert.Equal(t, "AtlasDeployment/team/orders/DeploymentNotCreatedInAtlas", resolved.DedupKey)
+	})
+}
+
+func TestStart(t *testing.T) {
+	t.Run("should post the notifications to the webhook", func(t *testing.T) {
+		received := make(chan Event, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			event := Event{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+			received <- event
+		}))
+		defer server.Close()
+
+		n, err := New(server.URL, FormatEvent, "", zaptest.NewLogger(t).Sugar())
+		require.NoError(t, err)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() { _ = n.Start(ctx) }()
+
+		n.Failed(deployment, failure("DeploymentNotCreatedInAtlas"), now)
+
+		select {
+		case event := <-received:
+			assert.Equal(t, "AtlasDeployment/team/orders/DeploymentNotCreatedInAtlas", event.DedupKey)
+			assert.Equal(t, StatusFiring, event.Status)
+		case <-time.After(time.Second * 5):
+			assert.Fail(t, "the notification wasn't sent")
+		}
+	})
+}
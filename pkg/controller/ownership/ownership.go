@@ -0,0 +1,121 @@
+package ownership
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/version"
+)
+
+const (
+	// NamespaceKey, NameKey, ClusterIDKey and VersionKey are the keys of the ownership marker stamped on the resources
+	// the operator creates in Atlas, wherever the Atlas API allows tags or labels
+	NamespaceKey = "mongodb-atlas-operator-namespace"
+	NameKey      = "mongodb-atlas-operator-name"
+	ClusterIDKey = "mongodb-atlas-operator-cluster-id"
+	VersionKey   = "mongodb-atlas-operator-version"
+
+	// clusterIDNamespace is the namespace whose UID identifies the Kubernetes cluster, as it exists in every cluster and
+	// is never deleted
+	clusterIDNamespace = "kube-system"
+)
+
+// ClusterID identifies the Kubernetes cluster the operator runs in. It is set once when the operator starts, and is
+// empty when it can't be discovered
+var ClusterID string
+
+// Marker names the custom resource an Atlas resource was created for
+type Marker struct {
+	ClusterID       string
+	Namespace       string
+	Name            string
+	OperatorVersion string
+}
+
+// Entry is a key and a value of the marker, as stored in the tags or labels of an Atlas resource
+type Entry struct {
+	Key   string
+	Value string
+}
+
+// For returns the marker of the resources created in Atlas for the custom resource
+func For(obj client.Object) Marker {
+	return Marker{
+		ClusterID:       ClusterID,
+		Namespace:       obj.GetNamespace(),
+		Name:            obj.GetName(),
+		OperatorVersion: version.Version,
+	}
+}
+
+// Entries returns the keys and values of the marker, skipping the empty ones
+func (m Marker) Entries() []Entry {
+	entries := make([]Entry, 0, 4)
+	for _, entry := range []Entry{
+		{Key: NamespaceKey, Value: m.Namespace},
+		{Key: NameKey, Value: m.Name},
+		{Key: ClusterIDKey, Value: m.ClusterID},
+		{Key: VersionKey, Value: m.OperatorVersion},
+	} {
+		if entry.Value != "" {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+// Owns reports whether the marker names the custom resource. The cluster is only compared when both are known, as the
+// resources marked before the cluster could be discovered must remain owned
+func (m Marker) Owns(obj client.Object) bool {
+	return m.SameCluster() && m.Namespace == obj.GetNamespace() && m.Name == obj.GetName()
+}
+
+// SameCluster reports whether the marker was stamped by an operator running in this Kubernetes cluster
+func (m Marker) SameCluster() bool {
+	return m.ClusterID == "" || ClusterID == "" || m.ClusterID == ClusterID
+}
+
+// KnownSameCluster reports whether the marker was stamped by an operator running in this Kubernetes cluster, both
+// clusters being known. Unlike SameCluster, it's false when either cluster couldn't be discovered, e.g. when the
+// operator is restricted to namespaced roles, so that it's safe to act destructively on the resource
+func (m Marker) KnownSameCluster() bool {
+	return m.ClusterID != "" && ClusterID != "" && m.ClusterID == ClusterID
+}
+
+// FromEntries reads the marker from the tags or labels of an Atlas resource. It returns false when the resource
+// wasn't marked by the operator
+func FromEntries(entries []Entry) (Marker, bool) {
+	m := Marker{}
+	for _, entry := range entries {
+		switch entry.Key {
+		case NamespaceKey:
+			m.Namespace = entry.Value
+		case NameKey:
+			m.Name = entry.Value
+		case ClusterIDKey:
+			m.ClusterID = entry.Value
+		case VersionKey:
+			m.OperatorVersion = entry.Value
+		}
+	}
+
+	return m, m.Namespace != "" && m.Name != ""
+}
+
+// IsKey reports whether the key of a tag or label belongs to the marker
+func IsKey(key string) bool {
+	return key == NamespaceKey || key == NameKey || key == ClusterIDKey || key == VersionKey
+}
+
+// DiscoverClusterID returns the identifier of the Kubernetes cluster, i.e. the UID of the kube-system namespace
+func DiscoverClusterID(ctx context.Context, reader client.Reader) (string, error) {
+	namespace := &corev1.Namespace{}
+	if err := reader.Get(ctx, client.ObjectKey{Name: clusterIDNamespace}, namespace); err != nil {
+		return "", err
+	}
+
+	return string(namespace.UID), nil
+}
@@ -0,0 +1,83 @@
+package ownership
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/version"
+)
+
+func withClusterID(t *testing.T, clusterID string) {
+	t.Helper()
+	previous := ClusterID
+	ClusterID = clusterID
+	t.Cleanup(func() { ClusterID = previous })
+}
+
+func TestMarker(t *testing.T) {
+	deployment := mdbv1.NewDeployment("ns", "deployment", "cluster0")
+
+	t.Run("should mark the resources with the custom resource, the cluster and the version", func(t *testing.T) {
+		withClusterID(t, "cluster-uid")
+
+		assert.Equal(t, []Entry{
+			{Key: NamespaceKey, Value: "ns"},
+			{Key: NameKey, Value: "deployment"},
+			{Key: ClusterIDKey, Value: "cluster-uid"},
+			{Key: VersionKey, Value: version.Version},
+		}, For(deployment).Entries())
+	})
+
+	t.Run("should skip the cluster when unknown", func(t *testing.T) {
+		withClusterID(t, "")
+
+		assert.Len(t, For(deployment).Entries(), 3)
+	})
+
+	t.Run("should read the marker back", func(t *testing.T) {
+		withClusterID(t, "cluster-uid")
+
+		marker, ok := FromEntries(append(For(deployment).Entries(), Entry{Key: "team", Value: "platform"}))
+
+		require.True(t, ok)
+		assert.Equal(t, For(deployment), marker)
+		assert.True(t, marker.Owns(deployment))
+	})
+
+	t.Run("should not read a marker without the custom resource", func(t *testing.T) {
+		_, ok := FromEntries([]Entry{{Key: ClusterIDKey, Value: "cluster-uid"}})
+
+		assert.False(t, ok)
+	})
+
+	t.Run("should not own the resources of another custom resource or cluster", func(t *testing.T) {
+		withClusterID(t, "cluster-uid")
+
+		assert.False(t, Marker{ClusterID: "cluster-uid", Namespace: "ns", Name: "other"}.Owns(deployment))
+		assert.False(t, Marker{ClusterID: "other-uid", Namespace: "ns", Name: "deployment"}.Owns(deployment))
+		assert.True(t, Marker{Namespace: "ns", Name: "deployment"}.Owns(deployment))
+	})
+}
+
+func TestDiscoverClusterID(t *testing.T) {
+	t.Run("should return the UID of the kube-system namespace", func(t *testing.T) {
+		sch := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(sch))
+		reader := fake.NewClientBuilder().WithScheme(sch).WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system", UID: "cluster-uid"}},
+		).Build()
+
+		clusterID, err := DiscoverClusterID(context.Background(), reader)
+
+		require.NoError(t, err)
+		assert.Equal(t, "cluster-uid", clusterID)
+	})
+}
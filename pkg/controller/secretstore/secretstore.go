@@ -0,0 +1,113 @@
+package secretstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+)
+
+const defaultPasswordKey = "password"
+
+// Provider reads the secrets kept in an external secret store
+type Provider interface {
+	// Read returns the value of the key of the secret at the path, and the version of the secret which changes each
+	// time the secret is updated
+	Read(ctx context.Context, path, key string) (string, string, error)
+}
+
+// Writer writes the secrets of an external secret store. The providers implementing it can hold the connection
+// credentials of the database users instead of Secrets, e.g. for the Secrets Store CSI driver to mount them
+type Writer interface {
+	// Write replaces the secret at the path with the data
+	Write(ctx context.Context, path string, data map[string]string) error
+	// ReadAll returns the data of the secret at the path, nil when it doesn't exist
+	ReadAll(ctx context.Context, path string) (map[string]string, error)
+	// Delete removes the secret at the path, it succeeds when the secret doesn't exist
+	Delete(ctx context.Context, path string) error
+}
+
+// NamespacedPath returns the path of a secret referenced by a resource of the namespace, confined under the namespace
+// so that a resource never reaches the secrets of another namespace
+func NamespacedPath(namespace, path string) (string, error) {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return "", errors.New("the secret store path is empty")
+	}
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return "", fmt.Errorf("the secret store path %q must not hold empty, '.' or '..' segments", path)
+		}
+	}
+
+	return namespace + "/" + path, nil
+}
+
+// Registry holds the secret store providers configured in the Operator by name, the database users reference them in
+// their passwordStoreRef
+type Registry map[string]Provider
+
+// ReadPassword returns the password of the database user and its version, read from the external secret store when
+// the user references one or from its password Secret otherwise. The version is the ResourceVersion of the Secret, or
+// the version of the secret in the store prefixed by the name of its provider. Both are empty when the user has no
+// password. The path of the store is confined under the namespace of the user
+func (r Registry) ReadPassword(ctx context.Context, kubeClient client.Client, dbUser *mdbv1.AtlasDatabaseUser) (string, string, error) {
+	if ref := dbUser.Spec.PasswordStore; ref != nil {
+		provider, ok := r[ref.Provider]
+		if !ok {
+			return "", "", fmt.Errorf("the secret store provider %q is not configured in the operator", ref.Provider)
+		}
+
+		path, err := NamespacedPath(dbUser.Namespace, ref.Path)
+		if err != nil {
+			return "", "", err
+		}
+		key := ref.Key
+		if key == "" {
+			key = defaultPasswordKey
+		}
+		password, version, err := provider.Read(ctx, path, key)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to read the password from the %s secret store: %w", ref.Provider, err)
+		}
+		if password == "" {
+			return "", "", errors.New("the password read from the secret store is empty")
+		}
+
+		return password, ref.Provider + ":" + version, nil
+	}
+
+	if dbUser.Spec.PasswordSecret == nil {
+		return "", "", nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := kubeClient.Get(ctx, *dbUser.PasswordSecretObjectKey(), secret); err != nil {
+		return "", "", err
+	}
+	password, err := mdbv1.PasswordFromSecret(secret)
+	if err != nil {
+		return "", "", err
+	}
+
+	return password, secret.ResourceVersion, nil
+}
+
+// Writer returns the provider the connection credentials can be written to
+func (r Registry) Writer(name string) (Writer, error) {
+	provider, ok := r[name]
+	if !ok {
+		return nil, fmt.Errorf("the secret store provider %q is not configured in the operator", name)
+	}
+	writer, ok := provider.(Writer)
+	if !ok {
+		return nil, fmt.Errorf("the secret store provider %q can't be written to", name)
+	}
+
+	return writer, nil
+}
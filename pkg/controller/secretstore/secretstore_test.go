@@ -0,0 +1,156 @@
+package secretstore
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+)
+
+type providerMock struct {
+	values map[string]string
+	err    error
+}
+
+func (p *providerMock) Read(_ context.Context, path, key string) (string, string, error) {
+	return p.values[path+"#"+key], "3", p.err
+}
+
+func TestReadPassword(t *testing.T) {
+	newClient := func(t *testing.T, objects ...client.Object) client.Client {
+		sch := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(sch))
+
+		return fake.NewClientBuilder().WithScheme(sch).WithObjects(objects...).Build()
+	}
+	newUser := func() *mdbv1.AtlasDatabaseUser {
+		return &mdbv1.AtlasDatabaseUser{ObjectMeta: metav1.ObjectMeta{Name: "user", Namespace: "default"}}
+	}
+	stores := Registry{"vault": &providerMock{values: map[string]string{"default/team/user#password": "from-vault", "default/team/user#custom": "custom"}}}
+
+	t.Run("should read the password from the secret store", func(t *testing.T) {
+		dbUser := newUser()
+		dbUser.Spec.PasswordStore = &mdbv1.PasswordStoreRef{Provider: "vault", Path: "team/user"}
+
+		password, version, err := stores.ReadPassword(context.Background(), newClient(t), dbUser)
+
+		require.NoError(t, err)
+		assert.Equal(t, "from-vault", password)
+		assert.Equal(t, "vault:3", version)
+	})
+
+	t.Run("should read the key of the reference", func(t *testing.T) {
+		dbUser := newUser()
+		dbUser.Spec.PasswordStore = &mdbv1.PasswordStoreRef{Provider: "vault", Path: "team/user", Key: "custom"}
+
+		password, _, err := stores.ReadPassword(context.Background(), newClient(t), dbUser)
+
+		require.NoError(t, err)
+		assert.Equal(t, "custom", password)
+	})
+
+	t.Run("should confine the path under the namespace of the user", func(t *testing.T) {
+		dbUser := newUser()
+		dbUser.Spec.PasswordStore = &mdbv1.PasswordStoreRef{Provider: "vault", Path: "../other/user"}
+
+		_, _, err := stores.ReadPassword(context.Background(), newClient(t), dbUser)
+
+		assert.ErrorContains(t, err, "must not hold empty, '.' or '..' segments")
+	})
+
+	t.Run("should fail when the provider isn't configured", func(t *testing.T) {
+		dbUser := newUser()
+		dbUser.Spec.PasswordStore = &mdbv1.PasswordStoreRef{Provider: "vault", Path: "team/user"}
+
+		_, _, err := Registry(nil).ReadPassword(context.Background(), newClient(t), dbUser)
+
+		assert.EqualError(t, err, `the secret store provider "vault" is not configured in the operator`)
+	})
+
+	t.Run("should fail when the store fails or holds no password", func(t *testing.T) {
+		dbUser := newUser()
+		dbUser.Spec.PasswordStore = &mdbv1.PasswordStoreRef{Provider: "vault", Path: "team/other"}
+
+		_, _, err := stores.ReadPassword(context.Background(), newClient(t), dbUser)
+		assert.EqualError(t, err, "the password read from the secret store is empty")
+
+		failing := Registry{"vault": &providerMock{err: errors.New("permission denied")}}
+		_, _, err = failing.ReadPassword(context.Background(), newClient(t), dbUser)
+		assert.EqualError(t, err, "failed to read the password from the vault secret store: permission denied")
+	})
+
+	t.Run("should read the password from the password Secret", func(t *testing.T) {
+		dbUser := newUser()
+		dbUser.Spec.PasswordSecret = &common.ResourceRef{Name: "user-password"}
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "user-password", Namespace: "default"},
+			Data:       map[string][]byte{"password": []byte("from-secret")},
+		}
+		kubeClient := newClient(t, secret)
+
+		password, version, err := stores.ReadPassword(context.Background(), kubeClient, dbUser)
+
+		require.NoError(t, err)
+		assert.Equal(t, "from-secret", password)
+		require.NoError(t, kubeClient.Get(context.Background(), client.ObjectKeyFromObject(secret), secret))
+		assert.Equal(t, secret.ResourceVersion, version)
+	})
+
+	t.Run("should return no password for the users without one", func(t *testing.T) {
+		password, version, err := stores.ReadPassword(context.Background(), newClient(t), newUser())
+
+		require.NoError(t, err)
+		assert.Empty(t, password)
+		assert.Empty(t, version)
+	})
+}
+
+type writerMock struct {
+	providerMock
+}
+
+func (w *writerMock) Write(context.Context, string, map[string]string) error { return nil }
+
+func (w *writerMock) Delete(context.Context, string) error { return nil }
+
+func (w *writerMock) ReadAll(context.Context, string) (map[string]string, error) { return nil, nil }
+
+func TestRegistryWriter(t *testing.T) {
+	stores := Registry{"vault": &writerMock{}, "read-only": &providerMock{}}
+
+	t.Run("should return the provider which can be written to", func(t *testing.T) {
+		writer, err := stores.Writer("vault")
+
+		require.NoError(t, err)
+		assert.Equal(t, stores["vault"], writer)
+	})
+
+	t.Run("should fail when the provider isn't configured or can't be written to", func(t *testing.T) {
+		_, err := stores.Writer("other")
+		assert.EqualError(t, err, `the secret store provider "other" is not configured in the operator`)
+
+		_, err = stores.Writer("read-only")
+		assert.EqualError(t, err, `the secret store provider "read-only" can't be written to`)
+	})
+}
+
+func TestNamespacedPath(t *testing.T) {
+	path, err := NamespacedPath("ns", "/team/app/")
+	require.NoError(t, err)
+	assert.Equal(t, "ns/team/app", path)
+
+	for _, path := range []string{"", "/", "team/../../other", "team//app", "./app"} {
+		_, err = NamespacedPath("ns", path)
+		assert.Error(t, err, path)
+	}
+}
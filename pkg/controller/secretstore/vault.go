@@ -0,0 +1,170 @@
+package secretstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	// VaultProviderName is the name the database users reference the HashiCorp Vault provider with
+	VaultProviderName = "vault"
+
+	maxVaultResponseSize = 1024 * 1024
+)
+
+// VaultProvider reads and writes the secrets of a KV version 2 secrets engine of HashiCorp Vault. The requests are authenticated
+// with the token of TokenFile, e.g. written by the Vault Agent, which is read on each request so that it can be renewed
+type VaultProvider struct {
+	Address string
+	// Mount is the path the KV secrets engine is mounted at
+	Mount string
+	// PathPrefix is prepended to all the paths, so that the Operator only reaches the secrets under it
+	PathPrefix string
+	TokenFile  string
+	HTTPClient *http.Client
+}
+
+type vaultKVResponse struct {
+	Data struct {
+		Data     map[string]interface{} `json:"data"`
+		Metadata struct {
+			Version int `json:"version"`
+		} `json:"metadata"`
+	} `json:"data"`
+}
+
+func (p *VaultProvider) Read(ctx context.Context, path, key string) (string, string, error) {
+	secret, err := p.readSecret(ctx, path)
+	if err != nil {
+		return "", "", err
+	}
+	if secret == nil {
+		return "", "", fmt.Errorf("the secret %s doesn't exist", path)
+	}
+
+	value, ok := secret.Data.Data[key].(string)
+	if !ok {
+		return "", "", fmt.Errorf("the secret %s has no %q string key", path, key)
+	}
+
+	return value, strconv.Itoa(secret.Data.Metadata.Version), nil
+}
+
+// ReadAll returns the string keys of the latest version of the secret at the path, nil when it doesn't exist
+func (p *VaultProvider) ReadAll(ctx context.Context, path string) (map[string]string, error) {
+	secret, err := p.readSecret(ctx, path)
+	if err != nil || secret == nil {
+		return nil, err
+	}
+
+	data := make(map[string]string, len(secret.Data.Data))
+	for key, value := range secret.Data.Data {
+		if s, ok := value.(string); ok {
+			data[key] = s
+		}
+	}
+
+	return data, nil
+}
+
+// readSecret returns the latest version of the secret at the path, nil when it doesn't exist
+func (p *VaultProvider) readSecret(ctx context.Context, path string) (*vaultKVResponse, error) {
+	req, err := p.newRequest(ctx, http.MethodGet, "data", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the secret %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("failed to read the secret %s: unexpected status %s", path, resp.Status)
+	}
+
+	secret := &vaultKVResponse{}
+	if err = json.NewDecoder(io.LimitReader(resp.Body, maxVaultResponseSize)).Decode(secret); err != nil {
+		return nil, fmt.Errorf("failed to decode the secret %s: %w", path, err)
+	}
+
+	return secret, nil
+}
+
+// Write creates a new version of the secret at the path holding the data
+func (p *VaultProvider) Write(ctx context.Context, path string, data map[string]string) error {
+	body, err := json.Marshal(map[string]interface{}{"data": data})
+	if err != nil {
+		return err
+	}
+
+	req, err := p.newRequest(ctx, http.MethodPost, "data", path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to write the secret %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("failed to write the secret %s: unexpected status %s", path, resp.Status)
+	}
+
+	return nil
+}
+
+// Delete removes all the versions of the secret at the path
+func (p *VaultProvider) Delete(ctx context.Context, path string) error {
+	req, err := p.newRequest(ctx, http.MethodDelete, "metadata", path, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := p.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to delete the secret %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("failed to delete the secret %s: unexpected status %s", path, resp.Status)
+	}
+
+	return nil
+}
+
+// newRequest returns a request to the API of the KV secrets engine authenticated with the current token
+func (p *VaultProvider) newRequest(ctx context.Context, method, api, path string, body io.Reader) (*http.Request, error) {
+	token, err := os.ReadFile(p.TokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the Vault token: %w", err)
+	}
+
+	if prefix := strings.Trim(p.PathPrefix, "/"); prefix != "" {
+		path = prefix + "/" + strings.TrimPrefix(path, "/")
+	}
+	url := fmt.Sprintf("%s/v1/%s/%s/%s", strings.TrimSuffix(p.Address, "/"), strings.Trim(p.Mount, "/"), api, strings.TrimPrefix(path, "/"))
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", strings.TrimSpace(string(token)))
+
+	return req, nil
+}
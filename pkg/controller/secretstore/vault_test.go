@@ -0,0 +1,140 @@
+package secretstore
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Header.Get("X-Vault-Token") != "vault-token":
+			w.WriteHeader(http.StatusForbidden)
+		case r.URL.Path == "/v1/kv/data/team/user":
+			_, _ = w.Write([]byte(`{"data":{"data":{"password":"s3cr3t","port":5432},"metadata":{"version":4}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("vault-token\n"), 0o600))
+	provider := &VaultProvider{Address: server.URL + "/", Mount: "kv", TokenFile: tokenFile, HTTPClient: server.Client()}
+
+	t.Run("should read the key of the secret and its version", func(t *testing.T) {
+		value, version, err := provider.Read(context.Background(), "team/user", "password")
+
+		require.NoError(t, err)
+		assert.Equal(t, "s3cr3t", value)
+		assert.Equal(t, "4", version)
+	})
+
+	t.Run("should fail when the key isn't a string", func(t *testing.T) {
+		_, _, err := provider.Read(context.Background(), "team/user", "port")
+
+		assert.EqualError(t, err, `the secret team/user has no "port" string key`)
+	})
+
+	t.Run("should fail when the secret doesn't exist", func(t *testing.T) {
+		_, _, err := provider.Read(context.Background(), "team/other", "password")
+
+		assert.EqualError(t, err, "the secret team/other doesn't exist")
+	})
+
+	t.Run("should fail when the token is refused", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(tokenFile, []byte("expired"), 0o600))
+
+		_, _, err := provider.Read(context.Background(), "team/user", "password")
+
+		assert.EqualError(t, err, "failed to read the secret team/user: unexpected status 403 Forbidden")
+	})
+}
+
+func TestVaultProviderWrite(t *testing.T) {
+	secrets := map[string]map[string]string{"connections/app/old": {"username": "app"}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "vault-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch {
+		case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/v1/kv/data/"):
+			body := struct {
+				Data map[string]string `json:"data"`
+			}{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			secrets[strings.TrimPrefix(r.URL.Path, "/v1/kv/data/")] = body.Data
+			_, _ = w.Write([]byte(`{"data":{"version":1}}`))
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/v1/kv/metadata/"):
+			delete(secrets, strings.TrimPrefix(r.URL.Path, "/v1/kv/metadata/"))
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/v1/kv/data/"):
+			data, ok := secrets[strings.TrimPrefix(r.URL.Path, "/v1/kv/data/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"data": data}}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("vault-token"), 0o600))
+	provider := &VaultProvider{Address: server.URL, Mount: "kv", TokenFile: tokenFile, HTTPClient: server.Client()}
+
+	t.Run("should write the data of the secret", func(t *testing.T) {
+		require.NoError(t, provider.Write(context.Background(), "connections/app/new", map[string]string{"password": "s3cr3t"}))
+
+		assert.Equal(t, map[string]string{"password": "s3cr3t"}, secrets["connections/app/new"])
+	})
+
+	t.Run("should read all the keys of the secret", func(t *testing.T) {
+		data, err := provider.ReadAll(context.Background(), "connections/app/new")
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"password": "s3cr3t"}, data)
+	})
+
+	t.Run("should read no keys of a missing secret", func(t *testing.T) {
+		data, err := provider.ReadAll(context.Background(), "connections/app/missing")
+
+		require.NoError(t, err)
+		assert.Nil(t, data)
+	})
+
+	t.Run("should prepend the path prefix", func(t *testing.T) {
+		prefixed := *provider
+		prefixed.PathPrefix = "/kube01/"
+
+		require.NoError(t, prefixed.Write(context.Background(), "ns/app", map[string]string{"password": "s3cr3t"}))
+
+		assert.Contains(t, secrets, "kube01/ns/app")
+	})
+
+	t.Run("should delete the secret and succeed when it doesn't exist", func(t *testing.T) {
+		require.NoError(t, provider.Delete(context.Background(), "connections/app/old"))
+		require.NoError(t, provider.Delete(context.Background(), "connections/app/old"))
+
+		assert.NotContains(t, secrets, "connections/app/old")
+	})
+
+	t.Run("should fail when the token is refused", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(tokenFile, []byte("expired"), 0o600))
+
+		err := provider.Write(context.Background(), "connections/app/new", map[string]string{})
+		assert.EqualError(t, err, "failed to write the secret connections/app/new: unexpected status 403 Forbidden")
+	})
+}
@@ -1,22 +1,28 @@
 package statushandler
 
 import (
+	"time"
+
 	apiErrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/notifier"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
 )
 
 // Update performs the update (in the form of patch) for the Atlas Custom Resource status.
-// It should be a common method for all the controllers
-func Update(ctx *workflow.Context, kubeClient client.Client, eventRecorder record.EventRecorder, resource mdbv1.AtlasCustomResource) {
+// It should be a common method for all the controllers. The failures and recoveries are notified to the failure
+// notifier, if any
+func Update(ctx *workflow.Context, kubeClient client.Client, eventRecorder record.EventRecorder, failureNotifier *notifier.Notifier, resource mdbv1.AtlasCustomResource) {
 	if ctx.LastCondition() != nil {
 		logEvent(ctx, eventRecorder, resource)
 	}
 
+	trackTimeToReady(ctx, resource, time.Now())
+	notifyFailure(ctx, failureNotifier, resource, time.Now())
 	resource.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
 
 	if err := patchUpdateStatus(ctx.Context, kubeClient, resource); err != nil {
@@ -30,6 +36,10 @@ func Update(ctx *workflow.Context, kubeClient client.Client, eventRecorder recor
 	}
 }
 
+// AtlasErrorCodeAnnotation is set on the events of the conditions caused by an Atlas Admin API error, to the code of the
+// error, so that the events of specific errors can be selected
+const AtlasErrorCodeAnnotation = "mongodb.com/atlas-error-code"
+
 // logEvent logs the last condition to the output and also creates the Event for it in Kubernetes.
 // Some tradeoffs about event submission: the Event always requires the 'reason' and 'message' though our Status
 // conditions may lack that in case the condition is successful ("true"). In this case we leave the message empty
@@ -47,5 +57,9 @@ func logEvent(ctx *workflow.Context, eventRecorder record.EventRecorder, resourc
 	if ctx.LastConditionWarn() {
 		eventType = "Warning"
 	}
+	if atlasError := ctx.LastCondition().AtlasError; atlasError != nil && atlasError.ErrorCode != "" {
+		eventRecorder.AnnotatedEventf(resource, map[string]string{AtlasErrorCodeAnnotation: atlasError.ErrorCode}, eventType, reason, "%s", msg)
+		return
+	}
 	eventRecorder.Event(resource, eventType, reason, msg)
 }
@@ -0,0 +1,58 @@
+package statushandler
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/notifier"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// notifyFailure reports the resource as failed when the reconciliation ended on a failure condition, and as recovered
+// once it's ready or deleted. No notification is sent when the notifier is nil.
+// It must be called before the status of the resource is updated, the failure the resource reported before the
+// Operator started is restored from its conditions
+func notifyFailure(ctx *workflow.Context, failureNotifier *notifier.Notifier, resource mdbv1.AtlasCustomResource, now time.Time) {
+	if failureNotifier == nil {
+		return
+	}
+
+	ref := notifier.Resource{Kind: ResourceKind(resource), Namespace: resource.GetNamespace(), Name: resource.GetName()}
+	if previous := reportedFailure(resource.GetStatus().GetConditions()); previous != nil {
+		failureNotifier.Restore(ref, *previous)
+	}
+
+	if !resource.GetDeletionTimestamp().IsZero() {
+		failureNotifier.Recovered(ref, now)
+		return
+	}
+
+	if last := ctx.LastCondition(); last != nil && ctx.LastConditionWarn() && last.Status == corev1.ConditionFalse && last.Reason != "" {
+		failureNotifier.Failed(ref, *last, now)
+		return
+	}
+
+	if isReady(ctx.Conditions()) {
+		failureNotifier.Recovered(ref, now)
+	}
+}
+
+// reportedFailure returns the latest false condition with a reason, other than Ready, of the persisted conditions.
+// Whether it was a failure or a reconciliation in progress isn't recorded, both are considered a failure
+func reportedFailure(conditions []status.Condition) *status.Condition {
+	var failure *status.Condition
+	for i := range conditions {
+		condition := &conditions[i]
+		if condition.Type == status.ReadyType || condition.Status != corev1.ConditionFalse || condition.Reason == "" {
+			continue
+		}
+		if failure == nil || condition.LastTransitionTime.After(failure.LastTransitionTime.Time) {
+			failure = condition
+		}
+	}
+
+	return failure
+}
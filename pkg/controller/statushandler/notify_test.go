@@ -0,0 +1,128 @@
+package statushandler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/notifier"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// newWebhook returns a notifier sending the events to a test webhook, and the events the webhook received
+func newWebhook(t *testing.T) (*notifier.Notifier, chan notifier.Event) {
+	received := make(chan notifier.Event, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event := notifier.Event{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		received <- event
+	}))
+	t.Cleanup(server.Close)
+
+	n, err := notifier.New(server.URL, notifier.FormatEvent, "", zaptest.NewLogger(t).Sugar())
+	require.NoError(t, err)
+
+	return n, received
+}
+
+func TestNotifyFailure(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	user := &mdbv1.AtlasDatabaseUser{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "team"}}
+	newResultContext := func(result workflow.Result) *workflow.Context {
+		ctx := newContext(t)
+		if result.IsOk() {
+			ctx.SetConditionTrue(status.ReadyType)
+		} else {
+			ctx.SetConditionFalse(status.ReadyType)
+		}
+
+		return ctx.SetConditionFromResult(status.DatabaseUserReadyType, result)
+	}
+
+	t.Run("should do nothing without a notifier", func(t *testing.T) {
+		ctx := newResultContext(workflow.Terminate(workflow.DatabaseUserNotCreatedInAtlas, "failed"))
+
+		assert.NotPanics(t, func() { notifyFailure(ctx, nil, user, now) })
+	})
+
+	t.Run("should notify the failures and the recoveries only", func(t *testing.T) {
+		n, received := newWebhook(t)
+		notifyFailure(newResultContext(workflow.InProgress(workflow.DatabaseUserDeploymentAppliedChanges, "applying")), n, user, now)
+		notifyFailure(newResultContext(workflow.Terminate(workflow.DatabaseUserNotCreatedInAtlas, "failed")), n, user, now)
+		notifyFailure(newResultContext(workflow.OK()), n, user, now)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() { _ = n.Start(ctx) }()
+
+		for _, expected := range []string{notifier.StatusFiring, notifier.StatusResolved} {
+			select {
+			case event := <-received:
+				assert.Equal(t, expected, event.Status)
+				assert.Equal(t, "AtlasDatabaseUser/team/app/DatabaseUserNotCreatedInAtlas", event.DedupKey)
+			case <-time.After(time.Second * 5):
+				require.Fail(t, "the notification wasn't sent", expected)
+			}
+		}
+	})
+	t.Run("should resolve the failure reported before a restart once the resource recovers", func(t *testing.T) {
+		n, received := newWebhook(t)
+		failedUser := user.DeepCopy()
+		failedUser.Status.Conditions = []status.Condition{
+			{Type: status.ReadyType, Status: corev1.ConditionFalse},
+			{Type: status.DatabaseUserReadyType, Status: corev1.ConditionFalse, Reason: string(workflow.DatabaseUserNotCreatedInAtlas)},
+		}
+
+		notifyFailure(newResultContext(workflow.Terminate(workflow.DatabaseUserNotCreatedInAtlas, "failed")), n, failedUser, now)
+		notifyFailure(newResultContext(workflow.OK()), n, failedUser, now)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() { _ = n.Start(ctx) }()
+
+		select {
+		case event := <-received:
+			assert.Equal(t, notifier.StatusResolved, event.Status)
+			assert.Equal(t, "AtlasDatabaseUser/team/app/DatabaseUserNotCreatedInAtlas", event.DedupKey)
+		case <-time.After(time.Second * 5):
+			require.Fail(t, "the notification wasn't sent")
+		}
+	})
+
+	t.Run("should resolve the failure of a deleted resource", func(t *testing.T) {
+		n, received := newWebhook(t)
+		deletedUser := user.DeepCopy()
+		deletedUser.DeletionTimestamp = &metav1.Time{Time: now}
+
+		notifyFailure(newResultContext(workflow.Terminate(workflow.DatabaseUserNotCreatedInAtlas, "failed")), n, user, now)
+		notifyFailure(newResultContext(workflow.Terminate(workflow.DatabaseUserNotCreatedInAtlas, "failed")), n, deletedUser, now)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() { _ = n.Start(ctx) }()
+
+		for _, expected := range []string{notifier.StatusFiring, notifier.StatusResolved} {
+			select {
+			case event := <-received:
+				assert.Equal(t, expected, event.Status)
+			case <-time.After(time.Second * 5):
+				require.Fail(t, "the notification wasn't sent", expected)
+			}
+		}
+	})
+}
+
+func newContext(t *testing.T) *workflow.Context {
+	return workflow.NewContext(zaptest.NewLogger(t).Sugar(), []status.Condition{}, context.Background())
+}
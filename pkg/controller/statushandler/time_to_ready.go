@@ -0,0 +1,63 @@
+package statushandler
+
+import (
+	"reflect"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/metrics"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// forgetDeleted removes the metrics kept for a resource being deleted, so that the series of the deleted resources don't
+// accumulate
+func forgetDeleted(resource mdbv1.AtlasCustomResource) {
+	metrics.ForgetTimeToReady(resource.GetUID())
+	metrics.DeleteAtlasAPIRequests(ResourceKind(resource), resource.GetNamespace(), resource.GetName())
+}
+
+// trackTimeToReady measures the time it takes the resource to become ready. The measurement starts at the creation of
+// the resource, or when the operator first observes a change of its spec, and ends once the Ready condition is true.
+// It must be called before the status of the resource is updated, so that a new generation can be detected
+func trackTimeToReady(ctx *workflow.Context, resource mdbv1.AtlasCustomResource, now time.Time) {
+	if !resource.GetDeletionTimestamp().IsZero() {
+		forgetDeleted(resource)
+		return
+	}
+
+	observedGeneration := resource.GetStatus().GetObservedGeneration()
+	if observedGeneration != resource.GetGeneration() {
+		since := now
+		if observedGeneration == 0 {
+			since = resource.GetCreationTimestamp().Time
+		}
+		metrics.StartTimeToReady(resource.GetUID(), resource.GetGeneration(), since)
+	}
+
+	if isReady(ctx.Conditions()) {
+		metrics.ObserveTimeToReady(ResourceKind(resource), resource.GetUID(), resource.GetGeneration(), now)
+	}
+}
+
+func isReady(conditions []status.Condition) bool {
+	for _, condition := range conditions {
+		if condition.Type == status.ReadyType {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// ResourceKind returns the kind of the resource, the TypeMeta of typed objects read from the API being usually empty
+func ResourceKind(resource mdbv1.AtlasCustomResource) string {
+	t := reflect.TypeOf(resource)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t.Name()
+}
@@ -0,0 +1,86 @@
+package statushandler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/workflow"
+)
+
+// timeToReadySamples returns the number and the sum of the time to ready observations of the given kind
+func timeToReadySamples(t *testing.T, kind string) (uint64, float64) {
+	t.Helper()
+	families, err := crmetrics.Registry.Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != "atlas_operator_resource_time_to_ready_seconds" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "kind" && label.GetValue() == kind {
+					return metric.GetHistogram().GetSampleCount(), metric.GetHistogram().GetSampleSum()
+				}
+			}
+		}
+	}
+
+	return 0, 0
+}
+
+func TestTrackTimeToReady(t *testing.T) {
+	now := time.Date(2023, 11, 20, 10, 0, 0, 0, time.UTC)
+	newReadyContext := func(ready bool) *workflow.Context {
+		ctx := newContext(t)
+		if ready {
+			ctx.SetConditionTrue(status.ReadyType)
+		} else {
+			ctx.SetConditionFalse(status.ReadyType)
+		}
+
+		return ctx
+	}
+
+	t.Run("should measure the time from the creation of the resource", func(t *testing.T) {
+		user := &mdbv1.AtlasDatabaseUser{ObjectMeta: metav1.ObjectMeta{
+			UID:               types.UID("created"),
+			Generation:        1,
+			CreationTimestamp: metav1.NewTime(now.Add(-time.Minute)),
+		}}
+
+		trackTimeToReady(newReadyContext(false), user, now)
+		user.UpdateStatus(nil)
+		trackTimeToReady(newReadyContext(true), user, now.Add(time.Minute))
+
+		count, sum := timeToReadySamples(t, "AtlasDatabaseUser")
+		assert.Equal(t, uint64(1), count)
+		assert.Equal(t, 120.0, sum)
+	})
+
+	t.Run("should measure the time from the change of the spec", func(t *testing.T) {
+		team := &mdbv1.AtlasTeam{ObjectMeta: metav1.ObjectMeta{
+			UID:               types.UID("changed"),
+			Generation:        2,
+			CreationTimestamp: metav1.NewTime(now.Add(-time.Hour)),
+		}}
+		team.Status.ObservedGeneration = 1
+
+		trackTimeToReady(newReadyContext(false), team, now)
+		team.UpdateStatus(nil)
+		trackTimeToReady(newReadyContext(true), team, now.Add(30*time.Second))
+		trackTimeToReady(newReadyContext(true), team, now.Add(time.Hour))
+
+		count, sum := timeToReadySamples(t, "AtlasTeam")
+		assert.Equal(t, uint64(1), count)
+		assert.Equal(t, 30.0, sum)
+	})
+}
@@ -6,10 +6,15 @@ import (
 	"net"
 	"reflect"
 	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/timeutil"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -32,6 +37,10 @@ func DeploymentSpec(deploymentSpec *mdbv1.AtlasDeploymentSpec, isGov bool, regio
 		if govErr := deploymentForGov(deploymentSpec, regionUsageRestrictions); govErr != nil {
 			err = errors.Join(err, govErr)
 		}
+	} else {
+		if regionsErr := deploymentRegions(deploymentSpec); regionsErr != nil {
+			err = errors.Join(err, regionsErr)
+		}
 	}
 
 	if deploymentSpec.DeploymentSpec != nil {
@@ -49,11 +58,93 @@ func DeploymentSpec(deploymentSpec *mdbv1.AtlasDeploymentSpec, isGov bool, regio
 		if instanceSizeRangeErr != nil {
 			err = errors.Join(err, instanceSizeRangeErr)
 		}
+
+		if diskSizeErr := diskSizeForAdvancedDeployment(deploymentSpec.DeploymentSpec); diskSizeErr != nil {
+			err = errors.Join(err, diskSizeErr)
+		}
+
+		if configServerErr := configServerForAdvancedDeployment(deploymentSpec.DeploymentSpec); configServerErr != nil {
+			err = errors.Join(err, configServerErr)
+		}
+
+		if versionErr := versionForAdvancedDeployment(deploymentSpec.DeploymentSpec); versionErr != nil {
+			err = errors.Join(err, versionErr)
+		}
+	}
+
+	if processArgsErr := processArgs(deploymentSpec.ProcessArgs); processArgsErr != nil {
+		err = errors.Join(err, processArgsErr)
+	}
+
+	return err
+}
+
+// processArgs checks the advanced configuration options against the values accepted by Atlas, these are enforced by
+// the CRD schema as well but are checked again for the resources stored before the schema was introduced
+func processArgs(args *mdbv1.ProcessArgs) error {
+	if args == nil {
+		return nil
+	}
+
+	var err error
+
+	if args.DefaultReadConcern != "" && !slices.Contains([]string{"local", "available", "majority"}, args.DefaultReadConcern) {
+		err = errors.Join(err, fmt.Errorf("processArgs.defaultReadConcern must be one of local, available or majority, got %s", args.DefaultReadConcern))
+	}
+
+	if args.DefaultWriteConcern != "" && args.DefaultWriteConcern != "majority" {
+		if nodes, convErr := strconv.Atoi(args.DefaultWriteConcern); convErr != nil || nodes < 0 {
+			err = errors.Join(err, fmt.Errorf("processArgs.defaultWriteConcern must be majority or a number of nodes, got %s", args.DefaultWriteConcern))
+		}
+	}
+
+	if args.MinimumEnabledTLSProtocol != "" && !slices.Contains([]string{"TLS1_0", "TLS1_1", "TLS1_2", "TLS1_3"}, args.MinimumEnabledTLSProtocol) {
+		err = errors.Join(err, fmt.Errorf("processArgs.minimumEnabledTlsProtocol must be one of TLS1_0, TLS1_1, TLS1_2 or TLS1_3, got %s", args.MinimumEnabledTLSProtocol))
+	}
+
+	if args.OplogSizeMB != nil && *args.OplogSizeMB < 990 {
+		err = errors.Join(err, fmt.Errorf("processArgs.oplogSizeMB must be at least 990, got %d", *args.OplogSizeMB))
+	}
+
+	if args.SampleSizeBIConnector != nil && *args.SampleSizeBIConnector < 0 {
+		err = errors.Join(err, fmt.Errorf("processArgs.sampleSizeBIConnector can't be negative, got %d", *args.SampleSizeBIConnector))
+	}
+
+	if args.SampleRefreshIntervalBIConnector != nil && *args.SampleRefreshIntervalBIConnector < 0 {
+		err = errors.Join(err, fmt.Errorf("processArgs.sampleRefreshIntervalBIConnector can't be negative, got %d", *args.SampleRefreshIntervalBIConnector))
+	}
+
+	if args.OplogMinRetentionHours != "" {
+		if hours, parseErr := strconv.ParseFloat(args.OplogMinRetentionHours, 64); parseErr != nil || hours < 0 {
+			err = errors.Join(err, fmt.Errorf("processArgs.oplogMinRetentionHours must be a positive number of hours, got %s", args.OplogMinRetentionHours))
+		}
 	}
 
 	return err
 }
 
+// configServerForAdvancedDeployment checks the config server management mode is only set for sharded deployments
+func configServerForAdvancedDeployment(deployment *mdbv1.AdvancedDeploymentSpec) error {
+	if deployment.ConfigServerManagementMode == "" {
+		return nil
+	}
+
+	if deployment.ClusterType != string(mdbv1.TypeSharded) && deployment.ClusterType != string(mdbv1.TypeGeoSharded) {
+		return fmt.Errorf("configServerManagementMode can only be set for %s or %s deployments", mdbv1.TypeSharded, mdbv1.TypeGeoSharded)
+	}
+
+	return nil
+}
+
+// versionForAdvancedDeployment checks a major version is only pinned with the LTS release system
+func versionForAdvancedDeployment(deployment *mdbv1.AdvancedDeploymentSpec) error {
+	if deployment.VersionReleaseSystem == mdbv1.VersionReleaseSystemContinuous && deployment.MongoDBMajorVersion != "" {
+		return fmt.Errorf("mongoDBMajorVersion can't be set with the %s version release system", mdbv1.VersionReleaseSystemContinuous)
+	}
+
+	return nil
+}
+
 func deploymentForGov(deployment *mdbv1.AtlasDeploymentSpec, regionUsageRestrictions string) error {
 	var err error
 
@@ -71,6 +162,96 @@ func deploymentForGov(deployment *mdbv1.AtlasDeploymentSpec, regionUsageRestrict
 	return err
 }
 
+// deploymentRegions checks the regions of the deployment are not given with the native name of their cloud provider nor
+// are the regions of another provider. Regions missing from the table of Atlas regions are reported by
+// DeploymentRegionWarnings instead, as they may be Atlas regions newer than the table
+func deploymentRegions(deployment *mdbv1.AtlasDeploymentSpec) error {
+	var err error
+	forEachDeploymentRegion(deployment, func(providerName provider.ProviderName, region string) {
+		err = errors.Join(err, providerRegion(providerName, region))
+	})
+
+	return err
+}
+
+// DeploymentRegionWarnings lists the regions of the deployment which aren't known Atlas regions of their cloud provider
+func DeploymentRegionWarnings(deployment *mdbv1.AtlasDeploymentSpec) []string {
+	var warnings []string
+	forEachDeploymentRegion(deployment, func(providerName provider.ProviderName, region string) {
+		if isUnknownRegion(providerName, region) {
+			warnings = append(warnings, fmt.Sprintf("region %s is not a known Atlas region of %s", region, providerName))
+		}
+	})
+
+	return warnings
+}
+
+func forEachDeploymentRegion(deployment *mdbv1.AtlasDeploymentSpec, fn func(providerName provider.ProviderName, region string)) {
+	if deployment.DeploymentSpec != nil {
+		for _, replication := range deployment.DeploymentSpec.ReplicationSpecs {
+			if replication == nil {
+				continue
+			}
+
+			for _, region := range replication.RegionConfigs {
+				if region == nil {
+					continue
+				}
+
+				providerName := provider.ProviderName(region.ProviderName)
+				if providerName == provider.ProviderTenant {
+					providerName = provider.ProviderName(region.BackingProviderName)
+				}
+
+				fn(providerName, region.RegionName)
+			}
+		}
+	}
+
+	if deployment.ServerlessSpec != nil && deployment.ServerlessSpec.ProviderSettings != nil {
+		settings := deployment.ServerlessSpec.ProviderSettings
+		fn(provider.ProviderName(settings.BackingProviderName), settings.RegionName)
+	}
+}
+
+// providerRegion fails when the region is the provider native name of an Atlas region, suggesting the Atlas name, or
+// when it is an Atlas region of another provider
+func providerRegion(providerName provider.ProviderName, region string) error {
+	if region == "" || !provider.HasRegions(providerName) || provider.IsRegionSupported(providerName, region) {
+		return nil
+	}
+
+	if atlasRegion, ok := provider.AtlasRegion(providerName, region); ok {
+		return fmt.Errorf("region %s is the %s name of the region, use the Atlas region name %s instead", region, providerName, atlasRegion)
+	}
+
+	if providers := provider.RegionProviders(region); len(providers) > 0 {
+		return fmt.Errorf("region %s is not available on %s, it is a region of %s", region, providerName, joinProviders(providers))
+	}
+
+	return nil
+}
+
+func isUnknownRegion(providerName provider.ProviderName, region string) bool {
+	if region == "" || !provider.HasRegions(providerName) || provider.IsRegionSupported(providerName, region) {
+		return false
+	}
+	if _, ok := provider.AtlasRegion(providerName, region); ok {
+		return false
+	}
+
+	return len(provider.RegionProviders(region)) == 0
+}
+
+func joinProviders(providers []provider.ProviderName) string {
+	names := make([]string, 0, len(providers))
+	for _, providerName := range providers {
+		names = append(names, string(providerName))
+	}
+
+	return strings.Join(names, ", ")
+}
+
 func Project(project *mdbv1.AtlasProject, isGov bool) error {
 	if !isGov && project.Spec.RegionUsageRestrictions != "" && project.Spec.RegionUsageRestrictions != "NONE" {
 		return errors.New("regionUsageRestriction can be used only with Atlas for government")
@@ -180,10 +361,185 @@ func validCloudGovRegion(restriction, region string) error {
 	return nil
 }
 
-func DatabaseUser(_ *mdbv1.AtlasDatabaseUser) error {
+func DatabaseUser(dbUser *mdbv1.AtlasDatabaseUser) error {
+	if err := passwordStore(dbUser); err != nil {
+		return err
+	}
+
+	if err := passwordRotation(dbUser); err != nil {
+		return err
+	}
+
+	if err := databaseUserExpiration(dbUser); err != nil {
+		return err
+	}
+
+	if dbUser.IsAWSIAM() {
+		return awsIAMDatabaseUser(dbUser)
+	}
+
+	if dbUser.IsX509() {
+		return x509DatabaseUser(dbUser)
+	}
+
+	if dbUser.Spec.X509Certificate != nil {
+		return errors.New("x509Certificate can only be set for the MANAGED X.509 users")
+	}
+
+	return nil
+}
+
+// passwordStore checks that a password read from an external secret store isn't kept in a Secret too, neither in the
+// password Secret nor in the connection Secrets, and that the user authenticates with it
+func passwordStore(dbUser *mdbv1.AtlasDatabaseUser) error {
+	if dbUser.Spec.PasswordStore == nil {
+		return nil
+	}
+
+	if dbUser.Spec.PasswordSecret != nil {
+		return errors.New("passwordSecretRef and passwordStoreRef are mutually exclusive")
+	}
+
+	if dbUser.Spec.ConnectionSecretStore == nil {
+		return errors.New("passwordStoreRef requires a connectionSecretStoreRef, so that the password isn't written to the connection Secrets")
+	}
+
+	if dbUser.ExternalAuthMechanism() != "" {
+		return errors.New("the users authenticated outside of MongoDB can't have a passwordStoreRef")
+	}
+
 	return nil
 }
 
+// passwordRotation checks that the rotated password is kept in a Secret and that it is rotated either at an interval
+// or on a cron schedule
+func passwordRotation(dbUser *mdbv1.AtlasDatabaseUser) error {
+	rotation := dbUser.Spec.PasswordRotation
+	if rotation == nil {
+		return nil
+	}
+
+	if dbUser.Spec.PasswordSecret == nil {
+		return errors.New("passwordRotation requires a passwordSecretRef to write the generated passwords to")
+	}
+
+	switch {
+	case rotation.Interval == nil && rotation.Schedule == "":
+		return errors.New("passwordRotation requires either an interval or a schedule")
+	case rotation.Interval != nil && rotation.Schedule != "":
+		return errors.New("passwordRotation can't have both an interval and a schedule")
+	case rotation.Interval != nil && rotation.Interval.Duration < time.Hour:
+		return fmt.Errorf("the interval of the passwordRotation must be at least 1h, got %s", rotation.Interval.Duration)
+	case rotation.Schedule != "":
+		if _, err := timeutil.ParseCron(rotation.Schedule); err != nil {
+			return fmt.Errorf("the schedule of the passwordRotation is invalid: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// databaseUserExpiration checks that the expiration policy applies to a temporary user and that an auto extension
+// pushes the deleteAfterDate back out of the warning period, within the week Atlas accepts
+func databaseUserExpiration(dbUser *mdbv1.AtlasDatabaseUser) error {
+	expiration := dbUser.Spec.Expiration
+	if expiration == nil {
+		return nil
+	}
+
+	if dbUser.Spec.DeleteAfterDate == "" {
+		return errors.New("expiration requires a deleteAfterDate")
+	}
+
+	warnBefore := 24 * time.Hour
+	if expiration.WarnBefore != nil {
+		if expiration.WarnBefore.Duration <= 0 {
+			return fmt.Errorf("the warnBefore of the expiration must be positive, got %s", expiration.WarnBefore.Duration)
+		}
+		warnBefore = expiration.WarnBefore.Duration
+	}
+
+	if expiration.AutoExtend != nil {
+		switch {
+		case expiration.AutoExtend.Duration > 7*24*time.Hour:
+			return fmt.Errorf("the autoExtend of the expiration must be at most one week, got %s", expiration.AutoExtend.Duration)
+		case expiration.AutoExtend.Duration <= warnBefore:
+			return fmt.Errorf("the autoExtend of the expiration must be longer than its warnBefore of %s, got %s", warnBefore, expiration.AutoExtend.Duration)
+		}
+	}
+
+	if expiration.ExtendUntil != "" {
+		if _, err := timeutil.ParseISO8601(expiration.ExtendUntil); err != nil {
+			return fmt.Errorf("invalid extendUntil: %s. value should follow ISO8601 format", expiration.ExtendUntil)
+		}
+	}
+
+	return nil
+}
+
+// x509DatabaseUser checks that a user authenticated with an X.509 certificate has no other means of authentication
+func x509DatabaseUser(dbUser *mdbv1.AtlasDatabaseUser) error {
+	var err error
+
+	if dbUser.Spec.DatabaseName != mdbv1.ExternalDatabaseName {
+		err = errors.Join(err, fmt.Errorf("the databaseName of an X.509 user must be %s", mdbv1.ExternalDatabaseName))
+	}
+
+	if dbUser.Spec.PasswordSecret != nil {
+		err = errors.Join(err, errors.New("an X.509 user can't have a passwordSecretRef"))
+	}
+
+	if dbUser.Spec.OIDCAuthType != "" && dbUser.Spec.OIDCAuthType != "NONE" {
+		err = errors.Join(err, errors.New("an X.509 user can't use OIDC authentication"))
+	}
+
+	if dbUser.Spec.X509Certificate != nil && dbUser.Spec.X509Type != mdbv1.X509TypeManaged {
+		err = errors.Join(err, errors.New("x509Certificate can only be set for the MANAGED X.509 users"))
+	}
+
+	if dbUser.Spec.X509Type == mdbv1.X509TypeManaged {
+		// the certificates would be renewed by every reconciliation otherwise, a month lasting 28 days at least
+		months, renewBefore := dbUser.X509CertificateSettings()
+		if validity := time.Duration(months) * 28 * 24 * time.Hour; renewBefore < 0 || renewBefore >= validity {
+			err = errors.Join(err, fmt.Errorf("the renewBefore of the x509Certificate must be positive and shorter than the %d months validity of the certificates, got %s", months, renewBefore))
+		}
+	}
+
+	return err
+}
+
+// awsIAMDatabaseUser checks that a user authenticated with AWS IAM is named after the ARN of an IAM user or role, and
+// has no other means of authentication
+func awsIAMDatabaseUser(dbUser *mdbv1.AtlasDatabaseUser) error {
+	var err error
+
+	if dbUser.Spec.DatabaseName != mdbv1.ExternalDatabaseName {
+		err = errors.Join(err, fmt.Errorf("the databaseName of an AWS IAM user must be %s", mdbv1.ExternalDatabaseName))
+	}
+
+	if dbUser.Spec.PasswordSecret != nil {
+		err = errors.Join(err, errors.New("an AWS IAM user can't have a passwordSecretRef"))
+	}
+
+	if dbUser.Spec.X509Type != "" && dbUser.Spec.X509Type != "NONE" {
+		err = errors.Join(err, errors.New("an AWS IAM user can't use X.509 authentication"))
+	}
+
+	if dbUser.Spec.OIDCAuthType != "" && dbUser.Spec.OIDCAuthType != "NONE" {
+		err = errors.Join(err, errors.New("an AWS IAM user can't use OIDC authentication"))
+	}
+
+	resource := ":user/"
+	if dbUser.Spec.AWSIAMType == mdbv1.AWSIAMTypeRole {
+		resource = ":role/"
+	}
+	if !strings.HasPrefix(dbUser.Spec.Username, "arn:aws") || !strings.Contains(dbUser.Spec.Username, resource) {
+		err = errors.Join(err, fmt.Errorf("the username of an AWS IAM %s must be its ARN, got %s", strings.ToLower(dbUser.Spec.AWSIAMType), dbUser.Spec.Username))
+	}
+
+	return err
+}
+
 func BackupSchedule(bSchedule *mdbv1.AtlasBackupSchedule, deployment *mdbv1.AtlasDeployment) error {
 	var err error
 
@@ -191,6 +547,12 @@ func BackupSchedule(bSchedule *mdbv1.AtlasBackupSchedule, deployment *mdbv1.Atla
 		err = errors.Join(err, errors.New("you must specify export policy when auto export is enabled"))
 	}
 
+	if bSchedule.Spec.TimeZone != "" {
+		if _, tzErr := time.LoadLocation(bSchedule.Spec.TimeZone); tzErr != nil {
+			err = errors.Join(err, fmt.Errorf("unknown timeZone %q, expected an IANA time zone name such as Europe/Paris", bSchedule.Spec.TimeZone))
+		}
+	}
+
 	replicaSets := map[string]struct{}{}
 	if deployment.Status.ReplicaSets != nil {
 		for _, replicaSet := range deployment.Status.ReplicaSets {
@@ -219,6 +581,88 @@ func BackupSchedule(bSchedule *mdbv1.AtlasBackupSchedule, deployment *mdbv1.Atla
 	return err
 }
 
+// BackupScheduleRestoreWindow checks that the continuous cloud backup restore window of the schedule is covered by
+// the snapshots of its policy: it can't exceed the retention of the hourly snapshots, or of the daily ones when the
+// policy has no hourly item
+func BackupScheduleRestoreWindow(bSchedule *mdbv1.AtlasBackupSchedule, bPolicy *mdbv1.AtlasBackupPolicy) error {
+	restoreWindowDays := bSchedule.Spec.RestoreWindowDays
+	if restoreWindowDays == 0 {
+		return nil
+	}
+	if restoreWindowDays < 0 {
+		return fmt.Errorf("restoreWindowDays must be positive, got %d", restoreWindowDays)
+	}
+
+	for _, frequencyType := range []string{"hourly", "daily"} {
+		for _, item := range bPolicy.Spec.Items {
+			if !strings.EqualFold(item.FrequencyType, frequencyType) {
+				continue
+			}
+
+			retentionDays := backupRetentionDays(item)
+			if restoreWindowDays > retentionDays {
+				return fmt.Errorf("restoreWindowDays %d exceeds the retention of the %s snapshots of the backup policy of %d days", restoreWindowDays, frequencyType, retentionDays)
+			}
+
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// backupRetentionDays returns the retention of the snapshots of the policy item in days, a month counting 30 days
+func backupRetentionDays(item mdbv1.AtlasBackupPolicyItem) int64 {
+	switch strings.ToLower(item.RetentionUnit) {
+	case "weeks":
+		return int64(item.RetentionValue) * 7
+	case "months":
+		return int64(item.RetentionValue) * 30
+	}
+
+	return int64(item.RetentionValue)
+}
+
+func SearchIndex(index *mdbv1.AtlasSearchIndex) error {
+	spec := index.Spec
+	if !index.IsVectorSearch() {
+		if spec.Mappings == nil {
+			return errors.New("the mappings are required by the search indexes")
+		}
+		if len(spec.Fields) > 0 {
+			return errors.New("the fields can only be set on the vectorSearch indexes, use the mappings instead")
+		}
+		return nil
+	}
+
+	var err error
+	if spec.Mappings != nil || len(spec.Analyzers) > 0 || len(spec.Synonyms) > 0 || spec.StoredSource != nil ||
+		spec.Analyzer != "" || spec.SearchAnalyzer != "" {
+		err = errors.Join(err, errors.New("the vectorSearch indexes only support fields"))
+	}
+
+	vectorFields := 0
+	for position, field := range spec.Fields {
+		if field.Type != mdbv1.VectorSearchFieldTypeVector {
+			if field.NumDimensions != 0 || field.Similarity != "" || field.Quantization != "" {
+				err = errors.Join(err, fmt.Errorf("field at position %d: the filter fields don't support dimensions, similarity nor quantization", position))
+			}
+			continue
+		}
+
+		vectorFields++
+		if field.NumDimensions == 0 || field.Similarity == "" {
+			err = errors.Join(err, fmt.Errorf("field at position %d: the vector fields require the number of dimensions and the similarity", position))
+		}
+	}
+
+	if vectorFields == 0 {
+		err = errors.Join(err, errors.New("the vectorSearch indexes require at least one vector field"))
+	}
+
+	return err
+}
+
 func getNonNilCount(values ...interface{}) int {
 	nonNilCount := 0
 	for _, v := range values {
@@ -327,6 +771,36 @@ func instanceSizeRangeForAdvancedDeployment(replicationSpecs []*mdbv1.AdvancedRe
 	return err
 }
 
+// diskSizeForAdvancedDeployment checks the disk size against the maximum of the instance sizes on their cloud provider.
+// The CRD schema enforces it at admission time as well, it's checked again for the resources stored before the rule
+func diskSizeForAdvancedDeployment(spec *mdbv1.AdvancedDeploymentSpec) error {
+	if spec.DiskSizeGB == nil {
+		return nil
+	}
+
+	for _, replicationSpec := range spec.ReplicationSpecs {
+		if replicationSpec == nil {
+			continue
+		}
+		for _, regionSpec := range replicationSpec.RegionConfigs {
+			if regionSpec == nil {
+				continue
+			}
+			for _, specs := range []*mdbv1.Specs{regionSpec.ElectableSpecs, regionSpec.ReadOnlySpecs, regionSpec.AnalyticsSpecs} {
+				if specs == nil {
+					continue
+				}
+				maxSize, ok := provider.MaxDiskSizeGB(provider.ProviderName(regionSpec.ProviderName), specs.InstanceSize)
+				if ok && *spec.DiskSizeGB > maxSize {
+					return fmt.Errorf("diskSizeGB %d exceeds the maximum disk size of %d GB of the instance size %s on %s", *spec.DiskSizeGB, maxSize, specs.InstanceSize, regionSpec.ProviderName)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func advancedInstanceSizeInRange(currentInstanceSize, minInstanceSize, maxInstanceSize string) error {
 	minSize, err := NewFromInstanceSizeName(minInstanceSize)
 	if err != nil {
@@ -412,7 +886,7 @@ func projectIPAccessList(ipAccessList []project.IPAccessList) error {
 				err = errors.Join(err, errors.New("don't set cidrBlock or ipAddress when configuring awsSecurityGroup"))
 			}
 
-			reg := regexp.MustCompile("^([0-9]*/)?sg-([0-9]*)")
+			reg := regexp.MustCompile("^([0-9]+/)?sg-[0-9a-f]+$")
 			if !reg.MatchString(item.AwsSecurityGroup) {
 				err = errors.Join(err, fmt.Errorf("invalid awsSecurityGroup: %s", item.AwsSecurityGroup))
 			}
@@ -2,12 +2,16 @@ package validate
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
 	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/provider"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
 )
 
@@ -159,6 +163,35 @@ func TestClusterValidation(t *testing.T) {
 				assert.Error(t, DeploymentSpec(&spec, false, "NONE"))
 			})
 		})
+		t.Run("config server management mode for a replica set", func(t *testing.T) {
+			spec := mdbv1.AtlasDeploymentSpec{
+				DeploymentSpec: &mdbv1.AdvancedDeploymentSpec{
+					ClusterType:                string(mdbv1.TypeReplicaSet),
+					ConfigServerManagementMode: mdbv1.ConfigServerManagementModeAtlasManaged,
+				},
+			}
+			assert.ErrorContains(t, DeploymentSpec(&spec, false, "NONE"), "configServerManagementMode can only be set for SHARDED or GEOSHARDED deployments")
+		})
+		t.Run("major version pinned with the continuous release system", func(t *testing.T) {
+			spec := mdbv1.AtlasDeploymentSpec{
+				DeploymentSpec: &mdbv1.AdvancedDeploymentSpec{
+					MongoDBMajorVersion:  "7.0",
+					VersionReleaseSystem: mdbv1.VersionReleaseSystemContinuous,
+				},
+			}
+			assert.ErrorContains(t, DeploymentSpec(&spec, false, "NONE"), "mongoDBMajorVersion can't be set with the CONTINUOUS version release system")
+		})
+		t.Run("disk size above the maximum of the instance size", func(t *testing.T) {
+			spec := mdbv1.AtlasDeploymentSpec{
+				DeploymentSpec: &mdbv1.AdvancedDeploymentSpec{
+					DiskSizeGB: pointer.MakePtr(256),
+					ReplicationSpecs: []*mdbv1.AdvancedReplicationSpec{{
+						RegionConfigs: []*mdbv1.AdvancedRegionConfig{{ProviderName: "AWS", ElectableSpecs: &mdbv1.Specs{InstanceSize: "M10"}}},
+					}},
+				},
+			}
+			assert.ErrorContains(t, DeploymentSpec(&spec, false, "NONE"), "diskSizeGB 256 exceeds the maximum disk size of 128 GB of the instance size M10 on AWS")
+		})
 	})
 	t.Run("Valid cluster specs", func(t *testing.T) {
 		t.Run("Advanced cluster spec specified", func(t *testing.T) {
@@ -166,6 +199,24 @@ func TestClusterValidation(t *testing.T) {
 			assert.NoError(t, DeploymentSpec(&spec, false, "NONE"))
 			assert.Nil(t, DeploymentSpec(&spec, false, "NONE"))
 		})
+		t.Run("Major version pinned with the LTS release system", func(t *testing.T) {
+			spec := mdbv1.AtlasDeploymentSpec{
+				DeploymentSpec: &mdbv1.AdvancedDeploymentSpec{
+					MongoDBMajorVersion:  "7.0",
+					VersionReleaseSystem: mdbv1.VersionReleaseSystemLTS,
+				},
+			}
+			assert.NoError(t, DeploymentSpec(&spec, false, "NONE"))
+		})
+		t.Run("Geo-sharded cluster with a config server management mode", func(t *testing.T) {
+			spec := mdbv1.AtlasDeploymentSpec{
+				DeploymentSpec: &mdbv1.AdvancedDeploymentSpec{
+					ClusterType:                string(mdbv1.TypeGeoSharded),
+					ConfigServerManagementMode: mdbv1.ConfigServerManagementModeAtlasManaged,
+				},
+			}
+			assert.NoError(t, DeploymentSpec(&spec, false, "NONE"))
+		})
 		t.Run("Advanced cluster with replication config", func(t *testing.T) {
 			spec := mdbv1.AtlasDeploymentSpec{
 				DeploymentSpec: &mdbv1.AdvancedDeploymentSpec{
@@ -655,6 +706,54 @@ func TestBackupScheduleValidation(t *testing.T) {
 	})
 }
 
+func TestBackupScheduleTimeZoneValidation(t *testing.T) {
+	deployment := &mdbv1.AtlasDeployment{}
+
+	t.Run("should accept an IANA time zone", func(t *testing.T) {
+		bSchedule := &mdbv1.AtlasBackupSchedule{Spec: mdbv1.AtlasBackupScheduleSpec{TimeZone: "America/New_York"}}
+
+		assert.NoError(t, BackupSchedule(bSchedule, deployment))
+	})
+
+	t.Run("should refuse an unknown time zone", func(t *testing.T) {
+		bSchedule := &mdbv1.AtlasBackupSchedule{Spec: mdbv1.AtlasBackupScheduleSpec{TimeZone: "Paris"}}
+
+		assert.ErrorContains(t, BackupSchedule(bSchedule, deployment), `unknown timeZone "Paris"`)
+	})
+}
+
+func TestBackupScheduleRestoreWindowValidation(t *testing.T) {
+	schedule := func(restoreWindowDays int64) *mdbv1.AtlasBackupSchedule {
+		return &mdbv1.AtlasBackupSchedule{Spec: mdbv1.AtlasBackupScheduleSpec{RestoreWindowDays: restoreWindowDays}}
+	}
+	policy := func(items ...mdbv1.AtlasBackupPolicyItem) *mdbv1.AtlasBackupPolicy {
+		return &mdbv1.AtlasBackupPolicy{Spec: mdbv1.AtlasBackupPolicySpec{Items: items}}
+	}
+	hourly := mdbv1.AtlasBackupPolicyItem{FrequencyType: "hourly", FrequencyInterval: 6, RetentionUnit: "days", RetentionValue: 2}
+	daily := mdbv1.AtlasBackupPolicyItem{FrequencyType: "daily", FrequencyInterval: 1, RetentionUnit: "weeks", RetentionValue: 1}
+
+	t.Run("should accept a restore window covered by the hourly snapshots", func(t *testing.T) {
+		assert.NoError(t, BackupScheduleRestoreWindow(schedule(2), policy(daily, hourly)))
+	})
+
+	t.Run("should refuse a restore window beyond the hourly snapshots", func(t *testing.T) {
+		assert.EqualError(
+			t,
+			BackupScheduleRestoreWindow(schedule(3), policy(daily, hourly)),
+			"restoreWindowDays 3 exceeds the retention of the hourly snapshots of the backup policy of 2 days",
+		)
+	})
+
+	t.Run("should compare with the daily snapshots without hourly ones", func(t *testing.T) {
+		assert.NoError(t, BackupScheduleRestoreWindow(schedule(7), policy(daily)))
+		assert.Error(t, BackupScheduleRestoreWindow(schedule(8), policy(daily)))
+	})
+
+	t.Run("should refuse a negative restore window", func(t *testing.T) {
+		assert.Error(t, BackupScheduleRestoreWindow(schedule(-1), policy(daily)))
+	})
+}
+
 func TestProjectIpAccessList(t *testing.T) {
 	t.Run("should return no error for empty list", func(t *testing.T) {
 		assert.NoError(t, projectIPAccessList([]project.IPAccessList{}))
@@ -734,6 +833,14 @@ func TestProjectIpAccessList(t *testing.T) {
 				},
 				err: "invalid awsSecurityGroup: invalid0129d834cbf03bc6d",
 			},
+			"for awsSecurityGroup with trailing characters": {
+				ipAccessList: []project.IPAccessList{
+					{
+						AwsSecurityGroup: "sg-0129d834cbf03bc6d/extra",
+					},
+				},
+				err: "invalid awsSecurityGroup: sg-0129d834cbf03bc6d/extra",
+			},
 			"for DeleteAfterDate": {
 				ipAccessList: []project.IPAccessList{
 					{
@@ -753,6 +860,14 @@ func TestProjectIpAccessList(t *testing.T) {
 	})
 }
 
+func TestProjectIpAccessListAWSSecurityGroup(t *testing.T) {
+	for _, sg := range []string{"sg-0129d834cbf03bc6d", "123456789012/sg-0129d834cbf03bc6d"} {
+		t.Run(sg, func(t *testing.T) {
+			assert.NoError(t, projectIPAccessList([]project.IPAccessList{{AwsSecurityGroup: sg}}))
+		})
+	}
+}
+
 func TestProjectAlertConfigs(t *testing.T) {
 	t.Run("should not fail on duplications when alert config is disabled", func(t *testing.T) {
 		prj := mdbv1.AtlasProject{
@@ -1125,3 +1240,400 @@ func TestAutoscalingForAdvancedDeployment(t *testing.T) {
 		assert.EqualError(t, autoscalingForAdvancedDeployment(replicationSpecs), "autoscaling must be the same for all regions and across all replication specs for advanced deployment")
 	})
 }
+
+func TestDeploymentRegions(t *testing.T) {
+	advancedDeployment := func(providerName, backingProviderName, regionName string) *mdbv1.AtlasDeploymentSpec {
+		return &mdbv1.AtlasDeploymentSpec{
+			DeploymentSpec: &mdbv1.AdvancedDeploymentSpec{
+				ReplicationSpecs: []*mdbv1.AdvancedReplicationSpec{
+					{
+						RegionConfigs: []*mdbv1.AdvancedRegionConfig{
+							{
+								ProviderName:        providerName,
+								BackingProviderName: backingProviderName,
+								RegionName:          regionName,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	serverlessInstance := func(backingProviderName, regionName string) *mdbv1.AtlasDeploymentSpec {
+		return &mdbv1.AtlasDeploymentSpec{
+			ServerlessSpec: &mdbv1.ServerlessSpec{
+				ProviderSettings: &mdbv1.ProviderSettingsSpec{
+					ProviderName:        provider.ProviderServerless,
+					BackingProviderName: backingProviderName,
+					RegionName:          regionName,
+				},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		deployment  *mdbv1.AtlasDeploymentSpec
+		expectedErr string
+	}{
+		"valid AWS region": {
+			deployment: advancedDeployment("AWS", "", "US_EAST_1"),
+		},
+		"valid GCP region": {
+			deployment: advancedDeployment("GCP", "", "CENTRAL_US"),
+		},
+		"valid tenant region": {
+			deployment: advancedDeployment("TENANT", "AZURE", "EUROPE_NORTH"),
+		},
+		"valid serverless region": {
+			deployment: serverlessInstance("AWS", "US_EAST_1"),
+		},
+		"region is not set": {
+			deployment: advancedDeployment("AWS", "", ""),
+		},
+		"AWS native region name": {
+			deployment:  advancedDeployment("AWS", "", "us-east-1"),
+			expectedErr: "region us-east-1 is the AWS name of the region, use the Atlas region name US_EAST_1 instead",
+		},
+		"Azure native region name": {
+			deployment:  advancedDeployment("AZURE", "", "westeurope"),
+			expectedErr: "region westeurope is the AZURE name of the region, use the Atlas region name EUROPE_WEST instead",
+		},
+		"tenant with GCP native region name": {
+			deployment:  advancedDeployment("TENANT", "GCP", "us-central1"),
+			expectedErr: "region us-central1 is the GCP name of the region, use the Atlas region name CENTRAL_US instead",
+		},
+		"serverless with AWS native region name": {
+			deployment:  serverlessInstance("AWS", "eu-west-1"),
+			expectedErr: "region eu-west-1 is the AWS name of the region, use the Atlas region name EU_WEST_1 instead",
+		},
+		"region of another provider": {
+			deployment:  advancedDeployment("AWS", "", "EASTERN_US"),
+			expectedErr: "region EASTERN_US is not available on AWS, it is a region of GCP",
+		},
+		"unknown region": {
+			deployment: advancedDeployment("GCP", "", "EU_EAST_1"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := deploymentRegions(tt.deployment)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+
+	t.Run("unknown regions are warnings", func(t *testing.T) {
+		assert.Equal(t, []string{"region EU_EAST_1 is not a known Atlas region of GCP"}, DeploymentRegionWarnings(advancedDeployment("GCP", "", "EU_EAST_1")))
+		assert.Empty(t, DeploymentRegionWarnings(advancedDeployment("AWS", "", "us-east-1")))
+		assert.Empty(t, DeploymentRegionWarnings(advancedDeployment("AWS", "", "US_EAST_1")))
+	})
+
+	t.Run("regions are not checked for Atlas for government", func(t *testing.T) {
+		assert.NoError(t, DeploymentSpec(advancedDeployment("AWS", "", "us-gov-east-1"), true, "GOV_REGIONS_ONLY"))
+		assert.ErrorContains(t, DeploymentSpec(advancedDeployment("AWS", "", "us-gov-east-1"), false, "NONE"), "use the Atlas region name US_GOV_EAST_1 instead")
+	})
+}
+
+func TestSearchIndexValidation(t *testing.T) {
+	vectorField := mdbv1.VectorSearchField{Type: mdbv1.VectorSearchFieldTypeVector, Path: "embedding", NumDimensions: 1536, Similarity: "cosine"}
+
+	t.Run("search index requires mappings", func(t *testing.T) {
+		index := &mdbv1.AtlasSearchIndex{}
+		assert.Error(t, SearchIndex(index))
+
+		index.Spec.Mappings = &mdbv1.SearchIndexMappings{Dynamic: true}
+		assert.NoError(t, SearchIndex(index))
+	})
+
+	t.Run("search index doesn't support vector fields", func(t *testing.T) {
+		index := &mdbv1.AtlasSearchIndex{Spec: mdbv1.AtlasSearchIndexSpec{
+			Mappings: &mdbv1.SearchIndexMappings{Dynamic: true},
+			Fields:   []mdbv1.VectorSearchField{vectorField},
+		}}
+		assert.Error(t, SearchIndex(index))
+	})
+
+	t.Run("valid vectorSearch index", func(t *testing.T) {
+		index := &mdbv1.AtlasSearchIndex{Spec: mdbv1.AtlasSearchIndexSpec{
+			Type:   mdbv1.SearchIndexTypeVectorSearch,
+			Fields: []mdbv1.VectorSearchField{vectorField, {Type: mdbv1.VectorSearchFieldTypeFilter, Path: "category"}},
+		}}
+		assert.NoError(t, SearchIndex(index))
+	})
+
+	t.Run("vectorSearch index doesn't support mappings", func(t *testing.T) {
+		index := &mdbv1.AtlasSearchIndex{Spec: mdbv1.AtlasSearchIndexSpec{
+			Type:     mdbv1.SearchIndexTypeVectorSearch,
+			Mappings: &mdbv1.SearchIndexMappings{Dynamic: true},
+			Fields:   []mdbv1.VectorSearchField{vectorField},
+		}}
+		assert.Error(t, SearchIndex(index))
+	})
+
+	t.Run("vector field requires dimensions and similarity", func(t *testing.T) {
+		index := &mdbv1.AtlasSearchIndex{Spec: mdbv1.AtlasSearchIndexSpec{
+			Type:   mdbv1.SearchIndexTypeVectorSearch,
+			Fields: []mdbv1.VectorSearchField{{Type: mdbv1.VectorSearchFieldTypeVector, Path: "embedding"}},
+		}}
+		assert.Error(t, SearchIndex(index))
+	})
+
+	t.Run("filter field doesn't support similarity", func(t *testing.T) {
+		index := &mdbv1.AtlasSearchIndex{Spec: mdbv1.AtlasSearchIndexSpec{
+			Type:   mdbv1.SearchIndexTypeVectorSearch,
+			Fields: []mdbv1.VectorSearchField{vectorField, {Type: mdbv1.VectorSearchFieldTypeFilter, Path: "category", Similarity: "cosine"}},
+		}}
+		assert.Error(t, SearchIndex(index))
+	})
+}
+
+func TestDatabaseUserValidation(t *testing.T) {
+	iamUser := func(awsIAMType, username string) *mdbv1.AtlasDatabaseUser {
+		return &mdbv1.AtlasDatabaseUser{Spec: mdbv1.AtlasDatabaseUserSpec{
+			AWSIAMType:   awsIAMType,
+			DatabaseName: mdbv1.ExternalDatabaseName,
+			Username:     username,
+		}}
+	}
+
+	t.Run("should accept the users authenticated with AWS IAM", func(t *testing.T) {
+		assert.NoError(t, DatabaseUser(iamUser(mdbv1.AWSIAMTypeUser, "arn:aws:iam::123456789012:user/workload")))
+		assert.NoError(t, DatabaseUser(iamUser(mdbv1.AWSIAMTypeRole, "arn:aws:iam::123456789012:role/workload")))
+	})
+
+	t.Run("should require the ARN of the IAM user or role as username", func(t *testing.T) {
+		assert.ErrorContains(t, DatabaseUser(iamUser(mdbv1.AWSIAMTypeUser, "workload")), "the username of an AWS IAM user must be its ARN")
+		assert.ErrorContains(t, DatabaseUser(iamUser(mdbv1.AWSIAMTypeRole, "arn:aws:iam::123456789012:user/workload")), "the username of an AWS IAM role must be its ARN")
+	})
+
+	t.Run("should refuse the other means of authentication", func(t *testing.T) {
+		user := iamUser(mdbv1.AWSIAMTypeRole, "arn:aws:iam::123456789012:role/workload")
+		user.Spec.DatabaseName = "admin"
+		user.Spec.PasswordSecret = &common.ResourceRef{Name: "password"}
+		user.Spec.X509Type = "MANAGED"
+
+		err := DatabaseUser(user)
+		assert.ErrorContains(t, err, "the databaseName of an AWS IAM user must be $external")
+		assert.ErrorContains(t, err, "an AWS IAM user can't have a passwordSecretRef")
+		assert.ErrorContains(t, err, "an AWS IAM user can't use X.509 authentication")
+	})
+
+	t.Run("should accept the users authenticated with X.509 certificates", func(t *testing.T) {
+		user := &mdbv1.AtlasDatabaseUser{Spec: mdbv1.AtlasDatabaseUserSpec{
+			X509Type:        mdbv1.X509TypeManaged,
+			DatabaseName:    mdbv1.ExternalDatabaseName,
+			Username:        "CN=app",
+			X509Certificate: &mdbv1.X509CertificateSpec{MonthsUntilExpiration: 6},
+		}}
+
+		assert.NoError(t, DatabaseUser(user))
+	})
+
+	t.Run("should refuse a renewal of the certificates not shorter than their validity", func(t *testing.T) {
+		user := &mdbv1.AtlasDatabaseUser{Spec: mdbv1.AtlasDatabaseUserSpec{
+			X509Type:        mdbv1.X509TypeManaged,
+			DatabaseName:    mdbv1.ExternalDatabaseName,
+			Username:        "CN=app",
+			X509Certificate: &mdbv1.X509CertificateSpec{MonthsUntilExpiration: 1, RenewBefore: &metav1.Duration{Duration: 720 * time.Hour}},
+		}}
+
+		assert.ErrorContains(t, DatabaseUser(user), "the renewBefore of the x509Certificate must be positive and shorter than the 1 months validity of the certificates, got 720h0m0s")
+
+		user.Spec.X509Certificate.RenewBefore = &metav1.Duration{Duration: 7 * 24 * time.Hour}
+		assert.NoError(t, DatabaseUser(user))
+	})
+
+	t.Run("should refuse the password and the certificate settings of the CUSTOMER X.509 users", func(t *testing.T) {
+		user := &mdbv1.AtlasDatabaseUser{Spec: mdbv1.AtlasDatabaseUserSpec{
+			X509Type:        mdbv1.X509TypeCustomer,
+			DatabaseName:    "admin",
+			Username:        "CN=app",
+			PasswordSecret:  &common.ResourceRef{Name: "password"},
+			X509Certificate: &mdbv1.X509CertificateSpec{},
+		}}
+
+		err := DatabaseUser(user)
+		assert.ErrorContains(t, err, "the databaseName of an X.509 user must be $external")
+		assert.ErrorContains(t, err, "an X.509 user can't have a passwordSecretRef")
+		assert.ErrorContains(t, err, "x509Certificate can only be set for the MANAGED X.509 users")
+	})
+
+	t.Run("should ignore the users not authenticated with AWS IAM", func(t *testing.T) {
+		assert.NoError(t, DatabaseUser(&mdbv1.AtlasDatabaseUser{Spec: mdbv1.AtlasDatabaseUserSpec{AWSIAMType: mdbv1.AWSIAMTypeNone, Username: "user"}}))
+	})
+}
+
+func TestPasswordStoreValidation(t *testing.T) {
+	storedUser := func() *mdbv1.AtlasDatabaseUser {
+		return &mdbv1.AtlasDatabaseUser{Spec: mdbv1.AtlasDatabaseUserSpec{
+			Username:              "user",
+			PasswordStore:         &mdbv1.PasswordStoreRef{Provider: "vault", Path: "team/user"},
+			ConnectionSecretStore: &mdbv1.ConnectionSecretStoreRef{Provider: "vault", Path: "connections/user"},
+		}}
+	}
+
+	t.Run("should accept a password read from a secret store", func(t *testing.T) {
+		assert.NoError(t, DatabaseUser(storedUser()))
+	})
+
+	t.Run("should refuse the connection Secrets", func(t *testing.T) {
+		user := storedUser()
+		user.Spec.ConnectionSecretStore = nil
+
+		assert.ErrorContains(t, DatabaseUser(user), "passwordStoreRef requires a connectionSecretStoreRef")
+	})
+
+	t.Run("should refuse a password Secret as well", func(t *testing.T) {
+		user := storedUser()
+		user.Spec.PasswordSecret = &common.ResourceRef{Name: "password"}
+
+		assert.ErrorContains(t, DatabaseUser(user), "passwordSecretRef and passwordStoreRef are mutually exclusive")
+	})
+
+	t.Run("should refuse the users authenticated outside of MongoDB", func(t *testing.T) {
+		user := storedUser()
+		user.Spec.X509Type = mdbv1.X509TypeManaged
+
+		assert.ErrorContains(t, DatabaseUser(user), "can't have a passwordStoreRef")
+	})
+}
+
+func TestPasswordRotationValidation(t *testing.T) {
+	rotatedUser := func(rotation mdbv1.PasswordRotationSpec) *mdbv1.AtlasDatabaseUser {
+		return &mdbv1.AtlasDatabaseUser{Spec: mdbv1.AtlasDatabaseUserSpec{
+			Username:         "user",
+			PasswordSecret:   &common.ResourceRef{Name: "password"},
+			PasswordRotation: &rotation,
+		}}
+	}
+
+	t.Run("should accept a rotation at an interval or on a schedule", func(t *testing.T) {
+		assert.NoError(t, DatabaseUser(rotatedUser(mdbv1.PasswordRotationSpec{Interval: &metav1.Duration{Duration: 720 * time.Hour}})))
+		assert.NoError(t, DatabaseUser(rotatedUser(mdbv1.PasswordRotationSpec{Schedule: "0 3 1 * *"})))
+	})
+
+	t.Run("should require a password Secret", func(t *testing.T) {
+		user := rotatedUser(mdbv1.PasswordRotationSpec{Schedule: "0 3 1 * *"})
+		user.Spec.PasswordSecret = nil
+
+		assert.ErrorContains(t, DatabaseUser(user), "passwordRotation requires a passwordSecretRef")
+	})
+
+	t.Run("should require exactly one of interval and schedule", func(t *testing.T) {
+		assert.ErrorContains(t, DatabaseUser(rotatedUser(mdbv1.PasswordRotationSpec{})), "requires either an interval or a schedule")
+		assert.ErrorContains(
+			t,
+			DatabaseUser(rotatedUser(mdbv1.PasswordRotationSpec{Interval: &metav1.Duration{Duration: time.Hour}, Schedule: "0 3 1 * *"})),
+			"can't have both an interval and a schedule",
+		)
+	})
+
+	t.Run("should refuse an interval under one hour", func(t *testing.T) {
+		assert.ErrorContains(
+			t,
+			DatabaseUser(rotatedUser(mdbv1.PasswordRotationSpec{Interval: &metav1.Duration{Duration: 10 * time.Minute}})),
+			"the interval of the passwordRotation must be at least 1h",
+		)
+	})
+
+	t.Run("should refuse an invalid schedule", func(t *testing.T) {
+		assert.ErrorContains(t, DatabaseUser(rotatedUser(mdbv1.PasswordRotationSpec{Schedule: "every day"})), "the schedule of the passwordRotation is invalid")
+	})
+}
+
+func TestDatabaseUserExpirationValidation(t *testing.T) {
+	expiringUser := func(expiration mdbv1.DatabaseUserExpirationSpec) *mdbv1.AtlasDatabaseUser {
+		return &mdbv1.AtlasDatabaseUser{Spec: mdbv1.AtlasDatabaseUserSpec{
+			Username:        "user",
+			DeleteAfterDate: "2024-01-10T10:00:00Z",
+			Expiration:      &expiration,
+		}}
+	}
+
+	t.Run("should accept an auto extension", func(t *testing.T) {
+		assert.NoError(t, DatabaseUser(expiringUser(mdbv1.DatabaseUserExpirationSpec{
+			WarnBefore:  &metav1.Duration{Duration: 12 * time.Hour},
+			AutoExtend:  &metav1.Duration{Duration: 72 * time.Hour},
+			ExtendUntil: "2024-02-01T00:00:00Z",
+		})))
+	})
+
+	t.Run("should require a deleteAfterDate", func(t *testing.T) {
+		user := expiringUser(mdbv1.DatabaseUserExpirationSpec{})
+		user.Spec.DeleteAfterDate = ""
+
+		assert.ErrorContains(t, DatabaseUser(user), "expiration requires a deleteAfterDate")
+	})
+
+	t.Run("should refuse an auto extension beyond one week", func(t *testing.T) {
+		assert.ErrorContains(
+			t,
+			DatabaseUser(expiringUser(mdbv1.DatabaseUserExpirationSpec{AutoExtend: &metav1.Duration{Duration: 8 * 24 * time.Hour}})),
+			"must be at most one week",
+		)
+	})
+
+	t.Run("should refuse an auto extension within the warning period", func(t *testing.T) {
+		assert.ErrorContains(
+			t,
+			DatabaseUser(expiringUser(mdbv1.DatabaseUserExpirationSpec{AutoExtend: &metav1.Duration{Duration: 12 * time.Hour}})),
+			"must be longer than its warnBefore of 24h0m0s",
+		)
+	})
+
+	t.Run("should refuse an invalid extendUntil", func(t *testing.T) {
+		assert.ErrorContains(
+			t,
+			DatabaseUser(expiringUser(mdbv1.DatabaseUserExpirationSpec{ExtendUntil: "tomorrow"})),
+			"invalid extendUntil",
+		)
+	})
+}
+
+func TestProcessArgsValidation(t *testing.T) {
+	t.Run("should accept the values supported by Atlas", func(t *testing.T) {
+		args := &mdbv1.ProcessArgs{
+			DefaultReadConcern:               "majority",
+			DefaultWriteConcern:              "2",
+			MinimumEnabledTLSProtocol:        "TLS1_2",
+			JavascriptEnabled:                pointer.MakePtr(false),
+			OplogSizeMB:                      pointer.MakePtr[int64](2048),
+			SampleSizeBIConnector:            pointer.MakePtr[int64](100),
+			SampleRefreshIntervalBIConnector: pointer.MakePtr[int64](0),
+			OplogMinRetentionHours:           "1.5",
+		}
+
+		assert.NoError(t, processArgs(args))
+		assert.NoError(t, processArgs(nil))
+	})
+
+	t.Run("should reject the values not supported by Atlas", func(t *testing.T) {
+		args := &mdbv1.ProcessArgs{
+			DefaultReadConcern:        "linearizable",
+			DefaultWriteConcern:       "all",
+			MinimumEnabledTLSProtocol: "SSL3",
+			OplogSizeMB:               pointer.MakePtr[int64](100),
+			SampleSizeBIConnector:     pointer.MakePtr[int64](-1),
+			OplogMinRetentionHours:    "a day",
+		}
+
+		err := processArgs(args)
+		assert.ErrorContains(t, err, "processArgs.defaultReadConcern must be one of local, available or majority, got linearizable")
+		assert.ErrorContains(t, err, "processArgs.defaultWriteConcern must be majority or a number of nodes, got all")
+		assert.ErrorContains(t, err, "processArgs.minimumEnabledTlsProtocol must be one of TLS1_0, TLS1_1, TLS1_2 or TLS1_3, got SSL3")
+		assert.ErrorContains(t, err, "processArgs.oplogSizeMB must be at least 990, got 100")
+		assert.ErrorContains(t, err, "processArgs.sampleSizeBIConnector can't be negative, got -1")
+		assert.ErrorContains(t, err, "processArgs.oplogMinRetentionHours must be a positive number of hours, got a day")
+	})
+
+	t.Run("should validate the processArgs of a deployment", func(t *testing.T) {
+		deployment := mdbv1.DefaultAWSDeployment("ns", "project")
+		deployment.Spec.ProcessArgs = &mdbv1.ProcessArgs{OplogSizeMB: pointer.MakePtr[int64](100)}
+
+		assert.ErrorContains(t, DeploymentSpec(&deployment.Spec, false, "NONE"), "processArgs.oplogSizeMB must be at least 990")
+	})
+}
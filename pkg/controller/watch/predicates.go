@@ -3,16 +3,25 @@ package watch
 import (
 	"reflect"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
 
+// ReapplyAnnotation forces an immediate full resynchronization of the resource with Atlas when it is set (or its value
+// changes), the Operator removes it once the reconciliation started
+const ReapplyAnnotation = "atlas.mongodb.com/reapply"
+
 // CommonPredicates returns the predicate which filter out the changes done to any field except for spec (e.g. status)
 // Also we should reconcile if finalizers have changed (see https://blog.openshift.com/kubernetes-operators-best-practices/)
+// or if the reapply annotation was set
 func CommonPredicates() predicate.Funcs {
 	return predicate.Funcs{
 		UpdateFunc: func(e event.UpdateEvent) bool {
+			if reapplyRequested(e.ObjectOld, e.ObjectNew) {
+				return true
+			}
 			if e.ObjectOld.GetGeneration() == e.ObjectNew.GetGeneration() && reflect.DeepEqual(e.ObjectNew.GetFinalizers(), e.ObjectOld.GetFinalizers()) {
 				return false
 			}
@@ -21,6 +30,18 @@ func CommonPredicates() predicate.Funcs {
 	}
 }
 
+// reapplyRequested returns true if the reapply annotation was added to the object or its value changed
+func reapplyRequested(oldObject, newObject client.Object) bool {
+	value, ok := newObject.GetAnnotations()[ReapplyAnnotation]
+	if !ok {
+		return false
+	}
+
+	oldValue, oldOk := oldObject.GetAnnotations()[ReapplyAnnotation]
+
+	return !oldOk || oldValue != value
+}
+
 // DeleteOnly returns a predicate that will filter out everything except the Delete event
 func DeleteOnly() predicate.Funcs {
 	return predicate.Funcs{
@@ -49,3 +70,19 @@ func SelectNamespacesPredicate(namespaceMap map[string]bool) predicate.Funcs {
 		return false
 	})
 }
+
+// ExcludeNamespacesPredicate filters out the resources living in any of the given namespaces
+func ExcludeNamespacesPredicate(namespaceMap map[string]bool) predicate.Funcs {
+	return predicate.NewPredicateFuncs(func(object client.Object) bool {
+		return !namespaceMap[object.GetNamespace()]
+	})
+}
+
+// SelectLabelsPredicate filters out the resources which labels don't match the selector.
+// Note, that it's enough to apply it to the primary resources only (the "For" registrations): the resources watched
+// by them are only mapped to the primary resources that were reconciled before.
+func SelectLabelsPredicate(selector labels.Selector) predicate.Funcs {
+	return predicate.NewPredicateFuncs(func(object client.Object) bool {
+		return selector.Matches(labels.Set(object.GetLabels()))
+	})
+}
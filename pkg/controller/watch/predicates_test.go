@@ -0,0 +1,69 @@
+package watch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+)
+
+func TestCommonPredicates(t *testing.T) {
+	p := CommonPredicates()
+	annotated := func(value string) *mdbv1.AtlasProject {
+		project := projectIn("default", nil)
+		project.Annotations = map[string]string{ReapplyAnnotation: value}
+		return project
+	}
+
+	t.Run("should ignore the updates not changing the spec", func(t *testing.T) {
+		assert.False(t, p.Update(event.UpdateEvent{ObjectOld: projectIn("default", nil), ObjectNew: projectIn("default", nil)}))
+	})
+
+	t.Run("should reconcile when the reapply annotation is set", func(t *testing.T) {
+		assert.True(t, p.Update(event.UpdateEvent{ObjectOld: projectIn("default", nil), ObjectNew: annotated("true")}))
+	})
+
+	t.Run("should reconcile when the reapply annotation changes", func(t *testing.T) {
+		assert.True(t, p.Update(event.UpdateEvent{ObjectOld: annotated("1"), ObjectNew: annotated("2")}))
+	})
+
+	t.Run("should ignore the removal or an unchanged reapply annotation", func(t *testing.T) {
+		assert.False(t, p.Update(event.UpdateEvent{ObjectOld: annotated("true"), ObjectNew: projectIn("default", nil)}))
+		assert.False(t, p.Update(event.UpdateEvent{ObjectOld: annotated("true"), ObjectNew: annotated("true")}))
+	})
+}
+
+func TestExcludeNamespacesPredicate(t *testing.T) {
+	p := ExcludeNamespacesPredicate(map[string]bool{"kube-system": true, "sandbox": true})
+
+	assert.True(t, p.Create(event.CreateEvent{Object: projectIn("default", nil)}))
+	assert.False(t, p.Create(event.CreateEvent{Object: projectIn("sandbox", nil)}))
+	assert.False(t, p.Update(event.UpdateEvent{ObjectOld: projectIn("kube-system", nil), ObjectNew: projectIn("kube-system", nil)}))
+	assert.True(t, ExcludeNamespacesPredicate(nil).Create(event.CreateEvent{Object: projectIn("sandbox", nil)}))
+}
+
+func TestSelectLabelsPredicate(t *testing.T) {
+	selector, err := labels.Parse("shard=a,tier!=dev")
+	assert.NoError(t, err)
+	p := SelectLabelsPredicate(selector)
+
+	assert.True(t, p.Create(event.CreateEvent{Object: projectIn("default", map[string]string{"shard": "a"})}))
+	assert.False(t, p.Create(event.CreateEvent{Object: projectIn("default", map[string]string{"shard": "b"})}))
+	assert.False(t, p.Create(event.CreateEvent{Object: projectIn("default", map[string]string{"shard": "a", "tier": "dev"})}))
+	assert.False(t, p.Delete(event.DeleteEvent{Object: projectIn("default", nil)}))
+	assert.True(t, SelectLabelsPredicate(labels.Everything()).Create(event.CreateEvent{Object: projectIn("default", nil)}))
+}
+
+func projectIn(namespace string, objectLabels map[string]string) *mdbv1.AtlasProject {
+	return &mdbv1.AtlasProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "project",
+			Namespace: namespace,
+			Labels:    objectLabels,
+		},
+	}
+}
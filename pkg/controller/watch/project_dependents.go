@@ -0,0 +1,73 @@
+package watch
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+)
+
+// projectDependent is a resource referencing an AtlasProject
+type projectDependent interface {
+	AtlasProjectObjectKey() client.ObjectKey
+}
+
+// NewProjectDependentsHandler enqueues the resources of a kind referencing the AtlasProject of the event. The list is
+// an empty list of that kind, e.g. &AtlasDeploymentList{}
+func NewProjectDependentsHandler(kubeClient client.Client, list client.ObjectList) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		dependents := list.DeepCopyObject().(client.ObjectList)
+		if err := kubeClient.List(ctx, dependents); err != nil {
+			zap.S().Errorf("failed to list the resources depending on the project %s: %s", client.ObjectKeyFromObject(obj), err)
+			return nil
+		}
+
+		items, err := meta.ExtractList(dependents)
+		if err != nil {
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0, len(items))
+		for _, item := range items {
+			dependent, ok := item.(projectDependent)
+			if !ok || dependent.AtlasProjectObjectKey() != client.ObjectKeyFromObject(obj) {
+				continue
+			}
+
+			if object, ok := item.(client.Object); ok {
+				requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(object)})
+			}
+		}
+
+		return requests
+	})
+}
+
+// ProjectOrganizationChanged passes the updates of the AtlasProjects moved to another organization, so that the
+// resources depending on them are validated again with the credentials of the new organization
+func ProjectOrganizationChanged() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldProject, okOld := e.ObjectOld.(*v1.AtlasProject)
+			newProject, okNew := e.ObjectNew.(*v1.AtlasProject)
+
+			return okOld && okNew && oldProject.Status.OrgID != "" && oldProject.Status.OrgID != newProject.Status.OrgID
+		},
+		DeleteFunc: func(event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(event.GenericEvent) bool {
+			return false
+		},
+	}
+}
@@ -0,0 +1,72 @@
+package watch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mdbv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/common"
+)
+
+func TestNewProjectDependentsHandler(t *testing.T) {
+	deployment := func(name, projectName string) *mdbv1.AtlasDeployment {
+		d := &mdbv1.AtlasDeployment{}
+		d.Name = name
+		d.Namespace = "default"
+		d.Spec.Project = common.ResourceRefNamespaced{Name: projectName}
+
+		return d
+	}
+	sch := runtime.NewScheme()
+	require.NoError(t, mdbv1.AddToScheme(sch))
+	kubeClient := fake.NewClientBuilder().
+		WithScheme(sch).
+		WithObjects(deployment("dependent", "project"), deployment("other", "other-project")).
+		Build()
+	project := projectIn("default", nil)
+	project.Name = "project"
+	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	defer queue.ShutDown()
+
+	NewProjectDependentsHandler(kubeClient, &mdbv1.AtlasDeploymentList{}).
+		Update(context.Background(), event.UpdateEvent{ObjectOld: project, ObjectNew: project}, queue)
+
+	require.Equal(t, 1, queue.Len())
+	item, _ := queue.Get()
+	assert.Equal(t, reconcile.Request{NamespacedName: client.ObjectKey{Namespace: "default", Name: "dependent"}}, item)
+}
+
+func TestProjectOrganizationChanged(t *testing.T) {
+	p := ProjectOrganizationChanged()
+	inOrg := func(orgID string) *mdbv1.AtlasProject {
+		project := projectIn("default", nil)
+		project.Status.OrgID = orgID
+		return project
+	}
+
+	t.Run("should pass the projects moved to another organization", func(t *testing.T) {
+		assert.True(t, p.Update(event.UpdateEvent{ObjectOld: inOrg("org-a"), ObjectNew: inOrg("org-b")}))
+	})
+
+	t.Run("should ignore the projects staying in their organization", func(t *testing.T) {
+		assert.False(t, p.Update(event.UpdateEvent{ObjectOld: inOrg("org-a"), ObjectNew: inOrg("org-a")}))
+	})
+
+	t.Run("should ignore the organization recorded for the first time", func(t *testing.T) {
+		assert.False(t, p.Update(event.UpdateEvent{ObjectOld: inOrg(""), ObjectNew: inOrg("org-a")}))
+	})
+
+	t.Run("should ignore the other events", func(t *testing.T) {
+		assert.False(t, p.Create(event.CreateEvent{Object: inOrg("org-a")}))
+		assert.False(t, p.Delete(event.DeleteEvent{Object: inOrg("org-a")}))
+	})
+}
@@ -9,6 +9,7 @@ import (
 
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -40,6 +41,10 @@ func NewSecretHandler(tracked map[WatchedObject]map[client.ObjectKey]bool) *Reso
 	return &ResourcesHandler{ResourceKind: "Secret", TrackedResources: tracked}
 }
 
+func NewConfigMapHandler(tracked map[WatchedObject]map[client.ObjectKey]bool) *ResourcesHandler {
+	return &ResourcesHandler{ResourceKind: "ConfigMap", TrackedResources: tracked}
+}
+
 func NewBackupScheduleHandler(tracked map[WatchedObject]map[client.ObjectKey]bool) *ResourcesHandler {
 	return &ResourcesHandler{ResourceKind: "AtlasBackupSchedule", TrackedResources: tracked}
 }
@@ -77,6 +82,9 @@ func shouldHandleUpdate(e event.UpdateEvent) bool {
 		return !reflect.DeepEqual(v.Data, e.ObjectNew.(*corev1.ConfigMap).Data)
 	case *corev1.Secret:
 		return !reflect.DeepEqual(v.Data, e.ObjectNew.(*corev1.Secret).Data)
+	case *metav1.PartialObjectMetadata:
+		// Objects watched through their metadata only, e.g. secrets, are considered changed on any update
+		return v.ResourceVersion != e.ObjectNew.GetResourceVersion()
 	case *v1.AtlasTeam:
 		return !reflect.DeepEqual(v.Spec, e.ObjectNew.(*v1.AtlasTeam).Spec)
 	case *v1.AtlasBackupSchedule:
@@ -98,6 +98,15 @@ func TestShouldHandleUpdate(t *testing.T) {
 
 		assert.True(t, shouldHandleUpdate(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj}))
 	})
+	t.Run("Update should happen only if the resource version has changed for metadata-only objects", func(t *testing.T) {
+		oldObj := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: "secret", ResourceVersion: "4242"}}
+		resynced := oldObj.DeepCopy()
+		updated := oldObj.DeepCopy()
+		updated.ResourceVersion = "4243"
+
+		assert.False(t, shouldHandleUpdate(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: resynced}))
+		assert.True(t, shouldHandleUpdate(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: updated}))
+	})
 }
 
 func secretForTesting(name string) *corev1.Secret {
@@ -0,0 +1,60 @@
+package workflow
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	"go.mongodb.org/atlas/mongodbatlas"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+// WithAtlasError reports the error code, description and parameters of the error returned by the Atlas Admin API in the
+// condition set from the result, and replaces the raw error embedded in the message with its description.
+// The result is unchanged when the error doesn't come from the Atlas Admin API.
+func (r Result) WithAtlasError(err error) Result {
+	atlasError := atlasErrorDetails(err)
+	if atlasError == nil {
+		return r
+	}
+
+	r.atlasError = atlasError
+	if atlasError.Detail != "" {
+		readable := atlasError.Detail
+		if atlasError.ErrorCode != "" {
+			readable = fmt.Sprintf("%s (%s)", atlasError.Detail, atlasError.ErrorCode)
+		}
+		r.message = strings.Replace(r.message, err.Error(), readable, 1)
+	}
+
+	return r
+}
+
+func atlasErrorDetails(err error) *status.AtlasError {
+	var apiError *mongodbatlas.ErrorResponse
+	if errors.As(err, &apiError) {
+		return &status.AtlasError{
+			HTTPStatus: apiError.HTTPCode,
+			ErrorCode:  apiError.ErrorCode,
+			Detail:     apiError.Detail,
+		}
+	}
+
+	sdkError, ok := admin.AsError(err)
+	if !ok {
+		return nil
+	}
+
+	atlasError := &status.AtlasError{
+		HTTPStatus: sdkError.GetError(),
+		ErrorCode:  sdkError.GetErrorCode(),
+		Detail:     sdkError.GetDetail(),
+	}
+	for _, parameter := range sdkError.GetParameters() {
+		atlasError.Parameters = append(atlasError.Parameters, fmt.Sprint(parameter))
+	}
+
+	return atlasError
+}
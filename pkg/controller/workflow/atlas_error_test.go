@@ -0,0 +1,70 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20231115004/admin"
+	"go.mongodb.org/atlas/mongodbatlas"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+func TestWithAtlasError(t *testing.T) {
+	t.Run("should report the details of an Atlas client error", func(t *testing.T) {
+		err := fmt.Errorf("failed: %w", &mongodbatlas.ErrorResponse{
+			Response: &http.Response{
+				StatusCode: http.StatusBadRequest,
+				Request:    &http.Request{Method: http.MethodPost, URL: &url.URL{Path: "/clusters"}},
+			},
+			HTTPCode:  http.StatusBadRequest,
+			ErrorCode: "DUPLICATE_CLUSTER_NAME",
+			Detail:    "A cluster named test already exists in group 123.",
+		})
+		ctx := NewContext(zaptest.NewLogger(t).Sugar(), []status.Condition{}, context.Background())
+
+		result := Terminate(DeploymentNotCreatedInAtlas, fmt.Sprintf("failed to create the deployment: %s", err)).WithAtlasError(err)
+		ctx.SetConditionFromResult(status.DeploymentReadyType, result)
+
+		condition, found := ctx.GetCondition(status.DeploymentReadyType)
+		require.True(t, found)
+		assert.Equal(t, &status.AtlasError{
+			HTTPStatus: http.StatusBadRequest,
+			ErrorCode:  "DUPLICATE_CLUSTER_NAME",
+			Detail:     "A cluster named test already exists in group 123.",
+		}, condition.AtlasError)
+		assert.Equal(t, "failed to create the deployment: A cluster named test already exists in group 123. (DUPLICATE_CLUSTER_NAME)", condition.Message)
+	})
+
+	t.Run("should report the details and parameters of an Atlas SDK error", func(t *testing.T) {
+		sdkError := &admin.GenericOpenAPIError{}
+		sdkError.SetError("409 Conflict: OUT_OF_CAPACITY")
+		sdkError.SetModel(admin.ApiError{
+			Error:      admin.PtrInt(http.StatusConflict),
+			ErrorCode:  admin.PtrString("OUT_OF_CAPACITY"),
+			Detail:     admin.PtrString("No capacity in the region."),
+			Parameters: &[]interface{}{"US_EAST_1", 30},
+		})
+
+		result := Terminate(DeploymentNotUpdatedInAtlas, sdkError.Error()).WithAtlasError(sdkError)
+
+		assert.Equal(t, "No capacity in the region. (OUT_OF_CAPACITY)", result.GetMessage())
+		assert.Equal(t, []string{"US_EAST_1", "30"}, result.atlasError.Parameters)
+	})
+
+	t.Run("should leave the results of other errors unchanged", func(t *testing.T) {
+		err := errors.New("connection refused")
+
+		result := Terminate(Internal, err.Error()).WithAtlasError(err)
+
+		assert.Equal(t, "connection refused", result.GetMessage())
+		assert.Nil(t, result.atlasError)
+	})
+}
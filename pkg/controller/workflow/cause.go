@@ -0,0 +1,37 @@
+package workflow
+
+import "errors"
+
+// AtlasOperationError is an error returned by an Atlas API operation. It keeps the message of the original error and
+// allows to report the operation in the condition cause, see Result.WithCause
+type AtlasOperationError struct {
+	Operation string
+	Err       error
+}
+
+func NewAtlasOperationError(operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &AtlasOperationError{Operation: operation, Err: err}
+}
+
+func (e *AtlasOperationError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *AtlasOperationError) Unwrap() error {
+	return e.Err
+}
+
+// AtlasOperation returns the Atlas API operation which returned the error, or an empty string if the error didn't
+// come from an identified Atlas operation
+func AtlasOperation(err error) string {
+	var operationErr *AtlasOperationError
+	if errors.As(err, &operationErr) {
+		return operationErr.Operation
+	}
+
+	return ""
+}
@@ -2,6 +2,13 @@ package workflow
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"time"
 
 	"go.mongodb.org/atlas-sdk/v20231115004/admin"
 
@@ -10,9 +17,13 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/metrics"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/watch"
 )
 
+// stackDigestLength is the number of hex characters of the stack trace hash reported in the condition message
+const stackDigestLength = 12
+
 // Context is a container for some information that is needed on all levels of function calls during reconciliation.
 // It's mutable by design.
 // Note, that it's NOT a Go Context but can carry one
@@ -44,13 +55,24 @@ type Context struct {
 
 	// Go context, when appropriate
 	Context context.Context
+
+	// Reapply is true when the reapply annotation requested a full resynchronization of the resource: the
+	// reconciliation must not rely on caches nor skip the Atlas updates because the resource looks unchanged
+	Reapply bool
+
+	// startedAt is the time the reconciliation started, used to report its total duration
+	startedAt time.Time
+
+	// stepTimings are the durations of the sub-reconcilers run with RunStep
+	stepTimings []StepTiming
 }
 
 func NewContext(log *zap.SugaredLogger, conditions []status.Condition, context context.Context) *Context {
 	return &Context{
-		status:  NewStatus(conditions),
-		Log:     log,
-		Context: context,
+		status:    NewStatus(conditions),
+		Log:       log,
+		Context:   context,
+		startedAt: time.Now(),
 	}
 }
 
@@ -87,10 +109,12 @@ func (c *Context) EnsureCondition(condition status.Condition) *Context {
 
 func (c *Context) SetConditionFromResult(conditionType status.ConditionType, result Result) *Context {
 	condition := status.Condition{
-		Type:    conditionType,
-		Status:  corev1.ConditionFalse,
-		Reason:  string(result.reason),
-		Message: result.message,
+		Type:       conditionType,
+		Status:     corev1.ConditionFalse,
+		Reason:     string(result.reason),
+		Message:    result.message,
+		Cause:      result.cause,
+		AtlasError: result.atlasError,
 	}
 	if result.IsOk() {
 		condition.Status = corev1.ConditionTrue
@@ -146,3 +170,46 @@ func (c *Context) AddResourcesToWatch(resources ...watch.WatchedObject) {
 func (c *Context) ListResourcesToWatch() []watch.WatchedObject {
 	return c.resourcesToWatch
 }
+
+// RecoverPanic converts a value returned by recover() into a terminal "Ready" condition so that the status accumulated
+// so far in the reconciliation can still be flushed. The recovered value and the full stack trace are only logged, as
+// they may hold data which doesn't belong to the status: the condition message carries the type of the value and a
+// short digest of the stack frames, which is the same for every occurrence of a panic and enough to tell different
+// panics apart.
+// Must be called from the deferred function of the Reconcile method.
+func (c *Context) RecoverPanic(controller string, recovered interface{}) Result {
+	stack := debug.Stack()
+	digest := stackDigest(stackFrames())
+
+	metrics.IncReconcilePanics(controller)
+	c.Log.Errorw("Reconciliation panicked", "panic", recovered, "stackDigest", digest, "stack", string(stack))
+
+	result := Terminate(ReconciliationPanicked, fmt.Sprintf("reconciliation panicked with a %T (stack digest: %s)", recovered, digest))
+	c.SetConditionFromResult(status.ReadyType, result)
+
+	return result
+}
+
+// stackFrames returns the function and the file:line of the frames of the calling goroutine. Unlike the stack trace,
+// they hold no goroutine ID, argument value or address, which differ between the occurrences of the same panic
+func stackFrames() []string {
+	pcs := make([]uintptr, 64)
+	pcs = pcs[:runtime.Callers(2, pcs)]
+
+	var frames []string
+	callers := runtime.CallersFrames(pcs)
+	for {
+		frame, more := callers.Next()
+		frames = append(frames, fmt.Sprintf("%s %s:%d", frame.Function, frame.File, frame.Line))
+		if !more {
+			break
+		}
+	}
+
+	return frames
+}
+
+func stackDigest(frames []string) string {
+	sum := sha256.Sum256([]byte(strings.Join(frames, "\n")))
+	return hex.EncodeToString(sum[:])[:stackDigestLength]
+}
@@ -0,0 +1,113 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+func TestRecoverPanic(t *testing.T) {
+	t.Run("should set a terminal ready condition keeping the accumulated status", func(t *testing.T) {
+		ctx := NewContext(zaptest.NewLogger(t).Sugar(), []status.Condition{}, context.Background())
+		ctx.SetConditionTrue(status.ProjectReadyType)
+		ctx.EnsureStatusOption(status.AtlasProjectIDOption("project-id"))
+
+		var result Result
+		func() {
+			defer func() {
+				if p := recover(); p != nil {
+					result = ctx.RecoverPanic("AtlasProject", p)
+				}
+			}()
+			panic("boom")
+		}()
+
+		assert.False(t, result.IsOk())
+		assert.True(t, result.IsWarning())
+		assert.Len(t, ctx.StatusOptions(), 1)
+
+		projectReady, found := ctx.GetCondition(status.ProjectReadyType)
+		assert.True(t, found)
+		assert.Equal(t, corev1.ConditionTrue, projectReady.Status)
+
+		ready, found := ctx.GetCondition(status.ReadyType)
+		assert.True(t, found)
+		assert.Equal(t, corev1.ConditionFalse, ready.Status)
+		assert.Equal(t, string(ReconciliationPanicked), ready.Reason)
+		assert.Contains(t, ready.Message, "reconciliation panicked with a string")
+		assert.NotContains(t, ready.Message, "boom")
+		assert.Contains(t, ready.Message, "stack digest: ")
+	})
+
+	t.Run("stack digest should be stable and short", func(t *testing.T) {
+		frames := []string{"main.main /app/main.go:10"}
+
+		assert.Equal(t, stackDigest(frames), stackDigest(frames))
+		assert.Len(t, stackDigest(frames), stackDigestLength)
+		assert.NotEqual(t, stackDigest(frames), stackDigest([]string{"main.main /app/main.go:11"}))
+	})
+
+	t.Run("stack digest should be the same for every occurrence of a panic", func(t *testing.T) {
+		digest := func() string {
+			ctx := NewContext(zaptest.NewLogger(t).Sugar(), []status.Condition{}, context.Background())
+			func() {
+				defer func() {
+					if p := recover(); p != nil {
+						ctx.RecoverPanic("AtlasProject", p)
+					}
+				}()
+				panic(fmt.Sprintf("boom %p", &ctx))
+			}()
+
+			ready, _ := ctx.GetCondition(status.ReadyType)
+			return ready.Message[strings.Index(ready.Message, "stack digest: "):]
+		}
+
+		digests := make(chan string)
+		for i := 0; i < 2; i++ {
+			go func() { digests <- digest() }()
+		}
+
+		assert.Equal(t, <-digests, <-digests)
+	})
+}
+
+func TestSetConditionFromResultCause(t *testing.T) {
+	t.Run("should record the cause of the result in the condition", func(t *testing.T) {
+		ctx := NewContext(zaptest.NewLogger(t).Sugar(), []status.Condition{}, context.Background())
+		err := NewAtlasOperationError("createProjectIpAccessList", errors.New("INVALID_CIDR"))
+		ctx.SetConditionFromResult(status.IPAccessListReadyType, Terminate(ProjectIPNotCreatedInAtlas, err.Error()).WithCause("ipAccessList", AtlasOperation(err)))
+
+		condition, found := ctx.GetCondition(status.IPAccessListReadyType)
+		assert.True(t, found)
+		assert.Equal(t, "INVALID_CIDR", condition.Message)
+		assert.Equal(t, &status.ConditionCause{Reconciler: "ipAccessList", AtlasOperation: "createProjectIpAccessList"}, condition.Cause)
+	})
+
+	t.Run("should clear the cause when the result has none", func(t *testing.T) {
+		ctx := NewContext(zaptest.NewLogger(t).Sugar(), []status.Condition{}, context.Background())
+		ctx.SetConditionFromResult(status.IPAccessListReadyType, Terminate(ProjectIPNotCreatedInAtlas, "failed").WithCause("ipAccessList", "createProjectIpAccessList"))
+		ctx.SetConditionFromResult(status.IPAccessListReadyType, OK())
+
+		condition, found := ctx.GetCondition(status.IPAccessListReadyType)
+		assert.True(t, found)
+		assert.Nil(t, condition.Cause)
+	})
+}
+
+func TestAtlasOperation(t *testing.T) {
+	assert.Nil(t, NewAtlasOperationError("getCluster", nil))
+	assert.Empty(t, AtlasOperation(errors.New("failed")))
+
+	err := fmt.Errorf("failed to sync: %w", NewAtlasOperationError("getCluster", errors.New("not found")))
+	assert.Equal(t, "getCluster", AtlasOperation(err))
+	assert.EqualError(t, err, "failed to sync: not found")
+}
@@ -14,11 +14,23 @@ const (
 	AtlasDeletionProtection       ConditionReason = "AtlasDeletionProtection"
 	AtlasGovUnsupported           ConditionReason = "AtlasGovUnsupported"
 	AtlasAPIAccessNotConfigured   ConditionReason = "AtlasAPIAccessNotConfigured"
+	ReconciliationPanicked        ConditionReason = "ReconciliationPanicked"
+	NamespaceReconciliationPaused ConditionReason = "NamespaceReconciliationPaused"
+	AtlasRateLimited              ConditionReason = "AtlasRateLimited"
+	InsufficientAtlasPermissions  ConditionReason = "InsufficientAtlasPermissions"
+	AtlasMaintenanceInProgress    ConditionReason = "AtlasMaintenanceInProgress"
+	ReconciliationStepTimedOut    ConditionReason = "ReconciliationStepTimedOut"
+	ReconciliationRetriesExceeded ConditionReason = "ReconciliationRetriesExceeded"
+	DeletionThrottled             ConditionReason = "DeletionThrottled"
+	DeletionPendingDependents     ConditionReason = "DeletionPendingDependents"
 )
 
 // Atlas Project reasons
 const (
 	ProjectNotCreatedInAtlas                   ConditionReason = "ProjectNotCreatedInAtlas"
+	ProjectOrganizationMismatch                ConditionReason = "ProjectOrganizationMismatch"
+	ProjectMigrationFailed                     ConditionReason = "ProjectMigrationFailed"
+	ProjectCreationPolicyNotMet                ConditionReason = "ProjectCreationPolicyNotMet"
 	ProjectIPAccessInvalid                     ConditionReason = "ProjectIPAccessListInvalid"
 	ProjectIPNotCreatedInAtlas                 ConditionReason = "ProjectIPAccessListNotCreatedInAtlas"
 	ProjectWindowInvalid                       ConditionReason = "ProjectWindowInvalid"
@@ -29,12 +41,20 @@ const (
 	ProjectWindowNotAutoDeferredInAtlas        ConditionReason = "ProjectWindowNotAutoDeferredInAtlas"
 	ProjectPEServiceIsNotReadyInAtlas          ConditionReason = "ProjectPrivateEndpointServiceIsNotReadyInAtlas"
 	ProjectPEInterfaceIsNotReadyInAtlas        ConditionReason = "ProjectPrivateEndpointIsNotReadyInAtlas"
+	ProjectPENameTemplateInvalid               ConditionReason = "ProjectPrivateEndpointNameTemplateInvalid"
+	ProjectPEInvalidSpec                       ConditionReason = "ProjectPrivateEndpointInvalidSpec"
+	ProjectRegionalizedPENotReady              ConditionReason = "ProjectRegionalizedPrivateEndpointNotReady"
+	ProjectRegionalizedPEInvalidSpec           ConditionReason = "ProjectRegionalizedPrivateEndpointInvalidSpec"
 	ProjectIPAccessListNotActive               ConditionReason = "ProjectIPAccessListNotActive"
+	ProjectIPAccessListAWSPeeringMissing       ConditionReason = "ProjectIPAccessListAWSPeeringMissing"
+	ProjectIPAccessListEgressDiscoveryFailed   ConditionReason = "ProjectIPAccessListEgressDiscoveryFailed"
 	ProjectIntegrationInternal                 ConditionReason = "ProjectIntegrationInternalError"
 	ProjectIntegrationRequest                  ConditionReason = "ProjectIntegrationRequestError"
 	ProjectIntegrationReady                    ConditionReason = "ProjectIntegrationReady"
+	ProjectIntegrationWebhookValidationFailed  ConditionReason = "ProjectIntegrationWebhookValidationFailed"
 	ProjectPrivateEndpointIsNotReadyInAtlas    ConditionReason = "ProjectPrivateEndpointIsNotReadyInAtlas"
 	ProjectNetworkPeerIsNotReadyInAtlas        ConditionReason = "ProjectNetworkPeerIsNotReadyInAtlas"
+	ProjectNetworkPeerAutoAcceptFailed         ConditionReason = "ProjectNetworkPeerAutoAcceptFailed"
 	ProjectEncryptionAtRestReady               ConditionReason = "ProjectEncryptionAtRestReady"
 	ProjectCloudIntegrationsIsNotReadyInAtlas  ConditionReason = "ProjectCloudIntegrationsIsNotReadyInAtlas"
 	ProjectAuditingReady                       ConditionReason = "ProjectAuditingReady"
@@ -42,17 +62,35 @@ const (
 	ProjectAlertConfigurationIsNotReadyInAtlas ConditionReason = "ProjectAlertConfigurationIsNotReadyInAtlas"
 	ProjectCustomRolesReady                    ConditionReason = "ProjectCustomRolesReady"
 	ProjectTeamUnavailable                     ConditionReason = "ProjectTeamUnavailable"
+	ProjectSyncInProgress                      ConditionReason = "ProjectSyncInProgress"
+	ProjectInvitationsNotReady                 ConditionReason = "ProjectInvitationsNotReady"
+	ProjectDefaultDatabaseUserNotReady         ConditionReason = "ProjectDefaultDatabaseUserNotReady"
+	ProjectUnmanagedDeploymentsNotListed       ConditionReason = "ProjectUnmanagedDeploymentsNotListed"
 )
 
 // Atlas Deployment reasons
 const (
 	DeploymentNotCreatedInAtlas           ConditionReason = "DeploymentNotCreatedInAtlas"
+	DeploymentCreationPolicyNotMet        ConditionReason = "DeploymentCreationPolicyNotMet"
 	DeploymentNotUpdatedInAtlas           ConditionReason = "DeploymentNotUpdatedInAtlas"
 	DeploymentCreating                    ConditionReason = "DeploymentCreating"
 	DeploymentUpdating                    ConditionReason = "DeploymentUpdating"
 	DeploymentConnectionSecretsNotCreated ConditionReason = "DeploymentConnectionSecretsNotCreated"
 	DeploymentAdvancedOptionsReady        ConditionReason = "DeploymentAdvancedOptionsReady"
+	DeploymentUnderProvisioned            ConditionReason = "DeploymentUnderProvisioned"
+	DeploymentOverProvisioned             ConditionReason = "DeploymentOverProvisioned"
+	DeploymentBackupIncompatible          ConditionReason = "DeploymentBackupIncompatible"
+	DeploymentProvisioningTimedOut        ConditionReason = "DeploymentProvisioningTimedOut"
+	DeploymentCapabilityUnsupported       ConditionReason = "DeploymentCapabilityUnsupported"
+	DeploymentServiceNotCreated           ConditionReason = "DeploymentServiceNotCreated"
+	DeploymentEgressConfigMapNotCreated   ConditionReason = "DeploymentEgressConfigMapNotCreated"
+	DeploymentConnectionInfoNotCreated    ConditionReason = "DeploymentConnectionInfoNotCreated"
+	DeploymentIPAddressReportNotCreated   ConditionReason = "DeploymentIPAddressReportNotCreated"
+	DeploymentImmutableFieldChanged       ConditionReason = "DeploymentImmutableFieldChanged"
+	DeploymentRecreating                  ConditionReason = "DeploymentRecreating"
+	DeploymentUnsupportedOverridesInvalid ConditionReason = "DeploymentUnsupportedOverridesInvalid"
 	ServerlessPrivateEndpointReady        ConditionReason = "ServerlessPrivateEndpointReady"
+	ServerlessPENameTemplateInvalid       ConditionReason = "ServerlessPrivateEndpointNameTemplateInvalid"
 	ManagedNamespacesReady                ConditionReason = "ManagedNamespacesReady"
 	CustomZoneMappingReady                ConditionReason = "CustomZoneMappingReady"
 )
@@ -68,6 +106,9 @@ const (
 	DatabaseUserDeploymentAppliedChanges    ConditionReason = "DeploymentAppliedDatabaseUsersChanges"
 	DatabaseUserInvalidSpec                 ConditionReason = "DatabaseUserInvalidSpec"
 	DatabaseUserExpired                     ConditionReason = "DatabaseUserExpired"
+	DatabaseUserX509CertificateNotIssued    ConditionReason = "DatabaseUserX509CertificateNotIssued"
+	DatabaseUserPasswordNotRotated          ConditionReason = "DatabaseUserPasswordNotRotated"
+	DatabaseUserCloseToExpiry               ConditionReason = "DatabaseUserCloseToExpiry"
 )
 
 // Atlas Data Federation reasons
@@ -89,8 +130,107 @@ const (
 
 // Atlas Federated Auth reasons
 const (
-	FederatedAuthNotAvailable     ConditionReason = "FederatedAuthNotAvailable"
-	FederatedAuthIsNotEnabledInCR ConditionReason = "FederatedAuthNotEnabledInCR"
-	FederatedAuthOrgNotConnected  ConditionReason = "FederatedAuthOrgIsNotConnected"
-	FederatedAuthUsersConflict    ConditionReason = "FederatedAuthUsersConflict"
+	FederatedAuthNotAvailable            ConditionReason = "FederatedAuthNotAvailable"
+	FederatedAuthIsNotEnabledInCR        ConditionReason = "FederatedAuthNotEnabledInCR"
+	FederatedAuthOrgNotConnected         ConditionReason = "FederatedAuthOrgIsNotConnected"
+	FederatedAuthUsersConflict           ConditionReason = "FederatedAuthUsersConflict"
+	FederatedAuthDataAccessFailed        ConditionReason = "FederatedAuthDataAccessFailed"
+	FederatedAuthRoleMappingsInvalid     ConditionReason = "FederatedAuthRoleMappingsInvalid"
+	FederatedAuthIdentityProviderInvalid ConditionReason = "FederatedAuthIdentityProviderInvalid"
+)
+
+// Atlas Migration reasons
+const (
+	MigrationDeploymentNotReady ConditionReason = "MigrationDeploymentNotReady"
+	MigrationInvalidSpec        ConditionReason = "MigrationInvalidSpec"
+	MigrationValidating         ConditionReason = "MigrationValidating"
+	MigrationValidationFailed   ConditionReason = "MigrationValidationFailed"
+	MigrationInProgress         ConditionReason = "MigrationInProgress"
+	MigrationReadyForCutover    ConditionReason = "MigrationReadyForCutover"
+	MigrationCuttingOver        ConditionReason = "MigrationCuttingOver"
+	MigrationFailed             ConditionReason = "MigrationFailed"
+)
+
+// Atlas Access Request reasons
+const (
+	AccessRequestPendingApproval ConditionReason = "AccessRequestPendingApproval"
+	AccessRequestInvalidSpec     ConditionReason = "AccessRequestInvalidSpec"
+	AccessRequestUserNotFound    ConditionReason = "AccessRequestUserNotFound"
+	AccessRequestExpired         ConditionReason = "AccessRequestExpired"
+)
+
+// Atlas Search Index reasons
+const (
+	SearchIndexDeploymentNotReady ConditionReason = "SearchIndexDeploymentNotReady"
+	SearchIndexInvalidSpec        ConditionReason = "SearchIndexInvalidSpec"
+	SearchIndexNotCreatedInAtlas  ConditionReason = "SearchIndexNotCreatedInAtlas"
+	SearchIndexNotUpdatedInAtlas  ConditionReason = "SearchIndexNotUpdatedInAtlas"
+	SearchIndexNotDeletedInAtlas  ConditionReason = "SearchIndexNotDeletedInAtlas"
+	SearchIndexBuilding           ConditionReason = "SearchIndexBuilding"
+	SearchIndexFailed             ConditionReason = "SearchIndexFailed"
+)
+
+// Atlas Network Container reasons
+const (
+	NetworkContainerProjectNotReady   ConditionReason = "NetworkContainerProjectNotReady"
+	NetworkContainerInvalidSpec       ConditionReason = "NetworkContainerInvalidSpec"
+	NetworkContainerNotCreatedInAtlas ConditionReason = "NetworkContainerNotCreatedInAtlas"
+	NetworkContainerNotUpdatedInAtlas ConditionReason = "NetworkContainerNotUpdatedInAtlas"
+	NetworkContainerNotDeletedInAtlas ConditionReason = "NetworkContainerNotDeletedInAtlas"
+)
+
+// Atlas Private Endpoint reasons
+const (
+	PrivateEndpointProjectNotReady          ConditionReason = "PrivateEndpointProjectNotReady"
+	PrivateEndpointInvalidSpec              ConditionReason = "PrivateEndpointInvalidSpec"
+	PrivateEndpointServiceNotCreatedInAtlas ConditionReason = "PrivateEndpointServiceNotCreatedInAtlas"
+	PrivateEndpointServiceInitiating        ConditionReason = "PrivateEndpointServiceInitiating"
+	PrivateEndpointServiceFailed            ConditionReason = "PrivateEndpointServiceFailed"
+	PrivateEndpointNotCreatedInAtlas        ConditionReason = "PrivateEndpointNotCreatedInAtlas"
+	PrivateEndpointPending                  ConditionReason = "PrivateEndpointPending"
+	PrivateEndpointFailed                   ConditionReason = "PrivateEndpointFailed"
+	PrivateEndpointRecreating               ConditionReason = "PrivateEndpointRecreating"
+	PrivateEndpointNotDeletedInAtlas        ConditionReason = "PrivateEndpointNotDeletedInAtlas"
+)
+
+// Atlas Org User reasons
+const (
+	OrgUserInvalidSpec         ConditionReason = "OrgUserInvalidSpec"
+	OrgUserNotInvitedInAtlas   ConditionReason = "OrgUserNotInvitedInAtlas"
+	OrgUserNotUpdatedInAtlas   ConditionReason = "OrgUserNotUpdatedInAtlas"
+	OrgUserNotAdopted          ConditionReason = "OrgUserNotAdopted"
+	OrgUserNotRemovedFromAtlas ConditionReason = "OrgUserNotRemovedFromAtlas"
+	OrgUserTeamsNotReady       ConditionReason = "OrgUserTeamsNotReady"
+)
+
+// Atlas Project API Key reasons
+const (
+	ProjectAPIKeyProjectNotReady   ConditionReason = "ProjectAPIKeyProjectNotReady"
+	ProjectAPIKeyNotCreatedInAtlas ConditionReason = "ProjectAPIKeyNotCreatedInAtlas"
+	ProjectAPIKeyNotUpdatedInAtlas ConditionReason = "ProjectAPIKeyNotUpdatedInAtlas"
+	ProjectAPIKeyNotDeletedInAtlas ConditionReason = "ProjectAPIKeyNotDeletedInAtlas"
+	ProjectAPIKeySecretNotWritten  ConditionReason = "ProjectAPIKeySecretNotWritten"
+)
+
+// Atlas Organization reasons
+const (
+	OrganizationNotCreatedInAtlas ConditionReason = "OrganizationNotCreatedInAtlas"
+	OrganizationNotUpdatedInAtlas ConditionReason = "OrganizationNotUpdatedInAtlas"
+	OrganizationNotDeletedInAtlas ConditionReason = "OrganizationNotDeletedInAtlas"
+	OrganizationSecretNotWritten  ConditionReason = "OrganizationSecretNotWritten"
+	OrganizationSecretMissing     ConditionReason = "OrganizationSecretMissing"
+)
+
+// Atlas Resource Policy reasons
+const (
+	ResourcePolicyInvalid           ConditionReason = "ResourcePolicyInvalid"
+	ResourcePolicyNotCreatedInAtlas ConditionReason = "ResourcePolicyNotCreatedInAtlas"
+	ResourcePolicyNotUpdatedInAtlas ConditionReason = "ResourcePolicyNotUpdatedInAtlas"
+	ResourcePolicyNotDeletedInAtlas ConditionReason = "ResourcePolicyNotDeletedInAtlas"
+)
+
+// Atlas Environment reasons
+const (
+	EnvironmentResourcesNotApplied ConditionReason = "EnvironmentResourcesNotApplied"
+	EnvironmentResourcesNotReady   ConditionReason = "EnvironmentResourcesNotReady"
 )
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
 )
 
 const (
@@ -19,6 +21,10 @@ type Result struct {
 	// warning indicates if the reconciliation hasn't ended the expected way. Most of all this may happens in case of
 	// an error
 	warning bool
+	// cause identifies the sub-reconciler and the Atlas operation which led to the result
+	cause *status.ConditionCause
+	// atlasError holds the details of the Atlas Admin API error which led to the result
+	atlasError *status.AtlasError
 }
 
 // OK indicates that the reconciliation logic can proceed further
@@ -79,6 +85,17 @@ func (r Result) WithMessage(message string) Result {
 	return r
 }
 
+// WithCause records the sub-reconciler and the Atlas API operation which led to the result. They are reported in the
+// condition set from the result. The Atlas operation is empty when the result wasn't caused by an Atlas API call.
+// The condition has no cause when the result has none.
+func (r Result) WithCause(reconciler, atlasOperation string) Result {
+	r.cause = &status.ConditionCause{
+		Reconciler:     reconciler,
+		AtlasOperation: atlasOperation,
+	}
+	return r
+}
+
 func (r Result) IsOk() bool {
 	return !r.terminated
 }
@@ -95,6 +112,10 @@ func (r Result) GetMessage() string {
 	return r.message
 }
 
+func (r Result) GetReason() ConditionReason {
+	return r.reason
+}
+
 func (r Result) ReconcileResult() reconcile.Result {
 	if r.requeueAfter < 0 {
 		return reconcile.Result{}
@@ -0,0 +1,113 @@
+package workflow
+
+import (
+	"fmt"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/metrics"
+)
+
+// MaxRetries is the number of consecutive failed reconciliations with the same reason after which a resource is
+// marked as Degraded and no longer retried, until its spec changes or the reapply annotation is set. Zero means the
+// resources are retried forever. It's configured once at startup by the manager
+var MaxRetries = 0
+
+// failureStreak holds the consecutive failures of a generation of a resource. It's kept in memory, so the streaks
+// start over when the operator restarts
+type failureStreak struct {
+	controller string
+	generation int64
+	reason     string
+	count      int
+	degraded   bool
+}
+
+var (
+	failureStreaks = map[types.UID]*failureStreak{}
+	streaksLock    sync.Mutex
+)
+
+// Degraded returns true if the resource exhausted its retries and must not be reconciled. A new generation of the
+// resource, its deletion or the reapply annotation end the degraded state
+func (c *Context) Degraded(resource metav1.Object) bool {
+	streaksLock.Lock()
+	defer streaksLock.Unlock()
+
+	streak, ok := failureStreaks[resource.GetUID()]
+	if !ok || !streak.degraded {
+		return false
+	}
+
+	if c.Reapply || streak.generation != resource.GetGeneration() || !resource.GetDeletionTimestamp().IsZero() {
+		forgetStreak(resource.GetUID())
+		c.UnsetCondition(status.DegradedType)
+		return false
+	}
+
+	return true
+}
+
+// CapRetries records the outcome of the reconciliation of the resource. Once the reconciliation failed MaxRetries
+// times in a row with the same reason, the Degraded condition is set and the reconciliation is no longer requeued.
+// Must be called from the deferred function of the Reconcile method, before the status is updated
+func (c *Context) CapRetries(controller string, resource metav1.Object, res reconcile.Result) reconcile.Result {
+	if MaxRetries <= 0 {
+		return res
+	}
+
+	streaksLock.Lock()
+	defer streaksLock.Unlock()
+
+	if !c.failed() {
+		forgetStreak(resource.GetUID())
+		c.UnsetCondition(status.DegradedType)
+		return res
+	}
+
+	streak, ok := failureStreaks[resource.GetUID()]
+	if !ok || streak.generation != resource.GetGeneration() || streak.reason != c.lastCondition.Reason {
+		forgetStreak(resource.GetUID())
+		streak = &failureStreak{controller: controller, generation: resource.GetGeneration(), reason: c.lastCondition.Reason}
+		failureStreaks[resource.GetUID()] = streak
+	}
+	streak.count++
+
+	if streak.count < MaxRetries {
+		return res
+	}
+
+	if !streak.degraded {
+		streak.degraded = true
+		metrics.IncDegradedResources(controller)
+		c.Log.Warnw("Reconciliation retries exhausted, the resource won't be retried until its spec changes or the reapply annotation is set",
+			"reason", streak.reason, "failures", streak.count)
+	}
+	c.EnsureCondition(status.TrueCondition(status.DegradedType).
+		WithReason(string(ReconciliationRetriesExceeded)).
+		WithMessageRegexp(fmt.Sprintf("the reconciliation failed %d times in a row with the reason %s, change the spec or set the reapply annotation to retry", streak.count, streak.reason)))
+
+	return reconcile.Result{}
+}
+
+// failed returns true if the last condition set in the reconciliation is an unexpected failure
+func (c *Context) failed() bool {
+	return c.lastConditionWarn && c.lastCondition != nil && c.lastCondition.Status != corev1.ConditionTrue
+}
+
+func forgetStreak(uid types.UID) {
+	streak, ok := failureStreaks[uid]
+	if !ok {
+		return
+	}
+
+	if streak.degraded {
+		metrics.DecDegradedResources(streak.controller)
+	}
+	delete(failureStreaks, uid)
+}
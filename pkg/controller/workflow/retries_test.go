@@ -0,0 +1,98 @@
+package workflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+func TestCapRetries(t *testing.T) {
+	MaxRetries = 3
+	defer func() { MaxRetries = 0 }()
+
+	retry := reconcile.Result{RequeueAfter: DefaultRetry}
+	reconcileWith := func(t *testing.T, resource metav1.Object, result Result) (*Context, reconcile.Result) {
+		ctx := NewContext(zaptest.NewLogger(t).Sugar(), []status.Condition{}, context.Background())
+		ctx.SetConditionFromResult(status.ReadyType, result)
+
+		return ctx, ctx.CapRetries("AtlasProject", resource, result.ReconcileResult())
+	}
+
+	t.Run("should mark the resource as degraded after failing with the same reason", func(t *testing.T) {
+		resource := &metav1.ObjectMeta{UID: types.UID("degraded"), Generation: 1}
+		defer forgetStreak(resource.UID)
+
+		for i := 0; i < 2; i++ {
+			_, res := reconcileWith(t, resource, Terminate(Internal, "error"))
+			assert.Equal(t, retry, res)
+		}
+		ctx, res := reconcileWith(t, resource, Terminate(Internal, "error"))
+
+		assert.Equal(t, reconcile.Result{}, res)
+		degraded, found := ctx.GetCondition(status.DegradedType)
+		assert.True(t, found)
+		assert.Equal(t, corev1.ConditionTrue, degraded.Status)
+		assert.Equal(t, string(ReconciliationRetriesExceeded), degraded.Reason)
+		assert.Equal(t, "the reconciliation failed 3 times in a row with the reason InternalError, change the spec or set the reapply annotation to retry", degraded.Message)
+		assert.True(t, ctx.Degraded(resource))
+	})
+
+	t.Run("should start over when the reason changes", func(t *testing.T) {
+		resource := &metav1.ObjectMeta{UID: types.UID("reason-changed"), Generation: 1}
+		defer forgetStreak(resource.UID)
+
+		reconcileWith(t, resource, Terminate(Internal, "error"))
+		reconcileWith(t, resource, Terminate(Internal, "error"))
+		_, res := reconcileWith(t, resource, Terminate(AtlasAPIAccessNotConfigured, "error"))
+
+		assert.Equal(t, retry, res)
+	})
+
+	t.Run("should not count the reconciliations in progress", func(t *testing.T) {
+		resource := &metav1.ObjectMeta{UID: types.UID("in-progress"), Generation: 1}
+		defer forgetStreak(resource.UID)
+
+		for i := 0; i < 3; i++ {
+			_, res := reconcileWith(t, resource, InProgress(ProjectPEServiceIsNotReadyInAtlas, "waiting"))
+			assert.Equal(t, retry, res)
+		}
+	})
+
+	t.Run("should retry a degraded resource once its spec changes or it's reapplied", func(t *testing.T) {
+		resource := &metav1.ObjectMeta{UID: types.UID("recovered"), Generation: 1}
+		defer forgetStreak(resource.UID)
+		for i := 0; i < 3; i++ {
+			reconcileWith(t, resource, Terminate(Internal, "error"))
+		}
+
+		ctx := NewContext(zaptest.NewLogger(t).Sugar(), []status.Condition{}, context.Background())
+		ctx.Reapply = true
+		assert.False(t, ctx.Degraded(resource))
+
+		for i := 0; i < 3; i++ {
+			reconcileWith(t, resource, Terminate(Internal, "error"))
+		}
+		resource.Generation = 2
+		assert.False(t, ctx.Degraded(resource))
+	})
+
+	t.Run("should clear the degraded condition once the reconciliation succeeds", func(t *testing.T) {
+		resource := &metav1.ObjectMeta{UID: types.UID("succeeded"), Generation: 1}
+		defer forgetStreak(resource.UID)
+		ctx := NewContext(zaptest.NewLogger(t).Sugar(), []status.Condition{status.TrueCondition(status.DegradedType)}, context.Background())
+		ctx.SetConditionFromResult(status.ReadyType, OK())
+
+		ctx.CapRetries("AtlasProject", resource, OK().ReconcileResult())
+
+		_, found := ctx.GetCondition(status.DegradedType)
+		assert.False(t, found)
+	})
+}
@@ -0,0 +1,85 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// StepTiming is the time spent in one of the sub-reconcilers (ensure* functions) run with RunStep
+type StepTiming struct {
+	Name     string
+	Duration time.Duration
+	TimedOut bool
+}
+
+// RunStep runs a sub-reconciler with a child Go context, so that the Atlas and Kubernetes calls made by the step are
+// cancelled once it returns or when the timeout elapses (zero means no deadline). The time spent in the step is
+// recorded for LogStepTimings. A step that fails after its deadline expired is reported as timed out
+func (c *Context) RunStep(name string, timeout time.Duration, step func() Result) Result {
+	parent := c.Context
+	if parent == nil {
+		parent = context.Background()
+	}
+
+	var stepCtx context.Context
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		stepCtx, cancel = context.WithTimeout(parent, timeout)
+	} else {
+		stepCtx, cancel = context.WithCancel(parent)
+	}
+	defer cancel()
+
+	c.Context = stepCtx
+	defer func() { c.Context = parent }()
+
+	started := time.Now()
+	result := step()
+	timing := StepTiming{Name: name, Duration: time.Since(started)}
+
+	if !result.IsOk() && errors.Is(stepCtx.Err(), context.DeadlineExceeded) && !errors.Is(parent.Err(), context.DeadlineExceeded) {
+		timing.TimedOut = true
+		c.Log.Warnw("Reconciliation step timed out", "step", name, "timeout", timeout.String())
+		result = Terminate(ReconciliationStepTimedOut, fmt.Sprintf("%s didn't complete within %s: %s", name, timeout, result.GetMessage()))
+	}
+	c.stepTimings = append(c.stepTimings, timing)
+
+	return result
+}
+
+// StepTimings returns the timing of the steps run so far, in the order they were run
+func (c Context) StepTimings() []StepTiming {
+	return c.stepTimings
+}
+
+// LogStepTimings logs the total duration of the reconciliation together with the time spent in each step, and the
+// slowest of them. Must be called once at the end of the reconciliation
+func (c *Context) LogStepTimings() {
+	if len(c.stepTimings) == 0 {
+		return
+	}
+
+	steps := make(map[string]string, len(c.stepTimings))
+	slowest := c.stepTimings[0]
+	var timedOut []string
+	for _, timing := range c.stepTimings {
+		steps[timing.Name] = timing.Duration.String()
+		if timing.Duration > slowest.Duration {
+			slowest = timing
+		}
+		if timing.TimedOut {
+			timedOut = append(timedOut, timing.Name)
+		}
+	}
+
+	keysAndValues := []interface{}{"steps", steps, "slowestStep", slowest.Name, "slowestStepDuration", slowest.Duration.String()}
+	if !c.startedAt.IsZero() {
+		keysAndValues = append(keysAndValues, "duration", time.Since(c.startedAt).String())
+	}
+	if len(timedOut) > 0 {
+		keysAndValues = append(keysAndValues, "timedOutSteps", timedOut)
+	}
+	c.Log.Infow("Reconciliation steps timing", keysAndValues...)
+}
@@ -0,0 +1,108 @@
+package workflow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/api/v1/status"
+)
+
+func TestRunStep(t *testing.T) {
+	newContext := func() (*Context, *observer.ObservedLogs) {
+		core, logs := observer.New(zapcore.InfoLevel)
+		return NewContext(zap.New(core).Sugar(), []status.Condition{}, context.Background()), logs
+	}
+
+	t.Run("should run the step with a child context and restore the parent one", func(t *testing.T) {
+		ctx, _ := newContext()
+		parent := ctx.Context
+		var stepCtx context.Context
+
+		result := ctx.RunStep("step", time.Minute, func() Result {
+			stepCtx = ctx.Context
+			return OK()
+		})
+
+		assert.True(t, result.IsOk())
+		assert.NotEqual(t, parent, stepCtx)
+		_, hasDeadline := stepCtx.Deadline()
+		assert.True(t, hasDeadline)
+		assert.ErrorIs(t, stepCtx.Err(), context.Canceled)
+		assert.Equal(t, parent, ctx.Context)
+		require.Len(t, ctx.StepTimings(), 1)
+		assert.Equal(t, "step", ctx.StepTimings()[0].Name)
+		assert.False(t, ctx.StepTimings()[0].TimedOut)
+	})
+
+	t.Run("should not set a deadline without timeout", func(t *testing.T) {
+		ctx, _ := newContext()
+
+		ctx.RunStep("step", 0, func() Result {
+			_, hasDeadline := ctx.Context.Deadline()
+			assert.False(t, hasDeadline)
+			return OK()
+		})
+	})
+
+	t.Run("should report a step failing after its deadline as timed out", func(t *testing.T) {
+		ctx, _ := newContext()
+
+		result := ctx.RunStep("slowStep", time.Millisecond, func() Result {
+			<-ctx.Context.Done()
+			return Terminate(Internal, ctx.Context.Err().Error())
+		})
+
+		assert.False(t, result.IsOk())
+		assert.Equal(t, ReconciliationStepTimedOut, result.GetReason())
+		assert.Equal(t, "slowStep didn't complete within 1ms: context deadline exceeded", result.GetMessage())
+		assert.True(t, ctx.StepTimings()[0].TimedOut)
+	})
+
+	t.Run("should keep the result of a step failing before its deadline", func(t *testing.T) {
+		ctx, _ := newContext()
+
+		result := ctx.RunStep("step", time.Minute, func() Result {
+			return Terminate(Internal, "failed")
+		})
+
+		assert.Equal(t, Internal, result.GetReason())
+	})
+}
+
+func TestLogStepTimings(t *testing.T) {
+	t.Run("should log nothing without steps", func(t *testing.T) {
+		core, logs := observer.New(zapcore.InfoLevel)
+		ctx := NewContext(zap.New(core).Sugar(), []status.Condition{}, context.Background())
+
+		ctx.LogStepTimings()
+
+		assert.Zero(t, logs.Len())
+	})
+
+	t.Run("should log the time spent in each step and the slowest one", func(t *testing.T) {
+		core, logs := observer.New(zapcore.InfoLevel)
+		ctx := NewContext(zap.New(core).Sugar(), []status.Condition{}, context.Background())
+		ctx.stepTimings = []StepTiming{
+			{Name: "fast", Duration: time.Second},
+			{Name: "slow", Duration: time.Minute, TimedOut: true},
+		}
+
+		ctx.LogStepTimings()
+
+		entries := logs.FilterMessage("Reconciliation steps timing").All()
+		require.Len(t, entries, 1)
+		fields := entries[0].ContextMap()
+		assert.Equal(t, map[string]string{"fast": "1s", "slow": "1m0s"}, fields["steps"])
+		assert.Equal(t, "slow", fields["slowestStep"])
+		assert.Equal(t, "1m0s", fields["slowestStepDuration"])
+		assert.Equal(t, []interface{}{"slow"}, fields["timedOutSteps"])
+		assert.Contains(t, fields, "duration")
+	})
+}
@@ -0,0 +1,201 @@
+package main
+
+// Generates the ClusterRoles granting access to the Atlas Custom Resources by category, so that the teams can be given
+// access to the network kinds only, or to the data kinds only. The categories are read from the CRDs, as set by the
+// kubebuilder:resource markers of the types. Run by "make manifests" once the CRDs are generated.
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// umbrellaCategory is shared by all the Atlas kinds, its roles aggregate the roles of the other categories
+	umbrellaCategory = "atlas"
+
+	aggregationLabelPrefix = "atlas.mongodb.com/aggregate-to-"
+)
+
+var (
+	viewerVerbs = []string{"get", "list", "watch"}
+	editorVerbs = []string{"create", "delete", "get", "list", "patch", "update", "watch"}
+)
+
+func main() {
+	crdsDir := flag.String("crds", "config/crd/bases", "directory of the generated CRDs")
+	outputDir := flag.String("output", "config/rbac/categories", "directory the ClusterRoles are written to")
+	flag.Parse()
+
+	if err := generate(*crdsDir, *outputDir); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func generate(crdsDir, outputDir string) error {
+	crds, err := readCRDs(crdsDir)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(outputDir, 0o755); err != nil {
+		return err
+	}
+
+	files := []string{}
+	for _, role := range categoryRoles(crds) {
+		file := role.Name + ".yaml"
+		if err = writeRole(filepath.Join(outputDir, file), role); err != nil {
+			return err
+		}
+		files = append(files, file)
+	}
+
+	return writeKustomization(filepath.Join(outputDir, "kustomization.yaml"), files)
+}
+
+func readCRDs(dir string) ([]apiextensionsv1.CustomResourceDefinition, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+
+	crds := make([]apiextensionsv1.CustomResourceDefinition, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		crd := apiextensionsv1.CustomResourceDefinition{}
+		if err = yaml.Unmarshal(data, &crd); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		crds = append(crds, crd)
+	}
+
+	return crds, nil
+}
+
+// categoryRoles returns the viewer and editor ClusterRoles of every category of the CRDs, sorted by name. The roles of
+// the umbrella category aggregate the roles of the other categories
+func categoryRoles(crds []apiextensionsv1.CustomResourceDefinition) []rbacv1.ClusterRole {
+	resources := map[string]map[string][]string{}
+	for _, crd := range crds {
+		for _, category := range crd.Spec.Names.Categories {
+			if category == umbrellaCategory {
+				continue
+			}
+			if resources[category] == nil {
+				resources[category] = map[string][]string{}
+			}
+			resources[category][crd.Spec.Group] = append(resources[category][crd.Spec.Group], crd.Spec.Names.Plural)
+		}
+	}
+
+	roles := []rbacv1.ClusterRole{
+		aggregatedRole(umbrellaCategory, "viewer"),
+		aggregatedRole(umbrellaCategory, "editor"),
+	}
+	for category, groups := range resources {
+		roles = append(roles,
+			categoryRole(category, "viewer", viewerVerbs, groups),
+			categoryRole(category, "editor", editorVerbs, groups),
+		)
+	}
+
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].Name < roles[j].Name
+	})
+
+	return roles
+}
+
+func categoryRole(category, access string, verbs []string, groups map[string][]string) rbacv1.ClusterRole {
+	role := newClusterRole(category, access)
+	role.Labels = map[string]string{
+		aggregationLabelPrefix + roleName(umbrellaCategory, access): "true",
+	}
+
+	apiGroups := make([]string, 0, len(groups))
+	for group := range groups {
+		apiGroups = append(apiGroups, group)
+	}
+	sort.Strings(apiGroups)
+
+	for _, group := range apiGroups {
+		plurals := groups[group]
+		sort.Strings(plurals)
+
+		statuses := make([]string, 0, len(plurals))
+		for _, plural := range plurals {
+			statuses = append(statuses, plural+"/status")
+		}
+
+		role.Rules = append(role.Rules,
+			rbacv1.PolicyRule{APIGroups: []string{group}, Resources: plurals, Verbs: verbs},
+			rbacv1.PolicyRule{APIGroups: []string{group}, Resources: statuses, Verbs: []string{"get"}},
+		)
+	}
+
+	return role
+}
+
+func aggregatedRole(category, access string) rbacv1.ClusterRole {
+	role := newClusterRole(category, access)
+	role.AggregationRule = &rbacv1.AggregationRule{
+		ClusterRoleSelectors: []metav1.LabelSelector{{
+			MatchLabels: map[string]string{aggregationLabelPrefix + role.Name: "true"},
+		}},
+	}
+	// the rules are filled in by the Kubernetes controller manager
+	role.Rules = []rbacv1.PolicyRule{}
+
+	return role
+}
+
+func newClusterRole(category, access string) rbacv1.ClusterRole {
+	return rbacv1.ClusterRole{
+		TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "ClusterRole"},
+		ObjectMeta: metav1.ObjectMeta{Name: roleName(category, access)},
+	}
+}
+
+func roleName(category, access string) string {
+	return fmt.Sprintf("%s-%s", category, access)
+}
+
+func writeRole(path string, role rbacv1.ClusterRole) error {
+	data, err := yaml.Marshal(role)
+	if err != nil {
+		return err
+	}
+
+	verb := "view"
+	if strings.HasSuffix(role.Name, "-editor") {
+		verb = "edit"
+	}
+	category := strings.TrimSuffix(strings.TrimSuffix(role.Name, "-viewer"), "-editor")
+	header := fmt.Sprintf("# permissions for end users to %s the Atlas Custom Resources of the %s category.\n"+
+		"# Generated by scripts/category-roles, DO NOT EDIT.\n", verb, category)
+
+	return os.WriteFile(path, append([]byte(header), data...), 0o644)
+}
+
+func writeKustomization(path string, files []string) error {
+	content := "# Generated by scripts/category-roles, DO NOT EDIT.\nresources:\n"
+	for _, file := range files {
+		content += fmt.Sprintf("- %s\n", file)
+	}
+
+	return os.WriteFile(path, []byte(content), 0o644)
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+func newCRD(plural string, categories ...string) apiextensionsv1.CustomResourceDefinition {
+	crd := apiextensionsv1.CustomResourceDefinition{}
+	crd.Spec.Group = "atlas.mongodb.com"
+	crd.Spec.Names.Plural = plural
+	crd.Spec.Names.Categories = categories
+
+	return crd
+}
+
+func TestCategoryRoles(t *testing.T) {
+	roles := categoryRoles([]apiextensionsv1.CustomResourceDefinition{
+		newCRD("atlasprivateendpoints", "atlas", "atlas-network"),
+		newCRD("atlasdeployments", "atlas", "atlas-data"),
+		newCRD("atlasnetworkcontainers", "atlas", "atlas-network"),
+	})
+
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, role.Name)
+	}
+	require.Equal(t, []string{
+		"atlas-data-editor", "atlas-data-viewer", "atlas-editor", "atlas-network-editor", "atlas-network-viewer", "atlas-viewer",
+	}, names)
+
+	t.Run("should grant access to the kinds of the category", func(t *testing.T) {
+		networkViewer := roles[4]
+
+		assert.Equal(t, map[string]string{"atlas.mongodb.com/aggregate-to-atlas-viewer": "true"}, networkViewer.Labels)
+		require.Len(t, networkViewer.Rules, 2)
+		assert.Equal(t, []string{"atlasnetworkcontainers", "atlasprivateendpoints"}, networkViewer.Rules[0].Resources)
+		assert.Equal(t, viewerVerbs, networkViewer.Rules[0].Verbs)
+		assert.Equal(t, []string{"atlasnetworkcontainers/status", "atlasprivateendpoints/status"}, networkViewer.Rules[1].Resources)
+	})
+
+	t.Run("should aggregate the roles of the categories in the umbrella roles", func(t *testing.T) {
+		editor := roles[2]
+
+		require.NotNil(t, editor.AggregationRule)
+		assert.Equal(t, map[string]string{"atlas.mongodb.com/aggregate-to-atlas-editor": "true"}, editor.AggregationRule.ClusterRoleSelectors[0].MatchLabels)
+		assert.Empty(t, editor.Rules)
+	})
+}
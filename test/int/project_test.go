@@ -487,7 +487,7 @@ var _ = Describe("AtlasProject", Label("int", "AtlasProject"), func() {
 			Expect(list.GetTotalCount()).To(Equal(1))
 			Expect(list.GetResults()[0]).To(access.MatchIPAccessList(activeList))
 		})
-		It("Should Fail (AWS security group not supported without VPC)", func() {
+		It("Should Fail (AWS security group not supported without network peering)", func() {
 			createdProject = mdbv1.DefaultProject(namespace.Name, connectionSecret.Name).
 				WithIPAccessList(project.NewIPAccessList().WithAWSGroup("sg-0026348ec11780bd1"))
 
@@ -498,8 +498,8 @@ var _ = Describe("AtlasProject", Label("int", "AtlasProject"), func() {
 			}).WithTimeout(ProjectCreationTimeout).WithPolling(interval).Should(BeTrue())
 
 			ipAccessFailedCondition := status.FalseCondition(status.IPAccessListReadyType).
-				WithReason(string(workflow.ProjectIPNotCreatedInAtlas)).
-				WithMessageRegexp(".*CANNOT_USE_AWS_SECURITY_GROUP_WITHOUT_VPC_PEERING_CONNECTION.*")
+				WithReason(string(workflow.ProjectIPAccessListAWSPeeringMissing)).
+				WithMessageRegexp(".*require an AWS network peering connection.*")
 
 			expectedConditionsMatchers := conditions.MatchConditions(
 				status.TrueCondition(status.ProjectReadyType),